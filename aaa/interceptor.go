@@ -2,50 +2,207 @@ package aaa
 
 import (
 	"context"
+	"errors"
+	"strings"
 	"time"
 
 	"github.com/naren-m/panchangam/log"
 	"github.com/naren-m/panchangam/observability"
 	"google.golang.org/grpc"
-	// "google.golang.org/grpc/codes"
-	// "google.golang.org/grpc/status"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 )
 
 var logger = log.Logger()
 
 type Auth struct {
 	observer observability.ObserverInterface
+	keys     KeyStore
+	scopes   map[string]Scope
+	// serviceScopes holds the scopes of the RPCs of whole services, by
+	// service name.
+	serviceScopes map[string]Scope
+	// public holds the RPCs called without an API key.
+	public map[string]bool
+	usage  *Usage
 }
 
-func NewAuth() *Auth {
+// AuthOption configures an Auth.
+type AuthOption func(*Auth)
+
+// WithKeyStore requires every request to carry a valid API key from keys
+// in its x-api-key metadata. Without it every request is accepted.
+func WithKeyStore(keys KeyStore) AuthOption {
+	return func(a *Auth) {
+		a.keys = keys
+	}
+}
+
+// WithMethodScope sets the scope an API key needs to call the RPC with the
+// given full method name, e.g. "/panchangam.Panchangam/Get". RPCs default
+// to the read scope.
+func WithMethodScope(fullMethod string, scope Scope) AuthOption {
+	return func(a *Auth) {
+		a.scopes[fullMethod] = scope
+	}
+}
+
+// WithServiceScope sets the scope an API key needs to call every RPC of the
+// service with the given full name, e.g. "panchangam.Admin". Scopes set for
+// single RPCs with WithMethodScope take precedence.
+func WithServiceScope(service string, scope Scope) AuthOption {
+	return func(a *Auth) {
+		a.serviceScopes[service] = scope
+	}
+}
+
+// WithPublicMethod lets requests without an API key call the RPC with the
+// given full method name, e.g. the health checks of load balancers, which
+// cannot send one.
+func WithPublicMethod(fullMethod string) AuthOption {
+	return func(a *Auth) {
+		a.public[fullMethod] = true
+	}
+}
+
+// WithUsage records every call in usage, by API key and method, for the
+// usage reports.
+func WithUsage(usage *Usage) AuthOption {
+	return func(a *Auth) {
+		a.usage = usage
+	}
+}
+
+func NewAuth(opts ...AuthOption) *Auth {
 	o := observability.Observer()
-	return &Auth{
-		observer: o,
+	a := &Auth{
+		observer:      o,
+		scopes:        map[string]Scope{},
+		public:        map[string]bool{},
+		serviceScopes: map[string]Scope{},
+	}
+	for _, opt := range opts {
+		opt(a)
 	}
+	return a
+}
+
+type keyContextKey struct{}
+
+// KeyFromContext returns the API key a request was authenticated with.
+func KeyFromContext(ctx context.Context) (Key, bool) {
+	k, ok := ctx.Value(keyContextKey{}).(Key)
+	return k, ok
 }
 
 func (a *Auth) AuthInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 
 		c, span := a.observer.Tracer(info.FullMethod).Start(ctx, "aaa.AuthInterceptor")
-		logger.InfoContext(c, "Successfully authenticated.", "rpc", info.FullMethod)
-		time.Sleep(100 * time.Millisecond)
-		span.End()
+		defer span.End()
+		if a.keys == nil {
+			logger.InfoContext(c, "Successfully authenticated.", "rpc", info.FullMethod)
+			return handler(ctx, req)
+		}
+		if a.public[info.FullMethod] {
+			return handler(ctx, req)
+		}
 
-		return handler(ctx, req)
+		key, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			logger.InfoContext(c, "Authentication failed", "rpc", info.FullMethod, "error", err)
+			return nil, err
+		}
+		logger.InfoContext(c, "Successfully authenticated.", "rpc", info.FullMethod, "key_id", key.ID)
+		return handler(context.WithValue(ctx, keyContextKey{}, key), req)
 	}
 }
 
+// StreamAuthInterceptor authenticates streaming RPCs as AuthInterceptor
+// does unary ones.
+func (a *Auth) StreamAuthInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		c, span := a.observer.Tracer(info.FullMethod).Start(ctx, "aaa.StreamAuthInterceptor")
+		defer span.End()
+		if a.keys == nil || a.public[info.FullMethod] {
+			logger.InfoContext(c, "Successfully authenticated.", "rpc", info.FullMethod)
+			return handler(srv, ss)
+		}
+
+		key, err := a.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			logger.InfoContext(c, "Authentication failed", "rpc", info.FullMethod, "error", err)
+			return err
+		}
+		logger.InfoContext(c, "Successfully authenticated.", "rpc", info.FullMethod, "key_id", key.ID)
+		return handler(srv, &contextStream{ServerStream: ss, ctx: context.WithValue(ctx, keyContextKey{}, key)})
+	}
+}
+
+// contextStream is a server stream with the context of an interceptor.
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// authenticate returns the API key of the request if it may call method.
+func (a *Auth) authenticate(ctx context.Context, method string) (Key, error) {
+	md, _ := metadata.FromIncomingContext(ctx)
+	secrets := md.Get(APIKeyHeader)
+	if len(secrets) == 0 || secrets[0] == "" {
+		return Key{}, status.Error(codes.Unauthenticated, "missing API key")
+	}
+	key, err := a.keys.Validate(secrets[0])
+	if errors.Is(err, ErrInvalidKey) {
+		return Key{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "Failed to validate API key", "error", err)
+		return Key{}, status.Error(codes.Unavailable, "API keys unavailable")
+	}
+	scope := a.scope(method)
+	if !key.Allows(scope) {
+		return Key{}, status.Errorf(codes.PermissionDenied, "API key %s lacks the %s scope", key.ID, scope)
+	}
+	return key, nil
+}
+
+// scope returns the scope an API key needs to call method, a full method
+// name such as "/panchangam.Panchangam/Get".
+func (a *Auth) scope(method string) Scope {
+	if scope, ok := a.scopes[method]; ok {
+		return scope
+	}
+	service, _, _ := strings.Cut(strings.TrimPrefix(method, "/"), "/")
+	if scope, ok := a.serviceScopes[service]; ok {
+		return scope
+	}
+	return ReadScope
+}
+
+// AccountingInterceptor records the calls, with their latency and whether
+// they failed, by the API key AuthInterceptor authenticated them with, in
+// the Usage set with WithUsage.
 func (a *Auth) AccountingInterceptor() grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
 
 		c, span := a.observer.Tracer(info.FullMethod).Start(ctx, "aaa.AccountingInterceptor")
+		defer span.End()
 		startTime := time.Now()
-		time.Sleep(30 * time.Millisecond)
-		logger.InfoContext(c, "Accounting successful", "Method", info.FullMethod, "timetook", time.Since(startTime))
-		span.End()
-
 		// Continue the handler chain.
-		return handler(ctx, req)
+		resp, err := handler(ctx, req)
+		latency := time.Since(startTime)
+		if a.usage != nil {
+			key, _ := KeyFromContext(ctx)
+			a.usage.Record(key.ID, info.FullMethod, err, latency)
+		}
+		logger.InfoContext(c, "Accounting successful", "Method", info.FullMethod, "timetook", latency)
+		return resp, err
 	}
 }
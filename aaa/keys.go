@@ -0,0 +1,382 @@
+package aaa
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Scope is a permission granted to an API key.
+type Scope string
+
+const (
+	// ReadScope allows calling the read-only RPCs.
+	ReadScope Scope = "read"
+	// WriteScope allows calling the RPCs changing the data of a key, such
+	// as creating webhook subscriptions.
+	WriteScope Scope = "write"
+	// AdminScope allows every RPC.
+	AdminScope Scope = "admin"
+)
+
+// ParseScope returns the scope named s.
+func ParseScope(s string) (Scope, error) {
+	switch scope := Scope(s); scope {
+	case ReadScope, WriteScope, AdminScope:
+		return scope, nil
+	default:
+		return "", fmt.Errorf("unknown scope %q: expected %s, %s or %s", s, ReadScope, WriteScope, AdminScope)
+	}
+}
+
+var (
+	// ErrInvalidKey is returned for API keys that are unknown or revoked.
+	ErrInvalidKey = errors.New("invalid API key")
+	// ErrKeyNotFound is returned when revoking an unknown key ID.
+	ErrKeyNotFound = errors.New("API key not found")
+)
+
+// Key is an issued API key. Only a hash of the secret is stored.
+type Key struct {
+	// ID identifies the key in listings and logs. It is not secret.
+	ID      string    `json:"id"`
+	Name    string    `json:"name"`
+	Hash    string    `json:"hash"`
+	Scopes  []Scope   `json:"scopes"`
+	Created time.Time `json:"created"`
+	// Revoked is the time the key was revoked, or nil while it is valid.
+	Revoked *time.Time `json:"revoked,omitempty"`
+}
+
+// Allows reports whether the key grants scope. The admin scope grants
+// every scope.
+func (k *Key) Allows(scope Scope) bool {
+	for _, s := range k.Scopes {
+		if s == scope || s == AdminScope {
+			return true
+		}
+	}
+	return false
+}
+
+// keyReloadInterval bounds how often a FileKeyStore checks its file for
+// changes made by other processes, such as keys revoked from the CLI.
+const keyReloadInterval = time.Second
+
+// KeyStore keeps the API keys of a server.
+type KeyStore interface {
+	// Create issues a key with the given name and scopes and returns its
+	// secret, which is not stored and cannot be recovered.
+	Create(name string, scopes ...Scope) (string, Key, error)
+	// List returns every key of the store, including revoked ones.
+	List() ([]Key, error)
+	// Revoke revokes the key with the given ID, or returns ErrKeyNotFound.
+	Revoke(id string) error
+	// Validate returns the valid key with the given secret, or
+	// ErrInvalidKey.
+	Validate(secret string) (Key, error)
+}
+
+// FileKeyStore is a KeyStore keeping the keys in a JSON file, which the
+// CLI edits while the server runs.
+type FileKeyStore struct {
+	path string
+
+	mu         sync.Mutex
+	keys       []Key
+	modTime    time.Time
+	size       int64
+	lastReload time.Time
+}
+
+// OpenKeyStore returns the store kept in the file at path. The file is
+// created when the first key is issued.
+func OpenKeyStore(path string) (*FileKeyStore, error) {
+	s := &FileKeyStore{path: path}
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// reload reads the file when its modification time or size changed since
+// it was last read.
+func (s *FileKeyStore) reload() error {
+	info, err := os.Stat(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		s.keys, s.modTime, s.size = nil, time.Time{}, 0
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if info.ModTime().Equal(s.modTime) && info.Size() == s.size {
+		return nil
+	}
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return err
+	}
+	var keys []Key
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return fmt.Errorf("%s: %w", s.path, err)
+	}
+	s.keys, s.modTime, s.size = keys, info.ModTime(), info.Size()
+	return nil
+}
+
+// save writes the keys to a temporary file and renames it over the store,
+// so that readers never see a partial file.
+func (s *FileKeyStore) save() error {
+	data, err := json.MarshalIndent(s.keys, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".keys-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), s.path); err != nil {
+		return err
+	}
+	if info, err := os.Stat(s.path); err == nil {
+		s.modTime, s.size = info.ModTime(), info.Size()
+	}
+	return nil
+}
+
+// newKey returns a new key with the given name and scopes, and its secret.
+func newKey(name string, scopes []Scope) (string, Key, error) {
+	if len(scopes) == 0 {
+		return "", Key{}, errors.New("API key without scopes")
+	}
+	id, err := randomHex(8)
+	if err != nil {
+		return "", Key{}, err
+	}
+	random, err := randomHex(24)
+	if err != nil {
+		return "", Key{}, err
+	}
+	secret := "pk_" + random
+	return secret, Key{
+		ID:      id,
+		Name:    name,
+		Hash:    hashSecret(secret),
+		Scopes:  scopes,
+		Created: time.Now().UTC().Truncate(time.Second),
+	}, nil
+}
+
+// Create implements KeyStore.
+func (s *FileKeyStore) Create(name string, scopes ...Scope) (string, Key, error) {
+	secret, key, err := newKey(name, scopes)
+	if err != nil {
+		return "", Key{}, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reload(); err != nil {
+		return "", Key{}, err
+	}
+	s.keys = append(s.keys, key)
+	if err := s.save(); err != nil {
+		s.keys = s.keys[:len(s.keys)-1]
+		return "", Key{}, err
+	}
+	return secret, key, nil
+}
+
+// List implements KeyStore.
+func (s *FileKeyStore) List() ([]Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reload(); err != nil {
+		return nil, err
+	}
+	return append([]Key(nil), s.keys...), nil
+}
+
+// Revoke implements KeyStore.
+func (s *FileKeyStore) Revoke(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := s.reload(); err != nil {
+		return err
+	}
+	for i := range s.keys {
+		if s.keys[i].ID == id {
+			if s.keys[i].Revoked == nil {
+				now := time.Now().UTC().Truncate(time.Second)
+				s.keys[i].Revoked = &now
+			}
+			return s.save()
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrKeyNotFound, id)
+}
+
+// Validate implements KeyStore. Changes to the file are picked up within
+// a second.
+func (s *FileKeyStore) Validate(secret string) (Key, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if now := time.Now(); now.Sub(s.lastReload) >= keyReloadInterval {
+		// Keep serving the keys already loaded if the file is unreadable.
+		if err := s.reload(); err != nil {
+			logger.Error("Failed to reload API keys", "path", s.path, "error", err)
+		}
+		s.lastReload = now
+	}
+	hash := hashSecret(secret)
+	for _, k := range s.keys {
+		if k.Hash == hash && k.Revoked == nil {
+			return k, nil
+		}
+	}
+	return Key{}, ErrInvalidKey
+}
+
+// SQLKeyStore is a KeyStore keeping the keys in the api_keys table of a
+// SQL database, so that several servers can share them. Its statements
+// work with both SQLite and PostgreSQL; the driver must be registered with
+// database/sql by the binary.
+type SQLKeyStore struct {
+	db *sql.DB
+}
+
+// NewSQLKeyStore returns a store in db, creating its table if needed.
+func NewSQLKeyStore(ctx context.Context, db *sql.DB) (*SQLKeyStore, error) {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS api_keys (
+	id TEXT PRIMARY KEY,
+	name TEXT NOT NULL,
+	hash TEXT NOT NULL UNIQUE,
+	scopes TEXT NOT NULL,
+	created TEXT NOT NULL,
+	revoked TEXT
+)`)
+	if err != nil {
+		return nil, fmt.Errorf("creating API key table: %w", err)
+	}
+	return &SQLKeyStore{db: db}, nil
+}
+
+// Create implements KeyStore.
+func (s *SQLKeyStore) Create(name string, scopes ...Scope) (string, Key, error) {
+	secret, key, err := newKey(name, scopes)
+	if err != nil {
+		return "", Key{}, err
+	}
+	names := make([]string, len(scopes))
+	for i, scope := range scopes {
+		names[i] = string(scope)
+	}
+	_, err = s.db.Exec(`INSERT INTO api_keys (id, name, hash, scopes, created) VALUES ($1, $2, $3, $4, $5)`,
+		key.ID, key.Name, key.Hash, strings.Join(names, ","), key.Created.Format(time.RFC3339))
+	if err != nil {
+		return "", Key{}, fmt.Errorf("creating API key: %w", err)
+	}
+	return secret, key, nil
+}
+
+// List implements KeyStore.
+func (s *SQLKeyStore) List() ([]Key, error) {
+	rows, err := s.db.Query(`SELECT id, name, hash, scopes, created, revoked FROM api_keys ORDER BY created, id`)
+	if err != nil {
+		return nil, fmt.Errorf("listing API keys: %w", err)
+	}
+	defer rows.Close()
+	var keys []Key
+	for rows.Next() {
+		k, err := scanKey(rows)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, k)
+	}
+	return keys, rows.Err()
+}
+
+// Revoke implements KeyStore.
+func (s *SQLKeyStore) Revoke(id string) error {
+	now := time.Now().UTC().Truncate(time.Second).Format(time.RFC3339)
+	res, err := s.db.Exec(`UPDATE api_keys SET revoked = COALESCE(revoked, $1) WHERE id = $2`, now, id)
+	if err != nil {
+		return fmt.Errorf("revoking API key: %w", err)
+	}
+	if n, err := res.RowsAffected(); err != nil {
+		return err
+	} else if n == 0 {
+		return fmt.Errorf("%w: %s", ErrKeyNotFound, id)
+	}
+	return nil
+}
+
+// Validate implements KeyStore. Revoked keys are refused on the next
+// request.
+func (s *SQLKeyStore) Validate(secret string) (Key, error) {
+	row := s.db.QueryRow(`SELECT id, name, hash, scopes, created, revoked FROM api_keys
+WHERE hash = $1 AND revoked IS NULL`, hashSecret(secret))
+	k, err := scanKey(row)
+	if errors.Is(err, sql.ErrNoRows) {
+		return Key{}, ErrInvalidKey
+	}
+	return k, err
+}
+
+// scanKey reads a key from a row of the api_keys table.
+func scanKey(row interface{ Scan(...any) error }) (Key, error) {
+	var k Key
+	var scopes, created string
+	var revoked sql.NullString
+	if err := row.Scan(&k.ID, &k.Name, &k.Hash, &scopes, &created, &revoked); err != nil {
+		return Key{}, err
+	}
+	for _, scope := range strings.Split(scopes, ",") {
+		k.Scopes = append(k.Scopes, Scope(scope))
+	}
+	var err error
+	if k.Created, err = time.Parse(time.RFC3339, created); err != nil {
+		return Key{}, fmt.Errorf("API key %s: %w", k.ID, err)
+	}
+	if revoked.Valid {
+		t, err := time.Parse(time.RFC3339, revoked.String)
+		if err != nil {
+			return Key{}, fmt.Errorf("API key %s: %w", k.ID, err)
+		}
+		k.Revoked = &t
+	}
+	return k, nil
+}
+
+func hashSecret(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,76 @@
+//go:build sqlite
+
+package aaa
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"path/filepath"
+	"testing"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLKeyStore(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "keys.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	store, err := NewSQLKeyStore(ctx, db)
+	if err != nil {
+		t.Fatalf("NewSQLKeyStore() error = %v", err)
+	}
+	// Creating the table again is harmless.
+	if _, err := NewSQLKeyStore(ctx, db); err != nil {
+		t.Fatalf("NewSQLKeyStore() of an existing table error = %v", err)
+	}
+
+	secret, key, err := store.Create("partner", ReadScope, AdminScope)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if _, _, err := store.Create("nobody"); err == nil {
+		t.Error("Create() without scopes error = nil, want an error")
+	}
+
+	got, err := store.Validate(secret)
+	if err != nil || got.ID != key.ID || got.Name != "partner" || !got.Created.Equal(key.Created) || got.Revoked != nil {
+		t.Errorf("Validate() = %+v, %v, want %+v", got, err, key)
+	}
+	if len(got.Scopes) != 2 || got.Scopes[0] != ReadScope || got.Scopes[1] != AdminScope {
+		t.Errorf("Validate() scopes = %v, want [read admin]", got.Scopes)
+	}
+	if _, err := store.Validate("pk_unknown"); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Validate(unknown) error = %v, want %v", err, ErrInvalidKey)
+	}
+
+	// Another server sharing the database sees the revocation at once.
+	other, err := NewSQLKeyStore(ctx, db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Revoke(key.ID); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if err := other.Revoke("missing"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Revoke(missing) error = %v, want %v", err, ErrKeyNotFound)
+	}
+	if _, err := store.Validate(secret); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Validate(revoked) error = %v, want %v", err, ErrInvalidKey)
+	}
+	keys, err := store.List()
+	if err != nil || len(keys) != 1 || keys[0].ID != key.ID || keys[0].Revoked == nil {
+		t.Fatalf("List() = %+v, %v, want the revoked key", keys, err)
+	}
+	// Revoking again keeps the time of the first revocation.
+	revoked := *keys[0].Revoked
+	if err := store.Revoke(key.ID); err != nil {
+		t.Fatalf("Revoke() of a revoked key error = %v", err)
+	}
+	if keys, err := store.List(); err != nil || !keys[0].Revoked.Equal(revoked) {
+		t.Errorf("List() after revoking again = %+v, %v, want revoked at %s", keys, err, revoked)
+	}
+}
@@ -0,0 +1,65 @@
+package aaa
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+)
+
+func TestKeyStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "keys.json")
+	store, err := OpenKeyStore(path)
+	if err != nil {
+		t.Fatalf("OpenKeyStore() error = %v", err)
+	}
+	secret, key, err := store.Create("partner", ReadScope)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if key.Hash == secret || key.Hash == "" {
+		t.Errorf("Create() stored hash %q, want a hash of the secret", key.Hash)
+	}
+
+	got, err := store.Validate(secret)
+	if err != nil || got.ID != key.ID {
+		t.Errorf("Validate() = %+v, %v, want key %s", got, err, key.ID)
+	}
+	if !got.Allows(ReadScope) || got.Allows(WriteScope) || got.Allows(AdminScope) {
+		t.Errorf("read key scopes = %v", got.Scopes)
+	}
+	if _, err := store.Validate("pk_unknown"); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Validate(unknown) error = %v, want %v", err, ErrInvalidKey)
+	}
+
+	// Another process, such as the CLI, sees the key and can revoke it.
+	other, err := OpenKeyStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := other.Revoke(key.ID); err != nil {
+		t.Fatalf("Revoke() error = %v", err)
+	}
+	if err := other.Revoke("missing"); !errors.Is(err, ErrKeyNotFound) {
+		t.Errorf("Revoke(missing) error = %v, want %v", err, ErrKeyNotFound)
+	}
+	keys, err := store.List()
+	if err != nil || len(keys) != 1 || keys[0].Revoked == nil {
+		t.Errorf("List() = %+v, %v, want the revoked key", keys, err)
+	}
+	store.lastReload = store.lastReload.Add(-keyReloadInterval)
+	if _, err := store.Validate(secret); !errors.Is(err, ErrInvalidKey) {
+		t.Errorf("Validate(revoked) error = %v, want %v", err, ErrInvalidKey)
+	}
+}
+
+func TestParseScope(t *testing.T) {
+	if s, err := ParseScope("admin"); err != nil || s != AdminScope {
+		t.Errorf("ParseScope(admin) = %v, %v", s, err)
+	}
+	if s, err := ParseScope("write"); err != nil || s != WriteScope {
+		t.Errorf("ParseScope(write) = %v, %v", s, err)
+	}
+	if _, err := ParseScope("owner"); err == nil {
+		t.Error("ParseScope(owner) error = nil, want an error")
+	}
+}
@@ -0,0 +1,96 @@
+package aaa
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Limits are the ceilings on the size of a single request. A zero ceiling
+// is not enforced.
+type Limits struct {
+	// MaxDays bounds the date range of requests with a days field.
+	MaxDays int
+	// MaxBatch bounds the number of items in each repeated field of a
+	// request, other than its locations.
+	MaxBatch int
+	// MaxLocations bounds the number of items in the locations field of a
+	// request.
+	MaxLocations int
+}
+
+// DefaultLimits allow a year of days, the longest range any RPC accepts.
+var DefaultLimits = Limits{MaxDays: 366, MaxBatch: 100, MaxLocations: 10}
+
+// LimitError reports a request field over its ceiling. It converts to a
+// ResourceExhausted status.
+type LimitError struct {
+	// Field is the name of the request field, e.g. "days".
+	Field string
+	Value int
+	Limit int
+}
+
+func (e *LimitError) Error() string {
+	return fmt.Sprintf("%s is %d, above the limit of %d", e.Field, e.Value, e.Limit)
+}
+
+// GRPCStatus returns the status sent to the client for the error.
+func (e *LimitError) GRPCStatus() *status.Status {
+	return status.New(codes.ResourceExhausted, e.Error())
+}
+
+// RequestLimiter rejects requests larger than its limits before they are
+// handled, so that an accidental query over years or thousands of items
+// cannot tie up the server.
+type RequestLimiter struct {
+	limits Limits
+}
+
+// NewRequestLimiter returns a RequestLimiter enforcing limits.
+func NewRequestLimiter(limits Limits) *RequestLimiter {
+	return &RequestLimiter{limits: limits}
+}
+
+// UnaryInterceptor rejects requests over the limits with a LimitError.
+func (l *RequestLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if m, ok := req.(proto.Message); ok {
+			if err := l.check(m); err != nil {
+				logger.InfoContext(ctx, "Rejected request over limits", "rpc", info.FullMethod, "field", err.Field, "value", err.Value, "limit", err.Limit)
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// check returns the first field of m over its ceiling, or nil. Fields are
+// found by name, so new RPCs are covered as soon as they use them.
+func (l *RequestLimiter) check(m proto.Message) *LimitError {
+	msg := m.ProtoReflect()
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		var value, limit int
+		switch {
+		case fd.IsList() && fd.Name() == "locations":
+			value, limit = msg.Get(fd).List().Len(), l.limits.MaxLocations
+		case fd.IsList():
+			value, limit = msg.Get(fd).List().Len(), l.limits.MaxBatch
+		case fd.Name() == "days" && fd.Kind() == protoreflect.Int32Kind:
+			value, limit = int(msg.Get(fd).Int()), l.limits.MaxDays
+		default:
+			continue
+		}
+		if limit > 0 && value > limit {
+			return &LimitError{Field: string(fd.Name()), Value: value, Limit: limit}
+		}
+	}
+	return nil
+}
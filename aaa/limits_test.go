@@ -0,0 +1,35 @@
+package aaa
+
+import (
+	"testing"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRequestLimiter(t *testing.T) {
+	l := NewRequestLimiter(Limits{MaxDays: 31, MaxBatch: 2})
+
+	if err := l.check(&ppb.GetEventsRequest{Days: 31}); err != nil {
+		t.Errorf("check() of a request at the limit = %v, want nil", err)
+	}
+	err := l.check(&ppb.GetMuhurtaRequest{Days: 32})
+	if err == nil || *err != (LimitError{Field: "days", Value: 32, Limit: 31}) {
+		t.Errorf("check() of a request over the limit = %v", err)
+	}
+	if st := status.Convert(err); st.Code() != codes.ResourceExhausted {
+		t.Errorf("status code = %v, want ResourceExhausted", st.Code())
+	}
+
+	// Repeated fields are bounded by the batch size.
+	err = l.check(&ppb.MuhurtaWindow{Reasons: []string{"a", "b", "c"}})
+	if err == nil || err.Field != "reasons" {
+		t.Errorf("check() of a batch over the limit = %v, want a reasons error", err)
+	}
+
+	// Zero ceilings are not enforced.
+	if err := NewRequestLimiter(Limits{}).check(&ppb.GetEventsRequest{Days: 10000}); err != nil {
+		t.Errorf("check() without limits = %v, want nil", err)
+	}
+}
@@ -0,0 +1,229 @@
+package aaa
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// APIKeyHeader is the metadata key carrying the API key of a client.
+const APIKeyHeader = "x-api-key"
+
+// ForwardedForHeader is the metadata key with which a proxy on the same
+// host, such as the gateway, passes on the address of its caller.
+const ForwardedForHeader = "x-forwarded-for"
+
+// RetryAfterHeader is the metadata key telling a rate limited client how
+// many seconds to wait before retrying.
+const RetryAfterHeader = "retry-after"
+
+// maxIdleBuckets bounds the number of buckets kept before those of idle
+// clients are dropped.
+const maxIdleBuckets = 10000
+
+// RateLimit is the token bucket of a client: it may make Burst requests at
+// once and Rate requests per second after that.
+type RateLimit struct {
+	Rate  float64
+	Burst int
+}
+
+// RateLimiter limits the request rate of each client, identified by the
+// API key AuthInterceptor authenticated it with or, without one, by its
+// address. It runs after the auth interceptors, so that made-up keys are
+// not clients of their own.
+type RateLimiter struct {
+	limit     RateLimit
+	overrides map[string]RateLimit
+	now       func() time.Time
+
+	mu      sync.Mutex
+	buckets map[string]*bucket
+}
+
+// RateLimiterOption configures a RateLimiter.
+type RateLimiterOption func(*RateLimiter)
+
+// WithClientLimits sets the limits of specific clients, keyed by API key
+// ID, overriding the default limit.
+func WithClientLimits(limits map[string]RateLimit) RateLimiterOption {
+	return func(l *RateLimiter) {
+		for id, limit := range limits {
+			l.overrides[apiKeyClient(id)] = limit
+		}
+	}
+}
+
+// NewRateLimiter returns a RateLimiter applying limit to every client
+// without a limit of its own.
+func NewRateLimiter(limit RateLimit, opts ...RateLimiterOption) *RateLimiter {
+	l := &RateLimiter{
+		limit:     limit,
+		overrides: map[string]RateLimit{},
+		now:       time.Now,
+		buckets:   map[string]*bucket{},
+	}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+type bucket struct {
+	limit  RateLimit
+	tokens float64
+	last   time.Time
+}
+
+// refill adds the tokens accrued since the last request.
+func (b *bucket) refill(now time.Time) {
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = math.Min(float64(b.limit.Burst), b.tokens+elapsed*b.limit.Rate)
+	b.last = now
+}
+
+// allow takes a token from the bucket of the client identified by key, as
+// returned by clientKey. When the bucket is empty it returns false and how
+// long until a token is available.
+func (l *RateLimiter) allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		if len(l.buckets) >= maxIdleBuckets {
+			l.dropIdle(now)
+		}
+		limit, ok := l.overrides[key]
+		if !ok {
+			limit = l.limit
+		}
+		b = &bucket{limit: limit, tokens: float64(limit.Burst), last: now}
+		l.buckets[key] = b
+	}
+	b.refill(now)
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+	if b.limit.Rate <= 0 {
+		return false, time.Duration(math.MaxInt64)
+	}
+	wait := (1 - b.tokens) / b.limit.Rate
+	return false, time.Duration(wait * float64(time.Second))
+}
+
+// dropIdle forgets the buckets that have refilled completely, which behave
+// exactly like new ones.
+func (l *RateLimiter) dropIdle(now time.Time) {
+	for key, b := range l.buckets {
+		b.refill(now)
+		if b.tokens >= float64(b.limit.Burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// UnaryInterceptor rejects requests of clients over their limit with
+// ResourceExhausted, setting the retry-after header to the number of
+// seconds until their next request is allowed.
+func (l *RateLimiter) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := clientKey(ctx)
+		if ok, wait := l.allow(key); !ok {
+			retryAfter := strconv.FormatInt(int64(math.Ceil(wait.Seconds())), 10)
+			if err := grpc.SetHeader(ctx, metadata.Pairs(RetryAfterHeader, retryAfter)); err != nil {
+				logger.WarnContext(ctx, "Failed to set retry-after header", "error", err)
+			}
+			logger.InfoContext(ctx, "Rate limited request", "rpc", info.FullMethod, "retry_after", retryAfter)
+			return nil, status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s seconds", retryAfter)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamInterceptor rejects streaming RPCs of clients over their limit as
+// UnaryInterceptor does unary ones. A stream counts as one request however
+// long it lasts.
+func (l *RateLimiter) StreamInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if ok, wait := l.allow(clientKey(ss.Context())); !ok {
+			retryAfter := strconv.FormatInt(int64(math.Ceil(wait.Seconds())), 10)
+			if err := ss.SetHeader(metadata.Pairs(RetryAfterHeader, retryAfter)); err != nil {
+				logger.WarnContext(ss.Context(), "Failed to set retry-after header", "error", err)
+			}
+			logger.InfoContext(ss.Context(), "Rate limited request", "rpc", info.FullMethod, "retry_after", retryAfter)
+			return status.Errorf(codes.ResourceExhausted, "rate limit exceeded, retry after %s seconds", retryAfter)
+		}
+		return handler(srv, ss)
+	}
+}
+
+// clientKey identifies the client of a request by the ID of the API key it
+// was authenticated with, or by the host of its address when it was not:
+// the x-api-key header alone proves nothing. The address forwarded by a
+// proxy is only trusted from the loopback interface.
+func clientKey(ctx context.Context) string {
+	if key, ok := KeyFromContext(ctx); ok {
+		return apiKeyClient(key.ID)
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return "peer:unknown"
+	}
+	addr := p.Addr.String()
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	if ip := net.ParseIP(addr); ip != nil && ip.IsLoopback() {
+		if forwarded := md.Get(ForwardedForHeader); len(forwarded) > 0 && forwarded[0] != "" {
+			addr = forwarded[0]
+		}
+	}
+	return "peer:" + addr
+}
+
+// apiKeyClient returns the client key of the API key with the given ID.
+// Prefixes keep API keys and addresses apart.
+func apiKeyClient(id string) string {
+	return "key:" + id
+}
+
+// ParseClientLimits parses per-client limits written as a comma separated
+// list of key=rate/burst, e.g. "partner=50/100,batch=5/5".
+func ParseClientLimits(s string) (map[string]RateLimit, error) {
+	limits := map[string]RateLimit{}
+	if s == "" {
+		return limits, nil
+	}
+	for _, entry := range strings.Split(s, ",") {
+		key, value, ok := strings.Cut(entry, "=")
+		rate, burst, ok2 := strings.Cut(value, "/")
+		if !ok || !ok2 || key == "" {
+			return nil, fmt.Errorf("invalid client limit %q: expected key=rate/burst", entry)
+		}
+		r, err := strconv.ParseFloat(rate, 64)
+		if err != nil || r < 0 {
+			return nil, fmt.Errorf("invalid rate in client limit %q", entry)
+		}
+		b, err := strconv.Atoi(burst)
+		if err != nil || b < 1 {
+			return nil, fmt.Errorf("invalid burst in client limit %q", entry)
+		}
+		limits[key] = RateLimit{Rate: r, Burst: b}
+	}
+	return limits, nil
+}
@@ -0,0 +1,147 @@
+package aaa
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/observability"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimiter(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	l := NewRateLimiter(RateLimit{Rate: 2, Burst: 3},
+		WithClientLimits(map[string]RateLimit{"partner": {Rate: 10, Burst: 10}}))
+	l.now = func() time.Time { return now }
+
+	client := "peer:192.0.2.1"
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.allow(client); !ok {
+			t.Fatalf("request %d within the burst was limited", i+1)
+		}
+	}
+	ok, wait := l.allow(client)
+	if ok || wait != 500*time.Millisecond {
+		t.Errorf("allow() after the burst = %v, %v, want false, 500ms", ok, wait)
+	}
+
+	// Tokens refill at the rate.
+	now = now.Add(500 * time.Millisecond)
+	if ok, _ := l.allow(client); !ok {
+		t.Error("allow() after refilling a token was limited")
+	}
+
+	// Clients are limited independently and may have their own limits.
+	for i := 0; i < 10; i++ {
+		if ok, _ := l.allow(apiKeyClient("partner")); !ok {
+			t.Fatalf("partner request %d within its burst was limited", i+1)
+		}
+	}
+	if ok, _ := l.allow("peer:192.0.2.2"); !ok {
+		t.Error("another client was limited")
+	}
+}
+
+func TestParseClientLimits(t *testing.T) {
+	limits, err := ParseClientLimits("partner=50/100,batch=0.5/1")
+	if err != nil {
+		t.Fatalf("ParseClientLimits() error = %v", err)
+	}
+	if limits["partner"] != (RateLimit{Rate: 50, Burst: 100}) || limits["batch"] != (RateLimit{Rate: 0.5, Burst: 1}) {
+		t.Errorf("ParseClientLimits() = %v", limits)
+	}
+	for _, s := range []string{"partner", "partner=50", "=1/1", "partner=x/1", "partner=1/0"} {
+		if _, err := ParseClientLimits(s); err == nil {
+			t.Errorf("ParseClientLimits(%q) error = nil, want an error", s)
+		}
+	}
+}
+
+func TestClientKey(t *testing.T) {
+	from := func(ip string, md metadata.MD) context.Context {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+		return metadata.NewIncomingContext(ctx, md)
+	}
+	tests := []struct {
+		name string
+		ctx  context.Context
+		want string
+	}{
+		{"address", from("192.0.2.1", nil), "peer:192.0.2.1"},
+		{"authenticated key", context.WithValue(from("192.0.2.1", metadata.Pairs(APIKeyHeader, "pk_secret")), keyContextKey{}, Key{ID: "partner"}), "key:partner"},
+		{"unauthenticated key", from("192.0.2.1", metadata.Pairs(APIKeyHeader, "pk_made_up")), "peer:192.0.2.1"},
+		{"forwarded by gateway", from("127.0.0.1", metadata.Pairs(ForwardedForHeader, "198.51.100.7")), "peer:198.51.100.7"},
+		{"forwarded by remote client", from("192.0.2.1", metadata.Pairs(ForwardedForHeader, "198.51.100.7")), "peer:192.0.2.1"},
+		{"no peer", context.Background(), "peer:unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := clientKey(tt.ctx); got != tt.want {
+				t.Errorf("clientKey() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRateLimiterKeys(t *testing.T) {
+	if _, err := observability.NewObserver(""); err != nil {
+		t.Fatalf("NewObserver() error = %v", err)
+	}
+	store, err := OpenKeyStore(filepath.Join(t.TempDir(), "keys.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secret, key, err := store.Create("partner", ReadScope)
+	if err != nil {
+		t.Fatal(err)
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/panchangam.Panchangam/Get"}
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+	// call runs a request from ip with the API key secret through the auth
+	// interceptor of a, then the limiter, as the server chains them.
+	call := func(a *Auth, l *RateLimiter, ip, secret string) error {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(ip), Port: 50000}})
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(APIKeyHeader, secret))
+		_, err := a.AuthInterceptor()(ctx, nil, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return l.UnaryInterceptor()(ctx, req, info, ok)
+		})
+		return err
+	}
+
+	// Made-up keys are not clients of their own: a client rotating them is
+	// limited by its address.
+	l := NewRateLimiter(RateLimit{Rate: 0, Burst: 3})
+	open := NewAuth()
+	for i := 0; i < 3; i++ {
+		if err := call(open, l, "192.0.2.1", fmt.Sprintf("pk_made_up_%d", i)); err != nil {
+			t.Fatalf("request %d within the burst: %v", i+1, err)
+		}
+	}
+	if err := call(open, l, "192.0.2.1", "pk_made_up_3"); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("request with a fourth made-up key = %v, want ResourceExhausted", err)
+	}
+
+	// With keys, made-up ones are refused before the limiter, and a valid
+	// key is one client from every address.
+	l = NewRateLimiter(RateLimit{Rate: 0, Burst: 3}, WithClientLimits(map[string]RateLimit{key.ID: {Rate: 0, Burst: 2}}))
+	keyed := NewAuth(WithKeyStore(store))
+	if err := call(keyed, l, "192.0.2.1", "pk_made_up"); status.Code(err) != codes.Unauthenticated {
+		t.Errorf("request with a made-up key = %v, want Unauthenticated", err)
+	}
+	for _, ip := range []string{"192.0.2.1", "198.51.100.7"} {
+		if err := call(keyed, l, ip, secret); err != nil {
+			t.Fatalf("request of %s from %s within its burst: %v", key.ID, ip, err)
+		}
+	}
+	if err := call(keyed, l, "203.0.113.9", secret); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("request of %s past its burst from a third address = %v, want ResourceExhausted", key.ID, err)
+	}
+}
@@ -0,0 +1,129 @@
+package aaa
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// writeCert writes a certificate for name, signed by parent or else
+// self-signed, and its key to dir. It returns the paths of the files.
+func writeCert(t *testing.T, dir, name string, ca bool, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (certFile, keyFile string, cert *x509.Certificate, key *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+
+		IsCA:                  ca,
+		BasicConstraintsValid: true,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err = x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certFile, keyFile = filepath.Join(dir, name+".crt"), filepath.Join(dir, name+".key")
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return certFile, keyFile, cert, key
+}
+
+// handshake connects a client and a server with the configurations and
+// returns the error of the server's handshake.
+func handshake(t *testing.T, server, client *tls.Config) error {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	go func() {
+		if c, err := tls.Dial("tcp", l.Addr().String(), client); err == nil {
+			io.Copy(io.Discard, c)
+			c.Close()
+		}
+	}()
+	c, err := l.Accept()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	return tls.Server(c, server).Handshake()
+}
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	caFile, _, ca, caKey := writeCert(t, dir, "ca", true, nil, nil)
+	serverCert, serverKey, _, _ := writeCert(t, dir, "localhost", false, ca, caKey)
+	clientCert, clientKey, _, _ := writeCert(t, dir, "client", false, ca, caKey)
+
+	client, err := ClientTLS(caFile, "", "")
+	if err != nil {
+		t.Fatalf("ClientTLS() error = %v", err)
+	}
+	client.ServerName = "localhost"
+	server, err := ServerTLS(serverCert, serverKey, "")
+	if err != nil {
+		t.Fatalf("ServerTLS() error = %v", err)
+	}
+	if err := handshake(t, server, client); err != nil {
+		t.Errorf("TLS handshake error = %v", err)
+	}
+
+	mutual, err := ServerTLS(serverCert, serverKey, caFile)
+	if err != nil {
+		t.Fatalf("ServerTLS() with a client CA error = %v", err)
+	}
+	if err := handshake(t, mutual, client); err == nil {
+		t.Error("mutual TLS handshake without a client certificate succeeded")
+	}
+	withCert, err := ClientTLS(caFile, clientCert, clientKey)
+	if err != nil {
+		t.Fatalf("ClientTLS() with a certificate error = %v", err)
+	}
+	withCert.ServerName = "localhost"
+	if err := handshake(t, mutual, withCert); err != nil {
+		t.Errorf("mutual TLS handshake error = %v", err)
+	}
+
+	if _, err := ServerTLS(serverCert, "", ""); err == nil {
+		t.Error("ServerTLS() without a key succeeded")
+	}
+	if _, err := ClientTLS(serverKey, "", ""); err == nil {
+		t.Error("ClientTLS() with a CA file of no certificates succeeded")
+	}
+}
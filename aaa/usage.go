@@ -0,0 +1,375 @@
+package aaa
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/observability"
+)
+
+// usageDayLayout is the layout of the days of usage records, which are
+// reckoned in UTC.
+const usageDayLayout = "2006-01-02"
+
+// UsageRecord is the daily rollup of the calls an API key made to an RPC.
+type UsageRecord struct {
+	// Day is the UTC date of the calls, e.g. 2024-04-09.
+	Day string `json:"day"`
+	// KeyID is the ID of the API key, or empty for calls without one.
+	KeyID  string `json:"key_id"`
+	Method string `json:"method"`
+	Calls  int64  `json:"calls"`
+	// Errors counts the calls that failed.
+	Errors       int64         `json:"errors"`
+	TotalLatency time.Duration `json:"total_latency_ns"`
+	MaxLatency   time.Duration `json:"max_latency_ns"`
+}
+
+// MeanLatency returns the mean latency of the calls.
+func (r UsageRecord) MeanLatency() time.Duration {
+	if r.Calls == 0 {
+		return 0
+	}
+	return r.TotalLatency / time.Duration(r.Calls)
+}
+
+// merge adds the calls of o, of the same day, key and method, to r.
+func (r *UsageRecord) merge(o UsageRecord) {
+	r.Calls += o.Calls
+	r.Errors += o.Errors
+	r.TotalLatency += o.TotalLatency
+	if o.MaxLatency > r.MaxLatency {
+		r.MaxLatency = o.MaxLatency
+	}
+}
+
+type usageKey struct {
+	day, keyID, method string
+}
+
+func (r UsageRecord) key() usageKey {
+	return usageKey{r.Day, r.KeyID, r.Method}
+}
+
+// UsageQuery selects usage records. Empty fields match every record.
+type UsageQuery struct {
+	// From and To are the first and last days of the records, inclusive.
+	From, To string
+	KeyID    string
+	Method   string
+}
+
+func (q UsageQuery) matches(r UsageRecord) bool {
+	return (q.From == "" || r.Day >= q.From) && (q.To == "" || r.Day <= q.To) &&
+		(q.KeyID == "" || r.KeyID == q.KeyID) && (q.Method == "" || r.Method == q.Method)
+}
+
+// UsageStore keeps the daily rollups of usage durably.
+type UsageStore interface {
+	// Add adds the records to the rollups of their day, key and method.
+	Add(ctx context.Context, records []UsageRecord) error
+	// Query returns the rollups matching q, ordered by day, key and method.
+	Query(ctx context.Context, q UsageQuery) ([]UsageRecord, error)
+}
+
+// Usage accumulates the calls recorded by the AccountingInterceptor in
+// memory, and flushes them to a UsageStore from Run, so that the store is
+// written to once per interval rather than once per call.
+type Usage struct {
+	store UsageStore
+	now   func() time.Time
+
+	mu      sync.Mutex
+	pending map[usageKey]*UsageRecord
+}
+
+// NewUsage returns a Usage flushing to store.
+func NewUsage(store UsageStore) *Usage {
+	return &Usage{store: store, now: time.Now, pending: map[usageKey]*UsageRecord{}}
+}
+
+// Record records a call to method with the API key keyID, or "" for
+// calls without a key, that took latency and failed if err is not nil.
+func (u *Usage) Record(keyID, method string, err error, latency time.Duration) {
+	call := UsageRecord{
+		Day:          u.now().UTC().Format(usageDayLayout),
+		KeyID:        keyID,
+		Method:       method,
+		Calls:        1,
+		TotalLatency: latency,
+		MaxLatency:   latency,
+	}
+	if err != nil {
+		call.Errors = 1
+	}
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if r, ok := u.pending[call.key()]; ok {
+		r.merge(call)
+	} else {
+		u.pending[call.key()] = &call
+	}
+}
+
+// Flush adds the calls recorded since the last flush to the store. Calls
+// that cannot be added are kept for the next flush.
+func (u *Usage) Flush(ctx context.Context) error {
+	u.mu.Lock()
+	pending := u.pending
+	u.pending = map[usageKey]*UsageRecord{}
+	u.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+	records := make([]UsageRecord, 0, len(pending))
+	for _, r := range pending {
+		records = append(records, *r)
+	}
+	sortUsage(records)
+	if err := u.store.Add(ctx, records); err != nil {
+		u.mu.Lock()
+		for _, r := range records {
+			if p, ok := u.pending[r.key()]; ok {
+				p.merge(r)
+			} else {
+				r := r
+				u.pending[r.key()] = &r
+			}
+		}
+		u.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Run flushes the recorded calls every interval until ctx is done, then
+// flushes them a last time.
+func (u *Usage) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			// Flush the last calls even though ctx is done.
+			if err := u.Flush(context.WithoutCancel(ctx)); err != nil {
+				logger.Error("Failed to flush usage", "error", err)
+				observability.RecordError(ctx, "usage", err, usageStoreError...)
+			}
+			return
+		case <-ticker.C:
+			if err := u.Flush(ctx); err != nil {
+				logger.Error("Failed to flush usage", "error", err)
+				observability.RecordError(ctx, "usage", err, usageStoreError...)
+			}
+		}
+	}
+}
+
+// usageStoreError classes the failures of the store, whose calls are kept
+// for the next flush.
+var usageStoreError = []observability.ErrorOption{
+	observability.WithCategory(observability.CategoryDependency),
+	observability.WithRetryable(true),
+}
+
+// Report returns the daily rollups matching q, including the calls not
+// yet flushed.
+func (u *Usage) Report(ctx context.Context, q UsageQuery) ([]UsageRecord, error) {
+	if err := u.Flush(ctx); err != nil {
+		return nil, err
+	}
+	return u.store.Query(ctx, q)
+}
+
+func sortUsage(records []UsageRecord) {
+	sort.Slice(records, func(i, j int) bool {
+		a, b := records[i], records[j]
+		if a.Day != b.Day {
+			return a.Day < b.Day
+		}
+		if a.KeyID != b.KeyID {
+			return a.KeyID < b.KeyID
+		}
+		return a.Method < b.Method
+	})
+}
+
+// FileUsageStore is a UsageStore keeping the rollups in a JSON file, for a
+// single server.
+type FileUsageStore struct {
+	path string
+
+	mu      sync.Mutex
+	records []UsageRecord
+}
+
+// OpenUsageStore returns the store kept in the file at path. The file is
+// created by the first flush.
+func OpenUsageStore(path string) (*FileUsageStore, error) {
+	s := &FileUsageStore{path: path}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.records); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// Add implements UsageStore.
+func (s *FileUsageStore) Add(ctx context.Context, records []UsageRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	index := make(map[usageKey]int, len(s.records))
+	for i, r := range s.records {
+		index[r.key()] = i
+	}
+	updated := append([]UsageRecord(nil), s.records...)
+	for _, r := range records {
+		if i, ok := index[r.key()]; ok {
+			updated[i].merge(r)
+		} else {
+			index[r.key()] = len(updated)
+			updated = append(updated, r)
+		}
+	}
+	sortUsage(updated)
+	if err := s.save(updated); err != nil {
+		return err
+	}
+	s.records = updated
+	return nil
+}
+
+// Query implements UsageStore.
+func (s *FileUsageStore) Query(ctx context.Context, q UsageQuery) ([]UsageRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matched []UsageRecord
+	for _, r := range s.records {
+		if q.matches(r) {
+			matched = append(matched, r)
+		}
+	}
+	return matched, nil
+}
+
+// save writes the records to a temporary file and renames it over the
+// store, so that the file is never left partially written.
+func (s *FileUsageStore) save(records []UsageRecord) error {
+	data, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".usage-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// SQLUsageStore is a UsageStore keeping the rollups in the usage_rollups
+// table of a SQL database, so that several servers can share it. Its
+// statements work with both SQLite and PostgreSQL; the driver must be
+// registered with database/sql by the binary.
+type SQLUsageStore struct {
+	db *sql.DB
+}
+
+// NewSQLUsageStore returns a store in db, creating its table if needed.
+func NewSQLUsageStore(ctx context.Context, db *sql.DB) (*SQLUsageStore, error) {
+	_, err := db.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS usage_rollups (
+	day TEXT NOT NULL,
+	key_id TEXT NOT NULL,
+	method TEXT NOT NULL,
+	calls BIGINT NOT NULL,
+	errors BIGINT NOT NULL,
+	total_latency_ns BIGINT NOT NULL,
+	max_latency_ns BIGINT NOT NULL,
+	PRIMARY KEY (day, key_id, method)
+)`)
+	if err != nil {
+		return nil, fmt.Errorf("creating usage table: %w", err)
+	}
+	return &SQLUsageStore{db: db}, nil
+}
+
+// Add implements UsageStore, adding the records in one transaction.
+func (s *SQLUsageStore) Add(ctx context.Context, records []UsageRecord) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, r := range records {
+		_, err := tx.ExecContext(ctx, `INSERT INTO usage_rollups
+	(day, key_id, method, calls, errors, total_latency_ns, max_latency_ns)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
+ON CONFLICT (day, key_id, method) DO UPDATE SET
+	calls = usage_rollups.calls + excluded.calls,
+	errors = usage_rollups.errors + excluded.errors,
+	total_latency_ns = usage_rollups.total_latency_ns + excluded.total_latency_ns,
+	max_latency_ns = CASE WHEN excluded.max_latency_ns > usage_rollups.max_latency_ns
+		THEN excluded.max_latency_ns ELSE usage_rollups.max_latency_ns END`,
+			r.Day, r.KeyID, r.Method, r.Calls, r.Errors, int64(r.TotalLatency), int64(r.MaxLatency))
+		if err != nil {
+			return fmt.Errorf("adding usage: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// Query implements UsageStore.
+func (s *SQLUsageStore) Query(ctx context.Context, q UsageQuery) ([]UsageRecord, error) {
+	var conds []string
+	var args []any
+	for _, c := range []struct{ cond, arg string }{
+		{"day >= ", q.From}, {"day <= ", q.To}, {"key_id = ", q.KeyID}, {"method = ", q.Method},
+	} {
+		if c.arg != "" {
+			args = append(args, c.arg)
+			conds = append(conds, fmt.Sprintf("%s$%d", c.cond, len(args)))
+		}
+	}
+	query := `SELECT day, key_id, method, calls, errors, total_latency_ns, max_latency_ns FROM usage_rollups`
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	rows, err := s.db.QueryContext(ctx, query+" ORDER BY day, key_id, method", args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying usage: %w", err)
+	}
+	defer rows.Close()
+	var records []UsageRecord
+	for rows.Next() {
+		var r UsageRecord
+		var total, max int64
+		if err := rows.Scan(&r.Day, &r.KeyID, &r.Method, &r.Calls, &r.Errors, &total, &max); err != nil {
+			return nil, err
+		}
+		r.TotalLatency, r.MaxLatency = time.Duration(total), time.Duration(max)
+		records = append(records, r)
+	}
+	return records, rows.Err()
+}
@@ -0,0 +1,88 @@
+//go:build sqlite
+
+package aaa
+
+import (
+	"context"
+	"database/sql"
+	"path/filepath"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLUsageStore(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "usage.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	store, err := NewSQLUsageStore(ctx, db)
+	if err != nil {
+		t.Fatalf("NewSQLUsageStore() error = %v", err)
+	}
+	// Creating the table again is harmless.
+	if _, err := NewSQLUsageStore(ctx, db); err != nil {
+		t.Fatalf("NewSQLUsageStore() of an existing table error = %v", err)
+	}
+
+	get, events := "/panchangam.Panchangam/Get", "/panchangam.Panchangam/GetEvents"
+	if err := store.Add(ctx, []UsageRecord{
+		{Day: "2024-04-09", KeyID: "k1", Method: get, Calls: 2, Errors: 1, TotalLatency: 40 * time.Millisecond, MaxLatency: 30 * time.Millisecond},
+		{Day: "2024-04-09", KeyID: "", Method: events, Calls: 1, TotalLatency: 5 * time.Millisecond, MaxLatency: 5 * time.Millisecond},
+		{Day: "2024-04-10", KeyID: "k1", Method: get, Calls: 1, TotalLatency: 40 * time.Millisecond, MaxLatency: 40 * time.Millisecond},
+	}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+	// A second add of a rollup accumulates its counts and keeps the
+	// largest latency.
+	if err := store.Add(ctx, []UsageRecord{
+		{Day: "2024-04-09", KeyID: "k1", Method: get, Calls: 1, TotalLatency: 20 * time.Millisecond, MaxLatency: 20 * time.Millisecond},
+		{Day: "2024-04-10", KeyID: "k1", Method: get, Calls: 1, Errors: 1, TotalLatency: 50 * time.Millisecond, MaxLatency: 50 * time.Millisecond},
+	}); err != nil {
+		t.Fatalf("Add() error = %v", err)
+	}
+
+	k1of9 := UsageRecord{Day: "2024-04-09", KeyID: "k1", Method: get, Calls: 3, Errors: 1, TotalLatency: 60 * time.Millisecond, MaxLatency: 30 * time.Millisecond}
+	anonOf9 := UsageRecord{Day: "2024-04-09", KeyID: "", Method: events, Calls: 1, TotalLatency: 5 * time.Millisecond, MaxLatency: 5 * time.Millisecond}
+	k1of10 := UsageRecord{Day: "2024-04-10", KeyID: "k1", Method: get, Calls: 2, Errors: 1, TotalLatency: 90 * time.Millisecond, MaxLatency: 50 * time.Millisecond}
+	for _, tt := range []struct {
+		name string
+		q    UsageQuery
+		want []UsageRecord
+	}{
+		{"all", UsageQuery{}, []UsageRecord{anonOf9, k1of9, k1of10}},
+		{"from", UsageQuery{From: "2024-04-10"}, []UsageRecord{k1of10}},
+		{"to", UsageQuery{To: "2024-04-09"}, []UsageRecord{anonOf9, k1of9}},
+		{"key", UsageQuery{KeyID: "k1"}, []UsageRecord{k1of9, k1of10}},
+		{"method", UsageQuery{Method: events}, []UsageRecord{anonOf9}},
+		{"all conditions", UsageQuery{From: "2024-04-09", To: "2024-04-09", KeyID: "k1", Method: get}, []UsageRecord{k1of9}},
+		{"none", UsageQuery{KeyID: "k2"}, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.Query(ctx, tt.q)
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			if len(got) != len(tt.want) {
+				t.Fatalf("Query() = %+v, want %+v", got, tt.want)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("Query()[%d] = %+v, want %+v", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+
+	// Usage flushes to the store like to a file.
+	usage := NewUsage(store)
+	usage.now = func() time.Time { return time.Date(2024, 4, 10, 12, 0, 0, 0, time.UTC) }
+	usage.Record("k1", get, nil, 10*time.Millisecond)
+	got, err := usage.Report(ctx, UsageQuery{From: "2024-04-10"})
+	if err != nil || len(got) != 1 || got[0].Calls != 3 || got[0].MaxLatency != 50*time.Millisecond {
+		t.Errorf("Report() = %+v, %v, want 3 calls of 2024-04-10", got, err)
+	}
+}
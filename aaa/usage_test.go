@@ -0,0 +1,89 @@
+package aaa
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// failingStore is a UsageStore failing every Add.
+type failingStore struct{ UsageStore }
+
+func (failingStore) Add(ctx context.Context, records []UsageRecord) error {
+	return errors.New("store unavailable")
+}
+
+func TestUsage(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "usage.json")
+	store, err := OpenUsageStore(path)
+	if err != nil {
+		t.Fatalf("OpenUsageStore() error = %v", err)
+	}
+	usage := NewUsage(store)
+	day := time.Date(2024, 4, 9, 23, 0, 0, 0, time.UTC)
+	usage.now = func() time.Time { return day }
+	usage.Record("k1", "/panchangam.Panchangam/Get", nil, 10*time.Millisecond)
+	usage.Record("k1", "/panchangam.Panchangam/Get", errors.New("invalid"), 30*time.Millisecond)
+	usage.Record("", "/panchangam.Panchangam/GetEvents", nil, 5*time.Millisecond)
+	if err := usage.Flush(ctx); err != nil {
+		t.Fatalf("Flush() error = %v", err)
+	}
+	// Calls of the next day are rolled up separately, and those of a
+	// second flush add to the first.
+	usage.Record("k1", "/panchangam.Panchangam/Get", nil, 20*time.Millisecond)
+	usage.now = func() time.Time { return day.Add(2 * time.Hour) }
+	usage.Record("k1", "/panchangam.Panchangam/Get", nil, 40*time.Millisecond)
+
+	got, err := usage.Report(ctx, UsageQuery{KeyID: "k1"})
+	if err != nil {
+		t.Fatalf("Report() error = %v", err)
+	}
+	want := []UsageRecord{
+		{Day: "2024-04-09", KeyID: "k1", Method: "/panchangam.Panchangam/Get", Calls: 3, Errors: 1, TotalLatency: 60 * time.Millisecond, MaxLatency: 30 * time.Millisecond},
+		{Day: "2024-04-10", KeyID: "k1", Method: "/panchangam.Panchangam/Get", Calls: 1, TotalLatency: 40 * time.Millisecond, MaxLatency: 40 * time.Millisecond},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("Report() = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Report()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+	if mean := got[0].MeanLatency(); mean != 20*time.Millisecond {
+		t.Errorf("MeanLatency() = %v, want 20ms", mean)
+	}
+
+	// The rollups survive a restart.
+	reopened, err := OpenUsageStore(path)
+	if err != nil {
+		t.Fatalf("OpenUsageStore() error = %v", err)
+	}
+	all, err := reopened.Query(ctx, UsageQuery{From: "2024-04-09", To: "2024-04-09"})
+	if err != nil || len(all) != 2 || all[0].KeyID != "" || all[1].KeyID != "k1" {
+		t.Errorf("Query() of the reopened store = %+v, %v, want both rollups of 2024-04-09", all, err)
+	}
+}
+
+func TestUsageFlushFailure(t *testing.T) {
+	ctx := context.Background()
+	store, err := OpenUsageStore(filepath.Join(t.TempDir(), "usage.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage := NewUsage(failingStore{store})
+	usage.Record("k1", "/panchangam.Panchangam/Get", nil, time.Millisecond)
+	if err := usage.Flush(ctx); err == nil {
+		t.Fatal("Flush() to a failing store succeeded")
+	}
+	// The calls are kept until a flush succeeds.
+	usage.store = store
+	usage.Record("k1", "/panchangam.Panchangam/Get", nil, time.Millisecond)
+	got, err := usage.Report(ctx, UsageQuery{})
+	if err != nil || len(got) != 1 || got[0].Calls != 2 {
+		t.Errorf("Report() = %+v, %v, want 2 calls", got, err)
+	}
+}
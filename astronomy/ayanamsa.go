@@ -0,0 +1,62 @@
+package astronomy
+
+import "fmt"
+
+// Lahiri (Chitrapaksha) ayanamsa at J2000.0 and its annual rate of change,
+// in degrees.
+const (
+	lahiriAyanamsaJ2000 = 23.85305
+	lahiriAyanamsaRate  = 50.2388 / 3600
+)
+
+// LahiriAyanamsa returns the Lahiri ayanamsa in degrees for the given
+// Julian day, the offset subtracted from tropical longitudes to obtain
+// sidereal ones.
+func LahiriAyanamsa(jd float64) float64 {
+	return lahiriAyanamsaJ2000 + lahiriAyanamsaRate*(jd-J2000)/365.25
+}
+
+// SiderealLongitude converts a tropical longitude to a sidereal longitude
+// using the Lahiri ayanamsa.
+func SiderealLongitude(tropical, jd float64) float64 {
+	return normalizeDegrees(tropical - LahiriAyanamsa(jd))
+}
+
+// Ayanamsa selects the ayanamsa the sidereal elements, the nakshatra and
+// the yoga, are computed with.
+type Ayanamsa string
+
+const (
+	// Lahiri is the Chitrapaksha ayanamsa of the Indian national
+	// calendar. It is the default.
+	Lahiri Ayanamsa = "lahiri"
+	// Raman is the ayanamsa of B. V. Raman.
+	Raman Ayanamsa = "raman"
+	// Krishnamurti is the ayanamsa of the Krishnamurti Paddhati.
+	Krishnamurti Ayanamsa = "krishnamurti"
+)
+
+// ayanamsaOffsets holds the difference in degrees of each ayanamsa from
+// the Lahiri ayanamsa, which they follow at the same rate.
+var ayanamsaOffsets = map[Ayanamsa]float64{
+	Lahiri:       0,
+	Raman:        -1.446301,
+	Krishnamurti: -0.096852,
+}
+
+// ParseAyanamsa returns the ayanamsa named s. The empty string selects
+// Lahiri.
+func ParseAyanamsa(s string) (Ayanamsa, error) {
+	if s == "" {
+		return Lahiri, nil
+	}
+	if _, ok := ayanamsaOffsets[Ayanamsa(s)]; !ok {
+		return "", fmt.Errorf("unknown ayanamsa %q: expected %s, %s or %s", s, Lahiri, Raman, Krishnamurti)
+	}
+	return Ayanamsa(s), nil
+}
+
+// At returns the ayanamsa in degrees for the given Julian day.
+func (a Ayanamsa) At(jd float64) float64 {
+	return LahiriAyanamsa(jd) + ayanamsaOffsets[a]
+}
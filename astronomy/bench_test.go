@@ -0,0 +1,57 @@
+package astronomy
+
+import (
+	"testing"
+	"time"
+)
+
+var benchTime = time.Date(2024, 4, 23, 0, 30, 0, 0, time.UTC)
+
+func BenchmarkMoonLongitude(b *testing.B) {
+	jd := JulianDay(benchTime)
+	for i := 0; i < b.N; i++ {
+		MoonLongitude(jd + float64(i%365))
+	}
+}
+
+func BenchmarkCalculateElements(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CalculateElements(benchTime.AddDate(0, 0, i%365))
+	}
+}
+
+func BenchmarkNearBoundaries(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		NearBoundaries(benchTime.AddDate(0, 0, i%365), DefaultBoundaryWindow)
+	}
+}
+
+func BenchmarkCalculateMasa(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		CalculateMasa(benchTime.AddDate(0, 0, i%365))
+	}
+}
+
+// BenchmarkCalculateElementPeriod locates both transitions of a tithi. It
+// costs about fifteen times CalculateElements, mostly in bracketing, and
+// barely depends on the precision since Brent's method converges
+// superlinearly.
+func BenchmarkCalculateElementPeriod(b *testing.B) {
+	for _, precision := range []time.Duration{time.Minute, time.Second, time.Millisecond} {
+		b.Run(precision.String(), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				CalculateElementPeriod(TithiElement, benchTime.AddDate(0, 0, i%365), WithPrecision(precision))
+			}
+		})
+	}
+}
+
+func BenchmarkMoonPosition(b *testing.B) {
+	jd := JulianDay(benchTime)
+	for i := 0; i < b.N; i++ {
+		day := jd + float64(i%365)
+		MoonLongitude(day)
+		MoonLatitude(day)
+		MoonDistance(day)
+	}
+}
@@ -0,0 +1,80 @@
+package astronomy
+
+import (
+	"time"
+)
+
+// Nature classifies a period as auspicious, neutral or inauspicious.
+type Nature string
+
+const (
+	Auspicious   Nature = "auspicious"
+	Neutral      Nature = "neutral"
+	Inauspicious Nature = "inauspicious"
+)
+
+// Choghadiya names in the order of their ruling planets
+// (Sun, Venus, Mercury, Moon, Saturn, Jupiter, Mars).
+var choghadiyaCycle = []string{"Udveg", "Char", "Labh", "Amrit", "Kaal", "Shubh", "Rog"}
+
+var choghadiyaNature = map[string]Nature{
+	"Udveg": Inauspicious,
+	"Char":  Neutral,
+	"Labh":  Auspicious,
+	"Amrit": Auspicious,
+	"Kaal":  Inauspicious,
+	"Shubh": Auspicious,
+	"Rog":   Inauspicious,
+}
+
+// ChoghadiyaNames returns the names of the seven choghadiyas.
+func ChoghadiyaNames() []string {
+	return append([]string(nil), choghadiyaCycle...)
+}
+
+// Index into choghadiyaCycle of the first day and night choghadiya for each
+// weekday, starting from Sunday.
+var (
+	dayChoghadiyaStart   = [7]int{0, 3, 6, 2, 5, 1, 4}
+	nightChoghadiyaStart = [7]int{5, 1, 4, 0, 3, 6, 2}
+)
+
+// ChoghadiyaPeriod is one of the sixteen choghadiya of a day.
+type ChoghadiyaPeriod struct {
+	Name   string
+	Nature Nature
+	Start  time.Time
+	End    time.Time
+	IsDay  bool
+}
+
+// CalculateChoghadiya splits the day (sunrise to sunset) and the following
+// night (sunset to the next sunrise) into eight equal parts each and labels
+// them according to the weekday of sunrise.
+func CalculateChoghadiya(sunrise, sunset, nextSunrise time.Time) []ChoghadiyaPeriod {
+	weekday := int(sunrise.Weekday())
+	periods := make([]ChoghadiyaPeriod, 0, 16)
+	periods = appendChoghadiya(periods, sunrise, sunset, dayChoghadiyaStart[weekday], 1, true)
+	// Night choghadiya step backwards by two in the planetary cycle.
+	periods = appendChoghadiya(periods, sunset, nextSunrise, nightChoghadiyaStart[weekday], 5, false)
+	return periods
+}
+
+func appendChoghadiya(periods []ChoghadiyaPeriod, start, end time.Time, first, step int, isDay bool) []ChoghadiyaPeriod {
+	part := end.Sub(start) / 8
+	for i := 0; i < 8; i++ {
+		name := choghadiyaCycle[(first+i*step)%len(choghadiyaCycle)]
+		periodEnd := start.Add(part * time.Duration(i+1))
+		if i == 7 {
+			periodEnd = end
+		}
+		periods = append(periods, ChoghadiyaPeriod{
+			Name:   name,
+			Nature: choghadiyaNature[name],
+			Start:  start.Add(part * time.Duration(i)),
+			End:    periodEnd,
+			IsDay:  isDay,
+		})
+	}
+	return periods
+}
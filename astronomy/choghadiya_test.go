@@ -0,0 +1,76 @@
+package astronomy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalculateChoghadiya(t *testing.T) {
+	tests := []struct {
+		name      string
+		sunrise   time.Time
+		wantDay   []string
+		wantNight []string
+	}{
+		{
+			"sunday",
+			time.Date(2024, 4, 28, 6, 0, 0, 0, time.UTC),
+			[]string{"Udveg", "Char", "Labh", "Amrit", "Kaal", "Shubh", "Rog", "Udveg"},
+			[]string{"Shubh", "Amrit", "Char", "Rog", "Kaal", "Labh", "Udveg", "Shubh"},
+		},
+		{
+			"monday",
+			time.Date(2024, 4, 29, 6, 0, 0, 0, time.UTC),
+			[]string{"Amrit", "Kaal", "Shubh", "Rog", "Udveg", "Char", "Labh", "Amrit"},
+			[]string{"Char", "Rog", "Kaal", "Labh", "Udveg", "Shubh", "Amrit", "Char"},
+		},
+		{
+			"saturday",
+			time.Date(2024, 5, 4, 6, 0, 0, 0, time.UTC),
+			[]string{"Kaal", "Shubh", "Rog", "Udveg", "Char", "Labh", "Amrit", "Kaal"},
+			[]string{"Labh", "Udveg", "Shubh", "Amrit", "Char", "Rog", "Kaal", "Labh"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sunset := tt.sunrise.Add(12*time.Hour + 20*time.Minute)
+			nextSunrise := tt.sunrise.Add(24 * time.Hour)
+			periods := CalculateChoghadiya(tt.sunrise, sunset, nextSunrise)
+			if len(periods) != 16 {
+				t.Fatalf("len(periods) = %d, want 16", len(periods))
+			}
+			for i, p := range periods {
+				want, isDay := tt.wantNight, false
+				if i < 8 {
+					want, isDay = tt.wantDay, true
+				}
+				if p.Name != want[i%8] {
+					t.Errorf("periods[%d].Name = %s, want %s", i, p.Name, want[i%8])
+				}
+				if p.IsDay != isDay {
+					t.Errorf("periods[%d].IsDay = %v, want %v", i, p.IsDay, isDay)
+				}
+			}
+			if !periods[0].Start.Equal(tt.sunrise) || !periods[7].End.Equal(sunset) {
+				t.Errorf("day choghadiya span %v-%v, want %v-%v", periods[0].Start, periods[7].End, tt.sunrise, sunset)
+			}
+			if !periods[8].Start.Equal(sunset) || !periods[15].End.Equal(nextSunrise) {
+				t.Errorf("night choghadiya span %v-%v, want %v-%v", periods[8].Start, periods[15].End, sunset, nextSunrise)
+			}
+			for i := 1; i < len(periods); i++ {
+				if !periods[i].Start.Equal(periods[i-1].End) {
+					t.Errorf("periods[%d] starts at %v, previous ends at %v", i, periods[i].Start, periods[i-1].End)
+				}
+			}
+		})
+	}
+}
+
+func TestChoghadiyaNature(t *testing.T) {
+	for _, name := range choghadiyaCycle {
+		if _, ok := choghadiyaNature[name]; !ok {
+			t.Errorf("choghadiya %s has no nature", name)
+		}
+	}
+}
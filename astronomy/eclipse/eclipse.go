@@ -0,0 +1,229 @@
+// Package eclipse predicts solar and lunar eclipses and their local
+// circumstances.
+//
+// Eclipses are found at every new and full moon close enough to a lunar
+// node, using the method of Meeus, Astronomical Algorithms, chapter 54.
+// Times are accurate to a few minutes.
+package eclipse
+
+import (
+	"math"
+	"sort"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// Body is the body being eclipsed.
+type Body string
+
+const (
+	Solar Body = "solar"
+	Lunar Body = "lunar"
+)
+
+// Type classifies an eclipse by its greatest phase.
+type Type string
+
+const (
+	Total     Type = "total"
+	Annular   Type = "annular"
+	Hybrid    Type = "hybrid"
+	Partial   Type = "partial"
+	Penumbral Type = "penumbral"
+)
+
+// Contact is a named instant of an eclipse, such as the start of the
+// umbral phase of a lunar eclipse.
+type Contact struct {
+	// Name is P1, U1, U2, U3, U4 or P4 for lunar eclipses and C1 or C4,
+	// the local start and end, for solar eclipses.
+	Name string
+	Time time.Time
+}
+
+// Eclipse is a solar or lunar eclipse.
+type Eclipse struct {
+	Body Body
+	Type Type
+	// Maximum is the instant of greatest eclipse.
+	Maximum time.Time
+	// Gamma is the least distance of the shadow axis from the centre of
+	// the Earth, in Earth radii.
+	Gamma float64
+	// Magnitude is the greatest fraction of the sun's diameter covered for
+	// solar eclipses, and the umbral magnitude for lunar eclipses. The umbral
+	// magnitude of penumbral eclipses is negative.
+	Magnitude float64
+	// PenumbralMagnitude is the penumbral magnitude of lunar eclipses.
+	PenumbralMagnitude float64
+	// Contacts lists the phases of lunar eclipses, which are the same
+	// everywhere. Solar contacts depend on the location; see Local.
+	Contacts []Contact
+	// Local describes the eclipse as seen from the requested location.
+	Local Visibility
+}
+
+// Visibility describes an eclipse as seen from one location.
+type Visibility struct {
+	// Visible reports whether any part of the eclipse can be seen, with
+	// the eclipsed body above the horizon.
+	Visible bool
+	// Start and End bound the visible part of the eclipse.
+	Start time.Time
+	End   time.Time
+	// Magnitude is the greatest fraction of the sun's diameter covered as
+	// seen from the location. It is only set for solar eclipses.
+	Magnitude float64
+}
+
+// Lunation numbering of Meeus, k = 0 at the new moon of 2000-01-06.
+const (
+	lunationEpoch   = 2451550.09766
+	synodicMonth    = 29.530588861
+	lunationsPerCen = 1236.85
+)
+
+// Between returns the eclipses with a maximum between start and end,
+// ordered by time, with their local circumstances at loc.
+func Between(start, end time.Time, loc astronomy.Location) []Eclipse {
+	first := math.Floor((astronomy.JulianDayTT(start)-lunationEpoch)/synodicMonth) - 1
+	last := math.Ceil((astronomy.JulianDayTT(end)-lunationEpoch)/synodicMonth) + 1
+
+	var eclipses []Eclipse
+	for k := first; k <= last; k += 0.5 {
+		e, ok := at(k)
+		if !ok || e.Maximum.Before(start) || e.Maximum.After(end) {
+			continue
+		}
+		if e.Body == Lunar {
+			e.Local = lunarVisibility(e, loc)
+		} else {
+			e.Contacts, e.Local = solarVisibility(e, loc)
+		}
+		eclipses = append(eclipses, e)
+	}
+	sort.Slice(eclipses, func(i, j int) bool { return eclipses[i].Maximum.Before(eclipses[j].Maximum) })
+	return eclipses
+}
+
+// at returns the eclipse at lunation k, a new moon for integer k and a full
+// moon for k + 0.5, if there is one.
+func at(k float64) (Eclipse, bool) {
+	t := k / lunationsPerCen
+	t2, t3, t4 := t*t, t*t*t, t*t*t*t
+
+	f := 160.7108 + 390.67050284*k - 0.0016118*t2 - 0.00000227*t3 + 0.000000011*t4
+	if math.Abs(sinDeg(f)) > 0.36 {
+		return Eclipse{}, false
+	}
+	jde := lunationEpoch + synodicMonth*k + 0.00015437*t2 - 0.00000015*t3 + 0.00000000073*t4
+	m := 2.5534 + 29.10535670*k - 0.0000014*t2 - 0.00000011*t3
+	mp := 201.5643 + 385.81693528*k + 0.0107582*t2 + 0.00001238*t3 - 0.000000058*t4
+	omega := 124.7746 - 1.56375588*k + 0.0020672*t2 + 0.00000215*t3
+	e := 1 - 0.002516*t - 0.0000074*t2
+	f1 := f - 0.02665*sinDeg(omega)
+	a1 := 299.77 + 0.107408*k - 0.009173*t2
+
+	solar := k == math.Floor(k)
+	if solar {
+		jde += -0.4075*sinDeg(mp) + 0.1721*e*sinDeg(m)
+	} else {
+		jde += -0.4065*sinDeg(mp) + 0.1727*e*sinDeg(m)
+	}
+	jde += 0.0161*sinDeg(2*mp) - 0.0097*sinDeg(2*f1) + 0.0073*e*sinDeg(mp-m) -
+		0.0050*e*sinDeg(mp+m) - 0.0023*sinDeg(mp-2*f1) + 0.0021*e*sinDeg(2*m) +
+		0.0012*sinDeg(mp+2*f1) + 0.0006*e*sinDeg(2*mp+m) - 0.0004*sinDeg(3*mp) -
+		0.0003*e*sinDeg(m+2*f1) + 0.0003*sinDeg(a1) - 0.0002*e*sinDeg(m-2*f1) -
+		0.0002*e*sinDeg(2*mp-m) - 0.0002*sinDeg(omega)
+
+	p := 0.2070*e*sinDeg(m) + 0.0024*e*sinDeg(2*m) - 0.0392*sinDeg(mp) +
+		0.0116*sinDeg(2*mp) - 0.0073*e*sinDeg(mp+m) + 0.0067*e*sinDeg(mp-m) +
+		0.0118*sinDeg(2*f1)
+	q := 5.2207 - 0.0048*e*cosDeg(m) + 0.0020*e*cosDeg(2*m) - 0.3299*cosDeg(mp) -
+		0.0060*e*cosDeg(mp+m) + 0.0041*e*cosDeg(mp-m)
+	w := math.Abs(cosDeg(f1))
+	gamma := (p*cosDeg(f1) + q*sinDeg(f1)) * (1 - 0.0048*w)
+	u := 0.0059 + 0.0046*e*cosDeg(m) - 0.0182*cosDeg(mp) + 0.0004*cosDeg(2*mp) -
+		0.0005*cosDeg(m+mp)
+
+	eclipse := Eclipse{Maximum: astronomy.TimeFromJulianDayTT(jde), Gamma: gamma}
+	if solar {
+		return solarEclipse(eclipse, u)
+	}
+	return lunarEclipse(eclipse, u, mp)
+}
+
+func solarEclipse(e Eclipse, u float64) (Eclipse, bool) {
+	gamma := math.Abs(e.Gamma)
+	if gamma > 1.5433+u {
+		return Eclipse{}, false
+	}
+	e.Body = Solar
+	switch {
+	case gamma > 0.9972:
+		e.Type = Partial
+		e.Magnitude = (1.5433 + u - gamma) / (0.5461 + 2*u)
+		return e, true
+	case u < 0:
+		e.Type = Total
+	case u > 0.0047 || u >= 0.00464*math.Sqrt(1-gamma*gamma):
+		e.Type = Annular
+	default:
+		e.Type = Hybrid
+	}
+	// The magnitude of central eclipses is the ratio of the apparent
+	// diameters of the moon and the sun at the point of greatest eclipse,
+	// which is nearer to the moon than the centre of the Earth.
+	jd := astronomy.JulianDayTT(e.Maximum)
+	moonDistance := astronomy.MoonDistance(jd) - earthRadius*math.Sqrt(1-gamma*gamma)
+	e.Magnitude = (moonSemidiameterKm / moonDistance) / (sunSemidiameter / astronomy.SunDistance(jd))
+	return e, true
+}
+
+func lunarEclipse(e Eclipse, u, moonAnomaly float64) (Eclipse, bool) {
+	gamma := math.Abs(e.Gamma)
+	e.PenumbralMagnitude = (1.5573 + u - gamma) / 0.5450
+	if e.PenumbralMagnitude <= 0 {
+		return Eclipse{}, false
+	}
+	e.Body = Lunar
+	e.Magnitude = (1.0128 - u - gamma) / 0.5450
+	switch {
+	case e.Magnitude >= 1:
+		e.Type = Total
+	case e.Magnitude > 0:
+		e.Type = Partial
+	default:
+		e.Type = Penumbral
+	}
+
+	// Semidurations of the penumbral, partial and total phases.
+	n := 0.5458 + 0.0400*cosDeg(moonAnomaly)
+	semiduration := func(radius float64) time.Duration {
+		return time.Duration(60 / n * math.Sqrt(radius*radius-gamma*gamma) * float64(time.Minute))
+	}
+	phases := []struct {
+		start, end string
+		radius     float64
+		present    bool
+	}{
+		{"P1", "P4", 1.5573 + u, true},
+		{"U1", "U4", 1.0128 - u, e.Magnitude > 0},
+		{"U2", "U3", 0.4678 - u, e.Magnitude >= 1},
+	}
+	for _, phase := range phases {
+		if !phase.present {
+			continue
+		}
+		d := semiduration(phase.radius)
+		e.Contacts = append(e.Contacts,
+			Contact{Name: phase.start, Time: e.Maximum.Add(-d).Round(time.Second)},
+			Contact{Name: phase.end, Time: e.Maximum.Add(d).Round(time.Second)})
+	}
+	sort.Slice(e.Contacts, func(i, j int) bool { return e.Contacts[i].Time.Before(e.Contacts[j].Time) })
+	return e, true
+}
+
+func sinDeg(d float64) float64 { return math.Sin(d * math.Pi / 180) }
+func cosDeg(d float64) float64 { return math.Cos(d * math.Pi / 180) }
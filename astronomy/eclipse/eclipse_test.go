@@ -0,0 +1,101 @@
+package eclipse
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+var (
+	delhi  = astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	dallas = astronomy.Location{Latitude: 32.7767, Longitude: -96.7970}
+)
+
+func TestBetween(t *testing.T) {
+	// Eclipses of 2024 and 2025, with times of greatest eclipse from the
+	// NASA eclipse catalogues.
+	tests := []struct {
+		body      Body
+		typ       Type
+		maximum   time.Time
+		magnitude float64
+	}{
+		{Lunar, Penumbral, time.Date(2024, 3, 25, 7, 13, 0, 0, time.UTC), -0.132},
+		{Solar, Total, time.Date(2024, 4, 8, 18, 17, 0, 0, time.UTC), 1.057},
+		{Lunar, Partial, time.Date(2024, 9, 18, 2, 44, 0, 0, time.UTC), 0.085},
+		{Solar, Annular, time.Date(2024, 10, 2, 18, 45, 0, 0, time.UTC), 0.933},
+		{Lunar, Total, time.Date(2025, 3, 14, 6, 58, 0, 0, time.UTC), 1.178},
+		{Solar, Partial, time.Date(2025, 3, 29, 10, 47, 0, 0, time.UTC), 0.938},
+		{Lunar, Total, time.Date(2025, 9, 7, 18, 11, 0, 0, time.UTC), 1.362},
+		{Solar, Partial, time.Date(2025, 9, 21, 19, 41, 0, 0, time.UTC), 0.855},
+	}
+	eclipses := Between(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC), delhi)
+	if len(eclipses) != len(tests) {
+		t.Fatalf("Between() returned %d eclipses, want %d: %+v", len(eclipses), len(tests), eclipses)
+	}
+	for i, tt := range tests {
+		e := eclipses[i]
+		if e.Body != tt.body || e.Type != tt.typ {
+			t.Errorf("eclipse %d is %s %s, want %s %s", i, e.Type, e.Body, tt.typ, tt.body)
+		}
+		if d := e.Maximum.Sub(tt.maximum); d.Abs() > 5*time.Minute {
+			t.Errorf("%s %s eclipse maximum = %v, want %v", e.Type, e.Body, e.Maximum, tt.maximum)
+		}
+		if math.Abs(e.Magnitude-tt.magnitude) > 0.01 {
+			t.Errorf("%s %s eclipse magnitude = %.3f, want %.3f", e.Type, e.Body, e.Magnitude, tt.magnitude)
+		}
+	}
+}
+
+func TestLunarContacts(t *testing.T) {
+	eclipses := Between(time.Date(2025, 9, 7, 0, 0, 0, 0, time.UTC), time.Date(2025, 9, 8, 0, 0, 0, 0, time.UTC), delhi)
+	if len(eclipses) != 1 {
+		t.Fatalf("Between() returned %d eclipses, want 1", len(eclipses))
+	}
+	// Contacts of the total lunar eclipse of 2025-09-07 from the NASA
+	// catalogue.
+	want := []Contact{
+		{"P1", time.Date(2025, 9, 7, 15, 28, 0, 0, time.UTC)},
+		{"U1", time.Date(2025, 9, 7, 16, 27, 0, 0, time.UTC)},
+		{"U2", time.Date(2025, 9, 7, 17, 31, 0, 0, time.UTC)},
+		{"U3", time.Date(2025, 9, 7, 18, 53, 0, 0, time.UTC)},
+		{"U4", time.Date(2025, 9, 7, 19, 57, 0, 0, time.UTC)},
+		{"P4", time.Date(2025, 9, 7, 20, 55, 0, 0, time.UTC)},
+	}
+	got := eclipses[0].Contacts
+	if len(got) != len(want) {
+		t.Fatalf("Contacts = %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i].Name != want[i].Name || got[i].Time.Sub(want[i].Time).Abs() > 5*time.Minute {
+			t.Errorf("contact %d = %s %v, want %s %v", i, got[i].Name, got[i].Time, want[i].Name, want[i].Time)
+		}
+	}
+	if !eclipses[0].Local.Visible {
+		t.Error("Local.Visible = false, want the eclipse visible from Delhi")
+	}
+}
+
+func TestSolarVisibility(t *testing.T) {
+	from, to := time.Date(2024, 4, 8, 0, 0, 0, 0, time.UTC), time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC)
+
+	eclipses := Between(from, to, dallas)
+	if len(eclipses) != 1 {
+		t.Fatalf("Between() returned %d eclipses, want 1", len(eclipses))
+	}
+	local := eclipses[0].Local
+	if !local.Visible || local.Magnitude < 1 {
+		t.Errorf("Local = %+v, want a total eclipse visible from Dallas", local)
+	}
+	// The partial phases in Dallas lasted from 17:23 to 20:02 UTC.
+	if local.Start.Sub(time.Date(2024, 4, 8, 17, 23, 0, 0, time.UTC)).Abs() > 5*time.Minute ||
+		local.End.Sub(time.Date(2024, 4, 8, 20, 2, 0, 0, time.UTC)).Abs() > 5*time.Minute {
+		t.Errorf("Local = %v - %v, want 17:23 - 20:02 UTC", local.Start, local.End)
+	}
+
+	if local := Between(from, to, delhi)[0].Local; local.Visible {
+		t.Errorf("Local = %+v, want the eclipse not visible from Delhi", local)
+	}
+}
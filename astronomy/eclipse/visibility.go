@@ -0,0 +1,159 @@
+package eclipse
+
+import (
+	"math"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+const (
+	// earthRadius is the equatorial radius of the Earth in kilometres.
+	earthRadius = 6378.14
+	// sunSemidiameter is the semidiameter of the sun at 1 AU in degrees.
+	sunSemidiameter = 959.63 / 3600
+	// moonSemidiameterKm is the semidiameter of the moon in degrees times its
+	// distance in kilometres.
+	moonSemidiameterKm = 358473400.0 / 3600
+	// sunAltitudeAtRiseSet matches the sunrise and sunset altitude used by
+	// astronomy.CalculateSunTimes.
+	sunAltitudeAtRiseSet = -0.833
+)
+
+// scanStep is the resolution of the search for local contacts, refined by
+// bisection to the second.
+const scanStep = time.Minute
+
+// solarSearchWindow is how far from the greatest eclipse local contacts are
+// searched for.
+const solarSearchWindow = 4 * time.Hour
+
+// lunarVisibility returns the part of a lunar eclipse during which the moon
+// is above the horizon at loc.
+func lunarVisibility(e Eclipse, loc astronomy.Location) Visibility {
+	first, last := e.Contacts[0].Time, e.Contacts[len(e.Contacts)-1].Time
+	start, end, ok := window(first, last, func(t time.Time) bool {
+		jd := astronomy.JulianDayTT(t)
+		ra, dec := astronomy.Equatorial(astronomy.MoonLongitude(jd), astronomy.MoonLatitude(jd), jd)
+		return altitude(loc, astronomy.JulianDayUT1(t), ra, dec) > 0
+	})
+	if !ok {
+		return Visibility{}
+	}
+	return Visibility{Visible: true, Start: start, End: end}
+}
+
+// solarVisibility returns the local contacts of a solar eclipse at loc and
+// the part of it during which the sun is above the horizon. No contacts are
+// returned when the sun is below the horizon throughout.
+func solarVisibility(e Eclipse, loc astronomy.Location) ([]Contact, Visibility) {
+	from, to := e.Maximum.Add(-solarSearchWindow), e.Maximum.Add(solarSearchWindow)
+	c1, c4, ok := window(from, to, func(t time.Time) bool {
+		return solarObscuration(loc, t).magnitude > 0
+	})
+	if !ok {
+		return nil, Visibility{}
+	}
+	start, end, ok := window(c1, c4, func(t time.Time) bool {
+		o := solarObscuration(loc, t)
+		return o.magnitude > 0 && o.sunAltitude > sunAltitudeAtRiseSet
+	})
+	if !ok {
+		return nil, Visibility{}
+	}
+	contacts := []Contact{{Name: "C1", Time: c1}, {Name: "C4", Time: c4}}
+	v := Visibility{Visible: true, Start: start, End: end}
+	for t := start; !t.After(end); t = t.Add(scanStep) {
+		v.Magnitude = math.Max(v.Magnitude, solarObscuration(loc, t).magnitude)
+	}
+	return contacts, v
+}
+
+type obscuration struct {
+	// magnitude is the fraction of the sun's diameter covered by the moon.
+	magnitude float64
+	// sunAltitude is the altitude of the sun's centre in degrees.
+	sunAltitude float64
+}
+
+// solarObscuration returns how much of the sun is covered by the moon as
+// seen from loc at t, correcting the moon's position for parallax.
+func solarObscuration(loc astronomy.Location, t time.Time) obscuration {
+	jd, ut := astronomy.JulianDayTT(t), astronomy.JulianDayUT1(t)
+	sunRA, sunDec := astronomy.Equatorial(astronomy.SunLongitude(jd), 0, jd)
+	moonRA, moonDec := astronomy.Equatorial(astronomy.MoonLongitude(jd), astronomy.MoonLatitude(jd), jd)
+	distance := astronomy.MoonDistance(jd)
+	moonRA, moonDec = topocentric(loc, ut, moonRA, moonDec, distance)
+
+	cosSeparation := sinDeg(sunDec)*sinDeg(moonDec) + cosDeg(sunDec)*cosDeg(moonDec)*cosDeg(sunRA-moonRA)
+	separation := math.Acos(math.Min(1, cosSeparation)) * 180 / math.Pi
+	sunRadius := sunSemidiameter / astronomy.SunDistance(jd)
+	moonRadius := moonSemidiameterKm / distance
+	return obscuration{
+		magnitude:   math.Max(0, (sunRadius+moonRadius-separation)/(2*sunRadius)),
+		sunAltitude: altitude(loc, ut, sunRA, sunDec),
+	}
+}
+
+// topocentric corrects geocentric equatorial coordinates of a body at
+// distance kilometres for the parallax at loc at the Julian day ut in UT1,
+// Meeus chapter 40, treating the Earth as a sphere.
+func topocentric(loc astronomy.Location, ut, ra, dec, distance float64) (float64, float64) {
+	sinParallax := earthRadius / distance
+	hourAngle := astronomy.SiderealTime(ut) + loc.Longitude - ra
+	deltaRA := math.Atan2(-cosDeg(loc.Latitude)*sinParallax*sinDeg(hourAngle),
+		cosDeg(dec)-cosDeg(loc.Latitude)*sinParallax*cosDeg(hourAngle))
+	topoDec := math.Atan2((sinDeg(dec)-sinDeg(loc.Latitude)*sinParallax)*math.Cos(deltaRA),
+		cosDeg(dec)-cosDeg(loc.Latitude)*sinParallax*cosDeg(hourAngle))
+	return ra + deltaRA*180/math.Pi, topoDec * 180 / math.Pi
+}
+
+// altitude returns the altitude above the horizon at loc, in degrees, of a
+// body at the given right ascension and declination at the Julian day ut in
+// UT1.
+func altitude(loc astronomy.Location, ut, ra, dec float64) float64 {
+	hourAngle := astronomy.SiderealTime(ut) + loc.Longitude - ra
+	sinAltitude := sinDeg(loc.Latitude)*sinDeg(dec) + cosDeg(loc.Latitude)*cosDeg(dec)*cosDeg(hourAngle)
+	return math.Asin(sinAltitude) * 180 / math.Pi
+}
+
+// window returns the first and last instants between from and to at which
+// in holds, assuming it holds over a single interval.
+func window(from, to time.Time, in func(time.Time) bool) (start, end time.Time, ok bool) {
+	var previous time.Time
+	for t := from; ; t = t.Add(scanStep) {
+		if t.After(to) {
+			t = to
+		}
+		if in(t) {
+			if !ok {
+				start, ok = t, true
+				if t.After(from) {
+					start = edge(previous, t, in)
+				}
+			}
+			end = t
+		} else if ok {
+			return start, edge(end, t, in), true
+		}
+		if !t.Before(to) {
+			return start, end, ok
+		}
+		previous = t
+	}
+}
+
+// edge bisects between a and b, on either side of a change of in, to the
+// second.
+func edge(a, b time.Time, in func(time.Time) bool) time.Time {
+	inA := in(a)
+	for b.Sub(a) > time.Second {
+		mid := a.Add(b.Sub(a) / 2)
+		if in(mid) == inA {
+			a = mid
+		} else {
+			b = mid
+		}
+	}
+	return a.Add(b.Sub(a) / 2).Round(time.Second)
+}
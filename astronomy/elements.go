@@ -0,0 +1,475 @@
+package astronomy
+
+import (
+	"math"
+	"time"
+)
+
+// ElementKind identifies one of the angular panchangam elements.
+type ElementKind string
+
+const (
+	TithiElement     ElementKind = "tithi"
+	NakshatraElement ElementKind = "nakshatra"
+	YogaElement      ElementKind = "yoga"
+	KaranaElement    ElementKind = "karana"
+	// VaraElement is the weekday. Unlike the others it is not angular: a
+	// vara lasts from one sunrise to the next, see CalculateVara.
+	VaraElement ElementKind = "vara"
+)
+
+var tithiNames = []string{
+	"Pratipada", "Dwitiya", "Tritiya", "Chaturthi", "Panchami",
+	"Shashthi", "Saptami", "Ashtami", "Navami", "Dashami",
+	"Ekadashi", "Dwadashi", "Trayodashi", "Chaturdashi",
+}
+
+var nakshatraNames = []string{
+	"Ashwini", "Bharani", "Krittika", "Rohini", "Mrigashira", "Ardra",
+	"Punarvasu", "Pushya", "Ashlesha", "Magha", "Purva Phalguni",
+	"Uttara Phalguni", "Hasta", "Chitra", "Swati", "Vishakha", "Anuradha",
+	"Jyeshtha", "Mula", "Purva Ashadha", "Uttara Ashadha", "Shravana",
+	"Dhanishta", "Shatabhisha", "Purva Bhadrapada", "Uttara Bhadrapada",
+	"Revati",
+}
+
+var yogaNames = []string{
+	"Vishkambha", "Priti", "Ayushman", "Saubhagya", "Shobhana", "Atiganda",
+	"Sukarma", "Dhriti", "Shula", "Ganda", "Vriddhi", "Dhruva", "Vyaghata",
+	"Harshana", "Vajra", "Siddhi", "Vyatipata", "Variyana", "Parigha",
+	"Shiva", "Siddha", "Sadhya", "Shubha", "Shukla", "Brahma", "Indra",
+	"Vaidhriti",
+}
+
+// The seven movable karanas repeat eight times from the second half of
+// Shukla Pratipada; the four fixed karanas occupy the remaining halves.
+var movableKaranaNames = []string{"Bava", "Balava", "Kaulava", "Taitila", "Gara", "Vanija", "Vishti"}
+
+// Element is the value of one panchangam element at an instant.
+type Element struct {
+	Kind ElementKind
+	// Number is the one-based position of the element in its cycle,
+	// e.g. 1-30 for tithi and 1-27 for nakshatra.
+	Number int
+	Name   string
+}
+
+// Elements holds the four angular panchangam elements at an instant.
+type Elements struct {
+	Tithi     Element
+	Nakshatra Element
+	Yoga      Element
+	Karana    Element
+}
+
+// positions holds the tropical longitudes of the sun and the moon at an
+// instant. They are computed once and shared by every element evaluated at
+// that instant.
+type positions struct {
+	jd       float64
+	sun      float64
+	moon     float64
+	ayanamsa float64
+}
+
+func positionsAt(jd float64) positions {
+	return positions{
+		jd:       jd,
+		sun:      SunLongitude(jd),
+		moon:     MoonLongitude(jd),
+		ayanamsa: LahiriAyanamsa(jd),
+	}
+}
+
+// positionsAt returns the positions at jd, with the ayanamsa and the moon
+// as seen by the observer if they are configured.
+func (c elementConfig) positionsAt(jd float64) positions {
+	p := positionsAt(jd)
+	if c.ayanamsa != "" {
+		p.ayanamsa = c.ayanamsa.At(jd)
+	}
+	if c.observer != nil {
+		p.moon, _ = MoonTopocentric(jd, *c.observer)
+	}
+	return p
+}
+
+// elongation returns the angle of the moon ahead of the sun in degrees.
+func (p positions) elongation() float64 {
+	return normalizeDegrees(p.moon - p.sun)
+}
+
+// siderealMoon returns the sidereal longitude of the moon in degrees.
+func (p positions) siderealMoon() float64 {
+	return normalizeDegrees(p.moon - p.ayanamsa)
+}
+
+// siderealSun returns the sidereal longitude of the sun in degrees.
+func (p positions) siderealSun() float64 {
+	return normalizeDegrees(p.sun - p.ayanamsa)
+}
+
+// elementSpec describes how an element is derived from an angle that grows
+// monotonically with time and is divided into count equal parts.
+type elementSpec struct {
+	kind  ElementKind
+	count int
+	angle func(p positions) float64
+	name  func(index int) string
+}
+
+func (e elementSpec) span() float64 { return 360 / float64(e.count) }
+
+func (e elementSpec) element(index int) Element {
+	index = ((index % e.count) + e.count) % e.count
+	return Element{Kind: e.kind, Number: index + 1, Name: e.name(index)}
+}
+
+func (e elementSpec) at(p positions) (Element, float64) {
+	angle := e.angle(p)
+	index := int(angle / e.span())
+	return e.element(index), angle
+}
+
+// rate returns the angular speed of the element's angle in degrees per day
+// between two nearby instants.
+func (e elementSpec) rate(before, after positions) float64 {
+	delta := normalizeDegrees(e.angle(after)-e.angle(before)+180) - 180
+	return delta / (after.jd - before.jd)
+}
+
+// rateStep is the half width of the interval over which rates are
+// estimated, in days.
+const rateStep = 1.0 / 24
+
+var (
+	tithiSpec = elementSpec{
+		kind:  TithiElement,
+		count: 30,
+		angle: positions.elongation,
+		name:  tithiName,
+	}
+	nakshatraSpec = elementSpec{
+		kind:  NakshatraElement,
+		count: 27,
+		angle: positions.siderealMoon,
+		name:  func(i int) string { return nakshatraNames[i] },
+	}
+	yogaSpec = elementSpec{
+		kind:  YogaElement,
+		count: 27,
+		angle: func(p positions) float64 {
+			return normalizeDegrees(p.siderealMoon() + p.siderealSun())
+		},
+		name: func(i int) string { return yogaNames[i] },
+	}
+	karanaSpec = elementSpec{
+		kind:  KaranaElement,
+		count: 60,
+		angle: positions.elongation,
+		name:  karanaName,
+	}
+	elementSpecs = []elementSpec{tithiSpec, nakshatraSpec, yogaSpec, karanaSpec}
+)
+
+// lunarElongation returns the angle of the moon ahead of the sun in degrees.
+func lunarElongation(jd float64) float64 {
+	return normalizeDegrees(MoonLongitude(jd) - SunLongitude(jd))
+}
+
+func tithiName(index int) string {
+	switch index {
+	case 14:
+		return "Purnima"
+	case 29:
+		return "Amavasya"
+	case 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28:
+		return "Krishna " + tithiNames[index-15]
+	default:
+		return "Shukla " + tithiNames[index]
+	}
+}
+
+func karanaName(index int) string {
+	switch index {
+	case 0:
+		return "Kimstughna"
+	case 57:
+		return "Shakuni"
+	case 58:
+		return "Chatushpada"
+	case 59:
+		return "Naga"
+	default:
+		return movableKaranaNames[(index-1)%len(movableKaranaNames)]
+	}
+}
+
+// TithiByNumber returns the tithi numbered n, counted from 1 for Shukla
+// Pratipada. Numbers outside 1-30 wrap around the lunar month, so that 31
+// and 1 are both Shukla Pratipada and 0 is Amavasya.
+func TithiByNumber(n int) Element {
+	return tithiSpec.element(n - 1)
+}
+
+// NakshatraByName returns the nakshatra with the given name.
+func NakshatraByName(name string) (Element, bool) {
+	for i, n := range nakshatraNames {
+		if n == name {
+			return nakshatraSpec.element(i), true
+		}
+	}
+	return Element{}, false
+}
+
+// ElementNames returns the distinct names an element takes, in the order
+// they first occur in its cycle.
+func ElementNames(kind ElementKind) []string {
+	if kind == VaraElement {
+		return append([]string(nil), varaNames...)
+	}
+	for _, spec := range elementSpecs {
+		if spec.kind != kind {
+			continue
+		}
+		var names []string
+		seen := map[string]bool{}
+		for i := 0; i < spec.count; i++ {
+			if name := spec.name(i); !seen[name] {
+				seen[name] = true
+				names = append(names, name)
+			}
+		}
+		return names
+	}
+	return nil
+}
+
+// CalculateElements returns the tithi, nakshatra, yoga and karana prevailing
+// at t.
+func CalculateElements(t time.Time, opts ...ElementOption) Elements {
+	p := newElementConfig(opts).positionsAt(JulianDayTT(t))
+	tithi, _ := tithiSpec.at(p)
+	nakshatra, _ := nakshatraSpec.at(p)
+	yoga, _ := yogaSpec.at(p)
+	karana, _ := karanaSpec.at(p)
+	return Elements{Tithi: tithi, Nakshatra: nakshatra, Yoga: yoga, Karana: karana}
+}
+
+// ElementPeriod is the interval during which an element keeps one value.
+type ElementPeriod struct {
+	Element
+	Start time.Time
+	End   time.Time
+}
+
+// elementSearchStep is the step, in days, by which element transitions are
+// bracketed. It is shorter than the shortest element, a karana of about
+// nine hours, so that no value is stepped over.
+const elementSearchStep = 1.0 / 12
+
+// DefaultElementPrecision is the precision to which element transitions
+// are located by default.
+const DefaultElementPrecision = time.Second
+
+// ElementOption configures the calculation of elements and their periods.
+type ElementOption func(*elementConfig)
+
+type elementConfig struct {
+	precision time.Duration
+	// observer is nil for the geocentric moon.
+	observer *Location
+	// ayanamsa is empty for the Lahiri ayanamsa.
+	ayanamsa Ayanamsa
+}
+
+func newElementConfig(opts []ElementOption) elementConfig {
+	c := elementConfig{precision: DefaultElementPrecision}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	return c
+}
+
+// WithPrecision sets the precision to which transitions are located, e.g.
+// time.Minute for a quicker search or time.Millisecond for a finer one.
+// Times are rounded to the second unless the precision is finer.
+func WithPrecision(precision time.Duration) ElementOption {
+	return func(c *elementConfig) {
+		if precision > 0 {
+			c.precision = precision
+		}
+	}
+}
+
+// WithObserver computes the elements from the topocentric position of the
+// moon seen from loc, including its elevation, instead of the geocentric
+// one. Parallax moves the moon by up to about a degree, so transitions can
+// shift by minutes or more; traditional panchangams use the geocentric moon.
+func WithObserver(loc Location) ElementOption {
+	return func(c *elementConfig) {
+		c.observer = &loc
+	}
+}
+
+// WithAyanamsa computes the sidereal elements, the nakshatra and the yoga,
+// with the ayanamsa a instead of the Lahiri ayanamsa. The tithi and karana
+// depend only on the elongation of the moon, so a leaves them unchanged.
+func WithAyanamsa(a Ayanamsa) ElementOption {
+	return func(c *elementConfig) {
+		c.ayanamsa = a
+	}
+}
+
+// CalculateElementPeriod returns the value of the element of kind
+// prevailing at t and when it starts and ends. The zero ElementPeriod is
+// returned for an unknown kind.
+//
+// Each transition is bracketed by stepping two hours at a time and then
+// located by Brent's method on the distance of the element's angle from the
+// boundary, which converges in a few evaluations of the sun and the moon.
+func CalculateElementPeriod(kind ElementKind, t time.Time, opts ...ElementOption) ElementPeriod {
+	c := newElementConfig(opts)
+	for _, spec := range elementSpecs {
+		if spec.kind == kind {
+			return spec.period(JulianDayTT(t), c)
+		}
+	}
+	return ElementPeriod{}
+}
+
+func (e elementSpec) period(jd float64, c elementConfig) ElementPeriod {
+	current, _ := e.at(c.positionsAt(jd))
+	index := current.Number - 1
+	return ElementPeriod{
+		Element: current,
+		Start:   e.transition(jd, index, -elementSearchStep, c),
+		End:     e.transition(jd, index+1, elementSearchStep, c),
+	}
+}
+
+// transition returns the instant the element's angle crosses the start of
+// the element of the given index, searching from jd in the direction of
+// step.
+func (e elementSpec) transition(jd float64, index int, step float64, c elementConfig) time.Time {
+	boundary := float64(index) * e.span()
+	// distance is the signed angle from the boundary, negative before the
+	// crossing, of the instant days after jd. Working relative to jd keeps
+	// the precision of float64 for the small offsets searched.
+	distance := func(days float64) float64 {
+		return normalizeDegrees(e.angle(c.positionsAt(jd+days))-boundary+180) - 180
+	}
+	inside, outside := 0.0, step
+	before := distance(inside) < 0
+	for (distance(outside) < 0) == before {
+		inside, outside = outside, outside+step
+	}
+	days := brent(distance, inside, outside, float64(c.precision)/float64(24*time.Hour))
+	return TimeFromJulianDayTT(jd + days).Round(min(c.precision, time.Second))
+}
+
+// DefaultBoundaryWindow is the distance from an element transition within
+// which the value of the element is considered uncertain.
+const DefaultBoundaryWindow = 2 * time.Minute
+
+// Boundary describes an element transition close to an instant. Because of
+// the uncertainty of the underlying ephemeris either Current or Adjacent may
+// be the true value at that instant.
+type Boundary struct {
+	Kind ElementKind
+	// Current is the value computed at the instant.
+	Current Element
+	// Adjacent is the value on the other side of the transition.
+	Adjacent Element
+	// Time is the estimated instant of the transition.
+	Time time.Time
+}
+
+// NearBoundaries returns the element transitions that lie within window of
+// t. Transition times are estimated from the angular speed at t.
+func NearBoundaries(t time.Time, window time.Duration) []Boundary {
+	jd := JulianDayTT(t)
+	p := positionsAt(jd)
+	before, after := positionsAt(jd-rateStep), positionsAt(jd+rateStep)
+	var boundaries []Boundary
+	for _, spec := range elementSpecs {
+		current, angle := spec.at(p)
+		rate := spec.rate(before, after)
+		if rate <= 0 {
+			continue
+		}
+		elapsed := math.Mod(angle, spec.span())
+		sinceStart := durationFromDays(elapsed / rate)
+		untilEnd := durationFromDays((spec.span() - elapsed) / rate)
+
+		switch {
+		case untilEnd <= window && untilEnd <= sinceStart:
+			boundaries = append(boundaries, Boundary{
+				Kind:     spec.kind,
+				Current:  current,
+				Adjacent: spec.element(current.Number),
+				Time:     t.Add(untilEnd),
+			})
+		case sinceStart <= window:
+			boundaries = append(boundaries, Boundary{
+				Kind:     spec.kind,
+				Current:  current,
+				Adjacent: spec.element(current.Number - 2),
+				Time:     t.Add(-sinceStart),
+			})
+		}
+	}
+	return boundaries
+}
+
+func durationFromDays(days float64) time.Duration {
+	return time.Duration(days * float64(24*time.Hour))
+}
+
+// padasPerNakshatra is the number of quarters (padas) of a nakshatra.
+const padasPerNakshatra = 4
+
+// padaSpec divides the moon's sidereal longitude into the 108 padas of the
+// nakshatras, 3°20' each.
+var padaSpec = elementSpec{
+	kind:  NakshatraElement,
+	count: len(nakshatraNames) * padasPerNakshatra,
+	angle: positions.siderealMoon,
+	name:  func(i int) string { return nakshatraNames[i/padasPerNakshatra] },
+}
+
+// NakshatraPada is one of the four quarters of a nakshatra and when it
+// starts and ends.
+type NakshatraPada struct {
+	Nakshatra Element
+	// Pada is the one-based quarter of the nakshatra, 1-4.
+	Pada  int
+	Start time.Time
+	End   time.Time
+}
+
+// CalculateNakshatraPada returns the pada prevailing at t and when it
+// starts and ends, to within a second unless configured otherwise.
+func CalculateNakshatraPada(t time.Time, opts ...ElementOption) NakshatraPada {
+	period := padaSpec.period(JulianDayTT(t), newElementConfig(opts))
+	index := period.Number - 1
+	return NakshatraPada{
+		Nakshatra: nakshatraSpec.element(index / padasPerNakshatra),
+		Pada:      index%padasPerNakshatra + 1,
+		Start:     period.Start,
+		End:       period.End,
+	}
+}
+
+// NakshatraPadas returns the padas overlapping the interval from start to
+// end in order, including those that started before it or end after it.
+func NakshatraPadas(start, end time.Time, opts ...ElementOption) []NakshatraPada {
+	var padas []NakshatraPada
+	for t := start; t.Before(end); {
+		pada := CalculateNakshatraPada(t, opts...)
+		padas = append(padas, pada)
+		// End is rounded to the second, so step past it.
+		t = pada.End.Add(time.Second)
+	}
+	return padas
+}
@@ -0,0 +1,257 @@
+package astronomy
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestCalculateElements(t *testing.T) {
+	tests := []struct {
+		name          string
+		t             time.Time
+		wantTithi     string
+		wantNakshatra string
+	}{
+		// Chaitra Purnima, tithi from 21:55 UTC on the 22nd; full moon at 23:49 UTC.
+		{"chaturdashi", time.Date(2024, 4, 22, 12, 0, 0, 0, time.UTC), "Shukla Chaturdashi", ""},
+		{"full moon sunrise", time.Date(2024, 4, 23, 0, 30, 0, 0, time.UTC), "Purnima", "Chitra"},
+		{"full moon", time.Date(2024, 4, 23, 23, 0, 0, 0, time.UTC), "Purnima", "Swati"},
+		// New moon at 18:21 UTC.
+		{"before new moon", time.Date(2024, 4, 8, 17, 0, 0, 0, time.UTC), "Amavasya", "Revati"},
+		{"after new moon", time.Date(2024, 4, 8, 20, 0, 0, 0, time.UTC), "Shukla Pratipada", "Revati"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculateElements(tt.t)
+			if got.Tithi.Name != tt.wantTithi {
+				t.Errorf("Tithi = %s, want %s", got.Tithi.Name, tt.wantTithi)
+			}
+			if tt.wantNakshatra != "" && got.Nakshatra.Name != tt.wantNakshatra {
+				t.Errorf("Nakshatra = %s, want %s", got.Nakshatra.Name, tt.wantNakshatra)
+			}
+		})
+	}
+}
+
+func TestElementNames(t *testing.T) {
+	for i := 0; i < 30; i++ {
+		if tithiName(i) == "" {
+			t.Errorf("tithiName(%d) is empty", i)
+		}
+	}
+	wantKarana := map[int]string{0: "Kimstughna", 1: "Bava", 7: "Vishti", 8: "Bava", 56: "Vishti", 57: "Shakuni", 59: "Naga"}
+	for i, want := range wantKarana {
+		if got := karanaName(i); got != want {
+			t.Errorf("karanaName(%d) = %s, want %s", i, got, want)
+		}
+	}
+
+	counts := map[ElementKind]int{TithiElement: 30, NakshatraElement: 27, YogaElement: 27, KaranaElement: 11}
+	for kind, want := range counts {
+		if got := len(ElementNames(kind)); got != want {
+			t.Errorf("len(ElementNames(%s)) = %d, want %d", kind, got, want)
+		}
+	}
+}
+
+func TestTithiByNumber(t *testing.T) {
+	for n, want := range map[int]string{1: "Shukla Pratipada", 15: "Purnima", 18: "Krishna Tritiya", 30: "Amavasya", 0: "Amavasya", 31: "Shukla Pratipada", -1: "Krishna Chaturdashi"} {
+		if got := TithiByNumber(n); got.Name != want || got.Kind != TithiElement {
+			t.Errorf("TithiByNumber(%d) = %v, want %s", n, got, want)
+		}
+	}
+}
+
+func TestNearBoundaries(t *testing.T) {
+	newMoon := time.Date(2024, 4, 8, 18, 21, 0, 0, time.UTC)
+
+	t.Run("far from boundaries", func(t *testing.T) {
+		got := NearBoundaries(time.Date(2024, 4, 15, 3, 0, 0, 0, time.UTC), time.Minute)
+		for _, b := range got {
+			if b.Kind == TithiElement {
+				t.Errorf("unexpected tithi boundary %+v", b)
+			}
+		}
+	})
+
+	for _, offset := range []time.Duration{-10 * time.Minute, 10 * time.Minute} {
+		at := newMoon.Add(offset)
+		got := NearBoundaries(at, 30*time.Minute)
+		var tithi *Boundary
+		for i := range got {
+			if got[i].Kind == TithiElement {
+				tithi = &got[i]
+			}
+		}
+		if tithi == nil {
+			t.Fatalf("NearBoundaries(%v) found no tithi boundary", at)
+		}
+		names := map[string]bool{tithi.Current.Name: true, tithi.Adjacent.Name: true}
+		if !names["Amavasya"] || !names["Shukla Pratipada"] {
+			t.Errorf("candidates = %s/%s, want Amavasya and Shukla Pratipada", tithi.Current.Name, tithi.Adjacent.Name)
+		}
+		if !withinMinutes(tithi.Time, newMoon, 5) {
+			t.Errorf("boundary time = %v, want about %v", tithi.Time, newMoon)
+		}
+	}
+}
+
+func TestCalculateElementPeriod(t *testing.T) {
+	at := time.Date(2024, 4, 30, 0, 40, 58, 0, time.UTC)
+	for _, kind := range []ElementKind{TithiElement, NakshatraElement, YogaElement, KaranaElement} {
+		p := CalculateElementPeriod(kind, at)
+		current := elementOf(kind, at)
+		if p.Element != current {
+			t.Errorf("%s period element = %v, want %v", kind, p.Element, current)
+		}
+		if p.Start.After(at) || !p.End.After(at) {
+			t.Errorf("%s period %v-%v does not contain %v", kind, p.Start, p.End, at)
+		}
+		// The value holds just inside the period and differs just outside.
+		if elementOf(kind, p.Start.Add(2*time.Second)) != current || elementOf(kind, p.End.Add(-2*time.Second)) != current {
+			t.Errorf("%s changes within its period %v-%v", kind, p.Start, p.End)
+		}
+		if elementOf(kind, p.Start.Add(-2*time.Second)) == current || elementOf(kind, p.End.Add(2*time.Second)) == current {
+			t.Errorf("%s does not change at the bounds of its period %v-%v", kind, p.Start, p.End)
+		}
+	}
+	if p := CalculateElementPeriod("unknown", at); p != (ElementPeriod{}) {
+		t.Errorf("CalculateElementPeriod(unknown) = %v, want the zero period", p)
+	}
+}
+
+func TestWithAyanamsa(t *testing.T) {
+	at := time.Date(2024, 4, 30, 0, 40, 58, 0, time.UTC)
+	lahiri := CalculateElementPeriod(NakshatraElement, at)
+	// The Raman ayanamsa is smaller, so its nakshatras change hours earlier.
+	justBefore := lahiri.End.Add(-time.Minute)
+	raman := CalculateElementPeriod(NakshatraElement, justBefore, WithAyanamsa(Raman))
+	if raman.Number != lahiri.Number%27+1 {
+		t.Fatalf("Raman nakshatra at %v = %s, want the one after %s", justBefore, raman.Name, lahiri.Name)
+	}
+	if lead := lahiri.End.Sub(raman.Start); lead < time.Hour || lead > 5*time.Hour {
+		t.Errorf("Raman nakshatra starts %v before the Lahiri one, want a few hours", lead)
+	}
+	if got, want := CalculateElements(at, WithAyanamsa(Raman)).Tithi, CalculateElements(at).Tithi; got != want {
+		t.Errorf("Raman tithi = %v, want %v as with Lahiri", got, want)
+	}
+	if _, err := ParseAyanamsa("fagan"); err == nil {
+		t.Error("ParseAyanamsa(fagan) succeeded, want an error")
+	}
+}
+
+func TestCalculateElementPeriodPrecision(t *testing.T) {
+	at := time.Date(2024, 4, 30, 0, 40, 58, 0, time.UTC)
+	for _, precision := range []time.Duration{time.Minute, time.Second, 10 * time.Millisecond} {
+		for _, kind := range []ElementKind{TithiElement, NakshatraElement, YogaElement, KaranaElement} {
+			p := CalculateElementPeriod(kind, at, WithPrecision(precision))
+			current := elementOf(kind, at)
+			// Rounding to the second adds up to half a second.
+			margin := precision + precision/2
+			if precision < time.Second {
+				margin = 2 * precision
+			}
+			if elementOf(kind, p.End.Add(-margin)) != current || elementOf(kind, p.End.Add(margin)) == current {
+				t.Errorf("%s ends at %v, not within %v of the transition", kind, p.End, precision)
+			}
+			if elementOf(kind, p.Start.Add(margin)) != current || elementOf(kind, p.Start.Add(-margin)) == current {
+				t.Errorf("%s starts at %v, not within %v of the transition", kind, p.Start, precision)
+			}
+		}
+	}
+}
+
+func TestBrent(t *testing.T) {
+	tests := []struct {
+		name string
+		f    func(float64) float64
+		a, b float64
+		want float64
+	}{
+		{"linear", func(x float64) float64 { return 2*x - 1 }, 0, 3, 0.5},
+		{"cubic", func(x float64) float64 { return x*x*x - 2*x - 5 }, 2, 3, 2.0945514815423265},
+		{"reversed bracket", func(x float64) float64 { return math.Cos(x) }, 3, 0, math.Pi / 2},
+		{"root at bound", func(x float64) float64 { return x - 1 }, 1, 2, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			evaluations := 0
+			f := func(x float64) float64 {
+				evaluations++
+				return tt.f(x)
+			}
+			if got := brent(f, tt.a, tt.b, 1e-9); math.Abs(got-tt.want) > 1e-9 {
+				t.Errorf("brent() = %v, want %v", got, tt.want)
+			}
+			// Bisection needs 32 evaluations for this precision.
+			if evaluations > 20 {
+				t.Errorf("brent() evaluated f %d times", evaluations)
+			}
+		})
+	}
+}
+
+func elementOf(kind ElementKind, t time.Time) Element {
+	e := CalculateElements(t)
+	switch kind {
+	case TithiElement:
+		return e.Tithi
+	case NakshatraElement:
+		return e.Nakshatra
+	case YogaElement:
+		return e.Yoga
+	default:
+		return e.Karana
+	}
+}
+
+func TestNakshatraPadas(t *testing.T) {
+	start := time.Date(2024, 4, 30, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 3)
+	padas := NakshatraPadas(start, end)
+	// The moon crosses a pada of 3°20' in 5 to 7 hours.
+	if len(padas) < 10 || len(padas) > 15 {
+		t.Fatalf("NakshatraPadas() returned %d padas over 3 days", len(padas))
+	}
+	if padas[0].Start.After(start) || !padas[len(padas)-1].End.After(end) {
+		t.Errorf("padas cover %v-%v, want at least %v-%v", padas[0].Start, padas[len(padas)-1].End, start, end)
+	}
+	for i, p := range padas {
+		if p.Pada < 1 || p.Pada > 4 {
+			t.Errorf("padas[%d].Pada = %d", i, p.Pada)
+		}
+		if length := p.End.Sub(p.Start); length < 4*time.Hour || length > 8*time.Hour {
+			t.Errorf("pada %d of %s lasts %v", p.Pada, p.Nakshatra.Name, length)
+		}
+		// The pada agrees with the nakshatra in its middle.
+		middle := p.Start.Add(p.End.Sub(p.Start) / 2)
+		if got := CalculateElements(middle).Nakshatra; got != p.Nakshatra {
+			t.Errorf("pada %d of %s has nakshatra %s in its middle", p.Pada, p.Nakshatra.Name, got.Name)
+		}
+		if i == 0 {
+			continue
+		}
+		prev := padas[i-1]
+		if d := p.Start.Sub(prev.End); d < -time.Second || d > time.Second {
+			t.Errorf("pada %d of %s starts at %v, %v after the previous one ends", p.Pada, p.Nakshatra.Name, p.Start, d)
+		}
+		next := prev.Pada%4 + 1
+		if p.Pada != next || (next == 1) == (p.Nakshatra == prev.Nakshatra) {
+			t.Errorf("pada %d of %s follows pada %d of %s", p.Pada, p.Nakshatra.Name, prev.Pada, prev.Nakshatra.Name)
+		}
+	}
+
+	// The first pada of a nakshatra starts with it and the fourth ends
+	// with it.
+	for _, p := range padas {
+		nakshatra := CalculateElementPeriod(NakshatraElement, p.Start.Add(time.Minute))
+		if p.Pada == 1 && p.Start.Sub(nakshatra.Start).Abs() > time.Second {
+			t.Errorf("pada 1 of %s starts at %v, the nakshatra at %v", p.Nakshatra.Name, p.Start, nakshatra.Start)
+		}
+		if p.Pada == 4 && p.End.Sub(nakshatra.End).Abs() > time.Second {
+			t.Errorf("pada 4 of %s ends at %v, the nakshatra at %v", p.Nakshatra.Name, p.End, nakshatra.End)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package astronomy_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func ExampleCalculateSunTimes() {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	mumbai := astronomy.Location{Latitude: 19.0760, Longitude: 72.8777}
+	sunTimes, err := astronomy.CalculateSunTimes(mumbai, time.Date(2024, 4, 30, 0, 0, 0, 0, ist))
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	fmt.Println("sunrise", sunTimes.Sunrise.Format("15:04:05"))
+	fmt.Println("sunset", sunTimes.Sunset.Format("15:04:05"))
+	// Output:
+	// sunrise 06:10:58
+	// sunset 19:00:37
+}
+
+func ExampleCalculateElements() {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	e := astronomy.CalculateElements(time.Date(2024, 4, 30, 6, 11, 0, 0, ist))
+	fmt.Println(e.Tithi.Name, "/", e.Nakshatra.Name, "/", e.Yoga.Name, "/", e.Karana.Name)
+	// Output:
+	// Krishna Shashthi / Uttara Ashadha / Sadhya / Vanija
+}
+
+func ExampleAbhijitMuhurta() {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	sunrise := time.Date(2024, 4, 30, 6, 0, 0, 0, ist)
+	sunset := time.Date(2024, 4, 30, 19, 0, 0, 0, ist)
+	abhijit := astronomy.AbhijitMuhurta(sunrise, sunset)
+	fmt.Println(abhijit.Name, abhijit.Start.Format("15:04"), "-", abhijit.End.Format("15:04"))
+	// Output:
+	// Abhijit 12:04 - 12:56
+}
@@ -0,0 +1,41 @@
+package astronomy
+
+import "time"
+
+// horaLords are the planets ruling the horas in the order in which they
+// follow one another, from the Sun.
+var horaLords = []string{"Sun", "Venus", "Mercury", "Moon", "Saturn", "Jupiter", "Mars"}
+
+// horaStart is the index into horaLords of the lord of each weekday from
+// Sunday, which rules the first hora of its day.
+var horaStart = [7]int{0, 3, 6, 2, 5, 1, 4}
+
+// horasPerHalf is the number of horas between sunrise and sunset, and
+// between sunset and the next sunrise.
+const horasPerHalf = 12
+
+// Horas returns the 24 horas of the day starting at sunrise, named after
+// their ruling planets: twelve equal parts of the time from sunrise to
+// sunset and twelve of the time from sunset to the next sunrise. The first
+// is ruled by the lord of the weekday of sunrise, so that the horas run on
+// unbroken from one day to the next.
+func Horas(sunrise, sunset, nextSunrise time.Time) []Period {
+	first := horaStart[sunrise.Weekday()]
+	periods := make([]Period, 0, 2*horasPerHalf)
+	for half, bounds := range [2][2]time.Time{{sunrise, sunset}, {sunset, nextSunrise}} {
+		start, end := bounds[0], bounds[1]
+		part := end.Sub(start) / horasPerHalf
+		for i := 0; i < horasPerHalf; i++ {
+			periodEnd := start.Add(part * time.Duration(i+1))
+			if i == horasPerHalf-1 {
+				periodEnd = end
+			}
+			periods = append(periods, Period{
+				Name:  horaLords[(first+half*horasPerHalf+i)%len(horaLords)],
+				Start: start.Add(part * time.Duration(i)),
+				End:   periodEnd,
+			})
+		}
+	}
+	return periods
+}
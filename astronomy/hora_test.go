@@ -0,0 +1,32 @@
+package astronomy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHoras(t *testing.T) {
+	// Sunday, 28 April 2024.
+	sunrise := time.Date(2024, 4, 28, 6, 0, 0, 0, time.UTC)
+	sunset := sunrise.Add(12*time.Hour + 24*time.Minute)
+	nextSunrise := sunrise.Add(24 * time.Hour)
+	horas := Horas(sunrise, sunset, nextSunrise)
+	if len(horas) != 24 {
+		t.Fatalf("Horas() returned %d horas, want 24", len(horas))
+	}
+	for i, want := range map[int]string{0: "Sun", 1: "Venus", 6: "Mars", 7: "Sun", 12: "Jupiter", 23: "Mercury"} {
+		if horas[i].Name != want {
+			t.Errorf("hora %d = %s, want %s", i+1, horas[i].Name, want)
+		}
+	}
+	if got := horas[0].End.Sub(horas[0].Start); got != 62*time.Minute {
+		t.Errorf("day hora lasts %v, want 62m", got)
+	}
+	if !horas[11].End.Equal(sunset) || !horas[12].Start.Equal(sunset) || !horas[23].End.Equal(nextSunrise) {
+		t.Errorf("horas do not meet at sunset and the next sunrise")
+	}
+	// The hora following the last of Sunday is the first of Monday.
+	if next := Horas(nextSunrise, nextSunrise.Add(12*time.Hour), nextSunrise.Add(24*time.Hour)); next[0].Name != "Moon" {
+		t.Errorf("first hora of Monday = %s, want Moon", next[0].Name)
+	}
+}
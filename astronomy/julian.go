@@ -0,0 +1,44 @@
+package astronomy
+
+import (
+	"math"
+	"time"
+)
+
+// J2000 is the Julian day of the J2000.0 epoch (2000-01-01 12:00 TT).
+const J2000 = 2451545.0
+
+// unixEpochJD is the Julian day of 1970-01-01 00:00 UTC.
+const unixEpochJD = 2440587.5
+
+const (
+	deg2rad = math.Pi / 180
+	rad2deg = 180 / math.Pi
+)
+
+// JulianDay returns the Julian day number for the given instant on the UTC
+// scale. The positions of the sun and the moon take JulianDayTT instead,
+// and the sidereal time JulianDayUT1.
+func JulianDay(t time.Time) float64 {
+	return unixEpochJD + float64(t.UnixNano())/float64(24*time.Hour)
+}
+
+// TimeFromJulianDay converts a Julian day number on the UTC scale back to a
+// UTC time.
+func TimeFromJulianDay(jd float64) time.Time {
+	ns := (jd - unixEpochJD) * float64(24*time.Hour)
+	return time.Unix(0, int64(math.Round(ns))).UTC()
+}
+
+// normalizeDegrees maps an angle into the range [0, 360).
+func normalizeDegrees(d float64) float64 {
+	d = math.Mod(d, 360)
+	if d < 0 {
+		d += 360
+	}
+	return d
+}
+
+func sinDeg(d float64) float64 { return math.Sin(d * deg2rad) }
+func cosDeg(d float64) float64 { return math.Cos(d * deg2rad) }
+func tanDeg(d float64) float64 { return math.Tan(d * deg2rad) }
@@ -0,0 +1,94 @@
+package astronomy
+
+import (
+	"math"
+	"time"
+)
+
+// lagnaStep is the interval at which the rising sign is sampled to find
+// its changes. Each sign takes at least about half an hour to rise outside
+// the polar circles.
+const lagnaStep = 4 * time.Minute
+
+// Lagna is the sidereal sign rising on the eastern horizon during a period.
+type Lagna struct {
+	// Number is the one-based sign, Mesha = 1.
+	Number int
+	// Rashi is the name of the sign, e.g. "Mesha".
+	Rashi string
+	Start time.Time
+	End   time.Time
+}
+
+// LagnaCalculator computes the ascendant and the rising signs at a
+// location.
+type LagnaCalculator struct {
+	loc Location
+}
+
+// NewLagnaCalculator returns a calculator for the observer at loc.
+func NewLagnaCalculator(loc Location) *LagnaCalculator {
+	return &LagnaCalculator{loc: loc}
+}
+
+// Ascendant returns the sidereal longitude in degrees of the point of the
+// ecliptic rising on the eastern horizon at t, Meeus, Astronomical
+// Algorithms, equation 14.2, from the local sidereal time and the Lahiri
+// ayanamsa.
+func (c *LagnaCalculator) Ascendant(t time.Time) float64 {
+	jd := JulianDayTT(t)
+	localSiderealTime := SiderealTime(ut1(jd)) + c.loc.Longitude
+	obliquity := meanObliquity(jd)
+	tropical := math.Atan2(cosDeg(localSiderealTime),
+		-(sinDeg(localSiderealTime)*cosDeg(obliquity)+math.Tan(c.loc.Latitude*deg2rad)*sinDeg(obliquity))) * rad2deg
+	return SiderealLongitude(tropical, jd)
+}
+
+// sign returns the zero-based sidereal sign rising at t.
+func (c *LagnaCalculator) sign(t time.Time) int {
+	return int(c.Ascendant(t)/30) % 12
+}
+
+// LagnaAt returns the lagna prevailing at t with its start and end.
+func (c *LagnaCalculator) LagnaAt(t time.Time) Lagna {
+	sign := c.sign(t)
+	before := t
+	for c.sign(before) == sign {
+		before = before.Add(-lagnaStep)
+	}
+	after := t
+	for c.sign(after) == sign {
+		after = after.Add(lagnaStep)
+	}
+	return Lagna{Number: sign + 1, Rashi: rashiNames[sign], Start: c.transition(before, t), End: c.transition(t, after)}
+}
+
+// Lagnas returns the lagnas prevailing from start to end in order, the
+// first starting at or before start and the last ending after end.
+func (c *LagnaCalculator) Lagnas(start, end time.Time) []Lagna {
+	current := c.LagnaAt(start)
+	lagnas := []Lagna{current}
+	for current.End.Before(end) {
+		current = c.LagnaAt(current.End.Add(time.Second))
+		lagnas = append(lagnas, current)
+	}
+	return lagnas
+}
+
+// transition returns the first whole second between lo and hi, which rise
+// in different signs, at which the rising sign is no longer that of lo.
+// Working in whole seconds makes a transition the same however it is
+// bracketed.
+func (c *LagnaCalculator) transition(lo, hi time.Time) time.Time {
+	sign := c.sign(lo)
+	first, last := lo.Unix(), hi.Unix()+1
+	for last-first > 1 {
+		mid := first + (last-first)/2
+		if c.sign(time.Unix(mid, 0)) == sign {
+			first = mid
+		} else {
+			last = mid
+		}
+	}
+	return time.Unix(last, 0).In(lo.Location())
+}
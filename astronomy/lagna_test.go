@@ -0,0 +1,47 @@
+package astronomy
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLagnaCalculator(t *testing.T) {
+	delhi := Location{Latitude: 28.6139, Longitude: 77.2090}
+	ist := time.FixedZone("IST", 5*3600+1800)
+	sunTimes, err := CalculateSunTimes(delhi, time.Date(2024, 1, 30, 0, 0, 0, 0, ist))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewLagnaCalculator(delhi)
+
+	// The sun rises with the ascendant.
+	jd := JulianDayTT(sunTimes.Sunrise)
+	sun := SiderealLongitude(SunLongitude(jd), jd)
+	if d := math.Abs(c.Ascendant(sunTimes.Sunrise) - sun); d > 1.5 {
+		t.Errorf("ascendant at sunrise is %.2f° from the sun", d)
+	}
+
+	day := time.Date(2024, 1, 30, 0, 0, 0, 0, ist)
+	lagnas := c.Lagnas(day, day.AddDate(0, 0, 1))
+	if len(lagnas) < 12 || len(lagnas) > 13 {
+		t.Fatalf("Lagnas() over a day = %d lagnas, want 12 or 13", len(lagnas))
+	}
+	for i, l := range lagnas {
+		if d := l.End.Sub(l.Start); d < 80*time.Minute || d > 160*time.Minute {
+			t.Errorf("lagna %d %s lasts %v", i, l.Rashi, d)
+		}
+		if i == 0 {
+			continue
+		}
+		if prev := lagnas[i-1]; l.Start != prev.End || l.Number != prev.Number%12+1 {
+			t.Errorf("lagna %d %s from %v does not follow %s until %v", i, l.Rashi, l.Start, prev.Rashi, prev.End)
+		}
+	}
+	if first := lagnas[0]; first.Start.After(day) || !first.End.After(day) {
+		t.Errorf("first lagna %v - %v does not contain %v", first.Start, first.End, day)
+	}
+	if at := c.LagnaAt(sunTimes.Sunrise); at.Rashi != "Makara" {
+		t.Errorf("LagnaAt(sunrise) = %s, want Makara, the sign of the sun", at.Rashi)
+	}
+}
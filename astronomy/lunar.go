@@ -0,0 +1,247 @@
+package astronomy
+
+import "math"
+
+// lunarTerm is one periodic term of the moon's longitude: the multiples of
+// the mean elongation D, the solar anomaly M, the lunar anomaly M' and the
+// argument of latitude F, and the amplitude in millionths of a degree.
+type lunarTerm struct {
+	d, m, mp, f int
+	amplitude   float64
+}
+
+// Largest periodic terms of the moon's longitude, Meeus table 47.A.
+var lunarLongitudeTerms = []lunarTerm{
+	{0, 0, 1, 0, 6288774},
+	{2, 0, -1, 0, 1274027},
+	{2, 0, 0, 0, 658314},
+	{0, 0, 2, 0, 213618},
+	{0, 1, 0, 0, -185116},
+	{0, 0, 0, 2, -114332},
+	{2, 0, -2, 0, 58793},
+	{2, -1, -1, 0, 57066},
+	{2, 0, 1, 0, 53322},
+	{2, -1, 0, 0, 45758},
+	{0, 1, -1, 0, -40923},
+	{1, 0, 0, 0, -34720},
+	{0, 1, 1, 0, -30383},
+	{2, 0, 0, -2, 15327},
+	{0, 0, 1, 2, -12528},
+	{0, 0, 1, -2, 10980},
+	{4, 0, -1, 0, 10675},
+	{0, 0, 3, 0, 10034},
+	{4, 0, -2, 0, 8548},
+	{2, 1, -1, 0, -7888},
+	{2, 1, 0, 0, -6766},
+	{1, 0, -1, 0, -5163},
+	{1, 1, 0, 0, 4987},
+	{2, -1, 1, 0, 4036},
+	{2, 0, 2, 0, 3994},
+	{4, 0, 0, 0, 3861},
+	{2, 0, -3, 0, 3665},
+	{0, 1, -2, 0, -2689},
+	{2, 0, -1, 2, -2602},
+	{2, -1, -2, 0, 2390},
+	{1, 0, 1, 0, -2348},
+	{2, -2, 0, 0, 2236},
+}
+
+// Largest periodic terms of the moon's latitude, Meeus table 47.B.
+var lunarLatitudeTerms = []lunarTerm{
+	{0, 0, 0, 1, 5128122},
+	{0, 0, 1, 1, 280602},
+	{0, 0, 1, -1, 277693},
+	{2, 0, 0, -1, 173237},
+	{2, 0, -1, 1, 55413},
+	{2, 0, -1, -1, 46271},
+	{2, 0, 0, 1, 32573},
+	{0, 0, 2, 1, 17198},
+	{2, 0, 1, -1, 9266},
+	{0, 0, 2, -1, 8822},
+	{2, -1, 0, -1, 8216},
+	{2, 0, -2, -1, 4324},
+	{2, 0, 1, 1, 4200},
+	{2, 1, 0, -1, -3359},
+	{2, -1, -1, 1, 2463},
+	{2, -1, 0, 1, 2211},
+	{2, -1, -1, -1, 2065},
+	{0, 1, -1, -1, -1870},
+	{4, 0, -1, -1, 1828},
+	{0, 1, 0, 1, -1794},
+}
+
+// Largest periodic terms of the distance between the centres of the Earth
+// and the moon, Meeus table 47.A, in metres.
+var lunarDistanceTerms = []lunarTerm{
+	{0, 0, 1, 0, -20905355},
+	{2, 0, -1, 0, -3699111},
+	{2, 0, 0, 0, -2955968},
+	{0, 0, 2, 0, -569925},
+	{0, 1, 0, 0, 48888},
+	{0, 0, 0, 2, -3149},
+	{2, 0, -2, 0, 246158},
+	{2, -1, -1, 0, -152138},
+	{2, 0, 1, 0, -170733},
+	{2, -1, 0, 0, -204586},
+	{0, 1, -1, 0, -129620},
+	{1, 0, 0, 0, 108743},
+	{0, 1, 1, 0, 104755},
+	{2, 0, 0, -2, 10321},
+	{0, 0, 1, -2, 79661},
+	{4, 0, -1, 0, -34782},
+	{0, 0, 3, 0, -23210},
+	{4, 0, -2, 0, -21636},
+	{2, 1, -1, 0, 24208},
+	{2, 1, 0, 0, 30824},
+	{1, 0, -1, 0, -8379},
+	{1, 1, 0, 0, -16675},
+	{2, -1, 1, 0, -12831},
+	{2, 0, 2, 0, -10445},
+	{4, 0, 0, 0, -11650},
+	{2, 0, -3, 0, 14403},
+	{0, 1, -2, 0, -7003},
+	{2, -1, -2, 0, 10056},
+	{1, 0, 1, 0, 6322},
+	{2, -2, 0, 0, -9884},
+}
+
+// lunarArguments are the fundamental arguments of the lunar theory at an
+// instant, in degrees.
+type lunarArguments struct {
+	t                float64
+	meanLongitude    float64
+	elongation       float64
+	sunAnomaly       float64
+	moonAnomaly      float64
+	latitudeArgument float64
+	// eccentricity holds the powers 0 to 2 of the decreasing eccentricity
+	// of the Earth's orbit, which scales the terms in M.
+	eccentricity [3]float64
+	// multiples holds exp(ik·X), the cosine and sine of k times each
+	// argument X as a complex number, for the multiples k of D, M, M' and F
+	// the series use, offset by lunarMultipleOffset.
+	multiples [4][2*lunarMultipleOffset + 1]complex128
+}
+
+// lunarMultipleOffset is the largest multiple of an argument in the series.
+const lunarMultipleOffset = 4
+
+func lunarArgumentsAt(jd float64) lunarArguments {
+	t := (jd - J2000) / 36525
+	t2 := t * t
+	t3 := t2 * t
+	t4 := t3 * t
+	e := 1 - 0.002516*t - 0.0000074*t2
+	a := lunarArguments{
+		t:                t,
+		meanLongitude:    218.3164477 + 481267.88123421*t - 0.0015786*t2 + t3/538841 - t4/65194000,
+		elongation:       297.8501921 + 445267.1114034*t - 0.0018819*t2 + t3/545868 - t4/113065000,
+		sunAnomaly:       357.5291092 + 35999.0502909*t - 0.0001536*t2 + t3/24490000,
+		moonAnomaly:      134.9633964 + 477198.8675055*t + 0.0087414*t2 + t3/69699 - t4/14712000,
+		latitudeArgument: 93.2720950 + 483202.0175233*t - 0.0036539*t2 - t3/3526000 + t4/863310000,
+		eccentricity:     [3]float64{1, e, e * e},
+	}
+	for i, x := range [4]float64{a.elongation, a.sunAnomaly, a.moonAnomaly, a.latitudeArgument} {
+		sin, cos := math.Sincos(x * deg2rad)
+		m := &a.multiples[i]
+		m[lunarMultipleOffset] = 1
+		for k := 1; k <= lunarMultipleOffset; k++ {
+			m[lunarMultipleOffset+k] = m[lunarMultipleOffset+k-1] * complex(cos, sin)
+			m[lunarMultipleOffset-k] = complex(real(m[lunarMultipleOffset+k]), -imag(m[lunarMultipleOffset+k]))
+		}
+	}
+	return a
+}
+
+// lunarSeries is a table of periodic terms laid out as parallel arrays of
+// indexes into lunarArguments.multiples and scaled amplitudes, so that it is
+// evaluated by a loop of multiplications without trigonometric calls or
+// branches.
+type lunarSeries struct {
+	d, m, mp, f []uint8
+	// power is the power of the eccentricity scaling each term.
+	power     []uint8
+	amplitude []float64
+}
+
+func newLunarSeries(terms []lunarTerm) lunarSeries {
+	s := lunarSeries{
+		d:         make([]uint8, len(terms)),
+		m:         make([]uint8, len(terms)),
+		mp:        make([]uint8, len(terms)),
+		f:         make([]uint8, len(terms)),
+		power:     make([]uint8, len(terms)),
+		amplitude: make([]float64, len(terms)),
+	}
+	for i, term := range terms {
+		s.d[i] = uint8(term.d + lunarMultipleOffset)
+		s.m[i] = uint8(term.m + lunarMultipleOffset)
+		s.mp[i] = uint8(term.mp + lunarMultipleOffset)
+		s.f[i] = uint8(term.f + lunarMultipleOffset)
+		s.power[i] = uint8(max(term.m, -term.m))
+		s.amplitude[i] = term.amplitude
+	}
+	return s
+}
+
+var (
+	lunarLongitudeSeries = newLunarSeries(lunarLongitudeTerms)
+	lunarLatitudeSeries  = newLunarSeries(lunarLatitudeTerms)
+	lunarDistanceSeries  = newLunarSeries(lunarDistanceTerms)
+)
+
+// sum evaluates a series, returning the sums of its amplitudes times the
+// sine and times the cosine of the argument of each term. The argument is
+// never formed: its exponential is the product of the precomputed
+// multiples.
+func (a *lunarArguments) sum(s *lunarSeries) (sin, cos float64) {
+	d, m, mp, f := &a.multiples[0], &a.multiples[1], &a.multiples[2], &a.multiples[3]
+	for i, amplitude := range s.amplitude {
+		z := d[s.d[i]] * m[s.m[i]] * mp[s.mp[i]] * f[s.f[i]]
+		amplitude *= a.eccentricity[s.power[i]]
+		sin += amplitude * imag(z)
+		cos += amplitude * real(z)
+	}
+	return sin, cos
+}
+
+// MoonLongitude returns the apparent tropical ecliptic longitude of the moon
+// in degrees for the Julian Ephemeris Day jd, e.g. of JulianDayTT. It
+// evaluates the largest terms of the ELP-2000/82 series as tabulated by
+// Meeus, which is good to a few hundredths of a degree.
+func MoonLongitude(jd float64) float64 {
+	a := lunarArgumentsAt(jd)
+	sum, _ := a.sum(&lunarLongitudeSeries)
+
+	// Additive terms due to Venus, Jupiter and the flattening of the Earth.
+	a1 := 119.75 + 131.849*a.t
+	a2 := 53.09 + 479264.290*a.t
+	sum += 3958*sinDeg(a1) + 1962*sinDeg(a.meanLongitude-a.latitudeArgument) + 318*sinDeg(a2)
+
+	// Nutation in longitude, to match the apparent solar longitude.
+	omega := 125.04 - 1934.136*a.t
+	return normalizeDegrees(a.meanLongitude + sum/1e6 - 0.00478*sinDeg(omega))
+}
+
+// MoonLatitude returns the ecliptic latitude of the moon in degrees for the
+// Julian Ephemeris Day jd, from the largest terms of Meeus table 47.B.
+func MoonLatitude(jd float64) float64 {
+	a := lunarArgumentsAt(jd)
+	sum, _ := a.sum(&lunarLatitudeSeries)
+
+	// Additive terms due to Venus, Jupiter and the flattening of the Earth.
+	a1 := 119.75 + 131.849*a.t
+	a3 := 313.45 + 481266.484*a.t
+	sum += -2235*sinDeg(a.meanLongitude) + 382*sinDeg(a3) +
+		175*sinDeg(a1-a.latitudeArgument) + 175*sinDeg(a1+a.latitudeArgument) +
+		127*sinDeg(a.meanLongitude-a.moonAnomaly) - 115*sinDeg(a.meanLongitude+a.moonAnomaly)
+	return sum / 1e6
+}
+
+// MoonDistance returns the distance between the centres of the Earth and the
+// moon in kilometres for the Julian Ephemeris Day jd.
+func MoonDistance(jd float64) float64 {
+	a := lunarArgumentsAt(jd)
+	_, sum := a.sum(&lunarDistanceSeries)
+	return 385000.56 + sum/1000
+}
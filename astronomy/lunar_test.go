@@ -0,0 +1,47 @@
+package astronomy
+
+import (
+	"math"
+	"testing"
+)
+
+// directSum evaluates terms one by one from the argument of each, as the
+// series are written in Meeus.
+func directSum(a lunarArguments, terms []lunarTerm, trig func(float64) float64) float64 {
+	var sum float64
+	for _, term := range terms {
+		arg := float64(term.d)*a.elongation + float64(term.m)*a.sunAnomaly +
+			float64(term.mp)*a.moonAnomaly + float64(term.f)*a.latitudeArgument
+		sum += term.amplitude * math.Pow(a.eccentricity[1], math.Abs(float64(term.m))) * trig(arg)
+	}
+	return sum
+}
+
+func TestLunarSeries(t *testing.T) {
+	series := []struct {
+		name   string
+		terms  []lunarTerm
+		series *lunarSeries
+		cosine bool
+	}{
+		{"longitude", lunarLongitudeTerms, &lunarLongitudeSeries, false},
+		{"latitude", lunarLatitudeTerms, &lunarLatitudeSeries, false},
+		{"distance", lunarDistanceTerms, &lunarDistanceSeries, true},
+	}
+	// Every 10.3 days from 1900 to 2100.
+	for jd := 2415020.5; jd < 2488070.5; jd += 10.3 {
+		a := lunarArgumentsAt(jd)
+		for _, s := range series {
+			sin, cos := a.sum(s.series)
+			got, want := sin, directSum(a, s.terms, sinDeg)
+			if s.cosine {
+				got, want = cos, directSum(a, s.terms, cosDeg)
+			}
+			// Amplitudes are in millionths of a degree or in metres, so
+			// this is a microdegree or a millimetre.
+			if math.Abs(got-want) > 1e-3 {
+				t.Fatalf("%s series at JD %.1f = %f, want %f", s.name, jd, got, want)
+			}
+		}
+	}
+}
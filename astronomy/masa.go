@@ -0,0 +1,163 @@
+package astronomy
+
+import (
+	"math"
+	"time"
+)
+
+// Names of the lunar months, starting with Chaitra.
+var masaNames = []string{
+	"Chaitra", "Vaishakha", "Jyeshtha", "Ashadha", "Shravana", "Bhadrapada",
+	"Ashwin", "Kartika", "Margashirsha", "Pausha", "Magha", "Phalguna",
+}
+
+// Masa is a lunar month.
+type Masa struct {
+	// Number is the position of the month starting from Chaitra = 1.
+	Number int
+	Name   string
+	// Adhika marks an intercalary month, during which the sun does not
+	// change sign. It shares its name with the month that follows it.
+	Adhika bool
+	// Kshaya marks a month during which the sun changes sign twice. The
+	// name of the month after it is skipped that year.
+	Kshaya bool
+}
+
+// Paksha is a lunar fortnight: the waxing Shukla or the waning Krishna.
+type Paksha string
+
+const (
+	ShuklaPaksha  Paksha = "shukla"
+	KrishnaPaksha Paksha = "krishna"
+)
+
+// TithiPaksha returns the fortnight of a tithi: Shukla for tithis 1-15
+// (ending with Purnima) and Krishna for 16-30 (ending with Amavasya).
+func TithiPaksha(tithi Element) Paksha {
+	if tithi.Number > 15 {
+		return KrishnaPaksha
+	}
+	return ShuklaPaksha
+}
+
+// MasaByName returns the lunar month with the given name.
+func MasaByName(name string) (Masa, bool) {
+	for i, n := range masaNames {
+		if n == name {
+			return Masa{Number: i + 1, Name: n}, true
+		}
+	}
+	return Masa{}, false
+}
+
+// MasaNames returns the names of the twelve lunar months from Chaitra.
+func MasaNames() []string {
+	return append([]string(nil), masaNames...)
+}
+
+// meanSynodicMonth is the mean time between two new moons in days.
+const meanSynodicMonth = 29.530588853
+
+// PreviousNewMoon returns the last new moon at or before t.
+func PreviousNewMoon(t time.Time) time.Time {
+	jd := JulianDayTT(t)
+	return TimeFromJulianDayTT(previousNewMoon(jd, lunarElongation(jd)))
+}
+
+// NextNewMoon returns the first new moon after t.
+func NextNewMoon(t time.Time) time.Time {
+	jd := JulianDayTT(t)
+	return TimeFromJulianDayTT(nextNewMoon(jd, lunarElongation(jd)))
+}
+
+func previousNewMoon(jd, elongation float64) float64 {
+	return refineNewMoon(jd - elongation/360*meanSynodicMonth)
+}
+
+func nextNewMoon(jd, elongation float64) float64 {
+	return refineNewMoon(jd + (360-elongation)/360*meanSynodicMonth)
+}
+
+// newMoonTolerance is the elongation, in degrees, below which a new moon
+// estimate is accepted. The moon gains it on the sun in well under a
+// second.
+const newMoonTolerance = 1e-5
+
+// refineNewMoon improves an estimate of the instant of a new moon with
+// Newton's method on the lunar elongation. The elongation rate barely
+// changes over the correction, so it is estimated once.
+func refineNewMoon(jd float64) float64 {
+	before, after := positionsAt(jd-rateStep), positionsAt(jd+rateStep)
+	rate := tithiSpec.rate(before, after)
+	for i := 0; i < 10; i++ {
+		offset := normalizeDegrees(lunarElongation(jd)+180) - 180
+		jd -= offset / rate
+		if math.Abs(offset) < newMoonTolerance {
+			break
+		}
+	}
+	return jd
+}
+
+// MasaPeriod is a lunar month together with the new moons bounding it.
+type MasaPeriod struct {
+	Masa
+	Start time.Time
+	End   time.Time
+}
+
+// Contains reports whether t falls within the month.
+func (p MasaPeriod) Contains(t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
+
+// CalculateMasa returns the amanta lunar month in progress at t. The month
+// runs from one new moon to the next and is named after the sidereal sign
+// the sun occupies at the new moon that begins it: the sun in Meena gives
+// Chaitra, in Mesha Vaishakha, and so on. A month without a solar sign
+// change is Adhika and one with two is Kshaya.
+func CalculateMasa(t time.Time) Masa {
+	return CalculateMasaPeriod(t).Masa
+}
+
+// CalculateMasaPeriod returns the amanta lunar month in progress at t and
+// its bounds. Callers evaluating many instants can reuse the result for
+// every instant the period contains.
+func CalculateMasaPeriod(t time.Time) MasaPeriod {
+	jd := JulianDayTT(t)
+	elongation := lunarElongation(jd)
+	previous, next := previousNewMoon(jd, elongation), nextNewMoon(jd, elongation)
+	start, end := sunRashi(previous), sunRashi(next)
+	index := (start + 1) % 12
+	sankrantis := (end - start + 12) % 12
+	return MasaPeriod{
+		Masa: Masa{
+			Number: index + 1,
+			Name:   masaNames[index],
+			Adhika: sankrantis == 0,
+			Kshaya: sankrantis == 2,
+		},
+		Start: TimeFromJulianDayTT(previous),
+		End:   TimeFromJulianDayTT(next),
+	}
+}
+
+// CalculatePurnimantaMasa returns the purnimanta lunar month in progress at
+// t. Purnimanta months run from one full moon to the next, so the Krishna
+// paksha belongs to the month of the following amanta month. Adhika months
+// keep their amanta bounds in both systems.
+func CalculatePurnimantaMasa(t time.Time) Masa {
+	p := positionsAt(JulianDayTT(t))
+	tithi, _ := tithiSpec.at(p)
+	if current := CalculateMasa(t); TithiPaksha(tithi) == ShuklaPaksha || current.Adhika {
+		return current
+	}
+	next := CalculateMasa(NextNewMoon(t).Add(time.Hour))
+	return Masa{Number: next.Number, Name: next.Name}
+}
+
+// sunRashi returns the zero-based sidereal sign of the sun, Mesha = 0.
+func sunRashi(jd float64) int {
+	return int(SiderealLongitude(SunLongitude(jd), jd) / 30)
+}
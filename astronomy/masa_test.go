@@ -0,0 +1,115 @@
+package astronomy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewMoon(t *testing.T) {
+	// New moons at 2024-04-08 18:21 and 2024-05-08 03:22 UTC.
+	at := time.Date(2024, 4, 20, 0, 0, 0, 0, time.UTC)
+	if got, want := PreviousNewMoon(at), time.Date(2024, 4, 8, 18, 21, 0, 0, time.UTC); !withinMinutes(got, want, 5) {
+		t.Errorf("PreviousNewMoon() = %v, want %v", got, want)
+	}
+	if got, want := NextNewMoon(at), time.Date(2024, 5, 8, 3, 22, 0, 0, time.UTC); !withinMinutes(got, want, 5) {
+		t.Errorf("NextNewMoon() = %v, want %v", got, want)
+	}
+}
+
+func TestCalculateMasa(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		{"holi", time.Date(2024, 3, 25, 6, 0, 0, 0, time.UTC), "Phalguna"},
+		{"chaitra purnima", time.Date(2024, 4, 23, 6, 0, 0, 0, time.UTC), "Chaitra"},
+		{"raksha bandhan", time.Date(2024, 8, 19, 6, 0, 0, 0, time.UTC), "Shravana"},
+		{"naraka chaturdashi", time.Date(2024, 10, 31, 6, 0, 0, 0, time.UTC), "Ashwin"},
+		{"after diwali", time.Date(2024, 11, 3, 6, 0, 0, 0, time.UTC), "Kartika"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateMasa(tt.t); got.Name != tt.want {
+				t.Errorf("CalculateMasa() = %s, want %s", got.Name, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateMasaAdhika(t *testing.T) {
+	// 2023 had an Adhika Shravana from 18 July to 16 August, and 2020 an
+	// Adhika Ashwin from 17 September to 16 October.
+	tests := []struct {
+		t          time.Time
+		want       string
+		wantAdhika bool
+	}{
+		{time.Date(2023, 7, 10, 6, 0, 0, 0, time.UTC), "Ashadha", false},
+		{time.Date(2023, 8, 1, 6, 0, 0, 0, time.UTC), "Shravana", true},
+		{time.Date(2023, 8, 25, 6, 0, 0, 0, time.UTC), "Shravana", false},
+		{time.Date(2020, 10, 1, 6, 0, 0, 0, time.UTC), "Ashwin", true},
+		{time.Date(2020, 10, 24, 6, 0, 0, 0, time.UTC), "Ashwin", false},
+	}
+	for _, tt := range tests {
+		got := CalculateMasa(tt.t)
+		if got.Name != tt.want || got.Adhika != tt.wantAdhika || got.Kshaya {
+			t.Errorf("CalculateMasa(%s) = %+v, want %s (adhika %v)", tt.t.Format("2006-01-02"), got, tt.want, tt.wantAdhika)
+		}
+	}
+}
+
+func TestCalculatePurnimantaMasa(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		// Krishna Janmashtami: Shravana amanta, Bhadrapada purnimanta.
+		{"janmashtami", time.Date(2024, 8, 26, 6, 0, 0, 0, time.UTC), "Bhadrapada"},
+		{"raksha bandhan", time.Date(2024, 8, 19, 6, 0, 0, 0, time.UTC), "Shravana"},
+		// The Krishna paksha before an Adhika month takes the nija name.
+		{"before adhika", time.Date(2023, 7, 10, 6, 0, 0, 0, time.UTC), "Shravana"},
+	}
+	if got := CalculatePurnimantaMasa(time.Date(2023, 8, 10, 6, 0, 0, 0, time.UTC)); !got.Adhika {
+		t.Errorf("Krishna paksha of Adhika Shravana = %+v, want Adhika", got)
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := CalculatePurnimantaMasa(tt.t)
+			if got.Name != tt.want || got.Adhika {
+				t.Errorf("CalculatePurnimantaMasa() = %+v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateMasaSequence(t *testing.T) {
+	// Walking month by month, names advance by one except after an Adhika
+	// month and skip one after a Kshaya month.
+	at := time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC)
+	prev := CalculateMasa(at)
+	adhikas := 0
+	for at.Year() < 2030 {
+		at = NextNewMoon(at).Add(time.Hour)
+		m := CalculateMasa(at)
+		step := 1
+		switch {
+		case prev.Adhika:
+			step = 0
+		case prev.Kshaya:
+			step = 2
+		}
+		if want := (prev.Number-1+step)%12 + 1; m.Number != want {
+			t.Fatalf("month after %+v at %v is %+v", prev, at, m)
+		}
+		if m.Adhika {
+			adhikas++
+		}
+		prev = m
+	}
+	// Roughly seven adhika months every nineteen years.
+	if adhikas < 22 || adhikas > 29 {
+		t.Errorf("%d adhika months between 1960 and 2030", adhikas)
+	}
+}
@@ -0,0 +1,126 @@
+package astronomy
+
+import (
+	"math"
+	"time"
+)
+
+// MoonTimes holds the moonrise and moonset during a single civil day. The
+// moon rises about 50 minutes later each day, so on one day a month it
+// does not rise, and on another it does not set; the corresponding time is
+// then zero.
+type MoonTimes struct {
+	Moonrise time.Time
+	Moonset  time.Time
+}
+
+// moonAltitudeAtRiseSet is the geocentric altitude of the moon's centre at
+// moonrise and moonset. It accounts for refraction, the lunar
+// semi-diameter and the mean horizontal parallax.
+const moonAltitudeAtRiseSet = 0.125
+
+// moonSearchStep is the interval at which the moon's altitude is sampled
+// when searching for rise and set. The moon cannot rise and set within it.
+const moonSearchStep = time.Hour
+
+// CalculateMoonTimes returns the moonrise and moonset during the civil date
+// of date, interpreted in date's location. The returned times are in the
+// same location as date.
+func CalculateMoonTimes(loc Location, date time.Time) *MoonTimes {
+	y, m, d := date.Date()
+	tz := date.Location()
+	start := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	end := start.AddDate(0, 0, 1)
+
+	times := &MoonTimes{}
+	previous := moonAltitude(loc, start) - moonAltitudeAtRiseSet
+	for t := start; t.Before(end); {
+		next := t.Add(moonSearchStep)
+		if next.After(end) {
+			next = end
+		}
+		altitude := moonAltitude(loc, next) - moonAltitudeAtRiseSet
+		switch {
+		case previous < 0 && altitude >= 0 && times.Moonrise.IsZero():
+			times.Moonrise = moonCrossing(loc, t, next).In(tz)
+		case previous >= 0 && altitude < 0 && times.Moonset.IsZero():
+			times.Moonset = moonCrossing(loc, t, next).In(tz)
+		}
+		t, previous = next, altitude
+	}
+	return times
+}
+
+// moonCrossing finds the instant between a and b at which the moon crosses
+// the rise and set altitude, by bisection to within a second.
+func moonCrossing(loc Location, a, b time.Time) time.Time {
+	rising := moonAltitude(loc, a) < moonAltitudeAtRiseSet
+	for b.Sub(a) > time.Second {
+		mid := a.Add(b.Sub(a) / 2)
+		if (moonAltitude(loc, mid) < moonAltitudeAtRiseSet) == rising {
+			a = mid
+		} else {
+			b = mid
+		}
+	}
+	return a.Add(b.Sub(a) / 2).Round(time.Second)
+}
+
+// moonAltitude returns the geocentric altitude of the moon above the
+// horizon at loc in degrees.
+func moonAltitude(loc Location, t time.Time) float64 {
+	jd := JulianDayTT(t)
+	rightAscension, declination := Equatorial(MoonLongitude(jd), MoonLatitude(jd), jd)
+	hourAngle := SiderealTime(ut1(jd)) + loc.Longitude - rightAscension
+	sinAltitude := sinDeg(loc.Latitude)*sinDeg(declination) +
+		cosDeg(loc.Latitude)*cosDeg(declination)*cosDeg(hourAngle)
+	return math.Asin(sinAltitude) * rad2deg
+}
+
+// Equatorial converts ecliptic longitude and latitude to right ascension
+// and declination, in degrees.
+func Equatorial(longitude, latitude, jd float64) (rightAscension, declination float64) {
+	obliquity := meanObliquity(jd)
+	rightAscension = math.Atan2(
+		sinDeg(longitude)*cosDeg(obliquity)-math.Tan(latitude*deg2rad)*sinDeg(obliquity),
+		cosDeg(longitude)) * rad2deg
+	declination = math.Asin(sinDeg(latitude)*cosDeg(obliquity)+
+		cosDeg(latitude)*sinDeg(obliquity)*sinDeg(longitude)) * rad2deg
+	return normalizeDegrees(rightAscension), declination
+}
+
+// meanObliquity returns the mean obliquity of the ecliptic in degrees.
+func meanObliquity(jd float64) float64 {
+	return 23.4392911 - 0.0130042*(jd-J2000)/36525
+}
+
+// SiderealTime returns the Greenwich mean sidereal time in degrees at the
+// Julian day jd in UT1, e.g. of JulianDayUT1, Meeus equation 12.4.
+func SiderealTime(jd float64) float64 {
+	t := (jd - J2000) / 36525
+	return normalizeDegrees(280.46061837 + 360.98564736629*(jd-J2000) + 0.000387933*t*t - t*t*t/38710000)
+}
+
+// Names of the eight moon phases, each centred on a multiple of 45 degrees
+// of elongation starting with the new moon.
+var moonPhaseNames = []string{
+	"New Moon", "Waxing Crescent", "First Quarter", "Waxing Gibbous",
+	"Full Moon", "Waning Gibbous", "Last Quarter", "Waning Crescent",
+}
+
+// MoonPhase describes the appearance of the moon.
+type MoonPhase struct {
+	Name string
+	// Illumination is the illuminated fraction of the disc, from 0 to 1.
+	Illumination float64
+}
+
+// CalculateMoonPhase returns the phase of the moon at t.
+func CalculateMoonPhase(t time.Time) MoonPhase {
+	elongation := lunarElongation(JulianDayTT(t))
+	index := int(normalizeDegrees(elongation+22.5)/45) % len(moonPhaseNames)
+	return MoonPhase{
+		Name:         moonPhaseNames[index],
+		Illumination: (1 - cosDeg(elongation)) / 2,
+	}
+}
@@ -0,0 +1,82 @@
+package astronomy
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestMoonLatitude(t *testing.T) {
+	// Meeus example 47.a: 1992 April 12, 0h TD.
+	jd := 2448724.5
+	if got, want := MoonLatitude(jd), -3.229126; math.Abs(got-want) > 0.01 {
+		t.Errorf("MoonLatitude() = %f, want %f", got, want)
+	}
+}
+
+func TestCalculateMoonTimes(t *testing.T) {
+	delhi := Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz := mustLoadLocation(t, "Asia/Kolkata")
+
+	// At full moon the moon rises around sunset and sets around sunrise.
+	fullMoon := time.Date(2024, 4, 23, 0, 0, 0, 0, tz)
+	moon := CalculateMoonTimes(delhi, fullMoon)
+	sun, err := CalculateSunTimes(delhi, fullMoon)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !withinMinutes(moon.Moonrise, sun.Sunset, 60) {
+		t.Errorf("Moonrise = %v, want near sunset %v", moon.Moonrise, sun.Sunset)
+	}
+	if !withinMinutes(moon.Moonset, sun.Sunrise, 60) {
+		t.Errorf("Moonset = %v, want near sunrise %v", moon.Moonset, sun.Sunrise)
+	}
+	if moon.Moonrise.Location() != tz {
+		t.Errorf("Moonrise location = %v, want %v", moon.Moonrise.Location(), tz)
+	}
+
+	// The moon rises later every day and skips about one day a month.
+	var skipped int
+	previous := moon.Moonrise
+	for day := fullMoon.AddDate(0, 0, 1); day.Before(fullMoon.AddDate(0, 0, 30)); day = day.AddDate(0, 0, 1) {
+		rise := CalculateMoonTimes(delhi, day).Moonrise
+		if rise.IsZero() {
+			skipped++
+			continue
+		}
+		if delay := rise.Sub(previous); !previous.IsZero() && (delay < 24*time.Hour+20*time.Minute || delay > 24*time.Hour+80*time.Minute) {
+			t.Errorf("moonrise on %s is %v after the previous one", day.Format("2006-01-02"), delay)
+		}
+		previous = rise
+	}
+	if skipped != 1 {
+		t.Errorf("moon did not rise on %d days, want 1", skipped)
+	}
+}
+
+func TestCalculateMoonPhase(t *testing.T) {
+	tests := []struct {
+		t            time.Time
+		name         string
+		illumination float64
+	}{
+		{time.Date(2024, 4, 8, 18, 21, 0, 0, time.UTC), "New Moon", 0},
+		{time.Date(2024, 4, 15, 19, 13, 0, 0, time.UTC), "First Quarter", 0.5},
+		{time.Date(2024, 4, 23, 23, 49, 0, 0, time.UTC), "Full Moon", 1},
+		{time.Date(2024, 5, 1, 11, 27, 0, 0, time.UTC), "Last Quarter", 0.5},
+		{time.Date(2024, 4, 12, 0, 0, 0, 0, time.UTC), "Waxing Crescent", 0.15},
+	}
+	for _, tt := range tests {
+		got := CalculateMoonPhase(tt.t)
+		if got.Name != tt.name || math.Abs(got.Illumination-tt.illumination) > 0.05 {
+			t.Errorf("CalculateMoonPhase(%v) = %+v, want %s at %.2f", tt.t, got, tt.name, tt.illumination)
+		}
+	}
+}
+
+func TestMoonDistance(t *testing.T) {
+	// Meeus example 47.a: 1992 April 12, 0h TD.
+	if got, want := MoonDistance(2448724.5), 368409.7; math.Abs(got-want) > 50 {
+		t.Errorf("MoonDistance() = %.1f, want %.1f", got, want)
+	}
+}
@@ -0,0 +1,145 @@
+package astronomy
+
+import "time"
+
+// Period is a named span of time, such as a muhurta.
+type Period struct {
+	Name  string
+	Start time.Time
+	End   time.Time
+}
+
+// Muhurta names.
+const (
+	Abhijit     = "Abhijit"
+	Brahma      = "Brahma"
+	Durmuhurtam = "Durmuhurtam"
+	Varjyam     = "Varjyam"
+	Rahu        = "Rahu Kalam"
+	Yamaganda   = "Yamagandam"
+	Gulika      = "Gulika Kalam"
+)
+
+// muhurtasPerDay is the number of muhurtas between sunrise and sunset.
+const muhurtasPerDay = 15
+
+// brahmaMuhurtaStart and brahmaMuhurtaEnd bound the Brahma Muhurta before
+// sunrise: the two muhurtas of 48 minutes of a mean day that precede it.
+const (
+	brahmaMuhurtaStart = 96 * time.Minute
+	brahmaMuhurtaEnd   = 48 * time.Minute
+)
+
+// AbhijitMuhurta returns the eighth of the fifteen equal muhurtas between
+// sunrise and sunset, which is centred on local noon.
+func AbhijitMuhurta(sunrise, sunset time.Time) Period {
+	part := sunset.Sub(sunrise) / muhurtasPerDay
+	start := sunrise.Add(7 * part)
+	return Period{Name: Abhijit, Start: start, End: start.Add(part)}
+}
+
+// BrahmaMuhurta returns the period from 96 to 48 minutes before sunrise.
+func BrahmaMuhurta(sunrise time.Time) Period {
+	return Period{
+		Name:  Brahma,
+		Start: sunrise.Add(-brahmaMuhurtaStart),
+		End:   sunrise.Add(-brahmaMuhurtaEnd),
+	}
+}
+
+// durmuhurtas lists for each weekday from Sunday the inauspicious muhurtas,
+// numbered from 1 to 15 between sunrise and sunset and from 16 to 30 between
+// sunset and the next sunrise.
+var durmuhurtas = [7][]int{
+	{14},
+	{9, 12},
+	{4, 22},
+	{8},
+	{6, 12},
+	{4, 9},
+	{1, 2},
+}
+
+// Durmuhurtas returns the Durmuhurtam periods of the day starting at
+// sunrise, which depend on its weekday. Day muhurtas are a fifteenth of the
+// time from sunrise to sunset and night muhurtas a fifteenth of the time
+// from sunset to the next sunrise.
+func Durmuhurtas(sunrise, sunset, nextSunrise time.Time) []Period {
+	var periods []Period
+	for _, n := range durmuhurtas[sunrise.Weekday()] {
+		start, end := sunrise, sunset
+		if n > muhurtasPerDay {
+			start, end, n = sunset, nextSunrise, n-muhurtasPerDay
+		}
+		part := end.Sub(start) / muhurtasPerDay
+		periods = append(periods, Period{
+			Name:  Durmuhurtam,
+			Start: start.Add(time.Duration(n-1) * part),
+			End:   start.Add(time.Duration(n) * part),
+		})
+	}
+	return periods
+}
+
+// varjyamGhatis holds for each nakshatra from Ashwini the ghati, out of the
+// 60 of the nakshatra, at which its Varjyam starts.
+var varjyamGhatis = [27]int{
+	50, 24, 30, 40, 14, 11, 30, 20, 32, 30, 20, 18, 22, 20,
+	14, 14, 10, 14, 20, 20, 20, 10, 10, 18, 16, 24, 30,
+}
+
+// varjyamLength is the length of Varjyam in ghatis.
+const varjyamLength = 4
+
+// Varjyams returns the Varjyam (Tyajya) periods overlapping the day from
+// sunrise to the next sunrise. Each nakshatra has one Varjyam lasting 4 of
+// its 60 ghatis, so its times scale with the duration of the nakshatra.
+func Varjyams(sunrise, nextSunrise time.Time) []Period {
+	var periods []Period
+	for t := sunrise; t.Before(nextSunrise); {
+		nakshatra := CalculateElementPeriod(NakshatraElement, t)
+		ghati := nakshatra.End.Sub(nakshatra.Start) / 60
+		start := nakshatra.Start.Add(time.Duration(varjyamGhatis[nakshatra.Number-1]) * ghati)
+		end := start.Add(varjyamLength * ghati)
+		if end.After(sunrise) && start.Before(nextSunrise) {
+			periods = append(periods, Period{Name: Varjyam, Start: start, End: end})
+		}
+		// End is rounded to the second, so step past it.
+		t = nakshatra.End.Add(time.Second)
+	}
+	return periods
+}
+
+// Rahu Kalam, Yamagandam and Gulika Kalam are one of the eight equal parts
+// of the time from sunrise to sunset, numbered from 1, for each weekday
+// starting from Sunday.
+var (
+	rahuKalamPart   = [7]int{8, 2, 7, 5, 6, 4, 3}
+	yamagandamPart  = [7]int{5, 4, 3, 2, 1, 7, 6}
+	gulikaKalamPart = [7]int{7, 6, 5, 4, 3, 2, 1}
+)
+
+// RahuKalam returns the Rahu Kalam of the day from sunrise to sunset.
+func RahuKalam(sunrise, sunset time.Time) Period {
+	return dayPart(Rahu, sunrise, sunset, rahuKalamPart[sunrise.Weekday()])
+}
+
+// Yamagandam returns the Yamagandam of the day from sunrise to sunset.
+func Yamagandam(sunrise, sunset time.Time) Period {
+	return dayPart(Yamaganda, sunrise, sunset, yamagandamPart[sunrise.Weekday()])
+}
+
+// GulikaKalam returns the Gulika Kalam of the day from sunrise to sunset.
+func GulikaKalam(sunrise, sunset time.Time) Period {
+	return dayPart(Gulika, sunrise, sunset, gulikaKalamPart[sunrise.Weekday()])
+}
+
+// dayPart returns the nth of the eight equal parts from sunrise to sunset.
+func dayPart(name string, sunrise, sunset time.Time, n int) Period {
+	length := sunset.Sub(sunrise) / 8
+	end := sunrise.Add(length * time.Duration(n))
+	if n == 8 {
+		end = sunset
+	}
+	return Period{Name: name, Start: sunrise.Add(length * time.Duration(n-1)), End: end}
+}
@@ -0,0 +1,123 @@
+package astronomy
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestAbhijitMuhurta(t *testing.T) {
+	sunrise := time.Date(2024, 4, 30, 6, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name       string
+		sunset     time.Time
+		start, end time.Time
+	}{
+		// A 12 hour day has muhurtas of 48 minutes.
+		{"equinox", sunrise.Add(12 * time.Hour), sunrise.Add(5*time.Hour + 36*time.Minute), sunrise.Add(6*time.Hour + 24*time.Minute)},
+		// A 13 hour 30 minute day has muhurtas of 54 minutes.
+		{"summer", sunrise.Add(13*time.Hour + 30*time.Minute), sunrise.Add(6*time.Hour + 18*time.Minute), sunrise.Add(7*time.Hour + 12*time.Minute)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := AbhijitMuhurta(sunrise, tt.sunset)
+			if p.Name != Abhijit || !p.Start.Equal(tt.start) || !p.End.Equal(tt.end) {
+				t.Errorf("AbhijitMuhurta() = %s %v-%v, want %s %v-%v", p.Name, p.Start, p.End, Abhijit, tt.start, tt.end)
+			}
+			// Abhijit is centred on the midpoint of the day.
+			noon := sunrise.Add(tt.sunset.Sub(sunrise) / 2)
+			if p.Start.Sub(noon) != -p.End.Sub(noon) {
+				t.Errorf("AbhijitMuhurta() %v-%v is not centred on %v", p.Start, p.End, noon)
+			}
+		})
+	}
+}
+
+func TestBrahmaMuhurta(t *testing.T) {
+	sunrise := time.Date(2024, 4, 30, 6, 10, 58, 0, time.UTC)
+	p := BrahmaMuhurta(sunrise)
+	start := time.Date(2024, 4, 30, 4, 34, 58, 0, time.UTC)
+	end := time.Date(2024, 4, 30, 5, 22, 58, 0, time.UTC)
+	if p.Name != Brahma || !p.Start.Equal(start) || !p.End.Equal(end) {
+		t.Errorf("BrahmaMuhurta() = %s %v-%v, want %s %v-%v", p.Name, p.Start, p.End, Brahma, start, end)
+	}
+}
+
+func TestDurmuhurtas(t *testing.T) {
+	// 30 April 2024 is a Tuesday: the 4th muhurta of the day and the 7th of
+	// the night. A 12 hour day and night have muhurtas of 48 minutes.
+	sunrise := time.Date(2024, 4, 30, 6, 0, 0, 0, time.UTC)
+	periods := Durmuhurtas(sunrise, sunrise.Add(12*time.Hour), sunrise.Add(24*time.Hour))
+	want := []Period{
+		{Durmuhurtam, time.Date(2024, 4, 30, 8, 24, 0, 0, time.UTC), time.Date(2024, 4, 30, 9, 12, 0, 0, time.UTC)},
+		{Durmuhurtam, time.Date(2024, 4, 30, 22, 48, 0, 0, time.UTC), time.Date(2024, 4, 30, 23, 36, 0, 0, time.UTC)},
+	}
+	if len(periods) != len(want) {
+		t.Fatalf("Durmuhurtas() = %v, want %v", periods, want)
+	}
+	for i := range want {
+		if periods[i].Name != want[i].Name || !periods[i].Start.Equal(want[i].Start) || !periods[i].End.Equal(want[i].End) {
+			t.Errorf("Durmuhurtas()[%d] = %v, want %v", i, periods[i], want[i])
+		}
+	}
+
+	// Every weekday has at least one.
+	for d := 0; d < 7; d++ {
+		day := sunrise.AddDate(0, 0, d)
+		if len(Durmuhurtas(day, day.Add(13*time.Hour), day.Add(24*time.Hour))) == 0 {
+			t.Errorf("no Durmuhurtam on %v", day.Weekday())
+		}
+	}
+}
+
+func TestVarjyams(t *testing.T) {
+	sunrise := time.Date(2024, 4, 30, 0, 40, 58, 0, time.UTC)
+	nextSunrise := sunrise.Add(24*time.Hour - 30*time.Second)
+	for d := 0; d < 30; d++ {
+		sunrise, nextSunrise := sunrise.AddDate(0, 0, d), nextSunrise.AddDate(0, 0, d)
+		for _, p := range Varjyams(sunrise, nextSunrise) {
+			if p.Name != Varjyam || !p.End.After(sunrise) || !p.Start.Before(nextSunrise) {
+				t.Errorf("Varjyams(%v) returned %v outside the day", sunrise, p)
+			}
+			// Varjyam lies within one nakshatra and lasts 4 of its 60
+			// ghatis, 1h 20m to 1h 50m.
+			nakshatra := CalculateElementPeriod(NakshatraElement, p.Start)
+			if p.End.After(nakshatra.End.Add(time.Second)) {
+				t.Errorf("Varjyam %v-%v runs past the end of %s at %v", p.Start, p.End, nakshatra.Name, nakshatra.End)
+			}
+			ghatis := float64(p.Start.Sub(nakshatra.Start)) / float64(nakshatra.End.Sub(nakshatra.Start)) * 60
+			if math.Abs(ghatis-float64(varjyamGhatis[nakshatra.Number-1])) > 0.01 {
+				t.Errorf("Varjyam of %s starts at ghati %.2f, want %d", nakshatra.Name, ghatis, varjyamGhatis[nakshatra.Number-1])
+			}
+			if length := p.End.Sub(p.Start); length < 80*time.Minute || length > 110*time.Minute {
+				t.Errorf("Varjyam of %s lasts %v", nakshatra.Name, length)
+			}
+		}
+	}
+}
+
+func TestRahuKalam(t *testing.T) {
+	// 30 April 2024 is a Tuesday. A 12 hour day has parts of 90 minutes:
+	// Rahu Kalam is the 7th, Yamagandam the 3rd and Gulika Kalam the 5th.
+	sunrise := time.Date(2024, 4, 30, 6, 0, 0, 0, time.UTC)
+	sunset := sunrise.Add(12 * time.Hour)
+	for _, tt := range []struct {
+		period Period
+		want   Period
+	}{
+		{RahuKalam(sunrise, sunset), Period{Rahu, time.Date(2024, 4, 30, 15, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 16, 30, 0, 0, time.UTC)}},
+		{Yamagandam(sunrise, sunset), Period{Yamaganda, time.Date(2024, 4, 30, 9, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 10, 30, 0, 0, time.UTC)}},
+		{GulikaKalam(sunrise, sunset), Period{Gulika, time.Date(2024, 4, 30, 12, 0, 0, 0, time.UTC), time.Date(2024, 4, 30, 13, 30, 0, 0, time.UTC)}},
+	} {
+		p := tt.period
+		if p.Name != tt.want.Name || !p.Start.Equal(tt.want.Start) || !p.End.Equal(tt.want.End) {
+			t.Errorf("%s = %v-%v, want %v-%v", p.Name, p.Start, p.End, tt.want.Start, tt.want.End)
+		}
+	}
+
+	// On Sunday Rahu Kalam is the last part and ends at sunset.
+	sunday := time.Date(2024, 5, 5, 6, 0, 0, 0, time.UTC)
+	if p := RahuKalam(sunday, sunday.Add(13*time.Hour+1)); !p.End.Equal(sunday.Add(13*time.Hour + 1)) {
+		t.Errorf("RahuKalam() on Sunday ends at %v, not sunset", p.End)
+	}
+}
@@ -0,0 +1,62 @@
+package astronomy
+
+// tithiGroups are the five natures the tithis of each paksha cycle through:
+// Pratipada, Shashthi and Ekadashi are nanda, and so on.
+var tithiGroups = []string{"nanda", "bhadra", "jaya", "rikta", "purna"}
+
+// nakshatraGroups classify the nakshatras by nature as in the muhurta
+// texts: fixed (dhruva), movable (chara), fierce (ugra), mixed (mishra),
+// swift (kshipra), soft (mridu) and sharp (tikshna).
+var nakshatraGroups = map[string]string{
+	"Rohini": "dhruva", "Uttara Phalguni": "dhruva", "Uttara Ashadha": "dhruva", "Uttara Bhadrapada": "dhruva",
+	"Punarvasu": "chara", "Swati": "chara", "Shravana": "chara", "Dhanishta": "chara", "Shatabhisha": "chara",
+	"Bharani": "ugra", "Magha": "ugra", "Purva Phalguni": "ugra", "Purva Ashadha": "ugra", "Purva Bhadrapada": "ugra",
+	"Krittika": "mishra", "Vishakha": "mishra",
+	"Ashwini": "kshipra", "Pushya": "kshipra", "Hasta": "kshipra",
+	"Mrigashira": "mridu", "Chitra": "mridu", "Anuradha": "mridu", "Revati": "mridu",
+	"Ardra": "tikshna", "Ashlesha": "tikshna", "Jyeshtha": "tikshna", "Mula": "tikshna",
+}
+
+// inauspiciousYogas are the yogas avoided for auspicious undertakings.
+var inauspiciousYogas = map[string]bool{
+	"Vishkambha": true, "Atiganda": true, "Shula": true, "Ganda": true, "Vyaghata": true,
+	"Vajra": true, "Vyatipata": true, "Parigha": true, "Vaidhriti": true,
+}
+
+// Qualities returns the traditional classifications of the element: the
+// nature of a tithi (nanda, bhadra, jaya, rikta or purna), the nature of a
+// nakshatra (dhruva, chara, ugra, mishra, kshipra, mridu or tikshna),
+// whether a yoga is auspicious or inauspicious, whether a karana is movable
+// or fixed and whether it is the inauspicious Vishti, and the planet ruling
+// a vara.
+func (e Element) Qualities() []string {
+	switch e.Kind {
+	case TithiElement:
+		if e.Number >= 1 {
+			return []string{tithiGroups[(e.Number-1)%len(tithiGroups)]}
+		}
+	case NakshatraElement:
+		if group, ok := nakshatraGroups[e.Name]; ok {
+			return []string{group}
+		}
+	case YogaElement:
+		if inauspiciousYogas[e.Name] {
+			return []string{string(Inauspicious)}
+		}
+		return []string{string(Auspicious)}
+	case KaranaElement:
+		// The movable karanas fill positions 2 to 57 of the 60.
+		if e.Number < 2 || e.Number > 57 {
+			return []string{"fixed"}
+		}
+		if e.Name == "Vishti" {
+			return []string{"movable", string(Inauspicious)}
+		}
+		return []string{"movable"}
+	case VaraElement:
+		if e.Number >= 1 && e.Number <= len(varaLords) {
+			return []string{varaLords[e.Number-1]}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package astronomy
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestQualities(t *testing.T) {
+	for _, tt := range []struct {
+		element Element
+		want    []string
+	}{
+		{tithiSpec.element(0), []string{"nanda"}},
+		{tithiSpec.element(14), []string{"purna"}},
+		{tithiSpec.element(18), []string{"rikta"}},
+		{nakshatraSpec.element(3), []string{"dhruva"}},
+		{nakshatraSpec.element(26), []string{"mridu"}},
+		{yogaSpec.element(0), []string{"inauspicious"}},
+		{yogaSpec.element(1), []string{"auspicious"}},
+		{karanaSpec.element(0), []string{"fixed"}},
+		{karanaSpec.element(7), []string{"movable", "inauspicious"}},
+		{karanaSpec.element(57), []string{"fixed"}},
+		{Element{Kind: VaraElement, Number: 5, Name: "Guruvara"}, []string{"jupiter"}},
+	} {
+		if got := tt.element.Qualities(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("%s %s: Qualities() = %v, want %v", tt.element.Kind, tt.element.Name, got, tt.want)
+		}
+	}
+	for _, name := range nakshatraNames {
+		if _, ok := nakshatraGroups[name]; !ok {
+			t.Errorf("nakshatra %s has no group", name)
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package astronomy
+
+import "math"
+
+// maxRootIterations bounds the iterations of brent. Brent's method
+// converges in far fewer; the bound only guards against a function that is
+// not continuous over the bracket.
+const maxRootIterations = 100
+
+// brent returns a root of f between a and b, where f(a) and f(b) have
+// opposite signs, to within tol. It uses Brent's method: inverse quadratic
+// interpolation or secant steps while they converge quickly, and bisection
+// steps otherwise, so it never needs more evaluations than bisection and
+// usually far fewer on smooth functions.
+func brent(f func(float64) float64, a, b, tol float64) float64 {
+	fa, fb := f(a), f(b)
+	if fa == 0 {
+		return a
+	}
+	if fb == 0 {
+		return b
+	}
+	c, fc := b, fb
+	var d, e float64
+	for i := 0; i < maxRootIterations; i++ {
+		// Keep the root between b and c.
+		if (fb > 0) == (fc > 0) {
+			c, fc = a, fa
+			d = b - a
+			e = d
+		}
+		// Make b the best estimate.
+		if math.Abs(fc) < math.Abs(fb) {
+			a, b, c = b, c, b
+			fa, fb, fc = fb, fc, fb
+		}
+		tol1 := 2*epsilon*math.Abs(b) + tol/2
+		half := (c - b) / 2
+		if math.Abs(half) <= tol1 || fb == 0 {
+			return b
+		}
+		if math.Abs(e) >= tol1 && math.Abs(fa) > math.Abs(fb) {
+			// Interpolate: linearly from two points, inversely
+			// quadratically from three.
+			var p, q float64
+			s := fb / fa
+			if a == c {
+				p = 2 * half * s
+				q = 1 - s
+			} else {
+				q = fa / fc
+				r := fb / fc
+				p = s * (2*half*q*(q-r) - (b-a)*(r-1))
+				q = (q - 1) * (r - 1) * (s - 1)
+			}
+			if p > 0 {
+				q = -q
+			}
+			p = math.Abs(p)
+			// Accept the interpolation only if it stays within the bracket
+			// and shrinks the steps fast enough.
+			if 2*p < math.Min(3*half*q-math.Abs(tol1*q), math.Abs(e*q)) {
+				e, d = d, p/q
+			} else {
+				d, e = half, half
+			}
+		} else {
+			d, e = half, half
+		}
+		a, fa = b, fb
+		if math.Abs(d) > tol1 {
+			b += d
+		} else {
+			b += math.Copysign(tol1, half)
+		}
+		fb = f(b)
+	}
+	return b
+}
+
+// epsilon is the relative precision of float64.
+const epsilon = 2.220446049250313e-16
@@ -0,0 +1,57 @@
+package astronomy
+
+import "time"
+
+// Names of the years of the 60-year Jovian cycle, starting with Prabhava.
+var samvatsaraNames = []string{
+	"Prabhava", "Vibhava", "Shukla", "Pramoda", "Prajapati", "Angirasa",
+	"Shrimukha", "Bhava", "Yuva", "Dhatu", "Ishvara", "Bahudhanya",
+	"Pramathi", "Vikrama", "Vrisha", "Chitrabhanu", "Subhanu", "Tarana",
+	"Parthiva", "Vyaya", "Sarvajit", "Sarvadhari", "Virodhi", "Vikriti",
+	"Khara", "Nandana", "Vijaya", "Jaya", "Manmatha", "Durmukhi",
+	"Hevilambi", "Vilambi", "Vikari", "Sharvari", "Plava", "Shubhakrit",
+	"Shobhakrit", "Krodhi", "Vishvavasu", "Parabhava", "Plavanga", "Kilaka",
+	"Saumya", "Sadharana", "Virodhikrit", "Paridhavi", "Pramadi", "Ananda",
+	"Rakshasa", "Nala", "Pingala", "Kalayukti", "Siddharthi", "Raudri",
+	"Durmati", "Dundubhi", "Rudhirodgari", "Raktakshi", "Krodhana", "Akshaya",
+}
+
+// Samvatsara is a year of the 60-year cycle.
+type Samvatsara struct {
+	// Number is the position of the year in the cycle, Prabhava = 1.
+	Number int
+	Name   string
+}
+
+// samvatsaraEpoch is a Gregorian year in which a Prabhava year began.
+const samvatsaraEpoch = 1987
+
+// CalculateSamvatsara returns the year of the 60-year cycle in progress at
+// t. Years begin with the amanta month of Chaitra, following the
+// luni-solar convention used with the Shaka era.
+func CalculateSamvatsara(t time.Time) Samvatsara {
+	index := ((LunarYear(t)-samvatsaraEpoch)%60 + 60) % 60
+	return Samvatsara{Number: index + 1, Name: samvatsaraNames[index]}
+}
+
+// SamvatsaraNames returns the names of the years of the 60-year cycle from
+// Prabhava.
+func SamvatsaraNames() []string {
+	return append([]string(nil), samvatsaraNames...)
+}
+
+// LunarYear returns the Gregorian year in which the luni-solar year in
+// progress at t began. The year begins with the nija (non-Adhika) month
+// of Chaitra, so dates from January until then belong to the previous
+// year.
+func LunarYear(t time.Time) int {
+	year := t.UTC().Year()
+	if t.UTC().Month() > time.May {
+		return year
+	}
+	masa := CalculateMasa(t)
+	if masa.Number >= 10 || (masa.Number == 1 && masa.Adhika) {
+		return year - 1
+	}
+	return year
+}
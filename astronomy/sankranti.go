@@ -0,0 +1,92 @@
+package astronomy
+
+import (
+	"time"
+)
+
+// Names of the sidereal signs, starting with Mesha.
+var rashiNames = []string{
+	"Mesha", "Vrishabha", "Mithuna", "Karka", "Simha", "Kanya",
+	"Tula", "Vrishchika", "Dhanu", "Makara", "Kumbha", "Meena",
+}
+
+// Sankranti is the entry of the sun into a sidereal sign.
+type Sankranti struct {
+	// Number is the position of the sign entered starting from Mesha = 1.
+	Number int
+	// Rashi is the name of the sign entered, e.g. "Makara".
+	Rashi string
+	Time  time.Time
+}
+
+// RashiByName returns the position of the sidereal sign with the given
+// name starting from Mesha = 1, e.g. 5 for "Simha".
+func RashiByName(name string) (int, bool) {
+	for i, n := range rashiNames {
+		if n == name {
+			return i + 1, true
+		}
+	}
+	return 0, false
+}
+
+// RashiName returns the name of the sidereal sign at position n starting
+// from Mesha = 1, e.g. "Simha" for 5.
+func RashiName(n int) string {
+	return rashiNames[((n-1)%12+12)%12]
+}
+
+// SunRashi returns the position of the sidereal sign of the sun at t
+// starting from Mesha = 1. The sign names the solar month in progress.
+func SunRashi(t time.Time) int {
+	return sunRashi(JulianDayTT(t)) + 1
+}
+
+// MoonRashi returns the position of the sidereal sign of the moon at t
+// starting from Mesha = 1, the chandra rashi that chandrabala counts from.
+func MoonRashi(t time.Time) int {
+	return int(positionsAt(JulianDayTT(t)).siderealMoon()/30) + 1
+}
+
+// sankrantiTolerance is the precision to which sankrantis are located.
+const sankrantiTolerance = time.Second
+
+// NextSankranti returns the first sankranti after t.
+//
+// The sun is stepped forward a day at a time until its sign changes, and
+// the instant of the change is then located by bisection on its sidereal
+// longitude.
+func NextSankranti(t time.Time) Sankranti {
+	jd := JulianDayTT(t)
+	rashi := sunRashi(jd)
+	before, after := jd, jd+1
+	for sunRashi(after) == rashi {
+		before, after = after, after+1
+	}
+	for durationFromDays(after-before) > sankrantiTolerance {
+		mid := (before + after) / 2
+		if sunRashi(mid) == rashi {
+			before = mid
+		} else {
+			after = mid
+		}
+	}
+	index := (rashi + 1) % 12
+	return Sankranti{
+		Number: index + 1,
+		Rashi:  rashiNames[index],
+		Time:   TimeFromJulianDayTT((before + after) / 2).Round(time.Second),
+	}
+}
+
+// Sankrantis returns the sankrantis from start up to but excluding end, in
+// order.
+func Sankrantis(start, end time.Time) []Sankranti {
+	var sankrantis []Sankranti
+	// Sankrantis are a month apart, so searching from a day after one
+	// safely skips past it.
+	for s := NextSankranti(start); s.Time.Before(end); s = NextSankranti(s.Time.Add(24 * time.Hour)) {
+		sankrantis = append(sankrantis, s)
+	}
+	return sankrantis
+}
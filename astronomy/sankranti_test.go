@@ -0,0 +1,78 @@
+package astronomy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSankrantis(t *testing.T) {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	// Sankranti moments of 2024 with the Lahiri ayanamsa. The low precision
+	// solar position and the linear ayanamsa place them up to 20 minutes
+	// early; the sun moves under a minute of arc in that time.
+	want := []struct {
+		rashi string
+		time  time.Time
+	}{
+		{"Makara", time.Date(2024, 1, 15, 2, 54, 0, 0, ist)},
+		{"Kumbha", time.Date(2024, 2, 13, 15, 54, 0, 0, ist)},
+		{"Meena", time.Date(2024, 3, 14, 12, 46, 0, 0, ist)},
+		{"Mesha", time.Date(2024, 4, 13, 21, 15, 0, 0, ist)},
+	}
+
+	got := Sankrantis(time.Date(2024, 1, 1, 0, 0, 0, 0, ist), time.Date(2024, 4, 30, 0, 0, 0, 0, ist))
+	if len(got) != len(want) {
+		t.Fatalf("Sankrantis() = %v, want %d sankrantis", got, len(want))
+	}
+	for i, w := range want {
+		if got[i].Rashi != w.rashi || !withinMinutes(got[i].Time, w.time, 20) {
+			t.Errorf("sankranti %d = %s at %v, want %s at %v", i, got[i].Rashi, got[i].Time.In(ist), w.rashi, w.time)
+		}
+	}
+}
+
+func TestNextSankranti(t *testing.T) {
+	s := NextSankranti(time.Date(2024, 12, 1, 0, 0, 0, 0, time.UTC))
+	if s.Number != 9 || s.Rashi != "Dhanu" {
+		t.Errorf("NextSankranti() = %+v, want Dhanu", s)
+	}
+	// Just after a sankranti, the next one is a month later.
+	next := NextSankranti(s.Time.Add(time.Minute))
+	if next.Rashi != "Makara" || next.Time.Sub(s.Time) < 28*24*time.Hour {
+		t.Errorf("NextSankranti() after %v = %+v, want Makara", s.Time, next)
+	}
+}
+
+func TestSunRashi(t *testing.T) {
+	s := NextSankranti(time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC))
+	if got := SunRashi(s.Time.Add(-time.Minute)); got != 12 {
+		t.Errorf("SunRashi() before Mesha Sankranti = %d, want 12", got)
+	}
+	if got := SunRashi(s.Time.Add(time.Minute)); got != 1 {
+		t.Errorf("SunRashi() after Mesha Sankranti = %d, want 1", got)
+	}
+	if n, ok := RashiByName("Simha"); !ok || n != 5 {
+		t.Errorf("RashiByName(Simha) = %d, %v, want 5", n, ok)
+	}
+	if _, ok := RashiByName("Leo"); ok {
+		t.Error("RashiByName(Leo) found a sign")
+	}
+	if got := RashiName(5); got != "Simha" {
+		t.Errorf("RashiName(5) = %q, want Simha", got)
+	}
+	if got := RashiName(13); got != "Mesha" {
+		t.Errorf("RashiName(13) = %q, want Mesha", got)
+	}
+}
+
+func TestMoonRashi(t *testing.T) {
+	// Nine padas make a sign, so Krittika has its first pada in Mesha and
+	// the others in Vrishabha.
+	for at := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC); at.Before(time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC)); at = at.Add(5 * time.Hour) {
+		p := CalculateNakshatraPada(at)
+		want := ((p.Nakshatra.Number-1)*4+p.Pada-1)/9 + 1
+		if got := MoonRashi(at); got != want {
+			t.Errorf("MoonRashi(%v) = %d, want %d for %s pada %d", at, got, want, p.Nakshatra.Name, p.Pada)
+		}
+	}
+}
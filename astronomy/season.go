@@ -0,0 +1,80 @@
+package astronomy
+
+import "time"
+
+// Names of the six seasons, starting with spring.
+var rituNames = []string{"Vasanta", "Grishma", "Varsha", "Sharad", "Hemanta", "Shishira"}
+
+// Ritu is one of the six seasons of two months each.
+type Ritu struct {
+	// Number is the position of the season starting from Vasanta = 1.
+	Number int
+	Name   string
+}
+
+// Ayana is the half year during which the sun moves north or south. It is
+// the same in both hemispheres, although Uttarayana brings longer days only
+// in the northern one.
+type Ayana string
+
+const (
+	// Uttarayana runs from the winter to the summer solstice.
+	Uttarayana Ayana = "Uttarayana"
+	// Dakshinayana runs from the summer to the winter solstice.
+	Dakshinayana Ayana = "Dakshinayana"
+)
+
+// Hemisphere is the half of the Earth north or south of the equator.
+type Hemisphere string
+
+const (
+	Northern Hemisphere = "northern"
+	Southern Hemisphere = "southern"
+)
+
+// HemisphereOf returns the hemisphere of a latitude in degrees. The equator
+// is counted as northern.
+func HemisphereOf(latitude float64) Hemisphere {
+	if latitude < 0 {
+		return Southern
+	}
+	return Northern
+}
+
+// CalculateRitu returns the traditional season at t. Seasons follow the
+// tropical longitude of the sun in 60 degree steps, Vasanta starting at 330
+// degrees, a month before the March equinox. The traditional seasons
+// describe the northern hemisphere.
+func CalculateRitu(t time.Time) Ritu {
+	return rituAt(SunLongitude(JulianDayTT(t)))
+}
+
+// CalculateLocalRitu returns the season experienced at latitude at t. In the
+// southern hemisphere the seasons are shifted by half a year, so that
+// Grishma is the local summer rather than the northern one.
+func CalculateLocalRitu(t time.Time, latitude float64) Ritu {
+	longitude := SunLongitude(JulianDayTT(t))
+	if HemisphereOf(latitude) == Southern {
+		longitude += 180
+	}
+	return rituAt(longitude)
+}
+
+func rituAt(sunLongitude float64) Ritu {
+	index := int(normalizeDegrees(sunLongitude+30) / 60)
+	return Ritu{Number: index + 1, Name: rituNames[index]}
+}
+
+// RituNames returns the names of the six seasons from Vasanta.
+func RituNames() []string {
+	return append([]string(nil), rituNames...)
+}
+
+// CalculateAyana returns the half year at t, bounded by the solstices.
+func CalculateAyana(t time.Time) Ayana {
+	longitude := SunLongitude(JulianDayTT(t))
+	if longitude >= 90 && longitude < 270 {
+		return Dakshinayana
+	}
+	return Uttarayana
+}
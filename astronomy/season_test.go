@@ -0,0 +1,75 @@
+package astronomy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalculateSamvatsara(t *testing.T) {
+	tests := []struct {
+		name string
+		t    time.Time
+		want string
+	}{
+		// Ugadi fell on 2023-03-22 and 2024-04-09.
+		{"before ugadi 2023", time.Date(2023, 3, 10, 6, 0, 0, 0, time.UTC), "Shubhakrit"},
+		{"after ugadi 2023", time.Date(2023, 4, 1, 6, 0, 0, 0, time.UTC), "Shobhakrit"},
+		{"before ugadi 2024", time.Date(2024, 4, 1, 6, 0, 0, 0, time.UTC), "Shobhakrit"},
+		{"after ugadi 2024", time.Date(2024, 4, 10, 6, 0, 0, 0, time.UTC), "Krodhi"},
+		{"late 2024", time.Date(2024, 12, 31, 6, 0, 0, 0, time.UTC), "Krodhi"},
+		{"prabhava", time.Date(1987, 6, 1, 6, 0, 0, 0, time.UTC), "Prabhava"},
+		{"akshaya", time.Date(1986, 6, 1, 6, 0, 0, 0, time.UTC), "Akshaya"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := CalculateSamvatsara(tt.t); got.Name != tt.want {
+				t.Errorf("CalculateSamvatsara() = %+v, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateRituAndAyana(t *testing.T) {
+	tests := []struct {
+		t     time.Time
+		ritu  string
+		ayana Ayana
+	}{
+		{time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "Vasanta", Uttarayana},
+		{time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC), "Grishma", Uttarayana},
+		{time.Date(2024, 7, 1, 0, 0, 0, 0, time.UTC), "Varsha", Dakshinayana},
+		{time.Date(2024, 9, 1, 0, 0, 0, 0, time.UTC), "Sharad", Dakshinayana},
+		{time.Date(2024, 11, 1, 0, 0, 0, 0, time.UTC), "Hemanta", Dakshinayana},
+		{time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC), "Shishira", Uttarayana},
+	}
+	for _, tt := range tests {
+		if got := CalculateRitu(tt.t); got.Name != tt.ritu {
+			t.Errorf("CalculateRitu(%s) = %+v, want %s", tt.t.Format("2006-01-02"), got, tt.ritu)
+		}
+		if got := CalculateAyana(tt.t); got != tt.ayana {
+			t.Errorf("CalculateAyana(%s) = %s, want %s", tt.t.Format("2006-01-02"), got, tt.ayana)
+		}
+	}
+}
+
+func TestCalculateLocalRitu(t *testing.T) {
+	june := time.Date(2024, 6, 15, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name     string
+		latitude float64
+		want     string
+	}{
+		{"delhi", 28.6139, "Grishma"},
+		{"equator", 0, "Grishma"},
+		{"sydney", -33.8688, "Hemanta"},
+		{"sao paulo", -23.5505, "Hemanta"},
+	}
+	for _, tt := range tests {
+		if got := CalculateLocalRitu(june, tt.latitude); got.Name != tt.want {
+			t.Errorf("%s: CalculateLocalRitu() = %+v, want %s", tt.name, got, tt.want)
+		}
+	}
+	if got := CalculateRitu(june); got.Name != "Grishma" {
+		t.Errorf("CalculateRitu() = %+v, want Grishma regardless of hemisphere", got)
+	}
+}
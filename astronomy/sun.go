@@ -0,0 +1,373 @@
+package astronomy
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// ErrNoSunrise is returned when the sun does not cross the horizon on the
+// requested date (polar day or polar night). The error returned is a
+// *NoSunriseError telling which.
+var ErrNoSunrise = errors.New("sun does not rise or set on this date")
+
+// PolarCondition tells why the sun does not rise or set on a day.
+type PolarCondition string
+
+const (
+	// MidnightSun is a day on which the sun stays above the horizon.
+	MidnightSun PolarCondition = "midnight_sun"
+	// PolarNight is a day on which the sun stays below the horizon.
+	PolarNight PolarCondition = "polar_night"
+)
+
+// NoSunriseError reports a day without sunrise or sunset. It matches
+// ErrNoSunrise.
+type NoSunriseError struct {
+	Condition PolarCondition
+}
+
+func (e *NoSunriseError) Error() string {
+	if e.Condition == MidnightSun {
+		return "sun does not set on this date (midnight sun)"
+	}
+	return "sun does not rise on this date (polar night)"
+}
+
+func (e *NoSunriseError) Is(target error) bool {
+	return target == ErrNoSunrise
+}
+
+// noSunrise returns the error for a day on which the cosine of the hour
+// angle of sunrise is cosHourAngle, outside [-1, 1].
+func noSunrise(cosHourAngle float64) error {
+	if cosHourAngle < -1 {
+		return &NoSunriseError{Condition: MidnightSun}
+	}
+	return &NoSunriseError{Condition: PolarNight}
+}
+
+// Location is an observer position on the Earth.
+type Location struct {
+	// Latitude in degrees, positive north.
+	Latitude float64
+	// Longitude in degrees, positive east.
+	Longitude float64
+	// Elevation above sea level in metres. It only affects topocentric
+	// positions.
+	Elevation float64
+}
+
+// SunTimes holds the sunrise and sunset for a single civil day.
+type SunTimes struct {
+	Sunrise time.Time
+	Sunset  time.Time
+	// Convention is the convention the times were calculated with.
+	Convention SunConvention
+	// Condition is set on a day without sunrise or sunset calculated with
+	// WithPolarFallback, whose times are then the conventional ones of
+	// PolarSunTimes.
+	Condition PolarCondition
+}
+
+// SunConvention selects how sunrise and sunset are calculated.
+type SunConvention string
+
+const (
+	// ApparentSun uses the true position of the sun, so that noon follows
+	// the equation of time, and the upper limb of the refracted disc at the
+	// horizon. It matches the sun as observed and is the default.
+	ApparentSun SunConvention = "apparent"
+	// MeanSun centres the day on local mean noon and takes the centre of
+	// the unrefracted disc at the horizon, as do almanacs that ignore the
+	// equation of time and refraction.
+	MeanSun SunConvention = "mean"
+)
+
+// ParseSunConvention returns the convention named s. The empty string
+// selects ApparentSun.
+func ParseSunConvention(s string) (SunConvention, error) {
+	switch SunConvention(s) {
+	case "", ApparentSun:
+		return ApparentSun, nil
+	case MeanSun:
+		return MeanSun, nil
+	default:
+		return "", fmt.Errorf("unknown sun convention %q: expected %s or %s", s, ApparentSun, MeanSun)
+	}
+}
+
+// SunOption configures CalculateSunTimes.
+type SunOption func(*sunConfig)
+
+type sunConfig struct {
+	convention SunConvention
+	// refraction is the refraction at the horizon in degrees.
+	refraction float64
+	dip        bool
+	polar      bool
+}
+
+// WithPolarFallback returns the conventional times of PolarSunTimes, with
+// Condition set, for a day on which the sun does not rise or set, instead of
+// a *NoSunriseError. It lets quantities reckoned from sunrise be defined
+// everywhere.
+func WithPolarFallback() SunOption {
+	return func(cfg *sunConfig) { cfg.polar = true }
+}
+
+// PolarSunTimes returns the times used for a day without sunrise or sunset
+// at loc: 06:00 and 18:00 local mean time, the sunrise and sunset of the
+// equinox on the equator.
+func PolarSunTimes(loc Location, date time.Time, condition PolarCondition) *SunTimes {
+	y, m, d := date.Date()
+	noon := time.Date(y, m, d, 12, 0, 0, 0, time.UTC).Add(-durationFromDays(loc.Longitude / 360))
+	tz := date.Location()
+	return &SunTimes{
+		Sunrise:    noon.Add(-6 * time.Hour).In(tz),
+		Sunset:     noon.Add(6 * time.Hour).In(tz),
+		Convention: MeanSun,
+		Condition:  condition,
+	}
+}
+
+// WithAtmosphere computes the refraction at the horizon for the given
+// pressure in hectopascals and temperature in degrees Celsius instead of
+// the standard 1010 hPa and 10 °C. Refraction lifts the sun by about half a
+// degree at the horizon, less in thin or warm air, so at altitude sunrise
+// comes later than with the standard atmosphere. It does not apply to the
+// MeanSun convention.
+func WithAtmosphere(pressure, temperature float64) SunOption {
+	return func(cfg *sunConfig) {
+		cfg.refraction = horizonRefraction * pressure / standardPressure *
+			(273 + standardTemperature) / (273 + temperature)
+	}
+}
+
+// WithHorizonDip lowers the horizon by the dip seen from the elevation of
+// the location, so that an observer on a mountain sees the sun rise earlier
+// and set later over a distant sea-level horizon. It does not apply to the
+// MeanSun convention.
+func WithHorizonDip() SunOption {
+	return func(cfg *sunConfig) { cfg.dip = true }
+}
+
+// WithSunConvention selects the convention sunrise and sunset are
+// calculated with.
+func WithSunConvention(c SunConvention) SunOption {
+	return func(cfg *sunConfig) { cfg.convention = c }
+}
+
+// DayLength returns the time between sunrise and sunset.
+func (s *SunTimes) DayLength() time.Duration {
+	return s.Sunset.Sub(s.Sunrise)
+}
+
+// sunAltitudeAtRiseSet is the apparent altitude of the sun's centre at
+// sunrise and sunset, accounting for refraction and the solar semi-diameter.
+const sunAltitudeAtRiseSet = -0.833
+
+// The standard atmosphere and its refraction at the horizon in degrees,
+// which sunAltitudeAtRiseSet includes.
+const (
+	standardPressure    = 1010.0
+	standardTemperature = 10.0
+	horizonRefraction   = 34.0 / 60
+)
+
+// riseSetAltitude returns the altitude of the sun's centre at sunrise and
+// sunset at loc.
+func (cfg sunConfig) riseSetAltitude(loc Location) float64 {
+	altitude := sunAltitudeAtRiseSet - (cfg.refraction - horizonRefraction)
+	if cfg.dip && loc.Elevation > 0 {
+		// The dip of the horizon is 1.76' times the square root of the
+		// elevation in metres, refraction included.
+		altitude -= 1.76 / 60 * math.Sqrt(loc.Elevation)
+	}
+	return altitude
+}
+
+// CalculateSunTimes returns the sunrise and sunset for the civil date of
+// date, interpreted in date's location. The returned times are in the same
+// location as date.
+//
+// Each event is found by iterating on the hour angle of the sun, evaluating
+// its position from SunLongitude at the estimated time of the event itself
+// rather than once per day (Meeus, Astronomical Algorithms, chapter 15).
+// The times agree with the geometric crossing of the rise and set altitude
+// to within a few seconds at every latitude where the sun rises and sets.
+//
+// With the MeanSun convention the times are instead placed symmetrically
+// around local mean noon. WithAtmosphere and WithHorizonDip adjust the
+// altitude of the sun at sunrise and sunset for the local atmosphere and the
+// observer's elevation; by default the standard atmosphere and a sea-level
+// horizon are assumed, whatever the elevation of loc.
+//
+// On a day without sunrise or sunset it returns a *NoSunriseError, or with
+// WithPolarFallback the times of PolarSunTimes.
+func CalculateSunTimes(loc Location, date time.Time, opts ...SunOption) (*SunTimes, error) {
+	cfg := sunConfig{convention: ApparentSun, refraction: horizonRefraction}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	sunTimes, err := calculateSunTimes(loc, date, cfg)
+	var noSunrise *NoSunriseError
+	if cfg.polar && errors.As(err, &noSunrise) {
+		return PolarSunTimes(loc, date, noSunrise.Condition), nil
+	}
+	return sunTimes, err
+}
+
+func calculateSunTimes(loc Location, date time.Time, cfg sunConfig) (*SunTimes, error) {
+	y, m, d := date.Date()
+	// Mean solar noon at the observer's longitude seeds the search.
+	noon := JulianDayUT1(time.Date(y, m, d, 12, 0, 0, 0, time.UTC)) - loc.Longitude/360
+	tz := date.Location()
+	if cfg.convention == MeanSun {
+		return meanSunTimes(loc, noon, tz)
+	}
+	altitude := cfg.riseSetAltitude(loc)
+	transit, err := sunHourAngleCrossing(loc, noon, 0, altitude)
+	if err != nil {
+		return nil, err
+	}
+	sunrise, err := sunHourAngleCrossing(loc, transit, -1, altitude)
+	if err != nil {
+		return nil, err
+	}
+	sunset, err := sunHourAngleCrossing(loc, transit, 1, altitude)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SunTimes{
+		Sunrise:    TimeFromJulianDayUT1(sunrise).In(tz),
+		Sunset:     TimeFromJulianDayUT1(sunset).In(tz),
+		Convention: ApparentSun,
+	}, nil
+}
+
+// meanSunTimes returns the sunrise and sunset around local mean noon, the
+// Julian day noon in UT1, when the centre of the sun is on the geometric horizon.
+// The declination of the sun is taken at noon.
+func meanSunTimes(loc Location, noon float64, tz *time.Location) (*SunTimes, error) {
+	jde := tt(noon)
+	_, declination := Equatorial(SunLongitude(jde), 0, jde)
+	cosHourAngle := -tanDeg(loc.Latitude) * tanDeg(declination)
+	if cosHourAngle < -1 || cosHourAngle > 1 {
+		return nil, noSunrise(cosHourAngle)
+	}
+	// The hour angle of the mean sun advances 360 degrees per solar day.
+	halfDay := math.Acos(cosHourAngle) * rad2deg / 360
+	return &SunTimes{
+		Sunrise:    TimeFromJulianDayUT1(noon - halfDay).In(tz),
+		Sunset:     TimeFromJulianDayUT1(noon + halfDay).In(tz),
+		Convention: MeanSun,
+	}, nil
+}
+
+// sunEventTolerance is the correction, in days, below which the time of a
+// sun event is accepted. It is about a tenth of a second.
+const sunEventTolerance = 1e-6
+
+// sunHourAngleCrossing refines jd, in UT1, to the instant the sun reaches altitude
+// on the eastern (side -1) or western (side 1) side of the meridian, or
+// crosses the meridian (side 0).
+func sunHourAngleCrossing(loc Location, jd float64, side int, altitude float64) (float64, error) {
+	// ΔT changes by a fraction of a second in a day.
+	deltaT := tt(jd) - jd
+	for i := 0; i < 10; i++ {
+		jde := jd + deltaT
+		rightAscension, declination := Equatorial(SunLongitude(jde), 0, jde)
+		target := 0.0
+		if side != 0 {
+			cosHourAngle := (sinDeg(altitude) - sinDeg(loc.Latitude)*sinDeg(declination)) /
+				(cosDeg(loc.Latitude) * cosDeg(declination))
+			if cosHourAngle < -1 || cosHourAngle > 1 {
+				return 0, noSunrise(cosHourAngle)
+			}
+			target = float64(side) * math.Acos(cosHourAngle) * rad2deg
+		}
+		hourAngle := SiderealTime(jd) + loc.Longitude - rightAscension
+		// The sidereal day is shorter than the solar one.
+		correction := (normalizeDegrees(target-hourAngle+180) - 180) / 360.98564736629
+		jd += correction
+		if math.Abs(correction) < sunEventTolerance {
+			break
+		}
+	}
+	return jd, nil
+}
+
+// SunLongitude returns the apparent tropical ecliptic longitude of the sun in
+// degrees for the Julian Ephemeris Day jd, e.g. of JulianDayTT, using the
+// low precision solar
+// coordinates from Meeus, Astronomical Algorithms, chapter 25.
+func SunLongitude(jd float64) float64 {
+	t := (jd - J2000) / 36525
+	meanLongitude := 280.46646 + 36000.76983*t + 0.0003032*t*t
+	meanAnomaly := 357.52911 + 35999.05029*t - 0.0001537*t*t
+	center := (1.914602-0.004817*t-0.000014*t*t)*sinDeg(meanAnomaly) +
+		(0.019993-0.000101*t)*sinDeg(2*meanAnomaly) +
+		0.000289*sinDeg(3*meanAnomaly)
+	omega := 125.04 - 1934.136*t
+	return normalizeDegrees(meanLongitude + center - 0.00569 - 0.00478*sinDeg(omega))
+}
+
+// SunDistance returns the distance between the centres of the Earth and the
+// sun in astronomical units for the Julian Ephemeris Day jd.
+func SunDistance(jd float64) float64 {
+	t := (jd - J2000) / 36525
+	meanAnomaly := 357.52911 + 35999.05029*t - 0.0001537*t*t
+	eccentricity := 0.016708634 - 0.000042037*t - 0.0000001267*t*t
+	center := (1.914602-0.004817*t-0.000014*t*t)*sinDeg(meanAnomaly) +
+		(0.019993-0.000101*t)*sinDeg(2*meanAnomaly) +
+		0.000289*sinDeg(3*meanAnomaly)
+	trueAnomaly := meanAnomaly + center
+	return 1.000001018 * (1 - eccentricity*eccentricity) / (1 + eccentricity*cosDeg(trueAnomaly))
+}
+
+// SunDrift is the day-to-day change of the sun times.
+type SunDrift struct {
+	// Sunrise and Sunset are how much later the sun rises and sets than on
+	// the previous day. Negative values mean earlier. Clock changes such as
+	// daylight saving time are not included.
+	Sunrise time.Duration
+	Sunset  time.Duration
+	// DayLength is the change of the day length from the previous day.
+	DayLength time.Duration
+}
+
+// Trend describes the direction in which the day length is changing.
+type Trend string
+
+const (
+	Lengthening Trend = "lengthening"
+	Shortening  Trend = "shortening"
+	Steady      Trend = "steady"
+)
+
+// Trend returns whether the days are getting longer or shorter. Changes of
+// less than a second, as around the solstices, are reported as steady.
+func (d *SunDrift) Trend() Trend {
+	switch {
+	case d.DayLength >= time.Second:
+		return Lengthening
+	case d.DayLength <= -time.Second:
+		return Shortening
+	default:
+		return Steady
+	}
+}
+
+// CalculateSunDrift returns the change from the sun times of one day to
+// those of the next.
+func CalculateSunDrift(previous, current *SunTimes) *SunDrift {
+	const day = 24 * time.Hour
+	return &SunDrift{
+		Sunrise:   current.Sunrise.Sub(previous.Sunrise) - day,
+		Sunset:    current.Sunset.Sub(previous.Sunset) - day,
+		DayLength: current.DayLength() - previous.DayLength(),
+	}
+}
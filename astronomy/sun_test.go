@@ -0,0 +1,290 @@
+package astronomy
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func mustLoadLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	tz, err := time.LoadLocation(name)
+	if err != nil {
+		t.Fatalf("LoadLocation(%q) error = %v", name, err)
+	}
+	return tz
+}
+
+func withinMinutes(got, want time.Time, minutes float64) bool {
+	d := got.Sub(want)
+	if d < 0 {
+		d = -d
+	}
+	return d <= time.Duration(minutes*float64(time.Minute))
+}
+
+func TestCalculateSunTimes(t *testing.T) {
+	ist := mustLoadLocation(t, "Asia/Kolkata")
+	nyc := mustLoadLocation(t, "America/New_York")
+
+	tests := []struct {
+		name        string
+		loc         Location
+		date        time.Time
+		wantSunrise time.Time
+		wantSunset  time.Time
+	}{
+		{
+			"new delhi summer solstice",
+			Location{Latitude: 28.6139, Longitude: 77.2090},
+			time.Date(2024, 6, 21, 0, 0, 0, 0, ist),
+			time.Date(2024, 6, 21, 5, 24, 0, 0, ist),
+			time.Date(2024, 6, 21, 19, 22, 0, 0, ist),
+		},
+		{
+			"new york winter",
+			Location{Latitude: 40.7128, Longitude: -74.0060},
+			time.Date(2024, 1, 15, 0, 0, 0, 0, nyc),
+			time.Date(2024, 1, 15, 7, 18, 0, 0, nyc),
+			time.Date(2024, 1, 15, 16, 52, 0, 0, nyc),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := CalculateSunTimes(tt.loc, tt.date)
+			if err != nil {
+				t.Fatalf("CalculateSunTimes() error = %v", err)
+			}
+			if !withinMinutes(got.Sunrise, tt.wantSunrise, 1) {
+				t.Errorf("Sunrise = %v, want %v", got.Sunrise, tt.wantSunrise)
+			}
+			if !withinMinutes(got.Sunset, tt.wantSunset, 1) {
+				t.Errorf("Sunset = %v, want %v", got.Sunset, tt.wantSunset)
+			}
+			if got.Sunrise.Location() != tt.date.Location() {
+				t.Errorf("Sunrise location = %v, want %v", got.Sunrise.Location(), tt.date.Location())
+			}
+		})
+	}
+}
+
+func TestCalculateSunTimesAltitude(t *testing.T) {
+	// The simplified sunrise equation used before evaluated the sun once a
+	// day and missed the rise and set altitude by up to a minute at Delhi,
+	// a minute and a half at New York and three minutes at Reykjavik.
+	// Evaluating the sun at each event brings all three within seconds.
+	locations := map[string]Location{
+		"delhi":     {Latitude: 28.6139, Longitude: 77.2090},
+		"new york":  {Latitude: 40.7128, Longitude: -74.0060},
+		"sydney":    {Latitude: -33.8688, Longitude: 151.2093},
+		"reykjavik": {Latitude: 64.1466, Longitude: -21.9426},
+	}
+	for name, loc := range locations {
+		t.Run(name, func(t *testing.T) {
+			for day := 0; day < 366; day += 5 {
+				date := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC).AddDate(0, 0, day)
+				got, err := CalculateSunTimes(loc, date)
+				if err != nil {
+					t.Fatalf("CalculateSunTimes(%v) error = %v", date, err)
+				}
+				for _, event := range []time.Time{got.Sunrise, got.Sunset} {
+					if altitude := sunAltitude(loc, event); math.Abs(altitude-sunAltitudeAtRiseSet) > 0.005 {
+						t.Errorf("sun altitude at %v = %.4f, want %.3f", event, altitude, sunAltitudeAtRiseSet)
+					}
+				}
+			}
+		})
+	}
+}
+
+// sunAltitude returns the altitude of the sun's centre above the horizon
+// at loc in degrees.
+func sunAltitude(loc Location, t time.Time) float64 {
+	jd := JulianDayTT(t)
+	rightAscension, declination := Equatorial(SunLongitude(jd), 0, jd)
+	hourAngle := SiderealTime(JulianDayUT1(t)) + loc.Longitude - rightAscension
+	return math.Asin(sinDeg(loc.Latitude)*sinDeg(declination)+
+		cosDeg(loc.Latitude)*cosDeg(declination)*cosDeg(hourAngle)) * rad2deg
+}
+
+func TestCalculateSunTimesPolar(t *testing.T) {
+	// Longyearbyen, Svalbard has polar night in December and the midnight
+	// sun in June.
+	longyearbyen := Location{Latitude: 78.22, Longitude: 15.65}
+	tests := []struct {
+		date time.Time
+		want PolarCondition
+	}{
+		{time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), PolarNight},
+		{time.Date(2024, 6, 21, 0, 0, 0, 0, time.UTC), MidnightSun},
+	}
+	for _, tt := range tests {
+		for _, convention := range []SunConvention{ApparentSun, MeanSun} {
+			_, err := CalculateSunTimes(longyearbyen, tt.date, WithSunConvention(convention))
+			if !errors.Is(err, ErrNoSunrise) {
+				t.Errorf("CalculateSunTimes(%v, %s) error = %v, want %v", tt.date, convention, err, ErrNoSunrise)
+			}
+			var noSunrise *NoSunriseError
+			if !errors.As(err, &noSunrise) || noSunrise.Condition != tt.want {
+				t.Errorf("CalculateSunTimes(%v, %s) error = %v, want %s", tt.date, convention, err, tt.want)
+			}
+		}
+
+		got, err := CalculateSunTimes(longyearbyen, tt.date, WithPolarFallback())
+		if err != nil {
+			t.Fatalf("CalculateSunTimes(WithPolarFallback) error = %v", err)
+		}
+		// 06:00 local mean time is 04:57:24 UTC at 15.65 degrees east.
+		wantSunrise := time.Date(tt.date.Year(), tt.date.Month(), tt.date.Day(), 4, 57, 24, 0, time.UTC)
+		if got.Condition != tt.want || !got.Sunrise.Equal(wantSunrise) || got.DayLength() != 12*time.Hour {
+			t.Errorf("CalculateSunTimes(WithPolarFallback) = %+v, want %s from %v", got, tt.want, wantSunrise)
+		}
+	}
+
+	// Days with a sunrise are unaffected by the fallback.
+	date := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+	want, _ := CalculateSunTimes(longyearbyen, date)
+	if got, _ := CalculateSunTimes(longyearbyen, date, WithPolarFallback()); *got != *want {
+		t.Errorf("CalculateSunTimes(WithPolarFallback) = %+v, want %+v", got, want)
+	}
+}
+
+func TestCalculateSunDrift(t *testing.T) {
+	delhi := Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz := mustLoadLocation(t, "Asia/Kolkata")
+
+	tests := []struct {
+		name  string
+		date  time.Time
+		trend Trend
+	}{
+		{"spring", time.Date(2024, 3, 20, 0, 0, 0, 0, tz), Lengthening},
+		{"autumn", time.Date(2024, 9, 22, 0, 0, 0, 0, tz), Shortening},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			previous, err := CalculateSunTimes(delhi, tt.date.AddDate(0, 0, -1))
+			if err != nil {
+				t.Fatal(err)
+			}
+			current, err := CalculateSunTimes(delhi, tt.date)
+			if err != nil {
+				t.Fatal(err)
+			}
+			drift := CalculateSunDrift(previous, current)
+			if drift.Trend() != tt.trend {
+				t.Errorf("Trend() = %s, want %s", drift.Trend(), tt.trend)
+			}
+			// Near the equinoxes Delhi's day length changes by about a
+			// minute and three quarters a day, split between sunrise and sunset.
+			if drift.DayLength.Abs() < 90*time.Second || drift.DayLength.Abs() > 120*time.Second {
+				t.Errorf("DayLength drift = %v", drift.DayLength)
+			}
+			if drift.Sunset-drift.Sunrise != drift.DayLength {
+				t.Errorf("drift %+v is inconsistent", drift)
+			}
+		})
+	}
+
+	// At the June solstice the day length barely changes.
+	previous, _ := CalculateSunTimes(delhi, time.Date(2024, 6, 20, 0, 0, 0, 0, tz))
+	current, _ := CalculateSunTimes(delhi, time.Date(2024, 6, 21, 0, 0, 0, 0, tz))
+	if drift := CalculateSunDrift(previous, current); drift.DayLength.Abs() > 2*time.Second {
+		t.Errorf("DayLength drift at solstice = %v", drift.DayLength)
+	}
+}
+
+func TestSunDistance(t *testing.T) {
+	// Meeus example 25.a: 1992 October 13, 0h TD.
+	if got, want := SunDistance(2448908.5), 0.99766; math.Abs(got-want) > 0.0001 {
+		t.Errorf("SunDistance() = %.5f, want %.5f", got, want)
+	}
+}
+
+func TestCalculateSunTimesMeanSun(t *testing.T) {
+	delhi := Location{Latitude: 28.6139, Longitude: 77.2090}
+	// Around 3 November the equation of time peaks: the true sun crosses
+	// the meridian about 16.4 minutes before the mean sun.
+	date := time.Date(2024, 11, 3, 0, 0, 0, 0, mustLoadLocation(t, "Asia/Kolkata"))
+	apparent, err := CalculateSunTimes(delhi, date)
+	if err != nil {
+		t.Fatalf("CalculateSunTimes() error = %v", err)
+	}
+	mean, err := CalculateSunTimes(delhi, date, WithSunConvention(MeanSun))
+	if err != nil {
+		t.Fatalf("CalculateSunTimes(MeanSun) error = %v", err)
+	}
+	if apparent.Convention != ApparentSun || mean.Convention != MeanSun {
+		t.Errorf("Convention = %q and %q, want %q and %q", apparent.Convention, mean.Convention, ApparentSun, MeanSun)
+	}
+
+	// Mean noon at 77.209°E is 12:00 UTC less 5h 8m 50.16s.
+	meanNoon := time.Date(2024, 11, 3, 6, 51, 9, 840_000_000, time.UTC)
+	if got := mean.Sunrise.Add(mean.DayLength() / 2); !withinMinutes(got, meanNoon, 1.0/60) {
+		t.Errorf("mean sun day centred on %v, want %v", got.UTC(), meanNoon)
+	}
+	eot := meanNoon.Sub(apparent.Sunrise.Add(apparent.DayLength() / 2))
+	if eot < 16*time.Minute || eot > 17*time.Minute {
+		t.Errorf("apparent noon %v before mean noon, want about 16.4 minutes", eot)
+	}
+	// Without refraction and semi-diameter the day is several minutes
+	// shorter.
+	if shorter := apparent.DayLength() - mean.DayLength(); shorter < 6*time.Minute || shorter > 9*time.Minute {
+		t.Errorf("mean sun day %v shorter than the apparent one, want 6 to 9 minutes", shorter)
+	}
+
+	if _, err := CalculateSunTimes(Location{Latitude: 80}, time.Date(2024, 12, 21, 0, 0, 0, 0, time.UTC), WithSunConvention(MeanSun)); !errors.Is(err, ErrNoSunrise) {
+		t.Errorf("CalculateSunTimes(MeanSun) in polar night error = %v, want ErrNoSunrise", err)
+	}
+}
+
+func TestParseSunConvention(t *testing.T) {
+	for s, want := range map[string]SunConvention{"": ApparentSun, "apparent": ApparentSun, "mean": MeanSun} {
+		if got, err := ParseSunConvention(s); got != want || err != nil {
+			t.Errorf("ParseSunConvention(%q) = %q, %v, want %q, nil", s, got, err, want)
+		}
+	}
+	if _, err := ParseSunConvention("true"); err == nil {
+		t.Error("ParseSunConvention(\"true\") error = nil, want an error")
+	}
+}
+
+func TestCalculateSunTimesAtmosphereAndDip(t *testing.T) {
+	// Leh, at 3500 m.
+	date := time.Date(2024, 3, 20, 0, 0, 0, 0, time.UTC)
+	leh := Location{Latitude: 34.1526, Longitude: 77.5771, Elevation: 3500}
+	standard, err := CalculateSunTimes(leh, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	same, _ := CalculateSunTimes(leh, date, WithAtmosphere(standardPressure, standardTemperature))
+	if !same.Sunrise.Equal(standard.Sunrise) {
+		t.Errorf("standard atmosphere sunrise = %v, want %v", same.Sunrise, standard.Sunrise)
+	}
+
+	// Thin air refracts less, so the sun rises later and sets earlier.
+	thin, _ := CalculateSunTimes(leh, date, WithAtmosphere(660, 0))
+	if d := thin.Sunrise.Sub(standard.Sunrise); d < 30*time.Second || d > 2*time.Minute {
+		t.Errorf("sunrise in thin air is %v later, want about a minute", d)
+	}
+	if !thin.Sunset.Before(standard.Sunset) {
+		t.Errorf("sunset in thin air = %v, want before %v", thin.Sunset, standard.Sunset)
+	}
+
+	// A dip of 1.7 degrees over a distant horizon brings sunrise about
+	// eight minutes earlier.
+	dip, _ := CalculateSunTimes(leh, date, WithHorizonDip())
+	if d := standard.Sunrise.Sub(dip.Sunrise); d < 6*time.Minute || d > 10*time.Minute {
+		t.Errorf("sunrise with the horizon dip is %v earlier, want about eight minutes", d)
+	}
+	want := sunAltitudeAtRiseSet - 1.76/60*math.Sqrt(leh.Elevation)
+	if altitude := sunAltitude(leh, dip.Sunset); math.Abs(altitude-want) > 0.005 {
+		t.Errorf("sun altitude at sunset = %.4f, want %.4f", altitude, want)
+	}
+	sea, _ := CalculateSunTimes(Location{Latitude: leh.Latitude, Longitude: leh.Longitude}, date, WithHorizonDip())
+	if !sea.Sunrise.Equal(standard.Sunrise) {
+		t.Errorf("sunrise with the dip at sea level = %v, want %v", sea.Sunrise, standard.Sunrise)
+	}
+}
@@ -0,0 +1,219 @@
+package astronomy
+
+import (
+	"math"
+	"sort"
+	"time"
+)
+
+// Time scales
+//
+// Times are given as UTC instants, but the series of this package take two
+// other time scales. The positions of the sun and the moon take Terrestrial
+// Time (TT), the uniform time of the ephemerides, and the sidereal time the
+// rotation angle of the Earth, UT1. TT runs ahead of UTC by 32.184 seconds
+// plus the leap seconds, 69.184 seconds since 2017, and ahead of UT1 by
+// ΔT. Taking UTC as TT would place the moon, which moves its own diameter
+// in an hour, over a minute of time late.
+//
+// JulianDayTT and TimeFromJulianDayTT convert the instants of positions,
+// and JulianDayUT1 and TimeFromJulianDayUT1 those of sidereal time, e.g.
+// of sunrise. JulianDay itself stays on the UTC scale.
+
+// secondsPerDay is the number of SI seconds in a Julian day.
+const secondsPerDay = 86400
+
+// ttMinusTAI is TT − TAI in seconds.
+const ttMinusTAI = 32.184
+
+// leapSecond is TAI − UTC in seconds from a UTC instant on.
+type leapSecond struct {
+	from   int64 // Unix time
+	offset float64
+}
+
+// leapSeconds lists TAI − UTC since UTC took whole leap seconds in 1972,
+// from IERS Bulletin C. TAI − UTC is taken to stay at its last offset,
+// which needs updating when the IERS announces a leap second.
+var leapSeconds = []leapSecond{
+	{unixDate(1972, 1, 1), 10}, {unixDate(1972, 7, 1), 11}, {unixDate(1973, 1, 1), 12},
+	{unixDate(1974, 1, 1), 13}, {unixDate(1975, 1, 1), 14}, {unixDate(1976, 1, 1), 15},
+	{unixDate(1977, 1, 1), 16}, {unixDate(1978, 1, 1), 17}, {unixDate(1979, 1, 1), 18},
+	{unixDate(1980, 1, 1), 19}, {unixDate(1981, 7, 1), 20}, {unixDate(1982, 7, 1), 21},
+	{unixDate(1983, 7, 1), 22}, {unixDate(1985, 7, 1), 23}, {unixDate(1988, 1, 1), 24},
+	{unixDate(1990, 1, 1), 25}, {unixDate(1991, 1, 1), 26}, {unixDate(1992, 7, 1), 27},
+	{unixDate(1993, 7, 1), 28}, {unixDate(1994, 7, 1), 29}, {unixDate(1996, 1, 1), 30},
+	{unixDate(1997, 7, 1), 31}, {unixDate(1999, 1, 1), 32}, {unixDate(2006, 1, 1), 33},
+	{unixDate(2009, 1, 1), 34}, {unixDate(2012, 7, 1), 35}, {unixDate(2015, 7, 1), 36},
+	{unixDate(2017, 1, 1), 37},
+}
+
+func unixDate(year int, month time.Month, day int) int64 {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC).Unix()
+}
+
+// deltaTTableStart is the year of the first value of deltaTTable.
+const deltaTTableStart = 1900
+
+// deltaTTable lists ΔT = TT − UT1 in seconds at the start of each year from
+// deltaTTableStart, from the IERS and USNO determinations. Extend it each
+// year from IERS Bulletin A; deltaT extrapolates past its end.
+var deltaTTable = [...]float64{
+	-2.72, -1.54, -0.02, 1.24, 2.64, 3.86, 5.37, 6.14, 7.75, 9.13, // 1900
+	10.46, 11.53, 13.36, 14.65, 16.01, 17.20, 18.24, 19.06, 20.25, 20.95, // 1910
+	21.16, 22.25, 22.41, 23.03, 23.49, 23.62, 23.86, 24.49, 24.34, 24.08, // 1920
+	24.02, 24.00, 23.87, 23.95, 23.86, 23.93, 23.73, 23.92, 23.96, 24.02, // 1930
+	24.33, 24.83, 25.30, 25.70, 26.24, 26.77, 27.28, 27.78, 28.25, 28.71, // 1940
+	29.15, 29.57, 29.97, 30.36, 30.72, 31.07, 31.35, 31.68, 32.18, 32.68, // 1950
+	33.15, 33.59, 34.00, 34.47, 35.03, 35.73, 36.54, 37.43, 38.29, 39.20, // 1960
+	40.18, 41.17, 42.23, 43.37, 44.49, 45.48, 46.46, 47.52, 48.53, 49.59, // 1970
+	50.54, 51.38, 52.17, 52.96, 53.79, 54.34, 54.87, 55.32, 55.82, 56.30, // 1980
+	56.86, 57.57, 58.31, 59.12, 59.98, 60.78, 61.63, 62.29, 62.97, 63.47, // 1990
+	63.83, 64.09, 64.30, 64.47, 64.57, 64.69, 64.85, 65.15, 65.46, 65.78, // 2000
+	66.07, 66.32, 66.60, 66.91, 67.28, 67.64, 68.10, 68.59, 68.97, 69.22, // 2010
+	69.36, 69.36, 69.29, 69.20, 69.18, 69.13, // 2020
+}
+
+// deltaTTableEnd is the year of the last value of deltaTTable.
+const deltaTTableEnd = deltaTTableStart + float64(len(deltaTTable)-1)
+
+// deltaTPredictionEnd is the year from which deltaT follows the long-term
+// parabola of Morrison and Stephenson.
+const deltaTPredictionEnd = 2050
+
+// decimalYear returns the year, with its fraction, of the Julian day jd.
+func decimalYear(jd float64) float64 {
+	return 2000 + (jd-2451544.5)/365.25
+}
+
+// deltaT returns ΔT = TT − UT1 in seconds in the decimal year y. It
+// interpolates deltaTTable, and outside it follows the polynomials of
+// Espenak and Meeus, Five Millennium Canon of Solar Eclipses (2006). Their
+// prediction for the decades after the table has overestimated the recent
+// ΔT by seconds, so ΔT is rather taken to rise from the end of the table,
+// as flat as it has lately been, to meet the long-term parabola in 2050.
+func deltaT(y float64) float64 {
+	switch {
+	case y >= deltaTTableStart && y < deltaTTableEnd:
+		i := int(y) - deltaTTableStart
+		f := y - math.Floor(y)
+		return deltaTTable[i] + f*(deltaTTable[i+1]-deltaTTable[i])
+	case y >= deltaTTableEnd && y < deltaTPredictionEnd:
+		last := deltaTTable[len(deltaTTable)-1]
+		s := (y - deltaTTableEnd) / (deltaTPredictionEnd - deltaTTableEnd)
+		return last + (deltaT(deltaTPredictionEnd)-last)*s*s
+	case y >= deltaTPredictionEnd && y < 2150:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u - 0.5628*(2150-y)
+	case y >= 2150 || y < -500:
+		u := (y - 1820) / 100
+		return -20 + 32*u*u
+	case y < 500:
+		u := y / 100
+		return 10583.6 + u*(-1014.41+u*(33.78311+u*(-5.952053+u*(-0.1798452+u*(0.022174192+u*0.0090316521)))))
+	case y < 1600:
+		u := (y - 1000) / 100
+		return 1574.2 + u*(-556.01+u*(71.23472+u*(0.319781+u*(-0.8503463+u*(-0.005050998+u*0.0083572073)))))
+	case y < 1700:
+		t := y - 1600
+		return 120 + t*(-0.9808+t*(-0.01532+t/7129))
+	case y < 1800:
+		t := y - 1700
+		return 8.83 + t*(0.1603+t*(-0.0059285+t*(0.00013336-t/1174000)))
+	case y < 1860:
+		t := y - 1800
+		return 13.72 + t*(-0.332447+t*(0.0068612+t*(0.0041116+t*(-0.00037436+t*(0.0000121272+t*(-0.0000001699+t*0.000000000875))))))
+	default:
+		t := y - 1860
+		return 7.62 + t*(0.5737+t*(-0.251754+t*(0.01680668+t*(-0.0004473624+t/233174))))
+	}
+}
+
+// taiMinusUTC returns TAI − UTC in seconds at the Julian day jd on the UTC
+// scale, or zero before 1972.
+func taiMinusUTC(jd float64) float64 {
+	unix := (jd - unixEpochJD) * secondsPerDay
+	i := sort.Search(len(leapSeconds), func(i int) bool { return float64(leapSeconds[i].from) > unix })
+	if i == 0 {
+		return 0
+	}
+	return leapSeconds[i-1].offset
+}
+
+// ttMinusUTC returns TT − UTC in seconds at the Julian day jd on the UTC
+// scale. Before 1972 UTC kept within a fraction of a second of UT1 by
+// changing the length of its second, and is taken as UT1.
+func ttMinusUTC(jd float64) float64 {
+	if leap := taiMinusUTC(jd); leap > 0 {
+		return ttMinusTAI + leap
+	}
+	return deltaT(decimalYear(jd))
+}
+
+// DeltaT returns ΔT = TT − UT1 at t, the difference between the uniform
+// time of the ephemerides and that of the rotation of the Earth, about 69
+// seconds in 2024. It comes from a table of observed values, and beyond it
+// from predictions growing uncertain by seconds a decade into the future
+// and by minutes centuries ago.
+func DeltaT(t time.Time) time.Duration {
+	return secondsDuration(deltaT(decimalYear(JulianDay(t))))
+}
+
+// LeapSeconds returns TAI − UTC at t, the leap seconds inserted into UTC
+// since 1972 plus its initial ten seconds, or zero before 1972.
+func LeapSeconds(t time.Time) time.Duration {
+	return secondsDuration(taiMinusUTC(JulianDay(t)))
+}
+
+func secondsDuration(s float64) time.Duration {
+	return time.Duration(math.Round(s * float64(time.Second)))
+}
+
+// JulianDayTT returns the Julian Ephemeris Day of t, its Julian day in
+// Terrestrial Time, which the positions of the sun and the moon take.
+func JulianDayTT(t time.Time) float64 {
+	jd := JulianDay(t)
+	return jd + ttMinusUTC(jd)/secondsPerDay
+}
+
+// JulianDayUT1 returns the Julian day of t in UT1, which SiderealTime
+// takes.
+func JulianDayUT1(t time.Time) float64 {
+	return ut1(JulianDayTT(t))
+}
+
+// JulianDayTDB returns the Julian day of t in Barycentric Dynamical Time,
+// the time of the barycentric ephemerides, which differs from TT by
+// periodic terms under two milliseconds (Explanatory Supplement to the
+// Astronomical Almanac, 1992, 2.222-1).
+func JulianDayTDB(t time.Time) float64 {
+	jde := JulianDayTT(t)
+	g := (357.53 + 0.98560028*(jde-J2000)) * deg2rad
+	return jde + (0.001657*math.Sin(g)+0.000014*math.Sin(2*g))/secondsPerDay
+}
+
+// TimeFromJulianDayTT converts a Julian Ephemeris Day, e.g. of a position
+// of the moon, to a UTC time.
+func TimeFromJulianDayTT(jde float64) time.Time {
+	// TT − UTC is evaluated at the UTC instant, which it only changes
+	// by a minute or so: a second evaluation settles a leap second.
+	jd := jde - ttMinusUTC(jde)/secondsPerDay
+	jd = jde - ttMinusUTC(jd)/secondsPerDay
+	return TimeFromJulianDay(jd)
+}
+
+// TimeFromJulianDayUT1 converts a Julian day in UT1, e.g. of a sunrise
+// found from the sidereal time, to a UTC time.
+func TimeFromJulianDayUT1(jd float64) time.Time {
+	return TimeFromJulianDayTT(tt(jd))
+}
+
+// ut1 converts the Julian Ephemeris Day jde to UT1.
+func ut1(jde float64) float64 {
+	return jde - deltaT(decimalYear(jde))/secondsPerDay
+}
+
+// tt converts the Julian day jd in UT1 to Terrestrial Time.
+func tt(jd float64) float64 {
+	return jd + deltaT(decimalYear(jd))/secondsPerDay
+}
@@ -0,0 +1,99 @@
+package astronomy
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestLeapSeconds(t *testing.T) {
+	for _, tt := range []struct {
+		t    time.Time
+		want time.Duration
+	}{
+		{time.Date(1971, 12, 31, 23, 59, 59, 0, time.UTC), 0},
+		{time.Date(1972, 1, 1, 0, 0, 0, 0, time.UTC), 10 * time.Second},
+		{time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC), 36 * time.Second},
+		{time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC), 37 * time.Second},
+		{time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC), 37 * time.Second},
+	} {
+		if got := LeapSeconds(tt.t); got != tt.want {
+			t.Errorf("LeapSeconds(%v) = %v, want %v", tt.t, got, tt.want)
+		}
+	}
+}
+
+func TestDeltaT(t *testing.T) {
+	for _, tt := range []struct {
+		year      int
+		want, tol float64
+	}{
+		{1800, 13.7, 0.1},
+		{1950, 29.15, 0.01},
+		{2000, 63.83, 0.01},
+		{2024, 69.18, 0.01},
+		{2035, 73, 3},
+	} {
+		got := DeltaT(time.Date(tt.year, 1, 1, 0, 0, 0, 0, time.UTC)).Seconds()
+		if math.Abs(got-tt.want) > tt.tol {
+			t.Errorf("DeltaT(%d) = %.2fs, want %.2fs", tt.year, got, tt.want)
+		}
+	}
+	// JulianDay only covers the years 1678 to 2262, which UnixNano does.
+	if got := deltaT(1000); math.Abs(got-1574.2) > 0.1 {
+		t.Errorf("deltaT(1000) = %.1fs, want 1574.2s", got)
+	}
+	// The table and the polynomials meet, within the 0.16 seconds by
+	// which those of Espenak and Meeus do in 1700.
+	for _, y := range []float64{1700, 1800, 1860, deltaTTableStart, deltaTTableEnd, deltaTPredictionEnd, 2150} {
+		if d := math.Abs(deltaT(y-1e-9) - deltaT(y)); d > 0.2 {
+			t.Errorf("deltaT jumps by %.2fs at %g", d, y)
+		}
+	}
+}
+
+func TestJulianDayTT(t *testing.T) {
+	// J2000.0 is 2000-01-01 12:00 TT, 64.184 seconds ahead of UTC.
+	j2000 := time.Date(2000, 1, 1, 11, 58, 55, 816e6, time.UTC)
+	if got := JulianDayTT(j2000); math.Abs(got-J2000) > 1e-8 {
+		t.Errorf("JulianDayTT(%v) = %.8f, want %.1f", j2000, got, J2000)
+	}
+	// The TDB of the barycentric ephemerides is within two milliseconds.
+	if d := math.Abs(JulianDayTDB(j2000)-J2000) * secondsPerDay; d > 0.002 {
+		t.Errorf("TDB − TT at J2000.0 = %.4fs", d)
+	}
+
+	for _, at := range []time.Time{
+		time.Date(1900, 6, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(1971, 12, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(2016, 12, 31, 23, 59, 59, 0, time.UTC),
+		time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC),
+		time.Date(2024, 4, 9, 6, 11, 0, 0, time.UTC),
+		time.Date(2060, 1, 1, 0, 0, 0, 0, time.UTC),
+	} {
+		if got := TimeFromJulianDayTT(JulianDayTT(at)); got.Sub(at).Abs() > time.Millisecond {
+			t.Errorf("TimeFromJulianDayTT(JulianDayTT(%v)) = %v", at, got)
+		}
+		if got := TimeFromJulianDayUT1(JulianDayUT1(at)); got.Sub(at).Abs() > time.Millisecond {
+			t.Errorf("TimeFromJulianDayUT1(JulianDayUT1(%v)) = %v", at, got)
+		}
+	}
+}
+
+func TestJulianDayUT1(t *testing.T) {
+	// Leap seconds keep UTC within 0.9 seconds of UT1, which the table of
+	// ΔT must agree with.
+	for year := 1972; year <= int(deltaTTableEnd); year++ {
+		for _, month := range []time.Month{time.January, time.July} {
+			at := time.Date(year, month, 2, 0, 0, 0, 0, time.UTC)
+			if dut1 := (JulianDayUT1(at) - JulianDay(at)) * secondsPerDay; math.Abs(dut1) > 0.95 {
+				t.Errorf("UT1 − UTC on %s = %.2fs", at.Format("2006-01-02"), dut1)
+			}
+		}
+	}
+	// Before 1972 UTC is taken as UT1.
+	at := time.Date(1960, 1, 1, 0, 0, 0, 0, time.UTC)
+	if d := (JulianDayUT1(at) - JulianDay(at)) * secondsPerDay; math.Abs(d) > 1e-3 {
+		t.Errorf("UT1 − UTC in 1960 = %.4fs, want 0", d)
+	}
+}
@@ -0,0 +1,71 @@
+package astronomy
+
+import (
+	"fmt"
+	"math"
+)
+
+// MoonPosition selects the position of the moon elements are computed from.
+type MoonPosition string
+
+const (
+	// GeocentricMoon is the moon seen from the centre of the Earth, as in
+	// traditional panchangams. It is the default.
+	GeocentricMoon MoonPosition = "geocentric"
+	// TopocentricMoon is the moon seen by the observer, corrected for
+	// parallax.
+	TopocentricMoon MoonPosition = "topocentric"
+)
+
+// ParseMoonPosition returns the moon position named s. The empty string
+// selects GeocentricMoon.
+func ParseMoonPosition(s string) (MoonPosition, error) {
+	switch MoonPosition(s) {
+	case "", GeocentricMoon:
+		return GeocentricMoon, nil
+	case TopocentricMoon:
+		return TopocentricMoon, nil
+	default:
+		return "", fmt.Errorf("unknown moon position %q: expected %s or %s", s, GeocentricMoon, TopocentricMoon)
+	}
+}
+
+// earthRadius is the equatorial radius of the Earth in kilometres and
+// earthFlattening its flattening, per the IAU 1976 ellipsoid used by Meeus.
+const (
+	earthRadius     = 6378.14
+	earthFlattening = 1 / 298.257
+)
+
+// geocentricPosition returns ρ sin φ' and ρ cos φ' of loc, its distance
+// from the centre of the Earth in equatorial radii times the sine and the
+// cosine of its geocentric latitude, Meeus chapter 11.
+func (loc Location) geocentricPosition() (rhoSin, rhoCos float64) {
+	u := math.Atan((1 - earthFlattening) * math.Tan(loc.Latitude*deg2rad))
+	height := loc.Elevation / (earthRadius * 1000)
+	rhoSin = (1-earthFlattening)*math.Sin(u) + height*sinDeg(loc.Latitude)
+	rhoCos = math.Cos(u) + height*cosDeg(loc.Latitude)
+	return rhoSin, rhoCos
+}
+
+// MoonTopocentric returns the ecliptic longitude and latitude of the moon in
+// degrees as seen from loc rather than from the centre of the Earth. The
+// parallax of the moon is up to about a degree, which moves the end of a
+// tithi or nakshatra by up to a couple of hours for observers far from the
+// sub-lunar point and by minutes for most.
+//
+// It applies the parallax in ecliptic coordinates, Meeus equations 40.6.
+func MoonTopocentric(jd float64, loc Location) (longitude, latitude float64) {
+	longitude, latitude = MoonLongitude(jd), MoonLatitude(jd)
+	rhoSin, rhoCos := loc.geocentricPosition()
+	sinParallax := earthRadius / MoonDistance(jd)
+	obliquity := meanObliquity(jd)
+	localSidereal := SiderealTime(ut1(jd)) + loc.Longitude
+
+	n := cosDeg(longitude)*cosDeg(latitude) - rhoCos*sinParallax*cosDeg(localSidereal)
+	topocentric := math.Atan2(sinDeg(longitude)*cosDeg(latitude)-
+		sinParallax*(rhoSin*sinDeg(obliquity)+rhoCos*cosDeg(obliquity)*sinDeg(localSidereal)), n)
+	latitude = math.Atan(math.Cos(topocentric)*(sinDeg(latitude)-
+		sinParallax*(rhoSin*cosDeg(obliquity)-rhoCos*sinDeg(obliquity)*sinDeg(localSidereal)))/n) * rad2deg
+	return normalizeDegrees(topocentric * rad2deg), latitude
+}
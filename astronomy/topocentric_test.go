@@ -0,0 +1,71 @@
+package astronomy
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestGeocentricPosition(t *testing.T) {
+	// Meeus example 11.a: Palomar Observatory.
+	palomar := Location{Latitude: 33 + 21.0/60 + 22.0/3600, Longitude: -116.8625, Elevation: 1706}
+	rhoSin, rhoCos := palomar.geocentricPosition()
+	if math.Abs(rhoSin-0.546861) > 1e-6 || math.Abs(rhoCos-0.836339) > 1e-6 {
+		t.Errorf("geocentricPosition() = %f, %f, want 0.546861, 0.836339", rhoSin, rhoCos)
+	}
+}
+
+// altitude returns the altitude above the horizon at loc of the point at
+// the given ecliptic longitude and latitude at the Julian Ephemeris Day jd,
+// in degrees.
+func altitude(loc Location, longitude, latitude, jd float64) float64 {
+	rightAscension, declination := Equatorial(longitude, latitude, jd)
+	hourAngle := SiderealTime(ut1(jd)) + loc.Longitude - rightAscension
+	return math.Asin(sinDeg(loc.Latitude)*sinDeg(declination)+
+		cosDeg(loc.Latitude)*cosDeg(declination)*cosDeg(hourAngle)) * rad2deg
+}
+
+func TestMoonTopocentric(t *testing.T) {
+	chennai := Location{Latitude: 13.0827, Longitude: 80.2707, Elevation: 6}
+	start := time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)
+	for h := 0; h < 24*28; h += 7 {
+		jd := JulianDayTT(start.Add(time.Duration(h) * time.Hour))
+		longitude, latitude := MoonLongitude(jd), MoonLatitude(jd)
+		topoLongitude, topoLatitude := MoonTopocentric(jd, chennai)
+
+		// Parallax lowers the moon towards the horizon by the horizontal
+		// parallax times the cosine of the altitude.
+		geocentric := altitude(chennai, longitude, latitude, jd)
+		topocentric := altitude(chennai, topoLongitude, topoLatitude, jd)
+		rhoSin, rhoCos := chennai.geocentricPosition()
+		rho := math.Hypot(rhoSin, rhoCos)
+		want := math.Asin(rho*earthRadius/MoonDistance(jd)*cosDeg(geocentric)) * rad2deg
+		if got := geocentric - topocentric; math.Abs(got-want) > 0.01 {
+			t.Errorf("%v: parallax in altitude = %f, want %f", start.Add(time.Duration(h)*time.Hour), got, want)
+		}
+		if shift := math.Abs(normalizeDegrees(topoLongitude-longitude+180) - 180); shift > 1.1 {
+			t.Errorf("%v: topocentric longitude shifted by %f degrees", start.Add(time.Duration(h)*time.Hour), shift)
+		}
+	}
+}
+
+func TestWithObserver(t *testing.T) {
+	chennai := Location{Latitude: 13.0827, Longitude: 80.2707}
+	at := time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC)
+	geocentric := CalculateElementPeriod(TithiElement, at)
+	topocentric := CalculateElementPeriod(TithiElement, at, WithObserver(chennai))
+	if geocentric.Number != topocentric.Number {
+		t.Fatalf("topocentric tithi = %d, want %d", topocentric.Number, geocentric.Number)
+	}
+	shift := topocentric.End.Sub(geocentric.End).Abs()
+	if shift < time.Minute || shift > 3*time.Hour {
+		t.Errorf("topocentric tithi ends %v from the geocentric one, want minutes", shift)
+	}
+
+	// Elements at an instant use the same moon.
+	p := newElementConfig([]ElementOption{WithObserver(chennai)}).positionsAt(JulianDayTT(at))
+	want, _ := tithiSpec.at(p)
+	if got := CalculateElements(at, WithObserver(chennai)).Tithi; got != want {
+		t.Errorf("CalculateElements(WithObserver).Tithi = %v, want %v", got, want)
+	}
+}
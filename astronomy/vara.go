@@ -0,0 +1,21 @@
+package astronomy
+
+import "time"
+
+// varaNames are the names of the weekdays from Sunday.
+var varaNames = []string{"Ravivara", "Somavara", "Mangalavara", "Budhavara", "Guruvara", "Shukravara", "Shanivara"}
+
+// varaLords are the planets ruling the weekdays from Sunday.
+var varaLords = []string{"sun", "moon", "mars", "mercury", "jupiter", "venus", "saturn"}
+
+// CalculateVara returns the vara of the day beginning at sunrise, which
+// lasts until nextSunrise. It is the weekday of sunrise in its location, so
+// the hours before sunrise belong to the previous vara.
+func CalculateVara(sunrise, nextSunrise time.Time) ElementPeriod {
+	weekday := int(sunrise.Weekday())
+	return ElementPeriod{
+		Element: Element{Kind: VaraElement, Number: weekday + 1, Name: varaNames[weekday]},
+		Start:   sunrise,
+		End:     nextSunrise,
+	}
+}
@@ -0,0 +1,22 @@
+package astronomy
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCalculateVara(t *testing.T) {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	// Sunrise on Thursday, 14 March 2024 in Mumbai.
+	sunrise := time.Date(2024, 3, 14, 6, 49, 0, 0, ist)
+	vara := CalculateVara(sunrise, sunrise.Add(24*time.Hour))
+	if vara.Kind != VaraElement || vara.Number != 5 || vara.Name != "Guruvara" {
+		t.Errorf("CalculateVara() = %+v, want the fifth vara, Guruvara", vara.Element)
+	}
+	if !vara.Start.Equal(sunrise) || !vara.End.Equal(sunrise.Add(24*time.Hour)) {
+		t.Errorf("CalculateVara() lasts %v to %v, want sunrise to sunrise", vara.Start, vara.End)
+	}
+	if got := ElementNames(VaraElement); len(got) != 7 || got[0] != "Ravivara" {
+		t.Errorf("ElementNames(vara) = %v", got)
+	}
+}
@@ -0,0 +1,267 @@
+// Package audit records the calculations of the server: the inputs of
+// each RPC, how it was calculated, with which ayanamsa, ephemeris provider
+// and version of the server, and its output, so that a discrepancy a user
+// reports can be reproduced exactly, e.g. with `client audit -replay`.
+//
+// The records are kept in an append-only Store, a JSON Lines file or a SQL
+// database, from which a Retention drops the oldest ones.
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/log"
+	"github.com/naren-m/panchangam/observability"
+	"github.com/naren-m/panchangam/version"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var logger = log.Logger()
+
+// Record is a calculation.
+type Record struct {
+	Time time.Time `json:"time"`
+	// RequestID correlates the record with the logs of the request.
+	RequestID string `json:"request_id,omitempty"`
+	// Method is the full RPC method, e.g. /panchangam.Panchangam/Get.
+	Method string `json:"method"`
+	// Ayanamsa is that of the sidereal elements, e.g. lahiri.
+	Ayanamsa string `json:"ayanamsa"`
+	// Provider is the ephemeris provider of the positions of the moon and
+	// sun in the rashis, e.g. builtin.
+	Provider string `json:"provider"`
+	// Version is the version of the server, see version.Version.
+	Version string `json:"version"`
+	// Inputs is the request, and Output the response, in the JSON mapping
+	// of protocol buffers. Output is empty when the call failed.
+	Inputs json.RawMessage `json:"inputs"`
+	Output json.RawMessage `json:"output,omitempty"`
+	// Error is the status of the failed call, e.g. "InvalidArgument: date:
+	// invalid date".
+	Error    string        `json:"error,omitempty"`
+	Duration time.Duration `json:"duration_ns"`
+}
+
+// Query selects records. Empty fields match every record.
+type Query struct {
+	// From and To bound the times of the records, inclusive.
+	From, To  time.Time
+	RequestID string
+	Method    string
+	// Limit is the most records returned, the latest ones, or all when
+	// zero.
+	Limit int
+}
+
+func (q Query) matches(r Record) bool {
+	return (q.From.IsZero() || !r.Time.Before(q.From)) && (q.To.IsZero() || !r.Time.After(q.To)) &&
+		(q.RequestID == "" || r.RequestID == q.RequestID) && (q.Method == "" || r.Method == q.Method)
+}
+
+// Retention bounds the records kept. Zero fields set no bound.
+type Retention struct {
+	// MaxAge is the age from which records are dropped.
+	MaxAge time.Duration
+	// MaxRecords is the most records kept, the latest ones.
+	MaxRecords int
+}
+
+// Store keeps records durably.
+type Store interface {
+	// Append adds the records, ordered by time, after those kept.
+	Append(ctx context.Context, records []Record) error
+	// Query returns the records matching q, ordered by time.
+	Query(ctx context.Context, q Query) ([]Record, error)
+	// Prune drops the records older than before and, when max is positive,
+	// all but the latest max records, returning the number dropped.
+	Prune(ctx context.Context, before time.Time, max int) (int, error)
+}
+
+// Log accumulates the records of the calls audited by UnaryInterceptor in
+// memory, and appends them to a Store from Run, so that the store is
+// written to once per interval rather than once per call.
+type Log struct {
+	store     Store
+	ayanamsa  string
+	provider  string
+	retention Retention
+	methods   map[string]bool
+	now       func() time.Time
+
+	mu      sync.Mutex
+	pending []Record
+}
+
+// Option configures a Log.
+type Option func(*Log)
+
+// WithProvider sets the name of the ephemeris provider of the server,
+// builtin by default.
+func WithProvider(name string) Option {
+	return func(l *Log) { l.provider = name }
+}
+
+// WithAyanamsa sets the ayanamsa of the server, Lahiri by default.
+func WithAyanamsa(a astronomy.Ayanamsa) Option {
+	return func(l *Log) { l.ayanamsa = string(a) }
+}
+
+// WithRetention sets the records kept by Run, all by default.
+func WithRetention(r Retention) Option {
+	return func(l *Log) { l.retention = r }
+}
+
+// WithMethods restricts the calls audited to those of the full methods,
+// e.g. those calculating rather than managing subscriptions. Every call is
+// audited by default.
+func WithMethods(methods ...string) Option {
+	return func(l *Log) {
+		l.methods = make(map[string]bool, len(methods))
+		for _, m := range methods {
+			l.methods[m] = true
+		}
+	}
+}
+
+// NewLog returns a Log appending to store.
+func NewLog(store Store, opts ...Option) *Log {
+	l := &Log{store: store, ayanamsa: string(astronomy.Lahiri), provider: "builtin", now: time.Now}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l
+}
+
+// Record records a call to method with req which returned resp, or failed
+// with err, after d.
+func (l *Log) Record(ctx context.Context, method string, req, resp proto.Message, err error, d time.Duration) {
+	r := Record{
+		Time:      l.now().Add(-d).UTC(),
+		RequestID: log.RequestID(ctx),
+		Method:    method,
+		Ayanamsa:  l.ayanamsa,
+		Provider:  l.provider,
+		Version:   version.Version,
+		Duration:  d,
+	}
+	var merr error
+	if r.Inputs, merr = protojson.Marshal(req); merr != nil {
+		logger.ErrorContext(ctx, "Failed to audit request", "method", method, "error", merr)
+		return
+	}
+	if err != nil {
+		st := status.Convert(err)
+		r.Error = st.Code().String() + ": " + st.Message()
+	} else if r.Output, merr = protojson.Marshal(resp); merr != nil {
+		logger.ErrorContext(ctx, "Failed to audit response", "method", method, "error", merr)
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.pending = append(l.pending, r)
+}
+
+// UnaryInterceptor records the calls of the audited methods.
+func (l *Log) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if l.methods != nil && !l.methods[info.FullMethod] {
+			return handler(ctx, req)
+		}
+		start := l.now()
+		resp, err := handler(ctx, req)
+		in, ok := req.(proto.Message)
+		if !ok {
+			return resp, err
+		}
+		out, _ := resp.(proto.Message)
+		l.Record(ctx, info.FullMethod, in, out, err, l.now().Sub(start))
+		return resp, err
+	}
+}
+
+// Flush appends the records of the calls since the last flush to the
+// store. Records that cannot be appended are kept for the next flush.
+func (l *Log) Flush(ctx context.Context) error {
+	l.mu.Lock()
+	pending := l.pending
+	l.pending = nil
+	l.mu.Unlock()
+	if len(pending) == 0 {
+		return nil
+	}
+	sortRecords(pending)
+	if err := l.store.Append(ctx, pending); err != nil {
+		l.mu.Lock()
+		l.pending = append(pending, l.pending...)
+		l.mu.Unlock()
+		return err
+	}
+	return nil
+}
+
+// Prune drops the records beyond the retention of l.
+func (l *Log) Prune(ctx context.Context) (int, error) {
+	if l.retention == (Retention{}) {
+		return 0, nil
+	}
+	var before time.Time
+	if l.retention.MaxAge > 0 {
+		before = l.now().Add(-l.retention.MaxAge)
+	}
+	return l.store.Prune(ctx, before, l.retention.MaxRecords)
+}
+
+// Run flushes the recorded calls every interval, and prunes the store
+// every hour, until ctx is done, then flushes them a last time.
+func (l *Log) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	var pruned time.Time
+	for {
+		select {
+		case <-ctx.Done():
+			// Flush the last calls even though ctx is done.
+			if err := l.Flush(context.WithoutCancel(ctx)); err != nil {
+				logger.Error("Failed to flush audit log", "error", err)
+				observability.RecordError(ctx, "audit", err, storeError...)
+			}
+			return
+		case <-ticker.C:
+			if err := l.Flush(ctx); err != nil {
+				logger.Error("Failed to flush audit log", "error", err)
+				observability.RecordError(ctx, "audit", err, storeError...)
+			}
+			if l.now().Sub(pruned) < time.Hour {
+				continue
+			}
+			pruned = l.now()
+			if n, err := l.Prune(ctx); err != nil {
+				logger.Error("Failed to prune audit log", "error", err)
+			} else if n > 0 {
+				logger.Info("Pruned audit log", "records", n)
+			}
+		}
+	}
+}
+
+// storeError classes the failures of the store, whose records are kept for
+// the next flush.
+var storeError = []observability.ErrorOption{
+	observability.WithCategory(observability.CategoryDependency),
+	observability.WithRetryable(true),
+}
+
+// Query returns the records matching q, including those not yet flushed.
+func (l *Log) Query(ctx context.Context, q Query) ([]Record, error) {
+	if err := l.Flush(ctx); err != nil {
+		return nil, err
+	}
+	return l.store.Query(ctx, q)
+}
@@ -0,0 +1,111 @@
+package audit
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/log"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestLog(t *testing.T) {
+	store, err := OpenStore(filepath.Join(t.TempDir(), "audit.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	l := NewLog(store, WithProvider("swiss"), WithMethods(ppb.Panchangam_Get_FullMethodName))
+	interceptor := l.UnaryInterceptor()
+	get := func(ctx context.Context, req interface{}) (interface{}, error) {
+		r := req.(*ppb.GetPanchangamRequest)
+		if r.Date == "" {
+			return nil, status.Error(codes.InvalidArgument, "date: missing")
+		}
+		return &ppb.GetPanchangamResponse{PanchangamData: &ppb.PanchangamData{Date: r.Date, Tithi: "Pratipada"}}, nil
+	}
+	call := func(id, method string, req *ppb.GetPanchangamRequest) {
+		ctx := log.WithRequestID(context.Background(), id)
+		interceptor(ctx, req, &grpc.UnaryServerInfo{FullMethod: method}, get)
+	}
+	req := &ppb.GetPanchangamRequest{Date: "2024-04-09", Latitude: 13.08, Longitude: 80.27, Timezone: "Asia/Kolkata"}
+	call("first", ppb.Panchangam_Get_FullMethodName, req)
+	call("second", ppb.Panchangam_Get_FullMethodName, &ppb.GetPanchangamRequest{})
+	call("skipped", ppb.Panchangam_GetHealth_FullMethodName, req)
+
+	records, err := l.Query(context.Background(), Query{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].RequestID != "first" || records[1].RequestID != "second" {
+		t.Fatalf("records = %+v, want those of the two calls to Get", records)
+	}
+	r := records[0]
+	if r.Method != ppb.Panchangam_Get_FullMethodName || r.Ayanamsa != "lahiri" || r.Provider != "swiss" || r.Version == "" || r.Error != "" {
+		t.Errorf("record = %+v, want the method, ayanamsa, provider and version of a successful call", r)
+	}
+	inputs := &ppb.GetPanchangamRequest{}
+	if err := protojson.Unmarshal(r.Inputs, inputs); err != nil || !proto.Equal(inputs, req) {
+		t.Errorf("inputs = %s, %v, want the request", r.Inputs, err)
+	}
+	output := &ppb.GetPanchangamResponse{}
+	if err := protojson.Unmarshal(r.Output, output); err != nil || output.PanchangamData.GetTithi() != "Pratipada" {
+		t.Errorf("output = %s, %v, want the response", r.Output, err)
+	}
+	if r := records[1]; r.Error != "InvalidArgument: date: missing" || r.Output != nil {
+		t.Errorf("failed record = %+v, want its status and no output", r)
+	}
+
+	if got, err := store.Query(context.Background(), Query{RequestID: "second"}); err != nil || len(got) != 1 {
+		t.Errorf("Query(second) = %d records, %v, want 1", len(got), err)
+	}
+}
+
+func TestPrune(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2024, time.April, 9, 12, 0, 0, 0, time.UTC)
+	var records []Record
+	for i := 0; i < 5; i++ {
+		records = append(records, Record{Time: now.Add(time.Duration(i-4) * 24 * time.Hour), Method: "m", Inputs: []byte("{}")})
+	}
+	if err := store.Append(context.Background(), records); err != nil {
+		t.Fatal(err)
+	}
+	l := NewLog(store, WithRetention(Retention{MaxAge: 60 * time.Hour, MaxRecords: 2}))
+	l.now = func() time.Time { return now }
+	if n, err := l.Prune(context.Background()); err != nil || n != 3 {
+		t.Fatalf("Prune() = %d, %v, want 3 dropped", n, err)
+	}
+	kept, err := store.Query(context.Background(), Query{})
+	if err != nil || len(kept) != 2 || !kept[1].Time.Equal(now) {
+		t.Errorf("kept %+v, %v, want the last two records", kept, err)
+	}
+
+	// A record cut short by a crash is ignored.
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_WRONLY, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.WriteString(`{"time":"2024-04-09T13:00:00Z","meth`)
+	f.Close()
+	if kept, err := ReadFile(path, Query{Limit: 1}); err != nil || len(kept) != 1 || !kept[0].Time.Equal(now) {
+		t.Errorf("ReadFile() = %+v, %v, want the last complete record", kept, err)
+	}
+	later := Record{Time: now.Add(time.Hour), Method: "m", Inputs: []byte("{}")}
+	if err := store.Append(context.Background(), []Record{later}); err != nil {
+		t.Fatal(err)
+	}
+	if kept, err := ReadFile(path, Query{}); err != nil || len(kept) != 3 || !kept[2].Time.Equal(later.Time) {
+		t.Errorf("ReadFile() = %+v, %v, want the records around the one cut short", kept, err)
+	}
+}
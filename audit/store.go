@@ -0,0 +1,314 @@
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+func sortRecords(records []Record) {
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Time.Before(records[j].Time)
+	})
+}
+
+// latest returns the last max records, or all when max is not positive.
+func latest(records []Record, max int) []Record {
+	if max > 0 && len(records) > max {
+		return records[len(records)-max:]
+	}
+	return records
+}
+
+// FileStore is a Store keeping the records in a JSON Lines file, one
+// record per line, for a single server.
+type FileStore struct {
+	path string
+	mu   sync.Mutex
+}
+
+// OpenStore returns the store kept in the file at path, creating it if
+// needed.
+func OpenStore(path string) (*FileStore, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_RDONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	f.Close()
+	return &FileStore{path: path}, nil
+}
+
+// Append implements Store, writing the records at the end of the file.
+func (s *FileStore) Append(ctx context.Context, records []Record) error {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			return err
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	f, err := os.OpenFile(s.path, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0o600)
+	if err != nil {
+		return err
+	}
+	// Start on a new line after a record cut short by a crash.
+	if info, err := f.Stat(); err == nil && info.Size() > 0 {
+		last := make([]byte, 1)
+		if _, err := f.ReadAt(last, info.Size()-1); err == nil && last[0] != '\n' {
+			f.Write([]byte{'\n'})
+		}
+	}
+	if _, err := f.Write(buf.Bytes()); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// Query implements Store.
+func (s *FileStore) Query(ctx context.Context, q Query) ([]Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var matched []Record
+	err := s.scan(func(r Record) {
+		if q.matches(r) {
+			matched = append(matched, r)
+		}
+	})
+	return latest(matched, q.Limit), err
+}
+
+// Prune implements Store, rewriting the file with the records kept.
+func (s *FileStore) Prune(ctx context.Context, before time.Time, max int) (int, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var kept []Record
+	total := 0
+	err := s.scan(func(r Record) {
+		total++
+		if !r.Time.Before(before) {
+			kept = append(kept, r)
+		}
+	})
+	if err != nil {
+		return 0, err
+	}
+	kept = latest(kept, max)
+	if len(kept) == total {
+		return 0, nil
+	}
+	return total - len(kept), s.save(kept)
+}
+
+// ReadFile returns the records of the JSON Lines file at path, e.g. one
+// copied from a server, matching q.
+func ReadFile(path string, q Query) ([]Record, error) {
+	if _, err := os.Stat(path); err != nil {
+		return nil, err
+	}
+	return (&FileStore{path: path}).Query(context.Background(), q)
+}
+
+// scan calls f with each record of the file. Lines cut short by a crash
+// while appending are skipped.
+func (s *FileStore) scan(f func(Record)) error {
+	file, err := os.Open(s.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	rd := bufio.NewReader(file)
+	for n := 1; ; n++ {
+		line, err := rd.ReadBytes('\n')
+		if err == io.EOF {
+			// The last line is being appended, or was cut short.
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		var r Record
+		if err := json.Unmarshal(line, &r); err != nil {
+			logger.Warn("Skipping invalid audit record", "file", s.path, "line", n, "error", err)
+			continue
+		}
+		f(r)
+	}
+}
+
+// save writes the records to a temporary file and renames it over the
+// store, so that the file is never left partially written.
+func (s *FileStore) save(records []Record) error {
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".audit-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	w := bufio.NewWriter(tmp)
+	enc := json.NewEncoder(w)
+	for _, r := range records {
+		if err := enc.Encode(r); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// SQLStore is a Store keeping the records in the audit_log table of a SQL
+// database, so that several servers can share it. Its statements work with
+// both SQLite and PostgreSQL; the driver must be registered with
+// database/sql by the binary.
+type SQLStore struct {
+	db *sql.DB
+}
+
+// NewSQLStore returns a store in db, creating its table if needed.
+func NewSQLStore(ctx context.Context, db *sql.DB) (*SQLStore, error) {
+	for _, stmt := range []string{`CREATE TABLE IF NOT EXISTS audit_log (
+	time_ns BIGINT NOT NULL,
+	request_id TEXT NOT NULL,
+	method TEXT NOT NULL,
+	ayanamsa TEXT NOT NULL,
+	provider TEXT NOT NULL,
+	version TEXT NOT NULL,
+	inputs TEXT NOT NULL,
+	output TEXT NOT NULL,
+	error TEXT NOT NULL,
+	duration_ns BIGINT NOT NULL
+)`,
+		`CREATE INDEX IF NOT EXISTS audit_log_time ON audit_log (time_ns)`,
+		`CREATE INDEX IF NOT EXISTS audit_log_request_id ON audit_log (request_id)`,
+	} {
+		if _, err := db.ExecContext(ctx, stmt); err != nil {
+			return nil, fmt.Errorf("creating audit table: %w", err)
+		}
+	}
+	return &SQLStore{db: db}, nil
+}
+
+// Append implements Store, inserting the records in one transaction.
+func (s *SQLStore) Append(ctx context.Context, records []Record) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+	for _, r := range records {
+		_, err := tx.ExecContext(ctx, `INSERT INTO audit_log
+	(time_ns, request_id, method, ayanamsa, provider, version, inputs, output, error, duration_ns)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)`,
+			r.Time.UnixNano(), r.RequestID, r.Method, r.Ayanamsa, r.Provider, r.Version,
+			string(r.Inputs), string(r.Output), r.Error, int64(r.Duration))
+		if err != nil {
+			return fmt.Errorf("appending audit records: %w", err)
+		}
+	}
+	return tx.Commit()
+}
+
+// Query implements Store.
+func (s *SQLStore) Query(ctx context.Context, q Query) ([]Record, error) {
+	var conds []string
+	var args []any
+	for _, c := range []struct {
+		cond string
+		arg  any
+		set  bool
+	}{
+		{"time_ns >= ", q.From.UnixNano(), !q.From.IsZero()},
+		{"time_ns <= ", q.To.UnixNano(), !q.To.IsZero()},
+		{"request_id = ", q.RequestID, q.RequestID != ""},
+		{"method = ", q.Method, q.Method != ""},
+	} {
+		if c.set {
+			args = append(args, c.arg)
+			conds = append(conds, fmt.Sprintf("%s$%d", c.cond, len(args)))
+		}
+	}
+	query := `SELECT time_ns, request_id, method, ayanamsa, provider, version, inputs, output, error, duration_ns FROM audit_log`
+	if len(conds) > 0 {
+		query += " WHERE " + strings.Join(conds, " AND ")
+	}
+	query += " ORDER BY time_ns DESC"
+	if q.Limit > 0 {
+		query += fmt.Sprintf(" LIMIT %d", q.Limit)
+	}
+	rows, err := s.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, fmt.Errorf("querying audit log: %w", err)
+	}
+	defer rows.Close()
+	var records []Record
+	for rows.Next() {
+		var r Record
+		var at, duration int64
+		var inputs, output string
+		if err := rows.Scan(&at, &r.RequestID, &r.Method, &r.Ayanamsa, &r.Provider, &r.Version, &inputs, &output, &r.Error, &duration); err != nil {
+			return nil, err
+		}
+		r.Time, r.Duration = time.Unix(0, at).UTC(), time.Duration(duration)
+		r.Inputs = json.RawMessage(inputs)
+		if output != "" {
+			r.Output = json.RawMessage(output)
+		}
+		records = append(records, r)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	// The latest records were selected first.
+	for i, j := 0, len(records)-1; i < j; i, j = i+1, j-1 {
+		records[i], records[j] = records[j], records[i]
+	}
+	return records, nil
+}
+
+// Prune implements Store.
+func (s *SQLStore) Prune(ctx context.Context, before time.Time, max int) (int, error) {
+	var dropped int64
+	if !before.IsZero() {
+		res, err := s.db.ExecContext(ctx, `DELETE FROM audit_log WHERE time_ns < $1`, before.UnixNano())
+		if err != nil {
+			return 0, fmt.Errorf("pruning audit log: %w", err)
+		}
+		n, _ := res.RowsAffected()
+		dropped += n
+	}
+	if max > 0 {
+		res, err := s.db.ExecContext(ctx, `DELETE FROM audit_log WHERE time_ns <
+	(SELECT time_ns FROM audit_log ORDER BY time_ns DESC LIMIT 1 OFFSET $1)`, max-1)
+		if err != nil {
+			return 0, fmt.Errorf("pruning audit log: %w", err)
+		}
+		n, _ := res.RowsAffected()
+		dropped += n
+	}
+	return int(dropped), nil
+}
@@ -0,0 +1,105 @@
+//go:build sqlite
+
+package audit
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	_ "github.com/mattn/go-sqlite3"
+)
+
+func TestSQLStore(t *testing.T) {
+	ctx := context.Background()
+	db, err := sql.Open("sqlite3", filepath.Join(t.TempDir(), "audit.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	store, err := NewSQLStore(ctx, db)
+	if err != nil {
+		t.Fatalf("NewSQLStore() error = %v", err)
+	}
+	if _, err := NewSQLStore(ctx, db); err != nil {
+		t.Fatalf("NewSQLStore() of an existing table error = %v", err)
+	}
+
+	now := time.Date(2024, time.April, 9, 12, 0, 0, 0, time.UTC)
+	var records []Record
+	for i := 0; i < 5; i++ {
+		records = append(records, Record{
+			Time:      now.Add(time.Duration(i-4) * 24 * time.Hour),
+			RequestID: string(rune('a' + i)),
+			Method:    "/panchangam.Panchangam/Get",
+			Ayanamsa:  "lahiri",
+			Provider:  "builtin",
+			Version:   "v1.2.3",
+			Inputs:    json.RawMessage(`{"date":"2024-04-09"}`),
+			Output:    json.RawMessage(`{"panchangamData":{"tithi":"Pratipada"}}`),
+			Duration:  time.Duration(i+1) * time.Millisecond,
+		})
+	}
+	records[2].Method = "/panchangam.Panchangam/GetEvents"
+	records[3].Output, records[3].Error = nil, "InvalidArgument: date: invalid date"
+	if err := store.Append(ctx, records[:3]); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+	if err := store.Append(ctx, records[3:]); err != nil {
+		t.Fatalf("Append() error = %v", err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		q    Query
+		want []Record
+	}{
+		{"all", Query{}, records},
+		{"from", Query{From: records[3].Time}, records[3:]},
+		{"to", Query{To: records[1].Time}, records[:2]},
+		{"request id", Query{RequestID: "d"}, records[3:4]},
+		{"method", Query{Method: "/panchangam.Panchangam/GetEvents"}, records[2:3]},
+		{"limit", Query{Limit: 2}, records[3:]},
+		{"all conditions", Query{From: records[1].Time, To: records[3].Time, Method: "/panchangam.Panchangam/Get", Limit: 1}, records[3:4]},
+		{"none", Query{RequestID: "z"}, nil},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := store.Query(ctx, tt.q)
+			if err != nil {
+				t.Fatalf("Query() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Query() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+
+	// Records before the time given are dropped, then all but the latest
+	// max.
+	if n, err := store.Prune(ctx, records[1].Time, 0); err != nil || n != 1 {
+		t.Fatalf("Prune(before) = %d, %v, want 1 dropped", n, err)
+	}
+	if n, err := store.Prune(ctx, time.Time{}, 10); err != nil || n != 0 {
+		t.Fatalf("Prune(10) of 4 records = %d, %v, want none dropped", n, err)
+	}
+	if n, err := store.Prune(ctx, time.Time{}, 2); err != nil || n != 2 {
+		t.Fatalf("Prune(2) = %d, %v, want 2 dropped", n, err)
+	}
+	if got, err := store.Query(ctx, Query{}); err != nil || !reflect.DeepEqual(got, records[3:]) {
+		t.Errorf("records kept = %+v, %v, want the last two", got, err)
+	}
+
+	// The log prunes the store like a file.
+	l := NewLog(store, WithRetention(Retention{MaxAge: 12 * time.Hour, MaxRecords: 2}))
+	l.now = func() time.Time { return now }
+	if n, err := l.Prune(ctx); err != nil || n != 1 {
+		t.Fatalf("Log.Prune() = %d, %v, want 1 dropped", n, err)
+	}
+	if got, err := store.Query(ctx, Query{}); err != nil || !reflect.DeepEqual(got, records[4:]) {
+		t.Errorf("records kept = %+v, %v, want the last", got, err)
+	}
+}
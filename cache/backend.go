@@ -0,0 +1,132 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Backend stores encoded values shared by caches, such as those of the
+// instances of a horizontally scaled server. A Cache with a backend looks
+// its misses up there before computing them, and stores what it computes
+// there.
+type Backend interface {
+	// Get returns the value of key, reporting whether it was found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	// Set stores value under key for ttl.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// Delete removes key.
+	Delete(ctx context.Context, key string) error
+	// DeletePrefix removes every key starting with prefix.
+	DeletePrefix(ctx context.Context, prefix string) error
+}
+
+// Codec encodes the values of a Cache for its Backend.
+type Codec[V any] struct {
+	Marshal   func(V) ([]byte, error)
+	Unmarshal func([]byte) (V, error)
+}
+
+// DefaultMemoryBackendEntries is the default capacity of a MemoryBackend.
+const DefaultMemoryBackendEntries = 100000
+
+// MemoryBackend is a Backend in memory that evicts the least recently used
+// entries beyond its capacity. It is shared by the caches of a process
+// only.
+type MemoryBackend struct {
+	maxEntries int
+	now        func() time.Time
+
+	mu sync.Mutex
+	// lru holds the entries, most recently used first.
+	lru     *list.List
+	entries map[string]*list.Element
+}
+
+type memoryEntry struct {
+	key     string
+	value   []byte
+	expires time.Time
+}
+
+// NewMemoryBackend returns an empty MemoryBackend holding at most
+// maxEntries entries, or DefaultMemoryBackendEntries if it is not positive.
+func NewMemoryBackend(maxEntries int) *MemoryBackend {
+	if maxEntries <= 0 {
+		maxEntries = DefaultMemoryBackendEntries
+	}
+	return &MemoryBackend{
+		maxEntries: maxEntries,
+		now:        time.Now,
+		lru:        list.New(),
+		entries:    map[string]*list.Element{},
+	}
+}
+
+func (b *MemoryBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	el, ok := b.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+	e := el.Value.(*memoryEntry)
+	if !b.now().Before(e.expires) {
+		b.remove(el)
+		return nil, false, nil
+	}
+	b.lru.MoveToFront(el)
+	return e.value, true, nil
+}
+
+func (b *MemoryBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	e := &memoryEntry{key: key, value: value, expires: b.now().Add(ttl)}
+	if el, ok := b.entries[key]; ok {
+		el.Value = e
+		b.lru.MoveToFront(el)
+		return nil
+	}
+	b.entries[key] = b.lru.PushFront(e)
+	for b.lru.Len() > b.maxEntries {
+		b.remove(b.lru.Back())
+	}
+	return nil
+}
+
+func (b *MemoryBackend) Delete(ctx context.Context, key string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if el, ok := b.entries[key]; ok {
+		b.remove(el)
+	}
+	return nil
+}
+
+func (b *MemoryBackend) DeletePrefix(ctx context.Context, prefix string) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for key, el := range b.entries {
+		if strings.HasPrefix(key, prefix) {
+			b.remove(el)
+		}
+	}
+	return nil
+}
+
+// Len returns the number of entries, including expired ones not yet
+// removed.
+func (b *MemoryBackend) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.lru.Len()
+}
+
+// remove drops an entry. b.mu must be held.
+func (b *MemoryBackend) remove(el *list.Element) {
+	b.lru.Remove(el)
+	delete(b.entries, el.Value.(*memoryEntry).key)
+}
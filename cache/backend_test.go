@@ -0,0 +1,110 @@
+package cache
+
+import (
+	"context"
+	"strconv"
+	"testing"
+	"time"
+)
+
+var intCodec = Codec[int]{
+	Marshal:   func(v int) ([]byte, error) { return []byte(strconv.Itoa(v)), nil },
+	Unmarshal: func(b []byte) (int, error) { return strconv.Atoi(string(b)) },
+}
+
+func TestMemoryBackendEvictsLeastRecentlyUsed(t *testing.T) {
+	b := NewMemoryBackend(2)
+	ctx := context.Background()
+	b.Set(ctx, "a", []byte("1"), time.Hour)
+	b.Set(ctx, "b", []byte("2"), time.Hour)
+	b.Get(ctx, "a")
+	b.Set(ctx, "c", []byte("3"), time.Hour)
+
+	if _, ok, _ := b.Get(ctx, "b"); ok {
+		t.Error("Get(b) found the least recently used entry")
+	}
+	for _, key := range []string{"a", "c"} {
+		if _, ok, _ := b.Get(ctx, key); !ok {
+			t.Errorf("Get(%s) found nothing", key)
+		}
+	}
+}
+
+func TestMemoryBackendExpires(t *testing.T) {
+	clk := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	b := NewMemoryBackend(0)
+	b.now = clk.Now
+	ctx := context.Background()
+	b.Set(ctx, "a", []byte("1"), time.Minute)
+	clk.Advance(time.Minute)
+	if _, ok, _ := b.Get(ctx, "a"); ok {
+		t.Error("Get() found an expired entry")
+	}
+	if b.Len() != 0 {
+		t.Errorf("Len() = %d after expiry, want 0", b.Len())
+	}
+}
+
+func TestGetShared(t *testing.T) {
+	clk := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	backend := NewMemoryBackend(0)
+	backend.now = clk.Now
+	ctx := context.Background()
+	first := newTestCache(clk, WithName("days"), WithTTL(time.Hour), WithBackend(backend, intCodec))
+	second := newTestCache(clk, WithName("days"), WithTTL(time.Hour), WithBackend(backend, intCodec))
+
+	if v, _ := first.Get(ctx, "a", constant(1)); v != 1 {
+		t.Fatalf("Get() = %v, want 1", v)
+	}
+	clk.Advance(30 * time.Minute)
+	if v, _ := second.Get(ctx, "a", constant(2)); v != 1 {
+		t.Errorf("Get() from another cache = %v, want the shared 1", v)
+	}
+	if want := (Stats{Misses: 1, SharedHits: 1}); second.Stats() != want {
+		t.Errorf("Stats() = %+v, want %+v", second.Stats(), want)
+	}
+	// The shared value keeps its expiry.
+	clk.Advance(30 * time.Minute)
+	if v, _ := second.Get(ctx, "a", constant(2)); v != 2 {
+		t.Errorf("Get() after the shared expiry = %v, want the reloaded 2", v)
+	}
+
+	// Other versions are not shared.
+	other := newTestCache(clk, WithName("days"), WithVersion("v2"), WithBackend(backend, intCodec))
+	if v, _ := other.Get(ctx, "a", constant(3)); v != 3 {
+		t.Errorf("Get() of another version = %v, want the computed 3", v)
+	}
+}
+
+func TestInvalidateAndPurge(t *testing.T) {
+	clk := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	backend := NewMemoryBackend(0)
+	backend.now = clk.Now
+	ctx := context.Background()
+	c := newTestCache(clk, WithName("days"), WithBackend(backend, intCodec))
+	c.Get(ctx, "a", constant(1))
+	c.Get(ctx, "b", constant(1))
+	newTestCache(clk, WithName("days"), WithVersion("v0"), WithBackend(backend, intCodec)).Get(ctx, "a", constant(1))
+	newTestCache(clk, WithName("years"), WithBackend(backend, intCodec)).Get(ctx, "a", constant(1))
+
+	if err := c.Invalidate(ctx, "a"); err != nil {
+		t.Fatalf("Invalidate() = %v", err)
+	}
+	if v, _ := c.Get(ctx, "a", constant(2)); v != 2 {
+		t.Errorf("Get() after Invalidate() = %v, want the reloaded 2", v)
+	}
+	if v, _ := c.Get(ctx, "b", constant(2)); v != 1 {
+		t.Errorf("Get() of another key after Invalidate() = %v, want the cached 1", v)
+	}
+
+	if err := c.Purge(ctx); err != nil {
+		t.Fatalf("Purge() = %v", err)
+	}
+	if v, _ := c.Get(ctx, "b", constant(3)); v != 3 {
+		t.Errorf("Get() after Purge() = %v, want the reloaded 3", v)
+	}
+	// Every version of the cache is purged, and other caches are kept.
+	if backend.Len() != 2 {
+		t.Errorf("backend holds %d entries after Purge() and a reload, want 2", backend.Len())
+	}
+}
@@ -0,0 +1,499 @@
+// Package cache is an in-memory cache of computed values that protects the
+// computation from stampedes.
+//
+// Entries filled at the same time, such as the hot "today" keys after a
+// day rollover, would all expire at the same time and be recomputed
+// together. The cache spreads their expiry out instead:
+//
+//   - each key's time to live is staggered by a fixed offset derived from
+//     the key, so keys filled together expire in a predictable spread;
+//   - each fill adds random jitter to the time to live, so a key does not
+//     stay in step with the keys it happened to be filled with;
+//   - hot keys are refreshed in the background shortly before they expire
+//     while the current value keeps being served;
+//   - concurrent misses of the same key share a single computation.
+//
+// A cache may be backed by a shared Backend, such as Redis, so that the
+// instances of a horizontally scaled server compute each value once
+// between them: misses are looked up in the backend before being computed,
+// and computed values are stored there with their expiry.
+package cache
+
+import (
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/log"
+	"github.com/naren-m/panchangam/observability"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var logger = log.Logger()
+
+// Names of the metrics of caches. All are labelled with the name of the
+// cache.
+const (
+	// RequestsMetric counts reads by result: hit or miss.
+	RequestsMetric = "cache.requests"
+	// LoadsMetric counts computations by reason: miss or refresh.
+	LoadsMetric = "cache.loads"
+	// SharedMetric counts lookups of misses in the backend by result: hit,
+	// miss or error.
+	SharedMetric = "cache.shared"
+)
+
+// Defaults of a Cache.
+const (
+	DefaultTTL        = time.Hour
+	DefaultMaxEntries = 10000
+	DefaultVersion    = "v1"
+)
+
+// Option configures a Cache.
+type Option func(*config)
+
+type config struct {
+	name         string
+	ttl          time.Duration
+	stagger      time.Duration
+	jitter       float64
+	refreshAhead float64
+	hotHits      int
+	maxEntries   int
+	backend      Backend
+	codec        any
+	version      string
+}
+
+// WithName names the cache in its metrics.
+func WithName(name string) Option {
+	return func(c *config) { c.name = name }
+}
+
+// WithTTL sets the time to live of entries before staggering and jitter.
+func WithTTL(ttl time.Duration) Option {
+	return func(c *config) { c.ttl = ttl }
+}
+
+// WithStagger adds to the time to live of each key a fixed offset from 0
+// to window derived from the key.
+func WithStagger(window time.Duration) Option {
+	return func(c *config) { c.stagger = window }
+}
+
+// WithJitter changes the time to live of each fill randomly by up to
+// fraction of it in either direction, e.g. 0.1 for ±10%.
+func WithJitter(fraction float64) Option {
+	return func(c *config) { c.jitter = fraction }
+}
+
+// WithRefreshAhead refreshes keys read at least hotHits times once the
+// last fraction of their time to live is reached, e.g. 0.1 for the last
+// 10%. The refresh runs in the background while the current value is
+// served.
+func WithRefreshAhead(fraction float64, hotHits int) Option {
+	return func(c *config) {
+		c.refreshAhead = fraction
+		c.hotHits = hotHits
+	}
+}
+
+// WithMaxEntries bounds the number of entries. When it is reached, expired
+// entries are dropped and, failing that, an arbitrary one.
+func WithMaxEntries(n int) Option {
+	return func(c *config) { c.maxEntries = n }
+}
+
+// WithBackend shares the values through b, encoded with codec, whose
+// type must match the values of the cache.
+func WithBackend[V any](b Backend, codec Codec[V]) Option {
+	return func(c *config) {
+		c.backend = b
+		c.codec = codec
+	}
+}
+
+// WithVersion sets the version of the values in the backend, so that
+// values stored by instances computing them differently, e.g. before an
+// upgrade, are not shared. It defaults to DefaultVersion.
+func WithVersion(version string) Option {
+	return func(c *config) { c.version = version }
+}
+
+// Stats counts the outcomes of the reads of a Cache.
+type Stats struct {
+	// Hits are reads served from the cache, including those that started a
+	// refresh.
+	Hits int64
+	// Misses are reads that waited for a computation, shared or not.
+	Misses int64
+	// Loads are computations started because of a miss.
+	Loads int64
+	// Refreshes are computations started ahead of expiry.
+	Refreshes int64
+	// SharedHits are misses served from the backend.
+	SharedHits int64
+}
+
+// Cache holds values of type V by key.
+type Cache[V any] struct {
+	config
+	codec Codec[V]
+	now   func() time.Time
+	rand  func() float64
+
+	mu       sync.Mutex
+	entries  map[string]*entry[V]
+	inflight map[string]*call[V]
+	stats    Stats
+
+	requests metric.Int64Counter
+	loads    metric.Int64Counter
+	shared   metric.Int64Counter
+}
+
+type entry[V any] struct {
+	value V
+	// refreshAt is when a hot entry starts being refreshed; expires is
+	// when it stops being served.
+	refreshAt  time.Time
+	expires    time.Time
+	hits       int
+	refreshing bool
+}
+
+// call is a computation of a key shared by the reads waiting for it.
+type call[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+// New returns an empty Cache.
+func New[V any](opts ...Option) *Cache[V] {
+	c := &Cache[V]{
+		config: config{
+			name:       "default",
+			ttl:        DefaultTTL,
+			maxEntries: DefaultMaxEntries,
+			version:    DefaultVersion,
+		},
+		now:      time.Now,
+		rand:     rand.Float64,
+		entries:  map[string]*entry[V]{},
+		inflight: map[string]*call[V]{},
+	}
+	for _, opt := range opts {
+		opt(&c.config)
+	}
+	if c.backend != nil {
+		codec, ok := c.config.codec.(Codec[V])
+		if !ok {
+			panic("cache: the codec of the backend does not encode the values of the cache")
+		}
+		c.codec = codec
+	}
+
+	meter := otel.Meter("github.com/naren-m/panchangam/cache")
+	var err error
+	c.requests, err = meter.Int64Counter(RequestsMetric,
+		metric.WithDescription("Cache reads, by cache and result (hit or miss)"))
+	if err != nil {
+		logger.Error("Failed to create cache request counter", "error", err)
+	}
+	c.loads, err = meter.Int64Counter(LoadsMetric,
+		metric.WithDescription("Computations of cached values, by cache and reason (miss or refresh)"))
+	if err != nil {
+		logger.Error("Failed to create cache load counter", "error", err)
+	}
+	c.shared, err = meter.Int64Counter(SharedMetric,
+		metric.WithDescription("Lookups of cache misses in the shared backend, by cache and result (hit, miss or error)"))
+	if err != nil {
+		logger.Error("Failed to create cache shared lookup counter", "error", err)
+	}
+	return c
+}
+
+// Get returns the value of key, computing it with load when it is missing
+// or expired. Errors are returned to every waiting read and not cached.
+func (c *Cache[V]) Get(ctx context.Context, key string, load func(context.Context) (V, error)) (V, error) {
+	c.mu.Lock()
+	now := c.now()
+	if e, ok := c.entries[key]; ok && now.Before(e.expires) {
+		e.hits++
+		c.stats.Hits++
+		refresh := c.refreshAhead > 0 && !e.refreshing && e.hits >= c.hotHits && !now.Before(e.refreshAt)
+		if refresh {
+			e.refreshing = true
+			c.stats.Refreshes++
+		}
+		c.mu.Unlock()
+		c.record(ctx, c.requests, "result", "hit")
+		if refresh {
+			c.record(ctx, c.loads, "reason", "refresh")
+			go c.refresh(context.WithoutCancel(ctx), key, load)
+		}
+		return e.value, nil
+	}
+
+	c.stats.Misses++
+	cl, ok := c.inflight[key]
+	if !ok {
+		cl = &call[V]{done: make(chan struct{})}
+		c.inflight[key] = cl
+	}
+	c.mu.Unlock()
+	c.record(ctx, c.requests, "result", "miss")
+
+	if ok {
+		select {
+		case <-cl.done:
+			return cl.value, cl.err
+		case <-ctx.Done():
+			var zero V
+			return zero, ctx.Err()
+		}
+	}
+	value, ttl, shared := c.getShared(ctx, key)
+	if shared {
+		cl.value = value
+	} else {
+		c.record(ctx, c.loads, "reason", "miss")
+		cl.value, cl.err = load(ctx)
+	}
+	c.mu.Lock()
+	delete(c.inflight, key)
+	if shared {
+		c.stats.SharedHits++
+	} else {
+		c.stats.Loads++
+		ttl = c.ttlOf(key)
+	}
+	if cl.err == nil {
+		c.store(key, cl.value, 0, ttl)
+	}
+	c.mu.Unlock()
+	if !shared && cl.err == nil {
+		c.setShared(ctx, key, cl.value, ttl)
+	}
+	close(cl.done)
+	return cl.value, cl.err
+}
+
+// refresh recomputes key ahead of its expiry. On failure the current value
+// is kept until it expires.
+func (c *Cache[V]) refresh(ctx context.Context, key string, load func(context.Context) (V, error)) {
+	value, err := load(ctx)
+	c.mu.Lock()
+	e, ok := c.entries[key]
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to refresh cached value", "cache", c.name, "key", key, "error", err)
+		// The cached value is kept until it expires.
+		observability.RecordError(ctx, "cache", err, observability.WithSeverity(observability.SeverityWarning))
+		if ok {
+			e.refreshing = false
+		}
+		c.mu.Unlock()
+		return
+	}
+	hits := 0
+	if ok {
+		// The key stays hot, so that it is refreshed again.
+		hits = e.hits
+	}
+	ttl := c.ttlOf(key)
+	c.store(key, value, hits, ttl)
+	c.mu.Unlock()
+	c.setShared(ctx, key, value, ttl)
+}
+
+// store adds value under key for ttl. c.mu must be held.
+func (c *Cache[V]) store(key string, value V, hits int, ttl time.Duration) {
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= c.maxEntries {
+		c.evict()
+	}
+	now := c.now()
+	c.entries[key] = &entry[V]{
+		value:     value,
+		refreshAt: now.Add(time.Duration(float64(ttl) * (1 - c.refreshAhead))),
+		expires:   now.Add(ttl),
+		hits:      hits,
+	}
+}
+
+// ttlOf returns the time to live of a new value of key, staggered and
+// jittered.
+func (c *Cache[V]) ttlOf(key string) time.Duration {
+	ttl := c.ttl
+	if c.stagger > 0 {
+		h := fnv.New64a()
+		h.Write([]byte(key))
+		ttl += time.Duration(h.Sum64() % uint64(c.stagger))
+	}
+	if c.jitter > 0 {
+		ttl += time.Duration((2*c.rand() - 1) * c.jitter * float64(c.ttl))
+	}
+	return ttl
+}
+
+// evict makes room for an entry. c.mu must be held.
+func (c *Cache[V]) evict() {
+	now := c.now()
+	for key, e := range c.entries {
+		if !now.Before(e.expires) {
+			delete(c.entries, key)
+		}
+	}
+	if len(c.entries) < c.maxEntries {
+		return
+	}
+	for key := range c.entries {
+		delete(c.entries, key)
+		return
+	}
+}
+
+// getShared looks key up in the backend, returning its value and the time
+// it has left to live. Failures of the backend are logged and treated as
+// misses, so that the value is computed instead.
+func (c *Cache[V]) getShared(ctx context.Context, key string) (V, time.Duration, bool) {
+	var zero V
+	if c.backend == nil {
+		return zero, 0, false
+	}
+	data, ok, err := c.backend.Get(ctx, c.sharedKey(key))
+	if err == nil && ok {
+		var value V
+		var ttl time.Duration
+		if value, ttl, err = c.decode(data); err == nil && ttl > 0 {
+			c.record(ctx, c.shared, "result", "hit")
+			return value, ttl, true
+		}
+	}
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to get shared cached value", "cache", c.name, "key", key, "error", err)
+		observability.RecordError(ctx, "cache", err, sharedCacheError...)
+		c.record(ctx, c.shared, "result", "error")
+	} else {
+		c.record(ctx, c.shared, "result", "miss")
+	}
+	return zero, 0, false
+}
+
+// sharedCacheError classes the failures of the backend, which fall back on
+// computing the value.
+var sharedCacheError = []observability.ErrorOption{
+	observability.WithCategory(observability.CategoryDependency),
+	observability.WithSeverity(observability.SeverityWarning),
+	observability.WithRetryable(true),
+}
+
+// setShared stores value under key in the backend for ttl. Failures are
+// logged only.
+func (c *Cache[V]) setShared(ctx context.Context, key string, value V, ttl time.Duration) {
+	if c.backend == nil {
+		return
+	}
+	payload, err := c.codec.Marshal(value)
+	if err == nil {
+		// The expiry goes with the value, so that every instance drops it
+		// at the same time.
+		data := binary.BigEndian.AppendUint64(nil, uint64(c.now().Add(ttl).UnixNano()))
+		err = c.backend.Set(ctx, c.sharedKey(key), append(data, payload...), ttl)
+	}
+	if err != nil {
+		logger.WarnContext(ctx, "Failed to set shared cached value", "cache", c.name, "key", key, "error", err)
+		observability.RecordError(ctx, "cache", err, sharedCacheError...)
+	}
+}
+
+// decode splits the value stored in the backend into the value and the
+// time it has left to live.
+func (c *Cache[V]) decode(data []byte) (V, time.Duration, error) {
+	var zero V
+	if len(data) < 8 {
+		return zero, 0, errors.New("truncated shared value")
+	}
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(data)))
+	value, err := c.codec.Unmarshal(data[8:])
+	if err != nil {
+		return zero, 0, err
+	}
+	return value, expires.Sub(c.now()), nil
+}
+
+// sharedKey returns the key of the backend for key.
+func (c *Cache[V]) sharedKey(key string) string {
+	return c.name + ":" + c.version + ":" + key
+}
+
+// Invalidate drops keys, from the backend too. Other instances sharing
+// the backend keep serving the copies they hold until these expire.
+func (c *Cache[V]) Invalidate(ctx context.Context, keys ...string) error {
+	c.mu.Lock()
+	for _, key := range keys {
+		delete(c.entries, key)
+	}
+	c.mu.Unlock()
+	if c.backend == nil {
+		return nil
+	}
+	for _, key := range keys {
+		if err := c.backend.Delete(ctx, c.sharedKey(key)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Purge drops every entry, and those of every version of the cache in the
+// backend. Other instances sharing the backend keep serving the copies they
+// hold until these expire.
+func (c *Cache[V]) Purge(ctx context.Context) error {
+	c.mu.Lock()
+	clear(c.entries)
+	c.mu.Unlock()
+	if c.backend == nil {
+		return nil
+	}
+	return PurgeBackend(ctx, c.backend, c.name)
+}
+
+// PurgeBackend drops the values of every version of the cache named name
+// from b, e.g. from a tool run alongside the servers sharing them.
+func PurgeBackend(ctx context.Context, b Backend, name string) error {
+	return b.DeletePrefix(ctx, name+":")
+}
+
+// Stats returns the counts of the reads so far.
+func (c *Cache[V]) Stats() Stats {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.stats
+}
+
+// Len returns the number of entries, including expired ones not yet
+// dropped.
+func (c *Cache[V]) Len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return len(c.entries)
+}
+
+// Name returns the name of the cache in its metrics.
+func (c *Cache[V]) Name() string {
+	return c.name
+}
+
+func (c *Cache[V]) record(ctx context.Context, counter metric.Int64Counter, name, value string) {
+	if counter != nil {
+		counter.Add(ctx, 1, metric.WithAttributes(attribute.String("cache", c.name), attribute.String(name, value)))
+	}
+}
@@ -0,0 +1,198 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+)
+
+// clock is a manually advanced time source.
+type clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestCache(clk *clock, opts ...Option) *Cache[int] {
+	c := New[int](opts...)
+	c.now = clk.Now
+	c.rand = rand.New(rand.NewSource(1)).Float64
+	return c
+}
+
+func constant(v int) func(context.Context) (int, error) {
+	return func(context.Context) (int, error) { return v, nil }
+}
+
+func TestGet(t *testing.T) {
+	clk := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := newTestCache(clk, WithTTL(time.Hour))
+	ctx := context.Background()
+
+	if v, err := c.Get(ctx, "a", constant(1)); v != 1 || err != nil {
+		t.Fatalf("Get() = %v, %v, want 1, nil", v, err)
+	}
+	if v, _ := c.Get(ctx, "a", constant(2)); v != 1 {
+		t.Errorf("Get() before expiry = %v, want the cached 1", v)
+	}
+	clk.Advance(time.Hour)
+	if v, _ := c.Get(ctx, "a", constant(2)); v != 2 {
+		t.Errorf("Get() after expiry = %v, want the reloaded 2", v)
+	}
+
+	// Errors are not cached.
+	failure := errors.New("failed")
+	if _, err := c.Get(ctx, "b", func(context.Context) (int, error) { return 0, failure }); err != failure {
+		t.Errorf("Get() error = %v, want %v", err, failure)
+	}
+	if v, _ := c.Get(ctx, "b", constant(3)); v != 3 {
+		t.Errorf("Get() after an error = %v, want 3", v)
+	}
+	want := Stats{Hits: 1, Misses: 4, Loads: 4}
+	if got := c.Stats(); got != want {
+		t.Errorf("Stats() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetSharesLoads(t *testing.T) {
+	c := New[int]()
+	release := make(chan struct{})
+	var mu sync.Mutex
+	loads := 0
+	load := func(context.Context) (int, error) {
+		mu.Lock()
+		loads++
+		mu.Unlock()
+		<-release
+		return 7, nil
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if v, err := c.Get(context.Background(), "today", load); v != 7 || err != nil {
+				t.Errorf("Get() = %v, %v, want 7, nil", v, err)
+			}
+		}()
+	}
+	// Wait for every read to miss before the load completes.
+	for c.Stats().Misses < 50 {
+		time.Sleep(time.Millisecond)
+	}
+	close(release)
+	wg.Wait()
+	if loads != 1 {
+		t.Errorf("concurrent misses loaded %d times, want 1", loads)
+	}
+}
+
+// TestExpirySpread fills many keys at once, as after a day rollover, and
+// reads them every minute for two hours, counting the recomputations per
+// minute.
+func TestExpirySpread(t *testing.T) {
+	const keys = 1000
+	peak := func(opts ...Option) int {
+		clk := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+		c := newTestCache(clk, append([]Option{WithTTL(time.Hour)}, opts...)...)
+		worst := 0
+		for minute := 0; minute < 120; minute++ {
+			before := c.Stats().Loads
+			for i := 0; i < keys; i++ {
+				c.Get(context.Background(), fmt.Sprintf("2025-01-01/%d", i), constant(i))
+			}
+			if minute > 0 {
+				worst = max(worst, int(c.Stats().Loads-before))
+			}
+			clk.Advance(time.Minute)
+		}
+		return worst
+	}
+
+	if got := peak(); got != keys {
+		t.Errorf("peak loads per minute without smoothing = %d, want %d", got, keys)
+	}
+	got := peak(WithStagger(10*time.Minute), WithJitter(0.1))
+	t.Logf("peak loads per minute with stagger and jitter: %d of %d keys", got, keys)
+	if got > keys/10 {
+		t.Errorf("peak loads per minute with stagger and jitter = %d, want at most %d", got, keys/10)
+	}
+}
+
+func TestRefreshAhead(t *testing.T) {
+	clk := &clock{now: time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)}
+	c := newTestCache(clk, WithTTL(time.Hour), WithRefreshAhead(0.1, 3))
+	ctx := context.Background()
+
+	refreshed := make(chan struct{})
+	value := 1
+	load := func(context.Context) (int, error) {
+		if value > 1 {
+			defer close(refreshed)
+		}
+		return value, nil
+	}
+	c.Get(ctx, "today", load)
+	c.Get(ctx, "today", load)
+	c.Get(ctx, "today", load)
+
+	// In the last 10% of its time to live the hot key is refreshed in the
+	// background while the current value is served.
+	value = 2
+	clk.Advance(55 * time.Minute)
+	if v, _ := c.Get(ctx, "today", load); v != 1 {
+		t.Errorf("Get() starting a refresh = %v, want the current 1", v)
+	}
+	select {
+	case <-refreshed:
+	case <-time.After(time.Second):
+		t.Fatal("hot key was not refreshed")
+	}
+	// The refreshed value is served once stored, and past the expiry of
+	// the first one without a miss.
+	for v := 0; v != 2; {
+		v, _ = c.Get(ctx, "today", load)
+		time.Sleep(time.Millisecond)
+	}
+	clk.Advance(10 * time.Minute)
+	if v, _ := c.Get(ctx, "today", load); v != 2 {
+		t.Errorf("Get() after the first expiry = %v, want the refreshed 2", v)
+	}
+	if got := c.Stats(); got.Misses != 1 || got.Refreshes != 1 {
+		t.Errorf("Stats() = %+v, want a single miss and refresh", got)
+	}
+
+	// Cold keys are left to expire.
+	c.Get(ctx, "cold", constant(1))
+	clk.Advance(58 * time.Minute)
+	c.Get(ctx, "cold", constant(1))
+	if got := c.Stats().Refreshes; got != 1 {
+		t.Errorf("Refreshes = %d after reading a cold key, want 1", got)
+	}
+}
+
+func TestMaxEntries(t *testing.T) {
+	c := New[int](WithMaxEntries(2))
+	for i := 0; i < 5; i++ {
+		c.Get(context.Background(), fmt.Sprint(i), constant(i))
+	}
+	if len(c.entries) != 2 {
+		t.Errorf("cache holds %d entries, want 2", len(c.entries))
+	}
+}
@@ -0,0 +1,265 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Defaults of a RedisBackend.
+const (
+	DefaultRedisTimeout   = time.Second
+	DefaultRedisIdleConns = 8
+)
+
+// RedisBackend is a Backend storing the values in Redis, so that every
+// instance of a server shares them. It speaks the Redis protocol over a
+// small pool of connections.
+type RedisBackend struct {
+	addr     string
+	password string
+	db       int
+	timeout  time.Duration
+	// idle holds the connections not in use.
+	idle chan *redisConn
+}
+
+// RedisOption configures a RedisBackend.
+type RedisOption func(*RedisBackend)
+
+// WithRedisPassword authenticates the connections with password.
+func WithRedisPassword(password string) RedisOption {
+	return func(b *RedisBackend) { b.password = password }
+}
+
+// WithRedisDB selects the database of the connections.
+func WithRedisDB(db int) RedisOption {
+	return func(b *RedisBackend) { b.db = db }
+}
+
+// WithRedisTimeout bounds the time connecting and each command take, so
+// that a slow Redis delays requests by at most timeout before they are
+// computed instead.
+func WithRedisTimeout(timeout time.Duration) RedisOption {
+	return func(b *RedisBackend) { b.timeout = timeout }
+}
+
+// WithRedisIdleConns sets the number of connections kept open between
+// commands.
+func WithRedisIdleConns(n int) RedisOption {
+	return func(b *RedisBackend) { b.idle = make(chan *redisConn, n) }
+}
+
+// NewRedisBackend returns a RedisBackend for the server at addr, e.g.
+// localhost:6379. Connections are opened when first needed.
+func NewRedisBackend(addr string, opts ...RedisOption) *RedisBackend {
+	b := &RedisBackend{
+		addr:    addr,
+		timeout: DefaultRedisTimeout,
+		idle:    make(chan *redisConn, DefaultRedisIdleConns),
+	}
+	for _, opt := range opts {
+		opt(b)
+	}
+	return b
+}
+
+func (b *RedisBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	reply, err := b.do(ctx, "GET", key)
+	if err != nil || reply == nil {
+		return nil, false, err
+	}
+	value, ok := reply.([]byte)
+	if !ok {
+		return nil, false, fmt.Errorf("redis: unexpected GET reply %v", reply)
+	}
+	return value, true, nil
+}
+
+func (b *RedisBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	_, err := b.do(ctx, "SET", key, string(value), "PX", strconv.FormatInt(ttl.Milliseconds(), 10))
+	return err
+}
+
+func (b *RedisBackend) Delete(ctx context.Context, key string) error {
+	_, err := b.do(ctx, "DEL", key)
+	return err
+}
+
+// DeletePrefix scans the keys starting with prefix and deletes them in
+// batches, without blocking Redis as KEYS would.
+func (b *RedisBackend) DeletePrefix(ctx context.Context, prefix string) error {
+	pattern := globEscaper.Replace(prefix) + "*"
+	cursor := "0"
+	for {
+		reply, err := b.do(ctx, "SCAN", cursor, "MATCH", pattern, "COUNT", "500")
+		if err != nil {
+			return err
+		}
+		parts, ok := reply.([]any)
+		if !ok || len(parts) != 2 {
+			return fmt.Errorf("redis: unexpected SCAN reply %v", reply)
+		}
+		next, _ := parts[0].([]byte)
+		keys, _ := parts[1].([]any)
+		if len(keys) > 0 {
+			args := []string{"DEL"}
+			for _, k := range keys {
+				if k, ok := k.([]byte); ok {
+					args = append(args, string(k))
+				}
+			}
+			if _, err := b.do(ctx, args...); err != nil {
+				return err
+			}
+		}
+		if cursor = string(next); cursor == "0" || cursor == "" {
+			return nil
+		}
+	}
+}
+
+// Close closes the idle connections.
+func (b *RedisBackend) Close() error {
+	for {
+		select {
+		case c := <-b.idle:
+			c.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+// globEscaper escapes the characters special to the patterns of SCAN.
+var globEscaper = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`)
+
+// RedisError is an error reply of Redis.
+type RedisError struct {
+	Message string
+}
+
+func (e *RedisError) Error() string {
+	return "redis: " + e.Message
+}
+
+// do sends a command and returns its reply: nil, an int64, a []byte or a
+// []any of replies.
+func (b *RedisBackend) do(ctx context.Context, args ...string) (any, error) {
+	c, err := b.conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply, err := c.do(ctx, b.timeout, args...)
+	var redisErr *RedisError
+	if err != nil && !errors.As(err, &redisErr) {
+		// The connection is in an unknown state.
+		c.Close()
+		return nil, err
+	}
+	select {
+	case b.idle <- c:
+	default:
+		c.Close()
+	}
+	return reply, err
+}
+
+// conn returns an idle connection or dials a new one.
+func (b *RedisBackend) conn(ctx context.Context) (*redisConn, error) {
+	select {
+	case c := <-b.idle:
+		return c, nil
+	default:
+	}
+	dialer := net.Dialer{Timeout: b.timeout}
+	nc, err := dialer.DialContext(ctx, "tcp", b.addr)
+	if err != nil {
+		return nil, err
+	}
+	c := &redisConn{Conn: nc, r: bufio.NewReader(nc)}
+	if b.password != "" {
+		if _, err := c.do(ctx, b.timeout, "AUTH", b.password); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	if b.db != 0 {
+		if _, err := c.do(ctx, b.timeout, "SELECT", strconv.Itoa(b.db)); err != nil {
+			c.Close()
+			return nil, err
+		}
+	}
+	return c, nil
+}
+
+type redisConn struct {
+	net.Conn
+	r *bufio.Reader
+}
+
+func (c *redisConn) do(ctx context.Context, timeout time.Duration, args ...string) (any, error) {
+	deadline := time.Now().Add(timeout)
+	if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+		deadline = d
+	}
+	c.SetDeadline(deadline)
+	var cmd strings.Builder
+	fmt.Fprintf(&cmd, "*%d\r\n", len(args))
+	for _, a := range args {
+		fmt.Fprintf(&cmd, "$%d\r\n%s\r\n", len(a), a)
+	}
+	if _, err := io.WriteString(c, cmd.String()); err != nil {
+		return nil, err
+	}
+	return readReply(c.r)
+}
+
+// readReply reads a reply of the Redis protocol.
+func readReply(r *bufio.Reader) (any, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	line = strings.TrimSuffix(line, "\r\n")
+	if line == "" {
+		return nil, errors.New("redis: empty reply")
+	}
+	switch kind, rest := line[0], line[1:]; kind {
+	case '+':
+		return []byte(rest), nil
+	case '-':
+		return nil, &RedisError{Message: rest}
+	case ':':
+		return strconv.ParseInt(rest, 10, 64)
+	case '$':
+		n, err := strconv.Atoi(rest)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		buf := make([]byte, n+2)
+		if _, err := io.ReadFull(r, buf); err != nil {
+			return nil, err
+		}
+		return buf[:n], nil
+	case '*':
+		n, err := strconv.Atoi(rest)
+		if err != nil || n < 0 {
+			return nil, err
+		}
+		replies := make([]any, n)
+		for i := range replies {
+			if replies[i], err = readReply(r); err != nil {
+				return nil, err
+			}
+		}
+		return replies, nil
+	}
+	return nil, fmt.Errorf("redis: unexpected reply %q", line)
+}
@@ -0,0 +1,150 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"net"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeRedis serves the commands of RedisBackend from a map, ignoring
+// expiry.
+type fakeRedis struct {
+	mu       sync.Mutex
+	values   map[string]string
+	password string
+	commands []string
+}
+
+func startFakeRedis(t *testing.T, password string) (*fakeRedis, string) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Skipf("cannot listen: %v", err)
+	}
+	t.Cleanup(func() { l.Close() })
+	f := &fakeRedis{values: map[string]string{}, password: password}
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	return f, l.Addr().String()
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	authed := f.password == ""
+	for {
+		reply, err := readReply(r)
+		if err != nil {
+			return
+		}
+		var args []string
+		for _, a := range reply.([]any) {
+			args = append(args, string(a.([]byte)))
+		}
+		f.mu.Lock()
+		f.commands = append(f.commands, args[0])
+		var out string
+		switch {
+		case args[0] == "AUTH":
+			authed = args[1] == f.password
+			out = "+OK\r\n"
+			if !authed {
+				out = "-WRONGPASS invalid password\r\n"
+			}
+		case !authed:
+			out = "-NOAUTH Authentication required\r\n"
+		case args[0] == "GET":
+			if v, ok := f.values[args[1]]; ok {
+				out = fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+			} else {
+				out = "$-1\r\n"
+			}
+		case args[0] == "SET":
+			f.values[args[1]] = args[2]
+			out = "+OK\r\n"
+		case args[0] == "DEL":
+			for _, k := range args[1:] {
+				delete(f.values, k)
+			}
+			out = fmt.Sprintf(":%d\r\n", len(args)-1)
+		case args[0] == "SCAN":
+			// Every key is returned in one batch.
+			var keys []string
+			for k := range f.values {
+				if ok, _ := path.Match(args[3], k); ok {
+					keys = append(keys, fmt.Sprintf("$%d\r\n%s\r\n", len(k), k))
+				}
+			}
+			out = fmt.Sprintf("*2\r\n$1\r\n0\r\n*%d\r\n%s", len(keys), strings.Join(keys, ""))
+		default:
+			out = "-ERR unknown command\r\n"
+		}
+		f.mu.Unlock()
+		if _, err := conn.Write([]byte(out)); err != nil {
+			return
+		}
+	}
+}
+
+func TestRedisBackend(t *testing.T) {
+	f, addr := startFakeRedis(t, "secret")
+	b := NewRedisBackend(addr, WithRedisPassword("secret"))
+	defer b.Close()
+	ctx := context.Background()
+
+	if _, ok, err := b.Get(ctx, "days:v1:a"); ok || err != nil {
+		t.Fatalf("Get() of a missing key = %v, %v, want false, nil", ok, err)
+	}
+	value := "binary\r\n\x00value"
+	for _, key := range []string{"days:v1:a", "days:v2:b", "years:v1:a"} {
+		if err := b.Set(ctx, key, []byte(value), time.Minute); err != nil {
+			t.Fatalf("Set() = %v", err)
+		}
+	}
+	if got, ok, err := b.Get(ctx, "days:v1:a"); !ok || err != nil || string(got) != value {
+		t.Errorf("Get() = %q, %v, %v, want %q, true, nil", got, ok, err, value)
+	}
+	if err := b.DeletePrefix(ctx, "days:"); err != nil {
+		t.Fatalf("DeletePrefix() = %v", err)
+	}
+	if err := b.Delete(ctx, "missing"); err != nil {
+		t.Fatalf("Delete() = %v", err)
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if len(f.values) != 1 || f.values["years:v1:a"] != value {
+		t.Errorf("after DeletePrefix() Redis holds %v, want only years:v1:a", f.values)
+	}
+	// The connection is reused, so it authenticates once.
+	if auths := strings.Count(strings.Join(f.commands, " "), "AUTH"); auths != 1 {
+		t.Errorf("authenticated %d times, want 1", auths)
+	}
+}
+
+func TestRedisBackendErrors(t *testing.T) {
+	_, addr := startFakeRedis(t, "secret")
+	b := NewRedisBackend(addr, WithRedisPassword("wrong"))
+	defer b.Close()
+	if _, _, err := b.Get(context.Background(), "a"); err == nil || !strings.Contains(err.Error(), "WRONGPASS") {
+		t.Errorf("Get() with a wrong password = %v, want WRONGPASS", err)
+	}
+
+	// A cache treats an unreachable backend as a miss.
+	c := New[int](WithBackend(NewRedisBackend("127.0.0.1:1", WithRedisTimeout(100*time.Millisecond)), intCodec))
+	if v, err := c.Get(context.Background(), "a", constant(1)); v != 1 || err != nil {
+		t.Errorf("Get() with Redis down = %v, %v, want 1, nil", v, err)
+	}
+}
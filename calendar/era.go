@@ -0,0 +1,110 @@
+// Package calendar converts between Gregorian dates and the years of the
+// traditional Indian eras.
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// Era is a traditional year count.
+type Era string
+
+const (
+	// VikramSamvat starts in 57 BCE.
+	VikramSamvat Era = "vikram"
+	// ShakaSamvat starts in 78 CE.
+	ShakaSamvat Era = "shaka"
+)
+
+// offset is the difference between an era year and the Gregorian year in
+// which it begins.
+func (e Era) offset() (int, error) {
+	switch e {
+	case VikramSamvat:
+		return 57, nil
+	case ShakaSamvat:
+		return -78, nil
+	default:
+		return 0, fmt.Errorf("unknown era %q", e)
+	}
+}
+
+// NewYearRule is the event starting the year.
+type NewYearRule string
+
+const (
+	// Chaitradi years begin with the lunar month of Chaitra, in spring.
+	Chaitradi NewYearRule = "chaitradi"
+	// Kartikadi years begin with the lunar month of Kartika, after Diwali.
+	Kartikadi NewYearRule = "kartikadi"
+	// Meshadi years begin when the sun enters sidereal Mesha, in mid April.
+	Meshadi NewYearRule = "meshadi"
+)
+
+// regionalRules lists the regions whose new year differs from Chaitradi.
+var regionalRules = map[string]NewYearRule{
+	"gujarat":    Kartikadi,
+	"tamil_nadu": Meshadi,
+	"kerala":     Meshadi,
+	"bengal":     Meshadi,
+	"odisha":     Meshadi,
+	"assam":      Meshadi,
+	"punjab":     Meshadi,
+}
+
+// RuleFor returns the new-year rule followed in region. Regions without a
+// specific rule, including the empty region, follow Chaitradi.
+func RuleFor(region string) NewYearRule {
+	if rule, ok := regionalRules[region]; ok {
+		return rule
+	}
+	return Chaitradi
+}
+
+// Year returns the year of era in progress at t under the new-year rule of
+// region.
+func Year(era Era, t time.Time, region string) (int, error) {
+	offset, err := era.offset()
+	if err != nil {
+		return 0, err
+	}
+	return newYearGregorian(RuleFor(region), t) + offset, nil
+}
+
+// GregorianYear returns the Gregorian year in which year of era begins. The
+// era year then runs into the following Gregorian year.
+func GregorianYear(era Era, year int) (int, error) {
+	offset, err := era.offset()
+	if err != nil {
+		return 0, err
+	}
+	return year - offset, nil
+}
+
+// newYearGregorian returns the Gregorian year in which the year in progress
+// at t began under rule.
+func newYearGregorian(rule NewYearRule, t time.Time) int {
+	year := t.UTC().Year()
+	switch rule {
+	case Kartikadi:
+		// Kartika begins in October or November, so before September the
+		// year started in the previous autumn.
+		if t.UTC().Month() < time.September || astronomy.CalculateMasa(t).Number < 8 {
+			return year - 1
+		}
+		return year
+	case Meshadi:
+		jd := astronomy.JulianDayTT(t)
+		sun := astronomy.SiderealLongitude(astronomy.SunLongitude(jd), jd)
+		// Between January and the sankranti the sun is in Makara to Meena.
+		if t.UTC().Month() <= time.May && sun >= 270 {
+			return year - 1
+		}
+		return year
+	default:
+		return astronomy.LunarYear(t)
+	}
+}
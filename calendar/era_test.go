@@ -0,0 +1,54 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestYear(t *testing.T) {
+	tests := []struct {
+		name   string
+		era    Era
+		t      time.Time
+		region string
+		want   int
+	}{
+		// Vikram Samvat 2081 began on 2024-04-09 in the north and on
+		// 2024-11-02 in Gujarat.
+		{"north before ugadi", VikramSamvat, time.Date(2024, 4, 1, 6, 0, 0, 0, time.UTC), "", 2080},
+		{"north after ugadi", VikramSamvat, time.Date(2024, 4, 10, 6, 0, 0, 0, time.UTC), "", 2081},
+		{"gujarat after ugadi", VikramSamvat, time.Date(2024, 4, 10, 6, 0, 0, 0, time.UTC), "gujarat", 2080},
+		{"gujarat before diwali", VikramSamvat, time.Date(2024, 10, 30, 6, 0, 0, 0, time.UTC), "gujarat", 2080},
+		{"gujarat new year", VikramSamvat, time.Date(2024, 11, 2, 6, 0, 0, 0, time.UTC), "gujarat", 2081},
+		{"gujarat january", VikramSamvat, time.Date(2025, 1, 15, 6, 0, 0, 0, time.UTC), "gujarat", 2081},
+		// Shaka 1946 began on 2024-04-09, and the solar year on 2024-04-13.
+		{"shaka", ShakaSamvat, time.Date(2024, 4, 10, 6, 0, 0, 0, time.UTC), "", 1946},
+		{"shaka january", ShakaSamvat, time.Date(2024, 1, 15, 6, 0, 0, 0, time.UTC), "", 1945},
+		{"tamil before puthandu", ShakaSamvat, time.Date(2024, 4, 10, 6, 0, 0, 0, time.UTC), "tamil_nadu", 1945},
+		{"tamil after puthandu", ShakaSamvat, time.Date(2024, 4, 14, 6, 0, 0, 0, time.UTC), "tamil_nadu", 1946},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Year(tt.era, tt.t, tt.region)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Errorf("Year() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+
+	if _, err := Year("kali", time.Now(), ""); err == nil {
+		t.Errorf("Year() accepted an unknown era")
+	}
+}
+
+func TestGregorianYear(t *testing.T) {
+	if got, _ := GregorianYear(VikramSamvat, 2081); got != 2024 {
+		t.Errorf("GregorianYear(vikram, 2081) = %d, want 2024", got)
+	}
+	if got, _ := GregorianYear(ShakaSamvat, 1946); got != 2024 {
+		t.Errorf("GregorianYear(shaka, 1946) = %d, want 2024", got)
+	}
+}
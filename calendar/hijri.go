@@ -0,0 +1,101 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+)
+
+// MaxHijriAdjustment bounds the days by which a Hijri date may be moved
+// from the tabular calendar to follow the local sighting of the crescent.
+const MaxHijriAdjustment = 2
+
+// Names of the Hijri months from Muharram, in English and in Arabic.
+var hijriMonthNames = [2][12]string{
+	{"Muharram", "Safar", "Rabi al-Awwal", "Rabi al-Thani", "Jumada al-Ula", "Jumada al-Akhirah",
+		"Rajab", "Shaban", "Ramadan", "Shawwal", "Dhu al-Qadah", "Dhu al-Hijjah"},
+	{"محرم", "صفر", "ربيع الأول", "ربيع الآخر", "جمادى الأولى", "جمادى الآخرة",
+		"رجب", "شعبان", "رمضان", "شوال", "ذو القعدة", "ذو الحجة"},
+}
+
+// hijriEpoch is the Julian day number of 1 Muharram 1 AH, 16 July 622 of
+// the Julian calendar, in the civil reckoning.
+const hijriEpoch = 1948440
+
+// unixEpochJDN is the Julian day number of 1970-01-01.
+const unixEpochJDN = 2440588
+
+// HijriDate is a date of the Islamic calendar.
+type HijriDate struct {
+	Year  int
+	Month int
+	Day   int
+	// MonthName is the name of the month in English, e.g. "Ramadan", and
+	// ArabicMonthName in Arabic.
+	MonthName       string
+	ArabicMonthName string
+	// Adjustment is the number of days the date was moved from the
+	// tabular calendar.
+	Adjustment int
+}
+
+// Hijri returns the Hijri date of the civil date of t, in the time zone of
+// t, moved by adjustment days from the tabular calendar. The tabular
+// calendar has months of 30 and 29 days in turn and 11 leap years in 30,
+// and may differ by a day or two from the calendars following the sighting
+// of the crescent: a positive adjustment starts the months that many days
+// later. The Hijri day
+// begins at sunset, so the date is that of the daytime of the civil day.
+// It returns an error if adjustment exceeds MaxHijriAdjustment.
+func Hijri(t time.Time, adjustment int) (HijriDate, error) {
+	if adjustment < -MaxHijriAdjustment || adjustment > MaxHijriAdjustment {
+		return HijriDate{}, fmt.Errorf("hijri adjustment %d out of range -%d to %d", adjustment, MaxHijriAdjustment, MaxHijriAdjustment)
+	}
+	jdn := julianDayNumber(t) - adjustment
+	year := (30*(jdn-hijriEpoch) + 10646) / 10631
+	// Months start every 29.5 days, rounded up, from the new year.
+	month := min(12, (jdn-hijriJDN(year, 1, 1))*2/59+1)
+	return HijriDate{
+		Year:            year,
+		Month:           month,
+		Day:             jdn - hijriJDN(year, month, 1) + 1,
+		MonthName:       hijriMonthNames[0][month-1],
+		ArabicMonthName: hijriMonthNames[1][month-1],
+		Adjustment:      adjustment,
+	}, nil
+}
+
+// GregorianOfHijri returns the midnight in tz starting the civil day of the
+// Hijri date, moved by adjustment days from the tabular calendar as by
+// Hijri.
+func GregorianOfHijri(year, month, day, adjustment int, tz *time.Location) (time.Time, error) {
+	if adjustment < -MaxHijriAdjustment || adjustment > MaxHijriAdjustment {
+		return time.Time{}, fmt.Errorf("hijri adjustment %d out of range -%d to %d", adjustment, MaxHijriAdjustment, MaxHijriAdjustment)
+	}
+	if year < 1 || month < 1 || month > 12 || day < 1 || day > hijriMonthLength(year, month) {
+		return time.Time{}, fmt.Errorf("invalid hijri date %d-%02d-%02d", year, month, day)
+	}
+	days := hijriJDN(year, month, day) + adjustment - unixEpochJDN
+	y, m, d := time.Unix(int64(days)*86400, 0).UTC().Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, tz), nil
+}
+
+// hijriJDN returns the Julian day number of a date of the tabular calendar.
+func hijriJDN(year, month, day int) int {
+	return day + (59*(month-1)+1)/2 + (year-1)*354 + (3+11*year)/30 + hijriEpoch - 1
+}
+
+// hijriMonthLength returns the number of days of month in the tabular
+// calendar: 30 for odd months, 29 for even ones but Dhu al-Hijjah of leap
+// years.
+func hijriMonthLength(year, month int) int {
+	if month == 12 {
+		return hijriJDN(year+1, 1, 1) - hijriJDN(year, month, 1)
+	}
+	return hijriJDN(year, month+1, 1) - hijriJDN(year, month, 1)
+}
+
+// julianDayNumber returns the Julian day number of the civil date of t.
+func julianDayNumber(t time.Time) int {
+	y, m, d := t.Date()
+	return int(time.Date(y, m, d, 0, 0, 0, 0, time.UTC).Unix()/86400) + unixEpochJDN
+}
@@ -0,0 +1,73 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func TestHijri(t *testing.T) {
+	tests := []struct {
+		date       string
+		adjustment int
+		want       HijriDate
+	}{
+		{"2024-03-11", 0, HijriDate{Year: 1445, Month: 9, Day: 1, MonthName: "Ramadan", ArabicMonthName: "رمضان"}},
+		{"2024-03-10", 0, HijriDate{Year: 1445, Month: 8, Day: 29, MonthName: "Shaban", ArabicMonthName: "شعبان"}},
+		// Sighted a day later, Ramadan starts on 2024-03-12.
+		{"2024-03-11", 1, HijriDate{Year: 1445, Month: 8, Day: 29, MonthName: "Shaban", ArabicMonthName: "شعبان", Adjustment: 1}},
+		// 1445 is a leap year, whose Dhu al-Hijjah has 30 days.
+		{"2024-07-08", 0, HijriDate{Year: 1446, Month: 1, Day: 1, MonthName: "Muharram", ArabicMonthName: "محرم"}},
+		// 16 July 622 of the Julian calendar.
+		{"0622-07-19", 0, HijriDate{Year: 1, Month: 1, Day: 1, MonthName: "Muharram", ArabicMonthName: "محرم"}},
+	}
+	for _, tt := range tests {
+		day, err := time.Parse("2006-01-02", tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := Hijri(day, tt.adjustment)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != tt.want {
+			t.Errorf("Hijri(%s, %d) = %+v, want %+v", tt.date, tt.adjustment, got, tt.want)
+		}
+		back, err := GregorianOfHijri(got.Year, got.Month, got.Day, tt.adjustment, time.UTC)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if back.Format("2006-01-02") != day.Format("2006-01-02") {
+			t.Errorf("GregorianOfHijri(%+v) = %s, want %s", got, back.Format("2006-01-02"), tt.date)
+		}
+	}
+}
+
+func TestHijriRoundTrip(t *testing.T) {
+	day := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i < 3*366; i++ {
+		d := day.AddDate(0, 0, i)
+		h, err := Hijri(d, 0)
+		if err != nil {
+			t.Fatal(err)
+		}
+		back, err := GregorianOfHijri(h.Year, h.Month, h.Day, 0, time.UTC)
+		if err != nil {
+			t.Fatalf("GregorianOfHijri(%+v): %v", h, err)
+		}
+		if !back.Equal(d) {
+			t.Fatalf("GregorianOfHijri(Hijri(%s)) = %s", d.Format("2006-01-02"), back.Format("2006-01-02"))
+		}
+	}
+}
+
+func TestHijriInvalid(t *testing.T) {
+	if _, err := Hijri(time.Now(), 3); err == nil {
+		t.Error("Hijri() accepted an adjustment of 3 days")
+	}
+	if _, err := GregorianOfHijri(1445, 13, 1, 0, time.UTC); err == nil {
+		t.Error("GregorianOfHijri() accepted month 13")
+	}
+	if _, err := GregorianOfHijri(1445, 2, 30, 0, time.UTC); err == nil {
+		t.Error("GregorianOfHijri() accepted Safar 30")
+	}
+}
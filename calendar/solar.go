@@ -0,0 +1,266 @@
+package calendar
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// SolarCalendar is a regional solar calendar, whose months start when the
+// sun enters a sidereal sign. They differ in the instant of the day that
+// decides which month it belongs to, so that a sankranti late in the day
+// starts the month on the next day in some of them.
+type SolarCalendar string
+
+const (
+	// TamilCalendar counts a day in the month the sun is in at sunset.
+	TamilCalendar SolarCalendar = "tamil"
+	// MalayalamCalendar counts a day in the month the sun is in at the end
+	// of its madhyahna, three fifths of the daytime after sunrise.
+	MalayalamCalendar SolarCalendar = "malayalam"
+	// BengaliCalendar counts a day in the month the sun is in at the
+	// midnight starting it, so that a sankranti after sunrise starts the
+	// month on the next day.
+	BengaliCalendar SolarCalendar = "bengali"
+	// OdiaCalendar counts a day in the month the sun is in at sunrise.
+	OdiaCalendar SolarCalendar = "odia"
+	// NepaliCalendar, the Bikram Sambat of Nepal, counts a day in the month
+	// the sun is in at the midnight ending it, so that a month starts on
+	// the civil date of its sankranti.
+	NepaliCalendar SolarCalendar = "nepali"
+)
+
+// SolarCalendars lists the supported solar calendars.
+var SolarCalendars = []SolarCalendar{TamilCalendar, MalayalamCalendar, BengaliCalendar, OdiaCalendar, NepaliCalendar}
+
+// regionalCalendars lists the regions following a solar calendar.
+var regionalCalendars = map[string]SolarCalendar{
+	"tamil_nadu": TamilCalendar,
+	"kerala":     MalayalamCalendar,
+	"bengal":     BengaliCalendar,
+	"odisha":     OdiaCalendar,
+}
+
+// ParseSolarCalendar returns the solar calendar named s.
+func ParseSolarCalendar(s string) (SolarCalendar, error) {
+	for _, c := range SolarCalendars {
+		if string(c) == s {
+			return c, nil
+		}
+	}
+	return "", fmt.Errorf("unknown solar calendar %q", s)
+}
+
+// SolarCalendarFor returns the solar calendar followed in region, and false
+// if the region follows none.
+func SolarCalendarFor(region string) (SolarCalendar, bool) {
+	c, ok := regionalCalendars[region]
+	return c, ok
+}
+
+// Names of the months of the solar calendars from Mesha, in English and in
+// the script of the calendar.
+var solarMonthNames = map[SolarCalendar][2][12]string{
+	TamilCalendar: {
+		{"Chithirai", "Vaikasi", "Aani", "Aadi", "Avani", "Purattasi", "Aippasi", "Karthigai", "Margazhi", "Thai", "Maasi", "Panguni"},
+		{"சித்திரை", "வைகாசி", "ஆனி", "ஆடி", "ஆவணி", "புரட்டாசி", "ஐப்பசி", "கார்த்திகை", "மார்கழி", "தை", "மாசி", "பங்குனி"},
+	},
+	MalayalamCalendar: {
+		{"Medam", "Edavam", "Mithunam", "Karkidakam", "Chingam", "Kanni", "Thulam", "Vrischikam", "Dhanu", "Makaram", "Kumbham", "Meenam"},
+		{"മേടം", "ഇടവം", "മിഥുനം", "കർക്കടകം", "ചിങ്ങം", "കന്നി", "തുലാം", "വൃശ്ചികം", "ധനു", "മകരം", "കുംഭം", "മീനം"},
+	},
+	BengaliCalendar: {
+		{"Boishakh", "Joishtho", "Asharh", "Shrabon", "Bhadro", "Ashwin", "Kartik", "Ogrohayon", "Poush", "Magh", "Falgun", "Choitro"},
+		{"বৈশাখ", "জ্যৈষ্ঠ", "আষাঢ়", "শ্রাবণ", "ভাদ্র", "আশ্বিন", "কার্তিক", "অগ্রহায়ণ", "পৌষ", "মাঘ", "ফাল্গুন", "চৈত্র"},
+	},
+	OdiaCalendar: {
+		{"Baisakha", "Jyeshtha", "Ashadha", "Shravana", "Bhadraba", "Ashwina", "Kartika", "Margashira", "Pausha", "Magha", "Phalguna", "Chaitra"},
+		{"ବୈଶାଖ", "ଜ୍ୟେଷ୍ଠ", "ଆଷାଢ଼", "ଶ୍ରାବଣ", "ଭାଦ୍ରବ", "ଆଶ୍ୱିନ", "କାର୍ତ୍ତିକ", "ମାର୍ଗଶିର", "ପୌଷ", "ମାଘ", "ଫାଲ୍ଗୁନ", "ଚୈତ୍ର"},
+	},
+	NepaliCalendar: {
+		{"Baishakh", "Jestha", "Ashadh", "Shrawan", "Bhadra", "Ashwin", "Kartik", "Mangsir", "Poush", "Magh", "Falgun", "Chaitra"},
+		{"बैशाख", "जेठ", "असार", "साउन", "भदौ", "असोज", "कात्तिक", "मंसिर", "पुस", "माघ", "फागुन", "चैत"},
+	},
+}
+
+// solarEra is the era counting the years of a solar calendar.
+type solarEra struct {
+	name string
+	// month is the sign, Mesha = 1, starting the year.
+	month int
+	// offset is the difference between an era year and the Gregorian year
+	// in which it begins.
+	offset int
+}
+
+// solarEras lists the eras of the solar calendars counting their years.
+var solarEras = map[SolarCalendar]solarEra{
+	// Kollavarsham 1200 began on Chingam 1, 2024-08-17.
+	MalayalamCalendar: {name: "Kollavarsham", month: 5, offset: -824},
+	// Bangabda 1431 began on Boishakh 1, 2024-04-14.
+	BengaliCalendar: {name: "Bangabda", month: 1, offset: -593},
+	// Bikram Sambat 2081 began on Baishakh 1, 2024-04-13.
+	NepaliCalendar: {name: "Bikram Sambat", month: 1, offset: 57},
+}
+
+// SolarDate is a date of a solar calendar.
+type SolarDate struct {
+	Calendar SolarCalendar
+	// Month is the sign of the sun starting from Mesha = 1, e.g. 5 for
+	// Chingam, whatever the first month of the year of the calendar.
+	Month int
+	// MonthName is the name of the month in English, e.g. "Chingam", and
+	// LocalMonthName in the script of the calendar.
+	MonthName      string
+	LocalMonthName string
+	// Day counts the days of the month from 1.
+	Day int
+	// Era names the era counting Year, "Kollavarsham" for the Malayalam
+	// calendar, "Bangabda" for the Bengali one and "Bikram Sambat" for the
+	// Nepali one. It is empty, and Year zero, for the calendars naming their
+	// years instead.
+	Era  string
+	Year int
+	// Samvatsara is the name of the year of the 60-year cycle starting with
+	// Mesha, by which the Tamil calendar names its years.
+	Samvatsara string
+	// MonthStart is the midnight starting the first day of the month.
+	MonthStart time.Time
+}
+
+// reference returns the instant of day deciding its month in c.
+func (c SolarCalendar) reference(day time.Time, sunTimes *astronomy.SunTimes) time.Time {
+	switch c {
+	case TamilCalendar:
+		return sunTimes.Sunset
+	case MalayalamCalendar:
+		return sunTimes.Sunrise.Add(3 * sunTimes.DayLength() / 5)
+	case BengaliCalendar:
+		return day
+	case NepaliCalendar:
+		return day.AddDate(0, 0, 1)
+	default:
+		return sunTimes.Sunrise
+	}
+}
+
+// SolarDater dates the days of a location in solar calendars. It keeps the
+// start of the month last found in each calendar, so that dating
+// consecutive days costs little more than finding the sign of the sun. It
+// is not safe for concurrent use.
+type SolarDater struct {
+	loc    astronomy.Location
+	starts map[SolarCalendar]solarMonthStart
+}
+
+type solarMonthStart struct {
+	month int
+	start time.Time
+}
+
+// NewSolarDater returns a SolarDater for the days of loc.
+func NewSolarDater(loc astronomy.Location) *SolarDater {
+	return &SolarDater{loc: loc, starts: map[SolarCalendar]solarMonthStart{}}
+}
+
+// Date returns the date in c of day, the midnight starting a civil day,
+// whose sun times at the location of the dater are sunTimes.
+func (s *SolarDater) Date(c SolarCalendar, day time.Time, sunTimes *astronomy.SunTimes) (SolarDate, error) {
+	names, ok := solarMonthNames[c]
+	if !ok {
+		return SolarDate{}, fmt.Errorf("unknown solar calendar %q", c)
+	}
+	ref := c.reference(day, sunTimes)
+	month := astronomy.SunRashi(ref)
+	start, err := s.monthStart(c, month, day, ref)
+	if err != nil {
+		return SolarDate{}, err
+	}
+
+	date := SolarDate{
+		Calendar:       c,
+		Month:          month,
+		MonthName:      names[0][month-1],
+		LocalMonthName: names[1][month-1],
+		Day:            daysBetween(start, day) + 1,
+		MonthStart:     start,
+	}
+	// Mesha to Dhanu start in the Gregorian year of the sankranti of Mesha
+	// before them, Makara to Meena in the following one.
+	meshaYear := start.Year()
+	if month > 9 {
+		meshaYear--
+	}
+	if era, ok := solarEras[c]; ok {
+		year := meshaYear
+		if month < era.month {
+			year--
+		}
+		date.Era, date.Year = era.name, year+era.offset
+	}
+	if c == TamilCalendar {
+		date.Samvatsara = astronomy.SamvatsaraNames()[((meshaYear-1987)%60+60)%60]
+	}
+	return date, nil
+}
+
+// monthStart returns the midnight starting month of c, the month of day
+// decided at ref.
+func (s *SolarDater) monthStart(c SolarCalendar, month int, day, ref time.Time) (time.Time, error) {
+	// Solar months last at most 32 days.
+	if m, ok := s.starts[c]; ok && m.month == month && !day.Before(m.start) && day.Before(m.start.AddDate(0, 0, 33)) {
+		return m.start, nil
+	}
+
+	// The month starts on the civil date of its sankranti, or on the next
+	// when the sankranti follows the reference instant of that date.
+	sankranti := astronomy.NextSankranti(ref.AddDate(0, 0, -33))
+	for sankranti.Number != month {
+		sankranti = astronomy.NextSankranti(sankranti.Time.Add(24 * time.Hour))
+	}
+	y, m, d := sankranti.Time.In(day.Location()).Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, day.Location())
+	sunTimes, err := astronomy.CalculateSunTimes(s.loc, start)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("calculating sunrise on %s: %w", start.Format("2006-01-02"), err)
+	}
+	if c.reference(start, sunTimes).Before(sankranti.Time) {
+		start = start.AddDate(0, 0, 1)
+	}
+	s.starts[c] = solarMonthStart{month: month, start: start}
+	return start, nil
+}
+
+// SolarDateOf returns the date in c of the civil day of t at loc, in the
+// time zone of t.
+func SolarDateOf(c SolarCalendar, t time.Time, loc astronomy.Location) (SolarDate, error) {
+	y, m, d := t.Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+	sunTimes, err := astronomy.CalculateSunTimes(loc, day)
+	if err != nil {
+		return SolarDate{}, fmt.Errorf("calculating sunrise on %s: %w", day.Format("2006-01-02"), err)
+	}
+	return NewSolarDater(loc).Date(c, day, sunTimes)
+}
+
+// nepalLocation is Kathmandu, where the Nepali calendar is reckoned.
+var nepalLocation = astronomy.Location{Latitude: 27.7172, Longitude: 85.324}
+
+// BikramSambat returns the date of the Nepali calendar on the civil date of
+// t, whatever its time zone, as reckoned in Nepal.
+func BikramSambat(t time.Time) (SolarDate, error) {
+	tz, err := time.LoadLocation("Asia/Kathmandu")
+	if err != nil {
+		return SolarDate{}, err
+	}
+	y, m, d := t.Date()
+	return SolarDateOf(NepaliCalendar, time.Date(y, m, d, 0, 0, 0, 0, tz), nepalLocation)
+}
+
+// daysBetween returns the number of civil days from the midnight from to
+// the midnight to, which differ by whole days but for daylight saving
+// changes.
+func daysBetween(from, to time.Time) int {
+	return int((to.Sub(from) + 12*time.Hour) / (24 * time.Hour))
+}
@@ -0,0 +1,133 @@
+package calendar
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestSolarDaterDate(t *testing.T) {
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chennai := astronomy.Location{Latitude: 13.0827, Longitude: 80.2707}
+	s := NewSolarDater(chennai)
+	// Days are dated in order, as by festival generation, and across the
+	// sankranti of 21:15 IST on 2024-04-13, after sunset.
+	want := map[string][2]int{
+		"2024-04-01": {12, 19},
+		"2024-04-13": {12, 31},
+		"2024-04-14": {1, 1},
+		"2024-05-01": {1, 18},
+	}
+	for day := time.Date(2024, 4, 1, 0, 0, 0, 0, tz); day.Before(time.Date(2024, 5, 2, 0, 0, 0, 0, tz)); day = day.AddDate(0, 0, 1) {
+		sunTimes, err := astronomy.CalculateSunTimes(chennai, day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := s.Date(TamilCalendar, day, sunTimes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w, ok := want[day.Format("2006-01-02")]; ok && [2]int{got.Month, got.Day} != w {
+			t.Errorf("Date(%s) = %d/%d, want %d/%d", day.Format("2006-01-02"), got.Month, got.Day, w[0], w[1])
+		}
+	}
+}
+
+func TestSolarDateOf(t *testing.T) {
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	chennai := astronomy.Location{Latitude: 13.0827, Longitude: 80.2707}
+	kochi := astronomy.Location{Latitude: 9.9312, Longitude: 76.2673}
+	kolkata := astronomy.Location{Latitude: 22.5726, Longitude: 88.3639}
+	tests := []struct {
+		name     string
+		calendar SolarCalendar
+		date     string
+		loc      astronomy.Location
+		want     SolarDate
+	}{
+		{"puthandu", TamilCalendar, "2024-04-14", chennai,
+			SolarDate{Month: 1, MonthName: "Chithirai", LocalMonthName: "சித்திரை", Day: 1, Samvatsara: "Krodhi"}},
+		{"thai", TamilCalendar, "2025-01-14", chennai,
+			SolarDate{Month: 10, MonthName: "Thai", LocalMonthName: "தை", Day: 1, Samvatsara: "Krodhi"}},
+		// Kollavarsham 1200 began on Chingam 1, the sankranti of 2024-08-16
+		// falling after madhyahna.
+		{"karkidakam", MalayalamCalendar, "2024-08-16", kochi,
+			SolarDate{Month: 4, MonthName: "Karkidakam", LocalMonthName: "കർക്കടകം", Day: 32, Era: "Kollavarsham", Year: 1199}},
+		{"chingam", MalayalamCalendar, "2024-08-17", kochi,
+			SolarDate{Month: 5, MonthName: "Chingam", LocalMonthName: "ചിങ്ങം", Day: 1, Era: "Kollavarsham", Year: 1200}},
+		{"makaram", MalayalamCalendar, "2025-02-01", kochi,
+			SolarDate{Month: 10, MonthName: "Makaram", LocalMonthName: "മകരം", Day: 19, Era: "Kollavarsham", Year: 1200}},
+		{"boishakh", BengaliCalendar, "2024-04-14", kolkata,
+			SolarDate{Month: 1, MonthName: "Boishakh", LocalMonthName: "বৈশাখ", Day: 1, Era: "Bangabda", Year: 1431}},
+		{"magh", BengaliCalendar, "2025-02-01", kolkata,
+			SolarDate{Month: 10, MonthName: "Magh", LocalMonthName: "মাঘ", Day: 18, Era: "Bangabda", Year: 1431}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day, err := time.ParseInLocation("2006-01-02", tt.date, tz)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got, err := SolarDateOf(tt.calendar, day, tt.loc)
+			if err != nil {
+				t.Fatal(err)
+			}
+			got.MonthStart = time.Time{}
+			tt.want.Calendar = tt.calendar
+			if got != tt.want {
+				t.Errorf("SolarDateOf() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseSolarCalendar(t *testing.T) {
+	if c, err := ParseSolarCalendar("bengali"); err != nil || c != BengaliCalendar {
+		t.Errorf("ParseSolarCalendar(bengali) = %q, %v", c, err)
+	}
+	if _, err := ParseSolarCalendar("gregorian"); err == nil {
+		t.Error("ParseSolarCalendar(gregorian) succeeded")
+	}
+	if c, ok := SolarCalendarFor("kerala"); !ok || c != MalayalamCalendar {
+		t.Errorf("SolarCalendarFor(kerala) = %q, %v", c, ok)
+	}
+	if _, ok := SolarCalendarFor("gujarat"); ok {
+		t.Error("SolarCalendarFor(gujarat) found a calendar")
+	}
+}
+
+func TestBikramSambat(t *testing.T) {
+	tests := []struct {
+		date string
+		want string
+	}{
+		// Nepali new years, the first on the evening of its sankranti.
+		{"2023-04-14", "2080 Baishakh 1"},
+		{"2024-04-12", "2080 Chaitra 30"},
+		{"2024-04-13", "2081 Baishakh 1"},
+		{"2025-04-14", "2082 Baishakh 1"},
+		// Magh 1, Maghe Sankranti.
+		{"2025-01-14", "2081 Magh 1"},
+	}
+	for _, tt := range tests {
+		day, err := time.Parse("2006-01-02", tt.date)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := BikramSambat(day)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fmt.Sprintf("%d %s %d", d.Year, d.MonthName, d.Day); got != tt.want || d.Era != "Bikram Sambat" {
+			t.Errorf("BikramSambat(%s) = %s %s, want %s", tt.date, d.Era, got, tt.want)
+		}
+	}
+}
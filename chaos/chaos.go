@@ -0,0 +1,123 @@
+// Package chaos injects faults into gRPC requests so that clients, the
+// gateway and the alerting rules can be exercised against a failing server.
+// It is meant for test deployments and is never enabled by default.
+package chaos
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var logger = log.Logger()
+
+// Injector fails a percentage of requests with an Internal error and
+// delays requests by up to a maximum latency.
+type Injector struct {
+	percent float64
+	latency time.Duration
+	methods map[string]bool
+	rand    func() float64
+	sleep   func(context.Context, time.Duration) error
+}
+
+// Option configures an Injector.
+type Option func(*Injector)
+
+// WithLatency delays each request by a random duration up to max before
+// handling it.
+func WithLatency(max time.Duration) Option {
+	return func(i *Injector) {
+		i.latency = max
+	}
+}
+
+// WithMethods restricts faults to the RPCs with the given full method
+// names, e.g. "/panchangam.Panchangam/Get". Without it every RPC is
+// affected.
+func WithMethods(fullMethods ...string) Option {
+	return func(i *Injector) {
+		for _, m := range fullMethods {
+			i.methods[m] = true
+		}
+	}
+}
+
+// WithSeed seeds the random numbers choosing the requests failed and their
+// delays, so that a test sees the same faults in every run. They are
+// seeded randomly by default.
+func WithSeed(seed int64) Option {
+	return func(i *Injector) {
+		var mu sync.Mutex
+		r := rand.New(rand.NewSource(seed))
+		i.rand = func() float64 {
+			mu.Lock()
+			defer mu.Unlock()
+			return r.Float64()
+		}
+	}
+}
+
+// NewInjector returns an Injector failing percent percent of requests.
+func NewInjector(percent float64, opts ...Option) *Injector {
+	i := &Injector{
+		percent: percent,
+		methods: map[string]bool{},
+		rand:    rand.Float64,
+		sleep:   sleep,
+	}
+	for _, opt := range opts {
+		opt(i)
+	}
+	return i
+}
+
+// Enabled reports whether the injector injects any faults.
+func (i *Injector) Enabled() bool {
+	return i != nil && (i.percent > 0 || i.latency > 0)
+}
+
+// UnaryInterceptor injects the faults of i into unary RPCs.
+func (i *Injector) UnaryInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if err := i.inject(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// inject delays and fails a request to fullMethod as configured.
+func (i *Injector) inject(ctx context.Context, fullMethod string) error {
+	if len(i.methods) > 0 && !i.methods[fullMethod] {
+		return nil
+	}
+	if i.latency > 0 {
+		if err := i.sleep(ctx, time.Duration(i.rand()*float64(i.latency))); err != nil {
+			return status.FromContextError(err).Err()
+		}
+	}
+	if i.percent > 0 && i.rand()*100 < i.percent {
+		logger.WarnContext(ctx, "injecting fault", "method", fullMethod)
+		return status.Error(codes.Internal, "chaos: injected fault")
+	}
+	return nil
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
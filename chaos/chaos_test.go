@@ -0,0 +1,96 @@
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestInjector(t *testing.T) {
+	r := 0.0
+	i := NewInjector(25, WithLatency(time.Second), WithMethods("/panchangam.Panchangam/Get"))
+	i.rand = func() float64 { return r }
+	var slept time.Duration
+	i.sleep = func(ctx context.Context, d time.Duration) error {
+		slept = d
+		return nil
+	}
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return "ok", nil
+	}
+	call := func(method string) error {
+		called, slept = false, 0
+		_, err := i.UnaryInterceptor()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+		return err
+	}
+
+	r = 0.1
+	if err := call("/panchangam.Panchangam/Get"); status.Code(err) != codes.Internal || called {
+		t.Errorf("request below the fault percentage: error %v, handler called %v, want Internal and not called", err, called)
+	}
+	if slept != 100*time.Millisecond {
+		t.Errorf("slept %v, want 100ms", slept)
+	}
+
+	r = 0.5
+	if err := call("/panchangam.Panchangam/Get"); err != nil || !called {
+		t.Errorf("request above the fault percentage: error %v, handler called %v, want handled", err, called)
+	}
+
+	r = 0
+	if err := call("/panchangam.Panchangam/GetEvents"); err != nil || !called || slept != 0 {
+		t.Errorf("request to an unaffected method: error %v, handler called %v, slept %v", err, called, slept)
+	}
+}
+
+func TestInjectorCanceled(t *testing.T) {
+	i := NewInjector(0, WithLatency(time.Hour))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := i.inject(ctx, "/panchangam.Panchangam/Get"); status.Code(err) != codes.Canceled {
+		t.Errorf("inject() with a canceled context = %v, want Canceled", err)
+	}
+}
+
+func TestInjectorEnabled(t *testing.T) {
+	var nilInjector *Injector
+	for _, tt := range []struct {
+		i    *Injector
+		want bool
+	}{
+		{nilInjector, false},
+		{NewInjector(0), false},
+		{NewInjector(10), true},
+		{NewInjector(0, WithLatency(time.Second)), true},
+	} {
+		if got := tt.i.Enabled(); got != tt.want {
+			t.Errorf("Enabled() = %v, want %v", got, tt.want)
+		}
+	}
+}
+
+func TestInjectorSeed(t *testing.T) {
+	faults := func(seed int64) []bool {
+		i := NewInjector(50, WithSeed(seed))
+		var failed []bool
+		for n := 0; n < 32; n++ {
+			failed = append(failed, i.inject(context.Background(), "/panchangam.Panchangam/Get") != nil)
+		}
+		return failed
+	}
+	first, again, other := faults(42), faults(42), faults(7)
+	if fmt.Sprint(first) != fmt.Sprint(again) {
+		t.Errorf("faults with the same seed differ:\n%v\n%v", first, again)
+	}
+	if fmt.Sprint(first) == fmt.Sprint(other) {
+		t.Errorf("faults with seeds 42 and 7 are the same: %v", first)
+	}
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+// adminFlags are the flags of the commands calling the Admin service of a
+// server, which needs an API key with the admin scope.
+type adminFlags struct {
+	addr, apiKey, output *string
+}
+
+func registerAdminFlags(fs *flag.FlagSet) *adminFlags {
+	return &adminFlags{
+		addr:   serverFlag(fs),
+		apiKey: fs.String("api-key", "", "API key with the admin scope"),
+		output: fs.String("output", "text", "Output format: text or json"),
+	}
+}
+
+// parse parses the flags of the command and checks the output format.
+func (f *adminFlags) parse(fs *flag.FlagSet, args []string) {
+	parseFlags(fs, args)
+	if *f.output != "text" && *f.output != "json" {
+		log.Fatalf("Unknown output format %q: expected text or json", *f.output)
+	}
+}
+
+// connect returns a client of the Admin service and a context carrying the
+// API key, and a function closing both.
+func (f *adminFlags) connect() (ppb.AdminClient, context.Context, func()) {
+	conn := dial(*f.addr)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	if *f.apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, aaa.APIKeyHeader, *f.apiKey)
+	}
+	return ppb.NewAdminClient(conn), ctx, func() {
+		cancel()
+		conn.Close()
+	}
+}
+
+// printJSON prints m as JSON and reports whether the output is JSON.
+func (f *adminFlags) printJSON(m proto.Message) bool {
+	if *f.output != "json" {
+		return false
+	}
+	fmt.Println(string(marshalJSON(m)))
+	return true
+}
+
+// runAdminCaches lists the caches of a server:
+//
+//	client admin caches -api-key $KEY
+func runAdminCaches(fs *flag.FlagSet, args []string) {
+	f := registerAdminFlags(fs)
+	f.parse(fs, args)
+	client, ctx, closeConn := f.connect()
+	defer closeConn()
+	resp, err := client.ListCaches(ctx, &ppb.ListCachesRequest{})
+	if err != nil {
+		log.Fatalf("Error calling ListCaches: %v", err)
+	}
+	if f.printJSON(resp) {
+		return
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "CACHE\tENTRIES\tHITS\tMISSES\tLOADS\tREFRESHES\tSHARED HITS\t")
+	for _, c := range resp.GetCaches() {
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%d\t%d\t%d\t%d\t\n", c.GetName(), c.GetEntries(), c.GetHits(), c.GetMisses(),
+			c.GetLoads(), c.GetRefreshes(), c.GetSharedHits())
+	}
+	tw.Flush()
+}
+
+// runAdminCacheFlush empties a cache of a server, or every cache, unlike
+// cache purge, which empties the shared Redis cache without a server:
+//
+//	client admin cache flush -api-key $KEY -name panchangam
+func runAdminCacheFlush(fs *flag.FlagSet, args []string) {
+	f := registerAdminFlags(fs)
+	name := fs.String("name", "", "Name of the cache to flush (default every cache)")
+	f.parse(fs, args)
+	client, ctx, closeConn := f.connect()
+	defer closeConn()
+	resp, err := client.FlushCache(ctx, &ppb.FlushCacheRequest{Name: *name})
+	if err != nil {
+		log.Fatalf("Error calling FlushCache: %v", err)
+	}
+	if f.printJSON(resp) {
+		return
+	}
+	for _, name := range resp.GetFlushed() {
+		fmt.Printf("Flushed cache %s\n", name)
+	}
+}
+
+// runAdminProviders lists the ephemeris providers of a server, or enables
+// or disables one:
+//
+//	client admin providers -api-key $KEY
+//	client admin providers -api-key $KEY disable horizons
+func runAdminProviders(fs *flag.FlagSet, args []string) {
+	f := registerAdminFlags(fs)
+	f.parse(fs, args)
+	client, ctx, closeConn := f.connect()
+	defer closeConn()
+
+	var providers []*ppb.EphemerisProvider
+	switch action := fs.Arg(0); action {
+	case "":
+		resp, err := client.ListProviders(ctx, &ppb.ListProvidersRequest{})
+		if err != nil {
+			log.Fatalf("Error calling ListProviders: %v", err)
+		}
+		if f.printJSON(resp) {
+			return
+		}
+		providers = resp.GetProviders()
+	case "enable", "disable":
+		if fs.NArg() != 2 {
+			log.Fatalf("Usage: client admin providers [flags] %s <provider>", action)
+		}
+		p, err := client.SetProviderEnabled(ctx, &ppb.SetProviderEnabledRequest{Name: fs.Arg(1), Enabled: action == "enable"})
+		if err != nil {
+			log.Fatalf("Error calling SetProviderEnabled: %v", err)
+		}
+		if f.printJSON(p) {
+			return
+		}
+		providers = []*ppb.EphemerisProvider{p}
+	default:
+		log.Fatalf("Unknown providers command %q: expected enable or disable", action)
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROVIDER\tSTATE\t\t")
+	for _, p := range providers {
+		state, active := "enabled", ""
+		if !p.GetEnabled() {
+			state = "disabled"
+		}
+		if p.GetActive() {
+			active = "active"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t\n", p.GetName(), state, active)
+	}
+	tw.Flush()
+}
+
+// runAdminLogLevel changes the log level of a server:
+//
+//	client admin log-level -api-key $KEY debug
+func runAdminLogLevel(fs *flag.FlagSet, args []string) {
+	f := registerAdminFlags(fs)
+	f.parse(fs, args)
+	if fs.NArg() != 1 {
+		log.Fatalf("Usage: client admin log-level [flags] debug|info|warn|error")
+	}
+	client, ctx, closeConn := f.connect()
+	defer closeConn()
+	resp, err := client.SetLogLevel(ctx, &ppb.SetLogLevelRequest{Level: fs.Arg(0)})
+	if err != nil {
+		log.Fatalf("Error calling SetLogLevel: %v", err)
+	}
+	if f.printJSON(resp) {
+		return
+	}
+	fmt.Printf("Log level %s (was %s)\n", resp.GetLevel(), resp.GetPreviousLevel())
+}
+
+// runAdminConfig dumps the flags a server runs with, marking those changed
+// from their defaults:
+//
+//	client admin config -api-key $KEY
+func runAdminConfig(fs *flag.FlagSet, args []string) {
+	f := registerAdminFlags(fs)
+	f.parse(fs, args)
+	client, ctx, closeConn := f.connect()
+	defer closeConn()
+	config, err := client.GetConfig(ctx, &ppb.GetConfigRequest{})
+	if err != nil {
+		log.Fatalf("Error calling GetConfig: %v", err)
+	}
+	if f.printJSON(config) {
+		return
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "FLAG\tVALUE\t\t")
+	for _, fl := range config.GetFlags() {
+		changed := ""
+		if fl.GetValue() != fl.GetDefaultValue() {
+			changed = "*"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t\n", fl.GetName(), fl.GetValue(), changed)
+	}
+	tw.Flush()
+}
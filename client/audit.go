@@ -0,0 +1,230 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/audit"
+	_ "github.com/naren-m/panchangam/proto/v2/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// runAudit lists the calculations a server recorded with -audit-file or
+// -audit-db, and with -replay calculates them again on a server, printing
+// the outputs that changed, so that a discrepancy a user reports can be
+// reproduced from its request ID:
+//
+//	client audit -file audit.jsonl -request-id 3f9c2a7b1e4d
+//	client audit -file audit.jsonl -request-id 3f9c2a7b1e4d -replay -addr staging:50051
+func runAudit(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	file := fs.String("file", "", "JSON Lines audit file of the server (-audit-file)")
+	driver := fs.String("driver", "", "database/sql driver of -db, which must be built into the client")
+	dsn := fs.String("db", "", "Data source name of the audit database of the servers (-audit-db)")
+	requestID := fs.String("request-id", "", "Request ID of the calculations, as logged by the server")
+	method := fs.String("method", "", "RPC of the calculations, e.g. Get or /panchangam.v2.Panchangam/Get")
+	since := fs.Duration("since", 0, "Age of the oldest calculation listed, e.g. 24h (0 lists all)")
+	limit := fs.Int("limit", 20, "Most calculations listed, the latest ones (0 lists all)")
+	asJSON := fs.Bool("json", false, "Print the records as JSON Lines, with their inputs and outputs")
+	replay := fs.Bool("replay", false, "Calculate the listed calculations again on the server at -addr and print the differences")
+	parseFlags(fs, args)
+
+	q := audit.Query{RequestID: *requestID, Method: *method, Limit: *limit}
+	if q.Method != "" && !strings.HasPrefix(q.Method, "/") {
+		q.Method = "/panchangam.Panchangam/" + q.Method
+	}
+	if *since > 0 {
+		q.From = time.Now().Add(-*since)
+	}
+	var records []audit.Record
+	var err error
+	switch {
+	case *dsn != "":
+		db, err := sql.Open(*driver, *dsn)
+		if err != nil {
+			log.Fatalf("Error opening audit database: %v (drivers: %v)", err, sql.Drivers())
+		}
+		defer db.Close()
+		store, err := audit.NewSQLStore(context.Background(), db)
+		if err != nil {
+			log.Fatalf("Error opening audit database: %v", err)
+		}
+		if records, err = store.Query(context.Background(), q); err != nil {
+			log.Fatalf("Error reading audit log: %v", err)
+		}
+	case *file != "":
+		if records, err = audit.ReadFile(*file, q); err != nil {
+			log.Fatalf("Error reading audit log: %v", err)
+		}
+	default:
+		log.Fatalf("Usage: client audit -file FILE | -driver DRIVER -db DSN [flags]")
+	}
+	if len(records) == 0 {
+		fmt.Println("No calculations recorded")
+		return
+	}
+
+	if *replay {
+		replayCalculations(*addr, records)
+		return
+	}
+	if *asJSON {
+		enc := json.NewEncoder(os.Stdout)
+		for _, r := range records {
+			if err := enc.Encode(r); err != nil {
+				log.Fatalf("Error writing record: %v", err)
+			}
+		}
+		return
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "Time\tRequest ID\tMethod\tAyanamsa\tProvider\tVersion\tDuration\tError")
+	for _, r := range records {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.Time.Local().Format(time.DateTime), r.RequestID,
+			r.Method, r.Ayanamsa, r.Provider, r.Version, r.Duration.Round(time.Microsecond), r.Error)
+	}
+	tw.Flush()
+}
+
+// replayCalculations calls the server at addr with the inputs of each
+// record and prints the fields of the output that differ from those
+// recorded.
+func replayCalculations(addr string, records []audit.Record) {
+	client, closeConn := connect(addr)
+	defer closeConn()
+	changed := 0
+	for _, r := range records {
+		fmt.Printf("%s %s %s (%s, %s, %s)\n", r.Time.Local().Format(time.DateTime), r.RequestID, r.Method, r.Ayanamsa, r.Provider, r.Version)
+		in, out, err := calculationMessages(r.Method)
+		if err != nil {
+			fmt.Printf("  cannot replay: %v\n", err)
+			continue
+		}
+		if err := protojson.Unmarshal(r.Inputs, in); err != nil {
+			fmt.Printf("  cannot replay: invalid inputs: %v\n", err)
+			continue
+		}
+		err = client.Conn().Invoke(context.Background(), r.Method, in, out)
+		if status.Code(err) == codes.Unavailable {
+			log.Fatalf("Error calling %s: %v", r.Method, err)
+		}
+		var replayed json.RawMessage
+		if err == nil {
+			replayed, _ = protojson.Marshal(out)
+		}
+		diffs := auditDiff(r, replayed, err)
+		if len(diffs) == 0 {
+			fmt.Println("  reproduced")
+			continue
+		}
+		changed++
+		for _, d := range diffs {
+			fmt.Println("  " + d)
+		}
+	}
+	if changed > 0 {
+		fmt.Printf("%d of %d calculations changed\n", changed, len(records))
+		os.Exit(1)
+	}
+}
+
+// calculationMessages returns new request and response messages of the
+// full method, e.g. /panchangam.Panchangam/Get.
+func calculationMessages(method string) (proto.Message, proto.Message, error) {
+	name := protoreflect.FullName(strings.ReplaceAll(strings.TrimPrefix(method, "/"), "/", "."))
+	d, err := protoregistry.GlobalFiles.FindDescriptorByName(name)
+	if err != nil {
+		return nil, nil, fmt.Errorf("unknown method %s", method)
+	}
+	md, ok := d.(protoreflect.MethodDescriptor)
+	if !ok {
+		return nil, nil, fmt.Errorf("unknown method %s", method)
+	}
+	in, err := protoregistry.GlobalTypes.FindMessageByName(md.Input().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	out, err := protoregistry.GlobalTypes.FindMessageByName(md.Output().FullName())
+	if err != nil {
+		return nil, nil, err
+	}
+	return in.New().Interface(), out.New().Interface(), nil
+}
+
+// auditDiff returns the differences between the recorded outcome of r and
+// the replayed output or error, one per field, e.g.
+// `panchangamData.tithi: "Dashami" -> "Ekadashi"`.
+func auditDiff(r audit.Record, replayed json.RawMessage, err error) []string {
+	if r.Error != "" || err != nil {
+		var now string
+		if err != nil {
+			st := status.Convert(err)
+			now = st.Code().String() + ": " + st.Message()
+		}
+		if now != r.Error {
+			return []string{fmt.Sprintf("error: %q -> %q", r.Error, now)}
+		}
+		return nil
+	}
+	before, after := map[string]string{}, map[string]string{}
+	flattenJSON(r.Output, before)
+	flattenJSON(replayed, after)
+	var diffs []string
+	for path, v := range before {
+		if w, ok := after[path]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%s: %s -> (none)", path, v))
+		} else if w != v {
+			diffs = append(diffs, fmt.Sprintf("%s: %s -> %s", path, v, w))
+		}
+	}
+	for path, w := range after {
+		if _, ok := before[path]; !ok {
+			diffs = append(diffs, fmt.Sprintf("%s: (none) -> %s", path, w))
+		}
+	}
+	sort.Strings(diffs)
+	return diffs
+}
+
+// flattenJSON adds the scalar values of the JSON document data to values
+// by path, e.g. panchangamData.events[2].name.
+func flattenJSON(data json.RawMessage, values map[string]string) {
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return
+	}
+	var walk func(string, any)
+	walk = func(path string, v any) {
+		switch v := v.(type) {
+		case map[string]any:
+			for k, e := range v {
+				if path != "" {
+					k = path + "." + k
+				}
+				walk(k, e)
+			}
+		case []any:
+			for i, e := range v {
+				walk(fmt.Sprintf("%s[%d]", path, i), e)
+			}
+		default:
+			b, _ := json.Marshal(v)
+			values[path] = string(b)
+		}
+	}
+	walk("", v)
+}
@@ -0,0 +1,251 @@
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/status"
+)
+
+// histogramBounds are the upper bounds of the latency histogram buckets;
+// a last bucket holds the slower requests.
+var histogramBounds = []time.Duration{
+	time.Millisecond, 2 * time.Millisecond, 5 * time.Millisecond,
+	10 * time.Millisecond, 20 * time.Millisecond, 50 * time.Millisecond,
+	100 * time.Millisecond, 200 * time.Millisecond, 500 * time.Millisecond,
+	time.Second, 2 * time.Second, 5 * time.Second,
+}
+
+// histogramWidth is the width of the longest bar of the histogram.
+const histogramWidth = 40
+
+// sample is the outcome of a request of the benchmark.
+type sample struct {
+	Start   time.Time     `json:"start"`
+	Latency time.Duration `json:"latency_ns"`
+	// Code is the gRPC status code, OK for a success.
+	Code   string `json:"code"`
+	Worker int    `json:"worker"`
+}
+
+// runBenchmark sends Get requests from concurrent workers and reports the
+// throughput, latency percentiles and histogram, and the errors by gRPC
+// code:
+//
+//	client benchmark -workers 16 -requests 5000 -days 30 -o samples.csv
+//
+// The warm-up requests are sent first and left out of the results. The
+// dates requested cycle through -days days, so that the server cache can be
+// defeated.
+func runBenchmark(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	lat, lon, tz := locationFlags(fs)
+	date := fs.String("date", time.Now().Format("2006-01-02"), "First date requested in YYYY-MM-DD format")
+	days := fs.Int("days", 1, "Number of consecutive dates requested in turn")
+	workers := fs.Int("workers", 8, "Number of concurrent workers")
+	requests := fs.Int("requests", 1000, "Number of requests to send, after the warm-up")
+	duration := fs.Duration("duration", 0, "Time to send requests for instead of -requests (0 to use -requests)")
+	warmup := fs.Int("warmup", 10, "Number of requests sent before measuring")
+	out := fs.String("o", "", "File to write the raw samples to, as CSV or JSON by its extension")
+	parseFlags(fs, args)
+
+	first, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		log.Fatalf("Error parsing date %s: %v", *date, err)
+	}
+	if *workers < 1 || *days < 1 {
+		log.Fatalf("-workers and -days must be positive")
+	}
+	format := strings.TrimPrefix(filepath.Ext(*out), ".")
+	if *out != "" && format != "csv" && format != "json" {
+		log.Fatalf("Unknown sample file format %q: expected a .csv or .json file", *out)
+	}
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+	request := benchmarkRequest(client, first, *days, *lat, *lon, *tz)
+	all, elapsed := benchmark(context.Background(), request, *workers, *requests, *warmup, *duration)
+	printBenchmark(os.Stdout, all, elapsed, *workers)
+
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Error creating %s: %v", *out, err)
+		}
+		defer f.Close()
+		if format == "csv" {
+			err = writeSamplesCSV(f, all)
+		} else {
+			enc := json.NewEncoder(f)
+			enc.SetIndent("", "  ")
+			err = enc.Encode(all)
+		}
+		if err != nil {
+			log.Fatalf("Error writing %s: %v", *out, err)
+		}
+	}
+}
+
+// benchmarkRequest returns the function sending the request i of a
+// benchmark: a Get of the date i modulo days after first.
+func benchmarkRequest(client ppb.PanchangamClient, first time.Time, days int, lat, lon float64, tz string) func(context.Context, int) error {
+	return func(ctx context.Context, i int) error {
+		_, err := client.Get(ctx, &ppb.GetPanchangamRequest{
+			Date:      first.AddDate(0, 0, i%days).Format("2006-01-02"),
+			Latitude:  lat,
+			Longitude: lon,
+			Timezone:  tz,
+		})
+		return err
+	}
+}
+
+// benchmark sends warmup requests, then requests requests, or as many as
+// it can for duration when it is positive, from workers concurrent
+// workers. It returns the samples of the requests after the warm-up in the
+// order they started, and the time they took.
+func benchmark(ctx context.Context, request func(context.Context, int) error, workers, requests, warmup int, duration time.Duration) ([]sample, time.Duration) {
+	for i := 0; i < warmup; i++ {
+		request(ctx, i)
+	}
+
+	// Workers take the index of the next request until they are all sent
+	// or the duration is over.
+	var next atomic.Int64
+	var deadline time.Time
+	if duration > 0 {
+		deadline = time.Now().Add(duration)
+	}
+	samples := make([][]sample, workers)
+	var wg sync.WaitGroup
+	start := time.Now()
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for {
+				i := int(next.Add(1) - 1)
+				if deadline.IsZero() && i >= requests || !deadline.IsZero() && !time.Now().Before(deadline) {
+					return
+				}
+				begin := time.Now()
+				err := request(ctx, i)
+				samples[w] = append(samples[w], sample{Start: begin, Latency: time.Since(begin), Code: status.Code(err).String(), Worker: w})
+			}
+		}(w)
+	}
+	wg.Wait()
+	elapsed := time.Since(start)
+
+	var all []sample
+	for _, s := range samples {
+		all = append(all, s...)
+	}
+	sort.Slice(all, func(i, j int) bool { return all[i].Start.Before(all[j].Start) })
+	return all, elapsed
+}
+
+// printBenchmark reports the samples of a benchmark. The latencies are
+// those of the successful requests.
+func printBenchmark(w io.Writer, samples []sample, elapsed time.Duration, workers int) {
+	var latencies []time.Duration
+	failures := map[string]int{}
+	for _, s := range samples {
+		if s.Code == "OK" {
+			latencies = append(latencies, s.Latency)
+		} else {
+			failures[s.Code]++
+		}
+	}
+	fmt.Fprintf(w, "Requests: %d in %s (%.1f req/s) with %d workers\n",
+		len(samples), elapsed.Round(time.Millisecond), float64(len(samples))/elapsed.Seconds(), workers)
+	if len(latencies) > 0 {
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		var total time.Duration
+		for _, l := range latencies {
+			total += l
+		}
+		fmt.Fprintf(w, "Latency: mean %s, p50 %s, p95 %s, p99 %s, max %s\n",
+			roundLatency(total/time.Duration(len(latencies))), roundLatency(percentile(latencies, 50)),
+			roundLatency(percentile(latencies, 95)), roundLatency(percentile(latencies, 99)), roundLatency(latencies[len(latencies)-1]))
+		printHistogram(w, latencies)
+	}
+	if len(failures) > 0 {
+		fmt.Fprintf(w, "Errors: %d\n", len(samples)-len(latencies))
+		codes := make([]string, 0, len(failures))
+		for code := range failures {
+			codes = append(codes, code)
+		}
+		sort.Slice(codes, func(i, j int) bool { return failures[codes[i]] > failures[codes[j]] })
+		for _, code := range codes {
+			fmt.Fprintf(w, "  %-20s %d\n", code, failures[code])
+		}
+	}
+}
+
+// percentile returns the p-th percentile of sorted latencies by the
+// nearest rank.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+func roundLatency(d time.Duration) time.Duration {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond)
+	}
+	return d.Round(10 * time.Microsecond)
+}
+
+// printHistogram prints the number of latencies in each bucket, from the
+// first bucket holding any to the last.
+func printHistogram(w io.Writer, latencies []time.Duration) {
+	counts := make([]int, len(histogramBounds)+1)
+	for _, l := range latencies {
+		counts[sort.Search(len(histogramBounds), func(i int) bool { return l < histogramBounds[i] })]++
+	}
+	lo, hi, most := len(counts), 0, 0
+	for i, n := range counts {
+		if n > 0 {
+			lo, hi, most = min(lo, i), i, max(most, n)
+		}
+	}
+	fmt.Fprintln(w, "Histogram:")
+	for i := lo; i <= hi; i++ {
+		label := "≥ " + histogramBounds[len(histogramBounds)-1].String()
+		if i < len(histogramBounds) {
+			label = "< " + histogramBounds[i].String()
+		}
+		fmt.Fprintf(w, "  %-8s %7d %s\n", label, counts[i], strings.Repeat("#", (counts[i]*histogramWidth+most-1)/most))
+	}
+}
+
+// writeSamplesCSV writes the samples with their latency in milliseconds.
+func writeSamplesCSV(w io.Writer, samples []sample) error {
+	cw := csv.NewWriter(w)
+	cw.Write([]string{"start", "latency_ms", "code", "worker"})
+	for _, s := range samples {
+		cw.Write([]string{
+			s.Start.Format(time.RFC3339Nano),
+			strconv.FormatFloat(float64(s.Latency)/float64(time.Millisecond), 'f', 3, 64),
+			s.Code,
+			strconv.Itoa(s.Worker),
+		})
+	}
+	cw.Flush()
+	return cw.Error()
+}
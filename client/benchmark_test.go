@@ -0,0 +1,125 @@
+package main
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestBenchmark(t *testing.T) {
+	b := &backend{get: func(req *ppb.GetPanchangamRequest, _ metadata.MD) (*ppb.GetPanchangamResponse, error) {
+		if req.Date == "2024-04-03" {
+			return nil, status.Error(codes.Unavailable, "overloaded")
+		}
+		return &ppb.GetPanchangamResponse{PanchangamData: &ppb.PanchangamData{Date: req.Date}}, nil
+	}}
+	first := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	request := benchmarkRequest(b, first, 3, 13.0827, 80.2707, "Asia/Kolkata")
+	samples, elapsed := benchmark(context.Background(), request, 4, 30, 2, 0)
+
+	// The warm-up requests are sent but left out of the samples.
+	calls := b.calls()
+	if len(calls) != 32 || len(samples) != 30 || elapsed <= 0 {
+		t.Fatalf("benchmark sent %d requests and sampled %d in %s, want 32 and 30", len(calls), len(samples), elapsed)
+	}
+	dates := map[string]int{}
+	for _, c := range calls {
+		req := c.(*ppb.GetPanchangamRequest)
+		dates[req.Date]++
+		if req.Latitude != 13.0827 || req.Longitude != 80.2707 || req.Timezone != "Asia/Kolkata" {
+			t.Errorf("request = %v, want the location of the benchmark", req)
+		}
+	}
+	if dates["2024-04-01"] != 11 || dates["2024-04-02"] != 11 || dates["2024-04-03"] != 10 {
+		t.Errorf("dates requested = %v, want the 3 days in turn", dates)
+	}
+	codes := map[string]int{}
+	for i, s := range samples {
+		codes[s.Code]++
+		if s.Worker < 0 || s.Worker >= 4 {
+			t.Errorf("sample %d of worker %d", i, s.Worker)
+		}
+		if i > 0 && s.Start.Before(samples[i-1].Start) {
+			t.Errorf("sample %d started before the one preceding it", i)
+		}
+	}
+	if codes["OK"] != 20 || codes["Unavailable"] != 10 {
+		t.Errorf("codes = %v, want 20 OK and 10 Unavailable", codes)
+	}
+}
+
+func TestBenchmarkDuration(t *testing.T) {
+	b := &backend{get: func(req *ppb.GetPanchangamRequest, _ metadata.MD) (*ppb.GetPanchangamResponse, error) {
+		time.Sleep(time.Millisecond)
+		return &ppb.GetPanchangamResponse{}, nil
+	}}
+	request := benchmarkRequest(b, time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), 1, 0, 0, "UTC")
+	// The number of requests is ignored for a duration.
+	samples, elapsed := benchmark(context.Background(), request, 2, 1, 0, 50*time.Millisecond)
+	if len(samples) < 2 || elapsed < 50*time.Millisecond {
+		t.Errorf("benchmark for 50ms sampled %d requests in %s", len(samples), elapsed)
+	}
+}
+
+func TestPrintBenchmark(t *testing.T) {
+	start := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	samples := []sample{
+		{Start: start, Latency: 1500 * time.Microsecond, Code: "OK"},
+		{Start: start, Latency: 3 * time.Millisecond, Code: "OK", Worker: 1},
+		{Start: start.Add(time.Second), Latency: 30 * time.Millisecond, Code: "OK"},
+		{Start: start.Add(time.Second), Latency: time.Second, Code: "Unavailable", Worker: 1},
+	}
+	var out strings.Builder
+	printBenchmark(&out, samples, 2*time.Second, 2)
+	want := `Requests: 4 in 2s (2.0 req/s) with 2 workers
+Latency: mean 11.5ms, p50 3ms, p95 30ms, p99 30ms, max 30ms
+Histogram:
+  < 2ms          1 ########################################
+  < 5ms          1 ########################################
+  < 10ms         0
+  < 20ms         0
+  < 50ms         1 ########################################
+Errors: 1
+  Unavailable          1
+`
+	// The lines of the empty buckets end with the space before their bar.
+	if trimLines(out.String()) != want {
+		t.Errorf("printBenchmark() =\n%s\nwant\n%s", out.String(), want)
+	}
+
+	var csv strings.Builder
+	if err := writeSamplesCSV(&csv, samples[:2]); err != nil {
+		t.Fatal(err)
+	}
+	want = `start,latency_ms,code,worker
+2024-04-01T00:00:00Z,1.500,OK,0
+2024-04-01T00:00:00Z,3.000,OK,1
+`
+	if csv.String() != want {
+		t.Errorf("writeSamplesCSV() =\n%s\nwant\n%s", csv.String(), want)
+	}
+}
+
+func TestPercentile(t *testing.T) {
+	var latencies []time.Duration
+	for i := 1; i <= 200; i++ {
+		latencies = append(latencies, time.Duration(i)*time.Millisecond)
+	}
+	for _, tt := range []struct {
+		p    int
+		want time.Duration
+	}{{0, time.Millisecond}, {50, 100 * time.Millisecond}, {95, 190 * time.Millisecond}, {99, 198 * time.Millisecond}, {100, 200 * time.Millisecond}} {
+		if got := percentile(latencies, tt.p); got != tt.want {
+			t.Errorf("percentile(%d) = %s, want %s", tt.p, got, tt.want)
+		}
+	}
+	if got := percentile(latencies[:1], 99); got != time.Millisecond {
+		t.Errorf("percentile of one latency = %s", got)
+	}
+}
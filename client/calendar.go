@@ -0,0 +1,220 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+// calendarCellWidth is the width of a day in the month grid.
+const calendarCellWidth = 13
+
+// calendarDay is a day of the month view.
+type calendarDay struct {
+	Date      string `json:"date"`
+	Weekday   string `json:"weekday"`
+	Tithi     string `json:"tithi"`
+	Paksha    string `json:"paksha"`
+	Nakshatra string `json:"nakshatra"`
+	// BikramSambat and Hijri are the parallel dates of the day, e.g.
+	// "17 Poush 2081" and "1 Rajab 1446".
+	BikramSambat string   `json:"bikram_sambat"`
+	Hijri        string   `json:"hijri"`
+	Events       []string `json:"events,omitempty"`
+	// local names the elements of the day in the locale of the markdown
+	// and html outputs.
+	local *ppb.LocalNames
+}
+
+// runCalendar prints a month as a grid with the tithi and nakshatra at
+// sunrise of each day, marking the days with festivals, vrats, sankrantis
+// or eclipses, which are listed below it. The months of the Nepali and
+// Hijri calendars the month runs over head the grid. The markdown and html
+// outputs are tables of the days in the locale, to publish as they are:
+//
+//	client calendar -month 2024-11 -l mumbai
+//	client calendar -month 2024-11 -l chennai -locale ta -output html
+func runCalendar(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	month := fs.String("month", time.Now().Format("2006-01"), "Month in YYYY-MM format")
+	region := fs.String("region", "", "Region, e.g. tamil_nadu (empty for all regions)")
+	locale := fs.String("locale", "en", "Locale of the event names, e.g. hi, ta or te")
+	output := fs.String("output", "text", "Output format: text, json, markdown or html")
+	hijriAdjustment := fs.Int("hijri-adjustment", 0, "Days by which the Hijri date follows the local sighting of the crescent, from -2 to 2")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+
+	first, err := time.Parse("2006-01", *month)
+	if err != nil {
+		log.Fatalf("Error parsing month %s: %v", *month, err)
+	}
+	last := first.AddDate(0, 1, -1)
+	if *output != "text" && *output != "json" && !rendered(*output) {
+		log.Fatalf("Unknown output format %q: expected text, json, markdown or html", *output)
+	}
+	// Only the rendered outputs name the elements in the locale.
+	elementLocale := ""
+	if rendered(*output) {
+		elementLocale = *locale
+	}
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+	ctx := context.Background()
+
+	// The events of the whole month come from a single range request.
+	events, err := client.GetEvents(ctx, &ppb.GetEventsRequest{
+		Date:      first.Format("2006-01-02"),
+		EndDate:   last.Format("2006-01-02"),
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+		Region:    *region,
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetEvents: %v", err)
+	}
+	byDate := map[string][]string{}
+	for _, f := range events.GetFestivals() {
+		byDate[f.GetDate()] = append(byDate[f.GetDate()], localizedName(f.GetNames(), *locale))
+	}
+
+	var days []calendarDay
+	var bikramSambat []*ppb.SolarDate
+	var hijri []*ppb.HijriDate
+	for d := first; !d.After(last); d = d.AddDate(0, 0, 1) {
+		date := d.Format("2006-01-02")
+		resp, err := client.Get(ctx, &ppb.GetPanchangamRequest{
+			Date:                date,
+			Latitude:            *lat,
+			Longitude:           *lon,
+			Timezone:            *tz,
+			Region:              *region,
+			HijriAdjustmentDays: int32(*hijriAdjustment),
+			Locale:              elementLocale,
+		})
+		if err != nil {
+			log.Fatalf("Error calling Get for %s: %v", date, err)
+		}
+		data := resp.GetPanchangamData()
+		bs, h := data.GetBikramSambat(), data.GetHijriDate()
+		bikramSambat, hijri = append(bikramSambat, bs), append(hijri, h)
+		days = append(days, calendarDay{
+			Date:         date,
+			Weekday:      d.Weekday().String(),
+			Tithi:        data.GetTithi(),
+			Paksha:       data.GetPaksha(),
+			Nakshatra:    data.GetNakshatra(),
+			BikramSambat: fmt.Sprintf("%d %s %d", bs.GetDay(), bs.GetMonthName(), bs.GetYear()),
+			Hijri:        fmt.Sprintf("%d %s %d", h.GetDay(), h.GetMonthName(), h.GetYear()),
+			Events:       byDate[date],
+			local:        data.GetLocalNames(),
+		})
+	}
+
+	if *output == "json" {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(days); err != nil {
+			log.Fatalf("Error encoding calendar: %v", err)
+		}
+		return
+	}
+	subtitle := fmt.Sprintf("%s BS · %s AH",
+		monthSpan(bikramSambat[0].GetMonthName(), bikramSambat[0].GetYear(), bikramSambat[len(days)-1].GetMonthName(), bikramSambat[len(days)-1].GetYear()),
+		monthSpan(hijri[0].GetMonthName(), hijri[0].GetYear(), hijri[len(days)-1].GetMonthName(), hijri[len(days)-1].GetYear()))
+	if rendered(*output) {
+		if err := renderMonth(os.Stdout, *output, *locale, first, subtitle, days); err != nil {
+			log.Fatalf("Error rendering calendar: %v", err)
+		}
+		return
+	}
+	printMonth(first, subtitle, days)
+}
+
+// monthSpan describes the months from the first to the last of a calendar,
+// e.g. "Poush - Magh 2081" or "Dhu al-Hijjah 1445 - Muharram 1446".
+func monthSpan(first string, firstYear int32, last string, lastYear int32) string {
+	switch {
+	case first == last && firstYear == lastYear:
+		return fmt.Sprintf("%s %d", first, firstYear)
+	case firstYear == lastYear:
+		return fmt.Sprintf("%s - %s %d", first, last, lastYear)
+	default:
+		return fmt.Sprintf("%s %d - %s %d", first, firstYear, last, lastYear)
+	}
+}
+
+// printMonth prints days, the days of the month starting on first, as a
+// grid of weeks starting on Sunday under the title and subtitle, followed
+// by the list of their events.
+func printMonth(first time.Time, subtitle string, days []calendarDay) {
+	title := first.Format("January 2006")
+	width := 7 * calendarCellWidth
+	fmt.Printf("%*s\n", (width+len(title))/2, title)
+	fmt.Println(strings.Repeat(" ", max(0, width-utf8.RuneCountInString(subtitle))/2) + subtitle)
+	var header strings.Builder
+	for wd := time.Sunday; wd <= time.Saturday; wd++ {
+		header.WriteString(cell(wd.String()[:3]))
+	}
+	fmt.Println(strings.TrimRight(header.String(), " "))
+
+	// Each week is printed as three lines: the day of the month, marked
+	// with * when it has events, the tithi and the nakshatra.
+	offset := int(first.Weekday())
+	for start := -offset; start < len(days); start += 7 {
+		var lines [3]strings.Builder
+		for i := start; i < start+7; i++ {
+			if i < 0 || i >= len(days) {
+				for l := range lines {
+					lines[l].WriteString(cell(""))
+				}
+				continue
+			}
+			d := days[i]
+			marker := ""
+			if len(d.Events) > 0 {
+				marker = " *"
+			}
+			lines[0].WriteString(cell(fmt.Sprintf("%2d%s", i+1, marker)))
+			lines[1].WriteString(cell(pakshaInitial(d.Paksha) + " " + d.Tithi))
+			lines[2].WriteString(cell(d.Nakshatra))
+		}
+		for _, l := range lines {
+			fmt.Println(strings.TrimRight(l.String(), " "))
+		}
+		fmt.Println()
+	}
+
+	for _, d := range days {
+		for _, e := range d.Events {
+			fmt.Printf("%s  %s\n", d.Date, e)
+		}
+	}
+}
+
+// cell pads or truncates s to the width of a day of the grid, leaving a
+// space between days.
+func cell(s string) string {
+	r := []rune(s)
+	if len(r) > calendarCellWidth-1 {
+		r = r[:calendarCellWidth-1]
+	}
+	return string(r) + strings.Repeat(" ", calendarCellWidth-len(r))
+}
+
+// pakshaInitial abbreviates a paksha to S for Shukla and K for Krishna.
+func pakshaInitial(paksha string) string {
+	if paksha == "" {
+		return ""
+	}
+	return strings.ToUpper(paksha[:1])
+}
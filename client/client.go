@@ -2,29 +2,174 @@ package main
 
 import (
 	"context"
+	"database/sql"
+	"flag"
 	"fmt"
 	"log"
+	"os"
+	"strings"
+	"time"
 
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/cache"
+	"github.com/naren-m/panchangam/calendar"
+	"github.com/naren-m/panchangam/config"
+	"github.com/naren-m/panchangam/ephemeris"
+	"github.com/naren-m/panchangam/observability/alerts"
 	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	sdk "github.com/naren-m/panchangam/sdk/client"
+	"github.com/naren-m/panchangam/tamil"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
+// commands maps a subcommand name to its implementation.
+var commands = map[string]func(fs *flag.FlagSet, args []string){
+	"get":        runGet,
+	"choghadiya": runChoghadiya,
+	"gowri":      runGowri,
+	"bundle":     runBundle,
+	"era":        runEra,
+	"ephemeris":  runEphemeris,
+	"events":     runEvents,
+	"vrats":      runVrats,
+	"muhurta":    runMuhurta,
+	"find":       runFind,
+	"profile":    runProfile,
+	"lagna":      runLagna,
+	"summary":    runSummary,
+	"watch":      runWatch,
+	"next":       runNext,
+	"health":     runHealth,
+	"benchmark":  runBenchmark,
+	"calendar":   runCalendar,
+	"keys":       runKeys,
+	"locations":  runLocations,
+	"geocode":    runGeocode,
+	"repl":       runREPL,
+	"admin":      runAdmin,
+	"version":    runVersion,
+	"festival":   runFestival,
+	"ekadashi":   runEkadashi,
+	"convert":    runConvert,
+	"diff":       runDiff,
+	"export":     runExport,
+	"webhooks":   runWebhooks,
+	"notify":     runNotify,
+	"digest":     runDigest,
+	"mqtt":       runMQTT,
+	"audit":      runAudit,
+}
+
+// Usage: client [get|choghadiya|gowri|bundle|era|ephemeris|events|vrats|muhurta|find|profile|lagna|summary|watch|next|health|benchmark|calendar|keys|locations|geocode|repl|admin|version|festival|ekadashi|convert|diff|export|webhooks|notify|digest|mqtt|audit] [flags]
 func main() {
-	// Set up a connection to the server
-	conn, err := grpc.NewClient("localhost:50051",
-		// Note the use of insecure transport here. TLS is recommended in production.
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
-	)
+	command := "get"
+	args := os.Args[1:]
+	if len(args) > 0 && args[0][0] != '-' {
+		command, args = args[0], args[1:]
+	}
+	run, ok := commands[command]
+	if !ok {
+		log.Fatalf("Unknown command %q", command)
+	}
+	run(flag.NewFlagSet(command, flag.ExitOnError), args)
+}
+
+// connect returns a client for the server at addr and a function closing
+// the connection.
+func connect(addr string) (*sdk.Client, func()) {
+	c, err := sdk.Dial(addr, sdk.WithTransportCredentials(transportCredentials()))
+	if err != nil {
+		log.Fatalf("Error connecting to %s: %v", addr, err)
+	}
+	return c, func() { c.Close() }
+}
+
+// dial returns a connection to the server at addr, for the commands calling
+// other services than Panchangam.
+func dial(addr string) *grpc.ClientConn {
+	c, _ := connect(addr)
+	return c.Conn()
+}
+
+// tlsOptions are the TLS flags registered by serverFlag.
+var tlsOptions struct {
+	enabled                       *bool
+	caCert, clientCert, clientKey *string
+}
+
+// transportCredentials returns the credentials of the connections to the
+// server: plaintext, unless -tls or a certificate flag is set.
+func transportCredentials() credentials.TransportCredentials {
+	o := tlsOptions
+	if o.enabled == nil || (!*o.enabled && *o.caCert == "" && *o.clientCert == "") {
+		return insecure.NewCredentials()
+	}
+	config, err := aaa.ClientTLS(*o.caCert, *o.clientCert, *o.clientKey)
+	if err != nil {
+		log.Fatalf("Error configuring TLS: %v", err)
+	}
+	return credentials.NewTLS(config)
+}
+
+// parseFlags parses the flags of a command, taking their defaults from the
+// configuration file and the environment as described in package config.
+func parseFlags(fs *flag.FlagSet, args []string) {
+	if err := config.Parse(fs, args, fs.Name()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// serverFlag registers the server address flag shared by commands, with
+// the flags connecting to a server over TLS:
+//
+//	client get -addr panchangam.example.com:50051 -tls
+//	client get -tls -ca-cert ca.pem -client-cert client.pem -client-key client-key.pem
+func serverFlag(fs *flag.FlagSet) *string {
+	tlsOptions.enabled = fs.Bool("tls", false, "Connect to the server over TLS")
+	tlsOptions.caCert = fs.String("ca-cert", "", "PEM CA certificates verifying the server, implying -tls (default the system CAs)")
+	tlsOptions.clientCert = fs.String("client-cert", "", "PEM client certificate for servers using mutual TLS, implying -tls")
+	tlsOptions.clientKey = fs.String("client-key", "", "PEM private key of -client-cert")
+	return fs.String("addr", "localhost:50051", "Panchangam server address")
+}
 
-	defer conn.Close()
+func getPanchangam(fs *flag.FlagSet, args []string) *ppb.PanchangamData {
+	addr := serverFlag(fs)
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date in YYYY-MM-DD format")
+	lat, lon, tz := locationFlags(fs)
+	region := fs.String("region", "", "Region selecting regional conventions, e.g. gujarat or tamil_nadu")
+	sun := fs.String("sun", "apparent", "Sunrise convention: apparent or mean")
+	elevation := fs.Float64("elevation", 0, "Elevation above sea level in metres, for the topocentric moon")
+	moon := fs.String("moon", "geocentric", "Moon the elements are computed from: geocentric or topocentric")
+	pressure := fs.Float64("pressure", 0, "Atmospheric pressure in hPa for refraction (0 for the standard atmosphere)")
+	temperature := fs.Float64("temperature", 10, "Air temperature in degrees Celsius, used with -pressure")
+	dip := fs.Bool("horizon-dip", false, "Take sunrise and sunset over the horizon lowered by the dip seen from -elevation")
+	locale := fs.String("locale", "", "Locale of the local names of the elements, e.g. hi, ta, te or sa")
+	hijriAdjustment := fs.Int("hijri-adjustment", 0, "Days by which the Hijri date follows the local sighting of the crescent, from -2 to 2")
+	parseFlags(fs, args)
 
-	// Create a client instance
-	client := ppb.NewPanchangamClient(conn)
+	client, closeConn := connect(*addr)
+	defer closeConn()
 
 	// Create a request
 	request := &ppb.GetPanchangamRequest{
-		Date: "2024-04-30", // Example date
+		Date:          *date,
+		Latitude:      *lat,
+		Longitude:     *lon,
+		Timezone:      *tz,
+		Region:        *region,
+		SunConvention: *sun,
+		Elevation:     *elevation,
+		MoonPosition:  *moon,
+		Pressure:      *pressure,
+		Temperature:   *temperature,
+		HorizonDip:    *dip,
+		Locale:        *locale,
+
+		HijriAdjustmentDays: int32(*hijriAdjustment),
 	}
 
 	// Call the RPC method
@@ -32,12 +177,766 @@ func main() {
 	if err != nil {
 		log.Fatalf("Error calling Get: %v", err)
 	}
+	return response.GetPanchangamData()
+}
 
+func runGet(fs *flag.FlagSet, args []string) {
+	asJSON := fs.Bool("json", false, "Print the response as JSON")
 	// Process the response
-	panchangamData := response.GetPanchangamData()
+	panchangamData := getPanchangam(fs, args)
+	if *asJSON {
+		body, err := protojson.MarshalOptions{Multiline: true}.Marshal(panchangamData)
+		if err != nil {
+			log.Fatalf("Error encoding response: %v", err)
+		}
+		fmt.Println(string(body))
+		return
+	}
 	fmt.Println("Panchangam Data:")
 	fmt.Printf("Date: %s\n", panchangamData.GetDate())
-	fmt.Printf("Date: %s\n", panchangamData.GetTithi())
-	fmt.Printf("Date: %s\n", panchangamData.GetYoga())
-	fmt.Printf("Date: %s\n", panchangamData.GetNakshatra())
+	fmt.Printf("Vikram Samvat %d, Shaka Samvat %d\n", panchangamData.GetVikramSamvat(), panchangamData.GetShakaSamvat())
+	bs, hijri := panchangamData.GetBikramSambat(), panchangamData.GetHijriDate()
+	fmt.Printf("Bikram Sambat %d %s %d, Hijri %d %s %d AH\n", bs.GetDay(), bs.GetMonthName(), bs.GetYear(),
+		hijri.GetDay(), hijri.GetMonthName(), hijri.GetYear())
+	if solar := panchangamData.GetSolarDate(); solar != nil {
+		fmt.Printf("%s calendar: %s\n", solar.GetCalendar(), solarDateString(solar))
+	}
+	ritu := panchangamData.GetRitu()
+	if local := panchangamData.GetLocalRitu(); local != ritu {
+		ritu = fmt.Sprintf("%s (locally %s, %s hemisphere)", ritu, local, panchangamData.GetHemisphere())
+	}
+	fmt.Printf("Samvatsara: %s, Ritu: %s, Ayana: %s\n",
+		panchangamData.GetSamvatsara(), ritu, panchangamData.GetAyana())
+	local := panchangamData.GetLocalNames()
+	fmt.Printf("Tithi: %s\n", withLocalName(panchangamData.GetTithi(), local.GetTithi()))
+	fmt.Printf("Yoga: %s\n", withLocalName(panchangamData.GetYoga(), local.GetYoga()))
+	fmt.Printf("Nakshatra: %s\n", withLocalName(panchangamData.GetNakshatra(), local.GetNakshatra()))
+	fmt.Printf("Karana: %s\n", withLocalName(panchangamData.GetKarana(), local.GetKarana()))
+	for _, r := range []struct {
+		body  string
+		rashi *ppb.Rashi
+	}{{"Moon", panchangamData.GetMoonRashi()}, {"Sun", panchangamData.GetSunRashi()}} {
+		fmt.Printf("%s rashi: %s\n", r.body, rashiString(r.rashi))
+	}
+	masa := panchangamData.GetMasa()
+	if panchangamData.GetAdhikaMasa() {
+		masa = "Adhika " + masa
+	}
+	fmt.Printf("Masa: %s (purnimanta %s), %s paksha\n", masa, panchangamData.GetPurnimantaMasa(), panchangamData.GetPaksha())
+	if condition := panchangamData.GetSunCondition(); condition != "" {
+		fmt.Printf("No sunrise or sunset (%s): periods are reckoned from 06:00 local mean time\n", strings.ReplaceAll(condition, "_", " "))
+	} else {
+		fmt.Printf("Sunrise %s (%+ds), sunset %s (%+ds), day %s by %ds, %s sun\n",
+			panchangamData.GetSunriseTime(), panchangamData.GetSunriseDriftSeconds(),
+			panchangamData.GetSunsetTime(), panchangamData.GetSunsetDriftSeconds(),
+			panchangamData.GetDayLengthTrend(), panchangamData.GetDayLengthChangeSeconds(), panchangamData.GetSunConvention())
+	}
+	fmt.Printf("Moonrise %s, moonset %s, %s (%.0f%% illuminated), %s moon\n",
+		orNone(panchangamData.GetMoonriseTime()), orNone(panchangamData.GetMoonsetTime()),
+		panchangamData.GetMoonPhase(), panchangamData.GetMoonIllumination()*100, panchangamData.GetMoonPosition())
+	for _, m := range []*ppb.Muhurta{panchangamData.GetBrahmaMuhurta(), panchangamData.GetAbhijitMuhurta()} {
+		fmt.Printf("%s Muhurta: %s - %s\n", m.GetName(), m.GetStartTime(), m.GetEndTime())
+	}
+	for _, m := range panchangamData.GetInauspiciousPeriods() {
+		fmt.Printf("Avoid %s: %s - %s\n", m.GetName(), m.GetStartTime(), m.GetEndTime())
+	}
+	for _, p := range panchangamData.GetNakshatraPadas() {
+		fmt.Printf("%s pada %d: %s %s - %s %s\n", p.GetNakshatra(), p.GetPada(),
+			p.GetStartDate(), p.GetStartTime(), p.GetEndDate(), p.GetEndTime())
+	}
+	for _, p := range panchangamData.GetNallaNeram() {
+		fmt.Printf("%s (%s): %s - %s\n", p.GetName(), p.GetTamilName(), p.GetStartTime(), p.GetEndTime())
+	}
+	for _, b := range panchangamData.GetNearBoundaries() {
+		fmt.Printf("Note: %s changes between %s and %s at %s (%+ds from sunrise)\n",
+			b.GetElement(), b.GetCurrentValue(), b.GetAdjacentValue(), b.GetBoundaryTime(), b.GetOffsetSeconds())
+	}
+}
+
+// orNone returns s, or "none" when it is empty.
+// withLocalName returns name followed by its local name, if that differs.
+func withLocalName(name, local string) string {
+	if local == "" || local == name {
+		return name
+	}
+	return fmt.Sprintf("%s (%s)", name, local)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+func runChoghadiya(fs *flag.FlagSet, args []string) {
+	panchangamData := getPanchangam(fs, args)
+	fmt.Printf("Choghadiya for %s (sunrise %s, sunset %s):\n",
+		panchangamData.GetDate(), panchangamData.GetSunriseTime(), panchangamData.GetSunsetTime())
+	for i, p := range panchangamData.GetChoghadiya() {
+		if i == 0 && p.GetIsDay() {
+			fmt.Println("Day:")
+		} else if !p.GetIsDay() && (i == 0 || panchangamData.GetChoghadiya()[i-1].GetIsDay()) {
+			fmt.Println("Night:")
+		}
+		fmt.Printf("  %-6s %s - %s  %s\n", p.GetName(), p.GetStartTime(), p.GetEndTime(), p.GetNature())
+	}
+}
+
+// runGowri prints the Gowri Panchangam and Nalla Neram of the Tamil Nadu
+// almanacs. The region defaults to tamil_nadu.
+func runGowri(fs *flag.FlagSet, args []string) {
+	panchangamData := getPanchangam(fs, append([]string{"-region=" + tamil.Region}, args...))
+	fmt.Printf("Gowri Panchangam for %s (sunrise %s, sunset %s):\n",
+		panchangamData.GetDate(), panchangamData.GetSunriseTime(), panchangamData.GetSunsetTime())
+	printTamilPeriods(panchangamData.GetGowriPanchangam())
+	fmt.Println("Nalla Neram (நல்ல நேரம்):")
+	printTamilPeriods(panchangamData.GetNallaNeram())
+}
+
+// printTamilPeriods prints periods under Day and Night headings.
+func printTamilPeriods(periods []*ppb.TamilPeriod) {
+	for i, p := range periods {
+		if i == 0 && p.GetIsDay() {
+			fmt.Println("Day:")
+		} else if !p.GetIsDay() && (i == 0 || periods[i-1].GetIsDay()) {
+			fmt.Println("Night:")
+		}
+		fmt.Printf("  %-11s %-10s %s - %s  %s\n", p.GetName(), p.GetTamilName(), p.GetStartTime(), p.GetEndTime(), p.GetNature())
+	}
+}
+
+// runBundle writes the festival bundle of a year as JSON, to a file or stdout.
+func runBundle(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	year := fs.Int("year", 2024, "Gregorian year")
+	region := fs.String("region", "", "Region, e.g. tamil_nadu (empty for all regions)")
+	out := fs.String("o", "", "Output file (defaults to stdout)")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	bundle, err := client.GetFestivalBundle(context.Background(), &ppb.GetFestivalBundleRequest{
+		Year:      int32(*year),
+		Region:    *region,
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetFestivalBundle: %v", err)
+	}
+	body, err := protojson.MarshalOptions{Multiline: true}.Marshal(bundle)
+	if err != nil {
+		log.Fatalf("Error encoding bundle: %v", err)
+	}
+	if *out == "" {
+		fmt.Println(string(body))
+		return
+	}
+	if err := os.WriteFile(*out, body, 0o644); err != nil {
+		log.Fatalf("Error writing %s: %v", *out, err)
+	}
+}
+
+// runEvents lists the festivals, vrats, sankrantis and visible eclipses from
+// a date.
+func runEvents(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	date := fs.String("date", time.Now().Format("2006-01-02"), "First date in YYYY-MM-DD format")
+	days := fs.Int("days", 1, "Number of days to list")
+	end := fs.String("end", "", "Last date to list in YYYY-MM-DD format, inclusive, instead of -days")
+	eventType := fs.String("type", "", "Kind (festival, vrat, eclipse or sankranti) or definition, e.g. ekadashi (empty for all)")
+	region := fs.String("region", "", "Region, e.g. tamil_nadu (empty for all regions)")
+	locale := fs.String("locale", "en", "Locale of the names, e.g. hi, ta or te")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	resp, err := client.GetEvents(context.Background(), &ppb.GetEventsRequest{
+		Date:      *date,
+		Days:      rangeDays(fs, *days, *end),
+		EndDate:   *end,
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+		Region:    *region,
+		Type:      *eventType,
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetEvents: %v", err)
+	}
+	if len(resp.GetFestivals()) == 0 && len(resp.GetSpans()) == 0 {
+		fmt.Println("No events")
+		return
+	}
+	for _, f := range resp.GetFestivals() {
+		fmt.Printf("%s  %-8s %s (%s)", f.GetDate(), f.GetKind(), localizedName(f.GetNames(), *locale), f.GetTithi())
+		if f.GetStartTime() != "" {
+			fmt.Printf(" at %s", f.GetStartTime())
+		}
+		if f.GetEndTime() != "" {
+			fmt.Printf(" - %s", f.GetEndTime())
+		}
+		if f.GetKala() != "" {
+			fmt.Printf(" %s kala %s - %s", f.GetKala(), clockTime(f.GetObservanceStart()), clockTime(f.GetObservanceEnd()))
+		}
+		fmt.Println(continuation(f.GetContinuesFromPreviousDay(), f.GetContinuesToNextDay()))
+	}
+	for _, span := range resp.GetSpans() {
+		name := localizedName(span.GetNames(), *locale)
+		fmt.Printf("\n%s: %s - %s\n", name, span.GetStartDate(), span.GetEndDate())
+		for _, d := range span.GetDays() {
+			fmt.Printf("%s  %s day %d - %s (%s)%s\n", d.GetDate(), name, d.GetDay(), localizedName(d.GetNames(), *locale), d.GetTithi(), tithiNote(d.GetKshaya(), d.GetVriddhi()))
+		}
+	}
+}
+
+// tithiNote describes a day of a festival span whose tithi is kshaya or
+// vriddhi.
+func tithiNote(kshaya, vriddhi bool) string {
+	switch {
+	case kshaya:
+		return " [kshaya]"
+	case vriddhi:
+		return " [vriddhi]"
+	}
+	return ""
+}
+
+// clockTime returns the time of day of an RFC 3339 time, or s itself if it
+// is not one.
+func clockTime(s string) string {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return s
+	}
+	return t.Format("15:04")
+}
+
+// runVrats prints the ekadashis, purnimas, amavasyas and sankrantis of a
+// year with the parana window of each ekadashi.
+func runVrats(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	year := fs.Int("year", time.Now().Year(), "Gregorian year")
+	region := fs.String("region", "", "Region, e.g. tamil_nadu (empty for all regions)")
+	locale := fs.String("locale", "en", "Locale of the names, e.g. hi, ta or te")
+	tradition := fs.String("tradition", "", "Tradition deciding the day of ekadashis: smarta or vaishnava")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	list, err := client.GetVratList(context.Background(), &ppb.GetVratListRequest{
+		Year:      int32(*year),
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+		Region:    *region,
+		Tradition: *tradition,
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetVratList: %v", err)
+	}
+	for _, d := range list.GetDates() {
+		fmt.Printf("%s %-9s %s", d.GetDate(), d.GetWeekday(), localizedName(d.GetNames(), *locale))
+		if d.GetTime() != "" {
+			fmt.Printf(" at %s", d.GetTime())
+		}
+		if d.GetParanaDate() != "" {
+			fmt.Printf(", parana %s %s - %s", d.GetParanaDate(), d.GetParanaStartTime(), d.GetParanaEndTime())
+		}
+		fmt.Println()
+	}
+}
+
+// rangeDays returns the number of days to request: that of the -days flag,
+// or none when -end sets the range and -days is left at its default.
+func rangeDays(fs *flag.FlagSet, days int, end string) int32 {
+	set := false
+	fs.Visit(func(f *flag.Flag) { set = set || f.Name == "days" })
+	if end != "" && !set {
+		return 0
+	}
+	return int32(days)
+}
+
+// runMuhurta lists the periods suitable for an activity from a date.
+func runMuhurta(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	date := fs.String("date", time.Now().Format("2006-01-02"), "First date in YYYY-MM-DD format")
+	days := fs.Int("days", 7, "Number of days to search")
+	end := fs.String("end", "", "Last date to search in YYYY-MM-DD format, inclusive, instead of -days")
+	activity := fs.String("activity", "marriage", "Activity, e.g. marriage, griha_pravesh or travel")
+	tradition := fs.String("tradition", "", "Rule pack of the tradition, e.g. smarta, vaishnava or tamil")
+	lat, lon, tz := locationFlags(fs)
+	profile := profileFlags(fs)
+	parseFlags(fs, args)
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	resp, err := client.GetMuhurta(context.Background(), &ppb.GetMuhurtaRequest{
+		Date:      *date,
+		Days:      rangeDays(fs, *days, *end),
+		EndDate:   *end,
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+		Activity:  *activity,
+		Tradition: *tradition,
+		Profile:   profile(),
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetMuhurta: %v", err)
+	}
+	fmt.Println("Daily muhurtas:")
+	for _, m := range resp.GetDailyMuhurtas() {
+		fmt.Printf("  %s %s - %s  %s\n", m.GetDate(), m.GetStartTime(), m.GetEndTime(), m.GetName())
+	}
+	fmt.Printf("Muhurtas for %s (%s):\n", resp.GetActivity(), resp.GetTradition())
+	if len(resp.GetWindows()) == 0 {
+		fmt.Println("  none")
+		return
+	}
+	for _, w := range resp.GetWindows() {
+		fmt.Printf("  %s %s - %s  %-6s %2d  %s%s\n", w.GetDate(), w.GetStartTime(), w.GetEndTime(),
+			w.GetChoghadiya(), w.GetScore(), strings.Join(w.GetReasons(), ", "),
+			continuation(w.GetContinuesFromPreviousDay(), w.GetContinuesToNextDay()))
+	}
+}
+
+// runLagna prints the lagnas, the signs rising on the eastern horizon, of a
+// date.
+func runLagna(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date in YYYY-MM-DD format")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	resp, err := client.GetLagnas(context.Background(), &ppb.GetLagnasRequest{
+		Date:      *date,
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetLagnas: %v", err)
+	}
+	fmt.Printf("Lagnas on %s (%s):\n", resp.GetDate(), resp.GetTimezone())
+	for _, l := range resp.GetLagnas() {
+		start, end := l.GetStartTime(), l.GetEndTime()
+		if l.GetStartDate() != resp.GetDate() {
+			start = l.GetStartDate() + " " + start
+		}
+		if l.GetEndDate() != resp.GetDate() {
+			end = l.GetEndDate() + " " + end
+		}
+		fmt.Printf("  %-10s %s - %s\n", l.GetRashi(), start, end)
+	}
+}
+
+// runSummary prints the panchangam of a date as it is read out, in a
+// locale, or as a panel in Markdown or HTML to publish as it is:
+//
+//	client summary -date 2024-04-09 -l chennai -locale ta -output html
+func runSummary(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date in YYYY-MM-DD format")
+	lat, lon, tz := locationFlags(fs)
+	locale := fs.String("locale", "en", "Locale of the summary, e.g. hi, ta, te or sa")
+	output := fs.String("output", "text", "Output format: text, markdown or html")
+	parseFlags(fs, args)
+	if *output != "text" && !rendered(*output) {
+		log.Fatalf("Unknown output format %q: expected text, markdown or html", *output)
+	}
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	resp, err := client.GetSummary(context.Background(), &ppb.GetSummaryRequest{
+		Date:      *date,
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+		Locale:    *locale,
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetSummary: %v", err)
+	}
+	if rendered(*output) {
+		if err := renderSummary(os.Stdout, *output, resp); err != nil {
+			log.Fatalf("Error rendering summary: %v", err)
+		}
+		return
+	}
+	fmt.Print(resp.GetText())
+}
+
+// runWatch shows the panchangam at a location live until interrupted,
+// redrawing the terminal every minute with the elements and hora in force,
+// the muhurtas and kalams of the day and a countdown to the next
+// transition, all computed locally. With -stream it prints instead the
+// transitions the server sends as they happen:
+//
+//	client watch -l mumbai
+//	client watch -l mumbai -stream -notice 10
+func runWatch(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	lat, lon, tz := locationFlags(fs)
+	locale := fs.String("locale", "", "Locale of the local names of the elements, e.g. hi, ta, te or sa")
+	stream := fs.Bool("stream", false, "Print the transitions streamed by the server instead of the live screen")
+	notice := fs.Int("notice", 5, "Minutes before Rahu Kalam, Yamagandam and Gulika Kalam start that they are announced with -stream, 0 for none")
+	parseFlags(fs, args)
+
+	if !*stream {
+		zone, err := loadTimezone(*tz, *lat, *lon)
+		if err != nil {
+			log.Fatalf("Error loading timezone: %v", err)
+		}
+		watchLive(astronomy.Location{Latitude: *lat, Longitude: *lon}, zone, *locale)
+		return
+	}
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	transitions := client.Transitions(context.Background(), sdk.Location{Latitude: *lat, Longitude: *lon, Timezone: *tz}, &ppb.WatchTransitionsRequest{
+		Locale:        *locale,
+		NoticeMinutes: int32(*notice),
+		NoNotice:      *notice == 0,
+	})
+	for transitions.Next() {
+		t := transitions.Transition()
+		fmt.Print(t.GetMessage())
+		if t.GetLocalName() != "" {
+			fmt.Printf(" (%s)", t.GetLocalName())
+		}
+		fmt.Println()
+	}
+	if err := transitions.Err(); err != nil {
+		log.Fatalf("Error receiving transitions: %v", err)
+	}
+}
+
+// runNext prints the next transitions of the panchangam at a location.
+func runNext(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	lat, lon, tz := locationFlags(fs)
+	from := fs.String("time", "", "Time to list the transitions from in RFC 3339 format (default now)")
+	count := fs.Int("n", 10, "Number of transitions to list")
+	kinds := fs.String("kinds", "", "Comma-separated kinds of transitions, e.g. tithi,sunset,rahu_kalam (default all)")
+	locale := fs.String("locale", "", "Locale of the local names of the elements, e.g. hi, ta, te or sa")
+	parseFlags(fs, args)
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	req := &ppb.GetNextTransitionsRequest{
+		Time:      *from,
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+		Locale:    *locale,
+		Count:     int32(*count),
+	}
+	if *kinds != "" {
+		req.Kinds = strings.Split(*kinds, ",")
+	}
+	resp, err := client.GetNextTransitions(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error calling GetNextTransitions: %v", err)
+	}
+	fmt.Printf("Next transitions (%s):\n", resp.GetTimezone())
+	for _, t := range resp.GetTransitions() {
+		at, _ := time.Parse(time.RFC3339, t.GetTime())
+		fmt.Printf("  %s  %-12s %-6s %s", at.Format("2006-01-02 15:04"), t.GetKind(), t.GetEvent(), t.GetName())
+		if t.GetLocalName() != "" {
+			fmt.Printf(" (%s)", t.GetLocalName())
+		}
+		fmt.Println()
+	}
+}
+
+// continuation describes how a period reported on one day extends to the
+// days around it.
+func continuation(fromPrevious, toNext bool) string {
+	switch {
+	case fromPrevious && toNext:
+		return " (continued, continues next day)"
+	case fromPrevious:
+		return " (continued from previous day)"
+	case toNext:
+		return " (continues next day)"
+	default:
+		return ""
+	}
+}
+
+// localizedName returns the name in locale, falling back to English.
+func localizedName(names []*ppb.LocalizedName, locale string) string {
+	var fallback string
+	for _, n := range names {
+		switch n.GetLocale() {
+		case locale:
+			return n.GetName()
+		case "en":
+			fallback = n.GetName()
+		}
+	}
+	return fallback
+}
+
+// rashiString describes the sign of a body during the day, e.g. "Vrishabha,
+// entering Mithuna on 2024-03-02 at 14:32:10".
+func rashiString(r *ppb.Rashi) string {
+	if r.GetNextName() == "" {
+		return r.GetName()
+	}
+	return fmt.Sprintf("%s, entering %s on %s at %s", r.GetName(), r.GetNextName(), r.GetIngressDate(), r.GetIngressTime())
+}
+
+// runEra prints the Vikram and Shaka Samvat years of a date, or converts an
+// era year to the Gregorian year in which it begins. It runs locally.
+func runEra(fs *flag.FlagSet, args []string) {
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date in YYYY-MM-DD format")
+	region := fs.String("region", "", "Region selecting the new-year rule, e.g. gujarat or tamil_nadu")
+	era := fs.String("era", "", "Convert -year of this era (vikram or shaka) to a Gregorian year")
+	year := fs.Int("year", 0, "Era year to convert with -era")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+
+	if *era != "" {
+		gregorian, err := calendar.GregorianYear(calendar.Era(*era), *year)
+		if err != nil {
+			log.Fatalf("Error converting year: %v", err)
+		}
+		fmt.Printf("%s %d begins in %d\n", *era, *year, gregorian)
+		return
+	}
+
+	location, err := loadTimezone(*tz, *lat, *lon)
+	if err != nil {
+		log.Fatalf("Error loading timezone: %v", err)
+	}
+	day, err := time.ParseInLocation("2006-01-02", *date, location)
+	if err != nil {
+		log.Fatalf("Error parsing date %s: %v", *date, err)
+	}
+	// Like the server, evaluate the date at sunrise.
+	sunTimes, err := astronomy.CalculateSunTimes(astronomy.Location{Latitude: *lat, Longitude: *lon}, day)
+	if err != nil {
+		log.Fatalf("Error calculating sunrise: %v", err)
+	}
+	fmt.Printf("%s (%s new year):\n", *date, calendar.RuleFor(*region))
+	for _, e := range []calendar.Era{calendar.VikramSamvat, calendar.ShakaSamvat} {
+		y, err := calendar.Year(e, sunTimes.Sunrise, *region)
+		if err != nil {
+			log.Fatalf("Error calculating %s year: %v", e, err)
+		}
+		fmt.Printf("  %-6s %d\n", e, y)
+	}
+}
+
+// runEphemeris prints the positions and speeds of the sun, moon and planets
+// at a time from an ephemeris provider, or with -retrograde the retrograde
+// periods of the planets in its year. It runs locally, but providers such as
+// horizons query remote services.
+func runEphemeris(fs *flag.FlagSet, args []string) {
+	at := fs.String("time", time.Now().UTC().Format(time.RFC3339), "Time in RFC 3339 format")
+	provider := fs.String("provider", "builtin", "Ephemeris provider: "+strings.Join(ephemeris.Names(), ", "))
+	bodies := fs.String("bodies", "sun,moon", "Comma separated bodies, e.g. sun,moon,mars")
+	retrograde := fs.Bool("retrograde", false, "List the retrograde periods of the bodies in the year of -time")
+	parseFlags(fs, args)
+
+	p, err := ephemeris.Lookup(*provider)
+	if err != nil {
+		log.Fatal(err)
+	}
+	t, err := time.Parse(time.RFC3339, *at)
+	if err != nil {
+		log.Fatalf("Error parsing time %s: %v", *at, err)
+	}
+	if *retrograde {
+		fmt.Printf("%s retrograde periods in %d (UTC):\n", p.Name(), t.Year())
+	} else {
+		fmt.Printf("%s positions at %s:\n", p.Name(), t.Format(time.RFC3339))
+	}
+	for _, name := range strings.Split(*bodies, ",") {
+		body, err := ephemeris.ParseBody(strings.TrimSpace(name))
+		if err != nil {
+			log.Fatal(err)
+		}
+		if *retrograde {
+			periods, err := ephemeris.GetRetrogradePeriods(context.Background(), p, body, t.Year())
+			if err != nil {
+				log.Fatalf("Error finding the retrograde periods of %s: %v", body, err)
+			}
+			for _, r := range periods {
+				fmt.Printf("  %-8s retrograde %s to %s\n", body, r.Start.Format("2006-01-02 15:04"), r.End.Format("2006-01-02 15:04"))
+			}
+			continue
+		}
+		pos, err := p.Position(context.Background(), body, t)
+		if err != nil {
+			log.Fatalf("Error calculating the position of the %s: %v", body, err)
+		}
+		motion := ""
+		if pos.Retrograde() {
+			motion = " R"
+		}
+		fmt.Printf("  %-8s longitude %10.5f°  latitude %9.5f°  distance %.8f AU  speed %+8.4f°/day%s\n",
+			body, pos.Longitude, pos.Latitude, pos.Distance, pos.Speed, motion)
+	}
+}
+
+// runKeys manages the API keys in a server's key file or database. It runs
+// locally:
+//
+//	client keys create -name partner -scopes read
+//	client keys list
+//	client keys revoke -id 0123456789abcdef
+//	client keys list -driver sqlite3 -db keys.db
+func runKeys(fs *flag.FlagSet, args []string) {
+	if len(args) == 0 {
+		log.Fatalf("Usage: client keys [create|list|revoke] [flags]")
+	}
+	action, args := args[0], args[1:]
+	file := fs.String("file", "keys.json", "API key file of the server (-api-keys)")
+	driver := fs.String("driver", "", "database/sql driver of -db, which must be built into the client")
+	dsn := fs.String("db", "", "Data source name of the API key database of the servers (-api-keys-db), instead of -file")
+	name := fs.String("name", "", "Name of the key to create, e.g. the client it is issued to")
+	scopes := fs.String("scopes", "read", "Comma separated scopes of the key to create: read, write or admin")
+	id := fs.String("id", "", "ID of the key to revoke")
+	parseFlags(fs, args)
+
+	var store aaa.KeyStore
+	if *dsn != "" {
+		db, err := sql.Open(*driver, *dsn)
+		if err != nil {
+			log.Fatalf("Error opening API key database: %v (drivers: %v)", err, sql.Drivers())
+		}
+		defer db.Close()
+		if store, err = aaa.NewSQLKeyStore(context.Background(), db); err != nil {
+			log.Fatalf("Error opening API key database: %v", err)
+		}
+	} else {
+		fileStore, err := aaa.OpenKeyStore(*file)
+		if err != nil {
+			log.Fatalf("Error opening %s: %v", *file, err)
+		}
+		store = fileStore
+	}
+	switch action {
+	case "create":
+		var keyScopes []aaa.Scope
+		for _, s := range strings.Split(*scopes, ",") {
+			scope, err := aaa.ParseScope(s)
+			if err != nil {
+				log.Fatalf("Error parsing scopes: %v", err)
+			}
+			keyScopes = append(keyScopes, scope)
+		}
+		secret, key, err := store.Create(*name, keyScopes...)
+		if err != nil {
+			log.Fatalf("Error creating key: %v", err)
+		}
+		fmt.Printf("Created key %s. Its secret is shown only once:\n%s\n", key.ID, secret)
+	case "list":
+		keys, err := store.List()
+		if err != nil {
+			log.Fatalf("Error listing keys: %v", err)
+		}
+		for _, k := range keys {
+			state := "active"
+			if k.Revoked != nil {
+				state = "revoked " + k.Revoked.Format(time.RFC3339)
+			}
+			fmt.Printf("%s  %-20s %-12v created %s, %s\n", k.ID, k.Name, k.Scopes, k.Created.Format(time.RFC3339), state)
+		}
+	case "revoke":
+		if err := store.Revoke(*id); err != nil {
+			log.Fatalf("Error revoking key: %v", err)
+		}
+		fmt.Printf("Revoked key %s\n", *id)
+	default:
+		log.Fatalf("Unknown keys command %q", action)
+	}
+}
+
+// runAdmin runs operator commands: those exporting alerts and purging the
+// cache do not need a server, while the others call its Admin service or
+// ask it for usage reports.
+func runAdmin(fs *flag.FlagSet, args []string) {
+	switch {
+	case len(args) >= 2 && args[0] == "alerts" && args[1] == "export":
+		runAlertsExport(fs, args[2:])
+	case len(args) >= 2 && args[0] == "cache" && args[1] == "purge":
+		runCachePurge(fs, args[2:])
+	case len(args) >= 2 && args[0] == "cache" && args[1] == "flush":
+		runAdminCacheFlush(fs, args[2:])
+	case len(args) >= 1 && args[0] == "caches":
+		runAdminCaches(fs, args[1:])
+	case len(args) >= 1 && args[0] == "providers":
+		runAdminProviders(fs, args[1:])
+	case len(args) >= 1 && args[0] == "log-level":
+		runAdminLogLevel(fs, args[1:])
+	case len(args) >= 1 && args[0] == "config":
+		runAdminConfig(fs, args[1:])
+	case len(args) >= 1 && args[0] == "usage":
+		runUsage(fs, args[1:])
+	default:
+		log.Fatalf("Usage: client admin [alerts export|cache purge|cache flush|caches|providers|log-level|config|usage] [flags]")
+	}
+}
+
+func runAlertsExport(fs *flag.FlagSet, args []string) {
+	th := alerts.DefaultThresholds
+	out := fs.String("o", "", "File to write the Prometheus rules to instead of stdout")
+	fs.Float64Var(&th.ErrorRate, "error-rate", th.ErrorRate, "Fraction of requests failing with a server error to alert on")
+	fs.DurationVar(&th.P99Latency, "p99-latency", th.P99Latency, "99th percentile latency of a method to alert on")
+	fs.Float64Var(&th.CacheHitRate, "cache-hit-rate", th.CacheHitRate, "Cache hit rate to alert below")
+	fs.Float64Var(&th.ShadowMismatchRate, "shadow-mismatch-rate", th.ShadowMismatchRate, "Fraction of canary responses differing to alert on")
+	fs.DurationVar(&th.For, "for", th.For, "How long a condition must hold before alerting")
+	parseFlags(fs, args)
+
+	w := os.Stdout
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Error creating %s: %v", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	if err := alerts.Export(w, th); err != nil {
+		log.Fatalf("Error exporting alerts: %v", err)
+	}
+}
+
+// runCachePurge drops the computed panchangams shared through Redis, e.g.
+// after a fix to their calculation. Servers keep serving the copies they
+// hold until these expire.
+func runCachePurge(fs *flag.FlagSet, args []string) {
+	addr := fs.String("redis-addr", "localhost:6379", "Address of the Redis server the servers share panchangams through")
+	db := fs.Int("redis-db", 0, "Redis database of the shared panchangams")
+	name := fs.String("name", "panchangam", "Name of the cache to purge")
+	parseFlags(fs, args)
+
+	backend := cache.NewRedisBackend(*addr, cache.WithRedisPassword(os.Getenv("PANCHANGAM_REDIS_PASSWORD")), cache.WithRedisDB(*db))
+	defer backend.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if err := cache.PurgeBackend(ctx, backend, *name); err != nil {
+		log.Fatalf("Error purging cache: %v", err)
+	}
+	fmt.Printf("Purged cache %s\n", *name)
 }
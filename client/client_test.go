@@ -0,0 +1,60 @@
+package main
+
+import (
+	"context"
+	"sync"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// backend is a PanchangamClient answering with its functions and
+// remembering the requests it received. Methods without a function fail
+// with Unimplemented. The functions may set the response header.
+type backend struct {
+	ppb.PanchangamClient
+
+	mu       sync.Mutex
+	requests []proto.Message
+
+	get       func(*ppb.GetPanchangamRequest, metadata.MD) (*ppb.GetPanchangamResponse, error)
+	getEvents func(*ppb.GetEventsRequest, metadata.MD) (*ppb.GetEventsResponse, error)
+}
+
+// answer records req and returns the response of f to it, passing it the
+// header of a grpc.Header option.
+func answer[Req proto.Message, Resp any](b *backend, req Req, opts []grpc.CallOption, f func(Req, metadata.MD) (Resp, error)) (Resp, error) {
+	b.mu.Lock()
+	b.requests = append(b.requests, req)
+	b.mu.Unlock()
+	if f == nil {
+		var zero Resp
+		return zero, status.Errorf(codes.Unimplemented, "%T is not implemented", req)
+	}
+	header := metadata.MD{}
+	for _, o := range opts {
+		if h, ok := o.(grpc.HeaderCallOption); ok {
+			*h.HeaderAddr = header
+		}
+	}
+	return f(req, header)
+}
+
+// calls returns the requests b received.
+func (b *backend) calls() []proto.Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return append([]proto.Message(nil), b.requests...)
+}
+
+func (b *backend) Get(ctx context.Context, in *ppb.GetPanchangamRequest, opts ...grpc.CallOption) (*ppb.GetPanchangamResponse, error) {
+	return answer(b, in, opts, b.get)
+}
+
+func (b *backend) GetEvents(ctx context.Context, in *ppb.GetEventsRequest, opts ...grpc.CallOption) (*ppb.GetEventsResponse, error) {
+	return answer(b, in, opts, b.getEvents)
+}
@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/calendar"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+// runConvert converts a Gregorian date to the regional solar calendars:
+//
+//	client convert -date 2024-08-17 -region kerala
+//
+// Without -calendar or -region it prints the date in every solar calendar.
+// The month a day belongs to depends on sunrise and sunset, so -l or -lat
+// and -lon should be in the region of the calendar, but for the Nepali
+// calendar, which is always reckoned in Nepal. It runs locally.
+func runConvert(fs *flag.FlagSet, args []string) {
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date in YYYY-MM-DD format")
+	region := fs.String("region", "", "Region selecting its solar calendar, e.g. kerala or bengal")
+	name := fs.String("calendar", "", "Solar calendar: tamil, malayalam, bengali, odia or nepali")
+	output := fs.String("output", "text", "Output format: text or json")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+	if *output != "text" && *output != "json" {
+		log.Fatalf("Unknown output format %q: expected text or json", *output)
+	}
+
+	calendars := calendar.SolarCalendars
+	switch {
+	case *name != "":
+		c, err := calendar.ParseSolarCalendar(*name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		calendars = []calendar.SolarCalendar{c}
+	case *region != "":
+		c, ok := calendar.SolarCalendarFor(*region)
+		if !ok {
+			log.Fatalf("Region %q follows no solar calendar", *region)
+		}
+		calendars = []calendar.SolarCalendar{c}
+	}
+
+	location, err := loadTimezone(*tz, *lat, *lon)
+	if err != nil {
+		log.Fatalf("Error loading timezone: %v", err)
+	}
+	day, err := time.ParseInLocation("2006-01-02", *date, location)
+	if err != nil {
+		log.Fatalf("Error parsing date %s: %v", *date, err)
+	}
+	loc := astronomy.Location{Latitude: *lat, Longitude: *lon}
+	var dates []*ppb.SolarDate
+	for _, c := range calendars {
+		d, err := calendar.SolarDateOf(c, day, loc)
+		if c == calendar.NepaliCalendar {
+			d, err = calendar.BikramSambat(day)
+		}
+		if err != nil {
+			log.Fatalf("Error converting to the %s calendar: %v", c, err)
+		}
+		dates = append(dates, &ppb.SolarDate{
+			Calendar:       string(d.Calendar),
+			Month:          int32(d.Month),
+			MonthName:      d.MonthName,
+			LocalMonthName: d.LocalMonthName,
+			Day:            int32(d.Day),
+			Era:            d.Era,
+			Year:           int32(d.Year),
+			Samvatsara:     d.Samvatsara,
+		})
+	}
+
+	if *output == "json" {
+		var messages []json.RawMessage
+		for _, d := range dates {
+			messages = append(messages, marshalJSON(d))
+		}
+		body, err := json.MarshalIndent(messages, "", "  ")
+		if err != nil {
+			log.Fatalf("Error marshaling dates: %v", err)
+		}
+		fmt.Println(string(body))
+		return
+	}
+	fmt.Printf("%s:\n", *date)
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, d := range dates {
+		fmt.Fprintf(tw, "  %s\t%s\t\n", d.GetCalendar(), solarDateString(d))
+	}
+	tw.Flush()
+}
+
+// solarDateString formats a solar date with its year, e.g. "1 Chingam
+// (ചിങ്ങം) Kollavarsham 1200" or "1 Chithirai (சித்திரை) Krodhi".
+func solarDateString(d *ppb.SolarDate) string {
+	s := fmt.Sprintf("%d %s (%s)", d.GetDay(), d.GetMonthName(), d.GetLocalMonthName())
+	switch {
+	case d.GetEra() != "":
+		s += fmt.Sprintf(" %s %d", d.GetEra(), d.GetYear())
+	case d.GetSamvatsara() != "":
+		s += " " + d.GetSamvatsara()
+	}
+	return s
+}
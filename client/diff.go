@@ -0,0 +1,265 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/ephemeris"
+)
+
+// diffConfig is one of the configurations the diff command compares.
+type diffConfig struct {
+	label      string
+	ayanamsa   astronomy.Ayanamsa
+	convention astronomy.SunConvention
+	moon       astronomy.MoonPosition
+	// provider computes the positions of the moon and sun in the rashis.
+	provider ephemeris.Provider
+}
+
+// diffValue is a quantity of the panchangam of a configuration: a name, such
+// as that of the tithi, or a time, such as sunrise or the end of the tithi.
+// A time is zero when it does not fall on the day, as the ingress of the
+// sun on most days.
+type diffValue struct {
+	name string
+	at   time.Time
+}
+
+// diffQuantities names the quantities of diffPanchangam in their order.
+var diffQuantities = []string{
+	"Sunrise", "Sunset",
+	"Tithi", "Tithi ends", "Nakshatra", "Nakshatra ends",
+	"Yoga", "Yoga ends", "Karana", "Karana ends",
+	"Rahu Kalam", "Abhijit",
+	"Moon rashi", "Moon ingress", "Sun rashi", "Sun ingress",
+}
+
+// runDiff computes the panchangam of a date under several configurations,
+// every combination of the listed ayanamsas, sunrise conventions, moon
+// positions and ephemeris providers, and prints side by side the values
+// that differ, with the times of the other configurations as deltas from
+// the first. It runs locally:
+//
+//	client diff -date 2024-06-21 -l mumbai -ayanamsa lahiri,raman -sun apparent,mean
+//
+// The providers only compute the rashis of the moon and sun, as the
+// elements come from the formulas of the astronomy package. There is no
+// method axis: every panchangam is drik, computed from the positions, as
+// the tables of a vakya panchangam are not implemented.
+func runDiff(fs *flag.FlagSet, args []string) {
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date in YYYY-MM-DD format")
+	lat, lon, tz := locationFlags(fs)
+	elevation := fs.Float64("elevation", 0, "Elevation above sea level in metres, for the topocentric moon")
+	ayanamsas := fs.String("ayanamsa", "lahiri", "Comma-separated ayanamsas: lahiri, raman or krishnamurti")
+	suns := fs.String("sun", "apparent", "Comma-separated sunrise conventions: apparent or mean")
+	moons := fs.String("moon", "geocentric", "Comma-separated moons the elements are computed from: geocentric or topocentric")
+	providers := fs.String("provider", "builtin", "Comma-separated ephemeris providers of the rashis: "+strings.Join(ephemeris.Names(), ", "))
+	all := fs.Bool("all", false, "Print every value, not only those that differ")
+	parseFlags(fs, args)
+
+	configs, err := diffConfigs(*ayanamsas, *suns, *moons, *providers)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if len(configs) < 2 {
+		log.Fatalf("Nothing to compare: list two or more values of -ayanamsa, -sun, -moon or -provider")
+	}
+	location, err := loadTimezone(*tz, *lat, *lon)
+	if err != nil {
+		log.Fatalf("Error loading timezone: %v", err)
+	}
+	day, err := time.ParseInLocation("2006-01-02", *date, location)
+	if err != nil {
+		log.Fatalf("Error parsing date %s: %v", *date, err)
+	}
+	loc := astronomy.Location{Latitude: *lat, Longitude: *lon, Elevation: *elevation}
+	if err := writeDiff(context.Background(), os.Stdout, loc, day, configs, *all); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// writeDiff writes to w the table of the quantities of day at loc under
+// configs, only those differing unless all is set.
+func writeDiff(ctx context.Context, w io.Writer, loc astronomy.Location, day time.Time, configs []diffConfig, all bool) error {
+	values := make([][]diffValue, len(configs))
+	for i, c := range configs {
+		var err error
+		if values[i], err = diffPanchangam(ctx, loc, day, c); err != nil {
+			return fmt.Errorf("calculating the panchangam with %s: %w", c.label, err)
+		}
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprint(tw, day.Format("2006-01-02"))
+	for _, c := range configs {
+		fmt.Fprintf(tw, "\t%s", c.label)
+	}
+	fmt.Fprintln(tw, "\t")
+	differing := 0
+	for q, quantity := range diffQuantities {
+		first := values[0][q]
+		same := true
+		for _, v := range values[1:] {
+			same = same && v[q].name == first.name && v[q].at.Sub(first.at).Abs() < time.Second
+		}
+		if same && !all {
+			continue
+		}
+		if !same {
+			differing++
+		}
+		fmt.Fprint(tw, quantity)
+		for i, v := range values {
+			fmt.Fprintf(tw, "\t%s", diffCell(v[q], first, day, i > 0))
+		}
+		fmt.Fprintln(tw, "\t")
+	}
+	if err := tw.Flush(); err != nil {
+		return err
+	}
+	if differing == 0 {
+		fmt.Fprintln(w, "No differences")
+	}
+	return nil
+}
+
+// diffConfigs returns every combination of the comma-separated ayanamsas,
+// sunrise conventions, moon positions and providers, labelled by the
+// values of those listing more than one.
+func diffConfigs(ayanamsas, suns, moons, providers string) ([]diffConfig, error) {
+	var as []astronomy.Ayanamsa
+	for _, s := range strings.Split(ayanamsas, ",") {
+		a, err := astronomy.ParseAyanamsa(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		as = append(as, a)
+	}
+	var cs []astronomy.SunConvention
+	for _, s := range strings.Split(suns, ",") {
+		c, err := astronomy.ParseSunConvention(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		cs = append(cs, c)
+	}
+	var ms []astronomy.MoonPosition
+	for _, s := range strings.Split(moons, ",") {
+		m, err := astronomy.ParseMoonPosition(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		ms = append(ms, m)
+	}
+	var ps []ephemeris.Provider
+	for _, s := range strings.Split(providers, ",") {
+		p, err := ephemeris.Lookup(strings.TrimSpace(s))
+		if err != nil {
+			return nil, err
+		}
+		ps = append(ps, p)
+	}
+
+	var configs []diffConfig
+	for _, a := range as {
+		for _, c := range cs {
+			for _, m := range ms {
+				for _, p := range ps {
+					var label []string
+					if len(as) > 1 {
+						label = append(label, string(a))
+					}
+					if len(cs) > 1 {
+						label = append(label, string(c))
+					}
+					if len(ms) > 1 {
+						label = append(label, string(m))
+					}
+					if len(ps) > 1 {
+						label = append(label, p.Name())
+					}
+					configs = append(configs, diffConfig{label: strings.Join(label, "/"), ayanamsa: a, convention: c, moon: m, provider: p})
+				}
+			}
+		}
+	}
+	return configs, nil
+}
+
+// diffPanchangam returns the quantities of diffQuantities for day at loc
+// under c. Like the server, it takes the elements and rashis prevailing at
+// sunrise, and the ingresses until the next sunrise.
+func diffPanchangam(ctx context.Context, loc astronomy.Location, day time.Time, c diffConfig) ([]diffValue, error) {
+	sunOpts := []astronomy.SunOption{astronomy.WithSunConvention(c.convention), astronomy.WithPolarFallback()}
+	sun, err := astronomy.CalculateSunTimes(loc, day, sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	next, err := astronomy.CalculateSunTimes(loc, day.AddDate(0, 0, 1), sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	elementOpts := []astronomy.ElementOption{astronomy.WithAyanamsa(c.ayanamsa)}
+	if c.moon == astronomy.TopocentricMoon {
+		elementOpts = append(elementOpts, astronomy.WithObserver(loc))
+	}
+	values := []diffValue{{at: sun.Sunrise}, {at: sun.Sunset}}
+	for _, kind := range []astronomy.ElementKind{astronomy.TithiElement, astronomy.NakshatraElement, astronomy.YogaElement, astronomy.KaranaElement} {
+		p := astronomy.CalculateElementPeriod(kind, sun.Sunrise, elementOpts...)
+		values = append(values, diffValue{name: p.Name}, diffValue{at: p.End})
+	}
+	values = append(values,
+		diffValue{at: astronomy.RahuKalam(sun.Sunrise, sun.Sunset).Start},
+		diffValue{at: astronomy.AbhijitMuhurta(sun.Sunrise, sun.Sunset).Start},
+	)
+	for _, body := range []ephemeris.Body{ephemeris.Moon, ephemeris.Sun} {
+		pos, err := c.provider.Position(ctx, body, sun.Sunrise)
+		if err != nil {
+			return nil, err
+		}
+		ingresses, err := ephemeris.RashiIngresses(ctx, c.provider, body, sun.Sunrise, next.Sunrise)
+		if err != nil {
+			return nil, err
+		}
+		ingress := diffValue{}
+		if len(ingresses) > 0 {
+			ingress.at = ingresses[0].Time
+		}
+		values = append(values, diffValue{name: astronomy.RashiName(ephemeris.Rashi(pos))}, ingress)
+	}
+	return values, nil
+}
+
+// diffCell formats v for the table: a name, or a time on day, marked with
+// its date when on another day, followed with delta by its difference from
+// first.
+func diffCell(v, first diffValue, day time.Time, delta bool) string {
+	if v.name != "" {
+		return v.name
+	}
+	if v.at.IsZero() {
+		return "-"
+	}
+	at := v.at.In(day.Location())
+	s := at.Format("15:04:05")
+	if at.Format("2006-01-02") != day.Format("2006-01-02") {
+		s = at.Format("Jan 2 15:04:05")
+	}
+	if d := v.at.Sub(first.at).Round(time.Second); delta && !first.at.IsZero() && d != 0 {
+		sign := "+"
+		if d < 0 {
+			sign = "-"
+		}
+		s += fmt.Sprintf(" (%s%s)", sign, d.Abs())
+	}
+	return s
+}
@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/ephemeris"
+	"github.com/naren-m/panchangam/ephemeris/ephemeristest"
+)
+
+var (
+	ist    = time.FixedZone("IST", 5*3600+1800)
+	mumbai = astronomy.Location{Latitude: 19.076, Longitude: 72.8777}
+)
+
+func TestDiffConfigs(t *testing.T) {
+	configs, err := diffConfigs("lahiri, raman", "apparent", "geocentric,topocentric", "builtin")
+	if err != nil {
+		t.Fatalf("diffConfigs() error = %v", err)
+	}
+	var labels []string
+	for _, c := range configs {
+		labels = append(labels, c.label)
+		if c.convention != astronomy.ApparentSun || c.provider.Name() != "builtin" {
+			t.Errorf("config %s = %+v", c.label, c)
+		}
+	}
+	if got := strings.Join(labels, " "); got != "lahiri/geocentric lahiri/topocentric raman/geocentric raman/topocentric" {
+		t.Errorf("labels = %s", got)
+	}
+
+	configs, err = diffConfigs("lahiri", "apparent,mean", "geocentric", "builtin,horizons")
+	if err != nil || len(configs) != 4 || configs[1].label != "apparent/horizons" || configs[1].provider.Name() != "horizons" {
+		t.Errorf("diffConfigs() of suns and providers = %+v, %v", configs, err)
+	}
+
+	for _, args := range [][4]string{
+		{"fagan", "apparent", "geocentric", "builtin"},
+		{"lahiri", "upper-limb", "geocentric", "builtin"},
+		{"lahiri", "apparent", "heliocentric", "builtin"},
+		{"lahiri", "apparent", "geocentric", "builtin,jpl"},
+	} {
+		if _, err := diffConfigs(args[0], args[1], args[2], args[3]); err == nil {
+			t.Errorf("diffConfigs(%q) succeeded", args)
+		}
+	}
+}
+
+func TestWriteDiff(t *testing.T) {
+	// The scripted provider holds the moon and sun in Mithuna, where the
+	// builtin one has the moon in Vrishchika until the evening.
+	scripted := ephemeristest.New("scripted")
+	scripted.SetPosition(ephemeris.Moon, ephemeris.Position{Longitude: 100})
+	scripted.SetPosition(ephemeris.Sun, ephemeris.Position{Longitude: 110})
+	configs := []diffConfig{
+		{label: "builtin", ayanamsa: astronomy.Lahiri, convention: astronomy.ApparentSun, moon: astronomy.GeocentricMoon, provider: ephemeris.BuiltinProvider{}},
+		{label: "scripted", ayanamsa: astronomy.Lahiri, convention: astronomy.ApparentSun, moon: astronomy.GeocentricMoon, provider: scripted},
+	}
+	day := time.Date(2024, 6, 21, 0, 0, 0, 0, ist)
+	var b strings.Builder
+	if err := writeDiff(context.Background(), &b, mumbai, day, configs, false); err != nil {
+		t.Fatalf("writeDiff() error = %v", err)
+	}
+	want := `2024-06-21    builtin     scripted
+Moon rashi    Vrishchika  Mithuna
+Moon ingress  18:18:16    -
+`
+	if got := trimLines(b.String()); got != want {
+		t.Errorf("writeDiff() =\n%s\nwant\n%s", got, want)
+	}
+
+	// The ayanamsa moves the ends of the nakshatra and yoga.
+	configs = []diffConfig{
+		{label: "lahiri", ayanamsa: astronomy.Lahiri, convention: astronomy.ApparentSun, moon: astronomy.GeocentricMoon, provider: ephemeris.BuiltinProvider{}},
+		{label: "raman", ayanamsa: astronomy.Raman, convention: astronomy.ApparentSun, moon: astronomy.GeocentricMoon, provider: ephemeris.BuiltinProvider{}},
+	}
+	b.Reset()
+	if err := writeDiff(context.Background(), &b, mumbai, day, configs, false); err != nil {
+		t.Fatalf("writeDiff() error = %v", err)
+	}
+	want = `2024-06-21      lahiri    raman
+Nakshatra ends  18:18:16  15:42:51 (-2h35m25s)
+Yoga ends       18:40:49  13:50:26 (-4h50m23s)
+`
+	if got := trimLines(b.String()); got != want {
+		t.Errorf("writeDiff() of ayanamsas =\n%s\nwant\n%s", got, want)
+	}
+
+	// Every value is printed with all.
+	configs[1] = configs[0]
+	b.Reset()
+	if err := writeDiff(context.Background(), &b, mumbai, day, configs, true); err != nil {
+		t.Fatalf("writeDiff() error = %v", err)
+	}
+	lines := strings.Split(strings.TrimSpace(b.String()), "\n")
+	if len(lines) != len(diffQuantities)+2 || lines[len(lines)-1] != "No differences" {
+		t.Errorf("writeDiff() with all of equal configurations =\n%s", b.String())
+	}
+
+	scripted.Fail(errors.New("down"))
+	configs[1].provider, configs[1].label = scripted, "scripted"
+	if err := writeDiff(context.Background(), &b, mumbai, day, configs, false); err == nil || !strings.Contains(err.Error(), "with scripted: down") {
+		t.Errorf("writeDiff() with a failing provider error = %v", err)
+	}
+}
+
+func TestDiffCell(t *testing.T) {
+	day := time.Date(2024, 6, 21, 0, 0, 0, 0, ist)
+	first := diffValue{at: time.Date(2024, 6, 21, 6, 1, 56, 0, ist)}
+	for _, tt := range []struct {
+		v     diffValue
+		delta bool
+		want  string
+	}{
+		{diffValue{name: "Jyeshtha"}, true, "Jyeshtha"},
+		{first, false, "06:01:56"},
+		{first, true, "06:01:56"},
+		{diffValue{at: first.at.Add(90 * time.Second)}, true, "06:03:26 (+1m30s)"},
+		{diffValue{at: first.at.Add(-time.Hour)}, true, "05:01:56 (-1h0m0s)"},
+		{diffValue{at: time.Date(2024, 6, 22, 1, 0, 0, 0, time.UTC)}, false, "Jun 22 06:30:00"},
+		{diffValue{}, true, "-"},
+	} {
+		if got := diffCell(tt.v, first, day, tt.delta); got != tt.want {
+			t.Errorf("diffCell(%v, %v) = %q, want %q", tt.v, tt.delta, got, tt.want)
+		}
+	}
+	// No delta is given from a time that is not on the day.
+	if got := diffCell(first, diffValue{}, day, true); got != "06:01:56" {
+		t.Errorf("diffCell() from no time = %q", got)
+	}
+}
+
+// trimLines removes the padding at the end of the lines of a table.
+func trimLines(s string) string {
+	lines := strings.Split(s, "\n")
+	for i, l := range lines {
+		lines[i] = strings.TrimRight(l, " ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,96 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+
+	"github.com/naren-m/panchangam/digest"
+	"github.com/naren-m/panchangam/festival"
+)
+
+// runDigest composes the weekly digest of a location, computed locally
+// without a server, and emails it over SMTP:
+//
+//	client digest -l chennai -locale ta -to me@example.com -from digest@example.com -smtp-host smtp.example.com -smtp-user me -smtp-password secret
+//	client digest -l chennai -dry-run -html digest.html
+//
+// With -dry-run it prints the subject and text of the digest instead of
+// sending it; -html writes its HTML part to a file to preview in a browser.
+func runDigest(fs *flag.FlagSet, args []string) {
+	lat, lon, tz := locationFlags(fs)
+	place := fs.String("name", "", "Name of the location in the digest, e.g. Chennai")
+	region := fs.String("region", "", "Region selecting the festivals, e.g. tamil_nadu")
+	locale := fs.String("locale", "en", "Locale of the digest, e.g. hi or ta")
+	start := fs.String("date", "", "First date of the digest in YYYY-MM-DD format (default today)")
+	days := fs.Int("days", digest.DefaultDays, "Number of days of the digest")
+	activities := fs.String("activities", strings.Join(digest.DefaultActivities, ","), "Comma-separated activities whose muhurtas are listed")
+	count := fs.Int("muhurtas", digest.DefaultMuhurtas, "Number of muhurtas listed per activity")
+	pack := fs.String("pack", "", "Muhurta rule pack, e.g. smarta, tamil or vaishnava (default smarta)")
+	tradition := fs.String("tradition", "", "Tradition the ekadashis follow: smarta or vaishnava (default smarta)")
+	festivalsDir := fs.String("festivals-dir", "", "Directory of custom festival definition files (*.json)")
+	to := fs.String("to", "", "Comma-separated recipient addresses")
+	from := fs.String("from", "", "Sender address, e.g. \"Panchangam <digest@example.com>\"")
+	smtpHost := fs.String("smtp-host", "", "SMTP server host")
+	smtpPort := fs.Int("smtp-port", digest.DefaultSMTPPort, "SMTP server port")
+	smtpUser := fs.String("smtp-user", "", "SMTP username (default no authentication)")
+	smtpPassword := fs.String("smtp-password", os.Getenv("PANCHANGAM_SMTP_PASSWORD"), "SMTP password (default $PANCHANGAM_SMTP_PASSWORD)")
+	dryRun := fs.Bool("dry-run", false, "Print the digest instead of sending it")
+	htmlFile := fs.String("html", "", "File to write the HTML part of the digest to")
+	parseFlags(fs, args)
+
+	ekadashiTradition, err := festival.ParseTradition(*tradition)
+	if err != nil {
+		log.Fatalf("Invalid -tradition: %v", err)
+	}
+	festivals := festival.NewCatalogs()
+	if *festivalsDir != "" {
+		definitions, err := festival.ReadDir(*festivalsDir)
+		if err != nil {
+			log.Fatalf("Error loading festival definitions: %v", err)
+		}
+		festivals = festival.NewCatalogs(festival.WithDefinitions(definitions...))
+	}
+	d, err := digest.Build(context.Background(), digest.Config{
+		Place:      *place,
+		Latitude:   *lat,
+		Longitude:  *lon,
+		Timezone:   *tz,
+		Region:     *region,
+		Locale:     *locale,
+		Start:      *start,
+		Days:       *days,
+		Activities: strings.Split(*activities, ","),
+		Pack:       *pack,
+		Tradition:  ekadashiTradition,
+		Muhurtas:   *count,
+	}, digest.WithFestivals(festivals))
+	if err != nil {
+		log.Fatalf("Error building digest: %v", err)
+	}
+	email, err := digest.Render(d)
+	if err != nil {
+		log.Fatalf("Error rendering digest: %v", err)
+	}
+	if *htmlFile != "" {
+		if err := os.WriteFile(*htmlFile, []byte(email.HTML), 0o644); err != nil {
+			log.Fatalf("Error writing HTML: %v", err)
+		}
+	}
+	if *dryRun {
+		fmt.Printf("Subject: %s\n\n%s", email.Subject, email.Text)
+		return
+	}
+
+	if *to == "" {
+		log.Fatalf("Usage: client digest -to ADDRESS -from ADDRESS -smtp-host HOST [flags], or -dry-run to preview")
+	}
+	smtp := digest.SMTPConfig{Host: *smtpHost, Port: *smtpPort, Username: *smtpUser, Password: *smtpPassword, From: *from}
+	if err := smtp.Send(strings.Split(*to, ","), email); err != nil {
+		log.Fatalf("Error sending digest: %v", err)
+	}
+	fmt.Printf("Sent %q to %s\n", email.Subject, *to)
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// runEkadashi lists the ekadashis of a year with their parana windows:
+//
+//	client ekadashi -year 2025 -tradition vaishnava
+//
+// Viddha ekadashis, touched by Dashami at arunodaya, are marked, as the
+// Smarta and the Vaishnava observe them on different days.
+func runEkadashi(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	year := fs.Int("year", time.Now().Year(), "Gregorian year")
+	tradition := fs.String("tradition", "smarta", "Tradition deciding the day of ekadashis: smarta or vaishnava")
+	locale := fs.String("locale", "en", "Locale of the names, e.g. hi, ta or te")
+	output := fs.String("output", "text", "Output format: text or json")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+	if *output != "text" && *output != "json" {
+		log.Fatalf("Unknown output format %q: expected text or json", *output)
+	}
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+	list, err := client.GetVratList(context.Background(), &ppb.GetVratListRequest{
+		Year:      int32(*year),
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+		Tradition: *tradition,
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetVratList: %v", err)
+	}
+	ekadashis := list.GetDates()[:0]
+	for _, d := range list.GetDates() {
+		if d.GetDefinition() == "ekadashi" {
+			ekadashis = append(ekadashis, d)
+		}
+	}
+	list.Dates = ekadashis
+
+	if *output == "json" {
+		body, err := protojson.MarshalOptions{Multiline: true}.Marshal(list)
+		if err != nil {
+			log.Fatalf("Error marshaling ekadashis: %v", err)
+		}
+		fmt.Println(string(body))
+		return
+	}
+	fmt.Printf("Ekadashis of %d (%s, %s):\n", list.GetYear(), list.GetTradition(), list.GetTimezone())
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  DATE\tWEEKDAY\tNAME\tPARANA\t")
+	for _, d := range ekadashis {
+		viddha := ""
+		if d.GetViddha() {
+			viddha = "viddha"
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\t%s %s - %s\t%s\n", d.GetDate(), d.GetWeekday(), localizedName(d.GetNames(), *locale),
+			d.GetParanaDate(), d.GetParanaStartTime(), d.GetParanaEndTime(), viddha)
+	}
+	tw.Flush()
+}
@@ -0,0 +1,410 @@
+package main
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/parallel"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// progressWidth is the width of the progress bar of the export command.
+const progressWidth = 40
+
+// runExport writes the almanac of a year at a location: the panchangam of
+// every day, with its elements and muhurtas, and the festivals, vrats,
+// sankrantis and eclipses of the year, as JSON, CSV or an iCalendar file:
+//
+//	client export -year 2025 -l chennai -format ics -o chennai-2025.ics
+//
+// The days of each month are requested in parallel and written as soon as
+// the month is complete, while a progress bar is drawn on stderr. Requests
+// that are rate limited are retried when the server allows.
+func runExport(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	year := fs.Int("year", time.Now().Year(), "Year to export")
+	lat, lon, tz := locationFlags(fs)
+	region := fs.String("region", "", "Region, e.g. tamil_nadu (empty for all regions)")
+	locale := fs.String("locale", "en", "Locale of the event names, e.g. hi, ta or te")
+	format := fs.String("format", "json", "Format of the almanac: json, csv or ics")
+	out := fs.String("o", "", "File to write the almanac to (default stdout)")
+	workers := fs.Int("workers", 8, "Number of days requested at once")
+	parseFlags(fs, args)
+
+	w := io.Writer(os.Stdout)
+	if *out != "" {
+		f, err := os.Create(*out)
+		if err != nil {
+			log.Fatalf("Error creating %s: %v", *out, err)
+		}
+		defer f.Close()
+		w = f
+	}
+	buffered := bufio.NewWriter(w)
+	var almanac almanacWriter
+	switch *format {
+	case "json":
+		almanac = &jsonAlmanac{w: buffered}
+	case "csv":
+		almanac = &csvAlmanac{w: csv.NewWriter(buffered), locale: *locale}
+	case "ics":
+		almanac = &icsAlmanac{w: buffered, locale: *locale, uid: fmt.Sprintf("%.4f,%.4f@panchangam", *lat, *lon)}
+	default:
+		log.Fatalf("Unknown format %q: expected json, csv or ics", *format)
+	}
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+	progress := &progressBar{w: os.Stderr, label: fmt.Sprint(*year)}
+	if err := exportAlmanac(context.Background(), client, almanac, progress, *year, *lat, *lon, *tz, *region, *workers); err != nil {
+		log.Fatalf("Error %v", err)
+	}
+	if err := buffered.Flush(); err != nil {
+		log.Fatalf("Error writing almanac: %v", err)
+	}
+}
+
+// exportAlmanac writes the almanac of year at a location, requesting the
+// days of each month with workers at once, and counting them on progress.
+func exportAlmanac(ctx context.Context, client ppb.PanchangamClient, almanac almanacWriter, progress *progressBar, year int, lat, lon float64, tz, region string, workers int) error {
+	first := time.Date(year, time.January, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(1, 0, -1)
+	// The events of the whole year come from a single range request.
+	events, err := client.GetEvents(ctx, &ppb.GetEventsRequest{
+		Date:      first.Format("2006-01-02"),
+		EndDate:   last.Format("2006-01-02"),
+		Latitude:  lat,
+		Longitude: lon,
+		Timezone:  tz,
+		Region:    region,
+	})
+	if err != nil {
+		return fmt.Errorf("calling GetEvents: %w", err)
+	}
+	byDate := map[string][]*ppb.Festival{}
+	for _, f := range events.GetFestivals() {
+		byDate[f.GetDate()] = append(byDate[f.GetDate()], f)
+	}
+
+	progress.total = last.YearDay()
+	if err := almanac.begin(); err != nil {
+		return fmt.Errorf("writing almanac: %w", err)
+	}
+	for month := first; month.Year() == year; month = month.AddDate(0, 1, 0) {
+		days := month.AddDate(0, 1, -1).Day()
+		data, err := parallel.Map(ctx, days, workers, func(ctx context.Context, i int) (*ppb.PanchangamData, error) {
+			date := month.AddDate(0, 0, i).Format("2006-01-02")
+			d, err := getRateLimited(ctx, client, &ppb.GetPanchangamRequest{
+				Date:      date,
+				Latitude:  lat,
+				Longitude: lon,
+				Timezone:  tz,
+				Region:    region,
+			})
+			if err != nil {
+				return nil, fmt.Errorf("calling Get for %s: %w", date, err)
+			}
+			progress.add()
+			return d, nil
+		})
+		if err != nil {
+			progress.abort()
+			return err
+		}
+		for _, d := range data {
+			if err := almanac.writeDay(d, byDate[d.GetDate()]); err != nil {
+				return fmt.Errorf("writing almanac: %w", err)
+			}
+		}
+	}
+	if err := almanac.end(); err != nil {
+		return fmt.Errorf("writing almanac: %w", err)
+	}
+	return nil
+}
+
+// getRateLimited returns the panchangam of req, waiting as long as the
+// server asks each time the export is rate limited, since a year takes
+// more requests than the burst of a client.
+func getRateLimited(ctx context.Context, client ppb.PanchangamClient, req *ppb.GetPanchangamRequest) (*ppb.PanchangamData, error) {
+	for {
+		var header metadata.MD
+		resp, err := client.Get(ctx, req, grpc.Header(&header))
+		retryAfter := header.Get(aaa.RetryAfterHeader)
+		if status.Code(err) != codes.ResourceExhausted || len(retryAfter) == 0 {
+			return resp.GetPanchangamData(), err
+		}
+		seconds, _ := strconv.Atoi(retryAfter[0])
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(time.Duration(max(seconds, 1)) * time.Second):
+		}
+	}
+}
+
+// progressBar draws on w how many of the days of an export are done.
+type progressBar struct {
+	w     io.Writer
+	label string
+	total int
+
+	mu   sync.Mutex
+	done int
+}
+
+// add counts a day done and redraws the bar.
+func (p *progressBar) add() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.done++
+	filled := progressWidth * p.done / p.total
+	fmt.Fprintf(p.w, "\r%s [%s%s] %d/%d", p.label, strings.Repeat("#", filled), strings.Repeat(".", progressWidth-filled), p.done, p.total)
+	if p.done == p.total {
+		fmt.Fprintln(p.w)
+	}
+}
+
+// abort ends the line of the bar, so that an error is printed below it.
+func (p *progressBar) abort() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	fmt.Fprintln(p.w)
+}
+
+// almanacWriter writes the days of an almanac in a format, in order.
+type almanacWriter interface {
+	begin() error
+	writeDay(d *ppb.PanchangamData, events []*ppb.Festival) error
+	end() error
+}
+
+// jsonAlmanac writes an almanac as a JSON array with an object for each
+// day, holding its panchangam and events as the server returns them.
+type jsonAlmanac struct {
+	w    io.Writer
+	days int
+}
+
+// almanacDay is a day of a JSON almanac.
+type almanacDay struct {
+	Date       string            `json:"date"`
+	Panchangam json.RawMessage   `json:"panchangam"`
+	Events     []json.RawMessage `json:"events"`
+}
+
+func (a *jsonAlmanac) begin() error {
+	_, err := io.WriteString(a.w, "[")
+	return err
+}
+
+func (a *jsonAlmanac) writeDay(d *ppb.PanchangamData, events []*ppb.Festival) error {
+	day := almanacDay{Date: d.GetDate(), Panchangam: marshalJSON(d), Events: []json.RawMessage{}}
+	for _, e := range events {
+		day.Events = append(day.Events, marshalJSON(e))
+	}
+	body, err := json.Marshal(day)
+	if err != nil {
+		return err
+	}
+	separator := "\n"
+	if a.days > 0 {
+		separator = ",\n"
+	}
+	a.days++
+	_, err = fmt.Fprintf(a.w, "%s%s", separator, body)
+	return err
+}
+
+func (a *jsonAlmanac) end() error {
+	_, err := io.WriteString(a.w, "\n]\n")
+	return err
+}
+
+// csvAlmanac writes an almanac as CSV with a row for each day, with its
+// periods as start-end times and its events as names separated by
+// semicolons.
+type csvAlmanac struct {
+	w      *csv.Writer
+	locale string
+}
+
+// csvPeriods are the periods of a day given a column each, in the order of
+// the columns.
+var csvPeriods = []string{astronomy.Rahu, astronomy.Yamaganda, astronomy.Gulika, astronomy.Durmuhurtam, astronomy.Varjyam}
+
+// dayPeriods returns the inauspicious periods of d, with its Rahu Kalam,
+// Yamagandam and Gulika Kalam, which the panchangam leaves out, computed
+// from its sunrise and sunset.
+func dayPeriods(d *ppb.PanchangamData) []*ppb.Muhurta {
+	periods := d.GetInauspiciousPeriods()
+	sunrise, err1 := time.Parse("2006-01-02 15:04:05", d.GetDate()+" "+d.GetSunriseTime())
+	sunset, err2 := time.Parse("2006-01-02 15:04:05", d.GetDate()+" "+d.GetSunsetTime())
+	// Days without sunrise or sunset have no kalams.
+	if err1 != nil || err2 != nil {
+		return periods
+	}
+	var kalams []*ppb.Muhurta
+	for _, p := range []astronomy.Period{
+		astronomy.RahuKalam(sunrise, sunset),
+		astronomy.Yamagandam(sunrise, sunset),
+		astronomy.GulikaKalam(sunrise, sunset),
+	} {
+		kalams = append(kalams, &ppb.Muhurta{Name: p.Name, Date: d.GetDate(), StartTime: p.Start.Format("15:04:05"), EndTime: p.End.Format("15:04:05")})
+	}
+	return append(kalams, periods...)
+}
+
+func (a *csvAlmanac) begin() error {
+	return a.w.Write([]string{
+		"date", "tithi", "paksha", "nakshatra", "yoga", "karana", "masa", "samvatsara",
+		"sunrise", "sunset", "moonrise", "moonset", "abhijit_muhurta", "brahma_muhurta",
+		"rahu_kalam", "yamagandam", "gulika_kalam", "durmuhurtam", "varjyam", "events",
+	})
+}
+
+func (a *csvAlmanac) writeDay(d *ppb.PanchangamData, events []*ppb.Festival) error {
+	row := []string{
+		d.GetDate(), d.GetTithi(), d.GetPaksha(), d.GetNakshatra(), d.GetYoga(), d.GetKarana(), d.GetMasa(), d.GetSamvatsara(),
+		d.GetSunriseTime(), d.GetSunsetTime(), d.GetMoonriseTime(), d.GetMoonsetTime(),
+		periodSpan(d.GetAbhijitMuhurta()), periodSpan(d.GetBrahmaMuhurta()),
+	}
+	for _, name := range csvPeriods {
+		var spans []string
+		for _, p := range dayPeriods(d) {
+			if p.GetName() == name {
+				spans = append(spans, periodSpan(p))
+			}
+		}
+		row = append(row, strings.Join(spans, " "))
+	}
+	var names []string
+	for _, e := range events {
+		names = append(names, localizedName(e.GetNames(), a.locale))
+	}
+	if err := a.w.Write(append(row, strings.Join(names, "; "))); err != nil {
+		return err
+	}
+	return a.w.Error()
+}
+
+func (a *csvAlmanac) end() error {
+	a.w.Flush()
+	return a.w.Error()
+}
+
+// periodSpan formats the times of p, e.g. "10:30:00-12:00:00".
+func periodSpan(p *ppb.Muhurta) string {
+	if p == nil {
+		return ""
+	}
+	return p.GetStartTime() + "-" + p.GetEndTime()
+}
+
+// icsAlmanac writes an almanac as an iCalendar file, with an all-day event
+// for the panchangam of each day and one for each festival, vrat,
+// sankranti and eclipse, which calendar applications can subscribe to.
+type icsAlmanac struct {
+	w      io.Writer
+	locale string
+	// uid identifies the location in the UIDs of the events, so that the
+	// almanacs of several locations can be imported side by side.
+	uid   string
+	stamp string
+}
+
+func (a *icsAlmanac) begin() error {
+	a.stamp = time.Now().UTC().Format("20060102T150405Z")
+	return a.lines("BEGIN:VCALENDAR", "VERSION:2.0", "PRODID:-//panchangam//almanac//EN", "CALSCALE:GREGORIAN")
+}
+
+func (a *icsAlmanac) writeDay(d *ppb.PanchangamData, events []*ppb.Festival) error {
+	description := []string{
+		"Sunrise " + d.GetSunriseTime(), "Sunset " + d.GetSunsetTime(),
+		"Yoga " + d.GetYoga(), "Karana " + d.GetKarana(),
+		"Abhijit " + periodSpan(d.GetAbhijitMuhurta()),
+	}
+	for _, p := range dayPeriods(d) {
+		description = append(description, p.GetName()+" "+periodSpan(p))
+	}
+	if err := a.event(d.GetDate(), d.GetDate(), d.GetTithi()+", "+d.GetNakshatra(), strings.Join(description, "\n")); err != nil {
+		return err
+	}
+	for _, e := range events {
+		var description string
+		if e.GetStartTime() != "" {
+			description = e.GetStartTime() + "-" + e.GetEndTime()
+		}
+		if err := a.event(e.GetId(), d.GetDate(), localizedName(e.GetNames(), a.locale), description); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// event writes an all-day event on date.
+func (a *icsAlmanac) event(id, date, summary, description string) error {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return err
+	}
+	lines := []string{
+		"BEGIN:VEVENT",
+		"UID:" + id + "-" + a.uid,
+		"DTSTAMP:" + a.stamp,
+		"DTSTART;VALUE=DATE:" + day.Format("20060102"),
+		"DTEND;VALUE=DATE:" + day.AddDate(0, 0, 1).Format("20060102"),
+		"SUMMARY:" + icsText(summary),
+		"TRANSP:TRANSPARENT",
+	}
+	if description != "" {
+		lines = append(lines, "DESCRIPTION:"+icsText(description))
+	}
+	return a.lines(append(lines, "END:VEVENT")...)
+}
+
+func (a *icsAlmanac) end() error {
+	return a.lines("END:VCALENDAR")
+}
+
+// lines writes content lines folded to 75 octets, as RFC 5545 requires,
+// without splitting a character.
+func (a *icsAlmanac) lines(lines ...string) error {
+	var b strings.Builder
+	for _, line := range lines {
+		width := 0
+		for _, r := range line {
+			if n := len(string(r)); width+n > 75 {
+				b.WriteString("\r\n ")
+				width = 1
+			}
+			b.WriteRune(r)
+			width += len(string(r))
+		}
+		b.WriteString("\r\n")
+	}
+	_, err := io.WriteString(a.w, b.String())
+	return err
+}
+
+// icsText escapes s as an iCalendar text value.
+func icsText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`).Replace(s)
+}
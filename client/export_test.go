@@ -0,0 +1,275 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+var ugadi = &ppb.Festival{
+	Id: "ugadi", Kind: "festival", Date: "2024-04-09",
+	Names: []*ppb.LocalizedName{{Locale: "en", Name: "Ugadi"}, {Locale: "te", Name: "ఉగాది"}},
+}
+
+// exportDay is the panchangam the backend of the export tests returns for
+// a date.
+func exportDay(date string) *ppb.PanchangamData {
+	return &ppb.PanchangamData{
+		Date: date, Tithi: "Pratipada", Paksha: "shukla", Nakshatra: "Revati", Yoga: "Vaidhriti", Karana: "Kimstughna",
+		SunriseTime: "06:00:00", SunsetTime: "18:00:00",
+		AbhijitMuhurta: &ppb.Muhurta{Name: "Abhijit", StartTime: "11:36:00", EndTime: "12:24:00"},
+	}
+}
+
+func exportBackend() *backend {
+	return &backend{
+		get: func(req *ppb.GetPanchangamRequest, _ metadata.MD) (*ppb.GetPanchangamResponse, error) {
+			return &ppb.GetPanchangamResponse{PanchangamData: exportDay(req.Date)}, nil
+		},
+		getEvents: func(req *ppb.GetEventsRequest, _ metadata.MD) (*ppb.GetEventsResponse, error) {
+			return &ppb.GetEventsResponse{Festivals: []*ppb.Festival{ugadi}}, nil
+		},
+	}
+}
+
+func TestExportAlmanac(t *testing.T) {
+	b := exportBackend()
+	var out, bar bytes.Buffer
+	progress := &progressBar{w: &bar, label: "2024"}
+	err := exportAlmanac(context.Background(), b, &jsonAlmanac{w: &out}, progress, 2024, 13.0827, 80.2707, "Asia/Kolkata", "andhra_pradesh", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The events of the year are requested at once, then every day.
+	calls := b.calls()
+	if len(calls) != 1+366 {
+		t.Fatalf("export of 2024 sent %d requests, want 367", len(calls))
+	}
+	events := calls[0].(*ppb.GetEventsRequest)
+	if events.Date != "2024-01-01" || events.EndDate != "2024-12-31" || events.Region != "andhra_pradesh" || events.Timezone != "Asia/Kolkata" {
+		t.Errorf("GetEvents() request = %v", events)
+	}
+	for _, c := range calls[1:] {
+		if req := c.(*ppb.GetPanchangamRequest); req.Latitude != 13.0827 || req.Region != "andhra_pradesh" {
+			t.Errorf("Get() request = %v", req)
+		}
+	}
+
+	var days []struct {
+		Date       string
+		Panchangam struct{ Date, Tithi string }
+		Events     []struct{ Id string }
+	}
+	if err := json.Unmarshal(out.Bytes(), &days); err != nil {
+		t.Fatalf("almanac does not parse: %v\n%s", err, out.String())
+	}
+	if len(days) != 366 {
+		t.Fatalf("almanac has %d days, want 366", len(days))
+	}
+	for i, d := range days {
+		want := time.Date(2024, 1, 1+i, 0, 0, 0, 0, time.UTC).Format("2006-01-02")
+		if d.Date != want || d.Panchangam.Date != want || d.Panchangam.Tithi != "Pratipada" {
+			t.Fatalf("day %d = %+v, want %s", i, d, want)
+		}
+		// The events are attached to their own day.
+		if (len(d.Events) == 1 && d.Events[0].Id == "ugadi") != (want == "2024-04-09") || len(d.Events) > 1 {
+			t.Errorf("events of %s = %+v", d.Date, d.Events)
+		}
+	}
+	if !strings.HasSuffix(bar.String(), "\r2024 ["+strings.Repeat("#", progressWidth)+"] 366/366\n") {
+		t.Errorf("progress bar ends with %q", bar.String()[max(bar.Len()-80, 0):])
+	}
+}
+
+func TestExportAlmanacError(t *testing.T) {
+	b := exportBackend()
+	get := b.get
+	b.get = func(req *ppb.GetPanchangamRequest, md metadata.MD) (*ppb.GetPanchangamResponse, error) {
+		if req.Date == "2024-03-10" {
+			return nil, status.Error(codes.Internal, "no sunrise")
+		}
+		return get(req, md)
+	}
+	var out, bar bytes.Buffer
+	err := exportAlmanac(context.Background(), b, &jsonAlmanac{w: &out}, &progressBar{w: &bar}, 2024, 0, 0, "UTC", "", 4)
+	if err == nil || !strings.Contains(err.Error(), "calling Get for 2024-03-10") || status.Code(err) != codes.Internal {
+		t.Errorf("exportAlmanac() error = %v, want the failure of 2024-03-10", err)
+	}
+	// Only whole months are written, and the bar ends its line for the error.
+	if n := strings.Count(out.String(), `"panchangam":`); n != 31+29 {
+		t.Errorf("almanac holds %d days before the error, want 60", n)
+	}
+	if !strings.HasSuffix(bar.String(), "\n") {
+		t.Errorf("progress bar not ended: %q", bar.String())
+	}
+
+	b = exportBackend()
+	b.getEvents = nil
+	err = exportAlmanac(context.Background(), b, &jsonAlmanac{w: &out}, &progressBar{w: &bar}, 2024, 0, 0, "UTC", "", 4)
+	if status.Code(err) != codes.Unimplemented || !strings.HasPrefix(err.Error(), "calling GetEvents: ") || len(b.calls()) != 1 {
+		t.Errorf("exportAlmanac() without events = %v after %d requests", err, len(b.calls()))
+	}
+}
+
+func TestCSVAlmanac(t *testing.T) {
+	var out bytes.Buffer
+	a := &csvAlmanac{w: csv.NewWriter(&out), locale: "te"}
+	day := exportDay("2024-04-09")
+	day.InauspiciousPeriods = []*ppb.Muhurta{{Name: "Varjyam", StartTime: "20:00:00", EndTime: "21:30:00"}}
+	if err := a.begin(); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.writeDay(day, []*ppb.Festival{ugadi, {Id: "vrat", Names: []*ppb.LocalizedName{{Locale: "en", Name: "Vrat, fast"}}}}); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.end(); err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(&out).ReadAll()
+	if err != nil || len(rows) != 2 {
+		t.Fatalf("almanac = %q, %v", rows, err)
+	}
+	got := map[string]string{}
+	for i, column := range rows[0] {
+		got[column] = rows[1][i]
+	}
+	// 2024-04-09 is a Tuesday.
+	for column, want := range map[string]string{
+		"date":            "2024-04-09",
+		"tithi":           "Pratipada",
+		"sunrise":         "06:00:00",
+		"abhijit_muhurta": "11:36:00-12:24:00",
+		"brahma_muhurta":  "",
+		"rahu_kalam":      "15:00:00-16:30:00",
+		"yamagandam":      "09:00:00-10:30:00",
+		"gulika_kalam":    "12:00:00-13:30:00",
+		"varjyam":         "20:00:00-21:30:00",
+		"events":          "ఉగాది; Vrat, fast",
+	} {
+		if got[column] != want {
+			t.Errorf("%s = %q, want %q", column, got[column], want)
+		}
+	}
+}
+
+func TestICSAlmanac(t *testing.T) {
+	var out bytes.Buffer
+	a := &icsAlmanac{w: &out, locale: "te", uid: "13.0827,80.2707@panchangam"}
+	if err := a.begin(); err != nil {
+		t.Fatal(err)
+	}
+	festival := &ppb.Festival{Id: "ugadi", Names: ugadi.Names, StartTime: "06:00:00", EndTime: "08:00:00"}
+	if err := a.writeDay(exportDay("2024-04-09"), []*ppb.Festival{festival}); err != nil {
+		t.Fatal(err)
+	}
+	if err := a.end(); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+	if !strings.HasSuffix(got, "\r\n") || strings.Contains(strings.ReplaceAll(got, "\r\n", ""), "\n") {
+		t.Errorf("lines do not end with CRLF: %q", got)
+	}
+	for _, line := range strings.Split(strings.TrimSuffix(got, "\r\n"), "\r\n") {
+		if len(line) > 75 {
+			t.Errorf("line of %d octets: %q", len(line), line)
+		}
+	}
+	// Unfolded, the day and its festival are events on the date.
+	unfolded := strings.ReplaceAll(got, "\r\n ", "")
+	for _, want := range []string{
+		"BEGIN:VCALENDAR\r\nVERSION:2.0\r\nPRODID:-//panchangam//almanac//EN\r\nCALSCALE:GREGORIAN\r\n",
+		"UID:2024-04-09-13.0827,80.2707@panchangam\r\n",
+		"DTSTART;VALUE=DATE:20240409\r\nDTEND;VALUE=DATE:20240410\r\nSUMMARY:Pratipada\\, Revati\r\n",
+		"DESCRIPTION:Sunrise 06:00:00\\nSunset 18:00:00\\nYoga Vaidhriti\\nKarana Kimstughna\\nAbhijit 11:36:00-12:24:00\\nRahu Kalam 15:00:00-16:30:00\\n",
+		"UID:ugadi-13.0827,80.2707@panchangam\r\n",
+		"SUMMARY:ఉగాది\r\nTRANSP:TRANSPARENT\r\nDESCRIPTION:06:00:00-08:00:00\r\nEND:VEVENT\r\nEND:VCALENDAR\r\n",
+	} {
+		if !strings.Contains(unfolded, want) {
+			t.Errorf("calendar lacks %q:\n%s", want, unfolded)
+		}
+	}
+	if stamp := "DTSTAMP:" + a.stamp + "\r\n"; strings.Count(got, stamp) != 2 || len(a.stamp) != len("20060102T150405Z") {
+		t.Errorf("events are not stamped %q:\n%s", stamp, got)
+	}
+}
+
+func TestICSLines(t *testing.T) {
+	var out bytes.Buffer
+	a := &icsAlmanac{w: &out}
+	// A line of Telugu folds between characters, three octets each.
+	telugu := strings.Repeat("ఉ", 30)
+	if err := a.lines("SUMMARY:"+strings.Repeat("x", 67), "SUMMARY:"+strings.Repeat("x", 68), "SUMMARY:"+telugu); err != nil {
+		t.Fatal(err)
+	}
+	want := "SUMMARY:" + strings.Repeat("x", 67) + "\r\n" +
+		"SUMMARY:" + strings.Repeat("x", 67) + "\r\n x\r\n" +
+		"SUMMARY:" + strings.Repeat("ఉ", 22) + "\r\n " + strings.Repeat("ఉ", 8) + "\r\n"
+	if out.String() != want {
+		t.Errorf("lines() =\n%q\nwant\n%q", out.String(), want)
+	}
+}
+
+func TestICSText(t *testing.T) {
+	for in, want := range map[string]string{
+		"Ugadi":              "Ugadi",
+		"Tithi, Nakshatra":   `Tithi\, Nakshatra`,
+		"a;b":                `a\;b`,
+		`C:\almanac`:         `C:\\almanac`,
+		"Sunrise\nSunset":    `Sunrise\nSunset`,
+		"Rahu; Gulika,\n\\.": `Rahu\; Gulika\,\n\\.`,
+	} {
+		if got := icsText(in); got != want {
+			t.Errorf("icsText(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestGetRateLimited(t *testing.T) {
+	b := &backend{}
+	b.get = func(req *ppb.GetPanchangamRequest, header metadata.MD) (*ppb.GetPanchangamResponse, error) {
+		// The first request is rate limited, the second answered.
+		if len(b.calls()) == 1 {
+			header.Set(aaa.RetryAfterHeader, "1")
+			return nil, status.Error(codes.ResourceExhausted, "rate limited")
+		}
+		return &ppb.GetPanchangamResponse{PanchangamData: exportDay(req.Date)}, nil
+	}
+	start := time.Now()
+	d, err := getRateLimited(context.Background(), b, &ppb.GetPanchangamRequest{Date: "2024-04-09"})
+	if err != nil || d.GetDate() != "2024-04-09" || len(b.calls()) != 2 {
+		t.Fatalf("getRateLimited() = %v, %v after %d requests", d, err, len(b.calls()))
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("retried after %s, want the second of retry-after", elapsed)
+	}
+
+	// Without retry-after, and for other errors, the error is returned.
+	b = &backend{get: func(*ppb.GetPanchangamRequest, metadata.MD) (*ppb.GetPanchangamResponse, error) {
+		return nil, status.Error(codes.ResourceExhausted, "quota exceeded")
+	}}
+	if _, err := getRateLimited(context.Background(), b, &ppb.GetPanchangamRequest{}); status.Code(err) != codes.ResourceExhausted || len(b.calls()) != 1 {
+		t.Errorf("getRateLimited() without retry-after = %v after %d requests", err, len(b.calls()))
+	}
+
+	// The wait ends with the context.
+	b = &backend{get: func(_ *ppb.GetPanchangamRequest, header metadata.MD) (*ppb.GetPanchangamResponse, error) {
+		header.Set(aaa.RetryAfterHeader, "3600")
+		return nil, status.Error(codes.ResourceExhausted, "rate limited")
+	}}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := getRateLimited(ctx, b, &ppb.GetPanchangamRequest{}); err != context.DeadlineExceeded {
+		t.Errorf("getRateLimited() past its deadline = %v", err)
+	}
+}
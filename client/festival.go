@@ -0,0 +1,92 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+func runFestival(fs *flag.FlagSet, args []string) {
+	switch {
+	case len(args) >= 1 && args[0] == "when":
+		runFestivalWhen(fs, args[1:])
+	default:
+		log.Fatalf("Usage: client festival when <name> [flags]")
+	}
+}
+
+// runFestivalWhen prints the dates of a festival in a range of years:
+//
+//	client festival when diwali -year 2026 -end-year 2030
+//
+// The festival is named by its identifier or its name in any locale, before
+// or after the flags.
+func runFestivalWhen(fs *flag.FlagSet, args []string) {
+	var name string
+	if len(args) > 0 && !strings.HasPrefix(args[0], "-") {
+		name, args = args[0], args[1:]
+	}
+	addr := serverFlag(fs)
+	lat, lon, tz := locationFlags(fs)
+	year := fs.Int("year", time.Now().Year(), "First Gregorian year searched")
+	endYear := fs.Int("end-year", 0, "Last Gregorian year searched, inclusive (defaults to -year)")
+	region := fs.String("region", "", "Region whose festivals are searched, e.g. tamil_nadu")
+	output := fs.String("output", "text", "Output format: text or json")
+	parseFlags(fs, args)
+	if name == "" {
+		name = strings.Join(fs.Args(), " ")
+	}
+	if name == "" {
+		log.Fatalf("Usage: client festival when <name> [flags]")
+	}
+	if *output != "text" && *output != "json" {
+		log.Fatalf("Unknown output format %q: expected text or json", *output)
+	}
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+	resp, err := client.FindFestivalDates(context.Background(), &ppb.FindFestivalDatesRequest{
+		Name:      name,
+		Year:      int32(*year),
+		EndYear:   int32(*endYear),
+		Latitude:  *lat,
+		Longitude: *lon,
+		Timezone:  *tz,
+		Region:    *region,
+	})
+	if err != nil {
+		log.Fatalf("Error calling FindFestivalDates: %v", err)
+	}
+	if *output == "json" {
+		body, err := protojson.MarshalOptions{Multiline: true}.Marshal(resp)
+		if err != nil {
+			log.Fatalf("Error marshaling festival dates: %v", err)
+		}
+		fmt.Println(string(body))
+		return
+	}
+
+	title := localizedName(resp.GetNames(), "en")
+	if len(resp.GetFestivals()) == 0 {
+		fmt.Printf("%s is not observed in %s\n", title, orUnknown(*region))
+		return
+	}
+	fmt.Printf("%s (%s):\n", title, resp.GetTimezone())
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, f := range resp.GetFestivals() {
+		weekday := ""
+		if day, err := time.Parse("2006-01-02", f.GetDate()); err == nil {
+			weekday = day.Weekday().String()
+		}
+		fmt.Fprintf(tw, "  %s\t%s\t%s\n", f.GetDate(), weekday, f.GetTithi())
+	}
+	tw.Flush()
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+// runFind prints the next periods suitable for an activity from a date that
+// satisfy constraints, ranked by score with the reasons for each:
+//
+//	client find -activity marriage -n 5 -nakshatras rohini,hasta -weekdays friday,sunday -exclude-bhadra
+func runFind(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date to search from in YYYY-MM-DD format")
+	activity := fs.String("activity", "marriage", "Activity, e.g. marriage, griha_pravesh or travel")
+	tradition := fs.String("tradition", "", "Rule pack of the tradition, e.g. smarta, vaishnava or tamil")
+	count := fs.Int("n", 5, "Number of periods to find, 1 to 50")
+	nakshatras := fs.String("nakshatras", "", "Comma-separated nakshatras one of which must prevail throughout a period, e.g. rohini,hasta")
+	weekdays := fs.String("weekdays", "", "Comma-separated weekdays of the days to search, e.g. friday,sunday")
+	excludeBhadra := fs.Bool("exclude-bhadra", false, "Rule out periods touching Bhadra, the Vishti karana")
+	days := fs.Int("days", 366, "Number of days to search, 1 to 366")
+	lat, lon, tz := locationFlags(fs)
+	profile := profileFlags(fs)
+	parseFlags(fs, args)
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	req := &ppb.FindMuhurtaRequest{
+		Date:          *date,
+		Latitude:      *lat,
+		Longitude:     *lon,
+		Timezone:      *tz,
+		Activity:      *activity,
+		Tradition:     *tradition,
+		Count:         int32(*count),
+		ExcludeBhadra: *excludeBhadra,
+		Days:          int32(*days),
+		Profile:       profile(),
+	}
+	if *nakshatras != "" {
+		req.Nakshatras = strings.Split(*nakshatras, ",")
+	}
+	if *weekdays != "" {
+		req.Weekdays = strings.Split(*weekdays, ",")
+	}
+	resp, err := client.FindMuhurta(context.Background(), req)
+	if err != nil {
+		log.Fatalf("Error calling FindMuhurta: %v", err)
+	}
+	fmt.Printf("Muhurtas for %s (%s) from %s:\n", resp.GetActivity(), resp.GetTradition(), *date)
+	if len(resp.GetCandidates()) == 0 {
+		fmt.Printf("  none up to %s\n", resp.GetSearchedUntil())
+		return
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	for _, c := range resp.GetCandidates() {
+		fmt.Fprintf(tw, "  %d.\t%s\t%s\t%2d\t%s\n", c.GetRank(), candidateSpan(c.GetStart(), c.GetEnd()),
+			c.GetChoghadiya(), c.GetScore(), strings.Join(c.GetReasons(), ", "))
+	}
+	tw.Flush()
+	if len(resp.GetCandidates()) < *count {
+		fmt.Printf("Only %d found up to %s\n", len(resp.GetCandidates()), resp.GetSearchedUntil())
+	}
+}
+
+// candidateSpan formats the RFC 3339 start and end of a period as its date,
+// weekday and times, e.g. "Fri 2024-11-08 06:37 - 08:00".
+func candidateSpan(start, end string) string {
+	s, err := time.Parse(time.RFC3339, start)
+	if err != nil {
+		return start + " - " + end
+	}
+	e, err := time.Parse(time.RFC3339, end)
+	if err != nil {
+		return start + " - " + end
+	}
+	span := s.Format("Mon 2006-01-02 15:04") + " - " + e.Format("15:04")
+	if e.Format("2006-01-02") != s.Format("2006-01-02") {
+		span += " (+1)"
+	}
+	return span
+}
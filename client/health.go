@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// healthReport is the JSON output of the health command. The system
+// information is left out when the server refuses it, e.g. to an API key
+// without the admin scope, and its error is reported instead.
+type healthReport struct {
+	Serving     string          `json:"serving"`
+	Health      json.RawMessage `json:"health,omitempty"`
+	System      json.RawMessage `json:"system,omitempty"`
+	SystemError string          `json:"system_error,omitempty"`
+}
+
+// runHealth checks the server with the standard gRPC health service, then
+// reports the precomputation of popular locations, the build of the server,
+// the health of its ephemeris providers and its cache statistics:
+//
+//	client health -api-key $KEY -output json
+//
+// It exits with status 1 when the server is not serving.
+func runHealth(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	apiKey := fs.String("api-key", "", "API key with the admin scope, for the system information")
+	timeout := fs.Duration("timeout", 10*time.Second, "Time to wait for the server")
+	output := fs.String("output", "text", "Output format: text or json")
+	parseFlags(fs, args)
+	if *output != "text" && *output != "json" {
+		log.Fatalf("Unknown output format %q: expected text or json", *output)
+	}
+
+	conn := dial(*addr)
+	defer conn.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	if *apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, aaa.APIKeyHeader, *apiKey)
+	}
+
+	check, err := healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{Service: ppb.Panchangam_ServiceDesc.ServiceName})
+	if err != nil {
+		log.Fatalf("Error checking health: %v", err)
+	}
+	serving := check.GetStatus() == healthpb.HealthCheckResponse_SERVING
+	client := ppb.NewPanchangamClient(conn)
+	health, err := client.GetHealth(ctx, &ppb.GetHealthRequest{})
+	if err != nil {
+		log.Fatalf("Error calling GetHealth: %v", err)
+	}
+	system, systemErr := client.GetSystemInfo(ctx, &ppb.GetSystemInfoRequest{})
+
+	if *output == "json" {
+		report := healthReport{Serving: check.GetStatus().String()}
+		report.Health = marshalJSON(health)
+		if systemErr != nil {
+			report.SystemError = systemErr.Error()
+		} else {
+			report.System = marshalJSON(system)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatalf("Error writing health: %v", err)
+		}
+	} else {
+		fmt.Printf("Serving: %s\n", check.GetStatus())
+		printHealth(os.Stdout, health)
+		if systemErr != nil {
+			fmt.Printf("System information: %v\n", systemErr)
+		} else {
+			printSystemInfo(os.Stdout, system)
+		}
+	}
+	if !serving {
+		os.Exit(1)
+	}
+}
+
+// marshalJSON returns the JSON of m with the field names of the proto.
+func marshalJSON(m proto.Message) json.RawMessage {
+	body, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(m)
+	if err != nil {
+		log.Fatalf("Error marshaling %s: %v", m.ProtoReflect().Descriptor().Name(), err)
+	}
+	return body
+}
+
+func printHealth(w io.Writer, health *ppb.Health) {
+	fmt.Fprintf(w, "Status: %s\n", health.GetStatus())
+	if p := health.GetPrecompute(); p != nil {
+		fmt.Fprintf(w, "Precompute: %d locations, %d days each\n", p.GetLocations(), p.GetDays())
+		if p.GetPasses() > 0 {
+			fmt.Fprintf(w, "  Passes: %d, the latest ended %s after %dms\n", p.GetPasses(), p.GetLastPassTime(), p.GetLastPassDurationMs())
+			fmt.Fprintf(w, "  Warm: %d, failed: %d\n", p.GetWarm(), p.GetFailed())
+		}
+		if p.GetLastError() != "" {
+			fmt.Fprintf(w, "  Last error: %s\n", p.GetLastError())
+		}
+	}
+}
+
+// printSystemInfo prints the build of the server, then its ephemeris
+// providers and caches as tables.
+func printSystemInfo(w io.Writer, info *ppb.SystemInfo) {
+	fmt.Fprintf(w, "Version: %s (commit %s, %s)\n", info.GetVersion(), orUnknown(info.GetCommit()), info.GetGoVersion())
+	fmt.Fprintf(w, "Uptime: %s since %s\n", time.Duration(info.GetUptimeSeconds())*time.Second, info.GetStartTime())
+
+	fmt.Fprintln(w, "\nEphemeris providers:")
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  NAME\tHEALTHY\tLATENCY\tCHECKED\tERROR")
+	for _, p := range info.GetEphemerisProviders() {
+		fmt.Fprintf(tw, "  %s\t%t\t%dms\t%s\t%s\n", p.GetName(), p.GetHealthy(), p.GetLatencyMs(), p.GetCheckedTime(), p.GetError())
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w, "\nCaches:")
+	tw = tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "  NAME\tENTRIES\tHITS\tMISSES\tHIT RATE\tLOADS\tREFRESHES\tSHARED HITS")
+	for _, c := range info.GetCaches() {
+		rate := "-"
+		if lookups := c.GetHits() + c.GetMisses(); lookups > 0 {
+			rate = fmt.Sprintf("%.1f%%", 100*float64(c.GetHits())/float64(lookups))
+		}
+		fmt.Fprintf(tw, "  %s\t%d\t%d\t%d\t%s\t%d\t%d\t%d\n", c.GetName(), c.GetEntries(), c.GetHits(), c.GetMisses(), rate, c.GetLoads(), c.GetRefreshes(), c.GetSharedHits())
+	}
+	tw.Flush()
+}
@@ -0,0 +1,316 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/location"
+	"gopkg.in/yaml.v3"
+)
+
+// locationsEnv names the environment variable holding the path of the
+// user's locations file. The file cannot be a flag, since -l is resolved
+// while the flags are parsed.
+const locationsEnv = "PANCHANGAM_LOCATIONS"
+
+// geocoderEnv names the environment variable selecting the geocoder of
+// -place: offline for the built-in gazetteer alone, nominatim for the
+// OpenStreetMap Nominatim service alone, or by default the gazetteer and
+// then Nominatim.
+const geocoderEnv = "PANCHANGAM_GEOCODER"
+
+// Location is a named observer location.
+type Location struct {
+	Latitude  float64 `yaml:"lat"`
+	Longitude float64 `yaml:"lon"`
+	Timezone  string  `yaml:"tz"`
+}
+
+// builtinLocations are the locations that -l accepts by name besides those
+// of the user's locations file.
+var builtinLocations = map[string]Location{
+	"mumbai":    {19.0760, 72.8777, "Asia/Kolkata"},
+	"delhi":     {28.6139, 77.2090, "Asia/Kolkata"},
+	"chennai":   {13.0827, 80.2707, "Asia/Kolkata"},
+	"kolkata":   {22.5726, 88.3639, "Asia/Kolkata"},
+	"bengaluru": {12.9716, 77.5946, "Asia/Kolkata"},
+	"hyderabad": {17.3850, 78.4867, "Asia/Kolkata"},
+	"ujjain":    {23.1765, 75.7885, "Asia/Kolkata"},
+	"varanasi":  {25.3176, 82.9739, "Asia/Kolkata"},
+	"kathmandu": {27.7172, 85.3240, "Asia/Kathmandu"},
+	"singapore": {1.3521, 103.8198, "Asia/Singapore"},
+	"london":    {51.5074, -0.1278, "Europe/London"},
+	"new-york":  {40.7128, -74.0060, "America/New_York"},
+}
+
+// locationFlags registers the observer location flags shared by commands.
+// -l sets all three from a named location; flags after it override it.
+func locationFlags(fs *flag.FlagSet) (lat, lon *float64, tz *string) {
+	lat = fs.Float64("lat", 19.0760, "Latitude in degrees, positive north")
+	lon = fs.Float64("lon", 72.8777, "Longitude in degrees, positive east")
+	tz = fs.String("tz", "", "IANA timezone name (defaults to the timezone at -lat and -lon)")
+	fs.Func("l", "Named location, added with the locations command or one of: "+strings.Join(sortedNames(builtinLocations), ", "), func(name string) error {
+		loc, err := lookupLocation(name)
+		if err != nil {
+			return err
+		}
+		*lat, *lon, *tz = loc.Latitude, loc.Longitude, loc.Timezone
+		return nil
+	})
+	fs.Func("place", `Place name to look up the location of, e.g. "Madurai, India"`, func(name string) error {
+		loc, err := geocodePlace(name)
+		if err != nil {
+			return err
+		}
+		*lat, *lon, *tz = loc.Latitude, loc.Longitude, loc.Timezone
+		return nil
+	})
+	return lat, lon, tz
+}
+
+// geocodePlace returns the location of the place named by query, from the
+// user's places file if it was looked up before, or else from the geocoder
+// selected by geocoderEnv, adding it to the file.
+func geocodePlace(query string) (Location, error) {
+	key := strings.ToLower(strings.Join(strings.Fields(query), " "))
+	path := placesPath()
+	places, err := loadLocations(path)
+	if err != nil {
+		return Location{}, err
+	}
+	if loc, ok := places[key]; ok {
+		return loc, nil
+	}
+	g, err := geocoder()
+	if err != nil {
+		return Location{}, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	place, err := g.Geocode(ctx, query)
+	if err != nil {
+		return Location{}, err
+	}
+	loc := Location{
+		Latitude:  place.Latitude,
+		Longitude: place.Longitude,
+		Timezone:  location.TimezoneName(place.Latitude, place.Longitude),
+	}
+	fmt.Fprintf(os.Stderr, "Found %s at %.4f, %.4f (%s)\n", place.Name, loc.Latitude, loc.Longitude, loc.Timezone)
+	places[key] = loc
+	if err := saveLocations(path, places); err != nil {
+		log.Printf("Error saving %s: %v", path, err)
+	}
+	return loc, nil
+}
+
+// geocoder returns the geocoder selected by geocoderEnv.
+func geocoder() (location.Geocoder, error) {
+	switch name := os.Getenv(geocoderEnv); name {
+	case "":
+		return location.Geocoders{location.Gazetteer{}, location.NewNominatimGeocoder()}, nil
+	case "offline":
+		return location.Gazetteer{}, nil
+	case "nominatim":
+		return location.NewNominatimGeocoder(), nil
+	default:
+		return nil, fmt.Errorf("unknown %s %q: expected offline or nominatim", geocoderEnv, name)
+	}
+}
+
+// loadTimezone loads the timezone tz, or the timezone at lat and lon if tz
+// is empty.
+func loadTimezone(tz string, lat, lon float64) (*time.Location, error) {
+	if tz == "" {
+		return location.Timezone(lat, lon)
+	}
+	return time.LoadLocation(tz)
+}
+
+// lookupLocation returns the location with the given name from the user's
+// locations file or, failing that, the builtin locations.
+func lookupLocation(name string) (Location, error) {
+	name = strings.ToLower(name)
+	user, err := loadLocations(locationsPath())
+	if err != nil {
+		return Location{}, err
+	}
+	if loc, ok := user[name]; ok {
+		return loc, nil
+	}
+	if loc, ok := builtinLocations[name]; ok {
+		return loc, nil
+	}
+	return Location{}, fmt.Errorf("unknown location %q", name)
+}
+
+// sortedNames returns the names of locations in order.
+func sortedNames(locations map[string]Location) []string {
+	names := make([]string, 0, len(locations))
+	for name := range locations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// locationsPath returns the path of the user's locations file.
+func locationsPath() string {
+	if path := os.Getenv(locationsEnv); path != "" {
+		return path
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".panchangam-locations.yaml"
+	}
+	return filepath.Join(home, ".panchangam-locations.yaml")
+}
+
+// placesPath returns the path of the file caching the locations of the
+// places looked up with -place.
+func placesPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ".panchangam-places.yaml"
+	}
+	return filepath.Join(home, ".panchangam-places.yaml")
+}
+
+// loadLocations reads the locations file at path, which need not exist.
+func loadLocations(path string) (map[string]Location, error) {
+	locations := map[string]Location{}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return locations, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, &locations); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	return locations, nil
+}
+
+// saveLocations replaces the locations file at path.
+func saveLocations(path string, locations map[string]Location) error {
+	data, err := yaml.Marshal(locations)
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".locations-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// runLocations manages the named locations of the user's locations file,
+// which -l accepts alongside the builtin ones:
+//
+//	client locations add mycity -lat 12.30 -lon 76.64 -tz Asia/Kolkata
+//	client locations remove mycity
+//	client locations list
+//
+// The timezone of a new location defaults to the one at its coordinates.
+func runLocations(fs *flag.FlagSet, args []string) {
+	usage := "Usage: client locations [add NAME -lat LAT -lon LON [-tz TZ]|remove NAME|list]"
+	if len(args) == 0 {
+		log.Fatal(usage)
+	}
+	action, args := args[0], args[1:]
+	var name string
+	if action == "add" || action == "remove" {
+		if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+			log.Fatal(usage)
+		}
+		name, args = strings.ToLower(args[0]), args[1:]
+	}
+	lat := fs.Float64("lat", 0, "Latitude in degrees, positive north")
+	lon := fs.Float64("lon", 0, "Longitude in degrees, positive east")
+	tz := fs.String("tz", "", "IANA timezone name (defaults to the timezone at -lat and -lon)")
+	// The coordinates of a new location are not taken from the
+	// configuration file, which would silently copy its defaults.
+	fs.Parse(args)
+
+	path := locationsPath()
+	locations, err := loadLocations(path)
+	if err != nil {
+		log.Fatalf("Error reading %s: %v", path, err)
+	}
+	switch action {
+	case "add":
+		given := map[string]bool{}
+		fs.Visit(func(f *flag.Flag) { given[f.Name] = true })
+		if !given["lat"] || !given["lon"] {
+			log.Fatalf("%s needs -lat and -lon", name)
+		}
+		if *lat < -90 || *lat > 90 || *lon < -180 || *lon > 180 {
+			log.Fatalf("Invalid coordinates %g, %g", *lat, *lon)
+		}
+		tz, err := loadTimezone(*tz, *lat, *lon)
+		if err != nil {
+			log.Fatalf("Invalid timezone: %v", err)
+		}
+		locations[name] = Location{Latitude: *lat, Longitude: *lon, Timezone: tz.String()}
+		if err := saveLocations(path, locations); err != nil {
+			log.Fatalf("Error saving %s: %v", path, err)
+		}
+		fmt.Printf("Added %s to %s\n", name, path)
+	case "remove":
+		if _, ok := locations[name]; !ok {
+			log.Fatalf("%s is not in %s", name, path)
+		}
+		delete(locations, name)
+		if err := saveLocations(path, locations); err != nil {
+			log.Fatalf("Error saving %s: %v", path, err)
+		}
+		fmt.Printf("Removed %s from %s\n", name, path)
+	case "list":
+		for _, name := range sortedNames(builtinLocations) {
+			if _, ok := locations[name]; !ok {
+				printLocation(name, builtinLocations[name], "builtin")
+			}
+		}
+		for _, name := range sortedNames(locations) {
+			printLocation(name, locations[name], path)
+		}
+	default:
+		log.Fatal(usage)
+	}
+}
+
+// runGeocode prints the location of a place, as -place resolves it:
+//
+//	client geocode "Madurai, India"
+func runGeocode(fs *flag.FlagSet, args []string) {
+	fs.Parse(args)
+	if fs.NArg() != 1 {
+		log.Fatal(`Usage: client geocode "PLACE"`)
+	}
+	loc, err := geocodePlace(fs.Arg(0))
+	if err != nil {
+		log.Fatalf("Error looking up %s: %v", fs.Arg(0), err)
+	}
+	printLocation(fs.Arg(0), loc, placesPath())
+}
+
+func printLocation(name string, loc Location, source string) {
+	fmt.Printf("%-12s %9.4f %9.4f  %-20s %s\n", name, loc.Latitude, loc.Longitude, loc.Timezone, source)
+}
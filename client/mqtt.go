@@ -0,0 +1,77 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/naren-m/panchangam/mqtt"
+)
+
+// runMQTT publishes the panchangam of a location to an MQTT broker, with
+// Home Assistant discovery payloads, computed locally without a server:
+//
+//	client mqtt -broker tcp://homeassistant.local:1883 -l chennai -node chennai -user ha -password secret
+//	client mqtt -l chennai -node chennai -dry-run
+//
+// It runs until interrupted, republishing the state at every transition;
+// -once publishes it once and exits, and -dry-run prints the messages
+// instead of publishing them.
+func runMQTT(fs *flag.FlagSet, args []string) {
+	lat, lon, tz := locationFlags(fs)
+	broker := fs.String("broker", "tcp://localhost:1883", "MQTT broker address: tcp://host:port or mqtts://host:port")
+	node := fs.String("node", "home", "ID of the location in topics and entity IDs, e.g. chennai")
+	name := fs.String("name", "", "Name of the Home Assistant device (default \"Panchangam\" and -node)")
+	user := fs.String("user", "", "MQTT username")
+	password := fs.String("password", os.Getenv("PANCHANGAM_MQTT_PASSWORD"), "MQTT password (default $PANCHANGAM_MQTT_PASSWORD)")
+	clientID := fs.String("client-id", "", "MQTT client ID (default panchangam- and -node)")
+	prefix := fs.String("topic-prefix", mqtt.DefaultTopicPrefix, "Root of the state topics")
+	discovery := fs.String("discovery-prefix", mqtt.DefaultDiscoveryPrefix, "Root of the Home Assistant discovery topics")
+	once := fs.Bool("once", false, "Publish the state once and exit")
+	dryRun := fs.Bool("dry-run", false, "Print the messages instead of publishing them")
+	parseFlags(fs, args)
+
+	p, err := mqtt.NewPublisher(mqtt.Config{
+		Broker:          *broker,
+		ClientID:        *clientID,
+		Username:        *user,
+		Password:        *password,
+		Node:            *node,
+		Name:            *name,
+		Latitude:        *lat,
+		Longitude:       *lon,
+		Timezone:        *tz,
+		TopicPrefix:     *prefix,
+		DiscoveryPrefix: *discovery,
+	})
+	if err != nil {
+		log.Fatalf("Error configuring MQTT: %v", err)
+	}
+
+	switch {
+	case *dryRun:
+		msgs, err := p.Messages(time.Now())
+		if err != nil {
+			log.Fatalf("Error computing state: %v", err)
+		}
+		for _, m := range msgs {
+			fmt.Printf("%s\n  %s\n", m.Topic, m.Payload)
+		}
+	case *once:
+		ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := p.Publish(ctx); err != nil {
+			log.Fatalf("Error publishing to %s: %v", *broker, err)
+		}
+		fmt.Printf("Published %s\n", p.StateTopic())
+	default:
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		fmt.Printf("Publishing %s to %s; press Ctrl-C to stop\n", p.StateTopic(), *broker)
+		p.Run(ctx)
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/notify"
+)
+
+// runNotify sends the daily panchangam and transition alerts of the
+// locations of a notify configuration over Telegram, Slack, webhooks or
+// SMS. It computes them locally, without a server:
+//
+//	client notify serve -file notify.json
+//	client notify send -file notify.json -date 2024-11-01
+//
+// serve runs until interrupted, sending each message as it falls due; send
+// sends the daily summaries of a date at once, e.g. to try a configuration.
+func runNotify(fs *flag.FlagSet, args []string) {
+	if len(args) == 0 {
+		log.Fatalf("Usage: client notify [serve|send] [flags]")
+	}
+	action, args := args[0], args[1:]
+	file := fs.String("file", "notify.json", "JSON notify configuration of the locations and subscriptions")
+	festivalsDir := fs.String("festivals-dir", "", "Directory of custom festival definition files (*.json)")
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date whose daily summaries send sends, in YYYY-MM-DD format")
+	parseFlags(fs, args)
+
+	cfg, err := notify.LoadConfig(*file)
+	if err != nil {
+		log.Fatalf("Error loading notify configuration: %v", err)
+	}
+	festivals := festival.NewCatalogs()
+	if *festivalsDir != "" {
+		definitions, err := festival.ReadDir(*festivalsDir)
+		if err != nil {
+			log.Fatalf("Error loading festival definitions: %v", err)
+		}
+		festivals = festival.NewCatalogs(festival.WithDefinitions(definitions...))
+	}
+	service, err := notify.NewService(cfg, festivals)
+	if err != nil {
+		log.Fatalf("Error configuring notifications: %v", err)
+	}
+
+	switch action {
+	case "serve":
+		ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+		defer stop()
+		fmt.Printf("Sending notifications to %d subscriptions; press Ctrl-C to stop\n", len(cfg.Subscriptions))
+		service.Run(ctx)
+	case "send":
+		if err := service.SendDaily(context.Background(), *date); err != nil {
+			log.Fatalf("Error sending daily summaries: %v", err)
+		}
+		fmt.Printf("Sent the daily summaries of %s\n", *date)
+	default:
+		log.Fatalf("Unknown notify command %q", action)
+	}
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/muhurta"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+// profileFlags registers the flags describing the person muhurtas are
+// sought for, and returns a function giving the profile they set, or nil
+// when they set none. The profile is kept in the configuration file:
+//
+//	janma-nakshatra: Rohini
+//	janma-rashi: Vrishabha
+func profileFlags(fs *flag.FlagSet) func() *ppb.Profile {
+	nakshatra := fs.String("janma-nakshatra", "", "Janma nakshatra, that of the moon at birth, e.g. Rohini, to score tarabala")
+	rashi := fs.String("janma-rashi", "", "Janma rashi, the sign of the moon at birth, e.g. Vrishabha, to score chandrabala")
+	return func() *ppb.Profile {
+		if *nakshatra == "" && *rashi == "" {
+			return nil
+		}
+		return &ppb.Profile{JanmaNakshatra: *nakshatra, JanmaRashi: *rashi}
+	}
+}
+
+// runProfile prints the profile of the configuration file and the tarabala
+// and chandrabala of the person at sunrise on a date, computed locally:
+//
+//	client profile -date 2024-11-08 -l chennai
+func runProfile(fs *flag.FlagSet, args []string) {
+	date := fs.String("date", time.Now().Format("2006-01-02"), "Date in YYYY-MM-DD format")
+	lat, lon, tz := locationFlags(fs)
+	profile := profileFlags(fs)
+	parseFlags(fs, args)
+
+	p := profile()
+	if p == nil {
+		log.Fatalf("No profile: set janma-nakshatra and janma-rashi in the configuration file or with -janma-nakshatra and -janma-rashi")
+	}
+	location, err := loadTimezone(*tz, *lat, *lon)
+	if err != nil {
+		log.Fatalf("Error loading timezone: %v", err)
+	}
+	day, err := time.ParseInLocation("2006-01-02", *date, location)
+	if err != nil {
+		log.Fatalf("Error parsing date %s: %v", *date, err)
+	}
+	sun, err := astronomy.CalculateSunTimes(astronomy.Location{Latitude: *lat, Longitude: *lon}, day, astronomy.WithPolarFallback())
+	if err != nil {
+		log.Fatalf("Error calculating sunrise: %v", err)
+	}
+
+	fmt.Printf("Profile at sunrise on %s (%s):\n", *date, sun.Sunrise.In(location).Format("15:04"))
+	if p.JanmaNakshatra != "" {
+		janma, ok := lookupNakshatra(p.JanmaNakshatra)
+		if !ok {
+			log.Fatalf("Unknown janma nakshatra %q", p.JanmaNakshatra)
+		}
+		nakshatra := astronomy.CalculateElements(sun.Sunrise).Nakshatra
+		fmt.Printf("  Janma nakshatra  %s\n", janma.Name)
+		fmt.Printf("  Tarabala         %s (%s)\n", muhurta.Tara(janma.Number, nakshatra.Number), nakshatra.Name)
+	}
+	if p.JanmaRashi != "" {
+		janma := 0
+		for n := 1; n <= 12; n++ {
+			if strings.EqualFold(astronomy.RashiName(n), p.JanmaRashi) {
+				janma = n
+			}
+		}
+		if janma == 0 {
+			log.Fatalf("Unknown janma rashi %q", p.JanmaRashi)
+		}
+		rashi := astronomy.MoonRashi(sun.Sunrise)
+		fmt.Printf("  Janma rashi      %s\n", astronomy.RashiName(janma))
+		fmt.Printf("  Chandrabala      %s from the janma rashi (%s)\n", ordinal(muhurta.Chandrabala(janma, rashi)), astronomy.RashiName(rashi))
+	}
+}
+
+// lookupNakshatra returns the nakshatra named name in any case.
+func lookupNakshatra(name string) (astronomy.Element, bool) {
+	for _, n := range astronomy.ElementNames(astronomy.NakshatraElement) {
+		if strings.EqualFold(n, name) {
+			return astronomy.NakshatraByName(n)
+		}
+	}
+	return astronomy.Element{}, false
+}
+
+// ordinal formats n as an English ordinal, e.g. "3rd".
+func ordinal(n int) string {
+	suffix := "th"
+	switch {
+	case n%100 >= 11 && n%100 <= 13:
+	case n%10 == 1:
+		suffix = "st"
+	case n%10 == 2:
+		suffix = "nd"
+	case n%10 == 3:
+		suffix = "rd"
+	}
+	return fmt.Sprintf("%d%s", n, suffix)
+}
@@ -0,0 +1,162 @@
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/i18n"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/summary"
+)
+
+// Publishable output formats of the summary and calendar commands, which
+// temple websites and newsletters can include as they are.
+const (
+	markdownOutput = "markdown"
+	htmlOutput     = "html"
+)
+
+// rendered reports whether output is one of the publishable formats.
+func rendered(output string) bool {
+	return output == markdownOutput || output == htmlOutput
+}
+
+// renderLabel returns the label of key, one of the summary label keys, in
+// locale, or english when the catalog of locale has none.
+func renderLabel(locale, key, english string) string {
+	if label := i18n.DefaultCatalogs().Label(locale, key); label != "" {
+		return label
+	}
+	return english
+}
+
+// renderedDay is a daily panchangam panel: the lines of the summary of a
+// date.
+type renderedDay struct {
+	Locale string
+	Title  string
+	Lines  []*ppb.SummaryLine
+}
+
+var dayHTML = template.Must(template.New("day").Parse(`<section class="panchangam-day" lang="{{.Locale}}">
+<h2>{{.Title}}</h2>
+<table>
+{{- range .Lines}}
+<tr><th scope="row">{{.Label}}</th><td>{{.Value}}</td></tr>
+{{- end}}
+</table>
+</section>
+`))
+
+// renderSummary writes the summary of a date as a panel in format.
+func renderSummary(w io.Writer, format string, s *ppb.Summary) error {
+	day := renderedDay{Locale: s.GetLocale(), Title: s.GetDate(), Lines: s.GetLines()}
+	if format == htmlOutput {
+		return dayHTML.Execute(w, day)
+	}
+	fmt.Fprintf(w, "## %s\n\n", markdownCell(day.Title))
+	fmt.Fprintln(w, "| | |")
+	fmt.Fprintln(w, "|---|---|")
+	for _, l := range day.Lines {
+		fmt.Fprintf(w, "| **%s** | %s |\n", markdownCell(l.GetLabel()), markdownCell(l.GetValue()))
+	}
+	return nil
+}
+
+// renderedMonth is a monthly table of the days of a calendar, with the
+// headings of its columns in its locale.
+type renderedMonth struct {
+	Locale   string
+	Title    string
+	Subtitle string
+	Headings []string
+	Rows     [][]string
+}
+
+var monthHTML = template.Must(template.New("month").Parse(`<section class="panchangam-month" lang="{{.Locale}}">
+<h2>{{.Title}}</h2>
+<p>{{.Subtitle}}</p>
+<table>
+<thead>
+<tr>{{range .Headings}}<th scope="col">{{.}}</th>{{end}}</tr>
+</thead>
+<tbody>
+{{- range .Rows}}
+<tr>{{range .}}<td>{{.}}</td>{{end}}</tr>
+{{- end}}
+</tbody>
+</table>
+</section>
+`))
+
+// renderMonth writes days, the days of the month starting on first, as a
+// table in format with a row for each day. The tithis, nakshatras and varas
+// are named in locale when its catalog names them.
+func renderMonth(w io.Writer, format, locale string, first time.Time, subtitle string, days []calendarDay) error {
+	month := renderedMonth{
+		Locale:   locale,
+		Title:    first.Format("January 2006"),
+		Subtitle: subtitle,
+		Headings: []string{
+			"Date",
+			renderLabel(locale, summary.VaraLabel, "Vara"),
+			renderLabel(locale, summary.TithiLabel, "Tithi"),
+			renderLabel(locale, summary.NakshatraLabel, "Nakshatra"),
+			"Events",
+		},
+	}
+	for i, d := range days {
+		tithi, nakshatra := d.Tithi, d.Nakshatra
+		if local := d.local; locale != i18n.English && local.GetTithi() != "" {
+			tithi, nakshatra = local.GetTithi(), local.GetNakshatra()
+		}
+		month.Rows = append(month.Rows, []string{
+			d.Date,
+			localWeekday(locale, first.AddDate(0, 0, i)),
+			tithi,
+			nakshatra,
+			strings.Join(d.Events, ", "),
+		})
+	}
+	if format == htmlOutput {
+		return monthHTML.Execute(w, month)
+	}
+	fmt.Fprintf(w, "## %s\n\n%s\n\n", markdownCell(month.Title), markdownCell(month.Subtitle))
+	writeMarkdownRow(w, month.Headings)
+	fmt.Fprintln(w, "|"+strings.Repeat("---|", len(month.Headings)))
+	for _, row := range month.Rows {
+		writeMarkdownRow(w, row)
+	}
+	return nil
+}
+
+// localWeekday returns the name of the vara of date in locale, or its
+// weekday in English.
+func localWeekday(locale string, date time.Time) string {
+	if locale != i18n.English {
+		vara := astronomy.CalculateVara(date, date.AddDate(0, 0, 1)).Element
+		if name := i18n.DefaultCatalogs().ElementName(locale, vara); name != "" {
+			return name
+		}
+	}
+	return date.Weekday().String()
+}
+
+func writeMarkdownRow(w io.Writer, cells []string) {
+	var b strings.Builder
+	b.WriteString("|")
+	for _, c := range cells {
+		b.WriteString(" " + markdownCell(c) + " |")
+	}
+	fmt.Fprintln(w, b.String())
+}
+
+// markdownCell escapes s for a cell of a Markdown table, which cannot hold
+// pipes or line breaks.
+func markdownCell(s string) string {
+	return strings.NewReplacer("|", `\|`, "\n", " ").Replace(s)
+}
@@ -0,0 +1,87 @@
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+var update = flag.Bool("update", false, "Rewrite the golden files of testdata with the outputs")
+
+// golden compares got with the golden file testdata/name, or rewrites it
+// with -update.
+func golden(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *update {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != string(want) {
+		t.Errorf("output differs from %s:\n%s\nwant\n%s", path, got, want)
+	}
+}
+
+func TestRenderSummary(t *testing.T) {
+	s := &ppb.Summary{
+		Date:   "2024-04-09",
+		Locale: "en",
+		Lines: []*ppb.SummaryLine{
+			{Label: "Tithi", Value: "Shukla Pratipada until 20:31"},
+			{Label: "Nakshatra", Value: "Revati until 07:32"},
+			// Pipes and markup are escaped.
+			{Label: "Festivals", Value: "Ugadi | Gudi Padwa <new year>\nChaitra Navaratri begins"},
+		},
+	}
+	for _, format := range []string{markdownOutput, htmlOutput} {
+		var b strings.Builder
+		if err := renderSummary(&b, format, s); err != nil {
+			t.Fatalf("renderSummary(%s) error = %v", format, err)
+		}
+		golden(t, "summary."+format, b.String())
+	}
+}
+
+func TestRenderMonth(t *testing.T) {
+	first := time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC)
+	days := []calendarDay{
+		{Date: "2024-04-01", Tithi: "Krishna Saptami", Paksha: "krishna", Nakshatra: "Purva Ashadha"},
+		{Date: "2024-04-02", Tithi: "Krishna Ashtami", Paksha: "krishna", Nakshatra: "Uttara Ashadha", Events: []string{"Kalashtami"}},
+		{
+			Date: "2024-04-03", Tithi: "Krishna Navami", Paksha: "krishna", Nakshatra: "Shravana",
+			local: &ppb.LocalNames{Locale: "te", Tithi: "కృష్ణ నవమి", Nakshatra: "శ్రవణం"},
+		},
+	}
+	for _, locale := range []string{"en", "te"} {
+		for _, format := range []string{markdownOutput, htmlOutput} {
+			var b strings.Builder
+			if err := renderMonth(&b, format, locale, first, "Chaitra | Phalguna", days); err != nil {
+				t.Fatalf("renderMonth(%s, %s) error = %v", format, locale, err)
+			}
+			got := b.String()
+			golden(t, "month."+locale+"."+format, got)
+			// The names of the tithis carry their paksha, which must not be
+			// repeated.
+			if strings.Contains(strings.ToLower(got), "krishna krishna") {
+				t.Errorf("renderMonth(%s, %s) repeats the paksha:\n%s", format, locale, got)
+			}
+		}
+	}
+}
+
+func TestMarkdownCell(t *testing.T) {
+	if got := markdownCell("a | b\nc"); got != `a \| b c` {
+		t.Errorf("markdownCell() = %q", got)
+	}
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// replHelp describes the helpers of the repl command.
+const replHelp = `Helpers:
+  sun(lat, lon, date)  sunrise, sunset and the sun's position at sunrise
+  moon(at)             the moon's position and phase at a Julian day or time
+  tithi(at)            tithi, nakshatra, yoga and karana at a Julian day or time
+  jd(at)               Julian day of a date or time
+Dates are YYYY-MM-DD, times YYYY-MM-DDTHH:MM[:SS] with an optional offset,
+both in the -tz timezone by default, and may be quoted, e.g. "2024-04-30".
+Calls nest, e.g. moon(jd(2024-04-30)).
+Type help to show this message and exit or Ctrl-D to leave.`
+
+// runREPL starts an interactive prompt evaluating calls to the helpers
+// backed by the local calculators, without a server.
+func runREPL(fs *flag.FlagSet, args []string) {
+	tz := fs.String("tz", "Asia/Kolkata", "IANA timezone of dates and times")
+	parseFlags(fs, args)
+
+	location, err := time.LoadLocation(*tz)
+	if err != nil {
+		log.Fatalf("Invalid timezone %q: %v", *tz, err)
+	}
+	r := &repl{tz: location}
+	fmt.Println("Panchangam REPL. Type help for the helpers.")
+	r.run(os.Stdin, os.Stdout)
+}
+
+// repl evaluates expressions made of helper calls and literals.
+type repl struct {
+	tz *time.Location
+}
+
+// run reads expressions from in until it ends or exit is entered, writing
+// their values or errors to out.
+func (r *repl) run(in io.Reader, out io.Writer) {
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(out, "panchangam> ")
+		if !scanner.Scan() {
+			fmt.Fprintln(out)
+			return
+		}
+		line := strings.TrimSpace(scanner.Text())
+		switch line {
+		case "":
+			continue
+		case "exit", "quit":
+			return
+		case "help":
+			fmt.Fprintln(out, replHelp)
+			continue
+		}
+		v, err := r.eval(line)
+		if err != nil {
+			fmt.Fprintln(out, "error:", err)
+			continue
+		}
+		fmt.Fprintln(out, r.format(v))
+	}
+}
+
+// report is the multi-line value returned by helpers describing several
+// quantities.
+type report string
+
+// helper evaluates a call with already evaluated arguments.
+type helper func(r *repl, args []interface{}) (interface{}, error)
+
+var helpers = map[string]helper{
+	"sun":   (*repl).sun,
+	"moon":  (*repl).moon,
+	"tithi": (*repl).tithi,
+	"jd":    (*repl).jd,
+}
+
+// eval evaluates an expression: a helper call, a number or a date or time.
+func (r *repl) eval(expr string) (interface{}, error) {
+	expr = strings.TrimSpace(expr)
+	open := strings.IndexByte(expr, '(')
+	if open < 0 || strings.HasPrefix(expr, `"`) {
+		return r.literal(expr)
+	}
+	if !strings.HasSuffix(expr, ")") {
+		return nil, fmt.Errorf("missing ) in %q", expr)
+	}
+	name := strings.TrimSpace(expr[:open])
+	h, ok := helpers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown helper %q: expected one of %s", name, strings.Join(helperNames(), ", "))
+	}
+	parts, err := splitArgs(expr[open+1 : len(expr)-1])
+	if err != nil {
+		return nil, err
+	}
+	args := make([]interface{}, len(parts))
+	for i, part := range parts {
+		if args[i], err = r.eval(part); err != nil {
+			return nil, err
+		}
+	}
+	return h(r, args)
+}
+
+func helperNames() []string {
+	names := make([]string, 0, len(helpers))
+	for name := range helpers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// splitArgs splits the arguments of a call at the commas outside nested
+// calls and quoted strings, in which a backslash escapes the next
+// character.
+func splitArgs(s string) ([]string, error) {
+	if strings.TrimSpace(s) == "" {
+		return nil, nil
+	}
+	var args []string
+	depth, start := 0, 0
+	quoted, escaped := false, false
+	for i, c := range s {
+		switch {
+		case escaped:
+			escaped = false
+			continue
+		case quoted:
+			switch c {
+			case '\\':
+				escaped = true
+			case '"':
+				quoted = false
+			}
+			continue
+		}
+		switch c {
+		case '"':
+			quoted = true
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, errors.New("unbalanced )")
+			}
+		case ',':
+			if depth == 0 {
+				args = append(args, s[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if quoted {
+		return nil, errors.New("unterminated string")
+	}
+	if depth != 0 {
+		return nil, errors.New("unbalanced (")
+	}
+	return append(args, s[start:]), nil
+}
+
+// timeLayouts are the accepted forms of date and time literals.
+var timeLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02T15:04",
+	"2006-01-02",
+}
+
+// literal evaluates a number or a date or time, which may be quoted.
+func (r *repl) literal(s string) (interface{}, error) {
+	if strings.HasPrefix(s, `"`) {
+		unquoted, err := strconv.Unquote(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid string %s", s)
+		}
+		s = unquoted
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return f, nil
+	}
+	for _, layout := range timeLayouts {
+		if t, err := time.ParseInLocation(layout, s, r.tz); err == nil {
+			return t, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid value %q: expected a number, date or time", s)
+}
+
+func (r *repl) format(v interface{}) string {
+	switch v := v.(type) {
+	case float64:
+		return strconv.FormatFloat(v, 'f', 6, 64)
+	case time.Time:
+		return v.In(r.tz).Format(time.RFC3339)
+	case report:
+		return string(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+// checkArgs returns an error unless args has count values.
+func checkArgs(name string, args []interface{}, count int) error {
+	if len(args) != count {
+		return fmt.Errorf("%s takes %d arguments, got %d", name, count, len(args))
+	}
+	return nil
+}
+
+func number(v interface{}) (float64, error) {
+	f, ok := v.(float64)
+	if !ok {
+		return 0, fmt.Errorf("expected a number, got %v", v)
+	}
+	return f, nil
+}
+
+// instant converts a Julian day or a time to a time.
+func instant(v interface{}) (time.Time, error) {
+	switch v := v.(type) {
+	case float64:
+		return astronomy.TimeFromJulianDay(v), nil
+	case time.Time:
+		return v, nil
+	default:
+		return time.Time{}, fmt.Errorf("expected a Julian day or time, got %v", v)
+	}
+}
+
+func (r *repl) jd(args []interface{}) (interface{}, error) {
+	if err := checkArgs("jd", args, 1); err != nil {
+		return nil, err
+	}
+	t, err := instant(args[0])
+	if err != nil {
+		return nil, err
+	}
+	return astronomy.JulianDay(t), nil
+}
+
+func (r *repl) sun(args []interface{}) (interface{}, error) {
+	if err := checkArgs("sun", args, 3); err != nil {
+		return nil, err
+	}
+	lat, err := number(args[0])
+	if err != nil {
+		return nil, err
+	}
+	lon, err := number(args[1])
+	if err != nil {
+		return nil, err
+	}
+	date, err := instant(args[2])
+	if err != nil {
+		return nil, err
+	}
+	sunTimes, err := astronomy.CalculateSunTimes(astronomy.Location{Latitude: lat, Longitude: lon}, date.In(r.tz))
+	if err != nil {
+		return nil, err
+	}
+	jd := astronomy.JulianDayTT(sunTimes.Sunrise)
+	longitude := astronomy.SunLongitude(jd)
+	return report(fmt.Sprintf("sunrise    %s\nsunset     %s\nday length %s\nlongitude  %.4f° tropical, %.4f° sidereal at sunrise",
+		r.format(sunTimes.Sunrise), r.format(sunTimes.Sunset), sunTimes.DayLength().Round(time.Second),
+		longitude, astronomy.SiderealLongitude(longitude, jd))), nil
+}
+
+func (r *repl) moon(args []interface{}) (interface{}, error) {
+	if err := checkArgs("moon", args, 1); err != nil {
+		return nil, err
+	}
+	t, err := instant(args[0])
+	if err != nil {
+		return nil, err
+	}
+	jd := astronomy.JulianDayTT(t)
+	longitude := astronomy.MoonLongitude(jd)
+	phase := astronomy.CalculateMoonPhase(t)
+	return report(fmt.Sprintf("longitude %.4f° tropical, %.4f° sidereal\nlatitude  %.4f°\ndistance  %.0f km\nphase     %s, %.1f%% illuminated",
+		longitude, astronomy.SiderealLongitude(longitude, jd), astronomy.MoonLatitude(jd),
+		astronomy.MoonDistance(jd), phase.Name, phase.Illumination*100)), nil
+}
+
+func (r *repl) tithi(args []interface{}) (interface{}, error) {
+	if err := checkArgs("tithi", args, 1); err != nil {
+		return nil, err
+	}
+	t, err := instant(args[0])
+	if err != nil {
+		return nil, err
+	}
+	e := astronomy.CalculateElements(t)
+	return report(fmt.Sprintf("tithi     %s (%d, %s paksha)\nnakshatra %s (%d)\nyoga      %s (%d)\nkarana    %s (%d)",
+		e.Tithi.Name, e.Tithi.Number, astronomy.TithiPaksha(e.Tithi),
+		e.Nakshatra.Name, e.Nakshatra.Number, e.Yoga.Name, e.Yoga.Number, e.Karana.Name, e.Karana.Number)), nil
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSplitArgs(t *testing.T) {
+	for _, tt := range []struct {
+		in      string
+		want    []string
+		wantErr string
+	}{
+		{"", nil, ""},
+		{"  ", nil, ""},
+		{"1", []string{"1"}, ""},
+		{"13.08, 80.27, 2024-04-09", []string{"13.08", " 80.27", " 2024-04-09"}, ""},
+		{"jd(2024-04-09), 1", []string{"jd(2024-04-09)", " 1"}, ""},
+		{"moon(jd(2024-04-09)), sun(1, 2, 3)", []string{"moon(jd(2024-04-09))", " sun(1, 2, 3)"}, ""},
+		{`"a, b", 1`, []string{`"a, b"`, " 1"}, ""},
+		{`"(", ")"`, []string{`"("`, ` ")"`}, ""},
+		{`"say \"hi\", ok", 2`, []string{`"say \"hi\", ok"`, " 2"}, ""},
+		{`"back\\", 3`, []string{`"back\\"`, " 3"}, ""},
+		{"1,", []string{"1", ""}, ""},
+		{"jd(1", nil, "unbalanced ("},
+		{"1)", nil, "unbalanced )"},
+		{`"2024-04-09`, nil, "unterminated string"},
+		{`"escaped\"`, nil, "unterminated string"},
+	} {
+		got, err := splitArgs(tt.in)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("splitArgs(%q) error = %v, want %s", tt.in, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("splitArgs(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+}
+
+func TestREPLEval(t *testing.T) {
+	r := &repl{tz: time.UTC}
+	for _, tt := range []struct {
+		expr, want, wantErr string
+	}{
+		{"42", "42.000000", ""},
+		{"2024-04-09", "2024-04-09T00:00:00Z", ""},
+		{"2024-04-09T06:30", "2024-04-09T06:30:00Z", ""},
+		{"2024-04-09T12:00:00+05:30", "2024-04-09T06:30:00Z", ""},
+		{`"2024-04-09"`, "2024-04-09T00:00:00Z", ""},
+		{"jd(2024-04-09T12:00)", "2460410.000000", ""},
+		{` jd( "2024-04-09T12:00" ) `, "2460410.000000", ""},
+		{"jd(2460410)", "2460410.000000", ""},
+		{"tithi(jd(2024-04-09T06:00))", "tithi     Shukla Pratipada (1, shukla paksha)", ""},
+		{"today", "", `invalid value "today": expected a number, date or time`},
+		{`"2024-04-09`, "", `invalid string "2024-04-09`},
+		{`jd("2024-04-09)`, "", "unterminated string"},
+		{"lagna(2024-04-09)", "", `unknown helper "lagna": expected one of jd, moon, sun, tithi`},
+		{"jd(2024-04-09", "", `missing ) in "jd(2024-04-09"`},
+		{"jd(2024-04-09, 1)", "", "jd takes 1 arguments, got 2"},
+		{"sun(north, 80.27, 2024-04-09)", "", `invalid value "north": expected a number, date or time`},
+		{"sun(2024-04-09, 80.27, 2024-04-09)", "", "expected a number, got 2024-04-09 00:00:00 +0000 UTC"},
+	} {
+		v, err := r.eval(tt.expr)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("eval(%q) error = %v, want %s", tt.expr, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("eval(%q) error = %v", tt.expr, err)
+			continue
+		}
+		if got := r.format(v); !strings.HasPrefix(got, tt.want) {
+			t.Errorf("eval(%q) = %s, want %s", tt.expr, got, tt.want)
+		}
+	}
+}
+
+func TestREPLRun(t *testing.T) {
+	r := &repl{tz: time.UTC}
+	var out strings.Builder
+	r.run(strings.NewReader("jd(2024-04-09T12:00)\n\nlagna(1)\nlist\nhelp\nexit\njd(1)\n"), &out)
+	got := out.String()
+	for _, want := range []string{
+		"panchangam> 2460410.000000\n",
+		`panchangam> error: unknown helper "lagna": expected one of jd, moon, sun, tithi` + "\n",
+		`panchangam> error: invalid value "list": expected a number, date or time` + "\n",
+		"panchangam> " + replHelp + "\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output lacks %q:\n%s", want, got)
+		}
+	}
+	// Nothing is read after exit.
+	if strings.Count(got, "panchangam> ") != 6 || strings.Contains(got, "1.000000") {
+		t.Errorf("output after exit:\n%s", got)
+	}
+
+	// The prompt ends its line when the input ends.
+	out.Reset()
+	r.run(strings.NewReader("1"), &out)
+	if got := out.String(); got != "panchangam> 1.000000\npanchangam> \n" {
+		t.Errorf("output at the end of the input = %q", got)
+	}
+}
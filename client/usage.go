@@ -0,0 +1,67 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/metadata"
+)
+
+// runUsage reports the daily calls of API keys to the methods of the
+// server, with an API key of the admin scope:
+//
+//	client admin usage -api-key $KEY -date 2024-04-01 -end-date 2024-04-30 -key k1
+func runUsage(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	apiKey := fs.String("api-key", "", "API key with the admin scope")
+	date := fs.String("date", "", "First day of the report, YYYY-MM-DD (default today, UTC)")
+	endDate := fs.String("end-date", "", "Last day of the report, YYYY-MM-DD (default the first)")
+	keyID := fs.String("key", "", "ID of the API key to report on (default every key)")
+	method := fs.String("method", "", "Full method to report on, e.g. /panchangam.Panchangam/Get (default every method)")
+	output := fs.String("output", "text", "Output format: text or json")
+	parseFlags(fs, args)
+	if *output != "text" && *output != "json" {
+		log.Fatalf("Unknown output format %q: expected text or json", *output)
+	}
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	if *apiKey != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, aaa.APIKeyHeader, *apiKey)
+	}
+	report, err := client.GetUsageReport(ctx, &ppb.GetUsageReportRequest{
+		Date:    *date,
+		EndDate: *endDate,
+		KeyId:   *keyID,
+		Method:  *method,
+	})
+	if err != nil {
+		log.Fatalf("Error calling GetUsageReport: %v", err)
+	}
+
+	if *output == "json" {
+		fmt.Println(string(marshalJSON(report)))
+		return
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "DATE\tKEY\tMETHOD\tCALLS\tERRORS\tMEAN MS\tMAX MS\t")
+	for _, r := range report.GetRows() {
+		key := r.GetKeyId()
+		if key == "" {
+			key = "-"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%.1f\t%.1f\t\n", r.GetDate(), key, r.GetMethod(),
+			r.GetCalls(), r.GetErrors(), r.GetMeanLatencyMs(), r.GetMaxLatencyMs())
+	}
+	fmt.Fprintf(tw, "TOTAL\t\t\t%d\t%d\t\t\t\n", report.GetTotalCalls(), report.GetTotalErrors())
+	tw.Flush()
+}
@@ -0,0 +1,86 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"runtime"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/version"
+)
+
+// versionReport is the JSON output of the version command.
+type versionReport struct {
+	Client      json.RawMessage `json:"client"`
+	Server      json.RawMessage `json:"server,omitempty"`
+	ServerError string          `json:"server_error,omitempty"`
+}
+
+// runVersion prints the versions of the client and of the server. The
+// client version is printed even when the server cannot be reached.
+func runVersion(fs *flag.FlagSet, args []string) {
+	addr := serverFlag(fs)
+	timeout := fs.Duration("timeout", 5*time.Second, "Time to wait for the server")
+	output := fs.String("output", "text", "Output format: text or json")
+	parseFlags(fs, args)
+	if *output != "text" && *output != "json" {
+		log.Fatalf("Unknown output format %q: expected text or json", *output)
+	}
+
+	clientInfo := &ppb.VersionInfo{
+		Version:   version.Version,
+		Commit:    version.Commit(),
+		BuildDate: version.BuildDate,
+		GoVersion: runtime.Version(),
+		Features:  version.FeatureList(),
+	}
+	client, closeConn := connect(*addr)
+	defer closeConn()
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+	serverInfo, err := client.GetVersion(ctx, &ppb.GetVersionRequest{})
+
+	if *output == "json" {
+		report := versionReport{Client: marshalJSON(clientInfo)}
+		if err != nil {
+			report.ServerError = err.Error()
+		} else {
+			report.Server = marshalJSON(serverInfo)
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Fatalf("Error writing versions: %v", err)
+		}
+		return
+	}
+	tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "\tVERSION\tCOMMIT\tBUILT\tGO\tFEATURES")
+	printVersion(tw, "Client", clientInfo)
+	if err == nil {
+		printVersion(tw, "Server", serverInfo)
+	}
+	tw.Flush()
+	if err != nil {
+		fmt.Printf("Server %s: %v\n", *addr, err)
+	}
+}
+
+func printVersion(tw *tabwriter.Writer, name string, info *ppb.VersionInfo) {
+	fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\n", name, info.GetVersion(), orUnknown(info.GetCommit()),
+		orUnknown(info.GetBuildDate()), info.GetGoVersion(), orUnknown(strings.Join(info.GetFeatures(), ",")))
+}
+
+func orUnknown(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"log"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/i18n"
+	"github.com/naren-m/panchangam/transition"
+)
+
+// ANSI escapes of the live watch screen.
+const (
+	clearScreen       = "\033[H\033[2J"
+	auspiciousStyle   = "\033[1;32m"
+	inauspiciousStyle = "\033[1;31m"
+	resetStyle        = "\033[0m"
+)
+
+// watchWindow is a muhurta or kalam of the day listed by the watch screen.
+type watchWindow struct {
+	astronomy.Period
+	auspicious bool
+}
+
+// watchLive redraws the panchangam at loc every minute, at the turn of the
+// minute, until interrupted. It is computed locally, without a server.
+func watchLive(loc astronomy.Location, zone *time.Location, locale string) {
+	for {
+		now := time.Now().In(zone)
+		var screen bytes.Buffer
+		if err := drawWatch(&screen, loc, now, locale); err != nil {
+			log.Fatalf("Error calculating panchangam: %v", err)
+		}
+		fmt.Print(clearScreen + screen.String())
+		time.Sleep(time.Until(now.Truncate(time.Minute).Add(time.Minute)))
+	}
+}
+
+// drawWatch writes the screen of the watch command at now: the elements
+// and hora in force with the time left in each, the muhurtas and kalams of
+// the day with those in force highlighted, and the countdown to the next
+// transition.
+func drawWatch(w io.Writer, loc astronomy.Location, now time.Time, locale string) error {
+	zone := now.Location()
+	sun, nextSunrise, err := panchangamDay(loc, now)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w, "Panchangam at %.4f, %.4f  %s\n\n", loc.Latitude, loc.Longitude, now.Format("Mon 2 Jan 2006 15:04 MST"))
+
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	for _, kind := range []astronomy.ElementKind{astronomy.TithiElement, astronomy.NakshatraElement, astronomy.YogaElement, astronomy.KaranaElement} {
+		p := astronomy.CalculateElementPeriod(kind, now)
+		writeWatchRow(tw, kindLabel(kind), withLocalName(p.Name, localElementName(locale, p.Element)), p.End, now)
+	}
+	vara := astronomy.CalculateVara(sun.Sunrise, nextSunrise)
+	writeWatchRow(tw, "Vara", withLocalName(vara.Name, localElementName(locale, vara.Element)), vara.End, now)
+	for _, h := range astronomy.Horas(sun.Sunrise, sun.Sunset, nextSunrise) {
+		if !now.Before(h.Start) && now.Before(h.End) {
+			writeWatchRow(tw, "Hora", h.Name, h.End, now)
+		}
+	}
+	tw.Flush()
+
+	fmt.Fprintln(w, "\nToday:")
+	for _, win := range dayWindows(sun, nextSunrise) {
+		line := fmt.Sprintf("  %s-%s  %s", win.Start.In(zone).Format("15:04"), win.End.In(zone).Format("15:04"), win.Name)
+		if !now.Before(win.Start) && now.Before(win.End) {
+			style := inauspiciousStyle
+			if win.auspicious {
+				style = auspiciousStyle
+			}
+			line = style + "> " + strings.TrimPrefix(line, "  ") + ", " + countdown(win.End.Sub(now)) + " left" + resetStyle
+		}
+		fmt.Fprintln(w, line)
+	}
+
+	scheduler := transition.NewScheduler(loc, zone, transition.WithNotice(0))
+	transitions, err := scheduler.Between(now, now.Add(24*time.Hour))
+	if err != nil {
+		return err
+	}
+	if len(transitions) > 0 {
+		t := transitions[0]
+		next := t.Message()
+		if local := localElementName(locale, t.Element); local != "" && local != t.Name {
+			next += fmt.Sprintf(" (%s)", local)
+		}
+		fmt.Fprintf(w, "\nNext: %s, in %s\n", next, countdown(t.At.Sub(now)))
+	}
+	return nil
+}
+
+// panchangamDay returns the sun times of the panchangam day holding now,
+// which runs from sunrise to the next sunrise, and that next sunrise.
+func panchangamDay(loc astronomy.Location, now time.Time) (*astronomy.SunTimes, time.Time, error) {
+	y, m, d := now.Date()
+	date := time.Date(y, m, d, 0, 0, 0, 0, now.Location())
+	sun, err := astronomy.CalculateSunTimes(loc, date, astronomy.WithPolarFallback())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	// Before sunrise the day is still that of the previous sunrise.
+	if now.Before(sun.Sunrise) {
+		date = date.AddDate(0, 0, -1)
+		if sun, err = astronomy.CalculateSunTimes(loc, date, astronomy.WithPolarFallback()); err != nil {
+			return nil, time.Time{}, err
+		}
+	}
+	next, err := astronomy.CalculateSunTimes(loc, date.AddDate(0, 0, 1), astronomy.WithPolarFallback())
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	return sun, next.Sunrise, nil
+}
+
+// dayWindows returns the muhurtas and kalams of the day from the sunrise
+// of sun to nextSunrise, ordered by their start.
+func dayWindows(sun *astronomy.SunTimes, nextSunrise time.Time) []watchWindow {
+	windows := []watchWindow{
+		{astronomy.AbhijitMuhurta(sun.Sunrise, sun.Sunset), true},
+		// The Brahma Muhurta of the day is the one before its next sunrise.
+		{astronomy.BrahmaMuhurta(nextSunrise), true},
+		{astronomy.RahuKalam(sun.Sunrise, sun.Sunset), false},
+		{astronomy.Yamagandam(sun.Sunrise, sun.Sunset), false},
+		{astronomy.GulikaKalam(sun.Sunrise, sun.Sunset), false},
+	}
+	for _, p := range astronomy.Durmuhurtas(sun.Sunrise, sun.Sunset, nextSunrise) {
+		windows = append(windows, watchWindow{p, false})
+	}
+	for _, p := range astronomy.Varjyams(sun.Sunrise, nextSunrise) {
+		windows = append(windows, watchWindow{p, false})
+	}
+	sort.SliceStable(windows, func(i, j int) bool {
+		return windows[i].Start.Before(windows[j].Start)
+	})
+	return windows
+}
+
+// writeWatchRow writes the row of an element or hora in force until end.
+func writeWatchRow(tw *tabwriter.Writer, label, name string, end, now time.Time) {
+	fmt.Fprintf(tw, "%s\t%s\tuntil %s\t%s left\t\n", label, name, end.In(now.Location()).Format("15:04"), countdown(end.Sub(now)))
+}
+
+// kindLabel returns the label of the elements of kind, e.g. Tithi.
+func kindLabel(kind astronomy.ElementKind) string {
+	return strings.ToUpper(string(kind[:1])) + string(kind[1:])
+}
+
+// localElementName returns the name of e in locale, or "" when no locale
+// is given or its catalog does not name e.
+func localElementName(locale string, e astronomy.Element) string {
+	if locale == "" || e.Kind == "" {
+		return ""
+	}
+	return i18n.DefaultCatalogs().ElementName(locale, e)
+}
+
+// countdown describes d rounded up to the minute, e.g. "1h 05m" or "12m".
+func countdown(d time.Duration) string {
+	minutes := int((d + time.Minute - 1) / time.Minute)
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
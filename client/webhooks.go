@@ -0,0 +1,72 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/webhook"
+)
+
+// runWebhooks manages the webhook subscriptions of the API key of the
+// client on a server started with -webhooks-file. Creating and deleting
+// them needs a key with the write scope:
+//
+//	client webhooks create -url https://example.com/hook -events festival,ekadashi,rahu_kalam_start -l chennai
+//	client webhooks list
+//	client webhooks delete -id 0123456789abcdef
+func runWebhooks(fs *flag.FlagSet, args []string) {
+	if len(args) == 0 {
+		log.Fatalf("Usage: client webhooks [create|list|delete] [flags]")
+	}
+	action, args := args[0], args[1:]
+	addr := serverFlag(fs)
+	url := fs.String("url", "", "http or https URL the events are posted to")
+	events := fs.String("events", "festival", "Comma-separated events posted: "+strings.Join(webhook.Events(), ", "))
+	region := fs.String("region", "", "Region whose festivals are posted, e.g. tamil_nadu (default all regions)")
+	id := fs.String("id", "", "ID of the subscription to delete")
+	lat, lon, tz := locationFlags(fs)
+	parseFlags(fs, args)
+
+	client, closeConn := connect(*addr)
+	defer closeConn()
+
+	switch action {
+	case "create":
+		sub, err := client.CreateSubscription(context.Background(), &ppb.CreateSubscriptionRequest{
+			Url:       *url,
+			Latitude:  *lat,
+			Longitude: *lon,
+			Timezone:  *tz,
+			Region:    *region,
+			Events:    strings.Split(*events, ","),
+		})
+		if err != nil {
+			log.Fatalf("Error calling CreateSubscription: %v", err)
+		}
+		fmt.Printf("Created subscription %s. The secret signing its deliveries is shown only once:\n%s\n", sub.GetId(), sub.GetSecret())
+	case "list":
+		resp, err := client.ListSubscriptions(context.Background(), &ppb.ListSubscriptionsRequest{})
+		if err != nil {
+			log.Fatalf("Error calling ListSubscriptions: %v", err)
+		}
+		tw := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, s := range resp.GetSubscriptions() {
+			fmt.Fprintf(tw, "%s\t%s\t%.4f,%.4f\t%s\t%s\tcreated %s\n", s.GetId(), s.GetUrl(), s.GetLatitude(), s.GetLongitude(),
+				s.GetTimezone(), strings.Join(s.GetEvents(), ","), s.GetCreated())
+		}
+		tw.Flush()
+	case "delete":
+		if _, err := client.DeleteSubscription(context.Background(), &ppb.DeleteSubscriptionRequest{Id: *id}); err != nil {
+			log.Fatalf("Error calling DeleteSubscription: %v", err)
+		}
+		fmt.Printf("Deleted subscription %s\n", *id)
+	default:
+		log.Fatalf("Unknown webhooks command %q", action)
+	}
+}
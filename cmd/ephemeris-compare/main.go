@@ -0,0 +1,108 @@
+// Command ephemeris-compare compares the positions of the sun, moon and
+// planets from the registered ephemeris providers with those of a reference
+// provider over a date range, and reports their discrepancies in
+// arcseconds:
+//
+//	ephemeris-compare -reference horizons -start 2024-01-01 -end 2024-12-31 -step 240h
+//
+// It exits with status 1 when a discrepancy exceeds -threshold.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/config"
+	"github.com/naren-m/panchangam/ephemeris"
+)
+
+func main() {
+	reference := flag.String("reference", "horizons", "Provider to compare the others with: "+strings.Join(ephemeris.Names(), ", "))
+	providers := flag.String("providers", "", "Comma separated providers to compare (defaults to every registered provider but the reference)")
+	bodies := flag.String("bodies", "", "Comma separated bodies to compare (defaults to all)")
+	start := flag.String("start", time.Now().UTC().Format("2006-01-02"), "First date in YYYY-MM-DD format")
+	end := flag.String("end", "", "Last date in YYYY-MM-DD format (defaults to -start)")
+	step := flag.Duration("step", 24*time.Hour, "Time between compared positions")
+	threshold := flag.Float64("threshold", 0, "Discrepancy in arcseconds to flag and fail on (0 never fails)")
+	if err := config.Parse(flag.CommandLine, os.Args[1:], "ephemeris-compare"); err != nil {
+		log.Fatal(err)
+	}
+
+	ref, err := ephemeris.Lookup(*reference)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var compared []ephemeris.Provider
+	for _, name := range names(*providers, ephemeris.Names()) {
+		if *providers == "" && name == ref.Name() {
+			continue
+		}
+		p, err := ephemeris.Lookup(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		compared = append(compared, p)
+	}
+	var compareBodies []ephemeris.Body
+	for _, name := range names(*bodies, nil) {
+		b, err := ephemeris.ParseBody(name)
+		if err != nil {
+			log.Fatal(err)
+		}
+		compareBodies = append(compareBodies, b)
+	}
+	if compareBodies == nil {
+		compareBodies = ephemeris.Bodies
+	}
+	first, err := time.Parse("2006-01-02", *start)
+	if err != nil {
+		log.Fatalf("Error parsing start date %s: %v", *start, err)
+	}
+	last := first
+	if *end != "" {
+		if last, err = time.Parse("2006-01-02", *end); err != nil {
+			log.Fatalf("Error parsing end date %s: %v", *end, err)
+		}
+	}
+
+	discrepancies, err := ephemeris.Compare(context.Background(), ref, compared, compareBodies, first, last, *step)
+	if err != nil {
+		log.Fatalf("Error comparing providers: %v", err)
+	}
+	fmt.Printf("Discrepancies from %s in arcseconds, %s to %s every %v:\n\n", ref.Name(), *start, last.Format("2006-01-02"), *step)
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(w, "provider\tbody\tsamples\tmax lon\trms lon\tmax lat\trms lat\tworst at\t\t")
+	exceeded := false
+	for _, d := range discrepancies {
+		flagged := ""
+		if *threshold > 0 && d.Exceeds(*threshold) {
+			flagged, exceeded = "!", true
+		}
+		fmt.Fprintf(w, "%s\t%s\t%d\t%.1f\t%.1f\t%.1f\t%.1f\t%s\t%s\t\n",
+			d.Provider, d.Body, d.Samples, d.MaxLongitude, d.RMSLongitude, d.MaxLatitude, d.RMSLatitude,
+			d.Worst.Format("2006-01-02 15:04"), flagged)
+	}
+	w.Flush()
+	if exceeded {
+		fmt.Printf("\nDiscrepancies marked ! exceed %g arcseconds.\n", *threshold)
+		os.Exit(1)
+	}
+}
+
+// names splits the comma separated list s, or returns all if s is empty.
+func names(s string, all []string) []string {
+	if s == "" {
+		return all
+	}
+	var result []string
+	for _, name := range strings.Split(s, ",") {
+		result = append(result, strings.TrimSpace(name))
+	}
+	return result
+}
@@ -0,0 +1,304 @@
+// Command loadtest drives a mix of requests against a panchangam server at
+// a fixed rate and checks the latencies and errors of each kind of request
+// against service level objectives:
+//
+//	loadtest -addr localhost:50051 -rate 100 -duration 1m -mix single=70,range=20,muhurta=10 -p99 500ms
+//
+// The kinds of requests are single, the panchangam of a day; range, the
+// events of -range-days days; and muhurta, the windows for -activity over
+// -range-days days. The dates requested cycle through -days days from
+// -date, so that most requests miss the server cache.
+//
+// It exits with status 1 when an objective is missed, so that performance
+// regressions fail a release pipeline.
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"math/rand"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"text/tabwriter"
+	"time"
+
+	"github.com/naren-m/panchangam/config"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	sdk "github.com/naren-m/panchangam/sdk/client"
+	"google.golang.org/grpc/status"
+)
+
+// kinds are the kinds of requests, in the order they are reported.
+var kinds = []string{"single", "range", "muhurta"}
+
+// weight is the share of a kind of request in the mix.
+type weight struct {
+	kind   string
+	weight int
+}
+
+// result is the outcome of a request.
+type result struct {
+	kind    string
+	latency time.Duration
+	code    string
+}
+
+// objectives are the limits each kind of request must keep to. Zero limits
+// are not checked.
+type objectives struct {
+	p50, p95, p99 time.Duration
+	errorRate     float64
+}
+
+func main() {
+	addr := flag.String("addr", "localhost:50051", "Panchangam server address")
+	mix := flag.String("mix", "single=70,range=20,muhurta=10", "Comma separated kinds of requests and their weights: single, range and muhurta")
+	rate := flag.Float64("rate", 50, "Requests started per second")
+	concurrency := flag.Int("concurrency", 64, "Most requests in flight; further requests wait, lowering the rate")
+	duration := flag.Duration("duration", 30*time.Second, "Time to send requests for, after the warm-up")
+	warmup := flag.Duration("warmup", 5*time.Second, "Time to send requests for before measuring")
+	timeout := flag.Duration("timeout", 10*time.Second, "Deadline of each request")
+	lat := flag.Float64("lat", 19.0760, "Latitude in degrees, positive north")
+	lon := flag.Float64("lon", 72.8777, "Longitude in degrees, positive east")
+	tz := flag.String("tz", "Asia/Kolkata", "IANA timezone name")
+	date := flag.String("date", time.Now().Format("2006-01-02"), "First date requested in YYYY-MM-DD format")
+	days := flag.Int("days", 365, "Number of consecutive dates requested in turn")
+	rangeDays := flag.Int("range-days", 7, "Days covered by range and muhurta requests")
+	activity := flag.String("activity", "marriage", "Activity of muhurta requests")
+	var slo objectives
+	flag.DurationVar(&slo.p50, "p50", 0, "Median latency each kind of request must keep within (0 to not check)")
+	flag.DurationVar(&slo.p95, "p95", 0, "95th percentile latency each kind of request must keep within (0 to not check)")
+	flag.DurationVar(&slo.p99, "p99", time.Second, "99th percentile latency each kind of request must keep within (0 to not check)")
+	flag.Float64Var(&slo.errorRate, "max-error-rate", 0.01, "Fraction of each kind of request that may fail")
+	if err := config.Parse(flag.CommandLine, os.Args[1:], "loadtest"); err != nil {
+		log.Fatal(err)
+	}
+
+	weights, err := parseMix(*mix)
+	if err != nil {
+		log.Fatal(err)
+	}
+	first, err := time.Parse("2006-01-02", *date)
+	if err != nil {
+		log.Fatalf("Error parsing date %s: %v", *date, err)
+	}
+	if *rate <= 0 || *concurrency < 1 || *days < 1 || *rangeDays < 1 {
+		log.Fatal("-rate, -concurrency, -days and -range-days must be positive")
+	}
+	// Retries would hide the failures the objectives are measured on.
+	client, err := sdk.Dial(*addr, sdk.WithRetry(1, 0))
+	if err != nil {
+		log.Fatalf("Error connecting to %s: %v", *addr, err)
+	}
+	defer client.Close()
+
+	send := requests{
+		client: client, first: first, days: *days, rangeDays: *rangeDays, timeout: *timeout,
+		lat: *lat, lon: *lon, tz: *tz, activity: *activity,
+	}.send
+
+	fmt.Printf("Warming up for %s\n", *warmup)
+	run(send, weights, *rate, *concurrency, *warmup)
+	fmt.Printf("Sending %.0f requests per second for %s\n\n", *rate, *duration)
+	start := time.Now()
+	results := run(send, weights, *rate, *concurrency, *duration)
+	elapsed := time.Since(start)
+
+	violations := report(os.Stdout, results, elapsed, slo)
+	if len(violations) > 0 {
+		fmt.Println("\nObjectives missed:")
+		for _, v := range violations {
+			fmt.Println("  " + v)
+		}
+		os.Exit(1)
+	}
+	fmt.Println("\nAll objectives met")
+}
+
+// requests are the requests of a load test at a location.
+type requests struct {
+	client          ppb.PanchangamClient
+	first           time.Time
+	days, rangeDays int
+	timeout         time.Duration
+	lat, lon        float64
+	tz, activity    string
+}
+
+// send sends the request i of a kind, for the date i modulo days after
+// first.
+func (q requests) send(ctx context.Context, kind string, i int) error {
+	ctx, cancel := context.WithTimeout(ctx, q.timeout)
+	defer cancel()
+	d := q.first.AddDate(0, 0, i%q.days).Format("2006-01-02")
+	var err error
+	switch kind {
+	case "single":
+		_, err = q.client.Get(ctx, &ppb.GetPanchangamRequest{Date: d, Latitude: q.lat, Longitude: q.lon, Timezone: q.tz})
+	case "range":
+		_, err = q.client.GetEvents(ctx, &ppb.GetEventsRequest{Date: d, Days: int32(q.rangeDays), Latitude: q.lat, Longitude: q.lon, Timezone: q.tz})
+	case "muhurta":
+		_, err = q.client.GetMuhurta(ctx, &ppb.GetMuhurtaRequest{Date: d, Days: int32(q.rangeDays), Latitude: q.lat, Longitude: q.lon, Timezone: q.tz, Activity: q.activity})
+	}
+	return err
+}
+
+// parseMix parses the weights of the kinds of requests, e.g.
+// single=70,range=30.
+func parseMix(s string) ([]weight, error) {
+	var weights []weight
+	for _, part := range strings.Split(s, ",") {
+		kind, w, ok := strings.Cut(strings.TrimSpace(part), "=")
+		n, err := strconv.Atoi(w)
+		if !ok || err != nil || n < 0 {
+			return nil, fmt.Errorf("invalid mix entry %q: expected kind=weight", part)
+		}
+		known := false
+		for _, k := range kinds {
+			known = known || k == kind
+		}
+		if !known {
+			return nil, fmt.Errorf("unknown kind of request %q: expected one of %s", kind, strings.Join(kinds, ", "))
+		}
+		if n > 0 {
+			weights = append(weights, weight{kind, n})
+		}
+	}
+	if len(weights) == 0 {
+		return nil, fmt.Errorf("mix %q has no requests", s)
+	}
+	return weights, nil
+}
+
+// pick returns a kind of request at random by weight.
+func pick(weights []weight, r *rand.Rand) string {
+	total := 0
+	for _, w := range weights {
+		total += w.weight
+	}
+	n := r.Intn(total)
+	for _, w := range weights {
+		if n < w.weight {
+			return w.kind
+		}
+		n -= w.weight
+	}
+	return weights[len(weights)-1].kind
+}
+
+// run starts requests at rate for d, with at most concurrency in flight,
+// and returns their results once all have ended.
+func run(send func(context.Context, string, int) error, weights []weight, rate float64, concurrency int, d time.Duration) []result {
+	var mu sync.Mutex
+	var results []result
+	var wg sync.WaitGroup
+	slots := make(chan struct{}, concurrency)
+	r := rand.New(rand.NewSource(time.Now().UnixNano()))
+	ticker := time.NewTicker(time.Duration(float64(time.Second) / rate))
+	defer ticker.Stop()
+	end := time.Now().Add(d)
+	for i := 0; time.Now().Before(end); i++ {
+		slots <- struct{}{}
+		kind := pick(weights, r)
+		wg.Add(1)
+		go func(i int) {
+			defer func() {
+				<-slots
+				wg.Done()
+			}()
+			begin := time.Now()
+			err := send(context.Background(), kind, i)
+			mu.Lock()
+			results = append(results, result{kind: kind, latency: time.Since(begin), code: status.Code(err).String()})
+			mu.Unlock()
+		}(i)
+		<-ticker.C
+	}
+	wg.Wait()
+	return results
+}
+
+// report prints the latencies and errors of each kind of request and
+// returns the objectives they missed. Latencies are those of successful
+// requests.
+func report(w io.Writer, results []result, elapsed time.Duration, slo objectives) []string {
+	byKind := map[string][]result{}
+	for _, r := range results {
+		byKind[r.kind] = append(byKind[r.kind], r)
+	}
+	fmt.Fprintf(w, "%d requests in %s (%.1f req/s)\n\n", len(results), elapsed.Round(time.Millisecond), float64(len(results))/elapsed.Seconds())
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', tabwriter.AlignRight)
+	fmt.Fprintln(tw, "kind\trequests\terrors\tp50\tp95\tp99\tmax\terror codes\t")
+	var violations []string
+	for _, kind := range kinds {
+		rs := byKind[kind]
+		if len(rs) == 0 {
+			continue
+		}
+		var latencies []time.Duration
+		codes := map[string]int{}
+		for _, r := range rs {
+			if r.code == "OK" {
+				latencies = append(latencies, r.latency)
+			} else {
+				codes[r.code]++
+			}
+		}
+		sort.Slice(latencies, func(i, j int) bool { return latencies[i] < latencies[j] })
+		failed := len(rs) - len(latencies)
+		p := func(n int) time.Duration { return percentile(latencies, n) }
+		fmt.Fprintf(tw, "%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t\n", kind, len(rs), failed,
+			roundLatency(p(50)), roundLatency(p(95)), roundLatency(p(99)), roundLatency(p(100)), formatCodes(codes))
+
+		for _, check := range []struct {
+			name        string
+			got, within time.Duration
+		}{{"p50", p(50), slo.p50}, {"p95", p(95), slo.p95}, {"p99", p(99), slo.p99}} {
+			if check.within > 0 && check.got > check.within {
+				violations = append(violations, fmt.Sprintf("%s %s latency %s exceeds %s", kind, check.name, roundLatency(check.got), check.within))
+			}
+		}
+		if rate := float64(failed) / float64(len(rs)); rate > slo.errorRate {
+			violations = append(violations, fmt.Sprintf("%s error rate %.2f%% exceeds %.2f%%", kind, 100*rate, 100*slo.errorRate))
+		}
+	}
+	tw.Flush()
+	return violations
+}
+
+// percentile returns the p-th percentile of sorted latencies by the
+// nearest rank, or zero if there are none.
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	return sorted[max(rank, 1)-1]
+}
+
+func roundLatency(d time.Duration) time.Duration {
+	if d < time.Millisecond {
+		return d.Round(time.Microsecond)
+	}
+	return d.Round(10 * time.Microsecond)
+}
+
+// formatCodes lists the counts of error codes, e.g. Unavailable=3.
+func formatCodes(codes map[string]int) string {
+	var parts []string
+	for code, n := range codes {
+		parts = append(parts, fmt.Sprintf("%s=%d", code, n))
+	}
+	sort.Strings(parts)
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, ",")
+}
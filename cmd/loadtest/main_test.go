@@ -0,0 +1,174 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// backend is a PanchangamClient answering every request with err and
+// remembering the requests it received and whether they had a deadline.
+type backend struct {
+	ppb.PanchangamClient
+
+	mu        sync.Mutex
+	requests  []proto.Message
+	deadlines []bool
+	err       error
+}
+
+func (b *backend) answer(ctx context.Context, req proto.Message) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	_, ok := ctx.Deadline()
+	b.requests = append(b.requests, req)
+	b.deadlines = append(b.deadlines, ok)
+	return b.err
+}
+
+func (b *backend) Get(ctx context.Context, in *ppb.GetPanchangamRequest, opts ...grpc.CallOption) (*ppb.GetPanchangamResponse, error) {
+	return &ppb.GetPanchangamResponse{}, b.answer(ctx, in)
+}
+
+func (b *backend) GetEvents(ctx context.Context, in *ppb.GetEventsRequest, opts ...grpc.CallOption) (*ppb.GetEventsResponse, error) {
+	return &ppb.GetEventsResponse{}, b.answer(ctx, in)
+}
+
+func (b *backend) GetMuhurta(ctx context.Context, in *ppb.GetMuhurtaRequest, opts ...grpc.CallOption) (*ppb.GetMuhurtaResponse, error) {
+	return &ppb.GetMuhurtaResponse{}, b.answer(ctx, in)
+}
+
+func TestSend(t *testing.T) {
+	b := &backend{}
+	q := requests{
+		client: b, first: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), days: 3, rangeDays: 7, timeout: time.Second,
+		lat: 19.076, lon: 72.8777, tz: "Asia/Kolkata", activity: "marriage",
+	}
+	for i, kind := range []string{"single", "range", "muhurta", "single"} {
+		if err := q.send(context.Background(), kind, i); err != nil {
+			t.Fatalf("send(%s, %d) error = %v", kind, i, err)
+		}
+	}
+	want := []proto.Message{
+		&ppb.GetPanchangamRequest{Date: "2024-04-01", Latitude: 19.076, Longitude: 72.8777, Timezone: "Asia/Kolkata"},
+		&ppb.GetEventsRequest{Date: "2024-04-02", Days: 7, Latitude: 19.076, Longitude: 72.8777, Timezone: "Asia/Kolkata"},
+		&ppb.GetMuhurtaRequest{Date: "2024-04-03", Days: 7, Latitude: 19.076, Longitude: 72.8777, Timezone: "Asia/Kolkata", Activity: "marriage"},
+		// The dates requested start over after days.
+		&ppb.GetPanchangamRequest{Date: "2024-04-01", Latitude: 19.076, Longitude: 72.8777, Timezone: "Asia/Kolkata"},
+	}
+	for i, req := range b.requests {
+		if !proto.Equal(req, want[i]) {
+			t.Errorf("request %d = %v, want %v", i, req, want[i])
+		}
+		if !b.deadlines[i] {
+			t.Errorf("request %d has no deadline", i)
+		}
+	}
+
+	b.err = status.Error(codes.Unavailable, "overloaded")
+	if err := q.send(context.Background(), "range", 0); status.Code(err) != codes.Unavailable {
+		t.Errorf("send() error = %v, want the backend's", err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	b := &backend{err: status.Error(codes.DeadlineExceeded, "slow")}
+	q := requests{client: b, first: time.Date(2024, 4, 1, 0, 0, 0, 0, time.UTC), days: 1, rangeDays: 1, timeout: time.Second}
+	results := run(q.send, []weight{{"range", 1}}, 1000, 4, 50*time.Millisecond)
+	if len(results) == 0 || len(results) != len(b.requests) {
+		t.Fatalf("run() returned %d results of %d requests", len(results), len(b.requests))
+	}
+	for _, r := range results {
+		if r.kind != "range" || r.code != "DeadlineExceeded" {
+			t.Errorf("result = %+v, want a failed range request", r)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	results := []result{
+		{kind: "single", latency: 10 * time.Millisecond, code: "OK"},
+		{kind: "single", latency: 20 * time.Millisecond, code: "OK"},
+		{kind: "single", latency: 30 * time.Millisecond, code: "OK"},
+		{kind: "single", latency: time.Second, code: "Unavailable"},
+		{kind: "range", latency: 2 * time.Millisecond, code: "OK"},
+		{kind: "range", latency: 1500 * time.Microsecond, code: "OK"},
+	}
+	var out strings.Builder
+	violations := report(&out, results, 2*time.Second, objectives{p50: 25 * time.Millisecond, p99: 25 * time.Millisecond, errorRate: 0.1})
+	want := []string{
+		"single p99 latency 30ms exceeds 25ms",
+		"single error rate 25.00% exceeds 10.00%",
+	}
+	if !reflect.DeepEqual(violations, want) {
+		t.Errorf("report() violations = %q, want %q", violations, want)
+	}
+	got := out.String()
+	for _, line := range []string{
+		"6 requests in 2s (3.0 req/s)\n",
+		"    kind  requests  errors    p50   p95   p99   max    error codes",
+		"  single         4       1   20ms  30ms  30ms  30ms  Unavailable=1",
+		"   range         2       0  1.5ms   2ms   2ms   2ms              -",
+	} {
+		if !strings.Contains(got, line) {
+			t.Errorf("report() output lacks %q:\n%s", line, got)
+		}
+	}
+	// Kinds without requests are left out.
+	if strings.Contains(got, "muhurta") {
+		t.Errorf("report() output lists muhurta:\n%s", got)
+	}
+
+	if violations := report(&out, results, time.Second, objectives{errorRate: 0.25}); len(violations) != 0 {
+		t.Errorf("report() with lax objectives = %q", violations)
+	}
+}
+
+func TestParseMix(t *testing.T) {
+	for _, tt := range []struct {
+		mix     string
+		want    []weight
+		wantErr string
+	}{
+		{"single=70,range=20,muhurta=10", []weight{{"single", 70}, {"range", 20}, {"muhurta", 10}}, ""},
+		{" single=1 , muhurta=0", []weight{{"single", 1}}, ""},
+		{"single", nil, `invalid mix entry "single": expected kind=weight`},
+		{"single=-1", nil, `invalid mix entry "single=-1": expected kind=weight`},
+		{"single=many", nil, `invalid mix entry "single=many": expected kind=weight`},
+		{"festival=5", nil, `unknown kind of request "festival": expected one of single, range, muhurta`},
+		{"range=0", nil, `mix "range=0" has no requests`},
+	} {
+		got, err := parseMix(tt.mix)
+		if tt.wantErr != "" {
+			if err == nil || err.Error() != tt.wantErr {
+				t.Errorf("parseMix(%q) error = %v, want %s", tt.mix, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("parseMix(%q) = %v, %v, want %v", tt.mix, got, err, tt.want)
+		}
+	}
+}
+
+func TestPick(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	weights := []weight{{"single", 3}, {"muhurta", 1}}
+	picked := map[string]int{}
+	for i := 0; i < 4000; i++ {
+		picked[pick(weights, r)]++
+	}
+	if len(picked) != 2 || picked["single"] < 2800 || picked["single"] > 3200 {
+		t.Errorf("pick() by weights 3:1 = %v", picked)
+	}
+}
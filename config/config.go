@@ -0,0 +1,186 @@
+// Package config supplies the defaults of command line flags from a YAML
+// file and the environment, so that settings such as the server address or
+// the observer location need not be repeated on every command.
+//
+// The keys of the file are flag names. Top-level keys apply to every
+// command with a flag of that name, and the keys of a section named after a
+// command apply to it alone:
+//
+//	addr: panchangam.example.com:50051
+//	l: chennai
+//	locale: ta
+//	calendar:
+//	  output: json
+//
+// A flag is taken, in order of precedence, from the command line, from the
+// environment variable PANCHANGAM_ followed by its name in upper case with
+// dashes replaced by underscores, e.g. PANCHANGAM_HTTP_ADDR, from the
+// command's section, and from the top level of the file.
+package config
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// EnvPrefix prefixes the names of the environment variables setting flags.
+const EnvPrefix = "PANCHANGAM_"
+
+// FileName is the name of the configuration file in the home directory.
+const FileName = ".panchangam.yaml"
+
+// File holds the flag values of a configuration file.
+type File struct {
+	values   map[string]string
+	sections map[string]map[string]string
+}
+
+// DefaultPath returns the path of the configuration file in the home
+// directory, or "" if there is no home directory.
+func DefaultPath() string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, FileName)
+}
+
+// Load reads the configuration file at path.
+func Load(path string) (*File, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal(data, &raw); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	f := &File{values: map[string]string{}, sections: map[string]map[string]string{}}
+	for key, value := range raw {
+		section, ok := value.(map[string]interface{})
+		if !ok {
+			f.values[key] = scalar(value)
+			continue
+		}
+		f.sections[key] = map[string]string{}
+		for k, v := range section {
+			if _, ok := v.(map[string]interface{}); ok {
+				return nil, fmt.Errorf("%s: %s.%s: sections cannot be nested", path, key, k)
+			}
+			f.sections[key][k] = scalar(v)
+		}
+	}
+	return f, nil
+}
+
+// scalar formats a YAML value as a flag value; lists become comma
+// separated.
+func scalar(v interface{}) string {
+	if list, ok := v.([]interface{}); ok {
+		items := make([]string, len(list))
+		for i, item := range list {
+			items[i] = fmt.Sprint(item)
+		}
+		return strings.Join(items, ",")
+	}
+	return fmt.Sprint(v)
+}
+
+// Apply sets the flags of fs from the top level of f and its section,
+// then from the environment. Keys for flags fs does not have are ignored,
+// since they are meant for other commands. Keys are applied in order, so
+// that a flag such as -l, which sets other flags, comes before them.
+func (f *File) Apply(fs *flag.FlagSet, section string) error {
+	if f != nil {
+		for _, values := range []map[string]string{f.values, f.sections[section]} {
+			if err := set(fs, values, "config"); err != nil {
+				return err
+			}
+		}
+	}
+	env := map[string]string{}
+	fs.VisitAll(func(fl *flag.Flag) {
+		if v, ok := os.LookupEnv(EnvName(fl.Name)); ok {
+			env[fl.Name] = v
+		}
+	})
+	return set(fs, env, "environment")
+}
+
+// set sets the flags of fs named in values, in the order of their names.
+func set(fs *flag.FlagSet, values map[string]string, source string) error {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		if fs.Lookup(name) != nil {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := fs.Set(name, values[name]); err != nil {
+			return fmt.Errorf("%s: invalid value %q for -%s: %w", source, values[name], name, err)
+		}
+	}
+	return nil
+}
+
+// EnvName returns the environment variable setting the flag name, e.g.
+// PANCHANGAM_HTTP_ADDR for http-addr.
+func EnvName(name string) string {
+	return EnvPrefix + strings.ToUpper(strings.ReplaceAll(name, "-", "_"))
+}
+
+// Parse registers a -config flag on fs, applies the configuration file it
+// names, or the one in the home directory if it exists, and the
+// environment to fs, and then parses args. section selects the section of
+// the file for the command, usually fs.Name().
+func Parse(fs *flag.FlagSet, args []string, section string) error {
+	path := fs.String("config", DefaultPath(), "Configuration file of flag defaults")
+	explicit := configArg(args)
+	if explicit != "" {
+		*path = explicit
+	}
+	var f *File
+	if *path != "" {
+		var err error
+		f, err = Load(*path)
+		if errors.Is(err, os.ErrNotExist) && explicit == "" {
+			f, err = nil, nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+	if err := f.Apply(fs, section); err != nil {
+		return err
+	}
+	return fs.Parse(args)
+}
+
+// configArg returns the value of the -config flag in args, if any, which
+// must be known before the other flags are parsed.
+func configArg(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		name := strings.TrimLeft(arg, "-")
+		if name == arg {
+			continue
+		}
+		if value, ok := strings.CutPrefix(name, "config="); ok {
+			return value
+		}
+		if name == "config" && i+1 < len(args) {
+			return args[i+1]
+		}
+	}
+	return ""
+}
@@ -0,0 +1,101 @@
+package config
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testConfig = `
+addr: panchangam.example.com:50051
+lat: 13.08
+locale: ta
+bodies: [sun, moon]
+calendar:
+  output: json
+  lat: 12.97
+`
+
+func newFlagSet(name string) (*flag.FlagSet, map[string]*string) {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	flags := map[string]*string{}
+	for _, f := range []string{"addr", "lat", "locale", "output", "bodies"} {
+		flags[f] = fs.String(f, "default", "")
+	}
+	return fs, flags
+}
+
+func TestParse(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "panchangam.yaml")
+	if err := os.WriteFile(path, []byte(testConfig), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("PANCHANGAM_LOCALE", "hi")
+
+	for _, tt := range []struct {
+		name    string
+		command string
+		args    []string
+		want    map[string]string
+	}{
+		{
+			name:    "top level and environment",
+			command: "get",
+			args:    []string{"-config", path},
+			want:    map[string]string{"addr": "panchangam.example.com:50051", "lat": "13.08", "locale": "hi", "output": "default", "bodies": "sun,moon"},
+		},
+		{
+			name:    "section overrides top level",
+			command: "calendar",
+			args:    []string{"-config=" + path},
+			want:    map[string]string{"lat": "12.97", "output": "json"},
+		},
+		{
+			name:    "command line overrides all",
+			command: "calendar",
+			args:    []string{"-locale", "en", "-config", path, "-lat", "1"},
+			want:    map[string]string{"lat": "1", "locale": "en", "output": "json"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			fs, flags := newFlagSet(tt.command)
+			if err := Parse(fs, tt.args, tt.command); err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+			for name, want := range tt.want {
+				if got := *flags[name]; got != want {
+					t.Errorf("-%s = %q, want %q", name, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestParseMissingFile(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	// A missing default file is fine; a missing named file is not.
+	fs, _ := newFlagSet("get")
+	if err := Parse(fs, nil, "get"); err != nil {
+		t.Errorf("Parse() without a config file error = %v", err)
+	}
+	fs, _ = newFlagSet("get")
+	if err := Parse(fs, []string{"-config", filepath.Join(t.TempDir(), "missing.yaml")}, "get"); err == nil {
+		t.Error("Parse() with a missing -config file succeeded")
+	}
+}
+
+func TestApplyInvalid(t *testing.T) {
+	fs := flag.NewFlagSet("get", flag.ContinueOnError)
+	fs.Int("days", 1, "")
+	t.Setenv("PANCHANGAM_DAYS", "many")
+	if err := (*File)(nil).Apply(fs, "get"); err == nil {
+		t.Error("Apply() accepted an invalid environment value")
+	}
+}
+
+func TestEnvName(t *testing.T) {
+	if got := EnvName("http-addr"); got != "PANCHANGAM_HTTP_ADDR" {
+		t.Errorf("EnvName(http-addr) = %s", got)
+	}
+}
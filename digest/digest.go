@@ -0,0 +1,258 @@
+// Package digest composes the weekly email digest of a location: the
+// highlights of the panchangam of each day, the festivals and ekadashis of
+// the week and the best muhurtas for some activities.
+//
+// Build computes a Digest locally, without a server; Render renders it as
+// the text and HTML parts of an Email in a locale, and SMTPConfig.Send
+// sends it. `client digest -dry-run` previews a digest without sending it.
+package digest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/i18n"
+	"github.com/naren-m/panchangam/location"
+	"github.com/naren-m/panchangam/muhurta"
+	"github.com/naren-m/panchangam/summary"
+)
+
+// Defaults of a Config.
+const (
+	// DefaultDays is the number of days of a digest, a week.
+	DefaultDays = 7
+	// DefaultMuhurtas is the number of muhurtas listed per activity.
+	DefaultMuhurtas = 3
+)
+
+// DefaultActivities are the activities whose muhurtas a digest lists when
+// its Config names none.
+var DefaultActivities = []string{"marriage", "griha_pravesh"}
+
+// ErrInvalidConfig is returned by Build for a Config it cannot compute a
+// digest of.
+var ErrInvalidConfig = errors.New("invalid digest configuration")
+
+// Config selects the location, dates and contents of a digest.
+type Config struct {
+	// Place is a human readable name of the location, e.g. "Chennai".
+	Place     string
+	Latitude  float64
+	Longitude float64
+	// Timezone is the IANA timezone of the dates and times of the digest,
+	// the timezone at the location when empty.
+	Timezone string
+	// Region selects the festivals, e.g. tamil_nadu; empty for all.
+	Region string
+	// Locale is the locale the digest is rendered in, English when empty.
+	Locale string
+	// Start is the first date of the digest in YYYY-MM-DD format, today
+	// when empty.
+	Start string
+	// Days is the number of days of the digest, DefaultDays when zero.
+	Days int
+	// Activities lists the activities whose muhurtas are listed, e.g.
+	// marriage, DefaultActivities when empty.
+	Activities []string
+	// Pack is the ID of the muhurta rule pack, muhurta.DefaultTradition
+	// when empty.
+	Pack string
+	// Tradition is the observance the ekadashis follow, smarta when empty.
+	Tradition festival.Tradition
+	// Muhurtas is the number of muhurtas listed per activity,
+	// DefaultMuhurtas when zero.
+	Muhurtas int
+}
+
+// Digest is the panchangam of the days of a Config.
+type Digest struct {
+	Place  string
+	Locale string
+	// First and Last are the midnights starting the first and last days.
+	First time.Time
+	Last  time.Time
+
+	Days      []Day
+	Festivals []Festival
+	Ekadashis []festival.Ekadashi
+	Muhurtas  []Muhurta
+}
+
+// Day holds the highlights of the panchangam of a day, see
+// summary.Day.Highlights.
+type Day struct {
+	Date       time.Time
+	Highlights []summary.Line
+}
+
+// Festival is a festival, vrat, sankranti or eclipse of the digest, named
+// in its locale.
+type Festival struct {
+	Date time.Time
+	Name string
+	Kind festival.Kind
+}
+
+// Muhurta is one of the best windows of the digest for an activity.
+type Muhurta struct {
+	Activity string
+	muhurta.Window
+}
+
+// Option configures Build.
+type Option func(*builder)
+
+type builder struct {
+	festivals festival.Source
+	packs     *muhurta.Packs
+	catalogs  *i18n.Catalogs
+}
+
+// WithFestivals sets the source of the festivals, festival.NewCatalogs()
+// by default.
+func WithFestivals(s festival.Source) Option {
+	return func(b *builder) { b.festivals = s }
+}
+
+// WithPacks sets the muhurta rule packs, muhurta.DefaultPacks() by default.
+func WithPacks(p *muhurta.Packs) Option {
+	return func(b *builder) { b.packs = p }
+}
+
+// WithCatalogs sets the catalogs naming the labels and festivals,
+// i18n.DefaultCatalogs() by default.
+func WithCatalogs(c *i18n.Catalogs) Option {
+	return func(b *builder) { b.catalogs = c }
+}
+
+// Build computes the digest of cfg.
+func Build(ctx context.Context, cfg Config, opts ...Option) (*Digest, error) {
+	b := builder{}
+	for _, opt := range opts {
+		opt(&b)
+	}
+	if b.festivals == nil {
+		b.festivals = festival.NewCatalogs()
+	}
+	if b.packs == nil {
+		b.packs = muhurta.DefaultPacks()
+	}
+	if b.catalogs == nil {
+		b.catalogs = i18n.DefaultCatalogs()
+	}
+	cfg = cfg.withDefaults()
+	if !b.catalogs.Supports(cfg.Locale) {
+		return nil, fmt.Errorf("%w: unsupported locale %q", ErrInvalidConfig, cfg.Locale)
+	}
+	pack, ok := b.packs.Get(cfg.Pack)
+	if !ok {
+		return nil, fmt.Errorf("%w: unknown muhurta rule pack %q", ErrInvalidConfig, cfg.Pack)
+	}
+	zone, err := cfg.zone()
+	if err != nil {
+		return nil, err
+	}
+	first := time.Now().In(zone)
+	if cfg.Start != "" {
+		if first, err = time.ParseInLocation("2006-01-02", cfg.Start, zone); err != nil {
+			return nil, fmt.Errorf("%w: invalid start %q: expected YYYY-MM-DD", ErrInvalidConfig, cfg.Start)
+		}
+	}
+	first = time.Date(first.Year(), first.Month(), first.Day(), 0, 0, 0, 0, zone)
+	last := first.AddDate(0, 0, cfg.Days-1)
+	loc := astronomy.Location{Latitude: cfg.Latitude, Longitude: cfg.Longitude}
+
+	d := &Digest{Place: cfg.Place, Locale: cfg.Locale, First: first, Last: last}
+	sun, err := astronomy.CalculateSunTimes(loc, first, astronomy.WithPolarFallback())
+	if err != nil {
+		return nil, err
+	}
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		next, err := astronomy.CalculateSunTimes(loc, date.AddDate(0, 0, 1), astronomy.WithPolarFallback())
+		if err != nil {
+			return nil, err
+		}
+		day := summary.Compute(date, sun.Sunrise, sun.Sunset, next.Sunrise)
+		d.Days = append(d.Days, Day{Date: date, Highlights: day.Highlights(b.catalogs, cfg.Locale)})
+		sun = next
+	}
+
+	rules, err := b.festivals.ForRegion(cfg.Region)
+	if err != nil {
+		return nil, err
+	}
+	events, err := rules.GenerateContext(ctx, first, last, cfg.Region, loc)
+	if err != nil {
+		return nil, err
+	}
+	for _, e := range events {
+		// Ekadashis are listed with their parana instead.
+		if e.Definition == "ekadashi" {
+			continue
+		}
+		date, err := time.ParseInLocation("2006-01-02", e.Date, zone)
+		if err != nil {
+			return nil, err
+		}
+		names := b.catalogs.FestivalNames(e.Definition, e.Names)
+		name := names[cfg.Locale]
+		if name == "" {
+			name = names[i18n.English]
+		}
+		d.Festivals = append(d.Festivals, Festival{Date: date, Name: name, Kind: e.Kind})
+	}
+
+	if d.Ekadashis, err = festival.Ekadashis(ctx, first, last, loc, cfg.Tradition); err != nil {
+		return nil, err
+	}
+
+	for _, activity := range cfg.Activities {
+		windows, err := pack.Search(activity, first, loc, muhurta.Constraints{}, cfg.Muhurtas, cfg.Days)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidConfig, err)
+		}
+		for _, w := range windows {
+			d.Muhurtas = append(d.Muhurtas, Muhurta{Activity: activity, Window: w})
+		}
+	}
+	return d, nil
+}
+
+// withDefaults returns cfg with its empty fields set to their defaults.
+func (cfg Config) withDefaults() Config {
+	if cfg.Locale == "" {
+		cfg.Locale = i18n.English
+	}
+	if cfg.Days <= 0 {
+		cfg.Days = DefaultDays
+	}
+	if len(cfg.Activities) == 0 {
+		cfg.Activities = DefaultActivities
+	}
+	if cfg.Pack == "" {
+		cfg.Pack = muhurta.DefaultTradition
+	}
+	if cfg.Tradition == "" {
+		cfg.Tradition = festival.SmartaTradition
+	}
+	if cfg.Muhurtas <= 0 {
+		cfg.Muhurtas = DefaultMuhurtas
+	}
+	return cfg
+}
+
+// zone returns the timezone of cfg.
+func (cfg Config) zone() (*time.Location, error) {
+	if cfg.Timezone == "" {
+		return location.Timezone(cfg.Latitude, cfg.Longitude)
+	}
+	zone, err := time.LoadLocation(cfg.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid timezone %q: %v", ErrInvalidConfig, cfg.Timezone, err)
+	}
+	return zone, nil
+}
@@ -0,0 +1,139 @@
+package digest
+
+import (
+	"context"
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"strings"
+	"testing"
+	"time"
+)
+
+func bengaluruConfig() Config {
+	return Config{
+		Place:     "Bengaluru",
+		Latitude:  12.9716,
+		Longitude: 77.5946,
+		Timezone:  "Asia/Kolkata",
+		Start:     "2024-04-09",
+		Days:      14,
+	}
+}
+
+func TestBuild(t *testing.T) {
+	d, err := Build(context.Background(), bengaluruConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Days) != 14 || d.Last.Format("2006-01-02") != "2024-04-22" {
+		t.Errorf("digest has %d days to %s, want 14 to 2024-04-22", len(d.Days), d.Last.Format("2006-01-02"))
+	}
+	if len(d.Days[0].Highlights) == 0 {
+		t.Error("first day has no highlights")
+	}
+	var names []string
+	for _, f := range d.Festivals {
+		names = append(names, f.Name)
+	}
+	if !strings.Contains(strings.Join(names, ","), "Ugadi") {
+		t.Errorf("festivals = %v, want Ugadi", names)
+	}
+	if len(d.Ekadashis) != 1 || d.Ekadashis[0].ParanaStart.IsZero() {
+		t.Errorf("ekadashis = %+v, want one with its parana", d.Ekadashis)
+	}
+	counts := map[string]int{}
+	for _, m := range d.Muhurtas {
+		counts[m.Activity]++
+		if m.Start.Before(d.First) {
+			t.Errorf("muhurta %v starts before the digest", m.Start)
+		}
+	}
+	for _, activity := range DefaultActivities {
+		if counts[activity] == 0 || counts[activity] > DefaultMuhurtas {
+			t.Errorf("%d muhurtas for %s, want 1 to %d", counts[activity], activity, DefaultMuhurtas)
+		}
+	}
+}
+
+func TestBuildInvalid(t *testing.T) {
+	for name, cfg := range map[string]Config{
+		"locale":   {Locale: "xx"},
+		"pack":     {Pack: "unknown"},
+		"activity": {Activities: []string{"unknown"}},
+		"timezone": {Timezone: "Nowhere/City"},
+		"start":    {Start: "9 April"},
+	} {
+		cfg.Latitude, cfg.Longitude = 12.9716, 77.5946
+		if _, err := Build(context.Background(), cfg); !errors.Is(err, ErrInvalidConfig) {
+			t.Errorf("%s: Build() error = %v, want ErrInvalidConfig", name, err)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	cfg := bengaluruConfig()
+	cfg.Locale = "ta"
+	d, err := Build(context.Background(), cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := Render(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.Subject != "2024-04-09 முதல் வார பஞ்சாங்கம் — Bengaluru" {
+		t.Errorf("Subject = %q", e.Subject)
+	}
+	for _, part := range []string{e.Text, e.HTML} {
+		for _, want := range []string{"Tue 9 Apr 2024", "பண்டிகைகள்", "griha pravesh"} {
+			if !strings.Contains(part, want) {
+				t.Errorf("digest lacks %q:\n%s", want, part)
+			}
+		}
+	}
+	if !strings.HasPrefix(e.HTML, "<!DOCTYPE html>") {
+		t.Errorf("HTML does not start with a doctype:\n%s", e.HTML)
+	}
+}
+
+func TestMIME(t *testing.T) {
+	e := Email{Subject: "பஞ்சாங்கம்", Text: "Tithi: Pratipada", HTML: "<p>Tithi: Pratipada</p>"}
+	data, err := e.MIME("Panchangam <digest@example.com>", []string{"a@example.com"}, time.Date(2024, 4, 9, 6, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg, err := mail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if subject, _ := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject")); subject != e.Subject {
+		t.Errorf("Subject = %q, want %q", subject, e.Subject)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q, want multipart/alternative", msg.Header.Get("Content-Type"))
+	}
+	r := multipart.NewReader(msg.Body, params["boundary"])
+	for _, want := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", e.Text},
+		{"text/html; charset=utf-8", e.HTML},
+	} {
+		p, err := r.NextPart()
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(p)
+		if p.Header.Get("Content-Type") != want.contentType || string(body) != want.body {
+			t.Errorf("part %s = %q, want %s %q", p.Header.Get("Content-Type"), body, want.contentType, want.body)
+		}
+	}
+}
+
+func TestSendWithoutRecipients(t *testing.T) {
+	if err := (SMTPConfig{Host: "localhost"}).Send(nil, Email{}); !errors.Is(err, ErrNoRecipients) {
+		t.Errorf("Send() error = %v, want ErrNoRecipients", err)
+	}
+}
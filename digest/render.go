@@ -0,0 +1,153 @@
+package digest
+
+import (
+	"fmt"
+	htmltemplate "html/template"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+
+	"github.com/naren-m/panchangam/i18n"
+)
+
+// Email is a rendered digest.
+type Email struct {
+	Subject string
+	// Text and HTML are the plain text and HTML alternatives of the body.
+	Text string
+	HTML string
+}
+
+// headings are the headings of a digest in one locale.
+type headings struct {
+	Subject   string
+	Week      string
+	Festivals string
+	Ekadashis string
+	Parana    string
+	Muhurtas  string
+	None      string
+}
+
+// localeHeadings holds the headings per locale. Locales without headings
+// are rendered with the English ones around their localized panchangam.
+var localeHeadings = map[string]headings{
+	i18n.English: {
+		Subject:   "Panchangam for the week of {date}",
+		Week:      "The week ahead",
+		Festivals: "Festivals",
+		Ekadashis: "Ekadashi",
+		Parana:    "Parana",
+		Muhurtas:  "Good muhurtas",
+		None:      "None this week",
+	},
+	"hi": {
+		Subject:   "{date} से सप्ताह का पंचांग",
+		Week:      "आगामी सप्ताह",
+		Festivals: "पर्व",
+		Ekadashis: "एकादशी",
+		Parana:    "पारण",
+		Muhurtas:  "शुभ मुहूर्त",
+		None:      "इस सप्ताह कोई नहीं",
+	},
+	"ta": {
+		Subject:   "{date} முதல் வார பஞ்சாங்கம்",
+		Week:      "வரும் வாரம்",
+		Festivals: "பண்டிகைகள்",
+		Ekadashis: "ஏகாதசி",
+		Parana:    "பாரணை",
+		Muhurtas:  "சுப முகூர்த்தங்கள்",
+		None:      "இந்த வாரம் இல்லை",
+	},
+}
+
+const textTemplate = `{{.H.Week}}{{with .D.Place}} — {{.}}{{end}}
+{{date .D.First}} – {{date .D.Last}}
+{{range .D.Days}}
+{{date .Date}}
+{{range .Highlights}}  {{.Label}}: {{.Value}}
+{{end}}{{end}}
+{{.H.Festivals}}
+{{range .D.Festivals}}  {{date .Date}}  {{.Name}}
+{{else}}  {{.H.None}}
+{{end}}
+{{.H.Ekadashis}}
+{{range .D.Ekadashis}}  {{date .Date}}  {{.Name}} ({{$.H.Parana}} {{span .ParanaStart .ParanaEnd}})
+{{else}}  {{.H.None}}
+{{end}}
+{{.H.Muhurtas}}
+{{range .D.Muhurtas}}  {{activity .Activity}}: {{span .Start .End}} ({{.Choghadiya}})
+{{else}}  {{.H.None}}
+{{end}}`
+
+const htmlTemplate = `<!DOCTYPE html>
+<html lang="{{.D.Locale}}">
+<head><meta charset="utf-8"><title>{{.Subject}}</title></head>
+<body style="font-family: sans-serif">
+<h1>{{.H.Week}}{{with .D.Place}} — {{.}}{{end}}</h1>
+<p>{{date .D.First}} – {{date .D.Last}}</p>
+{{range .D.Days}}<h3>{{date .Date}}</h3>
+<table>
+{{range .Highlights}}<tr><th align="left">{{.Label}}</th><td>{{.Value}}</td></tr>
+{{end}}</table>
+{{end}}<h2>{{.H.Festivals}}</h2>
+<ul>
+{{range .D.Festivals}}<li>{{date .Date}}: {{.Name}}</li>
+{{else}}<li>{{.H.None}}</li>
+{{end}}</ul>
+<h2>{{.H.Ekadashis}}</h2>
+<ul>
+{{range .D.Ekadashis}}<li>{{date .Date}}: {{.Name}} ({{$.H.Parana}} {{span .ParanaStart .ParanaEnd}})</li>
+{{else}}<li>{{.H.None}}</li>
+{{end}}</ul>
+<h2>{{.H.Muhurtas}}</h2>
+<ul>
+{{range .D.Muhurtas}}<li>{{activity .Activity}}: {{span .Start .End}} ({{.Choghadiya}})</li>
+{{else}}<li>{{.H.None}}</li>
+{{end}}</ul>
+</body>
+</html>
+`
+
+var templateFuncs = map[string]any{
+	"date": func(t time.Time) string { return t.Format("Mon 2 Jan 2006") },
+	// span formats a window, with the date of its start.
+	"span": func(start, end time.Time) string {
+		return start.Format("Mon 2 Jan 15:04") + "–" + end.Format("15:04")
+	},
+	// activity turns an activity ID into words, e.g. griha pravesh.
+	"activity": func(id string) string { return strings.ReplaceAll(id, "_", " ") },
+}
+
+var templates = sync.OnceValues(func() (*template.Template, *htmltemplate.Template) {
+	return template.Must(template.New("text").Funcs(templateFuncs).Parse(textTemplate)),
+		htmltemplate.Must(htmltemplate.New("html").Funcs(templateFuncs).Parse(htmlTemplate))
+})
+
+// Render renders d in its locale, with English headings for the locales
+// that have none.
+func Render(d *Digest) (Email, error) {
+	h, ok := localeHeadings[d.Locale]
+	if !ok {
+		h = localeHeadings[i18n.English]
+	}
+	data := struct {
+		D       *Digest
+		H       headings
+		Subject string
+	}{D: d, H: h, Subject: strings.ReplaceAll(h.Subject, "{date}", d.First.Format("2006-01-02"))}
+	if d.Place != "" {
+		data.Subject += " — " + d.Place
+	}
+
+	text, html := templates()
+	var t, b strings.Builder
+	if err := text.Execute(&t, data); err != nil {
+		return Email{}, fmt.Errorf("rendering %s text: %w", d.Locale, err)
+	}
+	if err := html.Execute(&b, data); err != nil {
+		return Email{}, fmt.Errorf("rendering %s html: %w", d.Locale, err)
+	}
+	return Email{Subject: data.Subject, Text: t.String(), HTML: b.String()}, nil
+}
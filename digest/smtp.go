@@ -0,0 +1,108 @@
+package digest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultSMTPPort is the submission port, on which servers offer STARTTLS.
+const DefaultSMTPPort = 587
+
+// ErrNoRecipients is returned by Send without recipients.
+var ErrNoRecipients = errors.New("no recipients")
+
+// SMTPConfig configures the server digests are sent through.
+type SMTPConfig struct {
+	Host string `json:"host"`
+	// Port is DefaultSMTPPort when zero.
+	Port int `json:"port,omitempty"`
+	// Username and Password authenticate with PLAIN auth, which net/smtp
+	// only allows over TLS or to localhost; none when Username is empty.
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	// From is the sender address, e.g. "Panchangam <digest@example.com>".
+	From string `json:"from"`
+}
+
+// Send sends e to the addresses of to through the server of c, upgrading
+// the connection with STARTTLS when the server offers it.
+func (c SMTPConfig) Send(to []string, e Email) error {
+	if len(to) == 0 {
+		return ErrNoRecipients
+	}
+	if c.Host == "" {
+		return errors.New("no SMTP host")
+	}
+	port := c.Port
+	if port == 0 {
+		port = DefaultSMTPPort
+	}
+	var auth smtp.Auth
+	if c.Username != "" {
+		auth = smtp.PlainAuth("", c.Username, c.Password, c.Host)
+	}
+	msg, err := e.MIME(c.From, to, time.Now())
+	if err != nil {
+		return err
+	}
+	from, err := mail.ParseAddress(c.From)
+	if err != nil {
+		return fmt.Errorf("invalid sender %q: %w", c.From, err)
+	}
+	rcpts := make([]string, len(to))
+	for i, addr := range to {
+		rcpt, err := mail.ParseAddress(addr)
+		if err != nil {
+			return fmt.Errorf("invalid recipient %q: %w", addr, err)
+		}
+		rcpts[i] = rcpt.Address
+	}
+	return smtp.SendMail(net.JoinHostPort(c.Host, strconv.Itoa(port)), auth, from.Address, rcpts, msg)
+}
+
+// MIME returns e as a multipart/alternative message from from to to, sent
+// at date, with quoted-printable text and HTML parts.
+func (e Email) MIME(from string, to []string, date time.Time) ([]byte, error) {
+	var buf bytes.Buffer
+	w := multipart.NewWriter(&buf)
+	fmt.Fprintf(&buf, "From: %s\r\n", from)
+	fmt.Fprintf(&buf, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(&buf, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", e.Subject))
+	fmt.Fprintf(&buf, "Date: %s\r\n", date.Format(time.RFC1123Z))
+	fmt.Fprintf(&buf, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(&buf, "Content-Type: multipart/alternative; boundary=%s\r\n\r\n", w.Boundary())
+	for _, part := range []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", e.Text},
+		{"text/html; charset=utf-8", e.HTML},
+	} {
+		pw, err := w.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		qp := quotedprintable.NewWriter(pw)
+		if _, err := qp.Write([]byte(part.body)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+	if err := w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
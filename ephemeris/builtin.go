@@ -0,0 +1,46 @@
+package ephemeris
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// BuiltinProvider computes positions with the series of the astronomy
+// package. It covers the sun and moon only.
+type BuiltinProvider struct{}
+
+// Name returns "builtin".
+func (BuiltinProvider) Name() string { return "builtin" }
+
+// Position returns the position of the sun or moon at t.
+func (b BuiltinProvider) Position(ctx context.Context, body Body, t time.Time) (Position, error) {
+	p, err := b.position(body, t)
+	if err != nil {
+		return Position{}, err
+	}
+	before, _ := b.position(body, t.Add(-speedWindow))
+	after, _ := b.position(body, t.Add(speedWindow))
+	p.Speed = speed(before, after)
+	return p, nil
+}
+
+// position returns the position of body at t without its speed.
+func (BuiltinProvider) position(body Body, t time.Time) (Position, error) {
+	jd := astronomy.JulianDayTT(t)
+	p := Position{Body: body, Time: t}
+	switch body {
+	case Sun:
+		p.Longitude = astronomy.SunLongitude(jd)
+		p.Distance = astronomy.SunDistance(jd)
+	case Moon:
+		p.Longitude = astronomy.MoonLongitude(jd)
+		p.Latitude = astronomy.MoonLatitude(jd)
+		p.Distance = astronomy.MoonDistance(jd) / AstronomicalUnit
+	default:
+		return Position{}, fmt.Errorf("%w: %s", ErrUnsupportedBody, body)
+	}
+	return p, nil
+}
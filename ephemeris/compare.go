@@ -0,0 +1,97 @@
+package ephemeris
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// Discrepancy summarizes how far the positions of a body from a provider
+// are from those of the reference provider over a comparison.
+type Discrepancy struct {
+	Provider string
+	Body     Body
+	// Samples is the number of times compared.
+	Samples int
+	// MaxLongitude and MaxLatitude are the largest absolute differences in
+	// arcseconds, and RMSLongitude and RMSLatitude their root mean squares.
+	MaxLongitude float64
+	RMSLongitude float64
+	MaxLatitude  float64
+	RMSLatitude  float64
+	// Worst is the time of the largest difference in longitude.
+	Worst time.Time
+}
+
+// Exceeds reports whether the largest difference in longitude or latitude
+// is over limit arcseconds.
+func (d Discrepancy) Exceeds(limit float64) bool {
+	return d.MaxLongitude > limit || d.MaxLatitude > limit
+}
+
+// Compare computes the positions of bodies from reference and each of
+// providers every step from start to end inclusive, and returns the
+// discrepancies of each provider and body in that order. Bodies that a
+// provider or the reference do not support are left out.
+func Compare(ctx context.Context, reference Provider, providers []Provider, bodies []Body, start, end time.Time, step time.Duration) ([]Discrepancy, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("comparison step %v is not positive", step)
+	}
+	if end.Before(start) {
+		return nil, fmt.Errorf("comparison end %s is before its start %s", end, start)
+	}
+
+	var result []Discrepancy
+	for _, p := range providers {
+		for _, body := range bodies {
+			d := Discrepancy{Provider: p.Name(), Body: body}
+			var sumLongitude, sumLatitude float64
+			for t := start; !t.After(end); t = t.Add(step) {
+				want, err := reference.Position(ctx, body, t)
+				if errors.Is(err, ErrUnsupportedBody) {
+					break
+				}
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", reference.Name(), err)
+				}
+				got, err := p.Position(ctx, body, t)
+				if errors.Is(err, ErrUnsupportedBody) {
+					break
+				}
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", p.Name(), err)
+				}
+
+				longitude := math.Abs(angleDifference(got.Longitude, want.Longitude)) * 3600
+				latitude := math.Abs(got.Latitude-want.Latitude) * 3600
+				if longitude > d.MaxLongitude || d.Samples == 0 {
+					d.MaxLongitude, d.Worst = longitude, t
+				}
+				d.MaxLatitude = math.Max(d.MaxLatitude, latitude)
+				sumLongitude += longitude * longitude
+				sumLatitude += latitude * latitude
+				d.Samples++
+			}
+			if d.Samples == 0 {
+				continue
+			}
+			d.RMSLongitude = math.Sqrt(sumLongitude / float64(d.Samples))
+			d.RMSLatitude = math.Sqrt(sumLatitude / float64(d.Samples))
+			result = append(result, d)
+		}
+	}
+	return result, nil
+}
+
+// angleDifference returns a-b in degrees, between -180 and 180.
+func angleDifference(a, b float64) float64 {
+	d := math.Mod(a-b, 360)
+	if d >= 180 {
+		d -= 360
+	} else if d < -180 {
+		d += 360
+	}
+	return d
+}
@@ -0,0 +1,60 @@
+package ephemeris
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+// offsetProvider shifts the positions of the builtin provider by a fixed
+// longitude.
+type offsetProvider struct {
+	offset float64
+}
+
+func (o offsetProvider) Name() string { return "offset" }
+
+func (o offsetProvider) Position(ctx context.Context, body Body, t time.Time) (Position, error) {
+	p, err := BuiltinProvider{}.Position(ctx, body, t)
+	p.Longitude = math.Mod(p.Longitude+o.offset+360, 360)
+	return p, err
+}
+
+func TestCompare(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	got, err := Compare(context.Background(), BuiltinProvider{}, []Provider{offsetProvider{-2.0 / 3600}},
+		[]Body{Sun, Moon, Mars}, start, start.AddDate(0, 0, 9), 24*time.Hour)
+	if err != nil {
+		t.Fatalf("Compare() error = %v", err)
+	}
+	// Mars is not supported by the builtin provider and is left out.
+	if len(got) != 2 || got[0].Body != Sun || got[1].Body != Moon {
+		t.Fatalf("Compare() = %+v, want the sun and moon", got)
+	}
+	for _, d := range got {
+		if d.Samples != 10 || math.Abs(d.MaxLongitude-2) > 1e-6 || math.Abs(d.RMSLongitude-2) > 1e-6 || d.MaxLatitude != 0 {
+			t.Errorf("%s discrepancy = %+v, want 10 samples 2 arcseconds apart in longitude", d.Body, d)
+		}
+		if !d.Exceeds(1) || d.Exceeds(3) {
+			t.Errorf("%s: Exceeds(1), Exceeds(3) = %v, %v", d.Body, d.Exceeds(1), d.Exceeds(3))
+		}
+	}
+
+	if _, err := Compare(context.Background(), BuiltinProvider{}, nil, Bodies, start, start.AddDate(0, 0, -1), time.Hour); err == nil {
+		t.Error("Compare() accepted an end before the start")
+	}
+}
+
+func TestAngleDifference(t *testing.T) {
+	for _, tt := range []struct{ a, b, want float64 }{
+		{10, 350, 20},
+		{350, 10, -20},
+		{180, 0, -180},
+		{0.5, 0.25, 0.25},
+	} {
+		if got := angleDifference(tt.a, tt.b); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("angleDifference(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,163 @@
+// Package ephemeris provides the positions of the sun, moon and planets from
+// interchangeable providers: the built-in approximations of the astronomy
+// package, and remote or file based ephemerides that can validate them.
+package ephemeris
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/log"
+)
+
+var logger = log.Logger()
+
+// AstronomicalUnit is the astronomical unit in kilometres.
+const AstronomicalUnit = 149597870.7
+
+// Body is a body whose position a Provider computes.
+type Body int
+
+const (
+	Sun Body = iota
+	Moon
+	Mercury
+	Venus
+	Mars
+	Jupiter
+	Saturn
+)
+
+// Bodies lists the bodies in order.
+var Bodies = []Body{Sun, Moon, Mercury, Venus, Mars, Jupiter, Saturn}
+
+var bodyNames = [...]string{"sun", "moon", "mercury", "venus", "mars", "jupiter", "saturn"}
+
+func (b Body) String() string {
+	if b < 0 || int(b) >= len(bodyNames) {
+		return fmt.Sprintf("Body(%d)", int(b))
+	}
+	return bodyNames[b]
+}
+
+// ParseBody returns the body with the given name, e.g. "moon".
+func ParseBody(s string) (Body, error) {
+	for i, name := range bodyNames {
+		if strings.EqualFold(s, name) {
+			return Body(i), nil
+		}
+	}
+	return 0, fmt.Errorf("unknown body %q", s)
+}
+
+// Position is the apparent geocentric position of a body, referred to the
+// ecliptic and equinox of date.
+type Position struct {
+	Body Body
+	Time time.Time
+	// Longitude and Latitude are the tropical ecliptic coordinates in
+	// degrees.
+	Longitude float64
+	Latitude  float64
+	// Distance is the distance from the centre of the Earth in
+	// astronomical units.
+	Distance float64
+	// Speed is the rate of change of the longitude in degrees per day.
+	Speed float64
+}
+
+// Retrograde reports whether the body appears to move westward against
+// the stars, its longitude decreasing.
+func (p Position) Retrograde() bool {
+	return p.Speed < 0
+}
+
+// speedWindow is the time either side of a position over which its speed
+// is taken.
+const speedWindow = 12 * time.Hour
+
+// speed returns the speed in longitude in degrees per day between the
+// positions before and after, taken speedWindow either side.
+func speed(before, after Position) float64 {
+	return angleDifference(after.Longitude, before.Longitude) * float64(24*time.Hour) / float64(2*speedWindow)
+}
+
+// ErrUnsupportedBody is returned by providers for bodies they cannot
+// compute.
+var ErrUnsupportedBody = errors.New("body not supported by provider")
+
+// Provider computes the positions of bodies.
+type Provider interface {
+	// Name identifies the provider, e.g. in the -provider flag.
+	Name() string
+	// Position returns the position of body at the instant t, whose
+	// Julian day in Terrestrial Time is astronomy.JulianDayTT(t).
+	Position(ctx context.Context, body Body, t time.Time) (Position, error)
+}
+
+// checkTime is when Check computes the position of the sun, J2000.0,
+// which every provider covers.
+var checkTime = time.Date(2000, 1, 1, 12, 0, 0, 0, time.UTC)
+
+// Check probes p by computing the position of the sun, returning the error
+// of a provider that cannot, e.g. for lack of its data files or network.
+func Check(ctx context.Context, p Provider) error {
+	pos, err := p.Position(ctx, Sun, checkTime)
+	if err != nil {
+		return err
+	}
+	if pos.Longitude < 0 || pos.Longitude >= 360 {
+		return fmt.Errorf("%s: sun longitude %g out of range", p.Name(), pos.Longitude)
+	}
+	return nil
+}
+
+var (
+	mu        sync.RWMutex
+	providers = map[string]Provider{}
+)
+
+// Register makes p available by its name, replacing any provider of the
+// same name.
+func Register(p Provider) {
+	mu.Lock()
+	defer mu.Unlock()
+	providers[p.Name()] = p
+}
+
+// Lookup returns the registered provider with the given name.
+func Lookup(name string) (Provider, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	p, ok := providers[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown ephemeris provider %q (have %s)", name, strings.Join(namesLocked(), ", "))
+	}
+	return p, nil
+}
+
+// Names returns the names of the registered providers in order.
+func Names() []string {
+	mu.RLock()
+	defer mu.RUnlock()
+	return namesLocked()
+}
+
+func namesLocked() []string {
+	names := make([]string, 0, len(providers))
+	for name := range providers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func init() {
+	Register(BuiltinProvider{})
+	Register(NewHorizonsProvider())
+}
@@ -0,0 +1,65 @@
+package ephemeris
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+)
+
+func TestParseBody(t *testing.T) {
+	for _, b := range Bodies {
+		got, err := ParseBody(b.String())
+		if err != nil || got != b {
+			t.Errorf("ParseBody(%q) = %v, %v", b.String(), got, err)
+		}
+	}
+	if _, err := ParseBody("pluto"); err == nil {
+		t.Error("ParseBody(pluto) succeeded")
+	}
+}
+
+func TestLookup(t *testing.T) {
+	for _, name := range []string{"builtin", "horizons"} {
+		p, err := Lookup(name)
+		if err != nil || p.Name() != name {
+			t.Errorf("Lookup(%q) = %v, %v", name, p, err)
+		}
+	}
+	if _, err := Lookup("nonesuch"); err == nil {
+		t.Error("Lookup(nonesuch) succeeded")
+	}
+}
+
+func TestBuiltinProvider(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sun, err := BuiltinProvider{}.Position(context.Background(), Sun, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(sun.Longitude-280.0) > 0.5 || math.Abs(sun.Distance-0.9833) > 0.001 {
+		t.Errorf("sun position = %+v", sun)
+	}
+	if _, err := (BuiltinProvider{}).Position(context.Background(), Mars, at); !errors.Is(err, ErrUnsupportedBody) {
+		t.Errorf("Position(Mars) error = %v, want ErrUnsupportedBody", err)
+	}
+}
+
+func TestCheck(t *testing.T) {
+	if err := Check(context.Background(), BuiltinProvider{}); err != nil {
+		t.Errorf("Check(builtin) = %v", err)
+	}
+	if err := Check(context.Background(), failingProvider{}); err == nil {
+		t.Error("Check() of a failing provider succeeded")
+	}
+}
+
+// failingProvider fails every computation.
+type failingProvider struct{}
+
+func (failingProvider) Name() string { return "failing" }
+
+func (failingProvider) Position(ctx context.Context, body Body, t time.Time) (Position, error) {
+	return Position{}, errors.New("no data")
+}
@@ -0,0 +1,163 @@
+// Package ephemeristest provides a scriptable ephemeris.Provider, for tests
+// of the code using providers, e.g. a plugin comparing or wrapping them or
+// the panchangam service, without the data files, network or latency of
+// the real providers.
+//
+// A Provider returns the positions it is given, fixed or as functions of
+// time, and fails or delays the computations it is told to:
+//
+//	p := ephemeristest.New("test")
+//	p.SetPosition(ephemeris.Sun, ephemeris.Position{Longitude: 24.2, Speed: 0.98})
+//	p.FailNext(2, errors.New("connection reset"))
+//	p.SetLatency(50 * time.Millisecond)
+package ephemeristest
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/ephemeris"
+)
+
+// Call is a computation requested from a Provider.
+type Call struct {
+	Body ephemeris.Body
+	Time time.Time
+}
+
+// Provider is an ephemeris.Provider computing the positions scripted by its
+// methods. Bodies without a position are unsupported, failing with
+// ephemeris.ErrUnsupportedBody. It is safe for concurrent use, and may be
+// scripted while in use.
+type Provider struct {
+	name string
+
+	mu        sync.Mutex
+	positions map[ephemeris.Body]func(time.Time) ephemeris.Position
+	errs      map[ephemeris.Body]error
+	err       error
+	next      []error
+	latency   time.Duration
+	calls     []Call
+}
+
+// New returns a provider named name without positions.
+func New(name string) *Provider {
+	return &Provider{
+		name:      name,
+		positions: map[ephemeris.Body]func(time.Time) ephemeris.Position{},
+		errs:      map[ephemeris.Body]error{},
+	}
+}
+
+// Name returns the name of p.
+func (p *Provider) Name() string { return p.name }
+
+// SetPosition makes p return pos for body at every time.
+func (p *Provider) SetPosition(body ephemeris.Body, pos ephemeris.Position) {
+	p.SetFunc(body, func(time.Time) ephemeris.Position { return pos })
+}
+
+// SetFunc makes p return the position f computes for body at a time, e.g.
+// that of Linear.
+func (p *Provider) SetFunc(body ephemeris.Body, f func(time.Time) ephemeris.Position) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.positions[body] = f
+}
+
+// Linear returns the positions of a body at longitude at the time at,
+// moving speed degrees a day, for SetFunc.
+func Linear(at time.Time, longitude, speed float64) func(time.Time) ephemeris.Position {
+	return func(t time.Time) ephemeris.Position {
+		l := math.Mod(longitude+speed*t.Sub(at).Hours()/24, 360)
+		if l < 0 {
+			l += 360
+		}
+		return ephemeris.Position{Longitude: l, Speed: speed}
+	}
+}
+
+// SetError makes the computations of body fail with err, until it is set
+// to nil.
+func (p *Provider) SetError(body ephemeris.Body, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if err == nil {
+		delete(p.errs, body)
+		return
+	}
+	p.errs[body] = err
+}
+
+// Fail makes every computation fail with err, as when the provider is
+// down, until it is set to nil.
+func (p *Provider) Fail(err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.err = err
+}
+
+// FailNext makes the next n computations fail with err, as when a remote
+// provider fails intermittently, e.g. to test retries.
+func (p *Provider) FailNext(n int, err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	for ; n > 0; n-- {
+		p.next = append(p.next, err)
+	}
+}
+
+// SetLatency delays every computation by d, or until its context is done.
+func (p *Provider) SetLatency(d time.Duration) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.latency = d
+}
+
+// Calls returns the computations requested from p, in order.
+func (p *Provider) Calls() []Call {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return append([]Call(nil), p.calls...)
+}
+
+// Position returns the position of body at t as scripted.
+func (p *Provider) Position(ctx context.Context, body ephemeris.Body, t time.Time) (ephemeris.Position, error) {
+	p.mu.Lock()
+	p.calls = append(p.calls, Call{body, t})
+	latency := p.latency
+	var err error
+	switch {
+	case len(p.next) > 0:
+		err, p.next = p.next[0], p.next[1:]
+	case p.err != nil:
+		err = p.err
+	default:
+		err = p.errs[body]
+	}
+	f := p.positions[body]
+	p.mu.Unlock()
+
+	if latency > 0 {
+		timer := time.NewTimer(latency)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ephemeris.Position{}, ctx.Err()
+		case <-timer.C:
+		}
+	}
+	if err != nil {
+		return ephemeris.Position{}, err
+	}
+	if f == nil {
+		return ephemeris.Position{}, fmt.Errorf("%w: %s", ephemeris.ErrUnsupportedBody, body)
+	}
+	pos := f(t)
+	pos.Body, pos.Time = body, t
+	return pos, nil
+}
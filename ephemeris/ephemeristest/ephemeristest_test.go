@@ -0,0 +1,100 @@
+package ephemeristest
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/ephemeris"
+)
+
+var start = time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC)
+
+func TestProvider(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")
+	p.SetPosition(ephemeris.Sun, ephemeris.Position{Longitude: 19.5, Speed: 0.98})
+	p.SetFunc(ephemeris.Moon, Linear(start, 355, 12))
+
+	if err := ephemeris.Check(ctx, p); err != nil {
+		t.Errorf("Check() = %v", err)
+	}
+	sun, err := p.Position(ctx, ephemeris.Sun, start)
+	if err != nil || sun != (ephemeris.Position{Body: ephemeris.Sun, Time: start, Longitude: 19.5, Speed: 0.98}) {
+		t.Errorf("Position(sun) = %+v, %v", sun, err)
+	}
+	if moon, err := p.Position(ctx, ephemeris.Moon, start.Add(12*time.Hour)); err != nil || moon.Longitude != 1 {
+		t.Errorf("Position(moon) half a day later = %+v, %v, want longitude 1", moon, err)
+	}
+	if _, err := p.Position(ctx, ephemeris.Mars, start); !errors.Is(err, ephemeris.ErrUnsupportedBody) {
+		t.Errorf("Position(mars) = %v, want ErrUnsupportedBody", err)
+	}
+	if calls := p.Calls(); len(calls) != 4 || calls[2] != (Call{ephemeris.Moon, start.Add(12 * time.Hour)}) {
+		t.Errorf("Calls() = %v", calls)
+	}
+}
+
+func TestProviderErrors(t *testing.T) {
+	ctx := context.Background()
+	p := New("test")
+	p.SetPosition(ephemeris.Sun, ephemeris.Position{Longitude: 19.5})
+	p.SetPosition(ephemeris.Moon, ephemeris.Position{Longitude: 120})
+	reset, down, missing := errors.New("connection reset"), errors.New("down"), errors.New("no moon file")
+
+	p.FailNext(2, reset)
+	p.SetError(ephemeris.Moon, missing)
+	for i, want := range []error{reset, reset, nil} {
+		if _, err := p.Position(ctx, ephemeris.Sun, start); !errors.Is(err, want) {
+			t.Errorf("Position(sun) %d = %v, want %v", i, err, want)
+		}
+	}
+	if _, err := p.Position(ctx, ephemeris.Moon, start); !errors.Is(err, missing) {
+		t.Errorf("Position(moon) = %v, want %v", err, missing)
+	}
+
+	p.Fail(down)
+	if _, err := p.Position(ctx, ephemeris.Sun, start); !errors.Is(err, down) {
+		t.Errorf("Position(sun) of a failed provider = %v, want %v", err, down)
+	}
+	p.Fail(nil)
+	p.SetError(ephemeris.Moon, nil)
+	if _, err := p.Position(ctx, ephemeris.Moon, start); err != nil {
+		t.Errorf("Position(moon) after recovery = %v", err)
+	}
+}
+
+func TestProviderLatency(t *testing.T) {
+	p := New("test")
+	p.SetPosition(ephemeris.Sun, ephemeris.Position{Longitude: 19.5})
+	p.SetLatency(20 * time.Millisecond)
+	begin := time.Now()
+	if _, err := p.Position(context.Background(), ephemeris.Sun, start); err != nil || time.Since(begin) < 20*time.Millisecond {
+		t.Errorf("Position() = %v after %v, want it after 20ms", err, time.Since(begin))
+	}
+
+	p.SetLatency(time.Hour)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := p.Position(ctx, ephemeris.Sun, start); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Position() past its deadline = %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestRashiIngresses(t *testing.T) {
+	// The moon is a degree before the end of Mesha, and so enters
+	// Vrishabha two hours later at twelve degrees a day.
+	const tropical = 60.0
+	sidereal := astronomy.SiderealLongitude(tropical, astronomy.JulianDayTT(start))
+	p := New("test")
+	p.SetFunc(ephemeris.Moon, Linear(start, tropical-sidereal+29, 12))
+
+	ingresses, err := ephemeris.RashiIngresses(context.Background(), p, ephemeris.Moon, start, start.Add(24*time.Hour))
+	if err != nil || len(ingresses) != 1 {
+		t.Fatalf("RashiIngresses() = %v, %v, want one ingress", ingresses, err)
+	}
+	if in := ingresses[0]; in.Rashi != 2 || in.Time.Sub(start.Add(2*time.Hour)).Abs() > time.Minute {
+		t.Errorf("ingress = %+v, want Vrishabha around 02:00", in)
+	}
+}
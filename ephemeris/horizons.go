@@ -0,0 +1,283 @@
+package ephemeris
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/cache"
+)
+
+// HorizonsURL is the address of the NASA/JPL Horizons API.
+const HorizonsURL = "https://ssd.jpl.nasa.gov/api/horizons.api"
+
+// horizonsCommands are the Horizons target codes of the bodies.
+var horizonsCommands = map[Body]string{
+	Sun:     "10",
+	Moon:    "301",
+	Mercury: "199",
+	Venus:   "299",
+	Mars:    "499",
+	Jupiter: "599",
+	Saturn:  "699",
+}
+
+// HorizonsProvider queries the NASA/JPL Horizons system for positions from
+// the JPL development ephemerides. It is far more precise, and far slower,
+// than the built-in series, so it serves to validate them.
+//
+// Positions are cached, requests are spaced out so as not to overload the
+// service, and requests failing with a network or server error are retried
+// with exponential backoff.
+type HorizonsProvider struct {
+	url      string
+	client   *http.Client
+	retries  int
+	backoff  time.Duration
+	interval time.Duration
+	cache    *cache.Cache[Position]
+	sleep    func(context.Context, time.Duration) error
+
+	mu   sync.Mutex
+	next time.Time
+	now  func() time.Time
+}
+
+// HorizonsOption configures a HorizonsProvider.
+type HorizonsOption func(*HorizonsProvider)
+
+// WithHorizonsURL sends requests to url instead of HorizonsURL.
+func WithHorizonsURL(url string) HorizonsOption {
+	return func(h *HorizonsProvider) {
+		h.url = url
+	}
+}
+
+// WithHTTPClient sends requests with client instead of a client with a 30
+// second timeout.
+func WithHTTPClient(client *http.Client) HorizonsOption {
+	return func(h *HorizonsProvider) {
+		h.client = client
+	}
+}
+
+// WithRetries sets how often a failed request is retried, 3 by default.
+// The first retry waits backoff and each further one twice as long.
+func WithRetries(retries int, backoff time.Duration) HorizonsOption {
+	return func(h *HorizonsProvider) {
+		h.retries = retries
+		h.backoff = backoff
+	}
+}
+
+// WithRequestInterval sets the shortest time between the starts of two
+// requests, one second by default.
+func WithRequestInterval(interval time.Duration) HorizonsOption {
+	return func(h *HorizonsProvider) {
+		h.interval = interval
+	}
+}
+
+// NewHorizonsProvider returns a provider querying the Horizons API.
+func NewHorizonsProvider(opts ...HorizonsOption) *HorizonsProvider {
+	h := &HorizonsProvider{
+		url:      HorizonsURL,
+		client:   &http.Client{Timeout: 30 * time.Second},
+		retries:  3,
+		backoff:  time.Second,
+		interval: time.Second,
+		// Positions never change, so they are only evicted for space.
+		cache: cache.New[Position](cache.WithName("horizons"), cache.WithTTL(365*24*time.Hour)),
+		sleep: sleep,
+		now:   time.Now,
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+// Name returns "horizons".
+func (h *HorizonsProvider) Name() string { return "horizons" }
+
+// Position returns the position of body at t.
+func (h *HorizonsProvider) Position(ctx context.Context, body Body, t time.Time) (Position, error) {
+	command, ok := horizonsCommands[body]
+	if !ok {
+		return Position{}, fmt.Errorf("%w: %s", ErrUnsupportedBody, body)
+	}
+	jd := astronomy.JulianDayTT(t)
+	key := fmt.Sprintf("%s@%.8f", command, jd)
+	p, err := h.cache.Get(ctx, key, func(ctx context.Context) (Position, error) {
+		return h.fetch(ctx, body, command, jd)
+	})
+	if err != nil {
+		return Position{}, err
+	}
+	p.Time = t
+	return p, nil
+}
+
+// fetch requests the position of body, whose Horizons code is command, at
+// the Julian day jd, retrying failures.
+func (h *HorizonsProvider) fetch(ctx context.Context, body Body, command string, jd float64) (Position, error) {
+	window := speedWindow.Hours() / 24
+	query := url.Values{
+		"format":     {"json"},
+		"COMMAND":    {"'" + command + "'"},
+		"OBJ_DATA":   {"'NO'"},
+		"MAKE_EPHEM": {"'YES'"},
+		"EPHEM_TYPE": {"'OBSERVER'"},
+		"CENTER":     {"'500@399'"},
+		// The positions either side give the speed.
+		"TLIST":      {horizonsTimes(jd-window, jd, jd+window)},
+		"TLIST_TYPE": {"'JD'"},
+		// The Julian days are in Terrestrial Time, see astronomy.JulianDayTT.
+		"TIME_TYPE": {"'TT'"},
+		// Range, and the apparent ecliptic longitude and latitude of date.
+		"QUANTITIES": {"'20,31'"},
+		"CSV_FORMAT": {"'YES'"},
+		"EXTRA_PREC": {"'YES'"},
+	}
+	address := h.url + "?" + query.Encode()
+
+	backoff := h.backoff
+	for attempt := 0; ; attempt++ {
+		p, retry, err := h.request(ctx, address)
+		if err == nil {
+			p.Body = body
+			return p, nil
+		}
+		if !retry || attempt >= h.retries {
+			return Position{}, fmt.Errorf("horizons %s at JD %.5f: %w", body, jd, err)
+		}
+		logger.WarnContext(ctx, "Retrying Horizons request", "body", body.String(), "attempt", attempt+1, "error", err)
+		if err := h.sleep(ctx, backoff); err != nil {
+			return Position{}, err
+		}
+		backoff *= 2
+	}
+}
+
+// horizonsTimes formats the Julian days jds as a TLIST value.
+func horizonsTimes(jds ...float64) string {
+	quoted := make([]string, len(jds))
+	for i, jd := range jds {
+		quoted[i] = "'" + strconv.FormatFloat(jd, 'f', 8, 64) + "'"
+	}
+	return strings.Join(quoted, " ")
+}
+
+// request makes a single request to address once the request interval
+// allows, and reports whether a failure is worth retrying. The response
+// lists the positions before, at and after the requested time.
+func (h *HorizonsProvider) request(ctx context.Context, address string) (p Position, retry bool, err error) {
+	if err := h.wait(ctx); err != nil {
+		return Position{}, false, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, address, nil)
+	if err != nil {
+		return Position{}, false, err
+	}
+	resp, err := h.client.Do(req)
+	if err != nil {
+		return Position{}, ctx.Err() == nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Position{}, true, err
+	}
+
+	var result struct {
+		Result string `json:"result"`
+		Error  string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &result); err != nil && resp.StatusCode == http.StatusOK {
+		return Position{}, false, fmt.Errorf("decoding response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		retry := resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500
+		if result.Error != "" {
+			return Position{}, retry, fmt.Errorf("%s: %s", resp.Status, result.Error)
+		}
+		return Position{}, retry, fmt.Errorf("%s", resp.Status)
+	}
+	if result.Error != "" {
+		return Position{}, false, fmt.Errorf("%s", result.Error)
+	}
+	positions, err := parseHorizonsResult(result.Result)
+	if err != nil {
+		return Position{}, false, err
+	}
+	if len(positions) != 3 {
+		return Position{}, false, fmt.Errorf("got %d positions, want 3", len(positions))
+	}
+	p = positions[1]
+	p.Speed = speed(positions[0], positions[2])
+	return p, false, nil
+}
+
+// wait blocks until the request interval has passed since the start of the
+// previous request.
+func (h *HorizonsProvider) wait(ctx context.Context) error {
+	h.mu.Lock()
+	now := h.now()
+	start := h.next
+	if start.Before(now) {
+		start = now
+	}
+	h.next = start.Add(h.interval)
+	h.mu.Unlock()
+	if d := start.Sub(now); d > 0 {
+		return h.sleep(ctx, d)
+	}
+	return nil
+}
+
+// parseHorizonsResult reads the positions from the lines of the ephemeris
+// table in result, between the $$SOE and $$EOE markers. Their CSV columns
+// are the date, the solar and lunar presence markers, the range in
+// astronomical units and its rate, and the ecliptic longitude and latitude.
+func parseHorizonsResult(result string) ([]Position, error) {
+	start := strings.Index(result, "$$SOE")
+	end := strings.Index(result, "$$EOE")
+	if start < 0 || end < start {
+		return nil, fmt.Errorf("no ephemeris in response: %.200q", result)
+	}
+	var positions []Position
+	for _, line := range strings.Split(strings.TrimSpace(result[start+len("$$SOE"):end]), "\n") {
+		fields := strings.Split(line, ",")
+		var values []float64
+		for _, f := range fields[1:] {
+			if v, err := strconv.ParseFloat(strings.TrimSpace(f), 64); err == nil {
+				values = append(values, v)
+			}
+		}
+		if len(values) < 4 {
+			return nil, fmt.Errorf("unexpected ephemeris line %q", line)
+		}
+		positions = append(positions, Position{Distance: values[0], Longitude: values[2], Latitude: values[3]})
+	}
+	return positions, nil
+}
+
+// sleep waits for d or until ctx is done.
+func sleep(ctx context.Context, d time.Duration) error {
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,123 @@
+package ephemeris
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// horizonsMoon is a response in the format Horizons returns for a query of
+// the range and ecliptic coordinates of the moon.
+const horizonsMoon = `{"signature":{"version":"1.2","source":"NASA/JPL Horizons API"},"result":"*******************************************************************************\n Date__(TT)__HR:MN:SC.fff, , ,             delta,     deldot,    ObsEcLon,    ObsEcLat,\n*******************************************************************************\n$$SOE\n 2023-Dec-31 12:00:00.000, , , 0.00256990121480,  0.2079146, 156.7419583,   4.9835112,\n 2024-Jan-01 00:00:00.000, , , 0.00257968497864,  0.2453183, 163.2183012,   4.8792251,\n 2024-Jan-01 12:00:00.000, , , 0.00259011822109,  0.2801204, 169.6901875,   4.7171390,\n$$EOE\n*******************************************************************************\n"}`
+
+func TestHorizonsProvider(t *testing.T) {
+	var requests, failures atomic.Int32
+	failures.Store(1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failures.Add(-1) >= 0 {
+			http.Error(w, `{"error":"busy"}`, http.StatusServiceUnavailable)
+			return
+		}
+		if got := r.URL.Query().Get("COMMAND"); got != "'301'" {
+			t.Errorf("COMMAND = %s, want '301'", got)
+		}
+		if got, want := r.URL.Query().Get("TLIST"), "'2460310.00000000' '2460310.50000000' '2460311.00000000'"; got != want {
+			t.Errorf("TLIST = %s, want %s", got, want)
+		}
+		fmt.Fprint(w, horizonsMoon)
+	}))
+	defer server.Close()
+
+	h := NewHorizonsProvider(WithHorizonsURL(server.URL), WithRetries(2, time.Millisecond), WithRequestInterval(0))
+	// 2024-01-01 00:00 TT, 69.184 seconds ahead of UTC.
+	at := time.Date(2023, 12, 31, 23, 58, 50, 816e6, time.UTC)
+	p, err := h.Position(context.Background(), Moon, at)
+	if err != nil {
+		t.Fatalf("Position() error = %v", err)
+	}
+	want := Position{Body: Moon, Time: at, Longitude: 163.2183012, Latitude: 4.8792251, Distance: 0.00257968497864, Speed: 12.9482292}
+	if math.Abs(p.Speed-want.Speed) > 1e-6 {
+		t.Errorf("Position() speed = %v, want %v", p.Speed, want.Speed)
+	}
+	p.Speed = want.Speed
+	if p != want {
+		t.Errorf("Position() = %+v, want %+v", p, want)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("made %d requests, want 2 with one retry", n)
+	}
+
+	// The position is cached.
+	if _, err := h.Position(context.Background(), Moon, at); err != nil {
+		t.Fatalf("Position() error = %v", err)
+	}
+	if n := requests.Load(); n != 2 {
+		t.Errorf("made %d requests after a cached read, want 2", n)
+	}
+
+	if _, err := h.Position(context.Background(), Body(-1), at); !errors.Is(err, ErrUnsupportedBody) {
+		t.Errorf("Position(Body(-1)) error = %v, want ErrUnsupportedBody", err)
+	}
+}
+
+func TestHorizonsProviderErrors(t *testing.T) {
+	var requests atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		w.WriteHeader(http.StatusBadRequest)
+		fmt.Fprint(w, `{"error":"invalid COMMAND"}`)
+	}))
+	defer server.Close()
+
+	h := NewHorizonsProvider(WithHorizonsURL(server.URL), WithRetries(3, time.Millisecond), WithRequestInterval(0))
+	_, err := h.Position(context.Background(), Sun, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	if err == nil {
+		t.Fatal("Position() succeeded on a bad request")
+	}
+	if n := requests.Load(); n != 1 {
+		t.Errorf("made %d requests, want 1: client errors are not retried", n)
+	}
+}
+
+func TestHorizonsProviderInterval(t *testing.T) {
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	var waits []time.Duration
+	h := NewHorizonsProvider(WithRequestInterval(time.Second))
+	h.now = func() time.Time { return now }
+	h.sleep = func(ctx context.Context, d time.Duration) error {
+		waits = append(waits, d)
+		return nil
+	}
+	for i := 0; i < 3; i++ {
+		if err := h.wait(context.Background()); err != nil {
+			t.Fatal(err)
+		}
+	}
+	now = now.Add(5 * time.Second)
+	if err := h.wait(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if fmt.Sprint(waits) != "[1s 2s]" {
+		t.Errorf("waited %v, want [1s 2s]", waits)
+	}
+}
+
+func TestParseHorizonsResult(t *testing.T) {
+	if _, err := parseHorizonsResult("No ephemeris for target"); err == nil {
+		t.Error("parseHorizonsResult() accepted a result without an ephemeris")
+	}
+	positions, err := parseHorizonsResult("$$SOE\n 2024-Jan-01 00:00:00.000,*,m, 0.98331,  -0.01, 280.1,  0.0001,\n$$EOE")
+	if err != nil || len(positions) != 1 {
+		t.Fatalf("parseHorizonsResult() = %v, %v", positions, err)
+	}
+	if p := positions[0]; p.Longitude != 280.1 || p.Latitude != 0.0001 || p.Distance != 0.98331 {
+		t.Errorf("parseHorizonsResult() = %+v", positions[0])
+	}
+}
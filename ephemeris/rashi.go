@@ -0,0 +1,91 @@
+package ephemeris
+
+import (
+	"context"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// RashiIngress is the entry of a body into a sidereal sign.
+type RashiIngress struct {
+	Body Body
+	// Rashi is the position of the sign entered starting from Mesha = 1,
+	// and Name its name, e.g. "Mithuna".
+	Rashi int
+	Name  string
+	Time  time.Time
+}
+
+// ingressPrecision is the precision to which ingresses are found.
+const ingressPrecision = time.Second
+
+// ingressScanStep returns the time between the signs of body sampled to
+// find its ingresses, shorter than the least time it stays in a sign: two
+// days for the moon, and weeks for the sun and planets but for Mercury
+// around its stations.
+func ingressScanStep(body Body) time.Duration {
+	if body == Moon {
+		return 6 * time.Hour
+	}
+	return 24 * time.Hour
+}
+
+// Rashi returns the sidereal sign of pos starting from Mesha = 1, with the
+// Lahiri ayanamsa used throughout the astronomy package.
+func Rashi(pos Position) int {
+	sidereal := astronomy.SiderealLongitude(pos.Longitude, astronomy.JulianDayTT(pos.Time))
+	return int(sidereal/30)%12 + 1
+}
+
+// RashiIngresses returns the entries of body into sidereal signs from start
+// to end, in order, using the positions of p. A retrograde planet may
+// enter the sign it left.
+func RashiIngresses(ctx context.Context, p Provider, body Body, start, end time.Time) ([]RashiIngress, error) {
+	step := ingressScanStep(body)
+	pos, err := p.Position(ctx, body, start)
+	if err != nil {
+		return nil, err
+	}
+	rashi := Rashi(pos)
+	var ingresses []RashiIngress
+	for lo := start; lo.Before(end); lo = lo.Add(step) {
+		hi := lo.Add(step)
+		if hi.After(end) {
+			hi = end
+		}
+		pos, err := p.Position(ctx, body, hi)
+		if err != nil {
+			return nil, err
+		}
+		next := Rashi(pos)
+		if next == rashi {
+			continue
+		}
+		t, err := findIngress(ctx, p, body, lo, hi, rashi)
+		if err != nil {
+			return nil, err
+		}
+		ingresses = append(ingresses, RashiIngress{Body: body, Rashi: next, Name: astronomy.RashiName(next), Time: t})
+		rashi = next
+	}
+	return ingresses, nil
+}
+
+// findIngress returns the time between lo and hi at which body leaves
+// rashi, the sign it is in at lo.
+func findIngress(ctx context.Context, p Provider, body Body, lo, hi time.Time, rashi int) (time.Time, error) {
+	for hi.Sub(lo) > ingressPrecision {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		pos, err := p.Position(ctx, body, mid)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if Rashi(pos) == rashi {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi, nil
+}
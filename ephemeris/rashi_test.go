@@ -0,0 +1,50 @@
+package ephemeris
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestRashiIngressesSun(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ingresses, err := RashiIngresses(context.Background(), BuiltinProvider{}, Sun, start, start.AddDate(1, 0, 0))
+	if err != nil {
+		t.Fatalf("RashiIngresses() error = %v", err)
+	}
+	// The ingresses of the sun are the sankrantis.
+	sankrantis := astronomy.Sankrantis(start, start.AddDate(1, 0, 0))
+	if len(ingresses) != len(sankrantis) {
+		t.Fatalf("RashiIngresses() = %d ingresses, want %d", len(ingresses), len(sankrantis))
+	}
+	for i, s := range sankrantis {
+		got := ingresses[i]
+		if got.Rashi != s.Number || got.Name != s.Rashi || got.Time.Sub(s.Time).Abs() > 2*time.Second {
+			t.Errorf("ingress %d = %s at %s, want %s at %s", i, got.Name, got.Time, s.Rashi, s.Time)
+		}
+	}
+}
+
+func TestRashiIngressesMoon(t *testing.T) {
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	ingresses, err := RashiIngresses(context.Background(), BuiltinProvider{}, Moon, start, start.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatalf("RashiIngresses() error = %v", err)
+	}
+	// The moon crosses the twelve signs in 27.3 days.
+	if len(ingresses) < 13 || len(ingresses) > 14 {
+		t.Fatalf("RashiIngresses() = %d ingresses in a month, want 13 or 14", len(ingresses))
+	}
+	for i, in := range ingresses {
+		before, _ := BuiltinProvider{}.Position(context.Background(), Moon, in.Time.Add(-2*time.Second))
+		after, _ := BuiltinProvider{}.Position(context.Background(), Moon, in.Time.Add(time.Second))
+		if Rashi(before) == in.Rashi || Rashi(after) != in.Rashi {
+			t.Errorf("ingress %d into %s at %s is not when the moon enters it", i, in.Name, in.Time)
+		}
+		if i > 0 && in.Rashi != ingresses[i-1].Rashi%12+1 {
+			t.Errorf("ingress %d into %s does not follow %s", i, in.Name, ingresses[i-1].Name)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package ephemeris
+
+import (
+	"context"
+	"time"
+)
+
+// RetrogradePeriod is a period in which a planet is retrograde, from its
+// station retrograde to its station direct.
+type RetrogradePeriod struct {
+	Body  Body
+	Start time.Time
+	End   time.Time
+}
+
+const (
+	// retrogradeScanStep is the time between the speeds sampled to find
+	// stations. It is shorter than any retrograde or direct period, the
+	// shortest being the three weeks of Mercury.
+	retrogradeScanStep = 5 * 24 * time.Hour
+	// retrogradeMargin is how far the scan extends either side of a year,
+	// to find the stations of periods overlapping it. It is longer than
+	// any retrograde period, the longest being the 140 days of Saturn.
+	retrogradeMargin = 160 * 24 * time.Hour
+	// stationPrecision is the precision to which stations are found.
+	stationPrecision = time.Hour
+)
+
+// GetRetrogradePeriods returns the retrograde periods of planet that
+// overlap the given year in UTC, using the positions of p. The sun and moon
+// are never retrograde.
+func GetRetrogradePeriods(ctx context.Context, p Provider, planet Body, year int) ([]RetrogradePeriod, error) {
+	if planet == Sun || planet == Moon {
+		return nil, nil
+	}
+	first := time.Date(year, 1, 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(1, 0, 0)
+
+	var periods []RetrogradePeriod
+	var start time.Time
+	t := first.Add(-retrogradeMargin)
+	previous, err := p.Position(ctx, planet, t)
+	if err != nil {
+		return nil, err
+	}
+	for t = t.Add(retrogradeScanStep); !t.After(last.Add(retrogradeMargin)); t = t.Add(retrogradeScanStep) {
+		pos, err := p.Position(ctx, planet, t)
+		if err != nil {
+			return nil, err
+		}
+		if pos.Retrograde() != previous.Retrograde() {
+			station, err := findStation(ctx, p, planet, previous.Time, t, previous.Retrograde())
+			if err != nil {
+				return nil, err
+			}
+			if pos.Retrograde() {
+				start = station
+			} else if !start.IsZero() {
+				if station.After(first) && start.Before(last) {
+					periods = append(periods, RetrogradePeriod{Body: planet, Start: start, End: station})
+				}
+				start = time.Time{}
+			}
+		}
+		previous = pos
+	}
+	return periods, nil
+}
+
+// findStation returns the time between lo and hi at which planet turns
+// from retrograde to direct motion or back; retrograde is its motion at lo.
+func findStation(ctx context.Context, p Provider, planet Body, lo, hi time.Time, retrograde bool) (time.Time, error) {
+	for hi.Sub(lo) > stationPrecision {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		pos, err := p.Position(ctx, planet, mid)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if pos.Retrograde() == retrograde {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return lo.Add(hi.Sub(lo) / 2), nil
+}
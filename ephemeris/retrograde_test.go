@@ -0,0 +1,66 @@
+package ephemeris
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+)
+
+// epicycleProvider moves a planet along an epicycle, retrograde for the
+// middle third of each 120 day synodic period starting at epoch.
+type epicycleProvider struct {
+	epoch time.Time
+}
+
+func (e epicycleProvider) Name() string { return "epicycle" }
+
+func (e epicycleProvider) Position(ctx context.Context, body Body, t time.Time) (Position, error) {
+	const period = 120.0
+	omega := 2 * math.Pi / period
+	days := t.Sub(e.epoch).Hours() / 24
+	// The speed 0.5 - cos(ωt) is negative while cos(ωt) > 0.5, within 20
+	// days of each multiple of the period.
+	return Position{
+		Body:      body,
+		Time:      t,
+		Longitude: math.Mod(0.5*days-math.Sin(omega*days)/omega+3600, 360),
+		Speed:     0.5 - math.Cos(omega*days),
+	}, nil
+}
+
+func TestGetRetrogradePeriods(t *testing.T) {
+	epoch := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	periods, err := GetRetrogradePeriods(context.Background(), epicycleProvider{epoch}, Mars, 2024)
+	if err != nil {
+		t.Fatalf("GetRetrogradePeriods() error = %v", err)
+	}
+	// Periods are centred on days 0, 120, 240 and 360 of 2024; the first
+	// began in 2023.
+	if len(periods) != 4 {
+		t.Fatalf("GetRetrogradePeriods() = %d periods, want 4: %v", len(periods), periods)
+	}
+	for i, p := range periods {
+		centre := epoch.AddDate(0, 0, 120*i)
+		wantStart, wantEnd := centre.AddDate(0, 0, -20), centre.AddDate(0, 0, 20)
+		if p.Body != Mars || p.Start.Sub(wantStart).Abs() > stationPrecision || p.End.Sub(wantEnd).Abs() > stationPrecision {
+			t.Errorf("period %d = %v - %v, want %v - %v", i, p.Start, p.End, wantStart, wantEnd)
+		}
+	}
+
+	if periods, err := GetRetrogradePeriods(context.Background(), epicycleProvider{epoch}, Sun, 2024); err != nil || len(periods) != 0 {
+		t.Errorf("GetRetrogradePeriods(Sun) = %v, %v, want none", periods, err)
+	}
+}
+
+func TestBuiltinProviderSpeed(t *testing.T) {
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	sun, _ := BuiltinProvider{}.Position(context.Background(), Sun, at)
+	moon, _ := BuiltinProvider{}.Position(context.Background(), Moon, at)
+	if math.Abs(sun.Speed-1.019) > 0.005 || sun.Retrograde() {
+		t.Errorf("sun speed = %v°/day, want about 1.019", sun.Speed)
+	}
+	if moon.Speed < 11.5 || moon.Speed > 15.5 {
+		t.Errorf("moon speed = %v°/day, want 11.5-15.5", moon.Speed)
+	}
+}
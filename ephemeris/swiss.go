@@ -0,0 +1,106 @@
+//go:build swisseph
+
+// The Swiss Ephemeris provider links the Swiss Ephemeris C library, which
+// must be installed with its headers. Build with:
+//
+//	go build -tags swisseph ./...
+
+package ephemeris
+
+/*
+#cgo LDFLAGS: -lswe -lm
+#include <stdlib.h>
+#include <swephexp.h>
+*/
+import "C"
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+	"unsafe"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// swissBodies are the Swiss Ephemeris planet numbers of the bodies.
+var swissBodies = map[Body]C.int{
+	Sun:     C.SE_SUN,
+	Moon:    C.SE_MOON,
+	Mercury: C.SE_MERCURY,
+	Venus:   C.SE_VENUS,
+	Mars:    C.SE_MARS,
+	Jupiter: C.SE_JUPITER,
+	Saturn:  C.SE_SATURN,
+}
+
+// swissPathEnv is the environment variable the Swiss Ephemeris itself
+// reads its data files from, in preference to the path it is given.
+const swissPathEnv = "SE_EPHE_PATH"
+
+// swissMu serializes calls into the Swiss Ephemeris, which keeps global
+// state and is not safe for concurrent use.
+var swissMu sync.Mutex
+
+// SwissProvider computes positions with the Swiss Ephemeris from its .se1
+// data files. Positions outside the range of the files are an error rather
+// than a silent fallback to the less precise Moshier ephemeris.
+type SwissProvider struct {
+	path string
+}
+
+// NewSwissProvider returns a provider reading the Swiss Ephemeris files in
+// the directory path. An empty path falls back to SE_EPHE_PATH, then to
+// the library default. Since the library would prefer SE_EPHE_PATH to an
+// explicit path, setting one clears the variable.
+func NewSwissProvider(path string) *SwissProvider {
+	if path == "" {
+		return &SwissProvider{path: os.Getenv(swissPathEnv)}
+	}
+	os.Unsetenv(swissPathEnv)
+	return &SwissProvider{path: path}
+}
+
+// Name returns "swiss".
+func (s *SwissProvider) Name() string { return "swiss" }
+
+// Position returns the position of body at t.
+func (s *SwissProvider) Position(ctx context.Context, body Body, t time.Time) (Position, error) {
+	planet, ok := swissBodies[body]
+	if !ok {
+		return Position{}, fmt.Errorf("%w: %s", ErrUnsupportedBody, body)
+	}
+	var xx [6]C.double
+	var serr [C.AS_MAXCH]C.char
+
+	swissMu.Lock()
+	defer swissMu.Unlock()
+	// The path is global to the library; set it on every call in case
+	// another provider changed it.
+	path := C.CString(s.path)
+	defer C.free(unsafe.Pointer(path))
+	C.swe_set_ephe_path(path)
+	// swe_calc expects the Julian day in Terrestrial Time.
+	flags := C.swe_calc(C.double(astronomy.JulianDayTT(t)), planet, C.SEFLG_SWIEPH|C.SEFLG_SPEED, &xx[0], &serr[0])
+	if flags < 0 {
+		return Position{}, fmt.Errorf("swiss ephemeris %s: %s", body, C.GoString(&serr[0]))
+	}
+	if flags&C.SEFLG_SWIEPH == 0 {
+		return Position{}, fmt.Errorf("swiss ephemeris %s: no data files for %s in %q", body, t.Format("2006-01-02"), s.path)
+	}
+	return Position{
+		Body:      body,
+		Time:      t,
+		Longitude: float64(xx[0]),
+		Latitude:  float64(xx[1]),
+		Distance:  float64(xx[2]),
+		Speed:     float64(xx[3]),
+	}, nil
+}
+
+func init() {
+	// Servers replace it with one reading the files of -ephemeris-path.
+	Register(NewSwissProvider(""))
+}
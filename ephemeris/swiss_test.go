@@ -0,0 +1,45 @@
+//go:build swisseph
+
+package ephemeris
+
+import (
+	"context"
+	"math"
+	"os"
+	"testing"
+	"time"
+)
+
+func TestNewSwissProviderPath(t *testing.T) {
+	t.Setenv(swissPathEnv, "/usr/share/sweph")
+	if p := NewSwissProvider(""); p.path != "/usr/share/sweph" {
+		t.Errorf("NewSwissProvider(\"\") path = %q, want that of %s", p.path, swissPathEnv)
+	}
+	// An explicit path wins, and the library no longer overrides it.
+	if p := NewSwissProvider("/srv/ephe"); p.path != "/srv/ephe" {
+		t.Errorf("NewSwissProvider(/srv/ephe) path = %q", p.path)
+	}
+	if path, ok := os.LookupEnv(swissPathEnv); ok {
+		t.Errorf("%s = %q after an explicit path, want it cleared", swissPathEnv, path)
+	}
+}
+
+func TestSwissProvider(t *testing.T) {
+	p, err := Lookup("swiss")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	swiss, err := p.Position(context.Background(), Moon, at)
+	if err != nil {
+		t.Skipf("Swiss Ephemeris files unavailable (set SE_EPHE_PATH): %v", err)
+	}
+	builtin, err := BuiltinProvider{}.Position(context.Background(), Moon, at)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The built-in series are good to a few hundredths of a degree.
+	if d := math.Abs(swiss.Longitude - builtin.Longitude); d > 0.05 {
+		t.Errorf("moon longitude %.5f differs from the built-in %.5f by %.5f°", swiss.Longitude, builtin.Longitude, d)
+	}
+}
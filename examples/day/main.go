@@ -0,0 +1,54 @@
+// Command day prints the panchangam of a date at a location using the
+// astronomy package directly, without a server.
+//
+//	go run ./examples/day -date 2024-04-30 -lat 19.0760 -lon 72.8777 -tz Asia/Kolkata
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func main() {
+	date := flag.String("date", time.Now().Format("2006-01-02"), "Date in YYYY-MM-DD format")
+	lat := flag.Float64("lat", 19.0760, "Latitude in degrees, positive north")
+	lon := flag.Float64("lon", 72.8777, "Longitude in degrees, positive east")
+	tz := flag.String("tz", "Asia/Kolkata", "IANA timezone name")
+	flag.Parse()
+
+	location, err := time.LoadLocation(*tz)
+	if err != nil {
+		log.Fatal(err)
+	}
+	day, err := time.ParseInLocation("2006-01-02", *date, location)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := run(os.Stdout, day, astronomy.Location{Latitude: *lat, Longitude: *lon}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run prints the panchangam of the civil date of day at loc.
+func run(w io.Writer, day time.Time, loc astronomy.Location) error {
+	sunTimes, err := astronomy.CalculateSunTimes(loc, day)
+	if err != nil {
+		return err
+	}
+	// Elements are those prevailing at sunrise.
+	e := astronomy.CalculateElements(sunTimes.Sunrise)
+	masa := astronomy.CalculateMasa(sunTimes.Sunrise)
+	abhijit := astronomy.AbhijitMuhurta(sunTimes.Sunrise, sunTimes.Sunset)
+
+	fmt.Fprintf(w, "%s, %s masa, %s paksha\n", day.Format("Monday 2 January 2006"), masa.Name, astronomy.TithiPaksha(e.Tithi))
+	fmt.Fprintf(w, "Sunrise %s, sunset %s\n", sunTimes.Sunrise.Format("15:04"), sunTimes.Sunset.Format("15:04"))
+	fmt.Fprintf(w, "Tithi %s, nakshatra %s, yoga %s, karana %s\n", e.Tithi.Name, e.Nakshatra.Name, e.Yoga.Name, e.Karana.Name)
+	fmt.Fprintf(w, "Abhijit Muhurta %s - %s\n", abhijit.Start.Format("15:04"), abhijit.End.Format("15:04"))
+	return nil
+}
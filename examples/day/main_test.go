@@ -0,0 +1,19 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func Example() {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	mumbai := astronomy.Location{Latitude: 19.0760, Longitude: 72.8777}
+	run(os.Stdout, time.Date(2024, 4, 30, 0, 0, 0, 0, ist), mumbai)
+	// Output:
+	// Tuesday 30 April 2024, Chaitra masa, krishna paksha
+	// Sunrise 06:10, sunset 19:00
+	// Tithi Krishna Shashthi, nakshatra Uttara Ashadha, yoga Sadhya, karana Vanija
+	// Abhijit Muhurta 12:10 - 13:01
+}
@@ -0,0 +1,59 @@
+// Command ekadashi prints the next ekadashi vrats after a date using the
+// built-in festival definitions.
+//
+//	go run ./examples/ekadashi -date 2024-04-30 -count 3
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/festival"
+)
+
+func main() {
+	date := flag.String("date", time.Now().Format("2006-01-02"), "Date to search from in YYYY-MM-DD format")
+	count := flag.Int("count", 2, "Number of ekadashis to print")
+	lat := flag.Float64("lat", 19.0760, "Latitude in degrees, positive north")
+	lon := flag.Float64("lon", 72.8777, "Longitude in degrees, positive east")
+	tz := flag.String("tz", "Asia/Kolkata", "IANA timezone name")
+	flag.Parse()
+
+	location, err := time.LoadLocation(*tz)
+	if err != nil {
+		log.Fatal(err)
+	}
+	from, err := time.ParseInLocation("2006-01-02", *date, location)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := run(os.Stdout, from, *count, astronomy.Location{Latitude: *lat, Longitude: *lon}); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run prints the first count ekadashis observed at loc from the civil date
+// of from.
+func run(w io.Writer, from time.Time, count int, loc astronomy.Location) error {
+	// Ekadashi recurs every fortnight, so each month holds at least two.
+	for found := 0; found < count; from = from.AddDate(0, 1, 0) {
+		events, err := festival.DefaultRuleSet().Generate(from, from.AddDate(0, 1, -1), "", loc)
+		if err != nil {
+			return err
+		}
+		for _, e := range events {
+			if e.Definition != "ekadashi" || found == count {
+				continue
+			}
+			date, _ := time.Parse("2006-01-02", e.Date)
+			fmt.Fprintf(w, "%s %s\n", date.Format("Mon 2 Jan 2006"), e.Tithi)
+			found++
+		}
+	}
+	return nil
+}
@@ -0,0 +1,18 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func Example() {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	mumbai := astronomy.Location{Latitude: 19.0760, Longitude: 72.8777}
+	run(os.Stdout, time.Date(2024, 4, 30, 0, 0, 0, 0, ist), 3, mumbai)
+	// Output:
+	// Sat 4 May 2024 Krishna Ekadashi
+	// Sun 19 May 2024 Shukla Ekadashi
+	// Sun 2 Jun 2024 Krishna Ekadashi
+}
@@ -0,0 +1,71 @@
+// Command muhurta scores the choghadiyas of the coming days for an activity
+// with a built-in rule pack and prints the best one.
+//
+//	go run ./examples/muhurta -date 2024-04-30 -activity marriage -tradition smarta
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/muhurta"
+)
+
+func main() {
+	date := flag.String("date", time.Now().Format("2006-01-02"), "First date in YYYY-MM-DD format")
+	days := flag.Int("days", 7, "Number of days to search")
+	activity := flag.String("activity", "griha_pravesh", "Activity, e.g. marriage, griha_pravesh or travel")
+	tradition := flag.String("tradition", muhurta.DefaultTradition, "Rule pack of the tradition, e.g. smarta, vaishnava or tamil")
+	lat := flag.Float64("lat", 19.0760, "Latitude in degrees, positive north")
+	lon := flag.Float64("lon", 72.8777, "Longitude in degrees, positive east")
+	tz := flag.String("tz", "Asia/Kolkata", "IANA timezone name")
+	flag.Parse()
+
+	location, err := time.LoadLocation(*tz)
+	if err != nil {
+		log.Fatal(err)
+	}
+	first, err := time.ParseInLocation("2006-01-02", *date, location)
+	if err != nil {
+		log.Fatal(err)
+	}
+	loc := astronomy.Location{Latitude: *lat, Longitude: *lon}
+	if err := run(os.Stdout, *tradition, *activity, first, first.AddDate(0, 0, *days-1), loc); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// run prints the highest scoring window for activity from the civil date of
+// first to that of last, and how many windows were found.
+func run(w io.Writer, tradition, activity string, first, last time.Time, loc astronomy.Location) error {
+	pack, ok := muhurta.DefaultPacks().Get(tradition)
+	if !ok {
+		return fmt.Errorf("unknown tradition %q: expected one of %s", tradition, strings.Join(muhurta.DefaultPacks().IDs(), ", "))
+	}
+	windows, err := pack.Find(activity, first, last, loc)
+	if err != nil {
+		return err
+	}
+	if len(windows) == 0 {
+		fmt.Fprintf(w, "No muhurta for %s\n", activity)
+		return nil
+	}
+	best := windows[0]
+	for _, window := range windows[1:] {
+		if window.Score > best.Score {
+			best = window
+		}
+	}
+	fmt.Fprintf(w, "%d windows for %s (%s)\n", len(windows), activity, pack.Name)
+	fmt.Fprintf(w, "Best: %s - %s, %s, score %d\n", best.Start.Format("Mon 2 Jan 15:04"), best.End.Format("15:04"), best.Choghadiya, best.Score)
+	for _, reason := range best.Reasons {
+		fmt.Fprintf(w, "  %s\n", reason)
+	}
+	return nil
+}
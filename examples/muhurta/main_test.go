@@ -0,0 +1,21 @@
+package main
+
+import (
+	"os"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func Example() {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	mumbai := astronomy.Location{Latitude: 19.0760, Longitude: 72.8777}
+	first := time.Date(2024, 4, 30, 0, 0, 0, 0, ist)
+	run(os.Stdout, "smarta", "griha_pravesh", first, first.AddDate(0, 0, 6), mumbai)
+	// Output:
+	// 6 windows for griha_pravesh (Smarta)
+	// Best: Fri 3 May 03:22 - 04:45, Shubh, score 5
+	//   nakshatra Shatabhisha +2
+	//   weekday Thursday +1
+	//   choghadiya Shubh +2
+}
@@ -0,0 +1,207 @@
+package festival
+
+import (
+	"container/list"
+	"context"
+	"fmt"
+	"io/fs"
+	"sync"
+
+	"github.com/naren-m/panchangam/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+var logger = log.Logger()
+
+// Source provides the festival and vrat definitions of a region. Both
+// RuleSet and Catalogs are sources.
+type Source interface {
+	// ForRegion returns a rule set holding at least the definitions
+	// observed in region, or in every region when region is empty.
+	ForRegion(region string) (*RuleSet, error)
+}
+
+// DefaultMaxCatalogs is the number of regions whose definitions Catalogs
+// keeps parsed by default.
+const DefaultMaxCatalogs = 8
+
+// Names of the metrics of catalogs.
+const (
+	// CatalogLoadsMetric counts the regions parsed, by region.
+	CatalogLoadsMetric = "festival.catalog.loads"
+	// CatalogBytesMetric is the size of the definition files the cached
+	// catalogs were parsed from.
+	CatalogBytesMetric = "festival.catalog.bytes"
+)
+
+// CatalogOption configures Catalogs.
+type CatalogOption func(*Catalogs)
+
+// WithDefinitions adds definitions, e.g. from ReadDir, to the catalog of
+// every region, replacing the built-in ones with the same ID.
+func WithDefinitions(definitions ...Definition) CatalogOption {
+	return func(c *Catalogs) {
+		c.extra = append(c.extra, definitions...)
+	}
+}
+
+// WithMaxCatalogs sets the number of regions kept parsed.
+func WithMaxCatalogs(n int) CatalogOption {
+	return func(c *Catalogs) {
+		c.max = n
+	}
+}
+
+// Catalogs parses the built-in definitions of a region when it is first
+// asked for and keeps those of the most recently used regions, so that a
+// deployment serving a single region neither parses nor holds the
+// definitions of the others.
+type Catalogs struct {
+	fsys  fs.FS
+	extra []Definition
+	max   int
+
+	mu sync.Mutex
+	// lru orders the cached catalogs from the most recently used.
+	lru     *list.List
+	regions map[string]*list.Element
+	bytes   int64
+
+	loads metric.Int64Counter
+}
+
+// catalog is the parsed rule set of a region.
+type catalog struct {
+	region string
+	rules  *RuleSet
+	size   int64
+	// known is false for regions without definitions of their own.
+	known bool
+}
+
+// NewCatalogs returns Catalogs of the built-in definitions.
+func NewCatalogs(opts ...CatalogOption) *Catalogs {
+	c := &Catalogs{
+		fsys:    builtinData,
+		max:     DefaultMaxCatalogs,
+		lru:     list.New(),
+		regions: map[string]*list.Element{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	meter := otel.Meter("github.com/naren-m/panchangam/festival")
+	var err error
+	c.loads, err = meter.Int64Counter(CatalogLoadsMetric,
+		metric.WithDescription("Regional festival catalogs parsed, by region"))
+	if err != nil {
+		logger.Error("Failed to create catalog load counter", "error", err)
+	}
+	bytes, err := meter.Int64ObservableGauge(CatalogBytesMetric,
+		metric.WithDescription("Size of the definition files of the cached festival catalogs"),
+		metric.WithUnit("By"))
+	if err != nil {
+		logger.Error("Failed to create catalog size gauge", "error", err)
+		return c
+	}
+	_, err = meter.RegisterCallback(func(_ context.Context, o metric.Observer) error {
+		o.ObserveInt64(bytes, c.Bytes())
+		return nil
+	}, bytes)
+	if err != nil {
+		logger.Error("Failed to register catalog size callback", "error", err)
+	}
+	return c
+}
+
+// ForRegion returns the definitions observed in region, parsing them on
+// first use. An unknown region gets the definitions observed everywhere.
+func (c *Catalogs) ForRegion(region string) (*RuleSet, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if e, ok := c.regions[region]; ok {
+		c.lru.MoveToFront(e)
+		return e.Value.(*catalog).rules, nil
+	}
+
+	cat, err := c.load(region)
+	if err != nil {
+		return nil, err
+	}
+	if c.loads != nil {
+		// Regions come from requests, so unknown ones share a label.
+		label := region
+		if !cat.known {
+			label = "other"
+		}
+		c.loads.Add(context.Background(), 1, metric.WithAttributes(attribute.String("region", label)))
+	}
+	c.regions[region] = c.lru.PushFront(cat)
+	c.bytes += cat.size
+	for c.max > 0 && c.lru.Len() > c.max {
+		oldest := c.lru.Remove(c.lru.Back()).(*catalog)
+		delete(c.regions, oldest.region)
+		c.bytes -= oldest.size
+	}
+	return cat.rules, nil
+}
+
+// load parses the catalog of region.
+func (c *Catalogs) load(region string) (*catalog, error) {
+	patterns := []string{commonPattern}
+	known := true
+	if region == "" {
+		patterns = append(patterns, regionsPattern)
+	} else if file := "data/regions/" + region + ".json"; validRegion(region) && fileExists(c.fsys, file) {
+		patterns = append(patterns, file)
+	} else {
+		known = false
+	}
+	rules := &RuleSet{}
+	size, err := rules.loadFS(c.fsys, patterns...)
+	if err != nil {
+		return nil, fmt.Errorf("loading definitions of region %q: %w", region, err)
+	}
+	if err := rules.Add(c.extra...); err != nil {
+		return nil, err
+	}
+	return &catalog{region: region, rules: rules, size: size, known: known}, nil
+}
+
+func fileExists(fsys fs.FS, name string) bool {
+	_, err := fs.Stat(fsys, name)
+	return err == nil
+}
+
+// validRegion reports whether region can name a definition file, which
+// keeps it from matching other files as a pattern.
+func validRegion(region string) bool {
+	for _, r := range region {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '_' && r != '-' {
+			return false
+		}
+	}
+	return true
+}
+
+// Bytes returns the size of the definition files of the cached catalogs.
+func (c *Catalogs) Bytes() int64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.bytes
+}
+
+// Regions returns the regions whose catalogs are cached, from the most
+// recently used.
+func (c *Catalogs) Regions() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	regions := make([]string, 0, c.lru.Len())
+	for e := c.lru.Front(); e != nil; e = e.Next() {
+		regions = append(regions, e.Value.(*catalog).region)
+	}
+	return regions
+}
@@ -0,0 +1,151 @@
+package festival
+
+import (
+	"encoding/json"
+	"io/fs"
+	"path"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func ids(s *RuleSet) map[string]bool {
+	ids := map[string]bool{}
+	for _, d := range s.Definitions() {
+		ids[d.ID] = true
+	}
+	return ids
+}
+
+func TestCatalogsForRegion(t *testing.T) {
+	c := NewCatalogs()
+	tamil, err := c.ForRegion("tamil_nadu")
+	if err != nil {
+		t.Fatalf("ForRegion(tamil_nadu) error = %v", err)
+	}
+	got := ids(tamil)
+	if !got["karthigai-deepam"] || !got["ekadashi"] {
+		t.Errorf("tamil_nadu catalog lacks its own or the common definitions: %v", got)
+	}
+	if got["holi"] || got["gudi-padwa"] {
+		t.Errorf("tamil_nadu catalog holds definitions of other regions: %v", got)
+	}
+	for _, d := range tamil.Definitions() {
+		if !d.ObservedIn("tamil_nadu") {
+			t.Errorf("tamil_nadu catalog holds %s, observed in %v", d.ID, d.Regions)
+		}
+	}
+
+	// Every region together is the default rule set.
+	all, err := c.ForRegion("")
+	if err != nil {
+		t.Fatalf("ForRegion(\"\") error = %v", err)
+	}
+	if want := ids(DefaultRuleSet()); !reflect.DeepEqual(ids(all), want) {
+		t.Errorf("ForRegion(\"\") = %v, want %v", ids(all), want)
+	}
+
+	// Unknown regions, including ones that look like patterns, get the
+	// common definitions.
+	for _, region := range []string{"atlantis", "*", "../data/festivals"} {
+		rules, err := c.ForRegion(region)
+		if err != nil {
+			t.Fatalf("ForRegion(%q) error = %v", region, err)
+		}
+		for _, d := range rules.Definitions() {
+			if len(d.Regions) > 0 {
+				t.Errorf("ForRegion(%q) holds the regional %s", region, d.ID)
+			}
+		}
+	}
+}
+
+func TestCatalogsLRU(t *testing.T) {
+	c := NewCatalogs(WithMaxCatalogs(2))
+	first, _ := c.ForRegion("bengal")
+	c.ForRegion("maharashtra")
+	if again, _ := c.ForRegion("bengal"); again != first {
+		t.Error("ForRegion() parsed a cached region again")
+	}
+	size := c.Bytes()
+	c.ForRegion("tamil_nadu")
+	if got, want := strings.Join(c.Regions(), ","), "tamil_nadu,bengal"; got != want {
+		t.Errorf("Regions() = %s, want %s", got, want)
+	}
+	if c.Bytes() <= 0 || c.Bytes() == size {
+		t.Errorf("Bytes() = %d after evicting maharashtra, was %d", c.Bytes(), size)
+	}
+}
+
+func TestCatalogsWithDefinitions(t *testing.T) {
+	custom := Definition{ID: "ekadashi", Kind: VratKind, Names: map[string]string{"en": "Custom Ekadashi"}, Tithi: 11}
+	c := NewCatalogs(WithDefinitions(custom))
+	rules, err := c.ForRegion("bengal")
+	if err != nil {
+		t.Fatalf("ForRegion() error = %v", err)
+	}
+	for _, d := range rules.Definitions() {
+		if d.ID == "ekadashi" && d.Names["en"] != "Custom Ekadashi" {
+			t.Errorf("ekadashi = %v, want the custom definition", d.Names)
+		}
+	}
+	if !reflect.DeepEqual(ids(DefaultRuleSet()), ids(mustForRegion(t, NewCatalogs(), ""))) {
+		t.Error("WithDefinitions() changed other catalogs")
+	}
+}
+
+func mustForRegion(t *testing.T, s Source, region string) *RuleSet {
+	t.Helper()
+	rules, err := s.ForRegion(region)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rules
+}
+
+// TestRegionFiles checks that each regional definition is in the file of
+// every region it is observed in, with the same content.
+func TestRegionFiles(t *testing.T) {
+	names, err := fs.Glob(builtinData, regionsPattern)
+	if err != nil {
+		t.Fatal(err)
+	}
+	seen := map[string]Definition{}
+	files := map[string]map[string]bool{}
+	for _, name := range names {
+		region := strings.TrimSuffix(path.Base(name), ".json")
+		data, err := fs.ReadFile(builtinData, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var f ruleFile
+		if err := json.Unmarshal(data, &f); err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		files[region] = map[string]bool{}
+		for _, d := range f.Festivals {
+			files[region][d.ID] = true
+			if !d.ObservedIn(region) || len(d.Regions) == 0 {
+				t.Errorf("%s holds %s, observed in %v", name, d.ID, d.Regions)
+			}
+			if other, ok := seen[d.ID]; ok && !reflect.DeepEqual(other, d) {
+				t.Errorf("copies of %s differ: %v and %v", d.ID, other, d)
+			}
+			seen[d.ID] = d
+		}
+	}
+	for id, d := range seen {
+		for _, region := range d.Regions {
+			if !files[region][id] {
+				t.Errorf("%s is observed in %s but missing from its file", id, region)
+			}
+		}
+	}
+}
+
+func TestRuleSetForRegion(t *testing.T) {
+	s := DefaultRuleSet()
+	if got := mustForRegion(t, s, "bengal"); got != s {
+		t.Error("RuleSet.ForRegion() did not return the rule set itself")
+	}
+}
@@ -0,0 +1,47 @@
+package festival
+
+import (
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/astronomy/eclipse"
+)
+
+// eclipseNames holds the localized names of solar and lunar eclipses.
+var eclipseNames = map[eclipse.Body]map[string]string{
+	eclipse.Solar: {"en": "Solar Eclipse", "hi": "सूर्य ग्रहण", "ta": "சூரிய கிரகணம்"},
+	eclipse.Lunar: {"en": "Lunar Eclipse", "hi": "चंद्र ग्रहण", "ta": "சந்திர கிரகணம்"},
+}
+
+// Eclipses returns the eclipses visible at loc from the civil date of first
+// to that of last inclusive, ordered by date. Dates and times are in the
+// location of first. The definition of each event is "solar-eclipse" or
+// "lunar-eclipse", Start and End bound the visible part of the eclipse and
+// Tithi is the tithi at Start.
+func Eclipses(first, last time.Time, loc astronomy.Location) []Event {
+	tz := first.Location()
+	y, m, d := first.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	y, m, d = last.In(tz).Date()
+	end := time.Date(y, m, d, 0, 0, 0, 0, tz).AddDate(0, 0, 1)
+
+	var events []Event
+	for _, e := range eclipse.Between(start, end, loc) {
+		if !e.Local.Visible {
+			continue
+		}
+		definition := string(e.Body) + "-eclipse"
+		date := e.Local.Start.In(tz).Format("2006-01-02")
+		events = append(events, Event{
+			ID:         definition + "-" + date,
+			Definition: definition,
+			Kind:       EclipseKind,
+			Names:      eclipseNames[e.Body],
+			Date:       date,
+			Tithi:      astronomy.CalculateElements(e.Local.Start).Tithi.Name,
+			Start:      e.Local.Start.In(tz),
+			End:        e.Local.End.In(tz),
+		})
+	}
+	return events
+}
@@ -0,0 +1,172 @@
+package festival
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// Tradition selects the rules deciding the day an ekadashi is observed.
+type Tradition string
+
+const (
+	// SmartaTradition observes an ekadashi on the first day it prevails at
+	// sunrise, or on the day it falls within when it prevails at no
+	// sunrise.
+	SmartaTradition Tradition = "smarta"
+	// VaishnavaTradition observes an ekadashi only when it already
+	// prevails at arunodaya, before sunrise. A viddha ekadashi, one touched
+	// by Dashami at arunodaya, and one prevailing at two sunrises are
+	// observed on the next day.
+	VaishnavaTradition Tradition = "vaishnava"
+)
+
+// ParseTradition returns the tradition named s, SmartaTradition when s is
+// empty.
+func ParseTradition(s string) (Tradition, error) {
+	switch t := Tradition(s); t {
+	case "":
+		return SmartaTradition, nil
+	case SmartaTradition, VaishnavaTradition:
+		return t, nil
+	}
+	return "", fmt.Errorf("unknown tradition %q: expected %s or %s", s, SmartaTradition, VaishnavaTradition)
+}
+
+// arunodaya is the time before sunrise at which Dashami makes an ekadashi
+// viddha: four ghatikas, 96 minutes.
+const arunodaya = 96 * time.Minute
+
+// Ekadashi is an ekadashi with the day it is observed on.
+type Ekadashi struct {
+	// Date is the midnight starting the civil date the fast is kept on.
+	Date time.Time
+	// Name is the English name of the ekadashi, e.g. "Nirjala Ekadashi".
+	Name   string
+	Masa   astronomy.Masa
+	Paksha Paksha
+	// TithiStart and TithiEnd bound the Ekadashi tithi.
+	TithiStart time.Time
+	TithiEnd   time.Time
+	// Viddha reports that Dashami prevails at arunodaya on the first day
+	// Ekadashi prevails at sunrise.
+	Viddha bool
+	// Kshaya reports that Ekadashi prevails at no sunrise.
+	Kshaya bool
+	// ParanaStart and ParanaEnd bound the window in which the fast is
+	// broken, on the day after Date. See Parana.
+	ParanaStart time.Time
+	ParanaEnd   time.Time
+}
+
+// Ekadashis returns the ekadashis observed following tradition at loc from
+// the civil date of first to that of last inclusive, ordered by date. Dates
+// are civil dates in the location of first. It returns ErrInvalidRange if
+// last is before first.
+func Ekadashis(ctx context.Context, first, last time.Time, loc astronomy.Location, tradition Tradition) ([]Ekadashi, error) {
+	tz := first.Location()
+	y, m, d := first.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	y, m, d = last.In(tz).Date()
+	end := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	if end.Before(start) {
+		return nil, fmt.Errorf("%w: %s is before %s", ErrInvalidRange, end.Format("2006-01-02"), start.Format("2006-01-02"))
+	}
+
+	sunrises := map[time.Time]time.Time{}
+	sunriseOn := func(day time.Time) (time.Time, error) {
+		if sunrise, ok := sunrises[day]; ok {
+			return sunrise, nil
+		}
+		sunTimes, err := astronomy.CalculateSunTimes(loc, day)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("calculating sunrise on %s: %w", day.Format("2006-01-02"), err)
+		}
+		sunrises[day] = sunTimes.Sunrise
+		return sunTimes.Sunrise, nil
+	}
+
+	// An ekadashi is observed at most two days after it starts, and a day
+	// before when it prevails at no sunrise.
+	var ekadashis []Ekadashi
+	for t := start.AddDate(0, 0, -3); t.Before(end.AddDate(0, 0, 2)); {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		tithi := astronomy.CalculateElementPeriod(astronomy.TithiElement, t)
+		// End is rounded, possibly to just before the next tithi starts.
+		t = tithi.End.Add(time.Minute)
+		if !isTithi(tithi.Element, 11) {
+			// Skip to shortly before the next ekadashi: tithis last at
+			// least 19 hours.
+			ahead := (11 - tithi.Number%15 + 15) % 15
+			t = t.Add(time.Duration(max(ahead-1, 0)) * 19 * time.Hour)
+			continue
+		}
+		e, err := observeEkadashi(tithi, tz, tradition, sunriseOn)
+		if err != nil {
+			return nil, err
+		}
+		if e.Date.Before(start) || e.Date.After(end) {
+			continue
+		}
+		if e.ParanaStart, e.ParanaEnd, err = Parana(e.Date, loc); err != nil {
+			return nil, err
+		}
+		ekadashis = append(ekadashis, e)
+	}
+	return ekadashis, nil
+}
+
+// observeEkadashi returns the ekadashi of the Ekadashi tithi with the day it
+// is observed on following tradition, without its parana.
+func observeEkadashi(tithi astronomy.ElementPeriod, tz *time.Location, tradition Tradition, sunriseOn func(time.Time) (time.Time, error)) (Ekadashi, error) {
+	midpoint := tithi.Start.Add(tithi.End.Sub(tithi.Start) / 2)
+	masa := astronomy.CalculateMasa(midpoint)
+	paksha := astronomy.TithiPaksha(tithi.Element)
+	e := Ekadashi{
+		Name:       EkadashiName(masa, paksha),
+		Masa:       masa,
+		Paksha:     paksha,
+		TithiStart: tithi.Start,
+		TithiEnd:   tithi.End,
+	}
+
+	// day is the first day whose sunrise is not before the tithi starts.
+	y, m, d := tithi.Start.In(tz).Date()
+	day := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	sunrise, err := sunriseOn(day)
+	if err != nil {
+		return e, err
+	}
+	if sunrise.Before(tithi.Start) {
+		day = day.AddDate(0, 0, 1)
+		if sunrise, err = sunriseOn(day); err != nil {
+			return e, err
+		}
+	}
+	if !sunrise.Before(tithi.End) {
+		// The tithi falls between two sunrises: the Smarta observe it on
+		// the day it falls within, the Vaishnava on Dvadashi.
+		e.Kshaya = true
+		e.Date = day
+		if tradition == SmartaTradition {
+			e.Date = day.AddDate(0, 0, -1)
+		}
+		return e, nil
+	}
+	e.Viddha = sunrise.Add(-arunodaya).Before(tithi.Start)
+	e.Date = day
+	if tradition == VaishnavaTradition {
+		next, err := sunriseOn(day.AddDate(0, 0, 1))
+		if err != nil {
+			return e, err
+		}
+		if e.Viddha || next.Before(tithi.End) {
+			e.Date = day.AddDate(0, 0, 1)
+		}
+	}
+	return e, nil
+}
@@ -0,0 +1,87 @@
+package festival
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestEkadashis(t *testing.T) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+	first, last := time.Date(2024, 1, 1, 0, 0, 0, 0, tz), time.Date(2025, 12, 31, 0, 0, 0, 0, tz)
+	smarta, err := Ekadashis(context.Background(), first, last, delhi, SmartaTradition)
+	if err != nil {
+		t.Fatalf("Ekadashis(smarta) error = %v", err)
+	}
+	vaishnava, err := Ekadashis(context.Background(), first, last, delhi, VaishnavaTradition)
+	if err != nil {
+		t.Fatalf("Ekadashis(vaishnava) error = %v", err)
+	}
+	if len(smarta) < 48 || len(smarta) != len(vaishnava) {
+		t.Fatalf("found %d smarta and %d vaishnava ekadashis, want the same two years of them", len(smarta), len(vaishnava))
+	}
+
+	byName := map[string][2]Ekadashi{}
+	for i, s := range smarta {
+		v := vaishnava[i]
+		if s.Name != v.Name || v.Date.Before(s.Date) || v.Date.After(s.Date.AddDate(0, 0, 1)) {
+			t.Errorf("%s on %s is observed by the Vaishnava on %s, want the same day or the next", s.Name, s.Date.Format("2006-01-02"), v.Date.Format("2006-01-02"))
+		}
+		if s.Viddha && v.Date.Equal(s.Date) {
+			t.Errorf("viddha %s on %s is observed on the same day by the Vaishnava", s.Name, s.Date.Format("2006-01-02"))
+		}
+		if !v.ParanaStart.After(v.Date.AddDate(0, 0, 1)) || !v.ParanaEnd.After(v.ParanaStart) {
+			t.Errorf("%s on %s: parana %v - %v, want a window on the next day", v.Name, v.Date.Format("2006-01-02"), v.ParanaStart, v.ParanaEnd)
+		}
+		byName[s.Name+s.Date.Format(" 2006")] = [2]Ekadashi{s, v}
+	}
+
+	for _, tt := range []struct {
+		name              string
+		smarta, vaishnava string
+		viddha, kshaya    bool
+	}{
+		// Dashami prevails at arunodaya: the Vaishnava fast a day later.
+		{"Nirjala Ekadashi 2024", "2024-06-17", "2024-06-18", true, false},
+		// Ekadashi prevails at no sunrise: the Vaishnava fast on Dvadashi.
+		{"Yogini Ekadashi 2025", "2025-06-21", "2025-06-22", false, true},
+		{"Devshayani Ekadashi 2024", "2024-07-17", "2024-07-17", false, false},
+	} {
+		e, ok := byName[tt.name]
+		if !ok {
+			t.Errorf("%s not found", tt.name)
+			continue
+		}
+		if got := e[0].Date.Format("2006-01-02"); got != tt.smarta {
+			t.Errorf("%s smarta date = %s, want %s", tt.name, got, tt.smarta)
+		}
+		if got := e[1].Date.Format("2006-01-02"); got != tt.vaishnava {
+			t.Errorf("%s vaishnava date = %s, want %s", tt.name, got, tt.vaishnava)
+		}
+		if e[0].Viddha != tt.viddha || e[0].Kshaya != tt.kshaya {
+			t.Errorf("%s viddha, kshaya = %t, %t, want %t, %t", tt.name, e[0].Viddha, e[0].Kshaya, tt.viddha, tt.kshaya)
+		}
+	}
+
+	if _, err := Ekadashis(context.Background(), last, first, delhi, SmartaTradition); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Ekadashis(last, first) error = %v, want ErrInvalidRange", err)
+	}
+}
+
+func TestParseTradition(t *testing.T) {
+	for s, want := range map[string]Tradition{"": SmartaTradition, "smarta": SmartaTradition, "vaishnava": VaishnavaTradition} {
+		if got, err := ParseTradition(s); err != nil || got != want {
+			t.Errorf("ParseTradition(%q) = %q, %v, want %q", s, got, err, want)
+		}
+	}
+	if _, err := ParseTradition("iskcon"); err == nil {
+		t.Error("ParseTradition(iskcon) succeeded, want an error")
+	}
+}
@@ -0,0 +1,30 @@
+package festival_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/festival"
+)
+
+// Finds the next ekadashi after a date by generating the events of the
+// following month.
+func ExampleRuleSet_Generate() {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	mumbai := astronomy.Location{Latitude: 19.0760, Longitude: 72.8777}
+	from := time.Date(2024, 4, 30, 0, 0, 0, 0, ist)
+	events, err := festival.DefaultRuleSet().Generate(from, from.AddDate(0, 0, 30), "", mumbai)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	for _, e := range events {
+		if e.Definition == "ekadashi" {
+			fmt.Println(e.Date, e.Names["en"], "-", e.Tithi)
+			break
+		}
+	}
+	// Output:
+	// 2024-05-04 Ekadashi - Krishna Ekadashi
+}
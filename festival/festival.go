@@ -0,0 +1,308 @@
+package festival
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/calendar"
+	"github.com/naren-m/panchangam/parallel"
+)
+
+// Kind distinguishes festivals from recurring vrats (observances) and
+// astronomical events such as eclipses and sankrantis.
+type Kind string
+
+const (
+	FestivalKind  Kind = "festival"
+	VratKind      Kind = "vrat"
+	EclipseKind   Kind = "eclipse"
+	SankrantiKind Kind = "sankranti"
+)
+
+// Paksha is a lunar fortnight: the waxing Shukla or the waning Krishna.
+type Paksha = astronomy.Paksha
+
+const (
+	ShuklaPaksha  = astronomy.ShuklaPaksha
+	KrishnaPaksha = astronomy.KrishnaPaksha
+)
+
+// Definition describes a recurring festival or vrat as a set of conditions
+// on the panchangam at sunrise and on the date of a solar calendar. Every
+// condition that is set must hold.
+type Definition struct {
+	// ID is a stable slug identifying the definition, e.g. "ekadashi".
+	ID   string `json:"id"`
+	Kind Kind   `json:"kind"`
+	// Names maps a locale (en, hi, ta, ...) to the localized name.
+	Names map[string]string `json:"names"`
+	// Masa restricts the definition to an amanta lunar month, e.g. "Chaitra".
+	Masa string `json:"masa,omitempty"`
+	// Paksha restricts Tithi to one fortnight. When empty the tithi is
+	// observed in both.
+	Paksha Paksha `json:"paksha,omitempty"`
+	// Tithi is the tithi within the paksha (1-15), where 15 is Purnima in
+	// Shukla paksha and Amavasya in Krishna paksha. Zero means any tithi.
+	Tithi int `json:"tithi,omitempty"`
+	// Nakshatra restricts the definition to a nakshatra, e.g. "Krittika".
+	Nakshatra string `json:"nakshatra,omitempty"`
+	// Kala is the part of the day Tithi must prevail during, e.g.
+	// "pradosha". When empty the tithi is that at sunrise. Masa and
+	// Nakshatra are those at sunrise either way.
+	Kala Kala `json:"kala,omitempty"`
+	// SolarMonth restricts the definition to a sidereal solar month, named
+	// after the sign of the sun, e.g. "Simha" for the Malayalam month of
+	// Chingam. Calendar decides the civil days the month runs over.
+	SolarMonth string `json:"solar_month,omitempty"`
+	// SolarDay is the day of SolarMonth (1-32), e.g. 1 for Vishu. Zero
+	// means any day.
+	SolarDay int `json:"solar_day,omitempty"`
+	// MinSolarDay is the first day of SolarMonth the definition may be
+	// observed on, e.g. 10 for Onam, whose ten days from Atham fall within
+	// Chingam.
+	MinSolarDay int `json:"min_solar_day,omitempty"`
+	// Calendar is the solar calendar reckoning SolarMonth.
+	Calendar SolarCalendar `json:"calendar,omitempty"`
+	// Days lists the days of a festival lasting several, such as the nine
+	// nights of Navaratri, by their tithis relative to Tithi. See Spans.
+	Days []SpanDay `json:"days,omitempty"`
+	// Regions restricts the definition to the given regions. An empty list
+	// means it is observed everywhere.
+	Regions []string `json:"regions,omitempty"`
+}
+
+// ObservedIn reports whether the definition applies to region. An empty
+// region matches every definition.
+func (d *Definition) ObservedIn(region string) bool {
+	if region == "" || len(d.Regions) == 0 {
+		return true
+	}
+	for _, r := range d.Regions {
+		if r == region {
+			return true
+		}
+	}
+	return false
+}
+
+// sunrise holds the panchangam values a definition is evaluated against.
+type sunrise struct {
+	masa      astronomy.Masa
+	tithi     astronomy.Element
+	nakshatra astronomy.Element
+	sunTimes  *astronomy.SunTimes
+}
+
+// matches reports whether every condition of the definition holds at p. The
+// tithi of a definition with a kala is only checked to prevail at sunrise
+// or after it, so that its kala is calculated on the days it may be
+// observed only.
+func (d *Definition) matches(p sunrise) bool {
+	// Festivals tied to a month are not observed in its Adhika month.
+	if d.Masa != "" && (d.Masa != p.masa.Name || p.masa.Adhika) {
+		return false
+	}
+	if d.Nakshatra != "" && d.Nakshatra != p.nakshatra.Name {
+		return false
+	}
+	if d.Tithi == 0 {
+		return true
+	}
+	if d.Kala != "" {
+		// Tithis last longer than the day: the kala is in the tithi
+		// prevailing at sunrise or the next one.
+		return d.matchesTithi(p.tithi) || d.matchesTithi(nextTithi(p.tithi))
+	}
+	return d.matchesTithi(p.tithi)
+}
+
+// matchesTithi reports whether tithi is that of the definition.
+func (d *Definition) matchesTithi(t astronomy.Element) bool {
+	paksha := astronomy.TithiPaksha(t)
+	tithi := t.Number
+	if paksha == KrishnaPaksha {
+		tithi -= 15
+	}
+	return tithi == d.Tithi && (d.Paksha == "" || d.Paksha == paksha)
+}
+
+// Event is a single dated occurrence of a definition.
+type Event struct {
+	// ID is stable for a given definition and date, e.g. "ekadashi-2025-01-10".
+	ID string
+	// Definition is the ID of the definition observed, e.g. "ekadashi".
+	Definition string
+	Kind       Kind
+	Names      map[string]string
+	// Date is the civil date of the observance in YYYY-MM-DD format.
+	Date  string
+	Tithi string
+	// Start and End bound events tied to an instant, such as eclipses. They
+	// are zero for festivals and vrats, which last the whole day, and End is
+	// zero for events that happen at an instant, such as sankrantis.
+	Start time.Time
+	End   time.Time
+	// Kala is that of the definition. ObservanceStart and ObservanceEnd
+	// bound it on Date, e.g. the nishita kala of a Shivaratri, which ends
+	// after midnight; they are zero for definitions without a kala.
+	Kala            Kala
+	ObservanceStart time.Time
+	ObservanceEnd   time.Time
+}
+
+// ErrInvalidRange is returned for a range of dates that ends before it
+// starts.
+var ErrInvalidRange = errors.New("range ends before it starts")
+
+// Definitions returns the built-in festival and vrat definitions.
+func Definitions() []Definition {
+	return DefaultRuleSet().Definitions()
+}
+
+// GenerateYear returns every built-in festival and vrat observed in region
+// during the given Gregorian year. See RuleSet.GenerateYear.
+func GenerateYear(year int, region string, loc astronomy.Location, tz *time.Location) ([]Event, error) {
+	return DefaultRuleSet().GenerateYear(year, region, loc, tz)
+}
+
+// GenerateYear returns every festival and vrat of the rule set observed in
+// region during the given Gregorian year at loc, ordered by date. Dates are
+// civil dates in tz.
+func (s *RuleSet) GenerateYear(year int, region string, loc astronomy.Location, tz *time.Location) ([]Event, error) {
+	first := time.Date(year, 1, 1, 0, 0, 0, 0, tz)
+	return s.Generate(first, first.AddDate(1, 0, -1), region, loc)
+}
+
+// Generate returns every festival and vrat of the rule set observed in
+// region at loc from the civil date of first to that of last inclusive,
+// ordered by date. Dates are civil dates in the location of first.
+// Definitions are evaluated at sunrise, following the udaya tithi
+// convention, and solar dates following their calendar. It returns ErrInvalidRange if last is before first.
+func (s *RuleSet) Generate(first, last time.Time, region string, loc astronomy.Location) ([]Event, error) {
+	return s.GenerateContext(context.Background(), first, last, region, loc)
+}
+
+// GenerateContext is Generate stopping with the error of ctx once it ends.
+// The panchangams of the days are computed in parallel.
+func (s *RuleSet) GenerateContext(ctx context.Context, first, last time.Time, region string, loc astronomy.Location) ([]Event, error) {
+	return s.generate(ctx, first, last, region, loc, parallel.DefaultWorkers())
+}
+
+// chunkDays is the number of consecutive days computed by one call of a
+// worker, which reuses the month between them.
+const chunkDays = 16
+
+func (s *RuleSet) generate(ctx context.Context, first, last time.Time, region string, loc astronomy.Location, workers int) ([]Event, error) {
+	tz := first.Location()
+	y, m, d := first.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	y, m, d = last.In(tz).Date()
+	end := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	if end.Before(start) {
+		return nil, fmt.Errorf("%w: %s is before %s", ErrInvalidRange, end.Format("2006-01-02"), start.Format("2006-01-02"))
+	}
+
+	// The day before the range decides whether conditions holding on its
+	// first day were already observed.
+	from := start.AddDate(0, 0, -1)
+	days := 0
+	for day := from; !day.After(end); day = day.AddDate(0, 0, 1) {
+		days++
+	}
+	chunks, err := parallel.Map(ctx, (days+chunkDays-1)/chunkDays, workers, func(ctx context.Context, c int) ([]sunrise, error) {
+		var sunrises []sunrise
+		var masa astronomy.MasaPeriod
+		for i := c * chunkDays; i < min((c+1)*chunkDays, days); i++ {
+			if err := ctx.Err(); err != nil {
+				return nil, err
+			}
+			day := from.AddDate(0, 0, i)
+			sunTimes, err := astronomy.CalculateSunTimes(loc, day)
+			if err != nil {
+				return nil, fmt.Errorf("calculating sunrise on %s: %w", day.Format("2006-01-02"), err)
+			}
+			// The month only changes at new moons, so reuse it until then.
+			if !masa.Contains(sunTimes.Sunrise) {
+				masa = astronomy.CalculateMasaPeriod(sunTimes.Sunrise)
+			}
+			elements := astronomy.CalculateElements(sunTimes.Sunrise)
+			sunrises = append(sunrises, sunrise{
+				masa:      masa.Masa,
+				tithi:     elements.Tithi,
+				nakshatra: elements.Nakshatra,
+				sunTimes:  sunTimes,
+			})
+		}
+		return sunrises, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var sunrises []sunrise
+	for _, chunk := range chunks {
+		sunrises = append(sunrises, chunk...)
+	}
+
+	var events []Event
+	matchedYesterday := map[string]bool{}
+	solar := calendar.NewSolarDater(loc)
+	for n, p := range sunrises {
+		day := from.AddDate(0, 0, n)
+		date := day.Format("2006-01-02")
+		// The kalas of the day are calculated when first needed.
+		kalas := map[Kala]kalaPeriod{}
+		for i := range s.definitions {
+			d := &s.definitions[i]
+			if !d.ObservedIn(region) {
+				continue
+			}
+			matched := d.matches(p)
+			var kala kalaPeriod
+			if matched && d.Kala != "" {
+				var ok bool
+				if kala, ok = kalas[d.Kala]; !ok {
+					if err := ctx.Err(); err != nil {
+						return nil, err
+					}
+					kala, ok = calculateKala(d.Kala, loc, day, p.sunTimes)
+					kalas[d.Kala] = kala
+				}
+				matched = ok && d.matchesKala(kala)
+			}
+			if matched && d.SolarMonth != "" {
+				date, err := solar.Date(d.Calendar, day, p.sunTimes)
+				if err != nil {
+					return nil, err
+				}
+				matched = d.matchesSolar(date)
+			}
+			// Conditions holding on two consecutive days are observed on
+			// the first.
+			observed := matched && !matchedYesterday[d.ID]
+			matchedYesterday[d.ID] = matched
+			if !observed || day.Before(start) {
+				continue
+			}
+			tithi := p.tithi
+			if d.Kala != "" {
+				tithi = kala.tithi(d)
+			}
+			events = append(events, Event{
+				ID:              d.ID + "-" + date,
+				Definition:      d.ID,
+				Kind:            d.Kind,
+				Names:           d.Names,
+				Date:            date,
+				Tithi:           tithi.Name,
+				Kala:            d.Kala,
+				ObservanceStart: kala.start,
+				ObservanceEnd:   kala.end,
+			})
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,339 @@
+package festival
+
+import (
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/parallel"
+)
+
+func TestGenerateYear(t *testing.T) {
+	chennai := astronomy.Location{Latitude: 13.0827, Longitude: 80.2707}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := GenerateYear(2024, "", chennai, tz)
+	if err != nil {
+		t.Fatalf("GenerateYear() error = %v", err)
+	}
+
+	counts := map[string]int{}
+	seen := map[string]bool{}
+	previous := ""
+	for _, e := range events {
+		if seen[e.ID] {
+			t.Errorf("duplicate event id %s", e.ID)
+		}
+		seen[e.ID] = true
+		if e.Date < previous {
+			t.Errorf("event %s out of order after %s", e.ID, previous)
+		}
+		previous = e.Date
+		if !strings.HasPrefix(e.Date, "2024-") {
+			t.Errorf("event %s outside the requested year", e.ID)
+		}
+		if e.Names["en"] == "" {
+			t.Errorf("event %s has no English name", e.ID)
+		}
+		counts[strings.TrimSuffix(e.ID, "-"+e.Date)]++
+	}
+
+	// A year has 24 or 25 ekadashis and 12 or 13 full and new moons, less
+	// any tithi that does not prevail at a sunrise.
+	if counts["ekadashi"] < 22 || counts["ekadashi"] > 26 {
+		t.Errorf("ekadashi count = %d", counts["ekadashi"])
+	}
+	if counts["purnima"] < 11 || counts["purnima"] > 13 {
+		t.Errorf("purnima count = %d", counts["purnima"])
+	}
+	if !seen["purnima-2024-04-23"] {
+		t.Errorf("missing Chaitra Purnima on 2024-04-23")
+	}
+}
+
+func TestDefinitionObservedIn(t *testing.T) {
+	d := Definition{ID: "test", Regions: []string{"tamil_nadu"}}
+	if !d.ObservedIn("") || !d.ObservedIn("tamil_nadu") || d.ObservedIn("bengal") {
+		t.Errorf("ObservedIn() does not honour regions %v", d.Regions)
+	}
+	everywhere := Definition{ID: "test"}
+	if !everywhere.ObservedIn("bengal") {
+		t.Errorf("definition without regions should be observed everywhere")
+	}
+}
+
+func TestGenerateYearMajorFestivals(t *testing.T) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := GenerateYear(2024, "", delhi, tz)
+	if err != nil {
+		t.Fatalf("GenerateYear() error = %v", err)
+	}
+	dates := map[string][]string{}
+	for _, e := range events {
+		id := strings.TrimSuffix(e.ID, "-"+e.Date)
+		dates[id] = append(dates[id], e.Date)
+	}
+
+	tests := map[string]string{
+		"rama-navami":         "2024-04-17",
+		"raksha-bandhan":      "2024-08-19",
+		"ganesh-chaturthi":    "2024-09-07",
+		"akshaya-tritiya":     "2024-05-10",
+		"krishna-janmashtami": "2024-08-26",
+	}
+	for id, want := range tests {
+		if len(dates[id]) != 1 || dates[id][0] != want {
+			t.Errorf("%s dates = %v, want [%s]", id, dates[id], want)
+		}
+	}
+}
+
+func TestDefinitionMatches(t *testing.T) {
+	chaitra, _ := astronomy.MasaByName("Chaitra")
+	krittika, _ := astronomy.NakshatraByName("Krittika")
+	at := func(tithi int) sunrise {
+		return sunrise{masa: chaitra, tithi: astronomy.Element{Number: tithi}, nakshatra: krittika}
+	}
+
+	tests := []struct {
+		name string
+		def  Definition
+		p    sunrise
+		want bool
+	}{
+		{"tithi in both pakshas", Definition{Tithi: 11}, at(26), true},
+		{"shukla tithi", Definition{Paksha: ShuklaPaksha, Tithi: 15}, at(15), true},
+		{"krishna tithi", Definition{Paksha: KrishnaPaksha, Tithi: 15}, at(15), false},
+		{"amavasya", Definition{Paksha: KrishnaPaksha, Tithi: 15}, at(30), true},
+		{"masa", Definition{Masa: "Chaitra", Tithi: 9}, at(9), true},
+		{"other masa", Definition{Masa: "Magha", Tithi: 9}, at(9), false},
+		{"nakshatra", Definition{Nakshatra: "Krittika"}, at(3), true},
+		{"other nakshatra", Definition{Nakshatra: "Rohini"}, at(3), false},
+		{"kala tithi at sunrise", Definition{Tithi: 13, Kala: PradoshaKala}, at(13), true},
+		{"kala tithi after sunrise", Definition{Tithi: 13, Kala: PradoshaKala}, at(27), true},
+		{"kala tithi not that day", Definition{Tithi: 13, Kala: PradoshaKala}, at(11), false},
+	}
+	for _, tt := range tests {
+		if got := tt.def.matches(tt.p); got != tt.want {
+			t.Errorf("%s: matches() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	custom := `{"festivals": [
+		{"id": "temple-utsavam", "kind": "festival", "names": {"en": "Temple Utsavam"},
+		 "masa": "Chaitra", "nakshatra": "Uttara Phalguni", "regions": ["tamil_nadu"]},
+		{"id": "ekadashi", "kind": "vrat", "names": {"en": "Ekadashi"}, "paksha": "shukla", "tithi": 11}
+	]}`
+	if err := os.WriteFile(filepath.Join(dir, "temple.json"), []byte(custom), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := LoadDir(dir)
+	if err != nil {
+		t.Fatalf("LoadDir() error = %v", err)
+	}
+	defs := map[string]Definition{}
+	for _, d := range rules.Definitions() {
+		defs[d.ID] = d
+	}
+	if _, ok := defs["temple-utsavam"]; !ok {
+		t.Errorf("custom definition not loaded")
+	}
+	if defs["ekadashi"].Paksha != ShuklaPaksha {
+		t.Errorf("custom definition does not replace the built-in one")
+	}
+	if len(defs) != len(Definitions())+1 {
+		t.Errorf("got %d definitions, want %d", len(defs), len(Definitions())+1)
+	}
+	if DefaultRuleSet().Definitions()[0].Paksha != "" {
+		t.Errorf("LoadDir() modified the built-in definitions")
+	}
+}
+
+func TestLoadDirInvalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown masa":      `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "masa": "Chaitr", "tithi": 1}]}`,
+		"unknown nakshatra": `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "nakshatra": "Rohni"}]}`,
+		"tithi range":       `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "tithi": 16}]}`,
+		"no condition":      `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}}]}`,
+		"unknown field":     `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "tithi": 1, "month": "Chaitra"}]}`,
+		"unknown kala":      `{"festivals": [{"id": "x", "kind": "vrat", "names": {"en": "X"}, "tithi": 13, "kala": "sandhya"}]}`,
+		"days apart":        `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "tithi": 1, "days": [{"offset": 0, "names": {"en": "A"}}, {"offset": 2, "names": {"en": "B"}}]}]}`,
+		"days after":        `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "tithi": 1, "days": [{"offset": 1, "names": {"en": "A"}}]}]}`,
+		"unknown sign":      `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "solar_month": "Leo", "solar_day": 1, "calendar": "tamil"}]}`,
+		"no calendar":       `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "solar_month": "Mesha", "solar_day": 1}]}`,
+		"solar day alone":   `{"festivals": [{"id": "x", "kind": "festival", "names": {"en": "X"}, "tithi": 1, "solar_day": 1}]}`,
+		"duplicate id":      `{"festivals": [{"id": "x", "kind": "vrat", "names": {"en": "X"}, "tithi": 1}, {"id": "x", "kind": "vrat", "names": {"en": "X"}, "tithi": 2}]}`,
+	}
+	for name, data := range tests {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "bad.json"), []byte(data), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadDir(dir); err == nil {
+			t.Errorf("%s: LoadDir() error = nil", name)
+		}
+	}
+}
+
+func BenchmarkGenerateYear(b *testing.B) {
+	chennai := astronomy.Location{Latitude: 13.0827, Longitude: 80.2707}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		b.Fatal(err)
+	}
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, tz)
+	last := first.AddDate(1, 0, -1)
+	for _, bm := range []struct {
+		name    string
+		workers int
+	}{{"serial", 1}, {"parallel", parallel.DefaultWorkers()}} {
+		b.Run(bm.name, func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				if _, err := DefaultRuleSet().generate(context.Background(), first, last, "", chennai, bm.workers); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerateParallel(t *testing.T) {
+	chennai := astronomy.Location{Latitude: 13.0827, Longitude: 80.2707}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, tz)
+	last := first.AddDate(1, 0, -1)
+	serial, err := DefaultRuleSet().generate(context.Background(), first, last, "", chennai, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	concurrent, err := DefaultRuleSet().generate(context.Background(), first, last, "", chennai, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(concurrent, serial) {
+		t.Errorf("generate() with 8 workers differs from the serial one: %d and %d events", len(concurrent), len(serial))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := DefaultRuleSet().GenerateContext(ctx, first, last, "", chennai); !errors.Is(err, context.Canceled) {
+		t.Errorf("GenerateContext() with a cancelled context error = %v, want context.Canceled", err)
+	}
+}
+
+func TestGenerate(t *testing.T) {
+	chennai := astronomy.Location{Latitude: 13.0827, Longitude: 80.2707}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	year, err := GenerateYear(2024, "tamil_nadu", chennai, tz)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Any range yields the same events as the year it belongs to.
+	first := time.Date(2024, 4, 10, 0, 0, 0, 0, tz)
+	last := time.Date(2024, 5, 20, 0, 0, 0, 0, tz)
+	events, err := DefaultRuleSet().Generate(first, last, "tamil_nadu", chennai)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var want []string
+	for _, e := range year {
+		if e.Date >= "2024-04-10" && e.Date <= "2024-05-20" {
+			want = append(want, e.ID)
+		}
+	}
+	var got []string
+	for _, e := range events {
+		got = append(got, e.ID)
+	}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Generate() = %v, want %v", got, want)
+	}
+
+	day := time.Date(2024, 4, 23, 0, 0, 0, 0, tz)
+	events, err = DefaultRuleSet().Generate(day, day, "", chennai)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) == 0 || events[0].Definition != "purnima" || events[0].Date != "2024-04-23" {
+		t.Errorf("Generate() on Chaitra Purnima = %+v", events)
+	}
+}
+
+func TestEclipses(t *testing.T) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// September 2025 has a total lunar eclipse visible from India and a
+	// partial solar eclipse seen only from the southern hemisphere.
+	events := Eclipses(time.Date(2025, 9, 1, 0, 0, 0, 0, tz), time.Date(2025, 9, 30, 0, 0, 0, 0, tz), delhi)
+	if len(events) != 1 {
+		t.Fatalf("Eclipses() = %+v, want one lunar eclipse", events)
+	}
+	e := events[0]
+	if e.ID != "lunar-eclipse-2025-09-07" || e.Kind != EclipseKind || e.Tithi != "Purnima" {
+		t.Errorf("Eclipses() = %+v, want the lunar eclipse of 2025-09-07 at Purnima", e)
+	}
+	// The penumbral phase lasted from 20:58 to 02:25 IST.
+	if start := time.Date(2025, 9, 7, 20, 58, 0, 0, tz); e.Start.Sub(start).Abs() > 5*time.Minute {
+		t.Errorf("Start = %v, want %v", e.Start, start)
+	}
+	if end := time.Date(2025, 9, 8, 2, 25, 0, 0, tz); e.End.Sub(end).Abs() > 5*time.Minute {
+		t.Errorf("End = %v, want %v", e.End, end)
+	}
+}
+
+func TestSankrantis(t *testing.T) {
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events := Sankrantis(time.Date(2024, 1, 1, 0, 0, 0, 0, tz), time.Date(2024, 12, 31, 0, 0, 0, 0, tz))
+	if len(events) != 12 {
+		t.Fatalf("Sankrantis() returned %d events, want 12", len(events))
+	}
+	// Makar Sankranti fell in the early hours of 15 January 2024.
+	makara := events[0]
+	if makara.ID != "makara-sankranti-2024-01-15" || makara.Kind != SankrantiKind || makara.Names["en"] != "Makar Sankranti" {
+		t.Errorf("Sankrantis()[0] = %+v, want Makar Sankranti on 2024-01-15", makara)
+	}
+	if makara.Start.IsZero() || !makara.End.IsZero() {
+		t.Errorf("Sankrantis()[0] Start = %v, End = %v, want only the instant", makara.Start, makara.End)
+	}
+}
+
+func TestGenerateInvalidRange(t *testing.T) {
+	first := time.Date(2024, 3, 2, 0, 0, 0, 0, time.UTC)
+	_, err := DefaultRuleSet().Generate(first, first.AddDate(0, 0, -1), "", astronomy.Location{Latitude: 13.08, Longitude: 80.27})
+	if !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Generate() error = %v, want %v", err, ErrInvalidRange)
+	}
+}
@@ -0,0 +1,97 @@
+package festival
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// ErrUnknownFestival is returned for a festival name that matches no
+// definition.
+var ErrUnknownFestival = errors.New("unknown festival")
+
+// Lookup returns the definition named name: its ID, e.g. "diwali", or its
+// name in any locale, e.g. "Raksha Bandhan" or "दीवाली". Case, spaces,
+// hyphens and underscores are ignored.
+func (s *RuleSet) Lookup(name string) (Definition, bool) {
+	key := nameKey(name)
+	if key == "" {
+		return Definition{}, false
+	}
+	for _, d := range s.definitions {
+		if nameKey(d.ID) == key {
+			return d, true
+		}
+	}
+	for _, d := range s.definitions {
+		for _, n := range d.Names {
+			if nameKey(n) == key {
+				return d, true
+			}
+		}
+	}
+	return Definition{}, false
+}
+
+// nameKey folds a name for Lookup.
+func nameKey(name string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsSpace(r) || r == '-' || r == '_' {
+			return -1
+		}
+		return unicode.ToLower(r)
+	}, name)
+}
+
+// FindDates returns the occurrences of the definition named name (see
+// Lookup) in region at loc during the Gregorian years firstYear to lastYear
+// inclusive, ordered by date. Dates are civil dates in tz. A definition not
+// observed in region has no occurrences. It returns ErrUnknownFestival if
+// no definition is named name.
+//
+// A definition tied to a month is only evaluated during the occurrences of
+// that month, so that searching a year costs about a month of panchangams.
+func (s *RuleSet) FindDates(ctx context.Context, name string, firstYear, lastYear int, region string, loc astronomy.Location, tz *time.Location) ([]Event, error) {
+	d, ok := s.Lookup(name)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFestival, name)
+	}
+	first := time.Date(firstYear, 1, 1, 0, 0, 0, 0, tz)
+	last := time.Date(lastYear, 12, 31, 0, 0, 0, 0, tz)
+	if last.Before(first) {
+		return nil, fmt.Errorf("%w: %d is before %d", ErrInvalidRange, lastYear, firstYear)
+	}
+	if !d.ObservedIn(region) {
+		return nil, nil
+	}
+	single := &RuleSet{definitions: []Definition{d}}
+	if d.Masa == "" {
+		return single.GenerateContext(ctx, first, last, region, loc)
+	}
+
+	var events []Event
+	for t := first; !t.After(last); {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		month := astronomy.CalculateMasaPeriod(t)
+		if month.Name == d.Masa && !month.Adhika {
+			// The sunrises of the month fall on the civil dates from its
+			// start to its end.
+			start, end := later(month.Start.In(tz), first), earlier(month.End.In(tz), last)
+			found, err := single.GenerateContext(ctx, start, end, region, loc)
+			if err != nil {
+				return nil, err
+			}
+			events = append(events, found...)
+		}
+		// The next month starts at the new moon ending this one.
+		t = month.End.Add(time.Hour)
+	}
+	return events, nil
+}
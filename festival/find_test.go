@@ -0,0 +1,83 @@
+package festival
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestLookup(t *testing.T) {
+	s := DefaultRuleSet()
+	for _, name := range []string{"diwali", "Diwali", "दीपावली", "raksha bandhan", "Raksha-Bandhan", "KRISHNA_JANMASHTAMI"} {
+		if _, ok := s.Lookup(name); !ok {
+			t.Errorf("Lookup(%q) found nothing", name)
+		}
+	}
+	if d, _ := s.Lookup("Deepavali"); d.ID != "" {
+		t.Errorf("Lookup(Deepavali) = %s, want nothing", d.ID)
+	}
+	if _, ok := s.Lookup(" "); ok {
+		t.Error("Lookup of a blank name found a definition")
+	}
+}
+
+func TestFindDates(t *testing.T) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := DefaultRuleSet()
+
+	// Searching the months of a festival finds the dates of generating
+	// whole years.
+	for _, name := range []string{"diwali", "holi", "ekadashi"} {
+		found, err := s.FindDates(context.Background(), name, 2024, 2026, "", delhi, tz)
+		if err != nil {
+			t.Fatalf("FindDates(%s) error = %v", name, err)
+		}
+		var want []string
+		for year := 2024; year <= 2026; year++ {
+			events, err := s.GenerateYear(year, "", delhi, tz)
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, e := range events {
+				if e.Definition == name {
+					want = append(want, e.Date)
+				}
+			}
+		}
+		var got []string
+		for _, e := range found {
+			got = append(got, e.Date)
+		}
+		if len(want) == 0 || !reflect.DeepEqual(got, want) {
+			t.Errorf("FindDates(%s) = %v, want %v", name, got, want)
+		}
+	}
+
+	if events, err := s.FindDates(context.Background(), "holi", 2025, 2025, "tamil_nadu", delhi, tz); err != nil || len(events) != 0 {
+		t.Errorf("FindDates(holi, tamil_nadu) = %v, %v, want none", events, err)
+	}
+	if _, err := s.FindDates(context.Background(), "deepavali", 2025, 2025, "", delhi, tz); !errors.Is(err, ErrUnknownFestival) {
+		t.Errorf("FindDates(deepavali) error = %v, want ErrUnknownFestival", err)
+	}
+	if _, err := s.FindDates(context.Background(), "diwali", 2026, 2025, "", delhi, tz); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("FindDates(2026 to 2025) error = %v, want ErrInvalidRange", err)
+	}
+}
+
+func BenchmarkFindDates(b *testing.B) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, _ := time.LoadLocation("Asia/Kolkata")
+	for i := 0; i < b.N; i++ {
+		if _, err := DefaultRuleSet().FindDates(context.Background(), "diwali", 2020, 2029, "", delhi, tz); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
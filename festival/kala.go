@@ -0,0 +1,99 @@
+package festival
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// Kala is the part of the day during which the tithi of a definition must
+// prevail for it to be observed that day, such as the evening twilight of
+// Pradosham. A definition without one is evaluated at sunrise.
+type Kala string
+
+const (
+	// MadhyahnaKala is the middle fifth of the daytime, when Vinayaka
+	// Chaturthi is worshipped.
+	MadhyahnaKala Kala = "madhyahna"
+	// PradoshaKala is the first fifth of the night, from sunset, when
+	// Pradosham and Satyanarayan puja are observed.
+	PradoshaKala Kala = "pradosha"
+	// NishitaKala is the eighth of the fifteen muhurtas of the night,
+	// around midnight, when Shivaratri is observed.
+	NishitaKala Kala = "nishita"
+	// MoonriseKala runs from sunrise to moonrise, as the fasts of
+	// Sankashti Chaturthi and Karva Chauth, which end on seeing the moon.
+	// The tithi must prevail at moonrise.
+	MoonriseKala Kala = "moonrise"
+)
+
+func (k Kala) valid() bool {
+	switch k {
+	case "", MadhyahnaKala, PradoshaKala, NishitaKala, MoonriseKala:
+		return true
+	}
+	return false
+}
+
+// kalaPeriod is the window of a kala on a day and the tithis prevailing
+// at its ends.
+type kalaPeriod struct {
+	start, end time.Time
+	// tithis are prevailing at start and at end. Only the latter counts for
+	// MoonriseKala.
+	tithis [2]astronomy.Element
+}
+
+// calculateKala returns the window of k on the day of sunTimes at loc in
+// the location of day, or false if it has none, such as a day the moon does
+// not rise. The night is taken as the complement of the daytime.
+func calculateKala(k Kala, loc astronomy.Location, day time.Time, sunTimes *astronomy.SunTimes) (kalaPeriod, bool) {
+	dayLength := sunTimes.DayLength()
+	night := 24*time.Hour - dayLength
+	var p kalaPeriod
+	switch k {
+	case MadhyahnaKala:
+		p.start, p.end = sunTimes.Sunrise.Add(2*dayLength/5), sunTimes.Sunrise.Add(3*dayLength/5)
+	case PradoshaKala:
+		p.start, p.end = sunTimes.Sunset, sunTimes.Sunset.Add(night/5)
+	case NishitaKala:
+		p.start, p.end = sunTimes.Sunset.Add(7*night/15), sunTimes.Sunset.Add(8*night/15)
+	case MoonriseKala:
+		moonrise := astronomy.CalculateMoonTimes(loc, day).Moonrise
+		if moonrise.IsZero() {
+			return p, false
+		}
+		p.start, p.end = sunTimes.Sunrise, moonrise
+	default:
+		panic(fmt.Sprintf("festival: no window for kala %q", k))
+	}
+	p.start, p.end = p.start.In(day.Location()), p.end.In(day.Location())
+	p.tithis[0] = astronomy.CalculateElements(p.start).Tithi
+	p.tithis[1] = astronomy.CalculateElements(p.end).Tithi
+	return p, true
+}
+
+// matchesKala reports whether the tithi of d prevails during p.
+func (d *Definition) matchesKala(p kalaPeriod) bool {
+	if d.Tithi == 0 {
+		return true
+	}
+	if d.Kala == MoonriseKala {
+		return d.matchesTithi(p.tithis[1])
+	}
+	return d.matchesTithi(p.tithis[0]) || d.matchesTithi(p.tithis[1])
+}
+
+// tithi returns the tithi of d prevailing during p.
+func (p kalaPeriod) tithi(d *Definition) astronomy.Element {
+	if d.matchesTithi(p.tithis[1]) {
+		return p.tithis[1]
+	}
+	return p.tithis[0]
+}
+
+// nextTithi returns the tithi following tithi.
+func nextTithi(tithi astronomy.Element) astronomy.Element {
+	return astronomy.TithiByNumber(tithi.Number + 1)
+}
@@ -0,0 +1,83 @@
+package festival
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestGenerateKala(t *testing.T) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	events, err := DefaultRuleSet().Generate(time.Date(2024, 1, 1, 0, 0, 0, 0, tz), time.Date(2024, 12, 31, 0, 0, 0, 0, tz), "north_india", delhi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	byID := map[string]Event{}
+	for _, e := range events {
+		byID[e.ID] = e
+		if e.Kala == "" {
+			if !e.ObservanceStart.IsZero() || !e.ObservanceEnd.IsZero() {
+				t.Errorf("%s has an observance window without a kala", e.ID)
+			}
+			continue
+		}
+		if !e.ObservanceStart.Before(e.ObservanceEnd) {
+			t.Errorf("%s observance window %v - %v is empty", e.ID, e.ObservanceStart, e.ObservanceEnd)
+		}
+	}
+
+	// Observance windows in IST: Shivaratri is observed at midnight, while
+	// Trayodashi still prevails at sunrise.
+	tests := []struct {
+		id         string
+		kala       Kala
+		tithi      string
+		start, end string
+	}{
+		{"maha-shivaratri-2024-03-08", NishitaKala, "Krishna Chaturdashi", "03-09 00:07", "03-09 00:56"},
+		{"pradosham-2024-03-08", PradoshaKala, "Krishna Trayodashi", "03-08 18:25", "03-08 20:52"},
+		{"ganesh-chaturthi-2024-09-07", MadhyahnaKala, "Shukla Chaturthi", "09-07 11:03", "09-07 13:34"},
+		{"karva-chauth-2024-10-20", MoonriseKala, "Krishna Chaturthi", "10-20 06:25", "10-20 19:54"},
+		{"satyanarayan-purnima-2024-11-15", PradoshaKala, "Purnima", "11-15 17:27", "11-15 20:06"},
+	}
+	for _, tt := range tests {
+		e, ok := byID[tt.id]
+		if !ok {
+			t.Errorf("missing %s", tt.id)
+			continue
+		}
+		start := e.ObservanceStart.In(tz).Format("01-02 15:04")
+		end := e.ObservanceEnd.In(tz).Format("01-02 15:04")
+		if e.Kala != tt.kala || e.Tithi != tt.tithi || start != tt.start || end != tt.end {
+			t.Errorf("%s = %s %s %s - %s, want %s %s %s - %s", tt.id, e.Kala, e.Tithi, start, end, tt.kala, tt.tithi, tt.start, tt.end)
+		}
+	}
+}
+
+func TestDefinitionMatchesKala(t *testing.T) {
+	tithis := func(start, end int) kalaPeriod {
+		return kalaPeriod{tithis: [2]astronomy.Element{{Number: start}, {Number: end}}}
+	}
+	tests := []struct {
+		name string
+		def  Definition
+		p    kalaPeriod
+		want bool
+	}{
+		{"at start", Definition{Tithi: 13, Kala: PradoshaKala}, tithis(13, 14), true},
+		{"at end", Definition{Tithi: 14, Kala: NishitaKala}, tithis(28, 29), true},
+		{"outside", Definition{Tithi: 13, Kala: PradoshaKala}, tithis(11, 12), false},
+		{"moonrise at start only", Definition{Paksha: KrishnaPaksha, Tithi: 4, Kala: MoonriseKala}, tithis(19, 20), false},
+		{"moonrise", Definition{Paksha: KrishnaPaksha, Tithi: 4, Kala: MoonriseKala}, tithis(18, 19), true},
+	}
+	for _, tt := range tests {
+		if got := tt.def.matchesKala(tt.p); got != tt.want {
+			t.Errorf("%s: matchesKala() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
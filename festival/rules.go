@@ -0,0 +1,221 @@
+package festival
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"sync"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/calendar"
+)
+
+// The built-in definitions are split into those observed everywhere and
+// one file per region, so that a region can be loaded on its own. A
+// definition observed in several regions is repeated in each of their
+// files.
+//
+//go:embed data/*.json data/regions/*.json
+var builtinData embed.FS
+
+// Patterns of the built-in definition files.
+const (
+	commonPattern  = "data/*.json"
+	regionsPattern = "data/regions/*.json"
+)
+
+// RuleSet is a collection of festival and vrat definitions.
+type RuleSet struct {
+	definitions []Definition
+}
+
+// ruleFile is the layout of a festival definition file:
+//
+//	{"festivals": [{"id": "holi", "kind": "festival", "names": {"en": "Holi"},
+//	  "masa": "Phalguna", "paksha": "shukla", "tithi": 15}]}
+type ruleFile struct {
+	Festivals []Definition `json:"festivals"`
+}
+
+var defaultRuleSet = sync.OnceValue(func() *RuleSet {
+	s := &RuleSet{}
+	if _, err := s.loadFS(builtinData, commonPattern, regionsPattern); err != nil {
+		panic(fmt.Sprintf("festival: invalid built-in definitions: %v", err))
+	}
+	return s
+})
+
+// DefaultRuleSet returns the built-in definitions of every region embedded
+// in the binary. Use Catalogs to load the definitions of one region only.
+func DefaultRuleSet() *RuleSet {
+	return defaultRuleSet()
+}
+
+// LoadDir returns the built-in definitions extended with every *.json file
+// in dir. A definition in dir replaces the built-in one with the same ID.
+func LoadDir(dir string) (*RuleSet, error) {
+	definitions, err := ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	s := &RuleSet{definitions: DefaultRuleSet().Definitions()}
+	if err := s.Add(definitions...); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// ReadDir returns the definitions of every *.json file in dir, without the
+// built-in ones.
+func ReadDir(dir string) ([]Definition, error) {
+	s := &RuleSet{}
+	if _, err := s.loadFS(os.DirFS(dir), "*.json"); err != nil {
+		return nil, err
+	}
+	return s.definitions, nil
+}
+
+// ForRegion returns the rule set itself, which is not split by region.
+func (s *RuleSet) ForRegion(region string) (*RuleSet, error) {
+	return s, nil
+}
+
+// Definitions returns a copy of the definitions in the rule set.
+func (s *RuleSet) Definitions() []Definition {
+	return append([]Definition(nil), s.definitions...)
+}
+
+// Add validates definitions and adds them to the rule set, replacing any
+// existing definition with the same ID.
+func (s *RuleSet) Add(definitions ...Definition) error {
+	ids := map[string]bool{}
+	for i := range definitions {
+		d := &definitions[i]
+		if err := d.validate(); err != nil {
+			return err
+		}
+		if ids[d.ID] {
+			return fmt.Errorf("duplicate definition %q", d.ID)
+		}
+		ids[d.ID] = true
+	}
+	for _, d := range definitions {
+		s.replace(d)
+	}
+	return nil
+}
+
+func (s *RuleSet) replace(d Definition) {
+	for i := range s.definitions {
+		if s.definitions[i].ID == d.ID {
+			s.definitions[i] = d
+			return
+		}
+	}
+	s.definitions = append(s.definitions, d)
+}
+
+// loadFS adds the definitions of every file in fsys matching the patterns,
+// in order of the patterns and then in lexical order of the file names. It
+// returns the number of bytes read.
+func (s *RuleSet) loadFS(fsys fs.FS, patterns ...string) (int64, error) {
+	var size int64
+	for _, pattern := range patterns {
+		names, err := fs.Glob(fsys, pattern)
+		if err != nil {
+			return size, err
+		}
+		for _, name := range names {
+			data, err := fs.ReadFile(fsys, name)
+			if err != nil {
+				return size, err
+			}
+			size += int64(len(data))
+			if err := s.load(data); err != nil {
+				return size, fmt.Errorf("%s: %w", path.Base(name), err)
+			}
+		}
+	}
+	return size, nil
+}
+
+func (s *RuleSet) load(data []byte) error {
+	var f ruleFile
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&f); err != nil {
+		return err
+	}
+	return s.Add(f.Festivals...)
+}
+
+func (d *Definition) validate() error {
+	if d.ID == "" {
+		return fmt.Errorf("definition without id")
+	}
+	if d.Kind != FestivalKind && d.Kind != VratKind {
+		return fmt.Errorf("definition %q: unknown kind %q", d.ID, d.Kind)
+	}
+	if len(d.Names) == 0 {
+		return fmt.Errorf("definition %q: no names", d.ID)
+	}
+	if d.Masa != "" {
+		if _, ok := astronomy.MasaByName(d.Masa); !ok {
+			return fmt.Errorf("definition %q: unknown masa %q", d.ID, d.Masa)
+		}
+	}
+	if d.Paksha != "" && d.Paksha != ShuklaPaksha && d.Paksha != KrishnaPaksha {
+		return fmt.Errorf("definition %q: unknown paksha %q", d.ID, d.Paksha)
+	}
+	if d.Tithi < 0 || d.Tithi > 15 {
+		return fmt.Errorf("definition %q: tithi %d out of range 1-15", d.ID, d.Tithi)
+	}
+	if d.Nakshatra != "" {
+		if _, ok := astronomy.NakshatraByName(d.Nakshatra); !ok {
+			return fmt.Errorf("definition %q: unknown nakshatra %q", d.ID, d.Nakshatra)
+		}
+	}
+	if !d.Kala.valid() {
+		return fmt.Errorf("definition %q: unknown kala %q", d.ID, d.Kala)
+	}
+	if d.Paksha != "" && d.Tithi == 0 {
+		return fmt.Errorf("definition %q: paksha without tithi", d.ID)
+	}
+	if d.SolarMonth != "" {
+		if _, ok := astronomy.RashiByName(d.SolarMonth); !ok {
+			return fmt.Errorf("definition %q: unknown solar month %q", d.ID, d.SolarMonth)
+		}
+		if _, err := calendar.ParseSolarCalendar(string(d.Calendar)); err != nil {
+			return fmt.Errorf("definition %q: unknown calendar %q", d.ID, d.Calendar)
+		}
+	} else if d.SolarDay != 0 || d.MinSolarDay != 0 || d.Calendar != "" {
+		return fmt.Errorf("definition %q: solar day or calendar without solar month", d.ID)
+	}
+	if d.SolarDay < 0 || d.SolarDay > 32 || d.MinSolarDay < 0 || d.MinSolarDay > 32 {
+		return fmt.Errorf("definition %q: solar day out of range 1-32", d.ID)
+	}
+	if d.Tithi == 0 && d.Nakshatra == "" && d.SolarMonth == "" {
+		return fmt.Errorf("definition %q: needs a tithi, nakshatra or solar month", d.ID)
+	}
+	if len(d.Days) > 0 {
+		if d.Tithi == 0 || d.Kala != "" {
+			return fmt.Errorf("definition %q: days need a tithi at sunrise", d.ID)
+		}
+		for i, day := range d.Days {
+			if len(day.Names) == 0 {
+				return fmt.Errorf("definition %q: day %d has no names", d.ID, i+1)
+			}
+			if i > 0 && day.Offset != d.Days[i-1].Offset+1 {
+				return fmt.Errorf("definition %q: days are not consecutive tithis", d.ID)
+			}
+		}
+		if first, last := d.Days[0].Offset, d.Days[len(d.Days)-1].Offset; first > 0 || last < 0 || last-first >= maxSpanTithis {
+			return fmt.Errorf("definition %q: days must range over offset 0 and fewer than %d tithis", d.ID, maxSpanTithis)
+		}
+	}
+	return nil
+}
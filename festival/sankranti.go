@@ -0,0 +1,54 @@
+package festival
+
+import (
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// sankrantiNames holds the localized names of the sankranti into each sign,
+// starting with Mesha.
+var sankrantiNames = []map[string]string{
+	{"en": "Mesha Sankranti", "hi": "मेष संक्रांति", "ta": "மேஷ சங்கராந்தி"},
+	{"en": "Vrishabha Sankranti", "hi": "वृषभ संक्रांति", "ta": "ரிஷப சங்கராந்தி"},
+	{"en": "Mithuna Sankranti", "hi": "मिथुन संक्रांति", "ta": "மிதுன சங்கராந்தி"},
+	{"en": "Karka Sankranti", "hi": "कर्क संक्रांति", "ta": "கடக சங்கராந்தி"},
+	{"en": "Simha Sankranti", "hi": "सिंह संक्रांति", "ta": "சிம்ம சங்கராந்தி"},
+	{"en": "Kanya Sankranti", "hi": "कन्या संक्रांति", "ta": "கன்னி சங்கராந்தி"},
+	{"en": "Tula Sankranti", "hi": "तुला संक्रांति", "ta": "துலா சங்கராந்தி"},
+	{"en": "Vrishchika Sankranti", "hi": "वृश्चिक संक्रांति", "ta": "விருச்சிக சங்கராந்தி"},
+	{"en": "Dhanu Sankranti", "hi": "धनु संक्रांति", "ta": "தனுசு சங்கராந்தி"},
+	{"en": "Makar Sankranti", "hi": "मकर संक्रांति", "ta": "மகர சங்கராந்தி"},
+	{"en": "Kumbha Sankranti", "hi": "कुंभ संक्रांति", "ta": "கும்ப சங்கராந்தி"},
+	{"en": "Meena Sankranti", "hi": "मीन संक्रांति", "ta": "மீன சங்கராந்தி"},
+}
+
+// Sankrantis returns the sankrantis from the civil date of first to that of
+// last inclusive, ordered by date. Dates and times are in the location of
+// first. The definition of each event names the sign entered, e.g.
+// "makara-sankranti", Start is the instant the sun enters the sign and
+// Tithi is the tithi at that instant.
+func Sankrantis(first, last time.Time) []Event {
+	tz := first.Location()
+	y, m, d := first.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	y, m, d = last.In(tz).Date()
+	end := time.Date(y, m, d, 0, 0, 0, 0, tz).AddDate(0, 0, 1)
+
+	var events []Event
+	for _, s := range astronomy.Sankrantis(start, end) {
+		definition := strings.ToLower(s.Rashi) + "-sankranti"
+		date := s.Time.In(tz).Format("2006-01-02")
+		events = append(events, Event{
+			ID:         definition + "-" + date,
+			Definition: definition,
+			Kind:       SankrantiKind,
+			Names:      sankrantiNames[s.Number-1],
+			Date:       date,
+			Tithi:      astronomy.CalculateElements(s.Time).Tithi.Name,
+			Start:      s.Time.In(tz),
+		})
+	}
+	return events
+}
@@ -0,0 +1,24 @@
+package festival
+
+import (
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/calendar"
+)
+
+// SolarCalendar is a regional solar calendar reckoning the solar month of a
+// definition.
+type SolarCalendar = calendar.SolarCalendar
+
+// The solar calendars, described in the calendar package.
+const (
+	TamilCalendar     = calendar.TamilCalendar
+	MalayalamCalendar = calendar.MalayalamCalendar
+	BengaliCalendar   = calendar.BengaliCalendar
+	OdiaCalendar      = calendar.OdiaCalendar
+)
+
+// matchesSolar reports whether date satisfies the solar conditions of d.
+func (d *Definition) matchesSolar(date calendar.SolarDate) bool {
+	month, _ := astronomy.RashiByName(d.SolarMonth)
+	return date.Month == month && (d.SolarDay == 0 || d.SolarDay == date.Day) && date.Day >= d.MinSolarDay
+}
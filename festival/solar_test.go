@@ -0,0 +1,60 @@
+package festival
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestRegionalSolarFestivals(t *testing.T) {
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	locations := map[string]astronomy.Location{
+		"tamil_nadu": {Latitude: 13.0827, Longitude: 80.2707},
+		"kerala":     {Latitude: 8.5241, Longitude: 76.9366},
+		"bengal":     {Latitude: 22.5726, Longitude: 88.3639},
+	}
+	c := NewCatalogs()
+	// Mesha Sankranti fell at 15:12 IST on 2023-04-14, after the madhyahna
+	// but before sunset, and at 21:15 IST on 2024-04-13, after sunset.
+	tests := []struct {
+		region     string
+		definition string
+		year       int
+		want       []string
+	}{
+		{"tamil_nadu", "puthandu", 2023, []string{"2023-04-14"}},
+		{"kerala", "vishu", 2023, []string{"2023-04-15"}},
+		{"bengal", "poila-boishakh", 2023, []string{"2023-04-15"}},
+		{"tamil_nadu", "puthandu", 2024, []string{"2024-04-14"}},
+		{"bengal", "poila-boishakh", 2024, []string{"2024-04-14"}},
+		{"tamil_nadu", "thai-pongal", 2024, []string{"2024-01-15"}},
+		{"tamil_nadu", "thai-pongal", 2025, []string{"2025-01-14"}},
+		// Thiruvonam also prevailed on Chingam 4 in 2024.
+		{"kerala", "onam", 2024, []string{"2024-09-15"}},
+		{"kerala", "onam", 2025, []string{"2025-09-05"}},
+		{"bengal", "durga-puja", 2025, []string{"2025-09-28"}},
+	}
+	for _, tt := range tests {
+		rules, err := c.ForRegion(tt.region)
+		if err != nil {
+			t.Fatal(err)
+		}
+		events, err := rules.GenerateYear(tt.year, tt.region, locations[tt.region], tz)
+		if err != nil {
+			t.Fatalf("GenerateYear(%d, %s) error = %v", tt.year, tt.region, err)
+		}
+		var got []string
+		for _, e := range events {
+			if e.Definition == tt.definition {
+				got = append(got, e.Date)
+			}
+		}
+		if len(got) != len(tt.want) || len(got) > 0 && got[0] != tt.want[0] {
+			t.Errorf("%s %d dates = %v, want %v", tt.definition, tt.year, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,184 @@
+package festival
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// maxSpanTithis bounds the tithis the days of a definition range over, so
+// that a span lies within a paksha's worth of days of its definition.
+const maxSpanTithis = 15
+
+// SpanDay is a day of a festival lasting several days, e.g. Skandamata,
+// the fifth night of Navaratri.
+type SpanDay struct {
+	// Offset is the tithi of the day counted from that of the definition,
+	// e.g. -2 for Dhanteras, two tithis before the Amavasya of Diwali. The
+	// days of a definition have consecutive offsets.
+	Offset int `json:"offset"`
+	// Names maps a locale to the name of the day.
+	Names map[string]string `json:"names"`
+}
+
+// FestivalSpan is an occurrence of a festival lasting several days, with
+// an event for each of its days.
+type FestivalSpan struct {
+	// Event is the occurrence of the definition, on the day its tithi
+	// prevails at sunrise.
+	Event
+	// StartDate and EndDate are the civil dates of the first and last days
+	// in YYYY-MM-DD format.
+	StartDate string
+	EndDate   string
+	Days      []SpanEvent
+}
+
+// SpanEvent is the observance of a day of a FestivalSpan.
+type SpanEvent struct {
+	// ID is stable for a span and the position of the event in it, e.g.
+	// "navaratri-2024-10-03-5" for its fifth event.
+	ID string
+	// Day counts the civil days of the span from 1. It differs from the
+	// position of the day in the definition when a tithi is kshaya or
+	// vriddhi.
+	Day int
+	// Date is the civil date of the observance in YYYY-MM-DD format.
+	Date  string
+	Tithi string
+	Names map[string]string
+	// Kshaya reports that the tithi prevails at no sunrise, so that the
+	// day is observed with the previous one on Date.
+	Kshaya bool
+	// Vriddhi reports that the tithi prevails at a second sunrise, so that
+	// the day is observed again on Date.
+	Vriddhi bool
+}
+
+// Spans returns the occurrences of the definitions with days observed in
+// region at loc that have a day from the civil date of first to that of
+// last inclusive, ordered by their first day. Each span includes all of its
+// days, even those outside the range. Dates are civil dates in the
+// location of first.
+//
+// The days follow the tithis prevailing at sunrise: a day whose tithi is
+// kshaya shares the civil day it falls within, and a day whose tithi is
+// vriddhi is observed on both days it prevails at sunrise, so that Navaratri
+// may last eight or ten days. It returns ErrInvalidRange if last is before
+// first.
+func (s *RuleSet) Spans(ctx context.Context, first, last time.Time, region string, loc astronomy.Location) ([]FestivalSpan, error) {
+	tz := first.Location()
+	y, m, d := first.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	y, m, d = last.In(tz).Date()
+	end := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	if end.Before(start) {
+		return nil, fmt.Errorf("%w: %s is before %s", ErrInvalidRange, end.Format("2006-01-02"), start.Format("2006-01-02"))
+	}
+
+	spanning := &RuleSet{}
+	for _, d := range s.definitions {
+		if len(d.Days) > 0 && d.ObservedIn(region) {
+			spanning.definitions = append(spanning.definitions, d)
+		}
+	}
+	if len(spanning.definitions) == 0 {
+		return nil, nil
+	}
+	// A span lies within maxSpanTithis tithis, lasting at most a day and
+	// two hours each, of the occurrence of its definition.
+	events, err := spanning.GenerateContext(ctx, start.AddDate(0, 0, -maxSpanTithis-2), end.AddDate(0, 0, maxSpanTithis+2), region, loc)
+	if err != nil {
+		return nil, err
+	}
+	firstDate, lastDate := start.Format("2006-01-02"), end.Format("2006-01-02")
+	var spans []FestivalSpan
+	for _, e := range events {
+		d, _ := spanning.Lookup(e.Definition)
+		span, err := spanOf(ctx, &d, e, loc, tz)
+		if err != nil {
+			return nil, err
+		}
+		if span.EndDate >= firstDate && span.StartDate <= lastDate {
+			spans = append(spans, span)
+		}
+	}
+	sort.SliceStable(spans, func(i, j int) bool { return spans[i].StartDate < spans[j].StartDate })
+	return spans, nil
+}
+
+// spanOf returns the span of d around its occurrence e, dated in tz.
+func spanOf(ctx context.Context, d *Definition, e Event, loc astronomy.Location, tz *time.Location) (FestivalSpan, error) {
+	span := FestivalSpan{Event: e}
+	anchor, err := time.ParseInLocation("2006-01-02", e.Date, tz)
+	if err != nil {
+		return span, err
+	}
+	firstOffset, lastOffset := d.Days[0].Offset, d.Days[len(d.Days)-1].Offset
+
+	// The days before and after the span bound its kshaya and vriddhi
+	// tithis.
+	from := anchor.AddDate(0, 0, firstOffset-3)
+	var tithis []int
+	for day := from; !day.After(anchor.AddDate(0, 0, lastOffset+3)); day = day.AddDate(0, 0, 1) {
+		if err := ctx.Err(); err != nil {
+			return span, err
+		}
+		sunTimes, err := astronomy.CalculateSunTimes(loc, day)
+		if err != nil {
+			return span, fmt.Errorf("calculating sunrise on %s: %w", day.Format("2006-01-02"), err)
+		}
+		tithis = append(tithis, astronomy.CalculateElements(sunTimes.Sunrise).Tithi.Number)
+	}
+	// offsets holds the tithi at each sunrise counted from that of the
+	// definition.
+	anchorTithi := tithis[3-firstOffset]
+	offsets := make([]int, len(tithis))
+	for i, tithi := range tithis {
+		offsets[i] = ((tithi-anchorTithi)%30 + 30) % 30
+		if offsets[i] >= 15 {
+			offsets[i] -= 30
+		}
+	}
+
+	for i := 1; i < len(offsets)-1; i++ {
+		date := from.AddDate(0, 0, i).Format("2006-01-02")
+		var days []SpanEvent
+		// The tithis from that at this sunrise to that before the next
+		// sunrise are observed today.
+		for offset := offsets[i]; offset < max(offsets[i+1], offsets[i]+1); offset++ {
+			if offset < firstOffset || offset > lastOffset {
+				continue
+			}
+			n := offset - firstOffset
+			days = append(days, SpanEvent{
+				ID:      e.ID + "-" + strconv.Itoa(len(span.Days)+len(days)+1),
+				Date:    date,
+				Tithi:   astronomy.TithiByNumber(anchorTithi + offset).Name,
+				Names:   d.Days[n].Names,
+				Kshaya:  offset != offsets[i],
+				Vriddhi: offset == offsets[i-1],
+			})
+		}
+		if len(days) == 0 {
+			continue
+		}
+		if span.StartDate == "" {
+			span.StartDate = date
+		}
+		span.EndDate = date
+		day := len(span.Days)
+		if day > 0 {
+			day = span.Days[day-1].Day
+		}
+		for j := range days {
+			days[j].Day = day + 1
+		}
+		span.Days = append(span.Days, days...)
+	}
+	return span, nil
+}
@@ -0,0 +1,124 @@
+package festival
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestSpans(t *testing.T) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	type day struct {
+		day   int
+		date  string
+		name  string
+		flags string
+	}
+	tests := []struct {
+		name        string
+		first, last time.Time
+		id          string
+		start, end  string
+		days        []day
+	}{
+		{
+			// Tritiya prevails at two sunrises, so Navaratri lasts ten days.
+			name:  "vriddhi",
+			first: time.Date(2025, 9, 25, 0, 0, 0, 0, tz), last: time.Date(2025, 9, 25, 0, 0, 0, 0, tz),
+			id: "navaratri-2025-09-22", start: "2025-09-22", end: "2025-10-01",
+			days: []day{
+				{1, "2025-09-22", "Shailaputri", ""},
+				{2, "2025-09-23", "Brahmacharini", ""},
+				{3, "2025-09-24", "Chandraghanta", ""},
+				{4, "2025-09-25", "Chandraghanta", "vriddhi"},
+				{5, "2025-09-26", "Kushmanda", ""},
+				{6, "2025-09-27", "Skandamata", ""},
+				{7, "2025-09-28", "Katyayani", ""},
+				{8, "2025-09-29", "Kalaratri", ""},
+				{9, "2025-09-30", "Mahagauri", ""},
+				{10, "2025-10-01", "Siddhidatri", ""},
+			},
+		},
+		{
+			// Chaturthi prevails at no sunrise, so Navaratri lasts eight days.
+			name:  "kshaya",
+			first: time.Date(2021, 10, 1, 0, 0, 0, 0, tz), last: time.Date(2021, 10, 31, 0, 0, 0, 0, tz),
+			id: "navaratri-2021-10-07", start: "2021-10-07", end: "2021-10-14",
+			days: []day{
+				{1, "2021-10-07", "Shailaputri", ""},
+				{2, "2021-10-08", "Brahmacharini", ""},
+				{3, "2021-10-09", "Chandraghanta", ""},
+				{3, "2021-10-09", "Kushmanda", "kshaya"},
+				{4, "2021-10-10", "Skandamata", ""},
+				{5, "2021-10-11", "Katyayani", ""},
+				{6, "2021-10-12", "Kalaratri", ""},
+				{7, "2021-10-13", "Mahagauri", ""},
+				{8, "2021-10-14", "Siddhidatri", ""},
+			},
+		},
+		{
+			// The span starts before the Amavasya of Diwali and before the
+			// range.
+			name:  "days before the definition",
+			first: time.Date(2024, 11, 3, 0, 0, 0, 0, tz), last: time.Date(2024, 11, 30, 0, 0, 0, 0, tz),
+			id: "diwali-2024-11-01", start: "2024-10-30", end: "2024-11-03",
+			days: []day{
+				{1, "2024-10-30", "Dhanteras", ""},
+				{2, "2024-10-31", "Naraka Chaturdashi", ""},
+				{3, "2024-11-01", "Lakshmi Puja", ""},
+				{4, "2024-11-02", "Govardhan Puja", ""},
+				{5, "2024-11-03", "Bhai Dooj", ""},
+			},
+		},
+	}
+	for _, tt := range tests {
+		spans, err := DefaultRuleSet().Spans(context.Background(), tt.first, tt.last, "north_india", delhi)
+		if err != nil {
+			t.Fatalf("%s: Spans() error = %v", tt.name, err)
+		}
+		if len(spans) != 1 {
+			t.Fatalf("%s: Spans() returned %d spans, want 1", tt.name, len(spans))
+		}
+		s := spans[0]
+		if s.ID != tt.id || s.StartDate != tt.start || s.EndDate != tt.end {
+			t.Errorf("%s: span = %s %s..%s, want %s %s..%s", tt.name, s.ID, s.StartDate, s.EndDate, tt.id, tt.start, tt.end)
+		}
+		var got []day
+		for _, d := range s.Days {
+			flags := ""
+			if d.Kshaya {
+				flags = "kshaya"
+			}
+			if d.Vriddhi {
+				flags = "vriddhi"
+			}
+			got = append(got, day{d.Day, d.Date, d.Names["en"], flags})
+		}
+		if len(got) != len(tt.days) {
+			t.Fatalf("%s: days = %v, want %v", tt.name, got, tt.days)
+		}
+		seen := map[string]bool{}
+		for i := range got {
+			if got[i] != tt.days[i] {
+				t.Errorf("%s: day %d = %v, want %v", tt.name, i, got[i], tt.days[i])
+			}
+			if seen[s.Days[i].ID] {
+				t.Errorf("%s: duplicate day id %s", tt.name, s.Days[i].ID)
+			}
+			seen[s.Days[i].ID] = true
+		}
+	}
+
+	first := time.Date(2024, 1, 1, 0, 0, 0, 0, tz)
+	if _, err := DefaultRuleSet().Spans(context.Background(), first, first.AddDate(0, 0, -1), "", delhi); !errors.Is(err, ErrInvalidRange) {
+		t.Errorf("Spans() error = %v, want ErrInvalidRange", err)
+	}
+}
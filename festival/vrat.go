@@ -0,0 +1,195 @@
+package festival
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// vratListDefinitions are the definitions of the year-at-a-glance vrat
+// list, besides the sankrantis.
+var vratListDefinitions = map[string]bool{"ekadashi": true, "purnima": true, "amavasya": true}
+
+// ekadashiNames holds the English names of the ekadashis of each amanta
+// month from Chaitra, Shukla paksha first.
+var ekadashiNames = [12][2]string{
+	{"Kamada Ekadashi", "Varuthini Ekadashi"},
+	{"Mohini Ekadashi", "Apara Ekadashi"},
+	{"Nirjala Ekadashi", "Yogini Ekadashi"},
+	{"Devshayani Ekadashi", "Kamika Ekadashi"},
+	{"Shravana Putrada Ekadashi", "Aja Ekadashi"},
+	{"Parsva Ekadashi", "Indira Ekadashi"},
+	{"Papankusha Ekadashi", "Rama Ekadashi"},
+	{"Prabodhini Ekadashi", "Utpanna Ekadashi"},
+	{"Mokshada Ekadashi", "Saphala Ekadashi"},
+	{"Pausha Putrada Ekadashi", "Shattila Ekadashi"},
+	{"Jaya Ekadashi", "Vijaya Ekadashi"},
+	{"Amalaki Ekadashi", "Papmochani Ekadashi"},
+}
+
+// adhikaEkadashiNames holds the names of the ekadashis of an Adhika month.
+var adhikaEkadashiNames = [2]string{"Padmini Ekadashi", "Parama Ekadashi"}
+
+// EkadashiName returns the English name of the ekadashi of the given paksha
+// in masa, e.g. "Nirjala Ekadashi" for Jyeshtha Shukla paksha.
+func EkadashiName(masa astronomy.Masa, paksha Paksha) string {
+	i := 0
+	if paksha == KrishnaPaksha {
+		i = 1
+	}
+	if masa.Adhika {
+		return adhikaEkadashiNames[i]
+	}
+	if masa.Number < 1 || masa.Number > len(ekadashiNames) {
+		return "Ekadashi"
+	}
+	return ekadashiNames[masa.Number-1][i]
+}
+
+// VratDate is an entry of the year-at-a-glance vrat list: an ekadashi,
+// purnima, amavasya or sankranti.
+type VratDate struct {
+	Event
+	Weekday time.Weekday
+	// ParanaStart and ParanaEnd bound the window in which the fast of an
+	// ekadashi is broken, on the day after it. They are zero for the other
+	// entries.
+	ParanaStart time.Time
+	ParanaEnd   time.Time
+	// Viddha reports an ekadashi touched by Dashami at arunodaya. See
+	// Ekadashi.
+	Viddha bool
+}
+
+// VratList returns the ekadashis, purnimas, amavasyas and sankrantis of
+// the given Gregorian year at loc, ordered by date, with the parana window
+// of each ekadashi. Ekadashis are observed following tradition and named
+// after their month, e.g. "Nirjala Ekadashi". Dates and times are in tz.
+func (s *RuleSet) VratList(year int, region string, loc astronomy.Location, tz *time.Location, tradition Tradition) ([]VratDate, error) {
+	first := time.Date(year, 1, 1, 0, 0, 0, 0, tz)
+	last := first.AddDate(1, 0, -1)
+	events, err := s.Generate(first, last, region, loc)
+	if err != nil {
+		return nil, err
+	}
+
+	var list []VratDate
+	for _, e := range events {
+		// Ekadashis are not observed simply when the tithi prevails at
+		// sunrise; they are listed below.
+		if !vratListDefinitions[e.Definition] || e.Definition == "ekadashi" {
+			continue
+		}
+		day, err := time.ParseInLocation("2006-01-02", e.Date, tz)
+		if err != nil {
+			return nil, err
+		}
+		list = append(list, VratDate{Event: e, Weekday: day.Weekday()})
+	}
+	if d, ok := s.Lookup("ekadashi"); ok && d.ObservedIn(region) {
+		ekadashis, err := Ekadashis(context.Background(), first, last, loc, tradition)
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range ekadashis {
+			sunTimes, err := astronomy.CalculateSunTimes(loc, e.Date)
+			if err != nil {
+				return nil, err
+			}
+			date := e.Date.Format("2006-01-02")
+			list = append(list, VratDate{
+				Event: Event{
+					ID:         d.ID + "-" + date,
+					Definition: d.ID,
+					Kind:       d.Kind,
+					Names:      withName(d.Names, "en", e.Name),
+					Date:       date,
+					Tithi:      astronomy.CalculateElements(sunTimes.Sunrise).Tithi.Name,
+				},
+				Weekday:     e.Date.Weekday(),
+				ParanaStart: e.ParanaStart,
+				ParanaEnd:   e.ParanaEnd,
+				Viddha:      e.Viddha,
+			})
+		}
+	}
+	for _, e := range Sankrantis(first, last) {
+		list = append(list, VratDate{Event: e, Weekday: e.Start.Weekday()})
+	}
+	sort.SliceStable(list, func(i, j int) bool { return list[i].Date < list[j].Date })
+	return list, nil
+}
+
+// withName returns a copy of names with the name of locale replaced.
+func withName(names map[string]string, locale, name string) map[string]string {
+	result := make(map[string]string, len(names)+1)
+	for l, n := range names {
+		result[l] = n
+	}
+	result[locale] = name
+	return result
+}
+
+// Parana returns the window in which the fast of the ekadashi observed on
+// the civil date of ekadashi is broken, on the following day at loc.
+//
+// Parana is done after sunrise during Dvadashi, but not during Hari Vasara,
+// the first quarter of Dvadashi, and preferably during pratahkal, the first
+// fifth of the day. The window therefore starts at the later of sunrise and
+// the end of Hari Vasara, and ends at the earlier of the end of pratahkal and
+// the end of Dvadashi. When Hari Vasara ends after pratahkal the window
+// extends to madhyahna, two fifths into the day, or failing that to sunset,
+// but never past the end of Dvadashi. When Dvadashi ends before sunrise the
+// window is pratahkal.
+func Parana(ekadashi time.Time, loc astronomy.Location) (start, end time.Time, err error) {
+	y, m, d := ekadashi.Date()
+	next := time.Date(y, m, d+1, 0, 0, 0, 0, ekadashi.Location())
+	sunTimes, err := astronomy.CalculateSunTimes(loc, next)
+	if err != nil {
+		return time.Time{}, time.Time{}, fmt.Errorf("calculating sunrise on %s: %w", next.Format("2006-01-02"), err)
+	}
+	sunrise := sunTimes.Sunrise
+	fifth := sunTimes.DayLength() / 5
+	pratahkal, madhyahna := sunrise.Add(fifth), sunrise.Add(2*fifth)
+
+	dvadashi := astronomy.CalculateElementPeriod(astronomy.TithiElement, sunrise)
+	if isTithi(dvadashi.Element, 11) {
+		// Ekadashi lasts past sunrise; Dvadashi follows it.
+		dvadashi = astronomy.CalculateElementPeriod(astronomy.TithiElement, dvadashi.End)
+	}
+	if !isTithi(dvadashi.Element, 12) {
+		// Dvadashi ended before sunrise.
+		return sunrise, pratahkal, nil
+	}
+
+	hariVasara := dvadashi.Start.Add(dvadashi.End.Sub(dvadashi.Start) / 4)
+	start = later(sunrise, hariVasara)
+	for _, limit := range []time.Time{pratahkal, madhyahna, sunTimes.Sunset, dvadashi.End} {
+		if end = earlier(limit, dvadashi.End); end.After(start) {
+			break
+		}
+	}
+	return start.In(sunrise.Location()), end.In(sunrise.Location()), nil
+}
+
+// isTithi reports whether tithi is the given tithi (1-15) of either paksha.
+func isTithi(tithi astronomy.Element, n int) bool {
+	return tithi.Number == n || tithi.Number == n+15
+}
+
+func later(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
+
+func earlier(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,91 @@
+package festival
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestVratList(t *testing.T) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+	list, err := DefaultRuleSet().VratList(2024, "", delhi, tz, SmartaTradition)
+	if err != nil {
+		t.Fatalf("VratList() error = %v", err)
+	}
+
+	counts := map[Kind]int{}
+	names := map[string]string{}
+	for i, v := range list {
+		if i > 0 && v.Date < list[i-1].Date {
+			t.Errorf("%s listed after %s", v.Date, list[i-1].Date)
+		}
+		if day, _ := time.ParseInLocation("2006-01-02", v.Date, tz); day.Weekday() != v.Weekday {
+			t.Errorf("%s: weekday %v, want %v", v.ID, v.Weekday, day.Weekday())
+		}
+		counts[v.Kind]++
+		if v.Definition != "ekadashi" {
+			if !v.ParanaStart.IsZero() {
+				t.Errorf("%s has a parana window", v.ID)
+			}
+			continue
+		}
+		names[v.Date] = v.Names["en"]
+		if !v.ParanaEnd.After(v.ParanaStart) || v.ParanaStart.Format("2006-01-02") <= v.Date {
+			t.Errorf("%s: parana %v - %v, want a window on the next day", v.ID, v.ParanaStart, v.ParanaEnd)
+		}
+	}
+	if counts[SankrantiKind] != 12 {
+		t.Errorf("listed %d sankrantis, want 12", counts[SankrantiKind])
+	}
+	if counts[VratKind] < 48 {
+		t.Errorf("listed %d ekadashis, purnimas and amavasyas, want at least 48", counts[VratKind])
+	}
+	for date, want := range map[string]string{
+		"2024-01-07": "Saphala Ekadashi",
+		"2024-06-17": "Nirjala Ekadashi",
+		"2024-07-17": "Devshayani Ekadashi",
+		"2024-11-12": "Prabodhini Ekadashi",
+	} {
+		if names[date] != want {
+			t.Errorf("ekadashi on %s = %q, want %q", date, names[date], want)
+		}
+	}
+}
+
+func TestParana(t *testing.T) {
+	delhi := astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Skip("timezone data unavailable")
+	}
+	// Saphala Ekadashi, 7 January 2024: parana from 07:15 to 09:20 at New
+	// Delhi, during pratahkal.
+	start, end, err := Parana(time.Date(2024, 1, 7, 0, 0, 0, 0, tz), delhi)
+	if err != nil {
+		t.Fatalf("Parana() error = %v", err)
+	}
+	wantStart := time.Date(2024, 1, 8, 7, 15, 0, 0, tz)
+	wantEnd := time.Date(2024, 1, 8, 9, 20, 0, 0, tz)
+	if start.Sub(wantStart).Abs() > 2*time.Minute || end.Sub(wantEnd).Abs() > 2*time.Minute {
+		t.Errorf("Parana() = %v - %v, want %v - %v", start, end, wantStart, wantEnd)
+	}
+}
+
+func TestEkadashiName(t *testing.T) {
+	jyeshtha, _ := astronomy.MasaByName("Jyeshtha")
+	if got := EkadashiName(jyeshtha, ShuklaPaksha); got != "Nirjala Ekadashi" {
+		t.Errorf("EkadashiName(Jyeshtha, shukla) = %q", got)
+	}
+	if got := EkadashiName(jyeshtha, KrishnaPaksha); got != "Yogini Ekadashi" {
+		t.Errorf("EkadashiName(Jyeshtha, krishna) = %q", got)
+	}
+	jyeshtha.Adhika = true
+	if got := EkadashiName(jyeshtha, ShuklaPaksha); got != "Padmini Ekadashi" {
+		t.Errorf("EkadashiName(Adhika Jyeshtha, shukla) = %q", got)
+	}
+}
@@ -0,0 +1,228 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"slices"
+	"sort"
+
+	"github.com/naren-m/panchangam/parallel"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// Bounds of a batch request: the size of its body, and the backend calls
+// it makes at once.
+const (
+	maxBatchBody = 1 << 20
+	batchWorkers = 8
+)
+
+// WithMaxBatch sets the most items a batch request may list, by default
+// aaa.DefaultLimits.MaxBatch. Zero or less lifts the limit.
+func WithMaxBatch(n int) Option {
+	return func(g *Gateway) {
+		g.maxBatch = n
+	}
+}
+
+// batchRequest is the body of a batch request. Each item holds the query
+// parameters of /api/v1/panchangam, e.g.
+//
+//	{"items": [{"date": "2024-04-09", "lat": 13.0827, "lon": 80.2707}]}
+type batchRequest struct {
+	Items []map[string]json.RawMessage `json:"items"`
+}
+
+// batchResponse is the body of the response to a batch request, with a
+// result for each item in the order of the request.
+type batchResponse struct {
+	Results []batchResult `json:"results"`
+}
+
+// batchResult holds either the panchangam of an item or its error.
+type batchResult struct {
+	Panchangam json.RawMessage `json:"panchangam,omitempty"`
+	Error      *batchError     `json:"error,omitempty"`
+}
+
+// batchError is the error of an item, as in the error bodies of the
+// gateway.
+type batchError struct {
+	Code    string        `json:"code"`
+	Message string        `json:"message"`
+	Details []errorDetail `json:"details,omitempty"`
+}
+
+// getPanchangamBatch serves the panchangams of several dates and locations
+// in one response, so that a caller showing many cities needs a single
+// round trip. Each item is computed by its own call to the backend, which
+// rate limits the caller for every item, and an item that fails has an
+// error in place of its panchangam while the others succeed. Batches are
+// neither cached nor shadowed.
+func (g *Gateway) getPanchangamBatch(w http.ResponseWriter, r *http.Request) {
+	var body batchRequest
+	dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxBatchBody))
+	dec.DisallowUnknownFields()
+	if err := dec.Decode(&body); err != nil {
+		writeError(w, r, status.Errorf(codes.InvalidArgument, "invalid batch request: %v", err))
+		return
+	}
+	if len(body.Items) == 0 {
+		writeError(w, r, status.Error(codes.InvalidArgument, "invalid batch request: no items"))
+		return
+	}
+	if g.maxBatch > 0 && len(body.Items) > g.maxBatch {
+		writeError(w, r, status.Errorf(codes.ResourceExhausted, "items is %d, above the limit of %d", len(body.Items), g.maxBatch))
+		return
+	}
+	locale := requestLocale(w, r)
+	ctx := outgoingContext(r)
+	results, err := parallel.Map(ctx, len(body.Items), batchWorkers, func(ctx context.Context, i int) (batchResult, error) {
+		return g.batchItem(ctx, body.Items[i], locale), nil
+	})
+	if err != nil {
+		writeError(w, r, status.FromContextError(err).Err())
+		return
+	}
+	out, err := json.Marshal(batchResponse{Results: results})
+	if err != nil {
+		writeError(w, r, status.Error(codes.Internal, "failed to encode response"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(out)
+}
+
+// batchItem computes the panchangam of an item of a batch.
+func (g *Gateway) batchItem(ctx context.Context, item map[string]json.RawMessage, locale string) batchResult {
+	values, err := itemValues(item)
+	if err != nil {
+		return errorResult(err)
+	}
+	q := queryParser{values: values}
+	req := panchangamRequest(&q, locale)
+	if q.err != nil {
+		return errorResult(q.err)
+	}
+	resp, err := g.client.Get(ctx, req)
+	if err != nil {
+		return errorResult(err)
+	}
+	data, err := protojson.Marshal(resp.GetPanchangamData())
+	if err != nil {
+		return errorResult(status.Error(codes.Internal, "failed to encode response"))
+	}
+	return batchResult{Panchangam: data}
+}
+
+// itemValues returns the parameters of an item as query values, so that
+// they are read as those of /api/v1/panchangam. Names must be those of
+// panchangamParams, so that a misspelt one such as latitude fails rather
+// than being ignored, and values must be strings, numbers or booleans.
+func itemValues(item map[string]json.RawMessage) (url.Values, error) {
+	names := make([]string, 0, len(item))
+	for name := range item {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	values := url.Values{}
+	for _, name := range names {
+		if !slices.ContainsFunc(panchangamParams, func(p param) bool { return p.name == name }) {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown parameter %q", name)
+		}
+		raw := bytes.TrimSpace(item[name])
+		switch {
+		case bytes.Equal(raw, []byte("null")):
+		case len(raw) > 0 && raw[0] == '"':
+			var s string
+			if err := json.Unmarshal(raw, &s); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid %s: %v", name, err)
+			}
+			values.Set(name, s)
+		case len(raw) > 0 && (raw[0] == '{' || raw[0] == '['):
+			return nil, status.Errorf(codes.InvalidArgument, "invalid %s: expected a string, number or boolean", name)
+		default:
+			values.Set(name, string(raw))
+		}
+	}
+	return values, nil
+}
+
+// errorResult returns the result of an item that failed with err.
+func errorResult(err error) batchResult {
+	st := status.Convert(err)
+	e := &batchError{Code: codeName(st.Code()), Message: st.Message()}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				e.Details = append(e.Details, errorDetail{Field: v.GetField(), Description: v.GetDescription()})
+			}
+		}
+	}
+	return batchResult{Error: e}
+}
+
+// batchRequestSchema returns the schema of the body of a batch request,
+// whose items have the parameters of /api/v1/panchangam as properties.
+func batchRequestSchema(schemas map[string]any) map[string]any {
+	properties := map[string]any{}
+	var required []string
+	for _, p := range panchangamParams {
+		schema := map[string]any{"type": p.typ, "description": p.description}
+		if p.format != "" {
+			schema["format"] = p.format
+		}
+		properties[p.name] = schema
+		if p.required {
+			required = append(required, p.name)
+		}
+	}
+	return map[string]any{
+		"type":     "object",
+		"required": []string{"items"},
+		"properties": map[string]any{
+			"items": map[string]any{
+				"type":        "array",
+				"description": "Dates and locations, up to max-batch of them",
+				"items":       map[string]any{"type": "object", "required": required, "properties": properties},
+			},
+		},
+	}
+}
+
+// batchResponseSchema returns the schema of the response to a batch
+// request.
+func batchResponseSchema(schemas map[string]any) map[string]any {
+	errorProperties := errorSchema["properties"].(map[string]any)
+	return map[string]any{
+		"type": "object",
+		"properties": map[string]any{
+			"results": map[string]any{
+				"type":        "array",
+				"description": "Result of each item, in the order of the request",
+				"items": map[string]any{
+					"type": "object",
+					"properties": map[string]any{
+						"panchangam": messageRef((&ppb.PanchangamData{}).ProtoReflect().Descriptor(), schemas),
+						"error": map[string]any{
+							"type":        "object",
+							"description": "Error of an item that failed, in place of its panchangam",
+							"properties": map[string]any{
+								"code":    errorProperties["code"],
+								"message": errorProperties["message"],
+								"details": errorProperties["details"],
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
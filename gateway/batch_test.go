@@ -0,0 +1,106 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const batchPath = "/api/v1/panchangam/batch"
+
+func TestPanchangamBatch(t *testing.T) {
+	b := &backend{get: func(req *ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error) {
+		if req.Date == "1900-01-01" {
+			st, _ := status.New(codes.InvalidArgument, "date: out of range").WithDetails(&errdetails.BadRequest{
+				FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: "date", Description: "before 1901"}},
+			})
+			return nil, st.Err()
+		}
+		return panchangam(req)
+	}}
+	g := NewGateway(b)
+	resp, body := serve(t, g, http.MethodPost, batchPath, `{"items": [
+		{"date": "2024-04-09", "lat": 13.0827, "lon": 80.2707, "tz": "Asia/Kolkata", "horizon_dip": true},
+		{"date": "2024-04-10", "latitude": 13.0827, "lon": 80.2707},
+		{"date": "2024-04-11", "lat": "north"},
+		{"date": "2024-04-12", "lat": [13]},
+		{"date": "1900-01-01", "lat": 13.0827, "lon": 80.2707},
+		{"date": "2024-04-13", "locale": "en", "region": null}
+	]}`, http.Header{"Accept-Language": {"te"}})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("POST batch = %d: %s", resp.StatusCode, body)
+	}
+	var got batchResponse
+	if err := json.Unmarshal([]byte(body), &got); err != nil {
+		t.Fatal(err)
+	}
+	want := []string{
+		`{"panchangam":{"date":"2024-04-09","tithi":"శుక్ల పాడ్యమి"}}`,
+		`{"error":{"code":"INVALID_ARGUMENT","message":"unknown parameter \"latitude\""}}`,
+		`{"error":{"code":"INVALID_ARGUMENT","message":"invalid lat \"north\": expected a number"}}`,
+		`{"error":{"code":"INVALID_ARGUMENT","message":"invalid lat: expected a string, number or boolean"}}`,
+		`{"error":{"code":"INVALID_ARGUMENT","message":"date: out of range","details":[{"field":"date","description":"before 1901"}]}}`,
+		`{"panchangam":{"date":"2024-04-13","tithi":"Shukla Pratipada"}}`,
+	}
+	if len(got.Results) != len(want) {
+		t.Fatalf("results = %s, want %d", body, len(want))
+	}
+	for i, result := range got.Results {
+		// The panchangams are compacted as protojson spaces them at random.
+		if result.Panchangam != nil {
+			var data map[string]any
+			if err := json.Unmarshal(result.Panchangam, &data); err != nil {
+				t.Fatal(err)
+			}
+			result.Panchangam, _ = json.Marshal(data)
+		}
+		if out, _ := json.Marshal(result); string(out) != want[i] {
+			t.Errorf("results[%d] = %s, want %s", i, out, want[i])
+		}
+	}
+	// Only the valid items reach the backend.
+	if b.calls() != 3 {
+		t.Errorf("backend called %d times, want 3", b.calls())
+	}
+	for _, r := range b.requests {
+		req := r.(*ppb.GetPanchangamRequest)
+		if req.Date == "2024-04-09" && (req.Latitude != 13.0827 || req.Timezone != "Asia/Kolkata" || !req.HorizonDip || req.Locale != "te") {
+			t.Errorf("request of the first item = %v", req)
+		}
+	}
+}
+
+func TestPanchangamBatchInvalid(t *testing.T) {
+	for _, tt := range []struct {
+		name, body string
+		status     int
+		message    string
+	}{
+		{"not json", `items`, http.StatusBadRequest, "invalid batch request: invalid character"},
+		{"unknown field", `{"items": [{"date": "2024-04-09"}], "locale": "te"}`, http.StatusBadRequest, `invalid batch request: json: unknown field "locale"`},
+		{"no items", `{"items": []}`, http.StatusBadRequest, "invalid batch request: no items"},
+		{"too many items", `{"items": [{}, {}, {}]}`, http.StatusTooManyRequests, "items is 3, above the limit of 2"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &backend{get: panchangam}
+			g := NewGateway(b, WithMaxBatch(2))
+			resp, body := serve(t, g, http.MethodPost, batchPath, tt.body, nil)
+			var e errorBody
+			if err := json.Unmarshal([]byte(body), &e); err != nil {
+				t.Fatal(err)
+			}
+			if resp.StatusCode != tt.status || !strings.HasPrefix(e.Message, tt.message) {
+				t.Errorf("POST batch = %d %q, want %d %s", resp.StatusCode, e.Message, tt.status, tt.message)
+			}
+			if b.calls() != 0 {
+				t.Errorf("backend called %d times", b.calls())
+			}
+		})
+	}
+}
@@ -0,0 +1,237 @@
+package gateway
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/cache"
+)
+
+// DefaultResponseTTL is how long responses are kept by the response cache
+// and by the caches of clients, unless an endpoint sets its own lifetime.
+const DefaultResponseTTL = time.Hour
+
+// responseKeyPrefix starts the keys of the responses in the cache backend,
+// which may be shared with other caches.
+const responseKeyPrefix = "gateway:"
+
+// WithResponseCache keeps the successful responses of the endpoints that
+// only depend on their query in backend for ttl, so that popular dates and
+// locations are not recomputed by the backend. Responses are cached per
+// API key, so a cached response is only served to callers sending the key
+// it was computed for; a revoked key may however be served its cached
+// responses until they expire. A nil backend caches nothing, but ttl is
+// still the lifetime clients are allowed to cache the responses for. A ttl
+// of zero selects DefaultResponseTTL.
+func WithResponseCache(backend cache.Backend, ttl time.Duration) Option {
+	return func(g *Gateway) {
+		if ttl <= 0 {
+			ttl = DefaultResponseTTL
+		}
+		g.responses = backend
+		g.responseTTL = ttl
+	}
+}
+
+// cachedResponse is a response stored in the response cache.
+type cachedResponse struct {
+	Header http.Header `json:"header"`
+	Body   []byte      `json:"body"`
+	// Stored is when the response was stored, from which its Age header
+	// counts.
+	Stored time.Time `json:"stored"`
+}
+
+// cachedHeaders lists the headers of a response kept in the response cache.
+var cachedHeaders = []string{"Content-Type", "Cache-Control", "Vary", "Etag"}
+
+// conditional serves the successful responses of handler with a strong
+// ETag, a Cache-Control header, unless the handler sets its own, and a
+// Vary header listing the request headers of the response key, and
+// answers requests whose If-None-Match matches the ETag with 304 Not
+// Modified. With a response cache, the responses are looked up there
+// before calling handler, under a key including that returned by
+// extraKey, if not nil. They are kept no longer than their Cache-Control
+// lets clients keep them.
+func (g *Gateway) conditional(handler http.HandlerFunc, extraKey func(r *http.Request) string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var extra string
+		if extraKey != nil {
+			extra = extraKey(r)
+		}
+		key := responseKey(r, extra)
+		if g.responses != nil {
+			if b, ok, err := g.responses.Get(r.Context(), key); err != nil {
+				logger.WarnContext(r.Context(), "Failed to read the response cache", "error", err)
+			} else if ok {
+				var resp cachedResponse
+				if err := json.Unmarshal(b, &resp); err == nil {
+					if !resp.Stored.IsZero() {
+						age := max(g.now().Sub(resp.Stored), 0)
+						w.Header().Set("Age", strconv.Itoa(int(age.Seconds())))
+					}
+					writeConditional(w, r, resp)
+					return
+				}
+			}
+		}
+
+		rec := &responseRecorder{header: http.Header{}, status: http.StatusOK}
+		handler(rec, r)
+		if rec.status != http.StatusOK {
+			for name, values := range rec.header {
+				w.Header()[name] = values
+			}
+			w.WriteHeader(rec.status)
+			w.Write(rec.body.Bytes())
+			return
+		}
+
+		resp := cachedResponse{Header: http.Header{}, Body: rec.body.Bytes()}
+		for name, values := range rec.header {
+			resp.Header[name] = values
+		}
+		sum := sha256.Sum256(resp.Body)
+		resp.Header.Set("ETag", `"`+hex.EncodeToString(sum[:16])+`"`)
+		if resp.Header.Get("Cache-Control") == "" {
+			resp.Header.Set("Cache-Control", "public, max-age="+strconv.Itoa(int(g.ttl().Seconds())))
+		}
+		// Shared caches must tell apart the responses that the response
+		// cache does, whether or not this request sent the headers.
+		addVary(resp.Header, "Accept-Language", "X-API-Key")
+		writeConditional(w, r, resp)
+
+		ttl := g.ttl()
+		if maxAge, ok := cacheMaxAge(resp.Header.Get("Cache-Control")); ok {
+			ttl = min(ttl, maxAge)
+		}
+		if g.responses != nil && ttl > 0 {
+			stored := cachedResponse{Header: http.Header{}, Body: resp.Body, Stored: g.now()}
+			for _, name := range cachedHeaders {
+				if values := resp.Header.Values(name); len(values) > 0 {
+					stored.Header[name] = values
+				}
+			}
+			b, err := json.Marshal(stored)
+			if err == nil {
+				err = g.responses.Set(r.Context(), key, b, ttl)
+			}
+			if err != nil {
+				logger.WarnContext(r.Context(), "Failed to store a response in the cache", "error", err)
+			}
+		}
+	}
+}
+
+// ttl returns the lifetime of the responses given by the gateway.
+func (g *Gateway) ttl() time.Duration {
+	if g.responseTTL > 0 {
+		return g.responseTTL
+	}
+	return DefaultResponseTTL
+}
+
+// addVary adds names to the Vary header of h that it does not list yet.
+func addVary(h http.Header, names ...string) {
+	listed := map[string]bool{}
+	for _, value := range h.Values("Vary") {
+		for _, name := range strings.Split(value, ",") {
+			listed[http.CanonicalHeaderKey(strings.TrimSpace(name))] = true
+		}
+	}
+	for _, name := range names {
+		if !listed[name] {
+			h.Add("Vary", name)
+			listed[name] = true
+		}
+	}
+}
+
+// cacheMaxAge returns the max-age directive of the Cache-Control header
+// cacheControl, and whether it has one; no-store and no-cache count as a
+// max-age of zero.
+func cacheMaxAge(cacheControl string) (time.Duration, bool) {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, _ := strings.Cut(strings.TrimSpace(directive), "=")
+		switch strings.ToLower(name) {
+		case "no-store", "no-cache":
+			return 0, true
+		case "max-age":
+			if seconds, err := strconv.Atoi(value); err == nil {
+				return time.Duration(seconds) * time.Second, true
+			}
+		}
+	}
+	return 0, false
+}
+
+// writeConditional writes resp, or only its headers with 304 Not Modified
+// if the caller already has it.
+func writeConditional(w http.ResponseWriter, r *http.Request, resp cachedResponse) {
+	for name, values := range resp.Header {
+		w.Header()[name] = values
+	}
+	if etagMatches(r.Header.Get("If-None-Match"), resp.Header.Get("ETag")) {
+		w.Header().Del("Content-Type")
+		w.Header().Del("Content-Length")
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Write(resp.Body)
+}
+
+// etagMatches reports whether the If-None-Match header ifNoneMatch lists
+// etag. It compares the tags weakly, as RFC 9110 requires for
+// If-None-Match.
+func etagMatches(ifNoneMatch, etag string) bool {
+	if ifNoneMatch == "" || etag == "" {
+		return false
+	}
+	for _, tag := range strings.Split(ifNoneMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// responseKey returns the key of the response to r in the response cache.
+// It covers everything the response depends on: the path, the query in a
+// canonical order, the languages accepted, the API key, which is hashed
+// so that it is not stored in a shared backend, and extra, what the route
+// adds.
+func responseKey(r *http.Request, extra string) string {
+	h := sha256.New()
+	for _, part := range []string{
+		r.URL.Path,
+		r.URL.Query().Encode(),
+		r.Header.Get("Accept-Language"),
+		r.Header.Get("X-API-Key"),
+		extra,
+	} {
+		h.Write([]byte(part))
+		h.Write([]byte{0})
+	}
+	return responseKeyPrefix + hex.EncodeToString(h.Sum(nil))
+}
+
+// responseRecorder records the response of a handler, so that it can be
+// given an ETag and cached before it is written.
+type responseRecorder struct {
+	header http.Header
+	status int
+	body   bytes.Buffer
+}
+
+func (rec *responseRecorder) Header() http.Header { return rec.header }
+
+func (rec *responseRecorder) WriteHeader(status int) { rec.status = status }
+
+func (rec *responseRecorder) Write(b []byte) (int, error) { return rec.body.Write(b) }
@@ -0,0 +1,182 @@
+package gateway
+
+import (
+	"net/http"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/cache"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const panchangamPath = "/api/v1/panchangam?date=2024-04-09&lat=13.0827&lon=80.2707"
+
+func TestConditional(t *testing.T) {
+	b := &backend{get: panchangam}
+	g := NewGateway(b, WithResponseCache(nil, 10*time.Minute))
+
+	resp, body := serve(t, g, http.MethodGet, panchangamPath, "", nil)
+	etag := resp.Header.Get("ETag")
+	if resp.StatusCode != http.StatusOK || !strings.HasPrefix(etag, `"`) || !strings.Contains(body, `"date":"2024-04-09"`) {
+		t.Fatalf("GET = %d, ETag %s: %s", resp.StatusCode, etag, body)
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != "public, max-age=600" {
+		t.Errorf("Cache-Control = %q", cc)
+	}
+	// Shared caches must vary on the headers even when they are missing.
+	if vary := resp.Header.Values("Vary"); !slices.Equal(vary, []string{"Accept-Language", "X-API-Key"}) {
+		t.Errorf("Vary = %q", vary)
+	}
+
+	for _, ifNoneMatch := range []string{etag, "W/" + etag, `"other", ` + etag, "*"} {
+		resp, body := serve(t, g, http.MethodGet, panchangamPath, "", http.Header{"If-None-Match": {ifNoneMatch}})
+		if resp.StatusCode != http.StatusNotModified || body != "" || resp.Header.Get("ETag") != etag {
+			t.Errorf("GET If-None-Match: %s = %d, ETag %s: %q", ifNoneMatch, resp.StatusCode, resp.Header.Get("ETag"), body)
+		}
+		if vary := resp.Header.Values("Vary"); len(vary) != 2 {
+			t.Errorf("304 Vary = %q", vary)
+		}
+	}
+	if resp, _ := serve(t, g, http.MethodGet, panchangamPath, "", http.Header{"If-None-Match": {`"other"`}}); resp.StatusCode != http.StatusOK {
+		t.Errorf("GET If-None-Match another ETag = %d, want 200", resp.StatusCode)
+	}
+
+	// A localized response has its own ETag, and Vary once.
+	resp, _ = serve(t, g, http.MethodGet, panchangamPath, "", http.Header{"Accept-Language": {"te, en;q=0.5"}, "X-Api-Key": {"k1"}})
+	if resp.Header.Get("ETag") == etag {
+		t.Errorf("Telugu response has the ETag of the English one")
+	}
+	if vary := resp.Header.Values("Vary"); !slices.Equal(vary, []string{"Accept-Language", "X-API-Key"}) {
+		t.Errorf("Vary of a localized response = %q", vary)
+	}
+
+	// Volatile endpoints are neither given an ETag nor cached.
+	b.getVersion = func(*ppb.GetVersionRequest) (*ppb.VersionInfo, error) { return &ppb.VersionInfo{}, nil }
+	if resp, _ := serve(t, g, http.MethodGet, "/api/v1/version", "", nil); resp.Header.Get("ETag") != "" || resp.Header.Get("Cache-Control") != "" {
+		t.Errorf("GET /api/v1/version has ETag %q and Cache-Control %q", resp.Header.Get("ETag"), resp.Header.Get("Cache-Control"))
+	}
+}
+
+func TestResponseCache(t *testing.T) {
+	b := &backend{get: panchangam}
+	g := NewGateway(b, WithResponseCache(cache.NewMemoryBackend(10), time.Hour))
+	now := time.Date(2024, 4, 9, 6, 0, 0, 0, time.UTC)
+	g.now = func() time.Time { return now }
+
+	first, body := serve(t, g, http.MethodGet, panchangamPath, "", nil)
+	now = now.Add(90 * time.Second)
+	cached, cachedBody := serve(t, g, http.MethodGet, panchangamPath+"&", "", nil)
+	if b.calls() != 1 {
+		t.Fatalf("backend called %d times for the same query, want 1", b.calls())
+	}
+	if cachedBody != body || cached.Header.Get("ETag") != first.Header.Get("ETag") || cached.Header.Get("Age") != "90" {
+		t.Errorf("cached response = ETag %s, Age %q: %s", cached.Header.Get("ETag"), cached.Header.Get("Age"), cachedBody)
+	}
+	if vary := cached.Header.Values("Vary"); len(vary) != 2 {
+		t.Errorf("cached response Vary = %q", vary)
+	}
+	if resp, _ := serve(t, g, http.MethodGet, panchangamPath, "", http.Header{"If-None-Match": {first.Header.Get("ETag")}}); resp.StatusCode != http.StatusNotModified {
+		t.Errorf("cached GET If-None-Match = %d, want 304", resp.StatusCode)
+	}
+
+	// Responses are cached per query, locale and API key.
+	for _, header := range []http.Header{
+		{"Accept-Language": {"te"}},
+		{"X-Api-Key": {"k1"}},
+		{"X-Api-Key": {"k2"}},
+	} {
+		calls := b.calls()
+		serve(t, g, http.MethodGet, panchangamPath, "", header)
+		serve(t, g, http.MethodGet, panchangamPath, "", header)
+		if b.calls() != calls+1 {
+			t.Errorf("backend called %d times for two requests with %v, want 1", b.calls()-calls, header)
+		}
+	}
+	_, body = serve(t, g, http.MethodGet, panchangamPath, "", http.Header{"Accept-Language": {"te"}})
+	if !strings.Contains(body, "శుక్ల") {
+		t.Errorf("cached Telugu response: %s", body)
+	}
+
+	// Errors are not cached.
+	b.get = func(*ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error) {
+		return nil, status.Error(codes.Unavailable, "overloaded")
+	}
+	calls := b.calls()
+	for range 2 {
+		if resp, _ := serve(t, g, http.MethodGet, "/api/v1/panchangam?date=2024-04-10", "", nil); resp.StatusCode != http.StatusServiceUnavailable || resp.Header.Get("ETag") != "" {
+			t.Errorf("failed GET = %d, ETag %q", resp.StatusCode, resp.Header.Get("ETag"))
+		}
+	}
+	if b.calls() != calls+2 {
+		t.Errorf("backend called %d times for two failing requests, want 2", b.calls()-calls)
+	}
+}
+
+func TestResponseKey(t *testing.T) {
+	key := func(target string, header http.Header, extra string) string {
+		r, _ := http.NewRequest(http.MethodGet, target, nil)
+		r.Header = header
+		return responseKey(r, extra)
+	}
+	base := key("/api/v1/panchangam?date=2024-04-09&lat=1", nil, "")
+	if k := key("/api/v1/panchangam?lat=1&date=2024-04-09", nil, ""); k != base {
+		t.Errorf("key depends on the order of the query")
+	}
+	if !strings.HasPrefix(base, responseKeyPrefix) || strings.Contains(key("/", http.Header{"X-Api-Key": {"secret"}}, ""), "secret") {
+		t.Errorf("key %s", base)
+	}
+	for name, k := range map[string]string{
+		"path":            key("/api/v1/summary?date=2024-04-09&lat=1", nil, ""),
+		"query":           key("/api/v1/panchangam?date=2024-04-10&lat=1", nil, ""),
+		"Accept-Language": key("/api/v1/panchangam?date=2024-04-09&lat=1", http.Header{"Accept-Language": {"te"}}, ""),
+		"X-API-Key":       key("/api/v1/panchangam?date=2024-04-09&lat=1", http.Header{"X-Api-Key": {"k"}}, ""),
+		"extra":           key("/api/v1/panchangam?date=2024-04-09&lat=1", nil, "2024-04-09"),
+	} {
+		if k == base {
+			t.Errorf("key does not depend on the %s", name)
+		}
+	}
+}
+
+func TestCacheMaxAge(t *testing.T) {
+	for _, tt := range []struct {
+		cacheControl string
+		want         time.Duration
+		ok           bool
+	}{
+		{"", 0, false},
+		{"public", 0, false},
+		{"public, max-age=3600", time.Hour, true},
+		{"Max-Age=60, public", time.Minute, true},
+		{"no-store", 0, true},
+		{"private, no-cache", 0, true},
+		{"max-age=soon", 0, false},
+	} {
+		if got, ok := cacheMaxAge(tt.cacheControl); got != tt.want || ok != tt.ok {
+			t.Errorf("cacheMaxAge(%q) = %v, %v, want %v, %v", tt.cacheControl, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestEtagMatches(t *testing.T) {
+	for _, tt := range []struct {
+		ifNoneMatch, etag string
+		want              bool
+	}{
+		{"", `"a"`, false},
+		{`"a"`, "", false},
+		{`"a"`, `"a"`, true},
+		{`W/"a"`, `"a"`, true},
+		{`"b", "a"`, `"a"`, true},
+		{`"b"`, `"a"`, false},
+		{"*", `"a"`, true},
+	} {
+		if got := etagMatches(tt.ifNoneMatch, tt.etag); got != tt.want {
+			t.Errorf("etagMatches(%q, %q) = %v", tt.ifNoneMatch, tt.etag, got)
+		}
+	}
+}
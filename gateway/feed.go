@@ -0,0 +1,330 @@
+package gateway
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/location"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// defaultFeedDays is the number of days of festivals a feed lists.
+const defaultFeedDays = 60
+
+// WithPublicURL sets the URL the gateway is reached at by its clients, e.g.
+// https://panchangam.example.com, which the links of its feeds start with.
+// Without it they start with the scheme and Host header of each request,
+// and the feeds are cached per host.
+func WithPublicURL(u *url.URL) Option {
+	return func(g *Gateway) {
+		g.publicURL = strings.TrimSuffix(u.String(), "/")
+	}
+}
+
+// getFestivalFeed serves the festivals, vrats, sankrantis and eclipses of
+// the next days at a location as an RSS 2.0 feed or, with format=atom, an
+// Atom feed, so that feed readers and static sites can follow them.
+func (g *Gateway) getFestivalFeed(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.GetEventsRequest{
+		Date:      q.string("date"),
+		Days:      int32(q.int("days")),
+		Latitude:  q.float("lat"),
+		Longitude: q.float("lon"),
+		Timezone:  q.string("tz"),
+		Region:    q.string("region"),
+		Type:      q.string("type"),
+	}
+	format := q.string("format")
+	if q.err == nil && format != "" && format != "rss" && format != "atom" {
+		q.err = status.Errorf(codes.InvalidArgument, "invalid format %q: expected rss or atom", format)
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	zone, err := feedZone(req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	if req.Date == "" {
+		today := g.now().In(zone)
+		req.Date = today.Format("2006-01-02")
+		// The feed of today is only fresh until midnight.
+		midnight := time.Date(today.Year(), today.Month(), today.Day()+1, 0, 0, 0, 0, zone)
+		if maxAge := min(g.ttl(), midnight.Sub(today)); maxAge > 0 {
+			w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(int(maxAge.Seconds())))
+		}
+	}
+	if req.Days == 0 {
+		req.Days = defaultFeedDays
+	}
+	locale := requestLocale(w, r)
+
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.GetEvents(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "GetEvents", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetEvents(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+
+	f := newFeed(g.baseURL(r), r, req, resp, zone, locale)
+	var doc any = f.rss()
+	contentType := "application/rss+xml; charset=utf-8"
+	if format == "atom" {
+		doc, contentType = f.atom(), "application/atom+xml; charset=utf-8"
+	}
+	body, err := xml.MarshalIndent(doc, "", "  ")
+	if err != nil {
+		writeError(w, r, status.Error(codes.Internal, "failed to encode feed"))
+		return
+	}
+	w.Header().Set("Content-Type", contentType)
+	w.Write([]byte(xml.Header))
+	w.Write(body)
+}
+
+// feedZone returns the timezone of the dates of the feed requested by req,
+// which is that of its location unless it names one.
+func feedZone(req *ppb.GetEventsRequest) (*time.Location, error) {
+	timezone := req.Timezone
+	if timezone == "" {
+		timezone = location.TimezoneName(req.Latitude, req.Longitude)
+	}
+	zone, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid timezone %q: %v", timezone, err)
+	}
+	return zone, nil
+}
+
+// feedKey returns what a feed depends on beyond its request, for the key
+// of the response cache: the base URL of its links and, without a date,
+// the date of today at its location.
+func (g *Gateway) feedKey(r *http.Request) string {
+	key := g.baseURL(r)
+	q := queryParser{values: r.URL.Query()}
+	if q.string("date") != "" {
+		return key
+	}
+	req := &ppb.GetEventsRequest{Latitude: q.float("lat"), Longitude: q.float("lon"), Timezone: q.string("tz")}
+	if zone, err := feedZone(req); q.err == nil && err == nil {
+		key += " " + g.now().In(zone).Format("2006-01-02")
+	}
+	return key
+}
+
+// feedSchema is the schema of the XML document of a feed.
+func feedSchema(schemas map[string]any) map[string]any {
+	return map[string]any{"type": "string", "description": "RSS 2.0 or Atom document"}
+}
+
+// feed is a list of events independent of the syndication format.
+type feed struct {
+	title, link, self string
+	updated           time.Time
+	entries           []feedEntry
+}
+
+type feedEntry struct {
+	id, title, summary, link string
+	date                     time.Time
+}
+
+// newFeed returns the feed of the events of resp listed for req, named in
+// locale, with links to the events of each date on the gateway at base.
+func newFeed(base string, r *http.Request, req *ppb.GetEventsRequest, resp *ppb.GetEventsResponse, zone *time.Location, locale string) feed {
+	place := fmt.Sprintf("%.4f, %.4f", req.Latitude, req.Longitude)
+	if req.Region != "" {
+		place = req.Region + ", " + place
+	}
+	// eventsLink links to the events from date on the gateway.
+	eventsLink := func(date string, days int32) string {
+		query := url.Values{"date": {date}, "lat": {fmt.Sprint(req.Latitude)}, "lon": {fmt.Sprint(req.Longitude)}, "tz": {zone.String()}}
+		if days > 1 {
+			query.Set("days", strconv.Itoa(int(days)))
+		}
+		if req.Region != "" {
+			query.Set("region", req.Region)
+		}
+		return base + "/api/v1/events?" + query.Encode()
+	}
+	f := feed{
+		title: "Panchangam festivals at " + place,
+		link:  eventsLink(req.Date, req.Days),
+		self:  base + r.URL.RequestURI(),
+	}
+	f.updated, _ = time.ParseInLocation("2006-01-02", req.Date, zone)
+	for _, e := range resp.Festivals {
+		date, err := time.ParseInLocation("2006-01-02", e.Date, zone)
+		if err != nil {
+			continue
+		}
+		title := localizedName(e.Names, locale)
+		summary := fmt.Sprintf("%s on %s", title, date.Format("Monday, 2 January 2006"))
+		if e.Tithi != "" {
+			summary += ", " + e.Tithi
+		}
+		if e.StartTime != "" {
+			summary += fmt.Sprintf(" from %s", e.StartTime)
+			if e.EndTime != "" {
+				summary += " to " + e.EndTime
+			}
+		}
+		f.entries = append(f.entries, feedEntry{
+			id:      e.Id,
+			title:   title,
+			summary: summary + " (" + e.Kind + ")",
+			link:    eventsLink(e.Date, 1),
+			date:    date,
+		})
+	}
+	return f
+}
+
+// localizedName returns the name in locale, or in English if it has none.
+func localizedName(names []*ppb.LocalizedName, locale string) string {
+	var english string
+	for _, n := range names {
+		switch n.Locale {
+		case locale:
+			return n.Name
+		case "en":
+			english = n.Name
+		}
+	}
+	return english
+}
+
+// baseURL returns the URL the links of the response to r start with: the
+// public URL of the gateway or, without one, the scheme and host r was
+// sent to.
+func (g *Gateway) baseURL(r *http.Request) string {
+	if g.publicURL != "" {
+		return g.publicURL
+	}
+	return requestBase(r)
+}
+
+// requestBase returns the scheme and host r was sent to, following the
+// X-Forwarded-Proto header of a proxy terminating TLS.
+func requestBase(r *http.Request) string {
+	scheme := "http"
+	if r.TLS != nil {
+		scheme = "https"
+	}
+	if forwarded := r.Header.Get("X-Forwarded-Proto"); forwarded == "http" || forwarded == "https" {
+		scheme = forwarded
+	}
+	return scheme + "://" + r.Host
+}
+
+type rssDocument struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	LastBuildDate string    `xml:"lastBuildDate"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string  `xml:"title"`
+	Link        string  `xml:"link"`
+	Description string  `xml:"description"`
+	GUID        rssGUID `xml:"guid"`
+	PubDate     string  `xml:"pubDate"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+func (f feed) rss() rssDocument {
+	doc := rssDocument{Version: "2.0", Channel: rssChannel{
+		Title:         f.title,
+		Link:          f.link,
+		Description:   "Upcoming festivals, vrats, sankrantis and eclipses",
+		LastBuildDate: f.updated.Format(time.RFC1123Z),
+	}}
+	for _, e := range f.entries {
+		doc.Channel.Items = append(doc.Channel.Items, rssItem{
+			Title:       e.title,
+			Link:        e.link,
+			Description: e.summary,
+			GUID:        rssGUID{Value: e.id},
+			PubDate:     e.date.Format(time.RFC1123Z),
+		})
+	}
+	return doc
+}
+
+type atomFeed struct {
+	XMLName xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID      string      `xml:"id"`
+	Title   string      `xml:"title"`
+	Updated string      `xml:"updated"`
+	Author  atomAuthor  `xml:"author"`
+	Links   []atomLink  `xml:"link"`
+	Entries []atomEntry `xml:"entry"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomLink struct {
+	Rel  string `xml:"rel,attr,omitempty"`
+	Href string `xml:"href,attr"`
+}
+
+type atomEntry struct {
+	ID      string   `xml:"id"`
+	Title   string   `xml:"title"`
+	Updated string   `xml:"updated"`
+	Summary string   `xml:"summary"`
+	Link    atomLink `xml:"link"`
+}
+
+func (f feed) atom() atomFeed {
+	doc := atomFeed{
+		ID:      f.self,
+		Title:   f.title,
+		Updated: f.updated.Format(time.RFC3339),
+		Author:  atomAuthor{Name: "Panchangam"},
+		Links:   []atomLink{{Rel: "self", Href: f.self}, {Rel: "alternate", Href: f.link}},
+	}
+	for _, e := range f.entries {
+		doc.Entries = append(doc.Entries, atomEntry{
+			ID:      "urn:panchangam:" + e.id,
+			Title:   e.title,
+			Updated: e.date.Format(time.RFC3339),
+			Summary: e.summary,
+			Link:    atomLink{Href: e.link},
+		})
+	}
+	return doc
+}
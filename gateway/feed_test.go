@@ -0,0 +1,119 @@
+package gateway
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/cache"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+// festivals answers GetEvents with a festival on the first date requested.
+func festivals(req *ppb.GetEventsRequest) (*ppb.GetEventsResponse, error) {
+	return &ppb.GetEventsResponse{Festivals: []*ppb.Festival{{
+		Id:    "ugadi-" + req.Date,
+		Kind:  "festival",
+		Date:  req.Date,
+		Names: []*ppb.LocalizedName{{Locale: "en", Name: "Ugadi"}, {Locale: "te", Name: "ఉగాది"}},
+		Tithi: "Shukla Pratipada",
+	}}}, nil
+}
+
+const feedPath = "/api/v1/festivals/feed.xml?lat=17.385&lon=78.4867&tz=Asia/Kolkata"
+
+func TestFestivalFeed(t *testing.T) {
+	b := &backend{getEvents: festivals}
+	g := NewGateway(b)
+	resp, body := serve(t, g, http.MethodGet, "https://panchangam.example.com"+feedPath+"&date=2024-04-09&days=7", "", nil)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/rss+xml; charset=utf-8" {
+		t.Fatalf("GET feed = %d %s: %s", resp.StatusCode, resp.Header.Get("Content-Type"), body)
+	}
+	req := b.last().(*ppb.GetEventsRequest)
+	if req.Date != "2024-04-09" || req.Days != 7 || req.Timezone != "Asia/Kolkata" {
+		t.Errorf("GetEvents() request = %v", req)
+	}
+	for _, want := range []string{
+		`<rss version="2.0">`,
+		"<title>Ugadi</title>",
+		"<pubDate>Tue, 09 Apr 2024 00:00:00 +0530</pubDate>",
+		"<link>https://panchangam.example.com/api/v1/events?date=2024-04-09&amp;lat=17.385",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("feed lacks %s:\n%s", want, body)
+		}
+	}
+
+	_, body = serve(t, g, http.MethodGet, feedPath+"&date=2024-04-09&format=atom", "", http.Header{"Accept-Language": {"te"}})
+	for _, want := range []string{`<feed xmlns="http://www.w3.org/2005/Atom">`, "<title>ఉగాది</title>", "<id>urn:panchangam:ugadi-2024-04-09</id>"} {
+		if !strings.Contains(body, want) {
+			t.Errorf("Atom feed lacks %s:\n%s", want, body)
+		}
+	}
+	if resp, _ := serve(t, g, http.MethodGet, feedPath+"&format=json", "", nil); resp.StatusCode != http.StatusBadRequest {
+		t.Errorf("GET feed in json = %d, want 400", resp.StatusCode)
+	}
+}
+
+func TestFestivalFeedHost(t *testing.T) {
+	// A request with a forged Host must not give the others its links.
+	b := &backend{getEvents: festivals}
+	g := NewGateway(b, WithResponseCache(cache.NewMemoryBackend(10), time.Hour))
+	target := feedPath + "&date=2024-04-09"
+	serve(t, g, http.MethodGet, "http://evil.example"+target, "", http.Header{"X-Forwarded-Proto": {"https"}})
+	_, body := serve(t, g, http.MethodGet, "http://panchangam.example.com"+target, "", nil)
+	if strings.Contains(body, "evil.example") || !strings.Contains(body, "http://panchangam.example.com/api/v1/events?") {
+		t.Errorf("feed of panchangam.example.com after one of evil.example:\n%s", body)
+	}
+	if b.calls() != 2 {
+		t.Errorf("backend called %d times, want 2", b.calls())
+	}
+
+	// With a public URL the links do not depend on the request.
+	b = &backend{getEvents: festivals}
+	g = NewGateway(b, WithResponseCache(cache.NewMemoryBackend(10), time.Hour),
+		WithPublicURL(&url.URL{Scheme: "https", Host: "panchangam.example.com", Path: "/"}))
+	for _, host := range []string{"evil.example", "panchangam.example.com"} {
+		_, body := serve(t, g, http.MethodGet, "http://"+host+target, "", nil)
+		if strings.Contains(body, "evil.example") || !strings.Contains(body, "https://panchangam.example.com/api/v1/events?") {
+			t.Errorf("feed requested of %s:\n%s", host, body)
+		}
+	}
+	if b.calls() != 1 {
+		t.Errorf("backend called %d times with a public URL, want 1", b.calls())
+	}
+}
+
+func TestFestivalFeedToday(t *testing.T) {
+	b := &backend{getEvents: festivals}
+	g := NewGateway(b, WithResponseCache(cache.NewMemoryBackend(10), time.Hour))
+	ist := time.FixedZone("IST", 5*3600+1800)
+	now := time.Date(2024, 4, 8, 23, 30, 0, 0, ist)
+	g.now = func() time.Time { return now }
+
+	resp, body := serve(t, g, http.MethodGet, feedPath, "", nil)
+	if req := b.last().(*ppb.GetEventsRequest); req.Date != "2024-04-08" {
+		t.Errorf("feed requested at %v starts on %s", now, req.Date)
+	}
+	if cc := resp.Header.Get("Cache-Control"); cc != "public, max-age=1800" {
+		t.Errorf("Cache-Control of today's feed half an hour before midnight = %q", cc)
+	}
+	if !strings.Contains(body, "ugadi-2024-04-08") {
+		t.Errorf("feed of 2024-04-08:\n%s", body)
+	}
+
+	now = now.Add(10 * time.Minute)
+	if resp, _ := serve(t, g, http.MethodGet, feedPath, "", nil); b.calls() != 1 || resp.Header.Get("Age") != "600" {
+		t.Errorf("feed requested again before midnight: backend called %d times, Age %q", b.calls(), resp.Header.Get("Age"))
+	}
+	now = now.Add(30 * time.Minute)
+	_, body = serve(t, g, http.MethodGet, feedPath, "", nil)
+	if req := b.last().(*ppb.GetEventsRequest); b.calls() != 2 || req.Date != "2024-04-09" {
+		t.Errorf("feed requested after midnight: backend called %d times, from %s", b.calls(), req.Date)
+	}
+	if !strings.Contains(body, "ugadi-2024-04-09") {
+		t.Errorf("feed after midnight:\n%s", body)
+	}
+}
@@ -0,0 +1,813 @@
+package gateway
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/cache"
+	"github.com/naren-m/panchangam/i18n"
+	"github.com/naren-m/panchangam/log"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+var logger = log.Logger()
+
+// Cache lifetime of responses that only depend on their inputs, such as a
+// year's festival bundle.
+const immutableMaxAge = 24 * 60 * 60
+
+// Gateway exposes the Panchangam gRPC service as a JSON REST API.
+type Gateway struct {
+	client ppb.PanchangamClient
+	mux    *http.ServeMux
+	shadow *shadow
+	// openAPI is the encoded OpenAPI spec of the routes.
+	openAPI []byte
+	// graphQLSchema is the schema of the GraphQL endpoint.
+	graphQLSchema []byte
+	// responses caches the responses of the routes that are not volatile,
+	// for responseTTL.
+	responses   cache.Backend
+	responseTTL time.Duration
+	// maxBatch bounds the items of a batch request, if positive.
+	maxBatch int
+	// publicURL is the URL the gateway is reached at, if set.
+	publicURL string
+	now       func() time.Time
+}
+
+// NewGateway returns a Gateway that forwards requests to client.
+func NewGateway(client ppb.PanchangamClient, opts ...Option) *Gateway {
+	g := &Gateway{
+		client:   client,
+		mux:      http.NewServeMux(),
+		maxBatch: aaa.DefaultLimits.MaxBatch,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(g)
+	}
+	routes := g.routes()
+	for _, rt := range routes {
+		handler := rt.handler
+		if rt.cacheable() {
+			handler = g.conditional(handler, rt.key)
+		}
+		g.mux.HandleFunc(rt.httpMethod()+" "+rt.path, handler)
+	}
+	g.openAPI = marshalSpec(routes)
+	g.mux.HandleFunc("GET /api/v1/openapi.json", g.getOpenAPI)
+	g.mux.HandleFunc("GET /api/v1/docs", getSwaggerUI)
+	g.graphQLSchema = graphQLSchema(g.graphQLFields())
+	g.mux.HandleFunc("GET /graphql", g.serveGraphQL)
+	g.mux.HandleFunc("POST /graphql", g.serveGraphQL)
+	g.mux.HandleFunc("GET /graphql/schema", g.getGraphQLSchema)
+	return g
+}
+
+// routes returns the REST endpoints of the gateway. Every endpoint is
+// listed here so that it is also described by the OpenAPI spec.
+func (g *Gateway) routes() []route {
+	return []route{
+		{
+			path:        "/api/v1/panchangam",
+			handler:     g.getPanchangam,
+			operationID: "getPanchangam",
+			summary:     "Panchangam of a date at a location",
+			params:      panchangamParams,
+			response:    &ppb.PanchangamData{},
+		},
+		{
+			path:         "/api/v1/panchangam/batch",
+			method:       http.MethodPost,
+			handler:      g.getPanchangamBatch,
+			operationID:  "getPanchangamBatch",
+			summary:      "Panchangams of up to max-batch dates and locations in one response, with an error entry for each item that fails",
+			requestBody:  batchRequestSchema,
+			responseBody: batchResponseSchema,
+		},
+		{
+			path:        "/api/v1/festivals/bundle",
+			handler:     g.getFestivalBundle,
+			operationID: "getFestivalBundle",
+			summary:     "All festivals and vrats of a year",
+			params: append([]param{
+				{name: "year", typ: "integer", format: "int32", description: "Gregorian year", required: true},
+				regionParam,
+			}, locationParams...),
+			response: &ppb.FestivalBundle{},
+		},
+		{
+			path:        "/api/v1/festivals/{name}",
+			handler:     g.findFestivalDates,
+			operationID: "findFestivalDates",
+			summary:     "Dates of a festival in a range of years, e.g. /api/v1/festivals/diwali?year=2026",
+			params: append([]param{
+				{name: "name", typ: "string", description: "Identifier or name in any locale of the festival, e.g. diwali", inPath: true},
+				{name: "year", typ: "integer", format: "int32", description: "First Gregorian year searched", required: true},
+				{name: "end_year", typ: "integer", format: "int32", description: "Last Gregorian year searched, inclusive (defaults to year)"},
+				regionParam,
+			}, locationParams...),
+			response: &ppb.FindFestivalDatesResponse{},
+		},
+		{
+			path:        "/api/v1/festivals/feed.xml",
+			handler:     g.getFestivalFeed,
+			operationID: "getFestivalFeed",
+			summary:     "RSS or Atom feed of the festivals, vrats, sankrantis and visible eclipses of the next days, for feed readers and static sites",
+			params: append([]param{
+				{name: "date", typ: "string", format: "date", description: "First date of the feed (defaults to today at the location)"},
+				{name: "days", typ: "integer", format: "int32", description: "Number of days listed, 1 to 366 (defaults to 60)"},
+				{name: "type", typ: "string", description: "Kind (festival, vrat, eclipse or sankranti) or definition, e.g. ekadashi (defaults to all)"},
+				{name: "format", typ: "string", description: "rss (the default) or atom"},
+				{name: "locale", typ: "string", description: "Locale of the names, e.g. hi, ta, te or sa; defaults to the first language of the Accept-Language header that has names"},
+				regionParam,
+			}, locationParams...),
+			responseBody: feedSchema,
+			contentType:  "application/rss+xml",
+			key:          g.feedKey,
+		},
+		{
+			path:        "/api/v1/vrats",
+			handler:     g.getVratList,
+			operationID: "getVratList",
+			summary:     "Ekadashis, purnimas, amavasyas and sankrantis of a year with parana windows",
+			params: append([]param{
+				{name: "year", typ: "integer", format: "int32", description: "Gregorian year", required: true},
+				{name: "tradition", typ: "string", description: "Tradition deciding the day ekadashis are observed on: smarta or vaishnava (defaults to smarta)"},
+				regionParam,
+			}, locationParams...),
+			response: &ppb.VratList{},
+		},
+		{
+			path:        "/api/v1/events",
+			handler:     g.getEvents,
+			operationID: "getEvents",
+			summary:     "Festivals, vrats, sankrantis and visible eclipses from a date",
+			params: append([]param{
+				dateParam(true),
+				{name: "days", typ: "integer", format: "int32", description: "Number of days to list, 1 to 366 (defaults to 1, or to the days up to end_date)"},
+				{name: "end_date", typ: "string", format: "date", description: "Last date to list, inclusive; must agree with days if both are set"},
+				{name: "type", typ: "string", description: "Kind (festival, vrat, eclipse or sankranti) or definition, e.g. ekadashi"},
+				regionParam,
+			}, locationParams...),
+			response: &ppb.GetEventsResponse{},
+		},
+		{
+			path:        "/api/v1/muhurta",
+			handler:     g.getMuhurta,
+			operationID: "getMuhurta",
+			summary:     "Periods suitable for an activity following a tradition",
+			params: append([]param{
+				dateParam(true),
+				{name: "days", typ: "integer", format: "int32", description: "Number of days to search, 1 to 366 (defaults to 1, or to the days up to end_date)"},
+				{name: "end_date", typ: "string", format: "date", description: "Last date to search, inclusive; must agree with days if both are set"},
+				{name: "activity", typ: "string", description: "Activity, e.g. marriage, griha_pravesh or travel", required: true},
+				{name: "tradition", typ: "string", description: "Rule pack of the tradition, e.g. smarta, vaishnava or tamil (defaults to smarta)"},
+			}, append(profileParams, locationParams...)...),
+			response: &ppb.GetMuhurtaResponse{},
+		},
+		{
+			path:        "/api/v1/muhurta/find",
+			handler:     g.findMuhurta,
+			operationID: "findMuhurta",
+			summary:     "Next periods suitable for an activity from a date that satisfy constraints, ranked by score",
+			params: append([]param{
+				dateParam(true),
+				{name: "activity", typ: "string", description: "Activity, e.g. marriage, griha_pravesh or travel", required: true},
+				{name: "tradition", typ: "string", description: "Rule pack of the tradition, e.g. smarta, vaishnava or tamil (defaults to smarta)"},
+				{name: "count", typ: "integer", format: "int32", description: "Number of periods to find, 1 to 50 (defaults to 5)"},
+				{name: "nakshatras", typ: "string", description: "Comma-separated nakshatras one of which must prevail throughout a period, e.g. Rohini,Hasta (defaults to any)"},
+				{name: "weekdays", typ: "string", description: "Comma-separated weekdays of the days to search, e.g. Friday,Sunday (defaults to any)"},
+				{name: "exclude_bhadra", typ: "boolean", description: "Rule out periods touching Bhadra, the Vishti karana"},
+				{name: "days", typ: "integer", format: "int32", description: "Number of days to search, 1 to 366 (defaults to 366)"},
+			}, append(profileParams, locationParams...)...),
+			response: &ppb.FindMuhurtaResponse{},
+		},
+		{
+			path:        "/api/v1/lagnas",
+			handler:     g.getLagnas,
+			operationID: "getLagnas",
+			summary:     "Signs rising on the eastern horizon during a date",
+			params:      append([]param{dateParam(true)}, locationParams...),
+			response:    &ppb.GetLagnasResponse{},
+		},
+		{
+			path:        "/api/v1/summary",
+			handler:     g.getSummary,
+			operationID: "getSummary",
+			summary:     "Panchangam of a date as it is read out, in a locale",
+			params: append([]param{
+				dateParam(true),
+				{name: "locale", typ: "string", description: "Locale of the summary, e.g. hi, ta, te or sa; defaults to the first language of the Accept-Language header that has names, or en"},
+				{name: "sun_convention", typ: "string", description: "Sunrise convention: apparent (true, refracted sun) or mean (mean sun); defaults to apparent"},
+				{name: "format", typ: "string", description: "json (the default) or text for the summary alone as plain text, e.g. for display widgets"},
+			}, locationParams...),
+			response: &ppb.Summary{},
+		},
+		{
+			path:        "/api/v1/transitions",
+			handler:     g.streamTransitions,
+			operationID: "streamTransitions",
+			summary:     "Server-Sent Events stream of the transitions of the panchangam at a location as they happen",
+			params: append([]param{
+				{name: "locale", typ: "string", description: "Locale of the local names of the elements, e.g. hi, ta, te or sa; defaults to the first language of the Accept-Language header that has names"},
+				{name: "notice_minutes", typ: "integer", format: "int32", description: "Minutes before Rahu Kalam, Yamagandam and Gulika Kalam start that they are announced, 0 for none (defaults to 5)"},
+			}, locationParams...),
+			response:    &ppb.Transition{},
+			contentType: "text/event-stream",
+			volatile:    true,
+		},
+		{
+			path:        "/api/v1/transitions/next",
+			handler:     g.getNextTransitions,
+			operationID: "getNextTransitions",
+			summary:     "Next transitions of the panchangam at a location from a time",
+			params: append([]param{
+				{name: "time", typ: "string", format: "date-time", description: "Time to list the transitions from in RFC 3339 format (defaults to now)"},
+				{name: "count", typ: "integer", format: "int32", description: "Number of transitions to list, 1 to 100 (defaults to 10)"},
+				{name: "kinds", typ: "string", description: "Comma-separated kinds of transitions, e.g. tithi,sunset,moonrise,rahu_kalam (defaults to every kind)"},
+				{name: "locale", typ: "string", description: "Locale of the local names of the elements, e.g. hi, ta, te or sa; defaults to the first language of the Accept-Language header that has names"},
+			}, locationParams...),
+			response: &ppb.GetNextTransitionsResponse{},
+			volatile: true,
+		},
+		{
+			path:        "/api/v1/subscriptions",
+			method:      http.MethodPost,
+			handler:     g.createSubscription,
+			operationID: "createSubscription",
+			summary:     "Register a URL notified with signed POSTs of the festivals and transitions at a location; the secret signing them is only returned now",
+			requestBody: subscriptionRequestSchema,
+			response:    &ppb.WebhookSubscription{},
+		},
+		{
+			path:        "/api/v1/subscriptions",
+			handler:     g.listSubscriptions,
+			operationID: "listSubscriptions",
+			summary:     "Webhook subscriptions of the API key of the caller",
+			response:    &ppb.ListSubscriptionsResponse{},
+			volatile:    true,
+		},
+		{
+			path:        "/api/v1/subscriptions/{id}",
+			method:      http.MethodDelete,
+			handler:     g.deleteSubscription,
+			operationID: "deleteSubscription",
+			summary:     "Delete a webhook subscription of the API key of the caller",
+			params: []param{
+				{name: "id", typ: "string", description: "Identifier of the subscription", inPath: true},
+			},
+			response: &ppb.DeleteSubscriptionResponse{},
+		},
+		{
+			path:        "/api/v1/health",
+			handler:     g.getHealth,
+			operationID: "getHealth",
+			summary:     "Health of the backend and progress of the precomputation of popular locations",
+			response:    &ppb.Health{},
+			volatile:    true,
+		},
+		{
+			path:        "/api/v1/version",
+			handler:     g.getVersion,
+			operationID: "getVersion",
+			summary:     "Version the backend was built as and the features built in",
+			response:    &ppb.VersionInfo{},
+			volatile:    true,
+		},
+	}
+}
+
+// ServeHTTP serves r under the request ID of its X-Request-ID header, or a
+// new one if it has none. The ID is sent back in the X-Request-ID header
+// of the response and in error bodies, forwarded to the backend and
+// logged with the records of the request.
+func (g *Gateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	id := r.Header.Get(log.RequestIDHeader)
+	if !log.ValidRequestID(id) {
+		id = log.NewRequestID()
+	}
+	w.Header().Set(log.RequestIDHeader, id)
+	g.mux.ServeHTTP(w, r.WithContext(log.WithRequestID(r.Context(), id)))
+}
+
+// panchangamParams documents the parameters of a panchangam, given in the
+// query of /api/v1/panchangam and in the items of its batches.
+var panchangamParams = append([]param{
+	dateParam(true),
+	{name: "boundary_window_seconds", typ: "integer", format: "int32", description: "Distance from an element transition within which it is reported as uncertain"},
+	{name: "sun_convention", typ: "string", description: "Sunrise convention: apparent (true, refracted sun) or mean (mean sun); defaults to apparent"},
+	{name: "elevation", typ: "number", format: "double", description: "Elevation of the observer above sea level in metres, used for the topocentric moon"},
+	{name: "moon_position", typ: "string", description: "Moon the elements are computed from: geocentric or topocentric (parallax-corrected); defaults to geocentric"},
+	{name: "pressure", typ: "number", format: "double", description: "Atmospheric pressure in hPa for the refraction at sunrise and sunset; defaults to the standard atmosphere"},
+	{name: "temperature", typ: "number", format: "double", description: "Air temperature in degrees Celsius, used with pressure"},
+	{name: "horizon_dip", typ: "boolean", description: "Take sunrise and sunset over the horizon lowered by the dip seen from elevation"},
+	{name: "locale", typ: "string", description: "Locale of the local names of the elements, e.g. hi, ta, te or sa; defaults to the first language of the Accept-Language header that has names"},
+	{name: "hijri_adjustment_days", typ: "integer", format: "int32", description: "Days by which the Hijri date follows the local sighting of the crescent rather than the tabular calendar, from -2 to 2"},
+	regionParam,
+}, locationParams...)
+
+// panchangamRequest reads the parameters of panchangamParams from q, with
+// the locale of the caller by default.
+func panchangamRequest(q *queryParser, locale string) *ppb.GetPanchangamRequest {
+	if l := q.string("locale"); l != "" {
+		locale = l
+	}
+	return &ppb.GetPanchangamRequest{
+		Date:                  q.string("date"),
+		Latitude:              q.float("lat"),
+		Longitude:             q.float("lon"),
+		Timezone:              q.string("tz"),
+		BoundaryWindowSeconds: int32(q.int("boundary_window_seconds")),
+		Region:                q.string("region"),
+		SunConvention:         q.string("sun_convention"),
+		Elevation:             q.float("elevation"),
+		MoonPosition:          q.string("moon_position"),
+		Pressure:              q.float("pressure"),
+		Temperature:           q.float("temperature"),
+		HorizonDip:            q.bool("horizon_dip"),
+		Locale:                locale,
+		HijriAdjustmentDays:   int32(q.int("hijri_adjustment_days")),
+	}
+}
+
+func (g *Gateway) getPanchangam(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := panchangamRequest(&q, requestLocale(w, r))
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.Get(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "Get", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.Get(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp.GetPanchangamData())
+}
+
+// requestLocale returns the locale query parameter or, failing that, the
+// first language listed in the Accept-Language header that has local names.
+// English needs none, so it yields "".
+func requestLocale(w http.ResponseWriter, r *http.Request) string {
+	if locale := r.URL.Query().Get("locale"); locale != "" {
+		return locale
+	}
+	addVary(w.Header(), "Accept-Language")
+	header := r.Header.Get("Accept-Language")
+	if header == "" {
+		return ""
+	}
+	var tags []string
+	for _, part := range strings.Split(header, ",") {
+		tag, _, _ := strings.Cut(part, ";")
+		tags = append(tags, tag)
+	}
+	if locale := i18n.DefaultCatalogs().Match(tags...); locale != i18n.English {
+		return locale
+	}
+	return ""
+}
+
+// getFestivalBundle serves a whole year of festivals in one response. The
+// bundle only depends on its query, so CDNs may cache it.
+func (g *Gateway) getFestivalBundle(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.GetFestivalBundleRequest{
+		Year:      int32(q.int("year")),
+		Region:    q.string("region"),
+		Latitude:  q.float("lat"),
+		Longitude: q.float("lon"),
+		Timezone:  q.string("tz"),
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.GetFestivalBundle(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "GetFestivalBundle", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetFestivalBundle(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(immutableMaxAge))
+	writeMessage(w, r, resp)
+}
+
+// findFestivalDates serves the dates of a festival, which like the festival
+// bundle only depend on the query.
+func (g *Gateway) findFestivalDates(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.FindFestivalDatesRequest{
+		Name:      r.PathValue("name"),
+		Year:      int32(q.int("year")),
+		EndYear:   int32(q.int("end_year")),
+		Region:    q.string("region"),
+		Latitude:  q.float("lat"),
+		Longitude: q.float("lon"),
+		Timezone:  q.string("tz"),
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.FindFestivalDates(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "FindFestivalDates", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.FindFestivalDates(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(immutableMaxAge))
+	writeMessage(w, r, resp)
+}
+
+// getVratList serves the vrat list of a year, which like the festival
+// bundle only depends on its query.
+func (g *Gateway) getVratList(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.GetVratListRequest{
+		Year:      int32(q.int("year")),
+		Region:    q.string("region"),
+		Tradition: q.string("tradition"),
+		Latitude:  q.float("lat"),
+		Longitude: q.float("lon"),
+		Timezone:  q.string("tz"),
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.GetVratList(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "GetVratList", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetVratList(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	w.Header().Set("Cache-Control", "public, max-age="+strconv.Itoa(immutableMaxAge))
+	writeMessage(w, r, resp)
+}
+
+func (g *Gateway) getEvents(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.GetEventsRequest{
+		Date:      q.string("date"),
+		Days:      int32(q.int("days")),
+		EndDate:   q.string("end_date"),
+		Latitude:  q.float("lat"),
+		Longitude: q.float("lon"),
+		Timezone:  q.string("tz"),
+		Region:    q.string("region"),
+		Type:      q.string("type"),
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.GetEvents(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "GetEvents", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetEvents(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+func (g *Gateway) getMuhurta(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.GetMuhurtaRequest{
+		Date:      q.string("date"),
+		Days:      int32(q.int("days")),
+		EndDate:   q.string("end_date"),
+		Latitude:  q.float("lat"),
+		Longitude: q.float("lon"),
+		Timezone:  q.string("tz"),
+		Activity:  q.string("activity"),
+		Tradition: q.string("tradition"),
+		Profile:   profileQuery(q),
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.GetMuhurta(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "GetMuhurta", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetMuhurta(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+func (g *Gateway) findMuhurta(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.FindMuhurtaRequest{
+		Date:          q.string("date"),
+		Latitude:      q.float("lat"),
+		Longitude:     q.float("lon"),
+		Timezone:      q.string("tz"),
+		Activity:      q.string("activity"),
+		Tradition:     q.string("tradition"),
+		Count:         int32(q.int("count")),
+		ExcludeBhadra: q.bool("exclude_bhadra"),
+		Days:          int32(q.int("days")),
+		Profile:       profileQuery(q),
+	}
+	if nakshatras := q.string("nakshatras"); nakshatras != "" {
+		req.Nakshatras = strings.Split(nakshatras, ",")
+	}
+	if weekdays := q.string("weekdays"); weekdays != "" {
+		req.Weekdays = strings.Split(weekdays, ",")
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.FindMuhurta(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "FindMuhurta", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.FindMuhurta(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+// profileQuery returns the profile of the person given by the query, or
+// nil when it gives none.
+func profileQuery(q queryParser) *ppb.Profile {
+	nakshatra, rashi := q.string("janma_nakshatra"), q.string("janma_rashi")
+	if nakshatra == "" && rashi == "" {
+		return nil
+	}
+	return &ppb.Profile{JanmaNakshatra: nakshatra, JanmaRashi: rashi}
+}
+
+func (g *Gateway) getLagnas(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.GetLagnasRequest{
+		Date:      q.string("date"),
+		Latitude:  q.float("lat"),
+		Longitude: q.float("lon"),
+		Timezone:  q.string("tz"),
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.GetLagnas(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "GetLagnas", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetLagnas(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+// getSummary serves the summary of a date as JSON or, with format=text, as
+// the plain text block for widgets that display it as is.
+func (g *Gateway) getSummary(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.GetSummaryRequest{
+		Date:          q.string("date"),
+		Latitude:      q.float("lat"),
+		Longitude:     q.float("lon"),
+		Timezone:      q.string("tz"),
+		Locale:        requestLocale(w, r),
+		SunConvention: q.string("sun_convention"),
+	}
+	format := q.string("format")
+	if q.err == nil && format != "" && format != "json" && format != "text" {
+		q.err = status.Errorf(codes.InvalidArgument, "invalid format %q: expected json or text", format)
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.GetSummary(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "GetSummary", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetSummary(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	if format == "text" {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		io.WriteString(w, resp.Text)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+// outgoingContext returns the context of the backend calls made for r. It
+// forwards the API key and address of the caller, so that the backend rate
+// limits the clients of the gateway individually rather than the gateway,
+// and the request ID.
+func outgoingContext(r *http.Request) context.Context {
+	md := metadata.MD{}
+	if key := r.Header.Get("X-API-Key"); key != "" {
+		md.Set(aaa.APIKeyHeader, key)
+	}
+	if id := log.RequestID(r.Context()); id != "" {
+		md.Set(log.RequestIDHeader, id)
+	}
+	addr := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(addr); err == nil {
+		addr = host
+	}
+	md.Set(aaa.ForwardedForHeader, addr)
+	return metadata.NewOutgoingContext(r.Context(), md)
+}
+
+// setRetryAfter passes the retry-after header of a rate limited backend
+// call on to the caller.
+func setRetryAfter(w http.ResponseWriter, header metadata.MD) {
+	if v := header.Get(aaa.RetryAfterHeader); len(v) > 0 {
+		w.Header().Set("Retry-After", v[0])
+	}
+}
+
+func writeMessage(w http.ResponseWriter, r *http.Request, m proto.Message) {
+	body, err := protojson.Marshal(m)
+	if err != nil {
+		writeError(w, r, status.Error(codes.Internal, "failed to encode response"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// errorBody is the JSON body of the error responses of the gateway.
+type errorBody struct {
+	// Code is the gRPC status code of the error, e.g. INVALID_ARGUMENT.
+	Code    string `json:"code"`
+	Message string `json:"message"`
+	// Details lists the invalid fields of the request, if known.
+	Details   []errorDetail `json:"details,omitempty"`
+	RequestID string        `json:"requestId"`
+	Timestamp string        `json:"timestamp"`
+	Path      string        `json:"path"`
+}
+
+// errorDetail describes an invalid field of a request.
+type errorDetail struct {
+	Field       string `json:"field"`
+	Description string `json:"description"`
+}
+
+// writeError writes err as an error body, with the HTTP status closest to
+// its gRPC status code.
+func writeError(w http.ResponseWriter, r *http.Request, err error) {
+	st := status.Convert(err)
+	code := httpStatusFromCode(st.Code())
+	if code >= http.StatusInternalServerError {
+		logger.ErrorContext(r.Context(), "Request failed", "path", r.URL.Path, "error", err)
+	}
+	resp := errorBody{
+		Code:      codeName(st.Code()),
+		Message:   st.Message(),
+		RequestID: log.RequestID(r.Context()),
+		Timestamp: time.Now().UTC().Format(time.RFC3339),
+		Path:      r.URL.Path,
+	}
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.GetFieldViolations() {
+				resp.Details = append(resp.Details, errorDetail{Field: v.GetField(), Description: v.GetDescription()})
+			}
+		}
+	}
+	body, _ := json.Marshal(resp)
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(code)
+	w.Write(body)
+}
+
+// codeName returns the name of a gRPC status code in the canonical upper
+// case form, e.g. INVALID_ARGUMENT for codes.InvalidArgument.
+func codeName(code codes.Code) string {
+	if code == codes.OK {
+		return "OK"
+	}
+	var b strings.Builder
+	for i, c := range code.String() {
+		if i > 0 && unicode.IsUpper(c) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToUpper(c))
+	}
+	return b.String()
+}
+
+// httpStatusFromCode maps a gRPC status code to the closest HTTP status.
+func httpStatusFromCode(code codes.Code) int {
+	switch code {
+	case codes.OK:
+		return http.StatusOK
+	case codes.InvalidArgument, codes.OutOfRange:
+		return http.StatusBadRequest
+	case codes.FailedPrecondition:
+		return http.StatusUnprocessableEntity
+	case codes.NotFound:
+		return http.StatusNotFound
+	case codes.Unauthenticated:
+		return http.StatusUnauthorized
+	case codes.PermissionDenied:
+		return http.StatusForbidden
+	case codes.ResourceExhausted:
+		return http.StatusTooManyRequests
+	case codes.Unimplemented:
+		return http.StatusNotImplemented
+	case codes.Unavailable:
+		return http.StatusServiceUnavailable
+	case codes.DeadlineExceeded:
+		return http.StatusGatewayTimeout
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// getHealth reports the health of the backend. It is not shadowed, as the
+// health of the canary is not compared.
+func (g *Gateway) getHealth(w http.ResponseWriter, r *http.Request) {
+	var header metadata.MD
+	resp, err := g.client.GetHealth(outgoingContext(r), &ppb.GetHealthRequest{}, grpc.Header(&header))
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+// getVersion reports the version of the backend. It is not shadowed either.
+func (g *Gateway) getVersion(w http.ResponseWriter, r *http.Request) {
+	var header metadata.MD
+	resp, err := g.client.GetVersion(outgoingContext(r), &ppb.GetVersionRequest{}, grpc.Header(&header))
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
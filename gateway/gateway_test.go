@@ -0,0 +1,123 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/naren-m/panchangam/observability"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// backend is a PanchangamClient answering with its functions and
+// remembering the requests it received. Methods without a function fail
+// with Unimplemented.
+type backend struct {
+	ppb.PanchangamClient
+
+	mu       sync.Mutex
+	requests []proto.Message
+
+	get        func(*ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error)
+	getEvents  func(*ppb.GetEventsRequest) (*ppb.GetEventsResponse, error)
+	getMuhurta func(*ppb.GetMuhurtaRequest) (*ppb.GetMuhurtaResponse, error)
+	getVersion func(*ppb.GetVersionRequest) (*ppb.VersionInfo, error)
+}
+
+// answer records req and returns the response of f to it.
+func answer[Req proto.Message, Resp any](b *backend, req Req, f func(Req) (Resp, error)) (Resp, error) {
+	b.mu.Lock()
+	b.requests = append(b.requests, req)
+	b.mu.Unlock()
+	if f == nil {
+		var zero Resp
+		return zero, status.Errorf(codes.Unimplemented, "%T is not implemented", req)
+	}
+	return f(req)
+}
+
+// calls returns the number of requests b received.
+func (b *backend) calls() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return len(b.requests)
+}
+
+// last returns the last request b received.
+func (b *backend) last() proto.Message {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if len(b.requests) == 0 {
+		return nil
+	}
+	return b.requests[len(b.requests)-1]
+}
+
+func (b *backend) Get(ctx context.Context, in *ppb.GetPanchangamRequest, opts ...grpc.CallOption) (*ppb.GetPanchangamResponse, error) {
+	return answer(b, in, b.get)
+}
+
+func (b *backend) GetEvents(ctx context.Context, in *ppb.GetEventsRequest, opts ...grpc.CallOption) (*ppb.GetEventsResponse, error) {
+	return answer(b, in, b.getEvents)
+}
+
+func (b *backend) GetMuhurta(ctx context.Context, in *ppb.GetMuhurtaRequest, opts ...grpc.CallOption) (*ppb.GetMuhurtaResponse, error) {
+	return answer(b, in, b.getMuhurta)
+}
+
+func (b *backend) GetVersion(ctx context.Context, in *ppb.GetVersionRequest, opts ...grpc.CallOption) (*ppb.VersionInfo, error) {
+	return answer(b, in, b.getVersion)
+}
+
+// panchangam answers Get with the panchangam of the date requested, whose
+// tithi is named in the locale requested.
+func panchangam(req *ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error) {
+	tithi := "Shukla Pratipada"
+	if req.Locale == "te" {
+		tithi = "శుక్ల పాడ్యమి"
+	}
+	return &ppb.GetPanchangamResponse{PanchangamData: &ppb.PanchangamData{Date: req.Date, Tithi: tithi}}, nil
+}
+
+// serve sends a request to g and returns its response and body.
+func serve(t *testing.T, g http.Handler, method, target string, body string, header http.Header) (*http.Response, string) {
+	t.Helper()
+	var r io.Reader
+	if body != "" {
+		r = strings.NewReader(body)
+	}
+	req := httptest.NewRequest(method, target, r)
+	for name, values := range header {
+		req.Header[name] = values
+	}
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, req)
+	resp := rec.Result()
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return resp, string(b)
+}
+
+func TestDebugErrorsNotServed(t *testing.T) {
+	// The recorded errors carry the URLs and responses of webhook
+	// subscribers, so they are only served on the loopback debug port.
+	observability.RecordError(context.Background(), "webhook", errors.New("delivering to https://hooks.example.com/s1: 500 internal"))
+	g := NewGateway(&backend{})
+	for _, header := range []http.Header{nil, {"X-Api-Key": {"read-key"}}} {
+		resp, body := serve(t, g, http.MethodGet, "/api/v1/debug/errors", "", header)
+		if resp.StatusCode != http.StatusNotFound || strings.Contains(body, "hooks.example.com") {
+			t.Errorf("GET /api/v1/debug/errors with %v = %d %s, want 404", header, resp.StatusCode, body)
+		}
+	}
+}
@@ -0,0 +1,783 @@
+package gateway
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/log"
+	"github.com/naren-m/panchangam/parallel"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// maxGraphQLBody bounds the size of a GraphQL request body.
+const maxGraphQLBody = 1 << 20
+
+// gqlRootField is a field of the Query type, answered by a backend call.
+// Its type, like those of the REST responses, is derived from the protobuf
+// message so that it cannot drift from the backend.
+type gqlRootField struct {
+	name        string
+	description string
+	args        []gqlArg
+	// typ is the message the field returns or, for a list, its items.
+	typ     protoreflect.MessageDescriptor
+	list    bool
+	resolve func(q *gqlQuery, a gqlArgs) (protoreflect.Value, error)
+}
+
+// gqlArg is an argument of a root field. Its type is String, Int, Float,
+// Boolean or Location.
+type gqlArg struct {
+	name     string
+	typ      string
+	required bool
+}
+
+// gqlLocationFields are the fields of the Location input type, the
+// observer location shared by the root fields.
+var gqlLocationFields = []gqlArg{
+	{name: "lat", typ: "Float", required: true},
+	{name: "lon", typ: "Float", required: true},
+	{name: "tz", typ: "String"},
+}
+
+// graphQLFields returns the fields of the Query type of the GraphQL schema.
+func (g *Gateway) graphQLFields() []gqlRootField {
+	location := gqlArg{name: "location", typ: "Location", required: true}
+	return []gqlRootField{
+		{
+			name:        "panchangam",
+			description: "Panchangam of a date at a location",
+			args: []gqlArg{
+				{name: "date", typ: "String", required: true},
+				location,
+				{name: "region", typ: "String"},
+				{name: "locale", typ: "String"},
+				{name: "sunConvention", typ: "String"},
+				{name: "hijriAdjustmentDays", typ: "Int"},
+			},
+			typ:     (&ppb.PanchangamData{}).ProtoReflect().Descriptor(),
+			resolve: g.resolvePanchangam,
+		},
+		{
+			name:        "festivals",
+			description: "Festivals, vrats, sankrantis and eclipses from a date to an end date or for a number of days",
+			args: []gqlArg{
+				{name: "from", typ: "String", required: true},
+				{name: "to", typ: "String"},
+				{name: "days", typ: "Int"},
+				location,
+				{name: "region", typ: "String"},
+				{name: "type", typ: "String"},
+			},
+			typ:     (&ppb.Festival{}).ProtoReflect().Descriptor(),
+			list:    true,
+			resolve: g.resolveFestivals,
+		},
+		{
+			name:        "muhurtas",
+			description: "Periods suitable for an activity from a date to an end date or for a number of days",
+			args: []gqlArg{
+				{name: "purpose", typ: "String", required: true},
+				{name: "from", typ: "String", required: true},
+				{name: "to", typ: "String"},
+				{name: "days", typ: "Int"},
+				location,
+				{name: "tradition", typ: "String"},
+			},
+			typ:     (&ppb.MuhurtaWindow{}).ProtoReflect().Descriptor(),
+			list:    true,
+			resolve: g.resolveMuhurtas,
+		},
+	}
+}
+
+func (g *Gateway) resolvePanchangam(q *gqlQuery, a gqlArgs) (protoreflect.Value, error) {
+	lat, lon, tz := a.location()
+	req := &ppb.GetPanchangamRequest{
+		Date:                a.string("date"),
+		Latitude:            lat,
+		Longitude:           lon,
+		Timezone:            tz,
+		Region:              a.string("region"),
+		Locale:              a.string("locale"),
+		SunConvention:       a.string("sunConvention"),
+		HijriAdjustmentDays: int32(a.int("hijriAdjustmentDays")),
+	}
+	if req.Locale == "" {
+		req.Locale = q.locale
+	}
+	var header metadata.MD
+	resp, err := g.client.Get(q.ctx, req, grpc.Header(&header))
+	g.shadow.mirror(q.ctx, "Get", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.Get(ctx, req)
+	})
+	q.noteHeader(header)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	return protoreflect.ValueOfMessage(resp.GetPanchangamData().ProtoReflect()), nil
+}
+
+func (g *Gateway) resolveFestivals(q *gqlQuery, a gqlArgs) (protoreflect.Value, error) {
+	lat, lon, tz := a.location()
+	req := &ppb.GetEventsRequest{
+		Date:      a.string("from"),
+		EndDate:   a.string("to"),
+		Days:      int32(a.int("days")),
+		Latitude:  lat,
+		Longitude: lon,
+		Timezone:  tz,
+		Region:    a.string("region"),
+		Type:      a.string("type"),
+	}
+	var header metadata.MD
+	resp, err := g.client.GetEvents(q.ctx, req, grpc.Header(&header))
+	g.shadow.mirror(q.ctx, "GetEvents", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetEvents(ctx, req)
+	})
+	q.noteHeader(header)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	m := resp.ProtoReflect()
+	return m.Get(m.Descriptor().Fields().ByName("festivals")), nil
+}
+
+func (g *Gateway) resolveMuhurtas(q *gqlQuery, a gqlArgs) (protoreflect.Value, error) {
+	lat, lon, tz := a.location()
+	req := &ppb.GetMuhurtaRequest{
+		Activity:  a.string("purpose"),
+		Date:      a.string("from"),
+		EndDate:   a.string("to"),
+		Days:      int32(a.int("days")),
+		Latitude:  lat,
+		Longitude: lon,
+		Timezone:  tz,
+		Tradition: a.string("tradition"),
+	}
+	var header metadata.MD
+	resp, err := g.client.GetMuhurta(q.ctx, req, grpc.Header(&header))
+	g.shadow.mirror(q.ctx, "GetMuhurta", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetMuhurta(ctx, req)
+	})
+	q.noteHeader(header)
+	if err != nil {
+		return protoreflect.Value{}, err
+	}
+	m := resp.ProtoReflect()
+	return m.Get(m.Descriptor().Fields().ByName("windows")), nil
+}
+
+// gqlArgs holds the arguments of a root field, coerced to their types:
+// string, int64, float64, bool or, for a Location, map[string]any.
+type gqlArgs map[string]any
+
+func (a gqlArgs) string(name string) string {
+	s, _ := a[name].(string)
+	return s
+}
+
+func (a gqlArgs) int(name string) int64 {
+	i, _ := a[name].(int64)
+	return i
+}
+
+func (a gqlArgs) location() (lat, lon float64, tz string) {
+	loc, _ := a["location"].(map[string]any)
+	lat, _ = loc["lat"].(float64)
+	lon, _ = loc["lon"].(float64)
+	tz, _ = loc["tz"].(string)
+	return lat, lon, tz
+}
+
+// gqlRequest is the body of a GraphQL request, or its query parameters for
+// a GET request.
+type gqlRequest struct {
+	Query         string         `json:"query"`
+	OperationName string         `json:"operationName"`
+	Variables     map[string]any `json:"variables"`
+}
+
+// gqlError is an error of a GraphQL response. Errors of the backend calls
+// carry their gRPC status code in the extensions.
+type gqlError struct {
+	Message    string         `json:"message"`
+	Path       []string       `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
+
+// gqlQuery is the execution of a GraphQL query.
+type gqlQuery struct {
+	ctx context.Context
+	// locale is the locale of the local names when the query names none.
+	locale string
+
+	mu         sync.Mutex
+	retryAfter string
+}
+
+// noteHeader remembers the retry-after header of a rate limited call, so
+// that it is passed on to the caller.
+func (q *gqlQuery) noteHeader(header metadata.MD) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+	if v := header.Get(aaa.RetryAfterHeader); len(v) > 0 {
+		q.retryAfter = v[0]
+	}
+}
+
+// serveGraphQL answers GraphQL queries, sent as JSON in the body of a POST
+// request or in the query, operationName and variables parameters of a GET
+// request. The root fields are fetched from the backend concurrently, by
+// batchWorkers calls at most, and a query may select as many of them as a
+// batch request may list items. A query that cannot be parsed or does not
+// match the schema is rejected with status 400, and one selecting too many
+// root fields with 429; the failure of a backend call nulls its field and
+// is reported in the errors of an otherwise successful response.
+func (g *Gateway) serveGraphQL(w http.ResponseWriter, r *http.Request) {
+	var req gqlRequest
+	if r.Method == http.MethodGet {
+		values := r.URL.Query()
+		req.Query = values.Get("query")
+		req.OperationName = values.Get("operationName")
+		if v := values.Get("variables"); v != "" {
+			dec := json.NewDecoder(strings.NewReader(v))
+			dec.UseNumber()
+			if err := dec.Decode(&req.Variables); err != nil {
+				writeGraphQLErrors(w, http.StatusBadRequest, fmt.Errorf("invalid variables: %v", err))
+				return
+			}
+		}
+	} else {
+		dec := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxGraphQLBody))
+		dec.UseNumber()
+		if err := dec.Decode(&req); err != nil {
+			writeGraphQLErrors(w, http.StatusBadRequest, fmt.Errorf("invalid request body: %v", err))
+			return
+		}
+	}
+
+	selections, err := g.prepareGraphQL(req)
+	if err != nil {
+		code := http.StatusBadRequest
+		if st, ok := status.FromError(err); ok {
+			code, err = httpStatusFromCode(st.Code()), errors.New(st.Message())
+		}
+		writeGraphQLErrors(w, code, err)
+		return
+	}
+
+	q := &gqlQuery{ctx: outgoingContext(r), locale: requestLocale(w, r)}
+	type result struct {
+		value any
+		err   *gqlError
+	}
+	results, err := parallel.Map(q.ctx, len(selections), batchWorkers, func(ctx context.Context, i int) (result, error) {
+		f, rf := selections[i].field, selections[i].root
+		if f.name == "__typename" {
+			return result{value: "Query"}, nil
+		}
+		v, err := rf.resolve(q, selections[i].args)
+		if err != nil {
+			st := status.Convert(err)
+			return result{err: &gqlError{
+				Message:    st.Message(),
+				Path:       []string{f.key()},
+				Extensions: map[string]any{"code": codeName(st.Code()), "requestId": log.RequestID(r.Context())},
+			}}, nil
+		}
+		if rf.list {
+			return result{value: gqlList(v.List(), f.selections)}, nil
+		}
+		return result{value: gqlMessage(v.Message(), f.selections)}, nil
+	})
+	if err != nil {
+		st := status.FromContextError(err)
+		writeGraphQLErrors(w, httpStatusFromCode(st.Code()), errors.New(st.Message()))
+		return
+	}
+
+	data := make(gqlObject, len(selections))
+	var resp struct {
+		Errors []*gqlError `json:"errors,omitempty"`
+		Data   gqlObject   `json:"data"`
+	}
+	for i, sel := range selections {
+		data[i] = gqlEntry{key: sel.field.key(), value: results[i].value}
+		if results[i].err != nil {
+			resp.Errors = append(resp.Errors, results[i].err)
+		}
+	}
+	resp.Data = data
+	if q.retryAfter != "" {
+		w.Header().Set("Retry-After", q.retryAfter)
+	}
+	body, err := json.Marshal(resp)
+	if err != nil {
+		writeGraphQLErrors(w, http.StatusInternalServerError, fmt.Errorf("failed to encode response"))
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(body)
+}
+
+// gqlRootSelection is a root field selected by a query.
+type gqlRootSelection struct {
+	field *gqlField
+	root  gqlRootField
+	args  gqlArgs
+}
+
+// prepareGraphQL parses the query of req, selects its operation,
+// substitutes its variables and validates it against the schema, so that
+// no backend call is made for an invalid query.
+func (g *Gateway) prepareGraphQL(req gqlRequest) ([]gqlRootSelection, error) {
+	if req.Query == "" {
+		return nil, fmt.Errorf("missing query")
+	}
+	ops, err := parseGraphQL(req.Query)
+	if err != nil {
+		return nil, err
+	}
+	var op *gqlOperation
+	switch {
+	case req.OperationName == "" && len(ops) == 1:
+		op = ops[0]
+	case req.OperationName == "":
+		return nil, fmt.Errorf("operationName is required for a document of several operations")
+	default:
+		for _, o := range ops {
+			if o.name == req.OperationName {
+				op = o
+			}
+		}
+		if op == nil {
+			return nil, fmt.Errorf("unknown operation %q", req.OperationName)
+		}
+	}
+
+	vars := map[string]any{}
+	for _, v := range op.variables {
+		value, ok := req.Variables[v.name]
+		if !ok && v.hasDefault {
+			value, ok = v.def, true
+		}
+		if ok {
+			value = fromJSON(value)
+		}
+		if v.required && value == nil {
+			return nil, fmt.Errorf("variable $%s is required", v.name)
+		}
+		vars[v.name] = value
+	}
+
+	roots := map[string]gqlRootField{}
+	for _, rf := range g.graphQLFields() {
+		roots[rf.name] = rf
+	}
+	if err := checkResponseKeys(op.selections); err != nil {
+		return nil, err
+	}
+	if g.maxBatch > 0 && len(op.selections) > g.maxBatch {
+		return nil, status.Errorf(codes.ResourceExhausted, "root fields are %d, above the limit of %d", len(op.selections), g.maxBatch)
+	}
+	selections := make([]gqlRootSelection, len(op.selections))
+	for i, f := range op.selections {
+		selections[i].field = f
+		if f.name == "__typename" {
+			continue
+		}
+		rf, ok := roots[f.name]
+		if !ok {
+			return nil, fmt.Errorf("cannot query field %q on type \"Query\"", f.name)
+		}
+		args, err := coerceArgs(rf.args, f.args, vars, f.name)
+		if err != nil {
+			return nil, err
+		}
+		if err := validateSelection(rf.typ, f); err != nil {
+			return nil, err
+		}
+		selections[i].root, selections[i].args = rf, args
+	}
+	return selections, nil
+}
+
+// coerceArgs substitutes the variables in the arguments of field, checks
+// them against their definitions and coerces them to their types.
+func coerceArgs(defs []gqlArg, values map[string]any, vars map[string]any, field string) (gqlArgs, error) {
+	known := map[string]bool{}
+	for _, d := range defs {
+		known[d.name] = true
+	}
+	for name := range values {
+		if !known[name] {
+			return nil, fmt.Errorf("unknown argument %q on field %q", name, field)
+		}
+	}
+	args := gqlArgs{}
+	for _, d := range defs {
+		v, err := substitute(values[d.name], vars)
+		if err != nil {
+			return nil, err
+		}
+		if v == nil {
+			if d.required {
+				return nil, fmt.Errorf("argument %q of field %q is required", d.name, field)
+			}
+			continue
+		}
+		if args[d.name], err = coerce(d, v, field); err != nil {
+			return nil, err
+		}
+	}
+	return args, nil
+}
+
+// coerce converts the value v of the argument d to its type.
+func coerce(d gqlArg, v any, field string) (any, error) {
+	invalid := fmt.Errorf("invalid value for argument %q of field %q: expected %s", d.name, field, d.typ)
+	switch d.typ {
+	case "String":
+		if s, ok := v.(string); ok {
+			return s, nil
+		}
+	case "Int":
+		if i, ok := v.(int64); ok && i >= math.MinInt32 && i <= math.MaxInt32 {
+			return i, nil
+		}
+	case "Float":
+		switch n := v.(type) {
+		case int64:
+			return float64(n), nil
+		case float64:
+			return n, nil
+		}
+	case "Boolean":
+		if b, ok := v.(bool); ok {
+			return b, nil
+		}
+	case "Location":
+		obj, ok := v.(map[string]any)
+		if !ok {
+			return nil, invalid
+		}
+		loc, err := coerceArgs(gqlLocationFields, obj, nil, field+"."+d.name)
+		return map[string]any(loc), err
+	}
+	return nil, invalid
+}
+
+// substitute replaces the variables in v by their values.
+func substitute(v any, vars map[string]any) (any, error) {
+	switch v := v.(type) {
+	case gqlVariableRef:
+		value, ok := vars[string(v)]
+		if !ok {
+			return nil, fmt.Errorf("variable $%s is not defined", v)
+		}
+		return value, nil
+	case []any:
+		list := make([]any, len(v))
+		for i, item := range v {
+			var err error
+			if list[i], err = substitute(item, vars); err != nil {
+				return nil, err
+			}
+		}
+		return list, nil
+	case map[string]any:
+		obj := make(map[string]any, len(v))
+		for k, item := range v {
+			var err error
+			if obj[k], err = substitute(item, vars); err != nil {
+				return nil, err
+			}
+		}
+		return obj, nil
+	}
+	return v, nil
+}
+
+// fromJSON converts the value of a variable decoded from JSON to the
+// values arguments are parsed into.
+func fromJSON(v any) any {
+	switch v := v.(type) {
+	case json.Number:
+		if i, err := v.Int64(); err == nil {
+			return i
+		}
+		f, _ := v.Float64()
+		return f
+	case []any:
+		for i, item := range v {
+			v[i] = fromJSON(item)
+		}
+	case map[string]any:
+		for k, item := range v {
+			v[k] = fromJSON(item)
+		}
+	}
+	return v
+}
+
+// validateSelection checks the fields selected on f, of message type md.
+func validateSelection(md protoreflect.MessageDescriptor, f *gqlField) error {
+	if len(f.selections) == 0 {
+		return fmt.Errorf("field %q of type %q must have a selection of subfields", f.name, md.Name())
+	}
+	if err := checkResponseKeys(f.selections); err != nil {
+		return err
+	}
+	for _, sub := range f.selections {
+		if sub.args != nil {
+			return fmt.Errorf("unknown argument on field %q of type %q", sub.name, md.Name())
+		}
+		if sub.name == "__typename" {
+			continue
+		}
+		fd := md.Fields().ByJSONName(sub.name)
+		if fd == nil {
+			return fmt.Errorf("cannot query field %q on type %q", sub.name, md.Name())
+		}
+		if fd.Message() != nil {
+			if err := validateSelection(fd.Message(), sub); err != nil {
+				return err
+			}
+		} else if len(sub.selections) > 0 {
+			return fmt.Errorf("field %q of type %q has no subfields", sub.name, md.Name())
+		}
+	}
+	return nil
+}
+
+// checkResponseKeys rejects selections that use the same response key
+// twice, which would make the response ambiguous.
+func checkResponseKeys(fields []*gqlField) error {
+	seen := map[string]bool{}
+	for _, f := range fields {
+		if seen[f.key()] {
+			return fmt.Errorf("response key %q selected twice", f.key())
+		}
+		seen[f.key()] = true
+	}
+	return nil
+}
+
+// gqlObject is an object of a GraphQL response, encoded with its fields in
+// the order they were selected.
+type gqlObject []gqlEntry
+
+type gqlEntry struct {
+	key   string
+	value any
+}
+
+func (o gqlObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, e := range o {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		key, _ := json.Marshal(e.key)
+		buf.Write(key)
+		buf.WriteByte(':')
+		value, err := json.Marshal(e.value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(value)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// gqlMessage returns the fields selected on m, or nil if m is not set.
+func gqlMessage(m protoreflect.Message, fields []*gqlField) any {
+	if !m.IsValid() {
+		return nil
+	}
+	md := m.Descriptor()
+	obj := make(gqlObject, len(fields))
+	for i, f := range fields {
+		obj[i].key = f.key()
+		if f.name == "__typename" {
+			obj[i].value = string(md.Name())
+			continue
+		}
+		fd := md.Fields().ByJSONName(f.name)
+		switch {
+		case fd.IsList():
+			list := m.Get(fd).List()
+			items := make([]any, list.Len())
+			for j := range items {
+				items[j] = gqlValue(fd, list.Get(j), f.selections)
+			}
+			obj[i].value = items
+		case fd.Message() != nil && !m.Has(fd):
+			obj[i].value = nil
+		default:
+			obj[i].value = gqlValue(fd, m.Get(fd), f.selections)
+		}
+	}
+	return obj
+}
+
+// gqlList returns the fields selected on the messages of list.
+func gqlList(list protoreflect.List, fields []*gqlField) []any {
+	items := make([]any, list.Len())
+	for i := range items {
+		items[i] = gqlMessage(list.Get(i).Message(), fields)
+	}
+	return items
+}
+
+// gqlValue returns a single value of the field fd, following the protojson
+// mapping as the REST responses do.
+func gqlValue(fd protoreflect.FieldDescriptor, v protoreflect.Value, fields []*gqlField) any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return v.Bool()
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return v.Int()
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return v.Uint()
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// protojson encodes 64-bit integers as strings.
+		return strconv.FormatInt(v.Int(), 10)
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return strconv.FormatUint(v.Uint(), 10)
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		if f := v.Float(); !math.IsNaN(f) && !math.IsInf(f, 0) {
+			return f
+		}
+		return nil
+	case protoreflect.BytesKind:
+		return base64.StdEncoding.EncodeToString(v.Bytes())
+	case protoreflect.EnumKind:
+		if ev := fd.Enum().Values().ByNumber(v.Enum()); ev != nil {
+			return string(ev.Name())
+		}
+		return int32(v.Enum())
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return gqlMessage(v.Message(), fields)
+	default:
+		return v.String()
+	}
+}
+
+func writeGraphQLErrors(w http.ResponseWriter, code int, err error) {
+	body, _ := json.Marshal(map[string]any{"errors": []*gqlError{{Message: err.Error()}}})
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	w.Write(body)
+}
+
+// graphQLSchema returns the schema of the GraphQL endpoint in the schema
+// definition language, with the object types derived from the protobuf
+// messages.
+func graphQLSchema(fields []gqlRootField) []byte {
+	var buf bytes.Buffer
+	types := map[string]protoreflect.MessageDescriptor{}
+	buf.WriteString("type Query {\n")
+	for _, rf := range fields {
+		var args []string
+		for _, a := range rf.args {
+			args = append(args, a.name+": "+gqlTypeName(a.typ, a.required))
+		}
+		typ := string(rf.typ.Name())
+		if rf.list {
+			typ = "[" + typ + "!]"
+		}
+		fmt.Fprintf(&buf, "  %q\n  %s(%s): %s\n", rf.description, rf.name, strings.Join(args, ", "), typ)
+		collectTypes(rf.typ, types)
+	}
+	buf.WriteString("}\n\ninput Location {\n")
+	for _, a := range gqlLocationFields {
+		fmt.Fprintf(&buf, "  %s: %s\n", a.name, gqlTypeName(a.typ, a.required))
+	}
+	buf.WriteString("}\n")
+
+	names := make([]string, 0, len(types))
+	for name := range types {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		md := types[name]
+		fmt.Fprintf(&buf, "\ntype %s {\n", name)
+		for i := 0; i < md.Fields().Len(); i++ {
+			fd := md.Fields().Get(i)
+			typ := gqlFieldType(fd)
+			if fd.IsList() {
+				typ = "[" + typ + "!]!"
+			}
+			fmt.Fprintf(&buf, "  %s: %s\n", fd.JSONName(), typ)
+		}
+		buf.WriteString("}\n")
+	}
+	return buf.Bytes()
+}
+
+// collectTypes adds md, and the messages it refers to, to types.
+func collectTypes(md protoreflect.MessageDescriptor, types map[string]protoreflect.MessageDescriptor) {
+	if _, ok := types[string(md.Name())]; ok {
+		return
+	}
+	types[string(md.Name())] = md
+	for i := 0; i < md.Fields().Len(); i++ {
+		if m := md.Fields().Get(i).Message(); m != nil {
+			collectTypes(m, types)
+		}
+	}
+}
+
+func gqlTypeName(typ string, required bool) string {
+	if required {
+		return typ + "!"
+	}
+	return typ
+}
+
+// gqlFieldType returns the GraphQL type of a single value of a field.
+func gqlFieldType(fd protoreflect.FieldDescriptor) string {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return "Boolean"
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return "Int"
+	case protoreflect.FloatKind, protoreflect.DoubleKind:
+		return "Float"
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return string(fd.Message().Name())
+	default:
+		// 64-bit integers, bytes and enums are encoded as strings.
+		return "String"
+	}
+}
+
+func (g *Gateway) getGraphQLSchema(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	w.Write(g.graphQLSchema)
+}
@@ -0,0 +1,499 @@
+package gateway
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// The gateway parses the subset of the GraphQL query language its schema
+// needs: query operations with variables, fields with aliases, arguments
+// and selections. Fragments, directives, mutations and subscriptions are
+// rejected with an error naming them.
+
+// gqlOperation is an operation of a GraphQL document.
+type gqlOperation struct {
+	name       string
+	variables  []gqlVariable
+	selections []*gqlField
+}
+
+// gqlVariable is a variable defined by an operation.
+type gqlVariable struct {
+	name string
+	// required marks a variable of a non-null type.
+	required   bool
+	def        any
+	hasDefault bool
+}
+
+// gqlField is a field selected in a query.
+type gqlField struct {
+	alias string
+	name  string
+	args  map[string]any
+	// selections are the fields selected on the value of the field, which
+	// must be an object.
+	selections []*gqlField
+}
+
+// key returns the name of the field in the response.
+func (f *gqlField) key() string {
+	if f.alias != "" {
+		return f.alias
+	}
+	return f.name
+}
+
+// Argument values are parsed into string, int64, float64, bool, nil,
+// gqlEnum, []any and map[string]any values, and references to variables
+// into gqlVariableRef values until the variables are substituted.
+type (
+	gqlEnum        string
+	gqlVariableRef string
+)
+
+// gqlParser parses a GraphQL document.
+type gqlParser struct {
+	src string
+	pos int
+	// tok is the current token, and kind its kind.
+	tok  string
+	kind gqlTokenKind
+}
+
+type gqlTokenKind int
+
+const (
+	gqlEOF gqlTokenKind = iota
+	gqlPunct
+	gqlName
+	gqlInt
+	gqlFloat
+	gqlString
+)
+
+// parseGraphQL parses the operations of a document.
+func parseGraphQL(src string) ([]*gqlOperation, error) {
+	p := &gqlParser{src: src}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+	var ops []*gqlOperation
+	for p.kind != gqlEOF {
+		op, err := p.operation()
+		if err != nil {
+			return nil, err
+		}
+		ops = append(ops, op)
+	}
+	if len(ops) == 0 {
+		return nil, fmt.Errorf("document contains no operation")
+	}
+	// Operations are selected by name, so a document of several must name
+	// each of them differently.
+	names := map[string]bool{}
+	for _, op := range ops {
+		switch {
+		case op.name == "" && len(ops) > 1:
+			return nil, fmt.Errorf("an anonymous operation must be the only operation of its document")
+		case names[op.name]:
+			return nil, fmt.Errorf("operation %q is defined twice", op.name)
+		}
+		names[op.name] = true
+	}
+	return ops, nil
+}
+
+func (p *gqlParser) operation() (*gqlOperation, error) {
+	op := &gqlOperation{}
+	// A bare selection set is a query shorthand.
+	if !p.is(gqlPunct, "{") {
+		if p.kind != gqlName {
+			return nil, p.unexpected()
+		}
+		switch p.tok {
+		case "query":
+		case "mutation", "subscription":
+			return nil, fmt.Errorf("%s operations are not supported", p.tok)
+		case "fragment":
+			return nil, fmt.Errorf("fragments are not supported")
+		default:
+			return nil, p.unexpected()
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		if p.kind == gqlName {
+			op.name = p.tok
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+		}
+		if p.is(gqlPunct, "(") {
+			vars, err := p.variableDefinitions()
+			if err != nil {
+				return nil, err
+			}
+			op.variables = vars
+		}
+		if p.is(gqlPunct, "@") {
+			return nil, fmt.Errorf("directives are not supported")
+		}
+	}
+	sel, err := p.selectionSet()
+	if err != nil {
+		return nil, err
+	}
+	op.selections = sel
+	return op, nil
+}
+
+func (p *gqlParser) variableDefinitions() ([]gqlVariable, error) {
+	if err := p.expect(gqlPunct, "("); err != nil {
+		return nil, err
+	}
+	var vars []gqlVariable
+	for !p.is(gqlPunct, ")") {
+		if err := p.expect(gqlPunct, "$"); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect(gqlPunct, ":"); err != nil {
+			return nil, err
+		}
+		required, err := p.typeRef()
+		if err != nil {
+			return nil, err
+		}
+		v := gqlVariable{name: name, required: required}
+		if p.is(gqlPunct, "=") {
+			if err := p.next(); err != nil {
+				return nil, err
+			}
+			if v.def, err = p.value(true); err != nil {
+				return nil, err
+			}
+			v.hasDefault = true
+		}
+		vars = append(vars, v)
+	}
+	return vars, p.next()
+}
+
+// typeRef skips a type reference, such as [String!]!, and returns whether
+// it is non-null.
+func (p *gqlParser) typeRef() (bool, error) {
+	if p.is(gqlPunct, "[") {
+		if err := p.next(); err != nil {
+			return false, err
+		}
+		if _, err := p.typeRef(); err != nil {
+			return false, err
+		}
+		if err := p.expect(gqlPunct, "]"); err != nil {
+			return false, err
+		}
+	} else if _, err := p.name(); err != nil {
+		return false, err
+	}
+	if !p.is(gqlPunct, "!") {
+		return false, nil
+	}
+	return true, p.next()
+}
+
+func (p *gqlParser) selectionSet() ([]*gqlField, error) {
+	if err := p.expect(gqlPunct, "{"); err != nil {
+		return nil, err
+	}
+	var fields []*gqlField
+	for !p.is(gqlPunct, "}") {
+		if p.is(gqlPunct, "...") {
+			return nil, fmt.Errorf("fragments are not supported")
+		}
+		f, err := p.field()
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, f)
+	}
+	if len(fields) == 0 {
+		return nil, fmt.Errorf("empty selection set")
+	}
+	return fields, p.next()
+}
+
+func (p *gqlParser) field() (*gqlField, error) {
+	name, err := p.name()
+	if err != nil {
+		return nil, err
+	}
+	f := &gqlField{name: name}
+	if p.is(gqlPunct, ":") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		f.alias = name
+		if f.name, err = p.name(); err != nil {
+			return nil, err
+		}
+	}
+	if p.is(gqlPunct, "(") {
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		f.args = map[string]any{}
+		for !p.is(gqlPunct, ")") {
+			arg, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			if _, ok := f.args[arg]; ok {
+				return nil, fmt.Errorf("argument %q given twice", arg)
+			}
+			if err := p.expect(gqlPunct, ":"); err != nil {
+				return nil, err
+			}
+			if f.args[arg], err = p.value(false); err != nil {
+				return nil, err
+			}
+		}
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+	}
+	if p.is(gqlPunct, "@") {
+		return nil, fmt.Errorf("directives are not supported")
+	}
+	if p.is(gqlPunct, "{") {
+		if f.selections, err = p.selectionSet(); err != nil {
+			return nil, err
+		}
+	}
+	return f, nil
+}
+
+// value parses an argument value, which is constant in the default values
+// of variables.
+func (p *gqlParser) value(constant bool) (any, error) {
+	var v any
+	switch {
+	case p.is(gqlPunct, "$") && !constant:
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		name, err := p.name()
+		return gqlVariableRef(name), err
+	case p.is(gqlPunct, "["):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		list := []any{}
+		for !p.is(gqlPunct, "]") {
+			item, err := p.value(constant)
+			if err != nil {
+				return nil, err
+			}
+			list = append(list, item)
+		}
+		v = list
+	case p.is(gqlPunct, "{"):
+		if err := p.next(); err != nil {
+			return nil, err
+		}
+		obj := map[string]any{}
+		for !p.is(gqlPunct, "}") {
+			name, err := p.name()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(gqlPunct, ":"); err != nil {
+				return nil, err
+			}
+			if obj[name], err = p.value(constant); err != nil {
+				return nil, err
+			}
+		}
+		v = obj
+	case p.kind == gqlInt:
+		i, err := strconv.ParseInt(p.tok, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid integer %s", p.tok)
+		}
+		v = i
+	case p.kind == gqlFloat:
+		f, err := strconv.ParseFloat(p.tok, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid number %s", p.tok)
+		}
+		v = f
+	case p.kind == gqlString:
+		v = p.tok
+	case p.kind == gqlName:
+		switch p.tok {
+		case "true", "false":
+			v = p.tok == "true"
+		case "null":
+			v = nil
+		default:
+			v = gqlEnum(p.tok)
+		}
+	default:
+		return nil, p.unexpected()
+	}
+	return v, p.next()
+}
+
+func (p *gqlParser) name() (string, error) {
+	if p.kind != gqlName {
+		return "", p.unexpected()
+	}
+	name := p.tok
+	return name, p.next()
+}
+
+func (p *gqlParser) is(kind gqlTokenKind, tok string) bool {
+	return p.kind == kind && p.tok == tok
+}
+
+func (p *gqlParser) expect(kind gqlTokenKind, tok string) error {
+	if !p.is(kind, tok) {
+		return p.unexpected()
+	}
+	return p.next()
+}
+
+func (p *gqlParser) unexpected() error {
+	if p.kind == gqlEOF {
+		return fmt.Errorf("unexpected end of document")
+	}
+	return fmt.Errorf("unexpected %q at offset %d", p.tok, p.pos-len(p.tok))
+}
+
+// next reads the next token, skipping white space, commas and comments.
+func (p *gqlParser) next() error {
+	for p.pos < len(p.src) {
+		c := p.src[p.pos]
+		if c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == ',' {
+			p.pos++
+		} else if c == '#' {
+			for p.pos < len(p.src) && p.src[p.pos] != '\n' && p.src[p.pos] != '\r' {
+				p.pos++
+			}
+		} else {
+			break
+		}
+	}
+	if p.pos >= len(p.src) {
+		p.kind, p.tok = gqlEOF, ""
+		return nil
+	}
+	start := p.pos
+	c := p.src[p.pos]
+	switch {
+	case strings.HasPrefix(p.src[p.pos:], "..."):
+		p.pos += 3
+		p.kind = gqlPunct
+	case strings.IndexByte("!$():=@[]{}|", c) >= 0:
+		p.pos++
+		p.kind = gqlPunct
+	case c == '_' || isLetter(c):
+		for p.pos < len(p.src) && (p.src[p.pos] == '_' || isLetter(p.src[p.pos]) || isDigit(p.src[p.pos])) {
+			p.pos++
+		}
+		p.kind = gqlName
+	case c == '-' || isDigit(c):
+		return p.number()
+	case c == '"':
+		return p.string()
+	default:
+		r, _ := utf8.DecodeRuneInString(p.src[p.pos:])
+		return fmt.Errorf("unexpected character %q at offset %d", r, p.pos)
+	}
+	p.tok = p.src[start:p.pos]
+	return nil
+}
+
+func (p *gqlParser) number() error {
+	start := p.pos
+	if p.src[p.pos] == '-' {
+		p.pos++
+	}
+	digits := func() int {
+		n := 0
+		for p.pos < len(p.src) && isDigit(p.src[p.pos]) {
+			p.pos++
+			n++
+		}
+		return n
+	}
+	if digits() == 0 {
+		return fmt.Errorf("invalid number at offset %d", start)
+	}
+	p.kind = gqlInt
+	if p.pos < len(p.src) && p.src[p.pos] == '.' {
+		p.pos++
+		if digits() == 0 {
+			return fmt.Errorf("invalid number at offset %d", start)
+		}
+		p.kind = gqlFloat
+	}
+	if p.pos < len(p.src) && (p.src[p.pos] == 'e' || p.src[p.pos] == 'E') {
+		p.pos++
+		if p.pos < len(p.src) && (p.src[p.pos] == '+' || p.src[p.pos] == '-') {
+			p.pos++
+		}
+		if digits() == 0 {
+			return fmt.Errorf("invalid number at offset %d", start)
+		}
+		p.kind = gqlFloat
+	}
+	p.tok = p.src[start:p.pos]
+	return nil
+}
+
+// string reads a string, which may be a block string between triple
+// quotes whose lines are taken as they are.
+func (p *gqlParser) string() error {
+	start := p.pos
+	p.kind = gqlString
+	if strings.HasPrefix(p.src[p.pos:], `"""`) {
+		end := strings.Index(p.src[p.pos+3:], `"""`)
+		if end < 0 {
+			return fmt.Errorf("unterminated string at offset %d", start)
+		}
+		p.tok = p.src[p.pos+3 : p.pos+3+end]
+		p.pos += end + 6
+		return nil
+	}
+	p.pos++
+	for p.pos < len(p.src) {
+		switch p.src[p.pos] {
+		case '\\':
+			p.pos += 2
+			continue
+		case '\n', '\r':
+			return fmt.Errorf("unterminated string at offset %d", start)
+		case '"':
+			p.pos++
+			// GraphQL escapes characters in strings as Go does, but for \/.
+			s, err := strconv.Unquote(strings.ReplaceAll(p.src[start:p.pos], `\/`, `/`))
+			if err != nil {
+				return fmt.Errorf("invalid string at offset %d", start)
+			}
+			p.tok = s
+			return nil
+		}
+		p.pos++
+	}
+	return fmt.Errorf("unterminated string at offset %d", start)
+}
+
+func isLetter(c byte) bool { return c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' }
+
+func isDigit(c byte) bool { return c >= '0' && c <= '9' }
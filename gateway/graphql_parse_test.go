@@ -0,0 +1,149 @@
+package gateway
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseGraphQL(t *testing.T) {
+	date := &gqlField{name: "date"}
+	for _, tt := range []struct {
+		name string
+		src  string
+		want []*gqlOperation
+	}{
+		{
+			name: "shorthand",
+			src:  `{ panchangam { date } }`,
+			want: []*gqlOperation{{selections: []*gqlField{{name: "panchangam", selections: []*gqlField{date}}}}},
+		},
+		{
+			name: "named with comments and commas",
+			src: `# The panchangam of today
+				query Today { panchangam { date, tithi } } # done`,
+			want: []*gqlOperation{{name: "Today", selections: []*gqlField{{name: "panchangam", selections: []*gqlField{date, {name: "tithi"}}}}}},
+		},
+		{
+			name: "aliases",
+			src:  `{ chennai: panchangam { day: date } }`,
+			want: []*gqlOperation{{selections: []*gqlField{{alias: "chennai", name: "panchangam", selections: []*gqlField{{alias: "day", name: "date"}}}}}},
+		},
+		{
+			name: "variables",
+			src:  `query Q($date: String!, $days: Int = 7, $kinds: [String!]!, $loc: Location) { festivals(from: $date) { date } }`,
+			want: []*gqlOperation{{
+				name: "Q",
+				variables: []gqlVariable{
+					{name: "date", required: true},
+					{name: "days", def: int64(7), hasDefault: true},
+					{name: "kinds", required: true},
+					{name: "loc"},
+				},
+				selections: []*gqlField{{name: "festivals", args: map[string]any{"from": gqlVariableRef("date")}, selections: []*gqlField{date}}},
+			}},
+		},
+		{
+			name: "argument values",
+			src: `{ f(s: "a\"bé\/", block: """line "one"
+line two""", i: -12, x: 1.5e3, y: 2E-1, t: true, n: null, e: SMARTA, l: [1 "two" [3]], o: {lat: 13.08, tz: $tz}) { date } }`,
+			want: []*gqlOperation{{selections: []*gqlField{{
+				name: "f",
+				args: map[string]any{
+					"s":     `a"bé/`,
+					"block": "line \"one\"\nline two",
+					"i":     int64(-12),
+					"x":     1500.0,
+					"y":     0.2,
+					"t":     true,
+					"n":     nil,
+					"e":     gqlEnum("SMARTA"),
+					"l":     []any{int64(1), "two", []any{int64(3)}},
+					"o":     map[string]any{"lat": 13.08, "tz": gqlVariableRef("tz")},
+				},
+				selections: []*gqlField{date},
+			}}}},
+		},
+		{
+			name: "several operations",
+			src:  `query A { panchangam { date } } query B { festivals { date } }`,
+			want: []*gqlOperation{
+				{name: "A", selections: []*gqlField{{name: "panchangam", selections: []*gqlField{date}}}},
+				{name: "B", selections: []*gqlField{{name: "festivals", selections: []*gqlField{date}}}},
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseGraphQL(tt.src)
+			if err != nil {
+				t.Fatalf("parseGraphQL() error = %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("parseGraphQL() = %s, want %s", dumpOperations(got), dumpOperations(tt.want))
+			}
+		})
+	}
+}
+
+func TestParseGraphQLErrors(t *testing.T) {
+	for _, tt := range []struct {
+		src, want string
+	}{
+		{"", "document contains no operation"},
+		{"# only a comment", "document contains no operation"},
+		{`{ panchangam { ...Fields } }`, "fragments are not supported"},
+		{`{ panchangam { ... on PanchangamData { date } } }`, "fragments are not supported"},
+		{`fragment Fields on PanchangamData { date } { panchangam { ...Fields } }`, "fragments are not supported"},
+		{`mutation { subscribe }`, "mutation operations are not supported"},
+		{`subscription { transitions }`, "subscription operations are not supported"},
+		{`query Q @cached { panchangam { date } }`, "directives are not supported"},
+		{`{ panchangam @include(if: true) { date } }`, "directives are not supported"},
+		{`{ }`, "empty selection set"},
+		{`{ panchangam { date }`, "unexpected end of document"},
+		{`{ panchangam(date: "2024\q") { date } }`, "invalid string at offset 19"},
+		{`{ panchangam(date: "2024`, "unterminated string at offset 19"},
+		{"{ panchangam(date: \"2024\n\") { date } }", "unterminated string at offset 19"},
+		{`{ panchangam(date: """2024) { date } }`, "unterminated string at offset 19"},
+		{`{ panchangam(days: 1.) { date } }`, "invalid number at offset 19"},
+		{`{ panchangam(days: -) { date } }`, "invalid number at offset 19"},
+		{`{ panchangam(days: 99999999999999999999) { date } }`, "invalid integer 99999999999999999999"},
+		{`{ panchangam(days: 1, days: 2) { date } }`, `argument "days" given twice`},
+		{`{ panchangam(days: $) { date } }`, `unexpected ")" at offset 20`},
+		{`query Q($days: Int = $other) { panchangam { date } }`, `unexpected "$" at offset 21`},
+		{`{ panchangam ~ }`, `unexpected character '~' at offset 13`},
+		{`query 1 { date }`, `unexpected "1" at offset 6`},
+		{`{ a { date } } { b { date } }`, "an anonymous operation must be the only operation of its document"},
+		{`query A { date } { b { date } }`, "an anonymous operation must be the only operation of its document"},
+		{`query A { date } query A { tithi }`, `operation "A" is defined twice`},
+	} {
+		if _, err := parseGraphQL(tt.src); err == nil || err.Error() != tt.want {
+			t.Errorf("parseGraphQL(%q) error = %v, want %s", tt.src, err, tt.want)
+		}
+	}
+}
+
+// dumpOperations formats ops for test failures.
+func dumpOperations(ops []*gqlOperation) string {
+	var b strings.Builder
+	var fields func([]*gqlField)
+	fields = func(fs []*gqlField) {
+		b.WriteString("{")
+		for _, f := range fs {
+			fmt.Fprintf(&b, " %s:%s", f.alias, f.name)
+			if f.args != nil {
+				fmt.Fprintf(&b, "%#v", f.args)
+			}
+			if f.selections != nil {
+				fields(f.selections)
+			}
+		}
+		b.WriteString(" }")
+	}
+	for _, op := range ops {
+		fmt.Fprintf(&b, "query %s%+v ", op.name, op.variables)
+		fields(op.selections)
+		b.WriteString("\n")
+	}
+	return b.String()
+}
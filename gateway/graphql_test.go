@@ -0,0 +1,275 @@
+package gateway
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// graphQLBackend returns a backend answering the root fields of the
+// GraphQL schema.
+func graphQLBackend() *backend {
+	return &backend{
+		get: func(req *ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error) {
+			resp, _ := panchangam(req)
+			resp.PanchangamData.AbhijitMuhurta = &ppb.Muhurta{Name: "Abhijit", StartTime: "11:48:02", EndTime: "12:36:10"}
+			resp.PanchangamData.MoonIllumination = 0.02
+			resp.PanchangamData.NearBoundaries = []*ppb.ElementBoundary{{}, {}}
+			return resp, nil
+		},
+		getEvents:  festivals,
+		getMuhurta: func(*ppb.GetMuhurtaRequest) (*ppb.GetMuhurtaResponse, error) { return &ppb.GetMuhurtaResponse{}, nil },
+	}
+}
+
+// postGraphQL posts a GraphQL request to g and returns its status and body.
+func postGraphQL(t *testing.T, g http.Handler, req gqlRequest, header http.Header) (int, string) {
+	t.Helper()
+	body, err := json.Marshal(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, b := serve(t, g, http.MethodPost, "/graphql", string(body), header)
+	return resp.StatusCode, b
+}
+
+func TestGraphQL(t *testing.T) {
+	b := graphQLBackend()
+	g := NewGateway(b)
+	code, body := postGraphQL(t, g, gqlRequest{
+		Query: `query Day($date: String!, $lat: Float!, $days: Int = 3) {
+			__typename
+			today: panchangam(date: $date, location: {lat: $lat, lon: 80.2707, tz: "Asia/Kolkata"}, region: "tamil_nadu") {
+				tithi date __typename
+				abhijitMuhurta { startTime }
+				brahmaMuhurta { startTime }
+				moonIllumination
+				nearBoundaries { __typename }
+			}
+			festivals(from: $date, days: $days, location: {lat: $lat, lon: 80}) { id names { name } }
+		}`,
+		OperationName: "Day",
+		Variables:     map[string]any{"date": "2024-04-09", "lat": 13},
+	}, http.Header{"Accept-Language": {"te"}})
+	want := `{"data":{"__typename":"Query",` +
+		`"today":{"tithi":"శుక్ల పాడ్యమి","date":"2024-04-09","__typename":"PanchangamData","abhijitMuhurta":{"startTime":"11:48:02"},"brahmaMuhurta":null,"moonIllumination":0.02,"nearBoundaries":[{"__typename":"ElementBoundary"},{"__typename":"ElementBoundary"}]},` +
+		`"festivals":[{"id":"ugadi-2024-04-09","names":[{"name":"Ugadi"},{"name":"ఉగాది"}]}]}}`
+	if code != http.StatusOK || body != want {
+		t.Errorf("POST /graphql = %d %s, want %s", code, body, want)
+	}
+
+	// The root fields are fetched with the arguments and variables given.
+	var get *ppb.GetPanchangamRequest
+	var events *ppb.GetEventsRequest
+	for _, req := range b.requests {
+		switch req := req.(type) {
+		case *ppb.GetPanchangamRequest:
+			get = req
+		case *ppb.GetEventsRequest:
+			events = req
+		}
+	}
+	wantGet := &ppb.GetPanchangamRequest{Date: "2024-04-09", Latitude: 13, Longitude: 80.2707, Timezone: "Asia/Kolkata", Region: "tamil_nadu", Locale: "te"}
+	if !proto.Equal(get, wantGet) {
+		t.Errorf("Get() request = %v, want %v", get, wantGet)
+	}
+	wantEvents := &ppb.GetEventsRequest{Date: "2024-04-09", Days: 3, Latitude: 13, Longitude: 80}
+	if !proto.Equal(events, wantEvents) {
+		t.Errorf("GetEvents() request = %v, want %v", events, wantEvents)
+	}
+}
+
+func TestGraphQLGet(t *testing.T) {
+	b := graphQLBackend()
+	query := url.Values{
+		"query":     {`query($d: String!) { panchangam(date: $d, location: {lat: 1, lon: 2}) { date } }`},
+		"variables": {`{"d": "2024-04-10"}`},
+	}
+	resp, body := serve(t, NewGateway(b), http.MethodGet, "/graphql?"+query.Encode(), "", nil)
+	if resp.StatusCode != http.StatusOK || body != `{"data":{"panchangam":{"date":"2024-04-10"}}}` {
+		t.Errorf("GET /graphql = %d %s", resp.StatusCode, body)
+	}
+	query.Set("variables", "{")
+	if resp, body := serve(t, NewGateway(b), http.MethodGet, "/graphql?"+query.Encode(), "", nil); resp.StatusCode != http.StatusBadRequest || !strings.Contains(body, "invalid variables") {
+		t.Errorf("GET /graphql with invalid variables = %d %s", resp.StatusCode, body)
+	}
+}
+
+func TestGraphQLOperationName(t *testing.T) {
+	const two = `query A { a: panchangam(date: "2024-04-09", location: {lat: 1, lon: 2}) { date } }
+		query B { b: panchangam(date: "2024-04-10", location: {lat: 1, lon: 2}) { date } }`
+	for _, tt := range []struct {
+		name, query, operation string
+		// want is the key of the field of the operation run, or the error.
+		want string
+	}{
+		{"lone anonymous", `{ a: panchangam(date: "2024-04-09", location: {lat: 1, lon: 2}) { date } }`, "", `"a"`},
+		{"lone named", `query A { a: panchangam(date: "2024-04-09", location: {lat: 1, lon: 2}) { date } }`, "", `"a"`},
+		{"lone named by name", `query A { a: panchangam(date: "2024-04-09", location: {lat: 1, lon: 2}) { date } }`, "A", `"a"`},
+		{"first of two", two, "A", `"a"`},
+		{"second of two", two, "B", `"b"`},
+		{"two without a name", two, "", "operationName is required for a document of several operations"},
+		{"unknown", two, "C", `unknown operation \"C\"`},
+		{"anonymous and named", `{ a: panchangam { date } } query B { b: panchangam { date } }`, "", "an anonymous operation must be the only operation of its document"},
+		{"anonymous and named by name", `{ a: panchangam { date } } query B { b: panchangam { date } }`, "B", "an anonymous operation must be the only operation of its document"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			b := graphQLBackend()
+			code, body := postGraphQL(t, NewGateway(b), gqlRequest{Query: tt.query, OperationName: tt.operation}, nil)
+			if strings.HasPrefix(tt.want, `"`) {
+				if code != http.StatusOK || !strings.HasPrefix(body, `{"data":{`+tt.want) || b.calls() != 1 {
+					t.Errorf("POST /graphql = %d %s after %d calls, want the field %s", code, body, b.calls(), tt.want)
+				}
+				return
+			}
+			if code != http.StatusBadRequest || body != `{"errors":[{"message":"`+tt.want+`"}]}` || b.calls() != 0 {
+				t.Errorf("POST /graphql = %d %s after %d calls, want error %s", code, body, b.calls(), tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphQLInvalid(t *testing.T) {
+	const loc = `location: {lat: 1, lon: 2}`
+	for _, tt := range []struct {
+		query     string
+		variables map[string]any
+		want      string
+	}{
+		{``, nil, "missing query"},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `) { ...F } }`, nil, "fragments are not supported"},
+		{`{ calendar { date } }`, nil, `cannot query field \"calendar\" on type \"Query\"`},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `, year: 2024) { date } }`, nil, `unknown argument \"year\" on field \"panchangam\"`},
+		{`{ panchangam(` + loc + `) { date } }`, nil, `argument \"date\" of field \"panchangam\" is required`},
+		{`{ panchangam(date: 20240409, ` + loc + `) { date } }`, nil, `invalid value for argument \"date\" of field \"panchangam\": expected String`},
+		{`{ panchangam(date: "2024-04-09", location: {lat: 1}) { date } }`, nil, `argument \"lon\" of field \"panchangam.location\" is required`},
+		{`{ panchangam(date: "2024-04-09", location: {lat: "north", lon: 2}) { date } }`, nil, `invalid value for argument \"lat\" of field \"panchangam.location\": expected Float`},
+		{`{ panchangam(date: "2024-04-09", location: [1, 2]) { date } }`, nil, `invalid value for argument \"location\" of field \"panchangam\": expected Location`},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `, hijriAdjustmentDays: 3000000000) { date } }`, nil, `invalid value for argument \"hijriAdjustmentDays\" of field \"panchangam\": expected Int`},
+		{`query($d: String!) { panchangam(date: $d, ` + loc + `) { date } }`, nil, `variable $d is required`},
+		{`query { panchangam(date: $d, ` + loc + `) { date } }`, nil, `variable $d is not defined`},
+		{`query($d: String) { panchangam(date: $d, ` + loc + `) { date } }`, map[string]any{"d": nil}, `argument \"date\" of field \"panchangam\" is required`},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `) }`, nil, `field \"panchangam\" of type \"PanchangamData\" must have a selection of subfields`},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `) { date { year } } }`, nil, `field \"date\" of type \"PanchangamData\" has no subfields`},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `) { abhijitMuhurta } }`, nil, `field \"abhijitMuhurta\" of type \"Muhurta\" must have a selection of subfields`},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `) { sunrise } }`, nil, `cannot query field \"sunrise\" on type \"PanchangamData\"`},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `) { date(format: "long") } }`, nil, `unknown argument on field \"date\" of type \"PanchangamData\"`},
+		{`{ panchangam(date: "2024-04-09", ` + loc + `) { date date } }`, nil, `response key \"date\" selected twice`},
+		{`{ p: panchangam(date: "2024-04-09", ` + loc + `) { date } p: festivals(from: "2024-04-09", ` + loc + `) { date } }`, nil, `response key \"p\" selected twice`},
+	} {
+		b := graphQLBackend()
+		code, body := postGraphQL(t, NewGateway(b), gqlRequest{Query: tt.query, Variables: tt.variables}, nil)
+		if code != http.StatusBadRequest || body != `{"errors":[{"message":"`+tt.want+`"}]}` || b.calls() != 0 {
+			t.Errorf("POST /graphql %s = %d %s after %d calls, want error %s", tt.query, code, body, b.calls(), tt.want)
+		}
+	}
+	resp, body := serve(t, NewGateway(graphQLBackend()), http.MethodPost, "/graphql", `{"query": 1}`, nil)
+	if resp.StatusCode != http.StatusBadRequest || !strings.Contains(body, "invalid request body") {
+		t.Errorf("POST /graphql with an invalid body = %d %s", resp.StatusCode, body)
+	}
+}
+
+func TestGraphQLFieldError(t *testing.T) {
+	// A failing field is null and reported while the others are answered.
+	b := graphQLBackend()
+	b.getEvents = func(*ppb.GetEventsRequest) (*ppb.GetEventsResponse, error) {
+		return nil, status.Error(codes.InvalidArgument, "days must be at most 366")
+	}
+	_, body := postGraphQL(t, NewGateway(b), gqlRequest{Query: `{
+		panchangam(date: "2024-04-09", location: {lat: 1, lon: 2}) { date }
+		later: festivals(from: "2024-04-09", days: 400, location: {lat: 1, lon: 2}) { date }
+	}`}, http.Header{"X-Request-Id": {"req-1"}})
+	var resp struct {
+		Data   map[string]json.RawMessage `json:"data"`
+		Errors []gqlError                 `json:"errors"`
+	}
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatalf("response %s: %v", body, err)
+	}
+	if string(resp.Data["panchangam"]) != `{"date":"2024-04-09"}` || string(resp.Data["later"]) != "null" {
+		t.Errorf("data = %s", body)
+	}
+	if len(resp.Errors) != 1 {
+		t.Fatalf("errors = %s", body)
+	}
+	e := resp.Errors[0]
+	if e.Message != "days must be at most 366" || len(e.Path) != 1 || e.Path[0] != "later" ||
+		e.Extensions["code"] != "INVALID_ARGUMENT" || e.Extensions["requestId"] != "req-1" {
+		t.Errorf("error = %+v", e)
+	}
+}
+
+func TestGraphQLLimits(t *testing.T) {
+	field := func(i int) string {
+		return fmt.Sprintf(`d%d: panchangam(date: "2024-04-09", location: {lat: 1, lon: 2}) { date } `, i)
+	}
+
+	// A query may select as many root fields as a batch may list items.
+	b := graphQLBackend()
+	code, body := postGraphQL(t, NewGateway(b, WithMaxBatch(2)), gqlRequest{Query: "{ " + field(1) + field(2) + field(3) + "}"}, nil)
+	if want := `{"errors":[{"message":"root fields are 3, above the limit of 2"}]}`; code != http.StatusTooManyRequests || body != want || b.calls() != 0 {
+		t.Errorf("POST /graphql of 3 fields = %d %s after %d calls, want 429 %s", code, body, b.calls(), want)
+	}
+	if code, body := postGraphQL(t, NewGateway(b, WithMaxBatch(2)), gqlRequest{Query: "{ " + field(1) + field(2) + "}"}, nil); code != http.StatusOK || b.calls() != 2 {
+		t.Errorf("POST /graphql of 2 fields = %d %s after %d calls", code, body, b.calls())
+	}
+
+	// The fields are fetched by at most batchWorkers calls at once.
+	var mu sync.Mutex
+	running, most := 0, 0
+	b = graphQLBackend()
+	get := b.get
+	b.get = func(req *ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error) {
+		mu.Lock()
+		running++
+		most = max(most, running)
+		mu.Unlock()
+		time.Sleep(5 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+		return get(req)
+	}
+	var query strings.Builder
+	for i := 0; i < 4*batchWorkers; i++ {
+		query.WriteString(field(i))
+	}
+	code, body = postGraphQL(t, NewGateway(b), gqlRequest{Query: "{ " + query.String() + "}"}, nil)
+	if code != http.StatusOK || b.calls() != 4*batchWorkers {
+		t.Fatalf("POST /graphql of %d fields = %d %s after %d calls", 4*batchWorkers, code, body, b.calls())
+	}
+	if most > batchWorkers {
+		t.Errorf("%d calls ran at once, want at most %d", most, batchWorkers)
+	}
+}
+
+func TestGraphQLSchema(t *testing.T) {
+	resp, body := serve(t, NewGateway(graphQLBackend()), http.MethodGet, "/graphql/schema", "", nil)
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("GET /graphql/schema = %d", resp.StatusCode)
+	}
+	for _, want := range []string{
+		"type Query {\n",
+		"  panchangam(date: String!, location: Location!, region: String, locale: String, sunConvention: String, hijriAdjustmentDays: Int): PanchangamData\n",
+		"  festivals(from: String!, to: String, days: Int, location: Location!, region: String, type: String): [Festival!]\n",
+		"input Location {\n  lat: Float!\n  lon: Float!\n  tz: String\n}\n",
+		"type PanchangamData {\n",
+		"  nearBoundaries: [ElementBoundary!]!\n",
+		"  abhijitMuhurta: Muhurta\n",
+		"type Muhurta {\n  name: String\n",
+	} {
+		if !strings.Contains(body, want) {
+			t.Errorf("schema lacks %q:\n%s", want, body)
+		}
+	}
+}
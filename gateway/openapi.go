@@ -0,0 +1,281 @@
+package gateway
+
+import (
+	_ "embed"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// apiVersion is the version of the REST API reported in the OpenAPI spec.
+const apiVersion = "1.0.0"
+
+// route is a REST endpoint together with the documentation from which the
+// OpenAPI spec is generated.
+type route struct {
+	path string
+	// method is the HTTP method of the endpoint, GET if empty.
+	method  string
+	handler http.HandlerFunc
+	// operationID names the operation in generated clients.
+	operationID string
+	summary     string
+	params      []param
+	// response is the message returned as JSON on success.
+	response proto.Message
+	// requestBody and responseBody return the schemas of JSON bodies that
+	// are not messages, adding those of the messages they refer to to
+	// schemas; responseBody takes the place of response.
+	requestBody, responseBody func(schemas map[string]any) map[string]any
+	// contentType is the media type of the response, if not JSON, e.g.
+	// text/event-stream for a stream of JSON response messages.
+	contentType string
+	// volatile marks a response that changes over time for the same query,
+	// such as one defaulting to the current time, which is neither cached
+	// nor given an ETag.
+	volatile bool
+	// key returns what a cacheable response depends on beyond its request,
+	// e.g. the date of today a missing date defaults to, which is added to
+	// its key in the response cache.
+	key func(r *http.Request) string
+}
+
+func (rt route) httpMethod() string {
+	if rt.method == "" {
+		return http.MethodGet
+	}
+	return rt.method
+}
+
+// cacheable reports whether the responses of the endpoint are cached and
+// given an ETag: those of GET endpoints that are not volatile.
+func (rt route) cacheable() bool {
+	return rt.httpMethod() == http.MethodGet && !rt.volatile
+}
+
+// param documents a query parameter, or a path parameter such as {name}.
+type param struct {
+	name string
+	// typ is the OpenAPI type: string, number or integer.
+	typ         string
+	format      string
+	description string
+	required    bool
+	// inPath marks a parameter of the path, which is always required.
+	inPath bool
+}
+
+func dateParam(required bool) param {
+	return param{name: "date", typ: "string", format: "date", description: "Date in YYYY-MM-DD format", required: required}
+}
+
+// locationParams documents the observer location parameters shared by the
+// endpoints.
+var locationParams = []param{
+	{name: "lat", typ: "number", format: "double", description: "Latitude in degrees, positive north"},
+	{name: "lon", typ: "number", format: "double", description: "Longitude in degrees, positive east"},
+	{name: "tz", typ: "string", description: "IANA timezone name, e.g. Asia/Kolkata (defaults to the timezone at lat and lon)"},
+}
+
+var regionParam = param{name: "region", typ: "string", description: "Region selecting regional conventions and festivals, e.g. tamil_nadu"}
+
+// profileParams documents the parameters describing the person a muhurta
+// is sought for.
+var profileParams = []param{
+	{name: "janma_nakshatra", typ: "string", description: "Janma nakshatra of the person, e.g. Rohini, to score tarabala"},
+	{name: "janma_rashi", typ: "string", description: "Janma rashi of the person, e.g. Vrishabha, to score chandrabala"},
+}
+
+// openAPISpec returns the OpenAPI 3 document describing routes. Response
+// schemas are derived from the protobuf messages, using the JSON names
+// protojson encodes them with, so they cannot drift from the responses.
+func openAPISpec(routes []route) map[string]any {
+	schemas := map[string]any{}
+	paths := map[string]any{}
+	for _, rt := range routes {
+		var params []any
+		for _, p := range rt.params {
+			schema := map[string]any{"type": p.typ}
+			if p.format != "" {
+				schema["format"] = p.format
+			}
+			in := "query"
+			if p.inPath {
+				in = "path"
+			}
+			params = append(params, map[string]any{
+				"name":        p.name,
+				"in":          in,
+				"description": p.description,
+				"required":    p.required || p.inPath,
+				"schema":      schema,
+			})
+		}
+		contentType := rt.contentType
+		if contentType == "" {
+			contentType = "application/json"
+		}
+		var schema map[string]any
+		if rt.responseBody != nil {
+			schema = rt.responseBody(schemas)
+		} else {
+			schema = messageRef(rt.response.ProtoReflect().Descriptor(), schemas)
+		}
+		responses := map[string]any{
+			"200": map[string]any{
+				"description": "Success",
+				"content": map[string]any{
+					contentType: map[string]any{"schema": schema},
+				},
+			},
+			"default": map[string]any{
+				"description": "Error",
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": map[string]any{"$ref": "#/components/schemas/Error"}},
+				},
+			},
+		}
+		if rt.cacheable() {
+			responses["304"] = map[string]any{
+				"description": "Not modified: the ETag of the response is listed in If-None-Match",
+			}
+		}
+		operation := map[string]any{
+			"operationId": rt.operationID,
+			"summary":     rt.summary,
+			"responses":   responses,
+		}
+		if params != nil {
+			operation["parameters"] = params
+		}
+		if rt.requestBody != nil {
+			operation["requestBody"] = map[string]any{
+				"required": true,
+				"content": map[string]any{
+					"application/json": map[string]any{"schema": rt.requestBody(schemas)},
+				},
+			}
+		}
+		// Routes of different methods may share a path.
+		if item, ok := paths[rt.path].(map[string]any); ok {
+			item[strings.ToLower(rt.httpMethod())] = operation
+		} else {
+			paths[rt.path] = map[string]any{strings.ToLower(rt.httpMethod()): operation}
+		}
+	}
+	schemas["Error"] = errorSchema
+	return map[string]any{
+		"openapi": "3.0.3",
+		"info": map[string]any{
+			"title":   "Panchangam API",
+			"version": apiVersion,
+		},
+		"paths":      paths,
+		"components": map[string]any{"schemas": schemas},
+	}
+}
+
+// errorSchema is the schema of the error bodies of the gateway.
+var errorSchema = map[string]any{
+	"type": "object",
+	"properties": map[string]any{
+		"code":    map[string]any{"type": "string", "description": "gRPC status code, e.g. INVALID_ARGUMENT"},
+		"message": map[string]any{"type": "string"},
+		"details": map[string]any{
+			"type":        "array",
+			"description": "Invalid fields of the request",
+			"items": map[string]any{
+				"type": "object",
+				"properties": map[string]any{
+					"field":       map[string]any{"type": "string"},
+					"description": map[string]any{"type": "string"},
+				},
+			},
+		},
+		"requestId": map[string]any{"type": "string", "description": "ID of the request, as in its X-Request-ID header"},
+		"timestamp": map[string]any{"type": "string", "format": "date-time"},
+		"path":      map[string]any{"type": "string"},
+	},
+}
+
+// messageRef adds the schema of a message, and of the messages it refers
+// to, to schemas and returns a reference to it.
+func messageRef(md protoreflect.MessageDescriptor, schemas map[string]any) map[string]any {
+	name := string(md.Name())
+	ref := map[string]any{"$ref": "#/components/schemas/" + name}
+	if _, ok := schemas[name]; ok {
+		return ref
+	}
+	properties := map[string]any{}
+	schemas[name] = map[string]any{"type": "object", "properties": properties}
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		schema := fieldSchema(fd, schemas)
+		if fd.IsList() {
+			schema = map[string]any{"type": "array", "items": schema}
+		}
+		properties[fd.JSONName()] = schema
+	}
+	return ref
+}
+
+// fieldSchema returns the schema of a single value of a field, following
+// the protojson mapping.
+func fieldSchema(fd protoreflect.FieldDescriptor, schemas map[string]any) map[string]any {
+	switch fd.Kind() {
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind,
+		protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind,
+		protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		// protojson encodes 64-bit integers as strings.
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := fd.Enum().Values()
+		names := make([]string, values.Len())
+		for i := range names {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageRef(fd.Message(), schemas)
+	default:
+		return map[string]any{"type": "string"}
+	}
+}
+
+// swaggerUI is a page rendering the OpenAPI spec with Swagger UI.
+//
+//go:embed swagger.html
+var swaggerUI []byte
+
+func (g *Gateway) getOpenAPI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "public, max-age=3600")
+	w.Write(g.openAPI)
+}
+
+func getSwaggerUI(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Write(swaggerUI)
+}
+
+func marshalSpec(routes []route) []byte {
+	body, err := json.MarshalIndent(openAPISpec(routes), "", "  ")
+	if err != nil {
+		logger.Error("Failed to encode OpenAPI spec", "error", err)
+	}
+	return body
+}
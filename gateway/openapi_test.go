@@ -0,0 +1,93 @@
+package gateway
+
+import (
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestOpenAPI(t *testing.T) {
+	g := NewGateway(&backend{})
+	resp, body := serve(t, g, http.MethodGet, "/api/v1/openapi.json", "", nil)
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "application/json" {
+		t.Fatalf("GET openapi.json = %d %s", resp.StatusCode, resp.Header.Get("Content-Type"))
+	}
+	var spec struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Version string `json:"version"`
+		} `json:"info"`
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name     string `json:"name"`
+				In       string `json:"in"`
+				Required bool   `json:"required"`
+			} `json:"parameters"`
+			Responses map[string]json.RawMessage `json:"responses"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]json.RawMessage `json:"schemas"`
+		} `json:"components"`
+	}
+	if err := json.Unmarshal([]byte(body), &spec); err != nil {
+		t.Fatalf("OpenAPI spec does not parse: %v", err)
+	}
+	if spec.OpenAPI != "3.0.3" || spec.Info.Version != apiVersion {
+		t.Errorf("openapi %q, version %q", spec.OpenAPI, spec.Info.Version)
+	}
+
+	routes := g.routes()
+	operations := 0
+	for _, item := range spec.Paths {
+		operations += len(item)
+	}
+	if operations != len(routes) {
+		t.Errorf("spec lists %d operations for %d routes", operations, len(routes))
+	}
+	ids := map[string]string{}
+	pathParam := regexp.MustCompile(`{(\w+)}`)
+	for _, rt := range routes {
+		method := strings.ToLower(rt.httpMethod())
+		op, ok := spec.Paths[rt.path][method]
+		if !ok {
+			t.Errorf("spec lacks %s %s", method, rt.path)
+			continue
+		}
+		if op.OperationID != rt.operationID || op.OperationID == "" {
+			t.Errorf("%s %s has operationId %q, want %q", method, rt.path, op.OperationID, rt.operationID)
+		}
+		if other, ok := ids[op.OperationID]; ok {
+			t.Errorf("operationId %s of %s %s is that of %s", op.OperationID, method, rt.path, other)
+		}
+		ids[op.OperationID] = method + " " + rt.path
+		if _, ok := op.Responses["200"]; !ok {
+			t.Errorf("%s %s has no success response", method, rt.path)
+		}
+		if _, ok := op.Responses["304"]; ok != rt.cacheable() {
+			t.Errorf("%s %s has a 304 response %v, cacheable %v", method, rt.path, ok, rt.cacheable())
+		}
+		// Every parameter of the path is documented.
+		for _, m := range pathParam.FindAllStringSubmatch(rt.path, -1) {
+			found := false
+			for _, p := range op.Parameters {
+				found = found || (p.Name == m[1] && p.In == "path" && p.Required)
+			}
+			if !found {
+				t.Errorf("%s %s does not document its path parameter %s", method, rt.path, m[1])
+			}
+		}
+	}
+
+	// Every schema referred to is defined.
+	for _, m := range regexp.MustCompile(`"\$ref": "#/components/schemas/(\w+)"`).FindAllStringSubmatch(body, -1) {
+		if _, ok := spec.Components.Schemas[m[1]]; !ok {
+			t.Errorf("schema %s is referred to but not defined", m[1])
+		}
+	}
+	if _, ok := spec.Components.Schemas["PanchangamData"]; !ok {
+		t.Error("spec lacks the schema of PanchangamData")
+	}
+}
@@ -0,0 +1,56 @@
+package gateway
+
+import (
+	"net/url"
+	"strconv"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// queryParser reads typed query parameters, remembering the first error so
+// that handlers can check once after reading every parameter.
+type queryParser struct {
+	values url.Values
+	err    error
+}
+
+func (q *queryParser) string(name string) string {
+	return q.values.Get(name)
+}
+
+func (q *queryParser) float(name string) float64 {
+	v := q.values.Get(name)
+	if v == "" || q.err != nil {
+		return 0
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		q.err = status.Errorf(codes.InvalidArgument, "invalid %s %q: expected a number", name, v)
+	}
+	return f
+}
+
+func (q *queryParser) int(name string) int {
+	v := q.values.Get(name)
+	if v == "" || q.err != nil {
+		return 0
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		q.err = status.Errorf(codes.InvalidArgument, "invalid %s %q: expected an integer", name, v)
+	}
+	return i
+}
+
+func (q *queryParser) bool(name string) bool {
+	v := q.values.Get(name)
+	if v == "" || q.err != nil {
+		return false
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		q.err = status.Errorf(codes.InvalidArgument, "invalid %s %q: expected true or false", name, v)
+	}
+	return b
+}
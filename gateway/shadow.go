@@ -0,0 +1,126 @@
+package gateway
+
+import (
+	"context"
+	"math/rand/v2"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// Names of the metrics of shadowed requests, labelled with the method.
+const (
+	// ShadowRequestsMetric counts the mirrored requests by result.
+	ShadowRequestsMetric = "gateway.shadow.requests"
+	// ShadowLatencyMetric records the latency of the canary in milliseconds.
+	ShadowLatencyMetric = "gateway.shadow.latency"
+)
+
+// Outcomes of a shadowed request, recorded in the result attribute of the
+// ShadowRequestsMetric metric.
+const (
+	shadowMatch       = "match"
+	shadowMismatch    = "mismatch"
+	shadowCanaryError = "canary_error"
+)
+
+// shadowTimeout bounds each mirrored call by default so that a slow canary
+// cannot pile up goroutines.
+const shadowTimeout = 5 * time.Second
+
+// Option configures a Gateway.
+type Option func(*Gateway)
+
+// WithShadow mirrors percent (0-100) of the requests to canary after they
+// have been answered by the primary backend, and records whether the
+// canary responses differ. Mirrored calls never delay or alter the
+// response to the caller.
+func WithShadow(canary ppb.PanchangamClient, percent float64) Option {
+	return func(g *Gateway) {
+		g.shadow = newShadow(canary, percent)
+	}
+}
+
+// shadow mirrors a share of the traffic to a canary backend.
+type shadow struct {
+	canary  ppb.PanchangamClient
+	percent float64
+	timeout time.Duration
+	results metric.Int64Counter
+	latency metric.Float64Histogram
+	// record reports the result of each mirrored call, by default to the
+	// metrics.
+	record func(ctx context.Context, method, result string, elapsed time.Duration)
+}
+
+func newShadow(canary ppb.PanchangamClient, percent float64) *shadow {
+	meter := otel.Meter("github.com/naren-m/panchangam/gateway")
+	results, err := meter.Int64Counter(ShadowRequestsMetric,
+		metric.WithDescription("Requests mirrored to the canary backend, by method and result"))
+	if err != nil {
+		logger.Error("Failed to create shadow request counter", "error", err)
+	}
+	latency, err := meter.Float64Histogram(ShadowLatencyMetric,
+		metric.WithDescription("Latency of mirrored requests on the canary backend"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		logger.Error("Failed to create shadow latency histogram", "error", err)
+	}
+	s := &shadow{canary: canary, percent: percent, timeout: shadowTimeout, results: results, latency: latency}
+	s.record = s.recordMetrics
+	return s
+}
+
+// mirror sends a sampled request to the canary in the background and
+// compares its outcome with the primary response and error.
+func (s *shadow) mirror(ctx context.Context, method string, primary proto.Message, primaryErr error, call func(context.Context, ppb.PanchangamClient) (proto.Message, error)) {
+	if s == nil || rand.Float64()*100 >= s.percent {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), s.timeout)
+	go func() {
+		defer cancel()
+		start := time.Now()
+		resp, err := call(ctx, s.canary)
+		elapsed := time.Since(start)
+
+		result := compareShadow(primary, primaryErr, resp, err)
+		if result != shadowMatch {
+			logger.InfoContext(ctx, "Shadow response differs", "method", method, "result", result, "error", err)
+		}
+		s.record(ctx, method, result, elapsed)
+	}()
+}
+
+// recordMetrics counts a mirrored call by method and result, and records
+// its latency.
+func (s *shadow) recordMetrics(ctx context.Context, method, result string, elapsed time.Duration) {
+	attrs := metric.WithAttributes(attribute.String("method", method), attribute.String("result", result))
+	if s.results != nil {
+		s.results.Add(ctx, 1, attrs)
+	}
+	if s.latency != nil {
+		s.latency.Record(ctx, float64(elapsed)/float64(time.Millisecond), attrs)
+	}
+}
+
+func compareShadow(primary proto.Message, primaryErr error, canary proto.Message, canaryErr error) string {
+	switch {
+	case primaryErr == nil && canaryErr != nil:
+		return shadowCanaryError
+	case primaryErr != nil || canaryErr != nil:
+		if status.Code(primaryErr) == status.Code(canaryErr) {
+			return shadowMatch
+		}
+		return shadowMismatch
+	case proto.Equal(primary, canary):
+		return shadowMatch
+	default:
+		return shadowMismatch
+	}
+}
@@ -0,0 +1,171 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// canaryBackend is a backend answering Get after its delay, or failing when
+// the context of the call is done first, and remembering the metadata of
+// the calls.
+type canaryBackend struct {
+	*backend
+	delay time.Duration
+	md    chan metadata.MD
+}
+
+func (c *canaryBackend) Get(ctx context.Context, in *ppb.GetPanchangamRequest, opts ...grpc.CallOption) (*ppb.GetPanchangamResponse, error) {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	c.md <- md
+	select {
+	case <-time.After(c.delay):
+	case <-ctx.Done():
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	return c.backend.Get(ctx, in, opts...)
+}
+
+// shadowResult is a result reported by a shadow.
+type shadowResult struct {
+	method, result string
+}
+
+// recordShadow makes the shadow of g report its results on the channel
+// returned.
+func recordShadow(g *Gateway) <-chan shadowResult {
+	results := make(chan shadowResult, 10)
+	g.shadow.record = func(ctx context.Context, method, result string, elapsed time.Duration) {
+		results <- shadowResult{method, result}
+	}
+	return results
+}
+
+// awaitShadow returns the next result of a shadow.
+func awaitShadow(t *testing.T, results <-chan shadowResult) shadowResult {
+	t.Helper()
+	select {
+	case r := <-results:
+		return r
+	case <-time.After(5 * time.Second):
+		t.Fatal("no shadow result")
+		return shadowResult{}
+	}
+}
+
+const shadowPath = "/api/v1/panchangam?date=2024-04-09&lat=13.0827&lon=80.2707&tz=Asia/Kolkata"
+
+func TestShadow(t *testing.T) {
+	canaryTithi := "Shukla Pratipada"
+	canaryErr := error(nil)
+	canary := &canaryBackend{
+		backend: &backend{get: func(req *ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error) {
+			if canaryErr != nil {
+				return nil, canaryErr
+			}
+			return &ppb.GetPanchangamResponse{PanchangamData: &ppb.PanchangamData{Date: req.Date, Tithi: canaryTithi}}, nil
+		}},
+		md: make(chan metadata.MD, 10),
+	}
+	primary := &backend{get: panchangam}
+	g := NewGateway(primary, WithShadow(canary, 100))
+	results := recordShadow(g)
+
+	for _, tt := range []struct {
+		name  string
+		tithi string
+		err   error
+		want  string
+	}{
+		{"match", "Shukla Pratipada", nil, shadowMatch},
+		{"mismatch", "Shukla Dwitiya", nil, shadowMismatch},
+		{"canary error", "", status.Error(codes.Unavailable, "canary down"), shadowCanaryError},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			canaryTithi, canaryErr = tt.tithi, tt.err
+			resp, body := serve(t, g, http.MethodGet, shadowPath, "", http.Header{"X-Api-Key": {"k1"}})
+			// The caller gets the response of the primary whatever the canary
+			// answers.
+			var data ppb.PanchangamData
+			if err := protojson.Unmarshal([]byte(body), &data); err != nil || resp.StatusCode != http.StatusOK || data.Tithi != "Shukla Pratipada" {
+				t.Errorf("GET panchangam = %d %s", resp.StatusCode, body)
+			}
+			if got := awaitShadow(t, results); got != (shadowResult{"Get", tt.want}) {
+				t.Errorf("shadow result = %+v, want %s", got, tt.want)
+			}
+			// The canary is replayed the request and metadata of the primary.
+			if !proto.Equal(canary.last(), primary.last()) {
+				t.Errorf("canary request = %v, want %v", canary.last(), primary.last())
+			}
+			if md := <-canary.md; len(md.Get(aaa.APIKeyHeader)) != 1 || md.Get(aaa.APIKeyHeader)[0] != "k1" {
+				t.Errorf("canary metadata = %v, want the API key of the caller", md)
+			}
+		})
+	}
+}
+
+func TestShadowTimeout(t *testing.T) {
+	canary := &canaryBackend{backend: &backend{get: panchangam}, delay: time.Minute, md: make(chan metadata.MD, 1)}
+	g := NewGateway(&backend{get: panchangam}, WithShadow(canary, 100))
+	g.shadow.timeout = 10 * time.Millisecond
+	results := recordShadow(g)
+
+	// The call to the canary outlives the request, and fails with its own
+	// timeout.
+	ctx, cancel := context.WithCancel(context.Background())
+	rec := httptest.NewRecorder()
+	g.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, shadowPath, nil).WithContext(ctx))
+	cancel()
+	if rec.Code != http.StatusOK {
+		t.Errorf("GET panchangam with a slow canary = %d %s", rec.Code, rec.Body)
+	}
+	if got := awaitShadow(t, results); got != (shadowResult{"Get", shadowCanaryError}) {
+		t.Errorf("shadow result of a slow canary = %+v", got)
+	}
+}
+
+func TestShadowPercent(t *testing.T) {
+	canary := &backend{get: panchangam}
+	g := NewGateway(&backend{get: panchangam}, WithShadow(canary, 0))
+	for i := 0; i < 20; i++ {
+		serve(t, g, http.MethodGet, shadowPath, "", nil)
+	}
+	if canary.calls() != 0 {
+		t.Errorf("canary called %d times at 0 percent", canary.calls())
+	}
+}
+
+func TestCompareShadow(t *testing.T) {
+	a := &ppb.PanchangamData{Tithi: "Shukla Pratipada"}
+	b := &ppb.PanchangamData{Tithi: "Shukla Dwitiya"}
+	invalid := status.Error(codes.InvalidArgument, "date: invalid")
+	for _, tt := range []struct {
+		name                  string
+		primary, canary       *ppb.PanchangamData
+		primaryErr, canaryErr error
+		want                  string
+	}{
+		{"equal", a, a, nil, nil, shadowMatch},
+		{"different", a, b, nil, nil, shadowMismatch},
+		{"canary fails", a, nil, nil, errors.New("down"), shadowCanaryError},
+		{"both fail alike", nil, nil, invalid, status.Error(codes.InvalidArgument, "other message"), shadowMatch},
+		{"both fail differently", nil, nil, invalid, status.Error(codes.Internal, "bug"), shadowMismatch},
+		{"primary fails", nil, a, invalid, nil, shadowMismatch},
+	} {
+		if got := compareShadow(tt.primary, tt.primaryErr, tt.canary, tt.canaryErr); got != tt.want {
+			t.Errorf("compareShadow() of %s = %s, want %s", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package gateway
+
+import (
+	"io"
+	"net/http"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// maxSubscriptionBody bounds the body of a subscription request.
+const maxSubscriptionBody = 64 << 10
+
+// createSubscription registers a webhook from a JSON CreateSubscriptionRequest
+// body, e.g. {"url": "https://example.com/hook", "latitude": 13.08,
+// "longitude": 80.27, "events": ["festival", "rahu_kalam_start"]}.
+func (g *Gateway) createSubscription(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(http.MaxBytesReader(w, r.Body, maxSubscriptionBody))
+	if err != nil {
+		writeError(w, r, status.Errorf(codes.InvalidArgument, "invalid subscription request: %v", err))
+		return
+	}
+	req := &ppb.CreateSubscriptionRequest{}
+	if err := protojson.Unmarshal(body, req); err != nil {
+		writeError(w, r, status.Errorf(codes.InvalidArgument, "invalid subscription request: %v", err))
+		return
+	}
+	var header metadata.MD
+	resp, err := g.client.CreateSubscription(outgoingContext(r), req, grpc.Header(&header))
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+func (g *Gateway) listSubscriptions(w http.ResponseWriter, r *http.Request) {
+	var header metadata.MD
+	resp, err := g.client.ListSubscriptions(outgoingContext(r), &ppb.ListSubscriptionsRequest{}, grpc.Header(&header))
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+func (g *Gateway) deleteSubscription(w http.ResponseWriter, r *http.Request) {
+	var header metadata.MD
+	resp, err := g.client.DeleteSubscription(outgoingContext(r), &ppb.DeleteSubscriptionRequest{Id: r.PathValue("id")}, grpc.Header(&header))
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
+
+func subscriptionRequestSchema(schemas map[string]any) map[string]any {
+	return messageRef((&ppb.CreateSubscriptionRequest{}).ProtoReflect().Descriptor(), schemas)
+}
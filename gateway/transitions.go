@@ -0,0 +1,139 @@
+package gateway
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// keepaliveInterval is how often a comment is sent on an idle event stream,
+// so that proxies do not close it during the hours between transitions.
+const keepaliveInterval = 30 * time.Second
+
+// streamTransitions streams the transitions of the panchangam at a location
+// as Server-Sent Events, one transition as JSON in the data of each event,
+// until the caller disconnects. A failure of the stream once it has started
+// is sent as an error event whose data is the message.
+func (g *Gateway) streamTransitions(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.WatchTransitionsRequest{
+		Latitude:      q.float("lat"),
+		Longitude:     q.float("lon"),
+		Timezone:      q.string("tz"),
+		Locale:        requestLocale(w, r),
+		NoticeMinutes: int32(q.int("notice_minutes")),
+	}
+	req.NoNotice = q.values.Has("notice_minutes") && req.NoticeMinutes == 0
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx, cancel := context.WithCancel(outgoingContext(r))
+	defer cancel()
+	stream, err := g.client.WatchTransitions(ctx, req)
+	if err != nil {
+		writeError(w, r, err)
+		return
+	}
+	// The backend sends its headers once it accepts the subscription;
+	// without them it rejected it, with the status received next.
+	if header, err := stream.Header(); err != nil || header == nil {
+		if _, err = stream.Recv(); err != nil {
+			setRetryAfter(w, stream.Trailer())
+			writeError(w, r, err)
+		}
+		return
+	}
+
+	rc := http.NewResponseController(w)
+	w.Header().Set("Content-Type", "text/event-stream")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.WriteHeader(http.StatusOK)
+	rc.Flush()
+
+	transitions := make(chan *ppb.Transition)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			t, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case transitions <- t:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	keepalive := time.NewTicker(keepaliveInterval)
+	defer keepalive.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case t := <-transitions:
+			data, err := protojson.Marshal(t)
+			if err != nil {
+				logger.ErrorContext(r.Context(), "Failed to encode transition", "error", err)
+				continue
+			}
+			fmt.Fprintf(w, "data: %s\n\n", data)
+		case err := <-errs:
+			if !errors.Is(err, io.EOF) && status.Code(err) != codes.Canceled {
+				fmt.Fprintf(w, "event: error\ndata: %s\n\n", status.Convert(err).Message())
+			}
+			rc.Flush()
+			return
+		case <-keepalive.C:
+			io.WriteString(w, ": keepalive\n\n")
+		}
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
+
+func (g *Gateway) getNextTransitions(w http.ResponseWriter, r *http.Request) {
+	q := queryParser{values: r.URL.Query()}
+	req := &ppb.GetNextTransitionsRequest{
+		Time:      q.string("time"),
+		Latitude:  q.float("lat"),
+		Longitude: q.float("lon"),
+		Timezone:  q.string("tz"),
+		Locale:    requestLocale(w, r),
+		Count:     int32(q.int("count")),
+	}
+	if kinds := q.string("kinds"); kinds != "" {
+		req.Kinds = strings.Split(kinds, ",")
+	}
+	if q.err != nil {
+		writeError(w, r, q.err)
+		return
+	}
+	ctx := outgoingContext(r)
+	var header metadata.MD
+	resp, err := g.client.GetNextTransitions(ctx, req, grpc.Header(&header))
+	g.shadow.mirror(ctx, "GetNextTransitions", resp, err, func(ctx context.Context, c ppb.PanchangamClient) (proto.Message, error) {
+		return c.GetNextTransitions(ctx, req)
+	})
+	if err != nil {
+		setRetryAfter(w, header)
+		writeError(w, r, err)
+		return
+	}
+	writeMessage(w, r, resp)
+}
@@ -0,0 +1,190 @@
+package gateway
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// transitionStream is a WatchTransitions stream receiving the transitions
+// sent on its channel, then err once it is closed, or the status of its
+// context once that is done. Without a header it is a stream the backend
+// rejected.
+type transitionStream struct {
+	grpc.ClientStream
+	ctx         context.Context
+	header      metadata.MD
+	transitions chan *ppb.Transition
+	err         error
+}
+
+func (s *transitionStream) Header() (metadata.MD, error) { return s.header, nil }
+
+func (s *transitionStream) Trailer() metadata.MD { return nil }
+
+func (s *transitionStream) Recv() (*ppb.Transition, error) {
+	select {
+	case t, ok := <-s.transitions:
+		if !ok {
+			return nil, s.err
+		}
+		return t, nil
+	case <-s.ctx.Done():
+		return nil, status.FromContextError(s.ctx.Err()).Err()
+	}
+}
+
+// watchBackend is a backend answering WatchTransitions with its stream,
+// and sending the contexts of the calls on started.
+type watchBackend struct {
+	*backend
+	stream  *transitionStream
+	started chan context.Context
+}
+
+func (b *watchBackend) WatchTransitions(ctx context.Context, in *ppb.WatchTransitionsRequest, opts ...grpc.CallOption) (ppb.Panchangam_WatchTransitionsClient, error) {
+	b.mu.Lock()
+	b.requests = append(b.requests, in)
+	b.mu.Unlock()
+	b.stream.ctx = ctx
+	b.started <- ctx
+	return b.stream, nil
+}
+
+func newWatchBackend(header metadata.MD) *watchBackend {
+	return &watchBackend{
+		backend: &backend{},
+		stream:  &transitionStream{header: header, transitions: make(chan *ppb.Transition)},
+		started: make(chan context.Context, 1),
+	}
+}
+
+const transitionsPath = "/api/v1/transitions?lat=13.0827&lon=80.2707&tz=Asia/Kolkata"
+
+// readEvent reads the next event of an event stream up to its blank line.
+func readEvent(t *testing.T, r *bufio.Reader) string {
+	t.Helper()
+	var event strings.Builder
+	for {
+		line, err := r.ReadString('\n')
+		if err != nil {
+			t.Fatalf("reading event %q: %v", event.String(), err)
+		}
+		if line == "\n" {
+			return event.String()
+		}
+		event.WriteString(line)
+	}
+}
+
+func TestStreamTransitions(t *testing.T) {
+	b := newWatchBackend(metadata.MD{})
+	srv := httptest.NewServer(NewGateway(b))
+	defer srv.Close()
+
+	req, _ := http.NewRequest(http.MethodGet, srv.URL+transitionsPath+"&notice_minutes=0", nil)
+	req.Header.Set("Accept-Language", "te")
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK || resp.Header.Get("Content-Type") != "text/event-stream" || resp.Header.Get("Cache-Control") != "no-cache" {
+		t.Fatalf("GET transitions = %d %v", resp.StatusCode, resp.Header)
+	}
+	<-b.started
+	if w := b.last().(*ppb.WatchTransitionsRequest); w.Latitude != 13.0827 || w.Timezone != "Asia/Kolkata" || w.Locale != "te" || !w.NoNotice {
+		t.Errorf("WatchTransitions() request = %v", w)
+	}
+
+	// Each transition is flushed as an event as soon as it is received.
+	events := bufio.NewReader(resp.Body)
+	for _, name := range []string{"Dvadashi", "Trayodashi"} {
+		b.stream.transitions <- &ppb.Transition{Kind: "tithi", Event: "start", Name: name, Time: "2024-04-20T14:32:00+05:30"}
+		event := readEvent(t, events)
+		data, ok := strings.CutPrefix(event, "data: ")
+		if !ok || strings.Count(event, "\n") != 1 {
+			t.Fatalf("event = %q, want a single data line", event)
+		}
+		var got ppb.Transition
+		if err := protojson.Unmarshal([]byte(data), &got); err != nil || got.Name != name {
+			t.Errorf("event data = %s, %v, want the transition to %s", data, err, name)
+		}
+	}
+
+	// A failure of the backend ends the stream with an error event.
+	b.stream.err = status.Error(codes.Unavailable, "backend restarting")
+	close(b.stream.transitions)
+	if event := readEvent(t, events); event != "event: error\ndata: backend restarting\n" {
+		t.Errorf("error event = %q", event)
+	}
+	if rest, err := io.ReadAll(events); err != nil || len(rest) != 0 {
+		t.Errorf("after the error event: %q, %v", rest, err)
+	}
+}
+
+func TestStreamTransitionsEnd(t *testing.T) {
+	// A stream ended by the backend closes without an error event.
+	b := newWatchBackend(metadata.MD{})
+	b.stream.err = io.EOF
+	close(b.stream.transitions)
+	resp, body := serve(t, NewGateway(b), http.MethodGet, transitionsPath, "", nil)
+	if resp.StatusCode != http.StatusOK || body != "" {
+		t.Errorf("GET transitions of an ended stream = %d %q", resp.StatusCode, body)
+	}
+}
+
+func TestStreamTransitionsCancel(t *testing.T) {
+	b := newWatchBackend(metadata.MD{})
+	srv := httptest.NewServer(NewGateway(b))
+	defer srv.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL+transitionsPath, nil)
+	resp, err := srv.Client().Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	stream := <-b.started
+
+	// The call to the backend is cancelled once the caller disconnects.
+	cancel()
+	select {
+	case <-stream.Done():
+	case <-time.After(5 * time.Second):
+		t.Fatal("WatchTransitions() still running after the caller disconnected")
+	}
+}
+
+func TestStreamTransitionsRejected(t *testing.T) {
+	// Without headers the backend rejected the call, whose status is sent
+	// as an error body.
+	b := newWatchBackend(nil)
+	b.stream.err = status.Error(codes.InvalidArgument, "latitude: out of range")
+	close(b.stream.transitions)
+	resp, body := serve(t, NewGateway(b), http.MethodGet, transitionsPath, "", nil)
+	var e errorBody
+	if err := json.Unmarshal([]byte(body), &e); err != nil || resp.StatusCode != http.StatusBadRequest || e.Message != "latitude: out of range" {
+		t.Errorf("GET transitions rejected = %d %s", resp.StatusCode, body)
+	}
+
+	b = newWatchBackend(metadata.MD{})
+	resp, _ = serve(t, NewGateway(b), http.MethodGet, transitionsPath+"&notice_minutes=soon", "", nil)
+	if resp.StatusCode != http.StatusBadRequest || b.calls() != 0 {
+		t.Errorf("GET transitions with an invalid query = %d, backend called %d times", resp.StatusCode, b.calls())
+	}
+}
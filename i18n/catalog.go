@@ -0,0 +1,318 @@
+// Package i18n translates the names of panchangam elements and festivals
+// into the locales of its catalogs.
+package i18n
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed catalogs/*.yaml
+var builtinCatalogs embed.FS
+
+// English is the locale of the names computed by the astronomy and festival
+// packages, which needs no catalog.
+const English = "en"
+
+// Catalog holds the names of one locale, keyed by their English names. The
+// tithis are named without their paksha, e.g. Ekadashi, and Purnima and
+// Amavasya on their own.
+type Catalog struct {
+	Locale string `yaml:"locale"`
+	// Name is the name of the language in itself, e.g. తెలుగు.
+	Name string `yaml:"name"`
+	// Paksha names the shukla and krishna fortnights.
+	Paksha     map[string]string `yaml:"paksha"`
+	Tithi      map[string]string `yaml:"tithi"`
+	Nakshatra  map[string]string `yaml:"nakshatra"`
+	Yoga       map[string]string `yaml:"yoga"`
+	Karana     map[string]string `yaml:"karana"`
+	Vara       map[string]string `yaml:"vara"`
+	Masa       map[string]string `yaml:"masa"`
+	Ritu       map[string]string `yaml:"ritu"`
+	Ayana      map[string]string `yaml:"ayana"`
+	Samvatsara map[string]string `yaml:"samvatsara"`
+	// Label maps keys chosen by the users of the catalog, e.g. rahu_kalam,
+	// to words and phrases.
+	Label map[string]string `yaml:"label"`
+	// Festival maps festival definitions, e.g. diwali or makara-sankranti,
+	// to their names.
+	Festival map[string]string `yaml:"festival"`
+}
+
+// Term is a kind of calendar name translated by the catalogs besides the
+// elements.
+type Term string
+
+const (
+	PakshaTerm     Term = "paksha"
+	MasaTerm       Term = "masa"
+	RituTerm       Term = "ritu"
+	AyanaTerm      Term = "ayana"
+	SamvatsaraTerm Term = "samvatsara"
+)
+
+// terms returns the names of each term in the catalog.
+func (c *Catalog) terms() map[Term]map[string]string {
+	return map[Term]map[string]string{
+		PakshaTerm: c.Paksha, MasaTerm: c.Masa, RituTerm: c.Ritu,
+		AyanaTerm: c.Ayana, SamvatsaraTerm: c.Samvatsara,
+	}
+}
+
+// Element returns the name of e in the catalog, or "" if it has none.
+func (c *Catalog) Element(e astronomy.Element) string {
+	switch e.Kind {
+	case astronomy.TithiElement:
+		paksha, name, ok := strings.Cut(e.Name, " ")
+		if !ok {
+			return c.Tithi[e.Name]
+		}
+		p, t := c.Paksha[strings.ToLower(paksha)], c.Tithi[name]
+		if p == "" || t == "" {
+			return ""
+		}
+		return p + " " + t
+	case astronomy.NakshatraElement:
+		return c.Nakshatra[e.Name]
+	case astronomy.YogaElement:
+		return c.Yoga[e.Name]
+	case astronomy.KaranaElement:
+		return c.Karana[e.Name]
+	case astronomy.VaraElement:
+		return c.Vara[e.Name]
+	}
+	return ""
+}
+
+// Catalogs is a collection of catalogs keyed by locale.
+type Catalogs struct {
+	catalogs map[string]*Catalog
+}
+
+var defaultCatalogs = sync.OnceValue(func() *Catalogs {
+	c := &Catalogs{catalogs: map[string]*Catalog{}}
+	if err := c.loadFS(builtinCatalogs, "catalogs/*.yaml"); err != nil {
+		panic(fmt.Sprintf("i18n: invalid built-in catalogs: %v", err))
+	}
+	return c
+})
+
+// DefaultCatalogs returns the built-in catalogs embedded in the binary.
+func DefaultCatalogs() *Catalogs {
+	return defaultCatalogs()
+}
+
+// LoadDir returns the built-in catalogs extended with every *.yaml file in
+// dir. A catalog in dir replaces the built-in one of the same locale.
+func LoadDir(dir string) (*Catalogs, error) {
+	c := &Catalogs{catalogs: map[string]*Catalog{}}
+	for locale, catalog := range DefaultCatalogs().catalogs {
+		c.catalogs[locale] = catalog
+	}
+	if err := c.loadFS(os.DirFS(dir), "*.yaml"); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// Get returns the catalog of locale.
+func (c *Catalogs) Get(locale string) (*Catalog, bool) {
+	catalog, ok := c.catalogs[locale]
+	return catalog, ok
+}
+
+// Locales returns English and the locales of the catalogs, sorted.
+func (c *Catalogs) Locales() []string {
+	locales := []string{English}
+	for locale := range c.catalogs {
+		locales = append(locales, locale)
+	}
+	sort.Strings(locales)
+	return locales
+}
+
+// Supports reports whether names can be given in locale.
+func (c *Catalogs) Supports(locale string) bool {
+	_, ok := c.catalogs[locale]
+	return ok || locale == English
+}
+
+// Add validates catalog and adds it to the collection, replacing any
+// existing catalog of the same locale.
+func (c *Catalogs) Add(catalog *Catalog) error {
+	if err := catalog.validate(); err != nil {
+		return err
+	}
+	c.catalogs[catalog.Locale] = catalog
+	return nil
+}
+
+// ElementName returns the name of e in locale: its own name in English, or
+// "" when the catalog of locale does not name it.
+func (c *Catalogs) ElementName(locale string, e astronomy.Element) string {
+	if locale == English {
+		return e.Name
+	}
+	catalog, ok := c.catalogs[locale]
+	if !ok {
+		return ""
+	}
+	return catalog.Element(e)
+}
+
+// Name returns the name of a term in locale, e.g. the masa Chaitra, or ""
+// when the catalog of locale does not name it. Pakshas are named shukla and
+// krishna in English.
+func (c *Catalogs) Name(locale string, term Term, name string) string {
+	if locale == English {
+		return name
+	}
+	catalog, ok := c.catalogs[locale]
+	if !ok {
+		return ""
+	}
+	return catalog.terms()[term][name]
+}
+
+// Label returns the label of key in locale, or "" when the catalog of locale
+// has none. English has no catalog, so its labels are left to the caller.
+func (c *Catalogs) Label(locale, key string) string {
+	if catalog, ok := c.catalogs[locale]; ok {
+		return catalog.Label[key]
+	}
+	return ""
+}
+
+// FestivalNames returns names, which maps locales to the names of a festival
+// of the given definition, completed with the name of every catalog for the
+// locales names lacks. The names of the festival definitions take
+// precedence over those of the catalogs.
+func (c *Catalogs) FestivalNames(definition string, names map[string]string) map[string]string {
+	result := make(map[string]string, len(names))
+	for locale, catalog := range c.catalogs {
+		if name := catalog.Festival[definition]; name != "" {
+			result[locale] = name
+		}
+	}
+	for locale, name := range names {
+		result[locale] = name
+	}
+	return result
+}
+
+// Match returns the first of the locales that names can be given in, such
+// as the language tags of an Accept-Language header, or "" if there is none.
+// Only the primary subtag of a tag is considered, so hi-IN matches hi.
+func (c *Catalogs) Match(locales ...string) string {
+	for _, l := range locales {
+		primary, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(l)), "-")
+		if c.Supports(primary) {
+			return primary
+		}
+	}
+	return ""
+}
+
+// loadFS adds the catalog of every file in fsys matching pattern, in lexical
+// order of the file names.
+func (c *Catalogs) loadFS(fsys fs.FS, pattern string) error {
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		if err := c.load(data); err != nil {
+			return fmt.Errorf("%s: %w", path.Base(name), err)
+		}
+	}
+	return nil
+}
+
+func (c *Catalogs) load(data []byte) error {
+	var catalog Catalog
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&catalog); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("empty catalog")
+		}
+		return err
+	}
+	return c.Add(&catalog)
+}
+
+// sectionKeys returns the English names each section of a catalog may
+// translate.
+var sectionKeys = sync.OnceValue(func() map[string][]string {
+	tithis := []string{}
+	for _, name := range astronomy.ElementNames(astronomy.TithiElement) {
+		if _, t, ok := strings.Cut(name, " "); ok {
+			name = t
+		}
+		if !contains(tithis, name) {
+			tithis = append(tithis, name)
+		}
+	}
+	return map[string][]string{
+		"paksha":     {string(astronomy.ShuklaPaksha), string(astronomy.KrishnaPaksha)},
+		"tithi":      tithis,
+		"nakshatra":  astronomy.ElementNames(astronomy.NakshatraElement),
+		"yoga":       astronomy.ElementNames(astronomy.YogaElement),
+		"karana":     astronomy.ElementNames(astronomy.KaranaElement),
+		"vara":       astronomy.ElementNames(astronomy.VaraElement),
+		"masa":       astronomy.MasaNames(),
+		"ritu":       astronomy.RituNames(),
+		"ayana":      {string(astronomy.Uttarayana), string(astronomy.Dakshinayana)},
+		"samvatsara": astronomy.SamvatsaraNames(),
+	}
+})
+
+func (c *Catalog) validate() error {
+	if c.Locale == "" {
+		return errors.New("catalog without locale")
+	}
+	if c.Locale == English {
+		return fmt.Errorf("catalog %q: English names need no catalog", c.Locale)
+	}
+	if c.Name == "" {
+		return fmt.Errorf("catalog %q: no name", c.Locale)
+	}
+	for section, names := range map[string]map[string]string{
+		"paksha": c.Paksha, "tithi": c.Tithi, "nakshatra": c.Nakshatra,
+		"yoga": c.Yoga, "karana": c.Karana, "vara": c.Vara, "masa": c.Masa,
+		"ritu": c.Ritu, "ayana": c.Ayana, "samvatsara": c.Samvatsara,
+	} {
+		for name := range names {
+			if !contains(sectionKeys()[section], name) {
+				return fmt.Errorf("catalog %q: unknown %s %q", c.Locale, section, name)
+			}
+		}
+	}
+	return nil
+}
+
+func contains(values []string, v string) bool {
+	for _, x := range values {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
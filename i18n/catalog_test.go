@@ -0,0 +1,145 @@
+package i18n
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestElementName(t *testing.T) {
+	c := DefaultCatalogs()
+	for _, tt := range []struct {
+		locale string
+		kind   astronomy.ElementKind
+		name   string
+		want   string
+	}{
+		{"hi", astronomy.TithiElement, "Shukla Ekadashi", "शुक्ल एकादशी"},
+		{"ta", astronomy.TithiElement, "Amavasya", "அமாவாசை"},
+		{"ta", astronomy.TithiElement, "Krishna Tritiya", "தேய்பிறை திருதியை"},
+		{"gu", astronomy.TithiElement, "Krishna Ekadashi", "વદ અગિયારસ"},
+		{"te", astronomy.NakshatraElement, "Purva Phalguni", "పుబ్బ"},
+		{"ml", astronomy.NakshatraElement, "Shravana", "തിരുവോണം"},
+		{"sa", astronomy.YogaElement, "Vaidhriti", "वैधृतिः"},
+		{"kn", astronomy.KaranaElement, "Vishti", "ವಿಷ್ಟಿ"},
+		{"bn", astronomy.VaraElement, "Guruvara", "বৃহস্পতিবার"},
+		{"en", astronomy.YogaElement, "Vishkambha", "Vishkambha"},
+		{"fr", astronomy.YogaElement, "Vishkambha", ""},
+	} {
+		e := astronomy.Element{Kind: tt.kind, Name: tt.name}
+		if got := c.ElementName(tt.locale, e); got != tt.want {
+			t.Errorf("ElementName(%s, %s) = %q, want %q", tt.locale, tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestDefaultCatalogsAreComplete(t *testing.T) {
+	c := DefaultCatalogs()
+	want := []string{"bn", "en", "gu", "hi", "kn", "ml", "sa", "ta", "te"}
+	if got := c.Locales(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("Locales() = %v, want %v", got, want)
+	}
+	kinds := []astronomy.ElementKind{
+		astronomy.TithiElement, astronomy.NakshatraElement, astronomy.YogaElement,
+		astronomy.KaranaElement, astronomy.VaraElement,
+	}
+	for _, locale := range c.Locales() {
+		for _, kind := range kinds {
+			for _, name := range astronomy.ElementNames(kind) {
+				if c.ElementName(locale, astronomy.Element{Kind: kind, Name: name}) == "" {
+					t.Errorf("%s names no %s %s", locale, kind, name)
+				}
+			}
+		}
+	}
+}
+
+func TestFestivalNames(t *testing.T) {
+	c := DefaultCatalogs()
+	names := c.FestivalNames("diwali", map[string]string{"en": "Diwali", "hi": "दीपावली"})
+	if names["en"] != "Diwali" || names["hi"] != "दीपावली" {
+		t.Errorf("FestivalNames() changed the names of the definition: %v", names)
+	}
+	if names["te"] != "దీపావళి" || names["gu"] != "દિવાળી" {
+		t.Errorf("FestivalNames() = %v, want the catalog names", names)
+	}
+	// The definition names durga-ashtami in Bengali itself.
+	names = c.FestivalNames("durga-ashtami", map[string]string{"bn": "দুর্গাষ্টমী"})
+	if names["bn"] != "দুর্গাষ্টমী" {
+		t.Errorf("FestivalNames(durga-ashtami)[bn] = %q", names["bn"])
+	}
+}
+
+func TestMatch(t *testing.T) {
+	c := DefaultCatalogs()
+	for _, tt := range []struct {
+		tags []string
+		want string
+	}{
+		{[]string{"te-IN", "en"}, "te"},
+		{[]string{"fr-FR", "EN-us"}, "en"},
+		{[]string{"fr"}, ""},
+		{nil, ""},
+	} {
+		if got := c.Match(tt.tags...); got != tt.want {
+			t.Errorf("Match(%v) = %q, want %q", tt.tags, got, tt.want)
+		}
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	catalog := "locale: mr\nname: मराठी\ntithi:\n  Purnima: पौर्णिमा\nfestival:\n  gudi-padwa: गुढीपाडवा\n"
+	if err := os.WriteFile(filepath.Join(dir, "mr.yaml"), []byte(catalog), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := LoadDir(dir)
+	if err != nil {
+		t.Fatalf("LoadDir() error = %v", err)
+	}
+	if !c.Supports("mr") || !c.Supports("ta") {
+		t.Errorf("Locales() = %v, want mr besides the built-in locales", c.Locales())
+	}
+	if got := c.ElementName("mr", astronomy.Element{Kind: astronomy.TithiElement, Name: "Purnima"}); got != "पौर्णिमा" {
+		t.Errorf("ElementName(mr, Purnima) = %q", got)
+	}
+	if DefaultCatalogs().Supports("mr") {
+		t.Error("LoadDir() changed the default catalogs")
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "xx.yaml"), []byte("locale: xx\nname: X\nyoga:\n  Nonesuch: y\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := LoadDir(dir); err == nil || !strings.Contains(err.Error(), "Nonesuch") {
+		t.Errorf("LoadDir() error = %v, want an unknown yoga", err)
+	}
+}
+
+func TestName(t *testing.T) {
+	c := DefaultCatalogs()
+	for _, tt := range []struct {
+		locale string
+		term   Term
+		name   string
+		want   string
+	}{
+		{"hi", MasaTerm, "Chaitra", "चैत्र"},
+		{"ta", PakshaTerm, "krishna", "தேய்பிறை"},
+		{"sa", AyanaTerm, "Uttarayana", "उत्तरायणम्"},
+		{"en", SamvatsaraTerm, "Krodhi", "Krodhi"},
+		{"fr", RituTerm, "Vasanta", ""},
+	} {
+		if got := c.Name(tt.locale, tt.term, tt.name); got != tt.want {
+			t.Errorf("Name(%s, %s, %s) = %q, want %q", tt.locale, tt.term, tt.name, got, tt.want)
+		}
+	}
+	if got := c.Label("hi", "tithi"); got != "तिथि" {
+		t.Errorf("Label(hi, tithi) = %q", got)
+	}
+	if got := c.Label("en", "tithi"); got != "" {
+		t.Errorf("Label(en, tithi) = %q, want none", got)
+	}
+}
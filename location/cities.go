@@ -0,0 +1,608 @@
+package location
+
+// city is a reference point of a timezone.
+type city struct {
+	name                string
+	latitude, longitude float64
+	zone                string
+}
+
+// cities are the reference points of TimezoneName and the places of the
+// Gazetteer. They are densest along the borders of the Indian subcontinent,
+// where most observers are, and elsewhere cover the populated parts of each
+// timezone closely enough that the nearest city is usually in the same one.
+var cities = []city{
+	// India.
+	{"Mumbai", 19.08, 72.88, "Asia/Kolkata"},
+	{"Delhi", 28.61, 77.21, "Asia/Kolkata"},
+	{"Chennai", 13.08, 80.27, "Asia/Kolkata"},
+	{"Kolkata", 22.57, 88.36, "Asia/Kolkata"},
+	{"Bengaluru", 12.97, 77.59, "Asia/Kolkata"},
+	{"Hyderabad", 17.39, 78.49, "Asia/Kolkata"},
+	{"Ahmedabad", 23.02, 72.57, "Asia/Kolkata"},
+	{"Pune", 18.52, 73.86, "Asia/Kolkata"},
+	{"Jaipur", 26.91, 75.79, "Asia/Kolkata"},
+	{"Lucknow", 26.85, 80.95, "Asia/Kolkata"},
+	{"Patna", 25.59, 85.14, "Asia/Kolkata"},
+	{"Bhopal", 23.26, 77.41, "Asia/Kolkata"},
+	{"Nagpur", 21.15, 79.09, "Asia/Kolkata"},
+	{"Ujjain", 23.18, 75.79, "Asia/Kolkata"},
+	{"Varanasi", 25.32, 82.97, "Asia/Kolkata"},
+	{"Srinagar", 34.08, 74.80, "Asia/Kolkata"},
+	{"Jammu", 32.73, 74.86, "Asia/Kolkata"},
+	{"Amritsar", 31.63, 74.87, "Asia/Kolkata"},
+	{"Ferozepur", 30.93, 74.61, "Asia/Kolkata"},
+	{"Sri Ganganagar", 29.90, 73.88, "Asia/Kolkata"},
+	{"Leh", 34.15, 77.58, "Asia/Kolkata"},
+	{"Dehradun", 30.32, 78.03, "Asia/Kolkata"},
+	{"Pithoragarh", 29.58, 80.22, "Asia/Kolkata"},
+	{"Gorakhpur", 26.76, 83.37, "Asia/Kolkata"},
+	{"Darbhanga", 26.15, 85.90, "Asia/Kolkata"},
+	{"Purnia", 25.78, 87.47, "Asia/Kolkata"},
+	{"Siliguri", 26.73, 88.40, "Asia/Kolkata"},
+	{"Malda", 25.01, 88.14, "Asia/Kolkata"},
+	{"Gangtok", 27.33, 88.61, "Asia/Kolkata"},
+	{"Guwahati", 26.14, 91.74, "Asia/Kolkata"},
+	{"Shillong", 25.58, 91.89, "Asia/Kolkata"},
+	{"Silchar", 24.83, 92.78, "Asia/Kolkata"},
+	{"Agartala", 23.83, 91.29, "Asia/Kolkata"},
+	{"Aizawl", 23.73, 92.72, "Asia/Kolkata"},
+	{"Imphal", 24.82, 93.94, "Asia/Kolkata"},
+	{"Kohima", 25.67, 94.11, "Asia/Kolkata"},
+	{"Itanagar", 27.08, 93.61, "Asia/Kolkata"},
+	{"Dibrugarh", 27.47, 94.91, "Asia/Kolkata"},
+	{"Jaisalmer", 26.92, 70.91, "Asia/Kolkata"},
+	{"Barmer", 25.75, 71.39, "Asia/Kolkata"},
+	{"Bhuj", 23.24, 69.67, "Asia/Kolkata"},
+	{"Panaji", 15.49, 73.83, "Asia/Kolkata"},
+	{"Mangaluru", 12.91, 74.86, "Asia/Kolkata"},
+	{"Kochi", 9.93, 76.27, "Asia/Kolkata"},
+	{"Thiruvananthapuram", 8.52, 76.94, "Asia/Kolkata"},
+	{"Madurai", 9.93, 78.12, "Asia/Kolkata"},
+	{"Rameswaram", 9.29, 79.31, "Asia/Kolkata"},
+	{"Visakhapatnam", 17.69, 83.22, "Asia/Kolkata"},
+	{"Bhubaneswar", 20.30, 85.82, "Asia/Kolkata"},
+	{"Raipur", 21.25, 81.63, "Asia/Kolkata"},
+	{"Ranchi", 23.34, 85.31, "Asia/Kolkata"},
+	{"Port Blair", 11.62, 92.73, "Asia/Kolkata"},
+	{"Kavaratti", 10.57, 72.64, "Asia/Kolkata"},
+
+	// The rest of South Asia.
+	{"Karachi", 24.86, 67.01, "Asia/Karachi"},
+	{"Hyderabad, Sindh", 25.40, 68.37, "Asia/Karachi"},
+	{"Lahore", 31.55, 74.34, "Asia/Karachi"},
+	{"Sialkot", 32.49, 74.53, "Asia/Karachi"},
+	{"Islamabad", 33.68, 73.05, "Asia/Karachi"},
+	{"Peshawar", 34.01, 71.58, "Asia/Karachi"},
+	{"Multan", 30.16, 71.52, "Asia/Karachi"},
+	{"Bahawalpur", 29.40, 71.68, "Asia/Karachi"},
+	{"Quetta", 30.18, 67.00, "Asia/Karachi"},
+	{"Gilgit", 35.92, 74.31, "Asia/Karachi"},
+	{"Gwadar", 25.13, 62.32, "Asia/Karachi"},
+	{"Kathmandu", 27.72, 85.32, "Asia/Kathmandu"},
+	{"Pokhara", 28.21, 83.99, "Asia/Kathmandu"},
+	{"Biratnagar", 26.45, 87.27, "Asia/Kathmandu"},
+	{"Janakpur", 26.73, 85.93, "Asia/Kathmandu"},
+	{"Birgunj", 27.01, 84.88, "Asia/Kathmandu"},
+	{"Siddharthanagar", 27.50, 83.45, "Asia/Kathmandu"},
+	{"Butwal", 27.70, 83.47, "Asia/Kathmandu"},
+	{"Nepalgunj", 28.05, 81.62, "Asia/Kathmandu"},
+	{"Dhangadhi", 28.69, 80.59, "Asia/Kathmandu"},
+	{"Dhaka", 23.81, 90.41, "Asia/Dhaka"},
+	{"Chittagong", 22.36, 91.78, "Asia/Dhaka"},
+	{"Khulna", 22.85, 89.54, "Asia/Dhaka"},
+	{"Rajshahi", 24.37, 88.60, "Asia/Dhaka"},
+	{"Sylhet", 24.89, 91.87, "Asia/Dhaka"},
+	{"Rangpur", 25.74, 89.28, "Asia/Dhaka"},
+	{"Thimphu", 27.47, 89.64, "Asia/Thimphu"},
+	{"Phuentsholing", 26.86, 89.39, "Asia/Thimphu"},
+	{"Trashigang", 27.33, 91.55, "Asia/Thimphu"},
+	{"Colombo", 6.93, 79.86, "Asia/Colombo"},
+	{"Kandy", 7.29, 80.63, "Asia/Colombo"},
+	{"Jaffna", 9.66, 80.02, "Asia/Colombo"},
+	{"Trincomalee", 8.59, 81.22, "Asia/Colombo"},
+	{"Male", 4.18, 73.51, "Indian/Maldives"},
+	{"Diego Garcia", -7.31, 72.42, "Indian/Chagos"},
+	{"Kabul", 34.56, 69.21, "Asia/Kabul"},
+	{"Kandahar", 31.63, 65.71, "Asia/Kabul"},
+	{"Herat", 34.35, 62.20, "Asia/Kabul"},
+	{"Mazar-i-Sharif", 36.71, 67.11, "Asia/Kabul"},
+	{"Yangon", 16.87, 96.20, "Asia/Yangon"},
+	{"Mandalay", 21.96, 96.09, "Asia/Yangon"},
+	{"Myitkyina", 25.38, 97.40, "Asia/Yangon"},
+	{"Sittwe", 20.15, 92.90, "Asia/Yangon"},
+
+	// East and Southeast Asia.
+	{"Beijing", 39.90, 116.41, "Asia/Shanghai"},
+	{"Shanghai", 31.23, 121.47, "Asia/Shanghai"},
+	{"Guangzhou", 23.13, 113.26, "Asia/Shanghai"},
+	{"Wuhan", 30.59, 114.31, "Asia/Shanghai"},
+	{"Xi'an", 34.34, 108.94, "Asia/Shanghai"},
+	{"Chengdu", 30.57, 104.07, "Asia/Shanghai"},
+	{"Kunming", 25.04, 102.71, "Asia/Shanghai"},
+	{"Lanzhou", 36.06, 103.83, "Asia/Shanghai"},
+	{"Xining", 36.62, 101.78, "Asia/Shanghai"},
+	{"Golmud", 36.40, 94.90, "Asia/Shanghai"},
+	{"Lhasa", 29.65, 91.17, "Asia/Shanghai"},
+	{"Shigatse", 29.27, 88.88, "Asia/Shanghai"},
+	{"Nyingchi", 29.65, 94.36, "Asia/Shanghai"},
+	{"Shiquanhe", 32.50, 80.10, "Asia/Shanghai"},
+	{"Hohhot", 40.84, 111.75, "Asia/Shanghai"},
+	{"Harbin", 45.80, 126.53, "Asia/Shanghai"},
+	{"Urumqi", 43.83, 87.62, "Asia/Urumqi"},
+	{"Kashgar", 39.47, 75.99, "Asia/Urumqi"},
+	{"Hotan", 37.11, 79.93, "Asia/Urumqi"},
+	{"Hong Kong", 22.32, 114.17, "Asia/Hong_Kong"},
+	{"Macau", 22.20, 113.54, "Asia/Macau"},
+	{"Taipei", 25.03, 121.57, "Asia/Taipei"},
+	{"Kaohsiung", 22.63, 120.30, "Asia/Taipei"},
+	{"Ulaanbaatar", 47.89, 106.91, "Asia/Ulaanbaatar"},
+	{"Khovd", 48.01, 91.64, "Asia/Hovd"},
+	{"Tokyo", 35.68, 139.69, "Asia/Tokyo"},
+	{"Osaka", 34.69, 135.50, "Asia/Tokyo"},
+	{"Sapporo", 43.06, 141.35, "Asia/Tokyo"},
+	{"Fukuoka", 33.59, 130.40, "Asia/Tokyo"},
+	{"Naha", 26.21, 127.68, "Asia/Tokyo"},
+	{"Seoul", 37.57, 126.98, "Asia/Seoul"},
+	{"Busan", 35.18, 129.08, "Asia/Seoul"},
+	{"Pyongyang", 39.04, 125.76, "Asia/Pyongyang"},
+	{"Bangkok", 13.76, 100.50, "Asia/Bangkok"},
+	{"Chiang Mai", 18.79, 98.98, "Asia/Bangkok"},
+	{"Ho Chi Minh City", 10.82, 106.63, "Asia/Ho_Chi_Minh"},
+	{"Hanoi", 21.03, 105.85, "Asia/Ho_Chi_Minh"},
+	{"Phnom Penh", 11.56, 104.93, "Asia/Phnom_Penh"},
+	{"Vientiane", 17.97, 102.63, "Asia/Vientiane"},
+	{"Kuala Lumpur", 3.14, 101.69, "Asia/Kuala_Lumpur"},
+	{"Kuching", 1.55, 110.36, "Asia/Kuching"},
+	{"Singapore", 1.35, 103.82, "Asia/Singapore"},
+	{"Bandar Seri Begawan", 4.90, 114.94, "Asia/Brunei"},
+	{"Jakarta", -6.21, 106.85, "Asia/Jakarta"},
+	{"Surabaya", -7.25, 112.75, "Asia/Jakarta"},
+	{"Medan", 3.60, 98.67, "Asia/Jakarta"},
+	{"Pontianak", -0.03, 109.33, "Asia/Pontianak"},
+	{"Makassar", -5.15, 119.43, "Asia/Makassar"},
+	{"Denpasar", -8.65, 115.22, "Asia/Makassar"},
+	{"Balikpapan", -1.24, 116.85, "Asia/Makassar"},
+	{"Ambon", -3.70, 128.18, "Asia/Jayapura"},
+	{"Jayapura", -2.53, 140.72, "Asia/Jayapura"},
+	{"Dili", -8.56, 125.56, "Asia/Dili"},
+	{"Manila", 14.60, 120.98, "Asia/Manila"},
+	{"Davao", 7.19, 125.46, "Asia/Manila"},
+	{"Flying Fish Cove", -10.49, 105.62, "Indian/Christmas"},
+	{"West Island", -12.19, 96.83, "Indian/Cocos"},
+
+	// Central and West Asia.
+	{"Tashkent", 41.30, 69.24, "Asia/Tashkent"},
+	{"Samarkand", 39.65, 66.96, "Asia/Samarkand"},
+	{"Almaty", 43.24, 76.89, "Asia/Almaty"},
+	{"Astana", 51.17, 71.45, "Asia/Almaty"},
+	{"Aktobe", 50.28, 57.17, "Asia/Aqtobe"},
+	{"Atyrau", 47.09, 51.92, "Asia/Atyrau"},
+	{"Bishkek", 42.87, 74.59, "Asia/Bishkek"},
+	{"Dushanbe", 38.56, 68.79, "Asia/Dushanbe"},
+	{"Ashgabat", 37.96, 58.33, "Asia/Ashgabat"},
+	{"Tehran", 35.69, 51.39, "Asia/Tehran"},
+	{"Mashhad", 36.30, 59.61, "Asia/Tehran"},
+	{"Isfahan", 32.65, 51.67, "Asia/Tehran"},
+	{"Shiraz", 29.59, 52.58, "Asia/Tehran"},
+	{"Tabriz", 38.08, 46.29, "Asia/Tehran"},
+	{"Zahedan", 29.50, 60.86, "Asia/Tehran"},
+	{"Bandar Abbas", 27.18, 56.27, "Asia/Tehran"},
+	{"Baku", 40.41, 49.87, "Asia/Baku"},
+	{"Tbilisi", 41.72, 44.79, "Asia/Tbilisi"},
+	{"Yerevan", 40.18, 44.51, "Asia/Yerevan"},
+	{"Istanbul", 41.01, 28.98, "Europe/Istanbul"},
+	{"Ankara", 39.93, 32.86, "Europe/Istanbul"},
+	{"Diyarbakir", 37.91, 40.24, "Europe/Istanbul"},
+	{"Baghdad", 33.31, 44.36, "Asia/Baghdad"},
+	{"Basra", 30.51, 47.78, "Asia/Baghdad"},
+	{"Mosul", 36.34, 43.13, "Asia/Baghdad"},
+	{"Riyadh", 24.71, 46.68, "Asia/Riyadh"},
+	{"Jeddah", 21.49, 39.19, "Asia/Riyadh"},
+	{"Dubai", 25.20, 55.27, "Asia/Dubai"},
+	{"Muscat", 23.59, 58.41, "Asia/Muscat"},
+	{"Salalah", 17.02, 54.09, "Asia/Muscat"},
+	{"Doha", 25.29, 51.53, "Asia/Qatar"},
+	{"Manama", 26.23, 50.59, "Asia/Bahrain"},
+	{"Kuwait City", 29.38, 47.99, "Asia/Kuwait"},
+	{"Sanaa", 15.37, 44.19, "Asia/Aden"},
+	{"Aden", 12.79, 45.02, "Asia/Aden"},
+	{"Amman", 31.95, 35.93, "Asia/Amman"},
+	{"Damascus", 33.51, 36.28, "Asia/Damascus"},
+	{"Beirut", 33.89, 35.50, "Asia/Beirut"},
+	{"Jerusalem", 31.77, 35.21, "Asia/Jerusalem"},
+	{"Tel Aviv", 32.09, 34.78, "Asia/Jerusalem"},
+	{"Nicosia", 35.19, 33.38, "Asia/Nicosia"},
+
+	// Russia.
+	{"Moscow", 55.76, 37.62, "Europe/Moscow"},
+	{"Saint Petersburg", 59.93, 30.34, "Europe/Moscow"},
+	{"Murmansk", 68.97, 33.07, "Europe/Moscow"},
+	{"Arkhangelsk", 64.54, 40.54, "Europe/Moscow"},
+	{"Krasnodar", 45.04, 38.98, "Europe/Moscow"},
+	{"Kaliningrad", 54.71, 20.51, "Europe/Kaliningrad"},
+	{"Samara", 53.20, 50.15, "Europe/Samara"},
+	{"Volgograd", 48.71, 44.51, "Europe/Volgograd"},
+	{"Astrakhan", 46.35, 48.04, "Europe/Astrakhan"},
+	{"Saratov", 51.53, 46.03, "Europe/Saratov"},
+	{"Ulyanovsk", 54.32, 48.40, "Europe/Ulyanovsk"},
+	{"Kirov", 58.60, 49.66, "Europe/Kirov"},
+	{"Yekaterinburg", 56.84, 60.61, "Asia/Yekaterinburg"},
+	{"Ufa", 54.74, 55.97, "Asia/Yekaterinburg"},
+	{"Perm", 58.01, 56.23, "Asia/Yekaterinburg"},
+	{"Salekhard", 66.53, 66.60, "Asia/Yekaterinburg"},
+	{"Surgut", 61.25, 73.40, "Asia/Yekaterinburg"},
+	{"Omsk", 54.99, 73.37, "Asia/Omsk"},
+	{"Novosibirsk", 55.01, 82.93, "Asia/Novosibirsk"},
+	{"Tomsk", 56.50, 84.97, "Asia/Tomsk"},
+	{"Barnaul", 53.35, 83.78, "Asia/Barnaul"},
+	{"Novokuznetsk", 53.76, 87.12, "Asia/Novokuznetsk"},
+	{"Krasnoyarsk", 56.01, 92.89, "Asia/Krasnoyarsk"},
+	{"Norilsk", 69.35, 88.20, "Asia/Krasnoyarsk"},
+	{"Irkutsk", 52.29, 104.30, "Asia/Irkutsk"},
+	{"Chita", 52.03, 113.50, "Asia/Chita"},
+	{"Yakutsk", 62.03, 129.73, "Asia/Yakutsk"},
+	{"Tiksi", 71.64, 128.87, "Asia/Yakutsk"},
+	{"Vladivostok", 43.12, 131.89, "Asia/Vladivostok"},
+	{"Khabarovsk", 48.48, 135.08, "Asia/Vladivostok"},
+	{"Magadan", 59.56, 150.80, "Asia/Magadan"},
+	{"Srednekolymsk", 67.46, 153.71, "Asia/Srednekolymsk"},
+	{"Petropavlovsk-Kamchatsky", 53.02, 158.65, "Asia/Kamchatka"},
+	{"Anadyr", 64.73, 177.51, "Asia/Anadyr"},
+
+	// Europe.
+	{"London", 51.51, -0.13, "Europe/London"},
+	{"Edinburgh", 55.95, -3.19, "Europe/London"},
+	{"Dublin", 53.35, -6.26, "Europe/Dublin"},
+	{"Lisbon", 38.72, -9.14, "Europe/Lisbon"},
+	{"Ponta Delgada", 37.74, -25.67, "Atlantic/Azores"},
+	{"Funchal", 32.65, -16.91, "Atlantic/Madeira"},
+	{"Las Palmas", 28.12, -15.43, "Atlantic/Canary"},
+	{"Madrid", 40.42, -3.70, "Europe/Madrid"},
+	{"Barcelona", 41.39, 2.17, "Europe/Madrid"},
+	{"Seville", 37.39, -5.98, "Europe/Madrid"},
+	{"Paris", 48.86, 2.35, "Europe/Paris"},
+	{"Marseille", 43.30, 5.37, "Europe/Paris"},
+	{"Bordeaux", 44.84, -0.58, "Europe/Paris"},
+	{"Brest", 48.39, -4.49, "Europe/Paris"},
+	{"Brussels", 50.85, 4.35, "Europe/Brussels"},
+	{"Amsterdam", 52.37, 4.90, "Europe/Amsterdam"},
+	{"Luxembourg", 49.61, 6.13, "Europe/Luxembourg"},
+	{"Berlin", 52.52, 13.40, "Europe/Berlin"},
+	{"Munich", 48.14, 11.58, "Europe/Berlin"},
+	{"Hamburg", 53.55, 9.99, "Europe/Berlin"},
+	{"Cologne", 50.94, 6.96, "Europe/Berlin"},
+	{"Zurich", 47.38, 8.54, "Europe/Zurich"},
+	{"Geneva", 46.20, 6.14, "Europe/Zurich"},
+	{"Vienna", 48.21, 16.37, "Europe/Vienna"},
+	{"Rome", 41.90, 12.50, "Europe/Rome"},
+	{"Milan", 45.46, 9.19, "Europe/Rome"},
+	{"Palermo", 38.12, 13.36, "Europe/Rome"},
+	{"Valletta", 35.90, 14.51, "Europe/Malta"},
+	{"Copenhagen", 55.68, 12.57, "Europe/Copenhagen"},
+	{"Oslo", 59.91, 10.75, "Europe/Oslo"},
+	{"Bergen", 60.39, 5.32, "Europe/Oslo"},
+	{"Tromso", 69.65, 18.96, "Europe/Oslo"},
+	{"Stockholm", 59.33, 18.07, "Europe/Stockholm"},
+	{"Kiruna", 67.86, 20.23, "Europe/Stockholm"},
+	{"Helsinki", 60.17, 24.94, "Europe/Helsinki"},
+	{"Oulu", 65.01, 25.47, "Europe/Helsinki"},
+	{"Tallinn", 59.44, 24.75, "Europe/Tallinn"},
+	{"Riga", 56.95, 24.11, "Europe/Riga"},
+	{"Vilnius", 54.69, 25.28, "Europe/Vilnius"},
+	{"Warsaw", 52.23, 21.01, "Europe/Warsaw"},
+	{"Krakow", 50.06, 19.94, "Europe/Warsaw"},
+	{"Gdansk", 54.35, 18.65, "Europe/Warsaw"},
+	{"Prague", 50.08, 14.44, "Europe/Prague"},
+	{"Bratislava", 48.15, 17.11, "Europe/Bratislava"},
+	{"Budapest", 47.50, 19.04, "Europe/Budapest"},
+	{"Ljubljana", 46.06, 14.51, "Europe/Ljubljana"},
+	{"Zagreb", 45.81, 15.98, "Europe/Zagreb"},
+	{"Split", 43.51, 16.44, "Europe/Zagreb"},
+	{"Belgrade", 44.79, 20.45, "Europe/Belgrade"},
+	{"Sarajevo", 43.86, 18.41, "Europe/Sarajevo"},
+	{"Podgorica", 42.44, 19.26, "Europe/Podgorica"},
+	{"Skopje", 42.00, 21.43, "Europe/Skopje"},
+	{"Tirana", 41.33, 19.82, "Europe/Tirane"},
+	{"Athens", 37.98, 23.73, "Europe/Athens"},
+	{"Thessaloniki", 40.64, 22.94, "Europe/Athens"},
+	{"Heraklion", 35.34, 25.13, "Europe/Athens"},
+	{"Sofia", 42.70, 23.32, "Europe/Sofia"},
+	{"Varna", 43.21, 27.91, "Europe/Sofia"},
+	{"Bucharest", 44.43, 26.10, "Europe/Bucharest"},
+	{"Cluj-Napoca", 46.77, 23.59, "Europe/Bucharest"},
+	{"Chisinau", 47.01, 28.86, "Europe/Chisinau"},
+	{"Kyiv", 50.45, 30.52, "Europe/Kiev"},
+	{"Lviv", 49.84, 24.03, "Europe/Kiev"},
+	{"Odesa", 46.48, 30.72, "Europe/Kiev"},
+	{"Kharkiv", 49.99, 36.23, "Europe/Kiev"},
+	{"Simferopol", 44.95, 34.10, "Europe/Simferopol"},
+	{"Minsk", 53.90, 27.56, "Europe/Minsk"},
+	{"Reykjavik", 64.15, -21.94, "Atlantic/Reykjavik"},
+	{"Torshavn", 62.01, -6.77, "Atlantic/Faroe"},
+	{"Andorra la Vella", 42.51, 1.52, "Europe/Andorra"},
+	{"Monaco", 43.74, 7.42, "Europe/Monaco"},
+
+	// Africa.
+	{"Cairo", 30.04, 31.24, "Africa/Cairo"},
+	{"Aswan", 24.09, 32.90, "Africa/Cairo"},
+	{"Tripoli", 32.89, 13.19, "Africa/Tripoli"},
+	{"Benghazi", 32.12, 20.09, "Africa/Tripoli"},
+	{"Sebha", 27.04, 14.43, "Africa/Tripoli"},
+	{"Tunis", 36.81, 10.18, "Africa/Tunis"},
+	{"Algiers", 36.75, 3.06, "Africa/Algiers"},
+	{"Tamanrasset", 22.79, 5.52, "Africa/Algiers"},
+	{"Casablanca", 33.57, -7.59, "Africa/Casablanca"},
+	{"Marrakesh", 31.63, -8.01, "Africa/Casablanca"},
+	{"Laayoune", 27.15, -13.20, "Africa/El_Aaiun"},
+	{"Nouakchott", 18.08, -15.98, "Africa/Nouakchott"},
+	{"Dakar", 14.72, -17.47, "Africa/Dakar"},
+	{"Banjul", 13.45, -16.58, "Africa/Banjul"},
+	{"Bissau", 11.86, -15.60, "Africa/Bissau"},
+	{"Conakry", 9.64, -13.58, "Africa/Conakry"},
+	{"Freetown", 8.47, -13.23, "Africa/Freetown"},
+	{"Monrovia", 6.30, -10.80, "Africa/Monrovia"},
+	{"Abidjan", 5.36, -4.01, "Africa/Abidjan"},
+	{"Accra", 5.60, -0.19, "Africa/Accra"},
+	{"Lome", 6.13, 1.22, "Africa/Lome"},
+	{"Porto-Novo", 6.50, 2.60, "Africa/Porto-Novo"},
+	{"Lagos", 6.52, 3.38, "Africa/Lagos"},
+	{"Abuja", 9.08, 7.40, "Africa/Lagos"},
+	{"Kano", 12.00, 8.52, "Africa/Lagos"},
+	{"Niamey", 13.51, 2.11, "Africa/Niamey"},
+	{"Agadez", 16.97, 7.99, "Africa/Niamey"},
+	{"Ouagadougou", 12.37, -1.52, "Africa/Ouagadougou"},
+	{"Bamako", 12.64, -8.00, "Africa/Bamako"},
+	{"Timbuktu", 16.77, -3.01, "Africa/Bamako"},
+	{"N'Djamena", 12.13, 15.06, "Africa/Ndjamena"},
+	{"Faya-Largeau", 17.93, 19.10, "Africa/Ndjamena"},
+	{"Douala", 4.05, 9.77, "Africa/Douala"},
+	{"Yaounde", 3.85, 11.50, "Africa/Douala"},
+	{"Malabo", 3.75, 8.78, "Africa/Malabo"},
+	{"Libreville", 0.42, 9.47, "Africa/Libreville"},
+	{"Sao Tome", 0.34, 6.73, "Africa/Sao_Tome"},
+	{"Brazzaville", -4.27, 15.28, "Africa/Brazzaville"},
+	{"Kinshasa", -4.44, 15.27, "Africa/Kinshasa"},
+	{"Kisangani", 0.52, 25.19, "Africa/Lubumbashi"},
+	{"Goma", -1.68, 29.23, "Africa/Lubumbashi"},
+	{"Lubumbashi", -11.66, 27.48, "Africa/Lubumbashi"},
+	{"Bangui", 4.39, 18.56, "Africa/Bangui"},
+	{"Khartoum", 15.50, 32.56, "Africa/Khartoum"},
+	{"Juba", 4.86, 31.57, "Africa/Juba"},
+	{"Addis Ababa", 9.03, 38.74, "Africa/Addis_Ababa"},
+	{"Asmara", 15.32, 38.93, "Africa/Asmara"},
+	{"Djibouti", 11.59, 43.15, "Africa/Djibouti"},
+	{"Mogadishu", 2.05, 45.32, "Africa/Mogadishu"},
+	{"Hargeisa", 9.56, 44.06, "Africa/Mogadishu"},
+	{"Nairobi", -1.29, 36.82, "Africa/Nairobi"},
+	{"Mombasa", -4.04, 39.67, "Africa/Nairobi"},
+	{"Kampala", 0.35, 32.58, "Africa/Kampala"},
+	{"Kigali", -1.94, 30.06, "Africa/Kigali"},
+	{"Bujumbura", -3.38, 29.36, "Africa/Bujumbura"},
+	{"Dar es Salaam", -6.79, 39.21, "Africa/Dar_es_Salaam"},
+	{"Dodoma", -6.16, 35.75, "Africa/Dar_es_Salaam"},
+	{"Lusaka", -15.39, 28.32, "Africa/Lusaka"},
+	{"Harare", -17.83, 31.05, "Africa/Harare"},
+	{"Blantyre", -15.79, 35.01, "Africa/Blantyre"},
+	{"Maputo", -25.97, 32.57, "Africa/Maputo"},
+	{"Beira", -19.84, 34.84, "Africa/Maputo"},
+	{"Luanda", -8.84, 13.23, "Africa/Luanda"},
+	{"Huambo", -12.78, 15.74, "Africa/Luanda"},
+	{"Windhoek", -22.56, 17.07, "Africa/Windhoek"},
+	{"Gaborone", -24.63, 25.92, "Africa/Gaborone"},
+	{"Johannesburg", -26.20, 28.05, "Africa/Johannesburg"},
+	{"Cape Town", -33.92, 18.42, "Africa/Johannesburg"},
+	{"Durban", -29.86, 31.02, "Africa/Johannesburg"},
+	{"Maseru", -29.31, 27.48, "Africa/Maseru"},
+	{"Mbabane", -26.31, 31.14, "Africa/Mbabane"},
+	{"Antananarivo", -18.88, 47.51, "Indian/Antananarivo"},
+	{"Moroni", -11.70, 43.26, "Indian/Comoro"},
+	{"Victoria", -4.62, 55.45, "Indian/Mahe"},
+	{"Port Louis", -20.16, 57.50, "Indian/Mauritius"},
+	{"Saint-Denis", -20.88, 55.45, "Indian/Reunion"},
+	{"Port-aux-Francais", -49.35, 70.22, "Indian/Kerguelen"},
+	{"Praia", 14.93, -23.51, "Atlantic/Cape_Verde"},
+	{"Jamestown", -15.93, -5.72, "Atlantic/St_Helena"},
+
+	// North America.
+	{"New York", 40.71, -74.01, "America/New_York"},
+	{"Boston", 42.36, -71.06, "America/New_York"},
+	{"Washington", 38.91, -77.04, "America/New_York"},
+	{"Charlotte", 35.23, -80.84, "America/New_York"},
+	{"Atlanta", 33.75, -84.39, "America/New_York"},
+	{"Miami", 25.76, -80.19, "America/New_York"},
+	{"Detroit", 42.33, -83.05, "America/Detroit"},
+	{"Indianapolis", 39.77, -86.16, "America/Indiana/Indianapolis"},
+	{"Chicago", 41.88, -87.63, "America/Chicago"},
+	{"Houston", 29.76, -95.37, "America/Chicago"},
+	{"Dallas", 32.78, -96.80, "America/Chicago"},
+	{"New Orleans", 29.95, -90.07, "America/Chicago"},
+	{"Minneapolis", 44.98, -93.27, "America/Chicago"},
+	{"Kansas City", 39.10, -94.58, "America/Chicago"},
+	{"Memphis", 35.15, -90.05, "America/Chicago"},
+	{"Nashville", 36.16, -86.78, "America/Chicago"},
+	{"Denver", 39.74, -104.99, "America/Denver"},
+	{"Salt Lake City", 40.76, -111.89, "America/Denver"},
+	{"Albuquerque", 35.08, -106.65, "America/Denver"},
+	{"El Paso", 31.76, -106.49, "America/Denver"},
+	{"Billings", 45.78, -108.50, "America/Denver"},
+	{"Boise", 43.62, -116.20, "America/Boise"},
+	{"Phoenix", 33.45, -112.07, "America/Phoenix"},
+	{"Tucson", 32.22, -110.97, "America/Phoenix"},
+	{"Los Angeles", 34.05, -118.24, "America/Los_Angeles"},
+	{"San Francisco", 37.77, -122.42, "America/Los_Angeles"},
+	{"Las Vegas", 36.17, -115.14, "America/Los_Angeles"},
+	{"Portland", 45.52, -122.68, "America/Los_Angeles"},
+	{"Seattle", 47.61, -122.33, "America/Los_Angeles"},
+	{"Anchorage", 61.22, -149.90, "America/Anchorage"},
+	{"Fairbanks", 64.84, -147.72, "America/Anchorage"},
+	{"Juneau", 58.30, -134.42, "America/Juneau"},
+	{"Nome", 64.50, -165.41, "America/Nome"},
+	{"Honolulu", 21.31, -157.86, "Pacific/Honolulu"},
+	{"Hilo", 19.72, -155.08, "Pacific/Honolulu"},
+	{"Toronto", 43.65, -79.38, "America/Toronto"},
+	{"Ottawa", 45.42, -75.70, "America/Toronto"},
+	{"Montreal", 45.50, -73.57, "America/Toronto"},
+	{"Quebec City", 46.81, -71.21, "America/Toronto"},
+	{"Thunder Bay", 48.38, -89.25, "America/Toronto"},
+	{"Halifax", 44.65, -63.58, "America/Halifax"},
+	{"Moncton", 46.09, -64.78, "America/Moncton"},
+	{"St. John's", 47.56, -52.71, "America/St_Johns"},
+	{"Happy Valley-Goose Bay", 53.30, -60.33, "America/Goose_Bay"},
+	{"Winnipeg", 49.90, -97.14, "America/Winnipeg"},
+	{"Regina", 50.45, -104.61, "America/Regina"},
+	{"Saskatoon", 52.13, -106.67, "America/Regina"},
+	{"Edmonton", 53.55, -113.49, "America/Edmonton"},
+	{"Calgary", 51.05, -114.07, "America/Edmonton"},
+	{"Yellowknife", 62.45, -114.37, "America/Edmonton"},
+	{"Vancouver", 49.28, -123.12, "America/Vancouver"},
+	{"Whitehorse", 60.72, -135.06, "America/Whitehorse"},
+	{"Inuvik", 68.36, -133.72, "America/Inuvik"},
+	{"Cambridge Bay", 69.12, -105.06, "America/Cambridge_Bay"},
+	{"Rankin Inlet", 62.81, -92.09, "America/Rankin_Inlet"},
+	{"Resolute", 74.70, -94.83, "America/Resolute"},
+	{"Iqaluit", 63.75, -68.52, "America/Iqaluit"},
+	{"Nuuk", 64.18, -51.69, "America/Nuuk"},
+	{"Ittoqqortoormiit", 70.49, -21.96, "America/Scoresbysund"},
+	{"Danmarkshavn", 76.77, -18.67, "America/Danmarkshavn"},
+	{"Pituffik", 76.53, -68.70, "America/Thule"},
+	{"Hamilton", 32.29, -64.78, "Atlantic/Bermuda"},
+	{"Mexico City", 19.43, -99.13, "America/Mexico_City"},
+	{"Guadalajara", 20.66, -103.35, "America/Mexico_City"},
+	{"Monterrey", 25.69, -100.32, "America/Monterrey"},
+	{"Merida", 20.97, -89.62, "America/Merida"},
+	{"Cancun", 21.16, -86.85, "America/Cancun"},
+	{"Chihuahua", 28.63, -106.07, "America/Chihuahua"},
+	{"Mazatlan", 23.25, -106.41, "America/Mazatlan"},
+	{"Hermosillo", 29.07, -110.96, "America/Hermosillo"},
+	{"Tijuana", 32.51, -117.04, "America/Tijuana"},
+
+	// Central America and the Caribbean.
+	{"Guatemala City", 14.63, -90.51, "America/Guatemala"},
+	{"Belmopan", 17.25, -88.77, "America/Belize"},
+	{"San Salvador", 13.69, -89.22, "America/El_Salvador"},
+	{"Tegucigalpa", 14.07, -87.19, "America/Tegucigalpa"},
+	{"Managua", 12.11, -86.24, "America/Managua"},
+	{"San Jose", 9.93, -84.08, "America/Costa_Rica"},
+	{"Panama City", 8.98, -79.52, "America/Panama"},
+	{"Havana", 23.11, -82.37, "America/Havana"},
+	{"Santiago de Cuba", 20.02, -75.82, "America/Havana"},
+	{"Kingston", 18.02, -76.80, "America/Jamaica"},
+	{"Port-au-Prince", 18.54, -72.34, "America/Port-au-Prince"},
+	{"Santo Domingo", 18.49, -69.93, "America/Santo_Domingo"},
+	{"San Juan", 18.47, -66.11, "America/Puerto_Rico"},
+	{"Nassau", 25.05, -77.35, "America/Nassau"},
+	{"Fort-de-France", 14.62, -61.06, "America/Martinique"},
+	{"Bridgetown", 13.10, -59.61, "America/Barbados"},
+	{"Port of Spain", 10.66, -61.52, "America/Port_of_Spain"},
+	{"Willemstad", 12.11, -68.93, "America/Curacao"},
+
+	// South America.
+	{"Bogota", 4.71, -74.07, "America/Bogota"},
+	{"Medellin", 6.24, -75.58, "America/Bogota"},
+	{"Caracas", 10.48, -66.90, "America/Caracas"},
+	{"Maracaibo", 10.65, -71.64, "America/Caracas"},
+	{"Georgetown", 6.80, -58.16, "America/Guyana"},
+	{"Paramaribo", 5.85, -55.20, "America/Paramaribo"},
+	{"Cayenne", 4.92, -52.31, "America/Cayenne"},
+	{"Quito", -0.18, -78.47, "America/Guayaquil"},
+	{"Guayaquil", -2.17, -79.92, "America/Guayaquil"},
+	{"Puerto Ayora", -0.74, -90.31, "Pacific/Galapagos"},
+	{"Lima", -12.05, -77.04, "America/Lima"},
+	{"Cusco", -13.53, -71.97, "America/Lima"},
+	{"Iquitos", -3.75, -73.25, "America/Lima"},
+	{"La Paz", -16.50, -68.15, "America/La_Paz"},
+	{"Santa Cruz", -17.78, -63.18, "America/La_Paz"},
+	{"Santiago", -33.45, -70.67, "America/Santiago"},
+	{"Antofagasta", -23.65, -70.40, "America/Santiago"},
+	{"Punta Arenas", -53.16, -70.91, "America/Punta_Arenas"},
+	{"Hanga Roa", -27.11, -109.35, "Pacific/Easter"},
+	{"Buenos Aires", -34.60, -58.38, "America/Argentina/Buenos_Aires"},
+	{"Cordoba", -31.42, -64.18, "America/Argentina/Cordoba"},
+	{"Mendoza", -32.89, -68.83, "America/Argentina/Mendoza"},
+	{"Salta", -24.78, -65.41, "America/Argentina/Salta"},
+	{"Rio Gallegos", -51.62, -69.22, "America/Argentina/Rio_Gallegos"},
+	{"Ushuaia", -54.80, -68.30, "America/Argentina/Ushuaia"},
+	{"Asuncion", -25.26, -57.58, "America/Asuncion"},
+	{"Montevideo", -34.90, -56.16, "America/Montevideo"},
+	{"Sao Paulo", -23.55, -46.63, "America/Sao_Paulo"},
+	{"Rio de Janeiro", -22.91, -43.17, "America/Sao_Paulo"},
+	{"Brasilia", -15.79, -47.88, "America/Sao_Paulo"},
+	{"Belo Horizonte", -19.92, -43.94, "America/Sao_Paulo"},
+	{"Porto Alegre", -30.03, -51.23, "America/Sao_Paulo"},
+	{"Salvador", -12.97, -38.50, "America/Bahia"},
+	{"Recife", -8.05, -34.88, "America/Recife"},
+	{"Fortaleza", -3.73, -38.53, "America/Fortaleza"},
+	{"Belem", -1.46, -48.50, "America/Belem"},
+	{"Araguaina", -7.19, -48.21, "America/Araguaina"},
+	{"Santarem", -2.44, -54.71, "America/Santarem"},
+	{"Manaus", -3.12, -60.02, "America/Manaus"},
+	{"Tabatinga", -4.25, -69.94, "America/Manaus"},
+	{"Sao Gabriel da Cachoeira", -0.13, -67.09, "America/Manaus"},
+	{"Boa Vista", 2.82, -60.67, "America/Boa_Vista"},
+	{"Porto Velho", -8.76, -63.90, "America/Porto_Velho"},
+	{"Rio Branco", -9.97, -67.81, "America/Rio_Branco"},
+	{"Cuiaba", -15.60, -56.10, "America/Cuiaba"},
+	{"Campo Grande", -20.47, -54.62, "America/Campo_Grande"},
+	{"Fernando de Noronha", -3.85, -32.42, "America/Noronha"},
+	{"Stanley", -51.70, -57.85, "Atlantic/Stanley"},
+	{"Grytviken", -54.28, -36.51, "Atlantic/South_Georgia"},
+
+	// Oceania.
+	{"Sydney", -33.87, 151.21, "Australia/Sydney"},
+	{"Canberra", -35.28, 149.13, "Australia/Sydney"},
+	{"Broken Hill", -31.95, 141.45, "Australia/Broken_Hill"},
+	{"Melbourne", -37.81, 144.96, "Australia/Melbourne"},
+	{"Hobart", -42.88, 147.33, "Australia/Hobart"},
+	{"Brisbane", -27.47, 153.03, "Australia/Brisbane"},
+	{"Townsville", -19.26, 146.82, "Australia/Brisbane"},
+	{"Cairns", -16.92, 145.77, "Australia/Brisbane"},
+	{"Mount Isa", -20.73, 139.49, "Australia/Brisbane"},
+	{"Adelaide", -34.93, 138.60, "Australia/Adelaide"},
+	{"Coober Pedy", -29.01, 134.75, "Australia/Adelaide"},
+	{"Darwin", -12.46, 130.84, "Australia/Darwin"},
+	{"Katherine", -14.47, 132.26, "Australia/Darwin"},
+	{"Tennant Creek", -19.65, 134.19, "Australia/Darwin"},
+	{"Alice Springs", -23.70, 133.88, "Australia/Darwin"},
+	{"Eucla", -31.68, 128.88, "Australia/Eucla"},
+	{"Perth", -31.95, 115.86, "Australia/Perth"},
+	{"Kalgoorlie", -30.75, 121.47, "Australia/Perth"},
+	{"Port Hedland", -20.31, 118.58, "Australia/Perth"},
+	{"Broome", -17.96, 122.24, "Australia/Perth"},
+	{"Lord Howe Island", -31.55, 159.08, "Australia/Lord_Howe"},
+	{"Kingston", -29.04, 167.95, "Pacific/Norfolk"},
+	{"Auckland", -36.85, 174.76, "Pacific/Auckland"},
+	{"Wellington", -41.29, 174.78, "Pacific/Auckland"},
+	{"Christchurch", -43.53, 172.64, "Pacific/Auckland"},
+	{"Waitangi", -43.95, -176.56, "Pacific/Chatham"},
+	{"Port Moresby", -9.44, 147.18, "Pacific/Port_Moresby"},
+	{"Lae", -6.73, 147.00, "Pacific/Port_Moresby"},
+	{"Arawa", -6.23, 155.57, "Pacific/Bougainville"},
+	{"Honiara", -9.43, 159.95, "Pacific/Guadalcanal"},
+	{"Noumea", -22.27, 166.46, "Pacific/Noumea"},
+	{"Port Vila", -17.73, 168.32, "Pacific/Efate"},
+	{"Suva", -18.14, 178.44, "Pacific/Fiji"},
+	{"Nuku'alofa", -21.14, -175.20, "Pacific/Tongatapu"},
+	{"Apia", -13.83, -171.76, "Pacific/Apia"},
+	{"Pago Pago", -14.28, -170.70, "Pacific/Pago_Pago"},
+	{"Mata-Utu", -13.28, -176.17, "Pacific/Wallis"},
+	{"Funafuti", -8.52, 179.20, "Pacific/Funafuti"},
+	{"Fakaofo", -9.38, -171.25, "Pacific/Fakaofo"},
+	{"Alofi", -19.06, -169.92, "Pacific/Niue"},
+	{"Avarua", -21.21, -159.78, "Pacific/Rarotonga"},
+	{"Papeete", -17.54, -149.57, "Pacific/Tahiti"},
+	{"Taiohae", -9.78, -139.03, "Pacific/Marquesas"},
+	{"Rikitea", -23.12, -134.97, "Pacific/Gambier"},
+	{"Adamstown", -25.07, -130.10, "Pacific/Pitcairn"},
+	{"Tarawa", 1.45, 173.00, "Pacific/Tarawa"},
+	{"Kiritimati", 1.87, -157.43, "Pacific/Kiritimati"},
+	{"Yaren", -0.53, 166.92, "Pacific/Nauru"},
+	{"Majuro", 7.09, 171.38, "Pacific/Majuro"},
+	{"Kwajalein", 9.19, 167.42, "Pacific/Kwajalein"},
+	{"Palikir", 6.96, 158.21, "Pacific/Pohnpei"},
+	{"Weno", 7.45, 151.85, "Pacific/Chuuk"},
+	{"Tofol", 5.32, 162.98, "Pacific/Kosrae"},
+	{"Koror", 7.34, 134.48, "Pacific/Palau"},
+	{"Hagatna", 13.44, 144.79, "Pacific/Guam"},
+	{"Saipan", 15.18, 145.75, "Pacific/Saipan"},
+}
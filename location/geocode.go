@@ -0,0 +1,65 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrPlaceNotFound is returned by a Geocoder that knows no place matching a
+// query.
+var ErrPlaceNotFound = errors.New("place not found")
+
+// Place is a named place and its coordinates in degrees, positive north and
+// east.
+type Place struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+}
+
+// Geocoder resolves place names, such as "Madurai, India", to coordinates.
+type Geocoder interface {
+	Geocode(ctx context.Context, query string) (Place, error)
+}
+
+// Gazetteer is an offline Geocoder of the reference cities of TimezoneName.
+// It matches the whole query, or failing that its part before the first
+// comma, with the names of the cities regardless of case, so it knows
+// "Madurai, India" but not smaller places.
+type Gazetteer struct{}
+
+// Geocode returns the city named by query.
+func (Gazetteer) Geocode(_ context.Context, query string) (Place, error) {
+	normalized := normalizePlace(query)
+	name, _, _ := strings.Cut(normalized, ",")
+	for _, q := range []string{normalized, strings.TrimSpace(name)} {
+		for _, c := range cities {
+			if normalizePlace(c.name) == q {
+				return Place{Name: c.name, Latitude: c.latitude, Longitude: c.longitude}, nil
+			}
+		}
+	}
+	return Place{}, fmt.Errorf("%w: %q", ErrPlaceNotFound, query)
+}
+
+// normalizePlace lowers the case of a place name and collapses its spaces.
+func normalizePlace(s string) string {
+	return strings.ToLower(strings.Join(strings.Fields(s), " "))
+}
+
+// Geocoders is a Geocoder trying each of its geocoders in turn until one
+// finds the place, e.g. the Gazetteer before a slower online geocoder.
+type Geocoders []Geocoder
+
+// Geocode returns the place found by the first geocoder knowing query.
+func (gs Geocoders) Geocode(ctx context.Context, query string) (Place, error) {
+	for _, g := range gs {
+		p, err := g.Geocode(ctx, query)
+		if !errors.Is(err, ErrPlaceNotFound) {
+			return p, err
+		}
+	}
+	return Place{}, fmt.Errorf("%w: %q", ErrPlaceNotFound, query)
+}
@@ -0,0 +1,72 @@
+package location
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGazetteer(t *testing.T) {
+	for _, tt := range []struct {
+		query string
+		want  string
+	}{
+		{"Madurai, India", "Madurai"},
+		{"  new   YORK ", "New York"},
+		{"Hyderabad", "Hyderabad"},
+		{"Hyderabad, Sindh", "Hyderabad, Sindh"},
+	} {
+		p, err := Gazetteer{}.Geocode(context.Background(), tt.query)
+		if err != nil {
+			t.Errorf("Geocode(%q) error = %v", tt.query, err)
+			continue
+		}
+		if p.Name != tt.want {
+			t.Errorf("Geocode(%q) = %s, want %s", tt.query, p.Name, tt.want)
+		}
+	}
+	if _, err := (Gazetteer{}).Geocode(context.Background(), "Atlantis"); !errors.Is(err, ErrPlaceNotFound) {
+		t.Errorf("Geocode(Atlantis) error = %v, want ErrPlaceNotFound", err)
+	}
+}
+
+func TestNominatimGeocoder(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("User-Agent") == "" {
+			t.Error("request has no User-Agent")
+		}
+		switch r.URL.Query().Get("q") {
+		case "Mysuru, India":
+			fmt.Fprint(w, `[{"lat":"12.3051828","lon":"76.6553609","display_name":"Mysuru, Karnataka, India"}]`)
+		default:
+			fmt.Fprint(w, `[]`)
+		}
+	}))
+	defer server.Close()
+
+	n := NewNominatimGeocoder(WithNominatimURL(server.URL))
+	p, err := n.Geocode(context.Background(), "Mysuru, India")
+	if err != nil {
+		t.Fatalf("Geocode() error = %v", err)
+	}
+	want := Place{Name: "Mysuru, Karnataka, India", Latitude: 12.3051828, Longitude: 76.6553609}
+	if p != want {
+		t.Errorf("Geocode() = %+v, want %+v", p, want)
+	}
+	if _, err := n.Geocode(context.Background(), "Atlantis"); !errors.Is(err, ErrPlaceNotFound) {
+		t.Errorf("Geocode(Atlantis) error = %v, want ErrPlaceNotFound", err)
+	}
+
+	// The gazetteer answers first and the geocoder only when it does not
+	// know the place.
+	g := Geocoders{Gazetteer{}, n}
+	if p, err := g.Geocode(context.Background(), "Madurai, India"); err != nil || p.Name != "Madurai" {
+		t.Errorf("Geocoders.Geocode(Madurai) = %+v, %v", p, err)
+	}
+	if p, err := g.Geocode(context.Background(), "Mysuru, India"); err != nil || p != want {
+		t.Errorf("Geocoders.Geocode(Mysuru) = %+v, %v", p, err)
+	}
+}
@@ -0,0 +1,110 @@
+package location
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// NominatimURL is the address of the search API of the OpenStreetMap
+// Nominatim service.
+const NominatimURL = "https://nominatim.openstreetmap.org/search"
+
+// nominatimUserAgent identifies requests, as the Nominatim usage policy
+// requires.
+const nominatimUserAgent = "panchangam (https://github.com/naren-m/panchangam)"
+
+// NominatimGeocoder geocodes places with the OpenStreetMap Nominatim
+// service, which knows far more places than the Gazetteer. Its usage policy
+// allows at most one request a second, so callers should cache the places
+// it returns.
+type NominatimGeocoder struct {
+	url    string
+	client *http.Client
+}
+
+// NominatimOption configures a NominatimGeocoder.
+type NominatimOption func(*NominatimGeocoder)
+
+// WithNominatimURL sends requests to url instead of NominatimURL.
+func WithNominatimURL(url string) NominatimOption {
+	return func(n *NominatimGeocoder) {
+		n.url = url
+	}
+}
+
+// WithHTTPClient sends requests with client instead of a client with a 10
+// second timeout.
+func WithHTTPClient(client *http.Client) NominatimOption {
+	return func(n *NominatimGeocoder) {
+		n.client = client
+	}
+}
+
+// NewNominatimGeocoder returns a geocoder querying the Nominatim API.
+func NewNominatimGeocoder(opts ...NominatimOption) *NominatimGeocoder {
+	n := &NominatimGeocoder{
+		url:    NominatimURL,
+		client: &http.Client{Timeout: 10 * time.Second},
+	}
+	for _, opt := range opts {
+		opt(n)
+	}
+	return n
+}
+
+// nominatimResult is a search result of the Nominatim API, which formats
+// coordinates as strings.
+type nominatimResult struct {
+	DisplayName string `json:"display_name"`
+	Lat         string `json:"lat"`
+	Lon         string `json:"lon"`
+}
+
+// Geocode returns the best match of query.
+func (n *NominatimGeocoder) Geocode(ctx context.Context, query string) (Place, error) {
+	params := url.Values{
+		"q":      {query},
+		"format": {"jsonv2"},
+		"limit":  {"1"},
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, n.url+"?"+params.Encode(), nil)
+	if err != nil {
+		return Place{}, err
+	}
+	req.Header.Set("User-Agent", nominatimUserAgent)
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return Place{}, fmt.Errorf("nominatim: %w", err)
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Place{}, fmt.Errorf("nominatim: reading response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Place{}, fmt.Errorf("nominatim: %s", resp.Status)
+	}
+	var results []nominatimResult
+	if err := json.Unmarshal(body, &results); err != nil {
+		return Place{}, fmt.Errorf("nominatim: decoding response: %w", err)
+	}
+	if len(results) == 0 {
+		return Place{}, fmt.Errorf("%w: %q", ErrPlaceNotFound, query)
+	}
+	r := results[0]
+	lat, err := strconv.ParseFloat(r.Lat, 64)
+	if err != nil {
+		return Place{}, fmt.Errorf("nominatim: invalid latitude %q", r.Lat)
+	}
+	lon, err := strconv.ParseFloat(r.Lon, 64)
+	if err != nil {
+		return Place{}, fmt.Errorf("nominatim: invalid longitude %q", r.Lon)
+	}
+	return Place{Name: r.DisplayName, Latitude: lat, Longitude: lon}, nil
+}
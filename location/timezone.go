@@ -0,0 +1,75 @@
+// Package location resolves facts about observer locations, such as the
+// timezone at a latitude and longitude or the coordinates of a place name,
+// shared by the client and the service.
+package location
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// earthRadius is the mean radius of the earth in kilometres.
+const earthRadius = 6371.0
+
+// maxCityDistance is the distance in kilometres beyond which the nearest
+// reference city is considered too far away to share its timezone.
+const maxCityDistance = 1200.0
+
+// TimezoneName returns the IANA timezone name at latitude and longitude in
+// degrees, positive north and east.
+//
+// The timezone is that of the nearest of a table of reference cities, so it
+// is approximate within a few tens of kilometres of a border. Away from any
+// reference city, e.g. at sea, it is the nautical timezone Etc/GMT±N of the
+// longitude.
+func TimezoneName(latitude, longitude float64) string {
+	best, bestDistance := "", math.Inf(1)
+	for _, c := range cities {
+		if d := distance(latitude, longitude, c.latitude, c.longitude); d < bestDistance {
+			best, bestDistance = c.zone, d
+		}
+	}
+	if bestDistance <= maxCityDistance {
+		return best
+	}
+	return nauticalZone(longitude)
+}
+
+// Timezone returns the timezone at latitude and longitude, as TimezoneName.
+func Timezone(latitude, longitude float64) (*time.Location, error) {
+	name := TimezoneName(latitude, longitude)
+	tz, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, fmt.Errorf("loading timezone %s of %g, %g: %w", name, latitude, longitude, err)
+	}
+	return tz, nil
+}
+
+// nauticalZone returns the Etc/GMT±N timezone of longitude, each 15° wide.
+// The sign of Etc names is inverted: Etc/GMT-5 is five hours ahead of UTC.
+func nauticalZone(longitude float64) string {
+	offset := int(math.Round(longitude / 15))
+	switch {
+	case offset > 12:
+		offset = 12
+	case offset < -12:
+		offset = -12
+	}
+	switch {
+	case offset > 0:
+		return fmt.Sprintf("Etc/GMT-%d", offset)
+	case offset < 0:
+		return fmt.Sprintf("Etc/GMT+%d", -offset)
+	}
+	return "Etc/GMT"
+}
+
+// distance returns the great circle distance in kilometres between two
+// points given in degrees.
+func distance(lat1, lon1, lat2, lon2 float64) float64 {
+	phi1, phi2 := lat1*math.Pi/180, lat2*math.Pi/180
+	dPhi, dLambda := phi2-phi1, (lon2-lon1)*math.Pi/180
+	a := math.Sin(dPhi/2)*math.Sin(dPhi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dLambda/2)*math.Sin(dLambda/2)
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
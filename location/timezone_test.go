@@ -0,0 +1,41 @@
+package location
+
+import "testing"
+
+func TestTimezoneName(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		lat, lon float64
+		want     string
+	}{
+		{"Mysuru", 12.30, 76.64, "Asia/Kolkata"},
+		{"Amritsar", 31.63, 74.87, "Asia/Kolkata"},
+		{"Lahore", 31.52, 74.36, "Asia/Karachi"},
+		{"Lumbini", 27.48, 83.28, "Asia/Kathmandu"},
+		{"Dhaka", 23.78, 90.40, "Asia/Dhaka"},
+		{"Leicester", 52.64, -1.13, "Europe/London"},
+		{"Edison", 40.52, -74.41, "America/New_York"},
+		{"Fremont", 37.55, -121.99, "America/Los_Angeles"},
+		{"Alice Springs", -23.70, 133.88, "Australia/Darwin"},
+		{"Suva", -18.14, 178.44, "Pacific/Fiji"},
+		{"mid Pacific", 0, -125, "Etc/GMT+8"},
+		{"south Indian Ocean", -35, 80, "Etc/GMT-5"},
+		{"Antarctica", -85, 0, "Etc/GMT"},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := TimezoneName(tt.lat, tt.lon); got != tt.want {
+				t.Errorf("TimezoneName(%g, %g) = %s, want %s", tt.lat, tt.lon, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimezone(t *testing.T) {
+	tz, err := Timezone(13.08, 80.27)
+	if err != nil {
+		t.Fatalf("Timezone() error = %v", err)
+	}
+	if tz.String() != "Asia/Kolkata" {
+		t.Errorf("Timezone() = %s, want Asia/Kolkata", tz)
+	}
+}
@@ -14,9 +14,12 @@ import (
 var logger *slog.Logger
 var initOnce sync.Once
 
+// level is the minimum level of the records logger logs, info by default.
+var level = new(slog.LevelVar)
+
 func init() {
 	initOnce.Do(func() {
-		logger = slog.New(NewHandler(slog.NewTextHandler(os.Stdout, nil)))
+		logger = slog.New(NewHandler(slog.NewTextHandler(os.Stdout, &slog.HandlerOptions{Level: level})))
 	})
 }
 
@@ -24,6 +27,17 @@ func Logger() *slog.Logger {
 	return logger
 }
 
+// SetLevel sets the minimum level of the records Logger logs, taking effect
+// at once for every logger derived from it.
+func SetLevel(l slog.Level) {
+	level.Set(l)
+}
+
+// Level returns the minimum level of the records Logger logs.
+func Level() slog.Level {
+	return level.Level()
+}
+
 // A Handler wraps a Handler with an Enabled method
 // that returns false for levels below a minimum.
 type Handler struct {
@@ -46,9 +60,13 @@ func (h *Handler) Enabled(ctx context.Context, level slog.Level) bool {
 	return h.handler.Enabled(ctx, level)
 }
 
-// Handle implements Handler.Handle.
+// Handle implements Handler.Handle. Records logged with a context carrying
+// a request ID are given it as their request_id attribute.
 func (h *Handler) Handle(ctx context.Context, r slog.Record) error {
 	if ctx != nil {
+		if id := RequestID(ctx); id != "" {
+			r.AddAttrs(slog.String("request_id", id))
+		}
 		span := observability.SpanFromContext(ctx)
 		if span == nil || !span.IsRecording() {
 			r.Message = fmt.Sprintf("span not found: %s", r.Message)
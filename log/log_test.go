@@ -234,3 +234,17 @@ func BenchmarkLogging(b *testing.B) {
 		}
 	}
 }
+
+func TestSetLevel(t *testing.T) {
+	defer SetLevel(Level())
+	ctx := context.Background()
+	assert.False(t, Logger().Enabled(ctx, slog.LevelDebug), "debug records are logged by default")
+
+	SetLevel(slog.LevelDebug)
+	assert.Equal(t, slog.LevelDebug, Level())
+	assert.True(t, Logger().Enabled(ctx, slog.LevelDebug))
+	assert.True(t, Logger().With("key", "value").Enabled(ctx, slog.LevelDebug), "derived loggers follow the level")
+
+	SetLevel(slog.LevelError)
+	assert.False(t, Logger().Enabled(ctx, slog.LevelWarn))
+}
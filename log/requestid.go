@@ -0,0 +1,98 @@
+package log
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// RequestIDHeader is the HTTP header, and the gRPC metadata key, carrying
+// the ID that correlates the logs of a request in the gateway and the
+// server.
+const RequestIDHeader = "x-request-id"
+
+// maxRequestIDLength bounds the length of the request IDs accepted from
+// callers.
+const maxRequestIDLength = 128
+
+type requestIDKey struct{}
+
+// WithRequestID returns a context carrying the request ID id, which the
+// records logged with it are given as their request_id attribute.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, id)
+}
+
+// RequestID returns the request ID of ctx, or "" if it has none.
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(requestIDKey{}).(string)
+	return id
+}
+
+// NewRequestID returns a random request ID.
+func NewRequestID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// ValidRequestID reports whether a request ID sent by a caller may be used:
+// it must be at most 128 printable ASCII characters, so that it cannot
+// forge log lines or headers.
+func ValidRequestID(id string) bool {
+	if id == "" || len(id) > maxRequestIDLength {
+		return false
+	}
+	for i := 0; i < len(id); i++ {
+		if id[i] <= ' ' || id[i] > '~' {
+			return false
+		}
+	}
+	return true
+}
+
+// incomingRequestID returns the valid request ID of the incoming metadata
+// of ctx, or a new one.
+func incomingRequestID(ctx context.Context) string {
+	md, _ := metadata.FromIncomingContext(ctx)
+	if ids := md.Get(RequestIDHeader); len(ids) > 0 && ValidRequestID(ids[0]) {
+		return ids[0]
+	}
+	return NewRequestID()
+}
+
+// RequestIDInterceptor gives every RPC the request ID of its metadata, or a
+// new one, so that the records logged while handling it carry the ID. The
+// ID is sent back in the response header.
+func RequestIDInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		id := incomingRequestID(ctx)
+		if err := grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id)); err != nil {
+			logger.WarnContext(ctx, "Failed to send the request ID", "error", err)
+		}
+		return handler(WithRequestID(ctx, id), req)
+	}
+}
+
+// StreamRequestIDInterceptor is RequestIDInterceptor for streaming RPCs.
+func StreamRequestIDInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+		id := incomingRequestID(ctx)
+		if err := ss.SetHeader(metadata.Pairs(RequestIDHeader, id)); err != nil {
+			logger.WarnContext(ctx, "Failed to send the request ID", "error", err)
+		}
+		return handler(srv, &requestIDStream{ServerStream: ss, ctx: WithRequestID(ctx, id)})
+	}
+}
+
+// requestIDStream is a server stream whose context carries a request ID.
+type requestIDStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *requestIDStream) Context() context.Context { return s.ctx }
@@ -0,0 +1,61 @@
+package log
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestValidRequestID(t *testing.T) {
+	for id, want := range map[string]bool{
+		"":                         false,
+		"4bf92f3577b34da6":         true,
+		"abc def":                  false,
+		"abc\nlevel=ERROR":         false,
+		strings.Repeat("a", 128):   true,
+		strings.Repeat("a", 129):   false,
+		"req-2024/03/11:Ünicode":   false,
+		"req-2024/03/11:00:00.123": true,
+	} {
+		if got := ValidRequestID(id); got != want {
+			t.Errorf("ValidRequestID(%q) = %v, want %v", id, got, want)
+		}
+	}
+	if id := NewRequestID(); !ValidRequestID(id) || id == NewRequestID() {
+		t.Errorf("NewRequestID() = %q, want a valid unique ID", id)
+	}
+}
+
+func TestHandlerLogsRequestID(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(NewHandler(slog.NewTextHandler(&buf, nil)))
+	l.InfoContext(WithRequestID(context.Background(), "req-1"), "Handled")
+	if !strings.Contains(buf.String(), "request_id=req-1") {
+		t.Errorf("record %q lacks the request ID", buf.String())
+	}
+}
+
+func TestRequestIDInterceptor(t *testing.T) {
+	var got string
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		got = RequestID(ctx)
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/panchangam.Panchangam/Get"}
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "req-1"))
+	RequestIDInterceptor()(ctx, nil, info, handler)
+	if got != "req-1" {
+		t.Errorf("RequestID() = %q, want the ID of the metadata", got)
+	}
+
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs(RequestIDHeader, "bad id"))
+	RequestIDInterceptor()(ctx, nil, info, handler)
+	if got == "bad id" || !ValidRequestID(got) {
+		t.Errorf("RequestID() = %q, want a new ID replacing the invalid one", got)
+	}
+}
@@ -0,0 +1,338 @@
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"sync"
+	"time"
+)
+
+// Control packet types of MQTT 3.1.1, in the high nibble of the first byte
+// of a packet.
+const (
+	connectPacket    = 1
+	connackPacket    = 2
+	publishPacket    = 3
+	pingreqPacket    = 12
+	pingrespPacket   = 13
+	disconnectPacket = 14
+)
+
+// DefaultKeepAlive is the keep alive interval of a Client, within which it
+// sends a packet to the broker.
+const DefaultKeepAlive = 60 * time.Second
+
+// connackErrors names the return codes of a refused connection.
+var connackErrors = map[byte]string{
+	1: "unacceptable protocol version",
+	2: "identifier rejected",
+	3: "server unavailable",
+	4: "bad user name or password",
+	5: "not authorized",
+}
+
+// ErrRefused is returned by Dial when the broker refuses the connection.
+var ErrRefused = errors.New("mqtt connection refused")
+
+// Message is a message published to a topic.
+type Message struct {
+	Topic   string
+	Payload []byte
+	// Retain asks the broker to keep the message and deliver it to later
+	// subscribers of the topic.
+	Retain bool
+}
+
+// ClientOptions configures a connection to a broker.
+type ClientOptions struct {
+	ClientID string
+	Username string
+	Password string
+	// KeepAlive is DefaultKeepAlive when zero.
+	KeepAlive time.Duration
+	// Will is published by the broker when the connection is lost.
+	Will *Message
+	// TLSConfig configures the connections to mqtts:// and ssl:// brokers.
+	TLSConfig *tls.Config
+}
+
+// Client is a connection to an MQTT 3.1.1 broker, publishing messages with
+// QoS 0. It implements the subset of the protocol the Publisher needs.
+type Client struct {
+	conn      net.Conn
+	r         *bufio.Reader
+	keepAlive time.Duration
+
+	mu   sync.Mutex // serializes writes
+	done chan struct{}
+	err  error
+}
+
+// Dial connects to the broker at addr, a tcp://, mqtt://, ssl:// or
+// mqtts:// URL, or a bare host:port, and starts keeping the connection
+// alive.
+func Dial(ctx context.Context, addr string, opts ClientOptions) (*Client, error) {
+	network, hostport, useTLS, err := parseBroker(addr)
+	if err != nil {
+		return nil, err
+	}
+	var d net.Dialer
+	conn, err := d.DialContext(ctx, network, hostport)
+	if err != nil {
+		return nil, err
+	}
+	if useTLS {
+		cfg := opts.TLSConfig
+		if cfg == nil {
+			cfg = &tls.Config{}
+		}
+		if cfg.ServerName == "" {
+			cfg = cfg.Clone()
+			cfg.ServerName, _, _ = net.SplitHostPort(hostport)
+		}
+		tlsConn := tls.Client(conn, cfg)
+		if err := tlsConn.HandshakeContext(ctx); err != nil {
+			conn.Close()
+			return nil, err
+		}
+		conn = tlsConn
+	}
+	if opts.KeepAlive <= 0 {
+		opts.KeepAlive = DefaultKeepAlive
+	}
+	c := &Client{conn: conn, r: bufio.NewReader(conn), keepAlive: opts.KeepAlive, done: make(chan struct{})}
+	if deadline, ok := ctx.Deadline(); ok {
+		conn.SetDeadline(deadline)
+	}
+	if err := c.connect(opts); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	conn.SetDeadline(time.Time{})
+	go c.read()
+	go c.ping()
+	return c, nil
+}
+
+// parseBroker returns the network and address of the broker at addr and
+// whether it is reached over TLS.
+func parseBroker(addr string) (network, hostport string, useTLS bool, err error) {
+	u, err := url.Parse(addr)
+	if err != nil || u.Host == "" {
+		// A bare host:port parses as a URL with the host as scheme.
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return "", "", false, fmt.Errorf("invalid broker %q: expected tcp://host:port or host:port", addr)
+		}
+		return "tcp", addr, false, nil
+	}
+	port := u.Port()
+	switch u.Scheme {
+	case "tcp", "mqtt":
+		if port == "" {
+			port = "1883"
+		}
+	case "ssl", "tls", "mqtts":
+		useTLS = true
+		if port == "" {
+			port = "8883"
+		}
+	default:
+		return "", "", false, fmt.Errorf("invalid broker %q: unknown scheme %q", addr, u.Scheme)
+	}
+	return "tcp", net.JoinHostPort(u.Hostname(), port), useTLS, nil
+}
+
+// connect sends the CONNECT packet and reads the CONNACK.
+func (c *Client) connect(opts ClientOptions) error {
+	var body []byte
+	body = appendString(body, "MQTT")
+	body = append(body, 4) // protocol level 3.1.1
+	flags := byte(0x02)    // clean session
+	if opts.Will != nil {
+		flags |= 0x04
+		if opts.Will.Retain {
+			flags |= 0x20
+		}
+	}
+	if opts.Username != "" {
+		flags |= 0x80
+		if opts.Password != "" {
+			flags |= 0x40
+		}
+	}
+	body = append(body, flags)
+	body = binary.BigEndian.AppendUint16(body, uint16(opts.KeepAlive/time.Second))
+	body = appendString(body, opts.ClientID)
+	if opts.Will != nil {
+		body = appendString(body, opts.Will.Topic)
+		body = appendString(body, string(opts.Will.Payload))
+	}
+	if opts.Username != "" {
+		body = appendString(body, opts.Username)
+		if opts.Password != "" {
+			body = appendString(body, opts.Password)
+		}
+	}
+	if err := c.write(connectPacket<<4, body); err != nil {
+		return err
+	}
+	kind, resp, err := readPacket(c.r)
+	if err != nil {
+		return fmt.Errorf("reading CONNACK: %w", err)
+	}
+	if kind>>4 != connackPacket || len(resp) != 2 {
+		return fmt.Errorf("%w: unexpected packet type %d", ErrRefused, kind>>4)
+	}
+	if code := resp[1]; code != 0 {
+		if msg, ok := connackErrors[code]; ok {
+			return fmt.Errorf("%w: %s", ErrRefused, msg)
+		}
+		return fmt.Errorf("%w: return code %d", ErrRefused, code)
+	}
+	return nil
+}
+
+// Publish publishes m with QoS 0.
+func (c *Client) Publish(m Message) error {
+	header := byte(publishPacket << 4)
+	if m.Retain {
+		header |= 0x01
+	}
+	body := appendString(nil, m.Topic)
+	body = append(body, m.Payload...)
+	return c.write(header, body)
+}
+
+// Done is closed when the connection is lost or closed; Err then returns
+// why.
+func (c *Client) Done() <-chan struct{} {
+	return c.done
+}
+
+// Err returns the error that ended the connection once Done is closed.
+func (c *Client) Err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.err
+}
+
+// Close disconnects from the broker cleanly, so that it does not publish
+// the will.
+func (c *Client) Close() error {
+	err := c.write(disconnectPacket<<4, nil)
+	c.fail(net.ErrClosed)
+	return err
+}
+
+// write sends a packet with the given first byte and body.
+func (c *Client) write(header byte, body []byte) error {
+	packet := append([]byte{header}, remainingLength(len(body))...)
+	packet = append(packet, body...)
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return c.err
+	}
+	c.conn.SetWriteDeadline(time.Now().Add(c.keepAlive))
+	_, err := c.conn.Write(packet)
+	return err
+}
+
+// fail ends the connection with err, the first failure winning.
+func (c *Client) fail(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.err != nil {
+		return
+	}
+	c.err = err
+	c.conn.Close()
+	close(c.done)
+}
+
+// read consumes the packets the broker sends, PINGRESPs only as the client
+// subscribes to nothing, until the connection ends.
+func (c *Client) read() {
+	for {
+		c.conn.SetReadDeadline(time.Now().Add(c.keepAlive * 3 / 2))
+		if _, _, err := readPacket(c.r); err != nil {
+			c.fail(err)
+			return
+		}
+	}
+}
+
+// ping sends a PINGREQ every keep alive interval, which also tells the
+// client the broker is alive as it answers.
+func (c *Client) ping() {
+	ticker := time.NewTicker(c.keepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.done:
+			return
+		case <-ticker.C:
+			if err := c.write(pingreqPacket<<4, nil); err != nil {
+				c.fail(err)
+				return
+			}
+		}
+	}
+}
+
+// readPacket reads a packet, returning its first byte and body.
+func readPacket(r *bufio.Reader) (byte, []byte, error) {
+	header, err := r.ReadByte()
+	if err != nil {
+		return 0, nil, err
+	}
+	length, multiplier := 0, 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return 0, nil, errors.New("malformed remaining length")
+		}
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		length += int(b&0x7f) * multiplier
+		multiplier *= 128
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	body := make([]byte, length)
+	if _, err := io.ReadFull(r, body); err != nil {
+		return 0, nil, err
+	}
+	return header, body, nil
+}
+
+// remainingLength encodes the length of the body of a packet.
+func remainingLength(n int) []byte {
+	var b []byte
+	for {
+		digit := byte(n % 128)
+		n /= 128
+		if n > 0 {
+			digit |= 0x80
+		}
+		b = append(b, digit)
+		if n == 0 {
+			return b
+		}
+	}
+}
+
+// appendString appends s prefixed with its length.
+func appendString(b []byte, s string) []byte {
+	b = binary.BigEndian.AppendUint16(b, uint16(len(s)))
+	return append(b, s...)
+}
@@ -0,0 +1,89 @@
+package mqtt
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// entity is a Home Assistant entity declared by discovery, whose value is a
+// field of the State.
+type entity struct {
+	// component is sensor or binary_sensor.
+	component string
+	// field is the JSON field of the State holding the value.
+	field       string
+	name        string
+	icon        string
+	deviceClass string
+}
+
+var entities = []entity{
+	{component: "sensor", field: "tithi", name: "Tithi", icon: "mdi:moon-waxing-crescent"},
+	{component: "sensor", field: "nakshatra", name: "Nakshatra", icon: "mdi:star-four-points"},
+	{component: "sensor", field: "yoga", name: "Yoga", icon: "mdi:yin-yang"},
+	{component: "sensor", field: "karana", name: "Karana", icon: "mdi:circle-half-full"},
+	{component: "sensor", field: "vara", name: "Vara", icon: "mdi:calendar-week"},
+	{component: "binary_sensor", field: "rahu_kalam", name: "Rahu Kalam", icon: "mdi:alert-circle-outline"},
+	{component: "binary_sensor", field: "yamagandam", name: "Yamagandam", icon: "mdi:alert-circle-outline"},
+	{component: "binary_sensor", field: "gulika_kalam", name: "Gulika Kalam", icon: "mdi:alert-circle-outline"},
+	{component: "sensor", field: "next_sunrise", name: "Next sunrise", deviceClass: "timestamp"},
+	{component: "sensor", field: "next_sunset", name: "Next sunset", deviceClass: "timestamp"},
+}
+
+// discoveryConfig is the payload of a discovery message, see
+// https://www.home-assistant.io/integrations/mqtt/#mqtt-discovery.
+type discoveryConfig struct {
+	Name              string          `json:"name"`
+	UniqueID          string          `json:"unique_id"`
+	ObjectID          string          `json:"object_id"`
+	StateTopic        string          `json:"state_topic"`
+	ValueTemplate     string          `json:"value_template"`
+	AvailabilityTopic string          `json:"availability_topic"`
+	Icon              string          `json:"icon,omitempty"`
+	DeviceClass       string          `json:"device_class,omitempty"`
+	Device            discoveryDevice `json:"device"`
+}
+
+type discoveryDevice struct {
+	Identifiers  []string `json:"identifiers"`
+	Name         string   `json:"name"`
+	Manufacturer string   `json:"manufacturer"`
+	Model        string   `json:"model"`
+}
+
+// Discovery returns the retained Home Assistant discovery messages
+// declaring the entities of the state of the publisher, grouped in a
+// device named after its place.
+func (p *Publisher) Discovery() []Message {
+	device := discoveryDevice{
+		Identifiers:  []string{"panchangam_" + p.cfg.Node},
+		Name:         p.cfg.Name,
+		Manufacturer: "Panchangam",
+		Model:        fmt.Sprintf("Panchangam at %.4f, %.4f", p.cfg.Latitude, p.cfg.Longitude),
+	}
+	msgs := make([]Message, 0, len(entities))
+	for _, e := range entities {
+		id := "panchangam_" + p.cfg.Node + "_" + e.field
+		template := fmt.Sprintf("{{ value_json.%s }}", e.field)
+		if e.component == "binary_sensor" {
+			template = fmt.Sprintf("{{ 'ON' if value_json.%s else 'OFF' }}", e.field)
+		}
+		payload, _ := json.Marshal(discoveryConfig{
+			Name:              e.name,
+			UniqueID:          id,
+			ObjectID:          id,
+			StateTopic:        p.StateTopic(),
+			ValueTemplate:     template,
+			AvailabilityTopic: p.AvailabilityTopic(),
+			Icon:              e.icon,
+			DeviceClass:       e.deviceClass,
+			Device:            device,
+		})
+		msgs = append(msgs, Message{
+			Topic:   fmt.Sprintf("%s/%s/panchangam_%s/%s/config", p.cfg.DiscoveryPrefix, e.component, p.cfg.Node, e.field),
+			Payload: payload,
+			Retain:  true,
+		})
+	}
+	return msgs
+}
@@ -0,0 +1,168 @@
+package mqtt
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+var bengaluru = astronomy.Location{Latitude: 12.9716, Longitude: 77.5946}
+
+func kolkata(t *testing.T) *time.Location {
+	t.Helper()
+	zone, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return zone
+}
+
+func TestComputeState(t *testing.T) {
+	zone := kolkata(t)
+	// Rahu Kalam starts about 15:26 on Tuesday 9 April 2024.
+	s, err := ComputeState(bengaluru, zone, time.Date(2024, 4, 9, 15, 40, 0, 0, zone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.RahuKalam || s.Yamagandam || s.GulikaKalam {
+		t.Errorf("periods = %v %v %v, want only Rahu Kalam", s.RahuKalam, s.Yamagandam, s.GulikaKalam)
+	}
+	if s.Vara != "Mangalavara" || s.Tithi == "" || s.Nakshatra == "" {
+		t.Errorf("state = %+v, want the elements of Mangalavara", s)
+	}
+	if s.NextSunrise.Day() != 10 || s.NextSunset.Day() != 9 {
+		t.Errorf("next sunrise %v and sunset %v, want the 10th and 9th", s.NextSunrise, s.NextSunset)
+	}
+
+	// Before sunrise the vara is still Monday's.
+	s, err = ComputeState(bengaluru, zone, time.Date(2024, 4, 9, 5, 0, 0, 0, zone))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Vara != "Somavara" || s.RahuKalam || s.NextSunrise.Day() != 9 {
+		t.Errorf("state before sunrise = %+v, want Somavara and the sunrise of the 9th", s)
+	}
+}
+
+func TestNextChange(t *testing.T) {
+	zone := kolkata(t)
+	now := time.Date(2024, 4, 9, 15, 20, 0, 0, zone)
+	next, err := NextChange(bengaluru, zone, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !next.After(now) || next.After(time.Date(2024, 4, 9, 15, 30, 0, 0, zone)) {
+		t.Errorf("NextChange() = %v, want by the start of Rahu Kalam", next)
+	}
+}
+
+// broker accepts one connection, answers its CONNECT with code and returns
+// the packets received until the client disconnects.
+func broker(t *testing.T, code byte) (string, <-chan []packet) {
+	t.Helper()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { l.Close() })
+	received := make(chan []packet, 1)
+	go func() {
+		conn, err := l.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		var packets []packet
+		for {
+			header, body, err := readPacket(r)
+			if err != nil {
+				break
+			}
+			p := packet{kind: header >> 4, retain: header&1 == 1, body: body}
+			packets = append(packets, p)
+			if p.kind == connectPacket {
+				conn.Write([]byte{connackPacket << 4, 2, 0, code})
+			}
+			if p.kind == disconnectPacket {
+				break
+			}
+		}
+		received <- packets
+	}()
+	return "tcp://" + l.Addr().String(), received
+}
+
+type packet struct {
+	kind   byte
+	retain bool
+	body   []byte
+}
+
+// topic returns the topic and payload of a PUBLISH packet.
+func (p packet) topic() (string, string) {
+	n := binary.BigEndian.Uint16(p.body)
+	return string(p.body[2 : 2+n]), string(p.body[2+n:])
+}
+
+func TestPublish(t *testing.T) {
+	addr, received := broker(t, 0)
+	p, err := NewPublisher(Config{Broker: addr, Node: "bengaluru", Name: "Bengaluru", Latitude: 12.9716, Longitude: 77.5946, Timezone: "Asia/Kolkata"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Publish(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	packets := <-received
+	if len(packets) != len(entities)+4 || packets[0].kind != connectPacket || packets[len(packets)-1].kind != disconnectPacket {
+		t.Fatalf("received %d packets, want CONNECT, %d PUBLISH and DISCONNECT", len(packets), len(entities)+2)
+	}
+	if !strings.Contains(string(packets[0].body), "panchangam/bengaluru/availability") {
+		t.Error("CONNECT has no will on the availability topic")
+	}
+	topics := map[string]string{}
+	for _, p := range packets[1 : len(packets)-1] {
+		if p.kind != publishPacket || !p.retain {
+			t.Errorf("packet %d retain %v, want retained PUBLISH", p.kind, p.retain)
+		}
+		topic, payload := p.topic()
+		topics[topic] = payload
+	}
+	var discovery discoveryConfig
+	if err := json.Unmarshal([]byte(topics["homeassistant/binary_sensor/panchangam_bengaluru/rahu_kalam/config"]), &discovery); err != nil {
+		t.Fatalf("Rahu Kalam discovery: %v", err)
+	}
+	if discovery.StateTopic != "panchangam/bengaluru/state" || discovery.Device.Name != "Bengaluru" {
+		t.Errorf("Rahu Kalam discovery = %+v", discovery)
+	}
+	if topics["panchangam/bengaluru/availability"] != Online {
+		t.Errorf("availability = %q, want %s", topics["panchangam/bengaluru/availability"], Online)
+	}
+	var s State
+	if err := json.Unmarshal([]byte(topics["panchangam/bengaluru/state"]), &s); err != nil || s.Tithi == "" {
+		t.Errorf("state = %q: %v", topics["panchangam/bengaluru/state"], err)
+	}
+}
+
+func TestDialRefused(t *testing.T) {
+	addr, _ := broker(t, 5)
+	_, err := Dial(context.Background(), addr, ClientOptions{ClientID: "test"})
+	if !errors.Is(err, ErrRefused) || !strings.Contains(err.Error(), "not authorized") {
+		t.Errorf("Dial() error = %v, want ErrRefused: not authorized", err)
+	}
+}
+
+func TestInvalidNode(t *testing.T) {
+	if _, err := NewPublisher(Config{Node: "Bengaluru City", Timezone: "Asia/Kolkata"}); err == nil {
+		t.Error("NewPublisher() accepted a node with spaces")
+	}
+}
@@ -0,0 +1,241 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/location"
+	"github.com/naren-m/panchangam/log"
+)
+
+var logger = log.Logger()
+
+// Defaults of a Config.
+const (
+	DefaultTopicPrefix     = "panchangam"
+	DefaultDiscoveryPrefix = "homeassistant"
+)
+
+// Payloads of the availability topic.
+const (
+	Online  = "online"
+	Offline = "offline"
+)
+
+// Reconnection backoff of Run.
+const (
+	retryBackoff = 5 * time.Second
+	maxRetry     = 5 * time.Minute
+)
+
+// validNode matches the node IDs allowed in topics and unique IDs.
+var validNode = regexp.MustCompile(`^[a-z0-9_]+$`)
+
+// Config configures a Publisher.
+type Config struct {
+	// Broker is the address of the broker, e.g. tcp://localhost:1883 or
+	// mqtts://broker.example.com.
+	Broker   string
+	ClientID string
+	Username string
+	Password string
+	// Node identifies the place in topics and unique IDs, e.g. chennai.
+	Node string
+	// Name names the device of the place in Home Assistant, "Panchangam"
+	// and the node when empty.
+	Name      string
+	Latitude  float64
+	Longitude float64
+	// Timezone is the IANA timezone of the times of the state, the
+	// timezone at the location when empty.
+	Timezone string
+	// TopicPrefix and DiscoveryPrefix are the roots of the state and
+	// discovery topics, DefaultTopicPrefix and DefaultDiscoveryPrefix when
+	// empty.
+	TopicPrefix     string
+	DiscoveryPrefix string
+}
+
+// Publisher publishes the state of a place to a broker at every transition.
+type Publisher struct {
+	cfg  Config
+	loc  astronomy.Location
+	zone *time.Location
+}
+
+// NewPublisher returns a Publisher for cfg.
+func NewPublisher(cfg Config) (*Publisher, error) {
+	if !validNode.MatchString(cfg.Node) {
+		return nil, fmt.Errorf("invalid node %q: expected lowercase letters, digits and underscores", cfg.Node)
+	}
+	if cfg.Name == "" {
+		cfg.Name = "Panchangam " + cfg.Node
+	}
+	if cfg.ClientID == "" {
+		cfg.ClientID = "panchangam-" + cfg.Node
+	}
+	if cfg.TopicPrefix == "" {
+		cfg.TopicPrefix = DefaultTopicPrefix
+	}
+	if cfg.DiscoveryPrefix == "" {
+		cfg.DiscoveryPrefix = DefaultDiscoveryPrefix
+	}
+	var zone *time.Location
+	var err error
+	if cfg.Timezone == "" {
+		zone, err = location.Timezone(cfg.Latitude, cfg.Longitude)
+	} else {
+		zone, err = time.LoadLocation(cfg.Timezone)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone %q: %v", cfg.Timezone, err)
+	}
+	return &Publisher{
+		cfg:  cfg,
+		loc:  astronomy.Location{Latitude: cfg.Latitude, Longitude: cfg.Longitude},
+		zone: zone,
+	}, nil
+}
+
+// StateTopic is the topic of the JSON state, e.g. panchangam/chennai/state.
+func (p *Publisher) StateTopic() string {
+	return p.cfg.TopicPrefix + "/" + p.cfg.Node + "/state"
+}
+
+// AvailabilityTopic is the topic reporting whether the publisher is
+// connected, Online or Offline.
+func (p *Publisher) AvailabilityTopic() string {
+	return p.cfg.TopicPrefix + "/" + p.cfg.Node + "/availability"
+}
+
+// State returns the retained state message at now and when the state next
+// changes.
+func (p *Publisher) State(now time.Time) (Message, time.Time, error) {
+	s, err := ComputeState(p.loc, p.zone, now)
+	if err != nil {
+		return Message{}, time.Time{}, err
+	}
+	next, err := NextChange(p.loc, p.zone, now)
+	if err != nil {
+		return Message{}, time.Time{}, err
+	}
+	payload, err := json.Marshal(s)
+	if err != nil {
+		return Message{}, time.Time{}, err
+	}
+	return Message{Topic: p.StateTopic(), Payload: payload, Retain: true}, next, nil
+}
+
+// Messages returns the messages a connection starts with: the discovery
+// messages, Online and the state at now.
+func (p *Publisher) Messages(now time.Time) ([]Message, error) {
+	state, _, err := p.State(now)
+	if err != nil {
+		return nil, err
+	}
+	msgs := append(p.Discovery(), p.availability(Online), state)
+	return msgs, nil
+}
+
+func (p *Publisher) availability(payload string) Message {
+	return Message{Topic: p.AvailabilityTopic(), Payload: []byte(payload), Retain: true}
+}
+
+// Publish connects to the broker, publishes the messages a connection
+// starts with and disconnects, leaving the state as it is at the time.
+func (p *Publisher) Publish(ctx context.Context) error {
+	c, err := p.dial(ctx)
+	if err != nil {
+		return err
+	}
+	msgs, err := p.Messages(time.Now())
+	if err == nil {
+		err = publishAll(c, msgs)
+	}
+	return errors.Join(err, c.Close())
+}
+
+// Run publishes the state at every transition until ctx ends, then marks
+// the place Offline. It reconnects to the broker with backoff whenever the
+// connection is lost.
+func (p *Publisher) Run(ctx context.Context) {
+	backoff := retryBackoff
+	for {
+		connected, err := p.session(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		if connected {
+			backoff = retryBackoff
+		}
+		logger.WarnContext(ctx, "MQTT connection lost, reconnecting", "broker", p.cfg.Broker, "retry_in", backoff, "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff = min(2*backoff, maxRetry)
+	}
+}
+
+// session publishes the state over one connection until ctx ends or the
+// connection is lost, reporting whether it connected.
+func (p *Publisher) session(ctx context.Context) (bool, error) {
+	c, err := p.dial(ctx)
+	if err != nil {
+		return false, err
+	}
+	logger.InfoContext(ctx, "Publishing panchangam over MQTT", "broker", p.cfg.Broker, "topic", p.StateTopic())
+	if err := publishAll(c, append(p.Discovery(), p.availability(Online))); err != nil {
+		c.Close()
+		return true, err
+	}
+	for {
+		state, next, err := p.State(time.Now())
+		if err != nil {
+			c.Close()
+			return true, err
+		}
+		if err := c.Publish(state); err != nil {
+			c.Close()
+			return true, err
+		}
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			// A clean disconnection does not publish the will.
+			c.Publish(p.availability(Offline))
+			c.Close()
+			return true, nil
+		case <-c.Done():
+			timer.Stop()
+			return true, c.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+func (p *Publisher) dial(ctx context.Context) (*Client, error) {
+	will := p.availability(Offline)
+	return Dial(ctx, p.cfg.Broker, ClientOptions{
+		ClientID: p.cfg.ClientID,
+		Username: p.cfg.Username,
+		Password: p.cfg.Password,
+		Will:     &will,
+	})
+}
+
+func publishAll(c *Client, msgs []Message) error {
+	for _, m := range msgs {
+		if err := c.Publish(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
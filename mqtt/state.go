@@ -0,0 +1,103 @@
+// Package mqtt publishes the panchangam of a place to an MQTT broker for
+// smart-home automations.
+//
+// A Publisher keeps a retained JSON State on a topic of the place,
+// republished at every transition of the panchangam, with Home Assistant
+// discovery payloads declaring a sensor for each of its elements and a
+// binary sensor for each inauspicious period, so that automations can, for
+// example, hold off starting an appliance during Rahu Kalam.
+package mqtt
+
+import (
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/transition"
+)
+
+// State is the panchangam of a place at an instant.
+type State struct {
+	Tithi     string `json:"tithi"`
+	Nakshatra string `json:"nakshatra"`
+	Yoga      string `json:"yoga"`
+	Karana    string `json:"karana"`
+	// Vara is the weekday, which starts at sunrise.
+	Vara string `json:"vara"`
+	// RahuKalam, Yamagandam and GulikaKalam report whether the period is
+	// active.
+	RahuKalam   bool      `json:"rahu_kalam"`
+	Yamagandam  bool      `json:"yamagandam"`
+	GulikaKalam bool      `json:"gulika_kalam"`
+	NextSunrise time.Time `json:"next_sunrise"`
+	NextSunset  time.Time `json:"next_sunset"`
+	Updated     time.Time `json:"updated"`
+}
+
+// stateKinds are the kinds of transition changing a State.
+var stateKinds = []transition.Kind{
+	transition.Tithi, transition.Nakshatra, transition.Yoga, transition.Karana,
+	transition.Vara, transition.Sunrise, transition.Sunset,
+	transition.RahuKalam, transition.Yamagandam, transition.GulikaKalam,
+}
+
+// ComputeState returns the state at loc at t, with times in zone.
+func ComputeState(loc astronomy.Location, zone *time.Location, t time.Time) (State, error) {
+	t = t.In(zone)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, zone)
+	sun, err := astronomy.CalculateSunTimes(loc, day, astronomy.WithPolarFallback())
+	if err != nil {
+		return State{}, err
+	}
+	next, err := astronomy.CalculateSunTimes(loc, day.AddDate(0, 0, 1), astronomy.WithPolarFallback())
+	if err != nil {
+		return State{}, err
+	}
+	elements := astronomy.CalculateElements(t)
+	s := State{
+		Tithi:       elements.Tithi.Name,
+		Nakshatra:   elements.Nakshatra.Name,
+		Yoga:        elements.Yoga.Name,
+		Karana:      elements.Karana.Name,
+		RahuKalam:   active(astronomy.RahuKalam(sun.Sunrise, sun.Sunset), t),
+		Yamagandam:  active(astronomy.Yamagandam(sun.Sunrise, sun.Sunset), t),
+		GulikaKalam: active(astronomy.GulikaKalam(sun.Sunrise, sun.Sunset), t),
+		NextSunrise: sun.Sunrise,
+		NextSunset:  sun.Sunset,
+		Updated:     t.Truncate(time.Second),
+	}
+	if t.Before(sun.Sunrise) {
+		// Before sunrise the weekday is still that of the previous day.
+		prev, err := astronomy.CalculateSunTimes(loc, day.AddDate(0, 0, -1), astronomy.WithPolarFallback())
+		if err != nil {
+			return State{}, err
+		}
+		s.Vara = astronomy.CalculateVara(prev.Sunrise, sun.Sunrise).Name
+	} else {
+		s.Vara = astronomy.CalculateVara(sun.Sunrise, next.Sunrise).Name
+		s.NextSunrise = next.Sunrise
+	}
+	if !t.Before(sun.Sunset) {
+		s.NextSunset = next.Sunset
+	}
+	s.NextSunrise = s.NextSunrise.In(zone).Truncate(time.Second)
+	s.NextSunset = s.NextSunset.In(zone).Truncate(time.Second)
+	return s, nil
+}
+
+// NextChange returns when the state at loc next changes after t.
+func NextChange(loc astronomy.Location, zone *time.Location, t time.Time) (time.Time, error) {
+	scheduler := transition.NewScheduler(loc, zone, transition.WithKinds(stateKinds...), transition.WithNotice(0))
+	// Every element changes within two days.
+	transitions, err := scheduler.Between(t.Add(time.Nanosecond), t.Add(48*time.Hour))
+	if err != nil {
+		return time.Time{}, err
+	}
+	if len(transitions) == 0 {
+		return t.Add(24 * time.Hour), nil
+	}
+	return transitions[0].Time, nil
+}
+
+func active(p astronomy.Period, t time.Time) bool {
+	return !t.Before(p.Start) && t.Before(p.End)
+}
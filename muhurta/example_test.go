@@ -0,0 +1,29 @@
+package muhurta_test
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/muhurta"
+)
+
+func ExamplePack_Find() {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	mumbai := astronomy.Location{Latitude: 19.0760, Longitude: 72.8777}
+	pack, _ := muhurta.DefaultPacks().Get(muhurta.DefaultTradition)
+	first := time.Date(2024, 4, 30, 0, 0, 0, 0, ist)
+	windows, err := pack.Find("griha_pravesh", first, first.AddDate(0, 0, 6), mumbai)
+	if err != nil {
+		fmt.Println(err)
+		return
+	}
+	if len(windows) > 0 {
+		w := windows[0]
+		fmt.Println(w.Start.Format("2006-01-02 15:04"), "-", w.End.Format("15:04"), w.Choghadiya, w.Score)
+		fmt.Println(w.Reasons)
+	}
+	// Output:
+	// 2024-05-03 03:22 - 04:45 Shubh 5
+	// [nakshatra Shatabhisha +2 weekday Thursday +1 choghadiya Shubh +2]
+}
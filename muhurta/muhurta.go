@@ -0,0 +1,397 @@
+// Package muhurta finds auspicious periods (muhurtas) for activities such as
+// marriages or housewarmings.
+//
+// Candidate periods are the day and night choghadiyas. Each is checked
+// against the prohibitions of a rule pack and scored with its weights. Rule
+// packs encode the methodology of a tradition and are loaded from YAML.
+package muhurta
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// ErrUnknownActivity is returned when a rule pack has no rules for the
+// requested activity.
+var ErrUnknownActivity = errors.New("unknown activity")
+
+// ErrInvalidProfile is returned when a Profile names an unknown nakshatra
+// or rashi.
+var ErrInvalidProfile = errors.New("invalid profile")
+
+// ErrInvalidConstraint is returned when the constraints of a search name
+// an unknown nakshatra or weekday.
+var ErrInvalidConstraint = errors.New("invalid constraint")
+
+// Factor is a property of a period that rules refer to.
+type Factor string
+
+const (
+	// TithiFactor is the tithi name, e.g. "Shukla Dwitiya" or "Purnima".
+	TithiFactor     Factor = "tithi"
+	NakshatraFactor Factor = "nakshatra"
+	YogaFactor      Factor = "yoga"
+	KaranaFactor    Factor = "karana"
+	// WeekdayFactor is the English weekday of the sunrise beginning the
+	// day, e.g. "Monday".
+	WeekdayFactor    Factor = "weekday"
+	ChoghadiyaFactor Factor = "choghadiya"
+	// TarabalaFactor is the tara of the nakshatra counted from the janma
+	// nakshatra of the Profile, e.g. "Sampat", or empty without one.
+	TarabalaFactor Factor = "tarabala"
+	// ChandrabalaFactor is the position of the sign of the moon counted
+	// from the janma rashi of the Profile, "1" to "12", or empty without
+	// one.
+	ChandrabalaFactor Factor = "chandrabala"
+)
+
+// Profile describes the person an activity is for. Windows found with a
+// profile are also scored with the tarabala and chandrabala weights of the
+// rules, which depend on the moon at the birth of the person.
+type Profile struct {
+	// Nakshatra is the janma nakshatra, that of the moon at birth, e.g.
+	// "Rohini". Tarabala is scored when it is set.
+	Nakshatra string
+	// Rashi is the janma rashi, the sign of the moon at birth, e.g.
+	// "Vrishabha". Chandrabala is scored when it is set.
+	Rashi string
+}
+
+// taraNames are the nine taras, counted from the janma nakshatra.
+var taraNames = []string{"Janma", "Sampat", "Vipat", "Kshema", "Pratyak", "Sadhana", "Naidhana", "Mitra", "Parama Mitra"}
+
+// Tara returns the tara of nakshatra counted from the janma nakshatra,
+// both numbered from Ashwini = 1, e.g. "Vipat" for the third.
+func Tara(janma, nakshatra int) string {
+	return taraNames[((nakshatra-janma)%27+27)%27%9]
+}
+
+// Chandrabala returns the position of rashi counted from the janma rashi,
+// both numbered from Mesha = 1: 1 for the janma rashi itself up to 12.
+func Chandrabala(janma, rashi int) int {
+	return ((rashi-janma)%12+12)%12 + 1
+}
+
+// birth holds the janma nakshatra and rashi of a Profile by number, 0 when
+// not given.
+type birth struct {
+	nakshatra, rashi int
+}
+
+// resolve validates p, whose names may be given in any case.
+func (p Profile) resolve() (birth, error) {
+	var b birth
+	if p.Nakshatra != "" {
+		names, err := canonicalValues(NakshatraFactor, []string{p.Nakshatra})
+		if err != nil {
+			return birth{}, fmt.Errorf("%w: %w", ErrInvalidProfile, err)
+		}
+		e, _ := astronomy.NakshatraByName(names[0])
+		b.nakshatra = e.Number
+	}
+	if p.Rashi != "" {
+		for n := 1; n <= 12 && b.rashi == 0; n++ {
+			if strings.EqualFold(astronomy.RashiName(n), p.Rashi) {
+				b.rashi = n
+			}
+		}
+		if b.rashi == 0 {
+			return birth{}, fmt.Errorf("%w: unknown rashi %q", ErrInvalidProfile, p.Rashi)
+		}
+	}
+	return b, nil
+}
+
+// Option configures Find and Search.
+type Option func(*options)
+
+type options struct {
+	profile Profile
+}
+
+// WithProfile scores the windows for the person of p.
+func WithProfile(p Profile) Option {
+	return func(o *options) { o.profile = p }
+}
+
+// birthOf returns the birth of the profile of opts.
+func birthOf(opts []Option) (birth, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+	return o.profile.resolve()
+}
+
+// Activity holds the rules of a pack for one activity.
+type Activity struct {
+	// Prohibited lists, per factor, the values that rule a period out. A
+	// period is ruled out when a prohibited value prevails at its start or
+	// its end.
+	Prohibited map[Factor][]string `yaml:"prohibited"`
+	// Weights adds, per factor, the weight of the value prevailing in the
+	// middle of a period to its score.
+	Weights map[Factor]map[string]int `yaml:"weights"`
+	// MinScore is the score a period needs to be returned.
+	MinScore int `yaml:"min_score"`
+}
+
+// Pack is the set of muhurta rules of one tradition.
+type Pack struct {
+	// ID is a stable slug identifying the pack, e.g. "smarta".
+	ID   string `yaml:"id"`
+	Name string `yaml:"name"`
+	// Activities maps an activity, e.g. "marriage", to its rules.
+	Activities map[string]Activity `yaml:"activities"`
+}
+
+// ActivityNames returns the activities the pack has rules for, sorted.
+func (p *Pack) ActivityNames() []string {
+	names := make([]string, 0, len(p.Activities))
+	for name := range p.Activities {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Window is a period suitable for an activity.
+type Window struct {
+	Start time.Time
+	End   time.Time
+	// Choghadiya is the name of the choghadiya the window spans.
+	Choghadiya string
+	Score      int
+	// Reasons lists the factors that contributed to Score, e.g.
+	// "nakshatra Rohini +2".
+	Reasons []string
+}
+
+// Find returns the windows suitable for activity at loc from the civil date
+// of first to that of last inclusive, ordered by time. Each day runs from
+// sunrise to the next sunrise; times are in the location of first.
+func (p *Pack) Find(activity string, first, last time.Time, loc astronomy.Location, opts ...Option) ([]Window, error) {
+	rules, ok := p.Activities[activity]
+	if !ok {
+		return nil, fmt.Errorf("%w %q in rule pack %q", ErrUnknownActivity, activity, p.ID)
+	}
+	b, err := birthOf(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tz := first.Location()
+	y, m, d := first.Date()
+	start := time.Date(y, m, d, 0, 0, 0, 0, tz)
+	y, m, d = last.In(tz).Date()
+	end := time.Date(y, m, d, 0, 0, 0, 0, tz)
+
+	var windows []Window
+	sunTimes, err := sunTimesOn(loc, start)
+	if err != nil {
+		return nil, err
+	}
+	for day := start; !day.After(end); day = day.AddDate(0, 0, 1) {
+		next, err := sunTimesOn(loc, day.AddDate(0, 0, 1))
+		if err != nil {
+			return nil, err
+		}
+		windows = append(windows, rules.windows(sunTimes, next, tz, b)...)
+		sunTimes = next
+	}
+	return windows, nil
+}
+
+// Constraints narrows the windows of Search beyond the rules of a pack.
+type Constraints struct {
+	// Nakshatras, when set, lists the nakshatras one of which must prevail
+	// throughout a window, e.g. "Rohini".
+	Nakshatras []string
+	// Weekdays, when set, lists the English weekdays of the days to search,
+	// e.g. "Friday". The weekday of a day is that of its sunrise.
+	Weekdays []string
+	// ExcludeBhadra rules out windows touching Bhadra, the Vishti karana.
+	ExcludeBhadra bool
+}
+
+// bhadra is the karana that ExcludeBhadra rules out.
+const bhadra = "Vishti"
+
+// Search returns the first count windows suitable for activity at loc that
+// start at from or later and satisfy c, searching the days from the date
+// of from for at most maxDays days. The windows are ranked by score, the
+// earlier first among equal scores, and their reasons include the
+// constraints they satisfy. Times are in the location of from.
+func (p *Pack) Search(activity string, from time.Time, loc astronomy.Location, c Constraints, count, maxDays int, opts ...Option) ([]Window, error) {
+	rules, ok := p.Activities[activity]
+	if !ok {
+		return nil, fmt.Errorf("%w %q in rule pack %q", ErrUnknownActivity, activity, p.ID)
+	}
+	c, err := c.canonical()
+	if err != nil {
+		return nil, err
+	}
+	b, err := birthOf(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	tz := from.Location()
+	y, m, d := from.Date()
+	// Each day runs from sunrise, so the night of the day before the date
+	// of from may hold windows after from.
+	day := time.Date(y, m, d-1, 0, 0, 0, 0, tz)
+	sunTimes, err := sunTimesOn(loc, day)
+	if err != nil {
+		return nil, err
+	}
+	var windows []Window
+	for i := 0; i <= maxDays && len(windows) < count; i++ {
+		day = day.AddDate(0, 0, 1)
+		next, err := sunTimesOn(loc, day)
+		if err != nil {
+			return nil, err
+		}
+		weekday := sunTimes.Sunrise.Weekday().String()
+		if len(c.Weekdays) == 0 || contains(c.Weekdays, weekday) {
+			for _, w := range rules.windows(sunTimes, next, tz, b) {
+				if w.Start.Before(from) || len(windows) == count {
+					continue
+				}
+				if reasons, ok := c.check(w, weekday); ok {
+					w.Reasons = append(w.Reasons, reasons...)
+					windows = append(windows, w)
+				}
+			}
+		}
+		sunTimes = next
+	}
+	sort.SliceStable(windows, func(i, j int) bool { return windows[i].Score > windows[j].Score })
+	return windows, nil
+}
+
+// canonical validates the values of c and returns them as the rules name
+// them, so that they may be given in any case, e.g. "rohini".
+func (c Constraints) canonical() (Constraints, error) {
+	var err error
+	if c.Nakshatras, err = canonicalValues(NakshatraFactor, c.Nakshatras); err != nil {
+		return Constraints{}, fmt.Errorf("%w: %w", ErrInvalidConstraint, err)
+	}
+	if c.Weekdays, err = canonicalValues(WeekdayFactor, c.Weekdays); err != nil {
+		return Constraints{}, fmt.Errorf("%w: %w", ErrInvalidConstraint, err)
+	}
+	return c, nil
+}
+
+// check reports whether w, on a day of weekday, satisfies c, and the
+// reasons it does.
+func (c Constraints) check(w Window, weekday string) ([]string, bool) {
+	var reasons []string
+	if len(c.Nakshatras) > 0 || c.ExcludeBhadra {
+		for _, t := range []time.Time{w.Start, w.End.Add(-time.Second)} {
+			elements := astronomy.CalculateElements(t)
+			if len(c.Nakshatras) > 0 && !contains(c.Nakshatras, elements.Nakshatra.Name) {
+				return nil, false
+			}
+			if c.ExcludeBhadra && elements.Karana.Name == bhadra {
+				return nil, false
+			}
+		}
+	}
+	if len(c.Nakshatras) > 0 {
+		reasons = append(reasons, fmt.Sprintf("nakshatra %s as required", astronomy.CalculateElements(w.Start).Nakshatra.Name))
+	}
+	if len(c.Weekdays) > 0 {
+		reasons = append(reasons, fmt.Sprintf("weekday %s as required", weekday))
+	}
+	if c.ExcludeBhadra {
+		reasons = append(reasons, "no Bhadra")
+	}
+	return reasons, true
+}
+
+// windows returns the windows of the day from the sunrise of sunTimes to
+// that of next, in tz, for the person born under b.
+func (a *Activity) windows(sunTimes, next *astronomy.SunTimes, tz *time.Location, b birth) []Window {
+	var windows []Window
+	weekday := sunTimes.Sunrise.Weekday().String()
+	for _, period := range astronomy.CalculateChoghadiya(sunTimes.Sunrise, sunTimes.Sunset, next.Sunrise) {
+		if w, ok := a.evaluate(period, weekday, b); ok {
+			w.Start, w.End = period.Start.In(tz), period.End.In(tz)
+			windows = append(windows, w)
+		}
+	}
+	return windows
+}
+
+// sunTimesOn returns the sun times of day at loc.
+func sunTimesOn(loc astronomy.Location, day time.Time) (*astronomy.SunTimes, error) {
+	sunTimes, err := astronomy.CalculateSunTimes(loc, day)
+	if err != nil {
+		return nil, fmt.Errorf("calculating sunrise on %s: %w", day.Format("2006-01-02"), err)
+	}
+	return sunTimes, nil
+}
+
+// evaluate checks a choghadiya period against the rules and scores it for
+// the person born under b.
+func (a *Activity) evaluate(period astronomy.ChoghadiyaPeriod, weekday string, b birth) (Window, bool) {
+	// Elements may change within the period, so prohibitions are checked at
+	// both of its ends.
+	for _, t := range []time.Time{period.Start, period.End.Add(-time.Second)} {
+		for factor, value := range factorsAt(t, weekday, period.Name, b) {
+			if contains(a.Prohibited[factor], value) {
+				return Window{}, false
+			}
+		}
+	}
+
+	w := Window{Choghadiya: period.Name}
+	middle := period.Start.Add(period.End.Sub(period.Start) / 2)
+	factors := factorsAt(middle, weekday, period.Name, b)
+	for _, factor := range factorOrder {
+		value := factors[factor]
+		if weight := a.Weights[factor][value]; weight != 0 {
+			w.Score += weight
+			w.Reasons = append(w.Reasons, fmt.Sprintf("%s %s %+d", factor, value, weight))
+		}
+	}
+	return w, w.Score >= a.MinScore
+}
+
+// factorOrder is the order in which factors are reported.
+var factorOrder = []Factor{TithiFactor, NakshatraFactor, YogaFactor, KaranaFactor, WeekdayFactor, ChoghadiyaFactor, TarabalaFactor, ChandrabalaFactor}
+
+func factorsAt(t time.Time, weekday, choghadiya string, b birth) map[Factor]string {
+	elements := astronomy.CalculateElements(t)
+	factors := map[Factor]string{
+		TithiFactor:      elements.Tithi.Name,
+		NakshatraFactor:  elements.Nakshatra.Name,
+		YogaFactor:       elements.Yoga.Name,
+		KaranaFactor:     elements.Karana.Name,
+		WeekdayFactor:    weekday,
+		ChoghadiyaFactor: choghadiya,
+	}
+	if b.nakshatra != 0 {
+		factors[TarabalaFactor] = Tara(b.nakshatra, elements.Nakshatra.Number)
+	}
+	if b.rashi != 0 {
+		factors[ChandrabalaFactor] = strconv.Itoa(Chandrabala(b.rashi, astronomy.MoonRashi(t)))
+	}
+	return factors
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,256 @@
+package muhurta
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+var delhi = astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}
+
+func TestDefaultPacks(t *testing.T) {
+	packs := DefaultPacks()
+	if got, want := strings.Join(packs.IDs(), ","), "smarta,tamil,vaishnava"; got != want {
+		t.Errorf("IDs() = %s, want %s", got, want)
+	}
+	for _, id := range packs.IDs() {
+		pack, _ := packs.Get(id)
+		if got, want := strings.Join(pack.ActivityNames(), ","), "griha_pravesh,marriage,travel"; got != want {
+			t.Errorf("pack %s activities = %s, want %s", id, got, want)
+		}
+	}
+	if _, ok := packs.Get(DefaultTradition); !ok {
+		t.Errorf("default tradition %q is not a built-in pack", DefaultTradition)
+	}
+}
+
+func TestFind(t *testing.T) {
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, last := time.Date(2024, 11, 1, 0, 0, 0, 0, tz), time.Date(2024, 11, 30, 0, 0, 0, 0, tz)
+
+	for _, id := range DefaultPacks().IDs() {
+		pack, _ := DefaultPacks().Get(id)
+		for _, activity := range pack.ActivityNames() {
+			windows, err := pack.Find(activity, first, last, delhi)
+			if err != nil {
+				t.Fatalf("%s Find(%s) error = %v", id, activity, err)
+			}
+			if len(windows) == 0 {
+				t.Errorf("%s Find(%s) found no windows in a month", id, activity)
+			}
+			rules := pack.Activities[activity]
+			for i, w := range windows {
+				if w.Score < rules.MinScore || !w.End.After(w.Start) || w.Start.Location() != tz {
+					t.Errorf("%s %s window %+v is invalid", id, activity, w)
+				}
+				if i > 0 && w.Start.Before(windows[i-1].End) {
+					t.Errorf("%s %s windows out of order at %v", id, activity, w.Start)
+				}
+				if contains(rules.Prohibited[ChoghadiyaFactor], w.Choghadiya) {
+					t.Errorf("%s %s window in prohibited choghadiya %s", id, activity, w.Choghadiya)
+				}
+				for _, at := range []time.Time{w.Start, w.End.Add(-time.Second)} {
+					if tithi := astronomy.CalculateElements(at).Tithi.Name; contains(rules.Prohibited[TithiFactor], tithi) {
+						t.Errorf("%s %s window %v - %v on prohibited tithi %s", id, activity, w.Start, w.End, tithi)
+					}
+				}
+			}
+		}
+	}
+}
+
+func TestFindTraditions(t *testing.T) {
+	// Utpanna Ekadashi, 26 November 2024, is avoided by Vaishnavas only.
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := time.Date(2024, 11, 26, 0, 0, 0, 0, tz)
+	onEkadashi := func(id string) bool {
+		pack, _ := DefaultPacks().Get(id)
+		windows, err := pack.Find("travel", day, day, delhi)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, w := range windows {
+			if astronomy.CalculateElements(w.Start).Tithi.Name == "Krishna Ekadashi" {
+				return true
+			}
+		}
+		return false
+	}
+	if !onEkadashi("smarta") {
+		t.Error("smarta travel windows skip Ekadashi")
+	}
+	if onEkadashi("vaishnava") {
+		t.Error("vaishnava travel windows include Ekadashi")
+	}
+}
+
+func TestFindUnknownActivity(t *testing.T) {
+	pack, _ := DefaultPacks().Get(DefaultTradition)
+	day := time.Date(2024, 11, 26, 0, 0, 0, 0, time.UTC)
+	if _, err := pack.Find("coronation", day, day, delhi); !errors.Is(err, ErrUnknownActivity) {
+		t.Errorf("Find() error = %v, want %v", err, ErrUnknownActivity)
+	}
+}
+
+func TestTara(t *testing.T) {
+	tests := []struct {
+		janma, nakshatra int
+		want             string
+	}{
+		{4, 4, "Janma"},
+		{4, 6, "Vipat"},
+		{4, 12, "Parama Mitra"},
+		{4, 13, "Janma"},
+		{27, 1, "Sampat"},
+		{4, 3, "Parama Mitra"},
+	}
+	for _, tt := range tests {
+		if got := Tara(tt.janma, tt.nakshatra); got != tt.want {
+			t.Errorf("Tara(%d, %d) = %s, want %s", tt.janma, tt.nakshatra, got, tt.want)
+		}
+	}
+	if got := Chandrabala(2, 1); got != 12 {
+		t.Errorf("Chandrabala(2, 1) = %d, want 12", got)
+	}
+}
+
+func TestFindProfile(t *testing.T) {
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	first, last := time.Date(2024, 11, 1, 0, 0, 0, 0, tz), time.Date(2024, 11, 30, 0, 0, 0, 0, tz)
+	pack, _ := DefaultPacks().Get(DefaultTradition)
+	profile := Profile{Nakshatra: "rohini", Rashi: "Vrishabha"}
+
+	windows, err := pack.Find("marriage", first, last, delhi, WithProfile(profile))
+	if err != nil {
+		t.Fatalf("Find() error = %v", err)
+	}
+	if len(windows) == 0 {
+		t.Fatal("Find() with a profile found no windows in a month")
+	}
+	for _, w := range windows {
+		middle := w.Start.Add(w.End.Sub(w.Start) / 2)
+		tara := Tara(4, astronomy.CalculateElements(middle).Nakshatra.Number)
+		if tara == "Naidhana" {
+			t.Errorf("window %v - %v on Naidhana tara, want it scored out", w.Start, w.End)
+		}
+		if weight := pack.Activities["marriage"].Weights[TarabalaFactor][tara]; weight != 0 && !strings.Contains(strings.Join(w.Reasons, ","), "tarabala "+tara) {
+			t.Errorf("window %v reasons = %v, want tarabala %s", w.Start, w.Reasons, tara)
+		}
+	}
+	plain, err := pack.Find("marriage", first, last, delhi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plain) == len(windows) {
+		t.Errorf("Find() found %d windows with and without a profile, want the profile to change them", len(plain))
+	}
+
+	if _, err := pack.Find("marriage", first, last, delhi, WithProfile(Profile{Rashi: "Leo"})); !errors.Is(err, ErrInvalidProfile) {
+		t.Errorf("Find() error = %v, want %v", err, ErrInvalidProfile)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	tz, err := time.LoadLocation("Asia/Kolkata")
+	if err != nil {
+		t.Fatal(err)
+	}
+	from := time.Date(2024, 11, 1, 10, 0, 0, 0, tz)
+	pack, _ := DefaultPacks().Get(DefaultTradition)
+	c := Constraints{Nakshatras: []string{"rohini", "Hasta", "Revati"}, Weekdays: []string{"friday", "Saturday", "Sunday"}, ExcludeBhadra: true}
+
+	windows, err := pack.Search("travel", from, delhi, c, 4, 120)
+	if err != nil {
+		t.Fatalf("Search() error = %v", err)
+	}
+	if len(windows) != 4 {
+		t.Fatalf("Search() found %d windows, want 4", len(windows))
+	}
+	for i, w := range windows {
+		if w.Start.Before(from) || w.Start.Location() != tz {
+			t.Errorf("window %v - %v starts before %v", w.Start, w.End, from)
+		}
+		if i > 0 && w.Score > windows[i-1].Score {
+			t.Errorf("windows not ranked by score at %v", w.Start)
+		}
+		if !contains(w.Reasons, "no Bhadra") {
+			t.Errorf("window %v reasons = %v, want no Bhadra", w.Start, w.Reasons)
+		}
+		for _, at := range []time.Time{w.Start, w.End.Add(-time.Second)} {
+			elements := astronomy.CalculateElements(at)
+			if !contains([]string{"Rohini", "Hasta", "Revati"}, elements.Nakshatra.Name) || elements.Karana.Name == "Vishti" {
+				t.Errorf("window %v - %v at %v in %s, %s", w.Start, w.End, at, elements.Nakshatra.Name, elements.Karana.Name)
+			}
+		}
+	}
+
+	if _, err := pack.Search("travel", from, delhi, Constraints{Nakshatras: []string{"Pluto"}}, 4, 120); !errors.Is(err, ErrInvalidConstraint) {
+		t.Errorf("Search() error = %v, want %v", err, ErrInvalidConstraint)
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := t.TempDir()
+	pack := `
+id: smarta
+name: Smarta (family)
+activities:
+  naming:
+    min_score: 1
+    weights:
+      nakshatra: {Pushya: 1}
+`
+	if err := os.WriteFile(filepath.Join(dir, "family.yaml"), []byte(pack), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	packs, err := LoadDir(dir)
+	if err != nil {
+		t.Fatalf("LoadDir() error = %v", err)
+	}
+	smarta, _ := packs.Get("smarta")
+	if smarta.Name != "Smarta (family)" || len(smarta.Activities) != 1 {
+		t.Errorf("smarta pack = %+v, want the pack from dir", smarta)
+	}
+	if _, ok := packs.Get("vaishnava"); !ok {
+		t.Error("LoadDir() dropped the built-in vaishnava pack")
+	}
+	if builtin, _ := DefaultPacks().Get("smarta"); builtin.Name != "Smarta" {
+		t.Errorf("LoadDir() modified the built-in packs")
+	}
+}
+
+func TestLoadDirInvalid(t *testing.T) {
+	tests := map[string]string{
+		"unknown field":   "id: x\nname: X\ncolour: red\nactivities: {travel: {}}\n",
+		"unknown factor":  "id: x\nname: X\nactivities: {travel: {weights: {planet: {Mars: 1}}}}\n",
+		"unknown value":   "id: x\nname: X\nactivities: {travel: {prohibited: {nakshatra: [Pluto]}}}\n",
+		"no activities":   "id: x\nname: X\n",
+		"missing id":      "name: X\nactivities: {travel: {}}\n",
+		"empty rule pack": "",
+	}
+	for name, pack := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(dir, "pack.yaml"), []byte(pack), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			if _, err := LoadDir(dir); err == nil {
+				t.Error("LoadDir() error = nil, want an error")
+			}
+		})
+	}
+}
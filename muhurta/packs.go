@@ -0,0 +1,193 @@
+package muhurta
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed packs/*.yaml
+var builtinPacks embed.FS
+
+// DefaultTradition is the ID of the rule pack used when none is requested.
+const DefaultTradition = "smarta"
+
+// Packs is a collection of rule packs keyed by ID.
+type Packs struct {
+	packs map[string]*Pack
+}
+
+var defaultPacks = sync.OnceValue(func() *Packs {
+	p := &Packs{packs: map[string]*Pack{}}
+	if err := p.loadFS(builtinPacks, "packs/*.yaml"); err != nil {
+		panic(fmt.Sprintf("muhurta: invalid built-in rule packs: %v", err))
+	}
+	return p
+})
+
+// DefaultPacks returns the built-in rule packs embedded in the binary.
+func DefaultPacks() *Packs {
+	return defaultPacks()
+}
+
+// LoadDir returns the built-in rule packs extended with every *.yaml file
+// in dir. A pack in dir replaces the built-in one with the same ID.
+func LoadDir(dir string) (*Packs, error) {
+	p := &Packs{packs: map[string]*Pack{}}
+	for id, pack := range DefaultPacks().packs {
+		p.packs[id] = pack
+	}
+	if err := p.loadFS(os.DirFS(dir), "*.yaml"); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Get returns the pack with the given ID.
+func (p *Packs) Get(id string) (*Pack, bool) {
+	pack, ok := p.packs[id]
+	return pack, ok
+}
+
+// IDs returns the IDs of the packs, sorted.
+func (p *Packs) IDs() []string {
+	ids := make([]string, 0, len(p.packs))
+	for id := range p.packs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+// Add validates pack and adds it to the collection, replacing any existing
+// pack with the same ID.
+func (p *Packs) Add(pack *Pack) error {
+	if err := pack.validate(); err != nil {
+		return err
+	}
+	p.packs[pack.ID] = pack
+	return nil
+}
+
+// loadFS adds the pack of every file in fsys matching pattern, in lexical
+// order of the file names.
+func (p *Packs) loadFS(fsys fs.FS, pattern string) error {
+	names, err := fs.Glob(fsys, pattern)
+	if err != nil {
+		return err
+	}
+	for _, name := range names {
+		data, err := fs.ReadFile(fsys, name)
+		if err != nil {
+			return err
+		}
+		if err := p.load(data); err != nil {
+			return fmt.Errorf("%s: %w", path.Base(name), err)
+		}
+	}
+	return nil
+}
+
+func (p *Packs) load(data []byte) error {
+	var pack Pack
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&pack); err != nil {
+		if errors.Is(err, io.EOF) {
+			return errors.New("empty rule pack")
+		}
+		return err
+	}
+	return p.Add(&pack)
+}
+
+// factorValues returns the values each factor can take.
+var factorValues = sync.OnceValue(func() map[Factor][]string {
+	var weekdays []string
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		weekdays = append(weekdays, d.String())
+	}
+	var positions []string
+	for n := 1; n <= 12; n++ {
+		positions = append(positions, strconv.Itoa(n))
+	}
+	return map[Factor][]string{
+		TithiFactor:       astronomy.ElementNames(astronomy.TithiElement),
+		NakshatraFactor:   astronomy.ElementNames(astronomy.NakshatraElement),
+		YogaFactor:        astronomy.ElementNames(astronomy.YogaElement),
+		KaranaFactor:      astronomy.ElementNames(astronomy.KaranaElement),
+		WeekdayFactor:     weekdays,
+		ChoghadiyaFactor:  astronomy.ChoghadiyaNames(),
+		TarabalaFactor:    taraNames,
+		ChandrabalaFactor: positions,
+	}
+})
+
+func (p *Pack) validate() error {
+	if p.ID == "" {
+		return fmt.Errorf("rule pack without id")
+	}
+	if p.Name == "" {
+		return fmt.Errorf("rule pack %q: no name", p.ID)
+	}
+	if len(p.Activities) == 0 {
+		return fmt.Errorf("rule pack %q: no activities", p.ID)
+	}
+	for name, a := range p.Activities {
+		for factor, values := range a.Prohibited {
+			if err := checkValues(factor, values...); err != nil {
+				return fmt.Errorf("rule pack %q: activity %q: prohibited %w", p.ID, name, err)
+			}
+		}
+		for factor, weights := range a.Weights {
+			for value := range weights {
+				if err := checkValues(factor, value); err != nil {
+					return fmt.Errorf("rule pack %q: activity %q: weights %w", p.ID, name, err)
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func checkValues(factor Factor, values ...string) error {
+	known, ok := factorValues()[factor]
+	if !ok {
+		return fmt.Errorf("unknown factor %q", factor)
+	}
+	for _, v := range values {
+		if !contains(known, v) {
+			return fmt.Errorf("unknown %s %q", factor, v)
+		}
+	}
+	return nil
+}
+
+// canonicalValues returns values as factor names them, matching them
+// regardless of case.
+func canonicalValues(factor Factor, values []string) ([]string, error) {
+	known := factorValues()[factor]
+	canonical := make([]string, 0, len(values))
+	for _, v := range values {
+		i := slices.IndexFunc(known, func(k string) bool { return strings.EqualFold(k, v) })
+		if i < 0 {
+			return nil, fmt.Errorf("unknown %s %q", factor, v)
+		}
+		canonical = append(canonical, known[i])
+	}
+	return canonical, nil
+}
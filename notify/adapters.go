@@ -0,0 +1,151 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// webhookChannel posts the message as JSON to an arbitrary URL.
+type webhookChannel struct {
+	url string
+}
+
+func newWebhookChannel(cfg ChannelConfig) (Channel, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("webhook channel: url is required")
+	}
+	return &webhookChannel{url: cfg.URL}, nil
+}
+
+func (c *webhookChannel) Send(ctx context.Context, msg Message) error {
+	return postJSON(ctx, c.url, map[string]string{
+		"locale":  msg.Locale,
+		"subject": msg.Subject,
+		"text":    msg.Text,
+	})
+}
+
+// slackChannel posts to a Slack incoming webhook.
+type slackChannel struct {
+	url string
+}
+
+func newSlackChannel(cfg ChannelConfig) (Channel, error) {
+	if cfg.URL == "" {
+		return nil, fmt.Errorf("slack channel: url is required")
+	}
+	return &slackChannel{url: cfg.URL}, nil
+}
+
+func (c *slackChannel) Send(ctx context.Context, msg Message) error {
+	text := msg.Text
+	if msg.Subject != "" {
+		text = "*" + msg.Subject + "*\n" + text
+	}
+	return postJSON(ctx, c.url, map[string]string{"text": text})
+}
+
+// telegramAPI is the base URL of the Telegram Bot API.
+const telegramAPI = "https://api.telegram.org"
+
+// telegramChannel sends messages through a Telegram bot.
+type telegramChannel struct {
+	baseURL string
+	token   string
+	chatID  string
+}
+
+func newTelegramChannel(cfg ChannelConfig) (Channel, error) {
+	if cfg.Token == "" || cfg.ChatID == "" {
+		return nil, fmt.Errorf("telegram channel: token and chat_id are required")
+	}
+	return &telegramChannel{baseURL: telegramAPI, token: cfg.Token, chatID: cfg.ChatID}, nil
+}
+
+func (c *telegramChannel) Send(ctx context.Context, msg Message) error {
+	text := msg.Text
+	if msg.Subject != "" {
+		text = msg.Subject + "\n\n" + text
+	}
+	return postJSON(ctx, c.baseURL+"/bot"+c.token+"/sendMessage", map[string]string{
+		"chat_id": c.chatID,
+		"text":    text,
+	})
+}
+
+// twilioAPI is the base URL of the Twilio REST API.
+const twilioAPI = "https://api.twilio.com"
+
+// twilioChannel sends SMS through Twilio.
+type twilioChannel struct {
+	baseURL    string
+	accountSID string
+	token      string
+	from, to   string
+}
+
+func newTwilioChannel(cfg ChannelConfig) (Channel, error) {
+	if cfg.AccountSID == "" || cfg.Token == "" || cfg.From == "" || cfg.To == "" {
+		return nil, fmt.Errorf("sms channel: account_sid, token, from and to are required")
+	}
+	return &twilioChannel{
+		baseURL:    twilioAPI,
+		accountSID: cfg.AccountSID,
+		token:      cfg.Token,
+		from:       cfg.From,
+		to:         cfg.To,
+	}, nil
+}
+
+func (c *twilioChannel) Send(ctx context.Context, msg Message) error {
+	form := url.Values{
+		"From": {c.from},
+		"To":   {c.to},
+		"Body": {msg.Text},
+	}
+	endpoint := c.baseURL + "/2010-04-01/Accounts/" + url.PathEscape(c.accountSID) + "/Messages.json"
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(c.accountSID, c.token)
+	return do(req)
+}
+
+func postJSON(ctx context.Context, endpoint string, body any) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	return do(req)
+}
+
+// do sends req and treats any non-2xx response as an error. The request URL
+// is left out of errors since it may embed credentials.
+func do(req *http.Request) error {
+	resp, err := httpClient.Do(req)
+	if urlErr, ok := err.(*url.Error); ok {
+		err = urlErr.Err
+	}
+	if err != nil {
+		return fmt.Errorf("sending notification to %s: %w", req.URL.Host, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("sending notification to %s: %s: %s", req.URL.Host, resp.Status, bytes.TrimSpace(body))
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+// recorder is a test server remembering the last request it received.
+type recorder struct {
+	*httptest.Server
+	path, contentType, user, password string
+	body                              []byte
+	status                            int
+}
+
+func newRecorder(t *testing.T) *recorder {
+	r := &recorder{status: http.StatusOK}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		r.path = req.URL.Path
+		r.contentType = req.Header.Get("Content-Type")
+		r.user, r.password, _ = req.BasicAuth()
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("reading request body: %v", err)
+		}
+		r.body = body
+		w.WriteHeader(r.status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *recorder) json(t *testing.T) map[string]string {
+	t.Helper()
+	var m map[string]string
+	if err := json.Unmarshal(r.body, &m); err != nil {
+		t.Fatalf("request body %q is not JSON: %v", r.body, err)
+	}
+	return m
+}
+
+var testMessage = Message{Locale: "en", Subject: "Panchangam", Text: "Tithi: Shukla Ekadashi"}
+
+func TestWebhookChannel(t *testing.T) {
+	srv := newRecorder(t)
+	ch, err := NewChannel(ChannelConfig{Type: "webhook", URL: srv.URL + "/hook"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ch.Send(context.Background(), testMessage); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	body := srv.json(t)
+	if srv.path != "/hook" || body["subject"] != "Panchangam" || body["text"] != testMessage.Text || body["locale"] != "en" {
+		t.Errorf("unexpected request to %s: %v", srv.path, body)
+	}
+}
+
+func TestSlackChannel(t *testing.T) {
+	srv := newRecorder(t)
+	ch, err := NewChannel(ChannelConfig{Type: "slack", URL: srv.URL})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ch.Send(context.Background(), testMessage); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if got, want := srv.json(t)["text"], "*Panchangam*\nTithi: Shukla Ekadashi"; got != want {
+		t.Errorf("text = %q, want %q", got, want)
+	}
+}
+
+func TestTelegramChannel(t *testing.T) {
+	srv := newRecorder(t)
+	ch, err := NewChannel(ChannelConfig{Type: "telegram", Token: "123:abc", ChatID: "@temple"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch.(*telegramChannel).baseURL = srv.URL
+	if err := ch.Send(context.Background(), testMessage); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	body := srv.json(t)
+	if srv.path != "/bot123:abc/sendMessage" || body["chat_id"] != "@temple" {
+		t.Errorf("unexpected request to %s: %v", srv.path, body)
+	}
+}
+
+func TestTwilioChannel(t *testing.T) {
+	srv := newRecorder(t)
+	ch, err := NewChannel(ChannelConfig{Type: "sms", AccountSID: "AC1", Token: "secret", From: "+100", To: "+200"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch.(*twilioChannel).baseURL = srv.URL
+	if err := ch.Send(context.Background(), testMessage); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	if srv.path != "/2010-04-01/Accounts/AC1/Messages.json" || srv.user != "AC1" || srv.password != "secret" {
+		t.Errorf("unexpected request to %s as %s", srv.path, srv.user)
+	}
+	if srv.contentType != "application/x-www-form-urlencoded" || !strings.Contains(string(srv.body), "Body=Tithi") {
+		t.Errorf("unexpected %s body %q", srv.contentType, srv.body)
+	}
+}
+
+func TestChannelErrors(t *testing.T) {
+	srv := newRecorder(t)
+	srv.status = http.StatusForbidden
+	ch, err := NewChannel(ChannelConfig{Type: "telegram", Token: "123:abc", ChatID: "@temple"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ch.(*telegramChannel).baseURL = srv.URL
+	err = ch.Send(context.Background(), testMessage)
+	if err == nil {
+		t.Fatal("Send() error = nil for a 403 response")
+	}
+	if strings.Contains(err.Error(), "123:abc") {
+		t.Errorf("error %q leaks the bot token", err)
+	}
+
+	for _, cfg := range []ChannelConfig{
+		{Type: "pigeon"},
+		{Type: "webhook"},
+		{Type: "telegram", Token: "123:abc"},
+		{Type: "sms", AccountSID: "AC1", Token: "secret", From: "+100"},
+	} {
+		if _, err := NewChannel(cfg); err == nil {
+			t.Errorf("NewChannel(%+v) error = nil", cfg)
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Package notify delivers panchangam messages to subscribers over
+// pluggable channels such as webhooks, Slack, Telegram and SMS. A Service
+// sends the daily summary and the transition alerts of configured
+// locations as they fall due.
+package notify
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+)
+
+// Message is a rendered notification.
+type Message struct {
+	// Locale is the locale the message was rendered in, e.g. "en".
+	Locale string
+	// Subject is a one-line title, used by channels that support one.
+	Subject string
+	// Text is the plain text body.
+	Text string
+}
+
+// Channel delivers messages to one destination.
+type Channel interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// ChannelConfig selects and configures the channel of a subscription. Only
+// the settings used by Type need to be set.
+type ChannelConfig struct {
+	// Type is the name of a registered channel: webhook, slack, telegram or
+	// sms.
+	Type string `json:"type"`
+	// URL is the endpoint of webhook and slack channels.
+	URL string `json:"url,omitempty"`
+	// Token is the Telegram bot token or the Twilio auth token.
+	Token string `json:"token,omitempty"`
+	// ChatID is the Telegram chat or channel to post to.
+	ChatID string `json:"chat_id,omitempty"`
+	// AccountSID is the Twilio account sending SMS.
+	AccountSID string `json:"account_sid,omitempty"`
+	// From and To are the SMS sender and recipient phone numbers.
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+}
+
+// ChannelFactory creates a channel from its configuration.
+type ChannelFactory func(cfg ChannelConfig) (Channel, error)
+
+var (
+	factoriesMu sync.RWMutex
+	factories   = map[string]ChannelFactory{
+		"webhook":  newWebhookChannel,
+		"slack":    newSlackChannel,
+		"telegram": newTelegramChannel,
+		"sms":      newTwilioChannel,
+	}
+)
+
+// RegisterChannel makes a channel type available to NewChannel, replacing
+// any channel registered under the same name.
+func RegisterChannel(name string, factory ChannelFactory) {
+	factoriesMu.Lock()
+	defer factoriesMu.Unlock()
+	factories[name] = factory
+}
+
+// Channels returns the names of the registered channel types in sorted
+// order.
+func Channels() []string {
+	factoriesMu.RLock()
+	defer factoriesMu.RUnlock()
+	names := make([]string, 0, len(factories))
+	for name := range factories {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// NewChannel returns the channel described by cfg.
+func NewChannel(cfg ChannelConfig) (Channel, error) {
+	factoriesMu.RLock()
+	factory, ok := factories[cfg.Type]
+	factoriesMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("unknown channel type %q", cfg.Type)
+	}
+	return factory(cfg)
+}
+
+// httpClient is shared by the HTTP based channels.
+var httpClient = &http.Client{Timeout: 10 * time.Second}
@@ -0,0 +1,108 @@
+package notify
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/naren-m/panchangam/transition"
+)
+
+// Config describes the locations a Service notifies the panchangam of and
+// the subscriptions to them. It is read from JSON, e.g.
+//
+//	{
+//	  "locations": [
+//	    {"name": "Chennai", "latitude": 13.0827, "longitude": 80.2707, "timezone": "Asia/Kolkata", "region": "tamil_nadu"}
+//	  ],
+//	  "subscriptions": [
+//	    {"id": "family", "locale": "ta", "location": "Chennai", "daily": "05:30", "alerts": ["rahu_kalam"],
+//	     "channel": {"type": "telegram", "token": "123:abc", "chat_id": "@family"}},
+//	    {"id": "team", "location": "Chennai", "alerts": ["tithi", "nakshatra"],
+//	     "channel": {"type": "slack", "url": "https://hooks.slack.com/services/T0/B0/X"}}
+//	  ]
+//	}
+type Config struct {
+	Locations     []Location     `json:"locations"`
+	Subscriptions []Subscription `json:"subscriptions"`
+	// NoticeMinutes is how long before Rahu Kalam, Yamagandam and Gulika
+	// Kalam start they are alerted. It defaults to 5 minutes.
+	NoticeMinutes int `json:"notice_minutes,omitempty"`
+}
+
+// Location is a place whose panchangam is notified.
+type Location struct {
+	// Name identifies the location in subscriptions and titles its
+	// messages, e.g. "Chennai".
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Timezone is the IANA timezone of the location, e.g. Asia/Kolkata.
+	Timezone string `json:"timezone"`
+	// Region selects the festivals of the daily summary, e.g. tamil_nadu;
+	// empty for all.
+	Region string `json:"region,omitempty"`
+}
+
+// LoadConfig reads and validates the configuration in the JSON file at path.
+func LoadConfig(path string) (Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Config{}, err
+	}
+	var cfg Config
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+	if err := cfg.Validate(); err != nil {
+		return Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// Validate checks that the locations have valid timezones and that every
+// subscription names one of them, a valid daily time and known kinds of
+// transition.
+func (c *Config) Validate() error {
+	var errs []error
+	names := map[string]bool{}
+	for _, l := range c.Locations {
+		if _, err := time.LoadLocation(l.Timezone); err != nil {
+			errs = append(errs, fmt.Errorf("location %q: invalid timezone %q", l.Name, l.Timezone))
+		}
+		names[l.Name] = true
+	}
+	for _, sub := range c.Subscriptions {
+		if !names[sub.Location] {
+			errs = append(errs, fmt.Errorf("subscription %q: unknown location %q", sub.ID, sub.Location))
+		}
+		if sub.Daily != "" {
+			if _, err := time.Parse("15:04", sub.Daily); err != nil {
+				errs = append(errs, fmt.Errorf("subscription %q: invalid daily time %q: expected HH:MM", sub.ID, sub.Daily))
+			}
+		}
+		for _, kind := range sub.Alerts {
+			if _, err := transition.ParseKind(kind); err != nil {
+				errs = append(errs, fmt.Errorf("subscription %q: %w", sub.ID, err))
+			}
+		}
+		if sub.Daily == "" && len(sub.Alerts) == 0 {
+			errs = append(errs, fmt.Errorf("subscription %q: neither daily nor alerts set", sub.ID))
+		}
+	}
+	if c.NoticeMinutes < 0 {
+		errs = append(errs, fmt.Errorf("invalid notice_minutes %d", c.NoticeMinutes))
+	}
+	return errors.Join(errs...)
+}
+
+func (c *Config) location(name string) Location {
+	for _, l := range c.Locations {
+		if l.Name == name {
+			return l
+		}
+	}
+	return Location{}
+}
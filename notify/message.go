@@ -0,0 +1,107 @@
+package notify
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"text/template"
+)
+
+// DailySummary is the panchangam of one day at one place, as broadcast to
+// subscribers.
+type DailySummary struct {
+	// Place is a human readable name of the location, e.g. "Chennai".
+	Place     string
+	Date      string
+	Sunrise   string
+	Sunset    string
+	Tithi     string
+	Nakshatra string
+	Yoga      string
+	Karana    string
+	// Festivals lists the names of the festivals observed on the day.
+	Festivals []string
+}
+
+// DefaultLocale is used when a subscription has no locale or one without
+// templates.
+const DefaultLocale = "en"
+
+// dailyTemplates holds the subject and body templates of the daily summary
+// per locale.
+var dailyTemplates = map[string]struct{ subject, text string }{
+	"en": {
+		subject: "Panchangam for {{.Date}}{{with .Place}} in {{.}}{{end}}",
+		text: `Sunrise: {{.Sunrise}}, Sunset: {{.Sunset}}
+Tithi: {{.Tithi}}
+Nakshatra: {{.Nakshatra}}
+Yoga: {{.Yoga}}
+Karana: {{.Karana}}{{if .Festivals}}
+Festivals: {{join .Festivals ", "}}{{end}}`,
+	},
+	"hi": {
+		subject: "{{.Date}} का पंचांग{{with .Place}} ({{.}}){{end}}",
+		text: `सूर्योदय: {{.Sunrise}}, सूर्यास्त: {{.Sunset}}
+तिथि: {{.Tithi}}
+नक्षत्र: {{.Nakshatra}}
+योग: {{.Yoga}}
+करण: {{.Karana}}{{if .Festivals}}
+पर्व: {{join .Festivals ", "}}{{end}}`,
+	},
+	"ta": {
+		subject: "{{.Date}} பஞ்சாங்கம்{{with .Place}} ({{.}}){{end}}",
+		text: `சூரிய உதயம்: {{.Sunrise}}, சூரிய அஸ்தமனம்: {{.Sunset}}
+திதி: {{.Tithi}}
+நட்சத்திரம்: {{.Nakshatra}}
+யோகம்: {{.Yoga}}
+கரணம்: {{.Karana}}{{if .Festivals}}
+பண்டிகைகள்: {{join .Festivals ", "}}{{end}}`,
+	},
+}
+
+var templateFuncs = template.FuncMap{"join": strings.Join}
+
+type localizedTemplate struct {
+	subject, text *template.Template
+}
+
+// parsedTemplates holds the templates of the locales rendered so far, parsed
+// on first use so that only the locales of the subscribers are parsed.
+var parsedTemplates sync.Map // locale -> func() localizedTemplate
+
+// templatesFor returns the parsed templates of locale, or false when it has
+// none.
+func templatesFor(locale string) (localizedTemplate, bool) {
+	if parse, ok := parsedTemplates.Load(locale); ok {
+		return parse.(func() localizedTemplate)(), true
+	}
+	t, ok := dailyTemplates[locale]
+	if !ok {
+		return localizedTemplate{}, false
+	}
+	parse, _ := parsedTemplates.LoadOrStore(locale, sync.OnceValue(func() localizedTemplate {
+		return localizedTemplate{
+			subject: template.Must(template.New(locale + "-subject").Funcs(templateFuncs).Parse(t.subject)),
+			text:    template.Must(template.New(locale + "-text").Funcs(templateFuncs).Parse(t.text)),
+		}
+	}))
+	return parse.(func() localizedTemplate)(), true
+}
+
+// RenderDaily renders the daily summary in locale, falling back to
+// DefaultLocale when the locale has no templates.
+func RenderDaily(locale string, s DailySummary) (Message, error) {
+	t, ok := templatesFor(locale)
+	if !ok {
+		locale = DefaultLocale
+		t, _ = templatesFor(locale)
+	}
+	var subject, text strings.Builder
+	if err := t.subject.Execute(&subject, s); err != nil {
+		return Message{}, fmt.Errorf("rendering %s subject: %w", locale, err)
+	}
+	if err := t.text.Execute(&text, s); err != nil {
+		return Message{}, fmt.Errorf("rendering %s text: %w", locale, err)
+	}
+	return Message{Locale: locale, Subject: subject.String(), Text: text.String()}, nil
+}
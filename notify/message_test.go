@@ -0,0 +1,85 @@
+package notify
+
+import (
+	"context"
+	"strings"
+	"testing"
+)
+
+var testSummary = DailySummary{
+	Place:     "Chennai",
+	Date:      "2024-04-19",
+	Sunrise:   "05:59:12",
+	Sunset:    "18:25:40",
+	Tithi:     "Shukla Ekadashi",
+	Nakshatra: "Purva Phalguni",
+	Yoga:      "Vriddhi",
+	Karana:    "Vishti",
+	Festivals: []string{"Ekadashi"},
+}
+
+func TestRenderDaily(t *testing.T) {
+	tests := []struct {
+		locale, wantLocale, subject, text string
+	}{
+		{"en", "en", "Panchangam for 2024-04-19 in Chennai", "Tithi: Shukla Ekadashi"},
+		{"ta", "ta", "2024-04-19 பஞ்சாங்கம் (Chennai)", "திதி: Shukla Ekadashi"},
+		{"hi", "hi", "2024-04-19 का पंचांग (Chennai)", "पर्व: Ekadashi"},
+		{"fr", "en", "Panchangam for 2024-04-19 in Chennai", "Festivals: Ekadashi"},
+		{"", "en", "Panchangam for 2024-04-19 in Chennai", "Sunrise: 05:59:12, Sunset: 18:25:40"},
+	}
+	for _, tt := range tests {
+		msg, err := RenderDaily(tt.locale, testSummary)
+		if err != nil {
+			t.Fatalf("RenderDaily(%q) error = %v", tt.locale, err)
+		}
+		if msg.Locale != tt.wantLocale || msg.Subject != tt.subject || !strings.Contains(msg.Text, tt.text) {
+			t.Errorf("RenderDaily(%q) = %+v", tt.locale, msg)
+		}
+	}
+}
+
+// fakeChannel records messages and fails when err is set.
+type fakeChannel struct {
+	sent []Message
+	err  error
+}
+
+func (c *fakeChannel) Send(_ context.Context, msg Message) error {
+	c.sent = append(c.sent, msg)
+	return c.err
+}
+
+func TestBroadcastDaily(t *testing.T) {
+	var channels []*fakeChannel
+	RegisterChannel("fake", func(cfg ChannelConfig) (Channel, error) {
+		ch := &fakeChannel{}
+		if cfg.URL == "fail" {
+			ch.err = context.DeadlineExceeded
+		}
+		channels = append(channels, ch)
+		return ch, nil
+	})
+
+	n, err := NewNotifier([]Subscription{
+		{ID: "temple-ta", Locale: "ta", Channel: ChannelConfig{Type: "fake"}},
+		{ID: "broken", Channel: ChannelConfig{Type: "fake", URL: "fail"}},
+		{ID: "temple-en", Locale: "en", Channel: ChannelConfig{Type: "fake"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = n.BroadcastDaily(context.Background(), testSummary)
+	if err == nil || !strings.Contains(err.Error(), `"broken"`) {
+		t.Errorf("BroadcastDaily() error = %v, want failure of broken", err)
+	}
+	for i, want := range []string{"ta", "en", "en"} {
+		if len(channels[i].sent) != 1 || channels[i].sent[0].Locale != want {
+			t.Errorf("channel %d received %+v, want one %s message", i, channels[i].sent, want)
+		}
+	}
+
+	if _, err := NewNotifier([]Subscription{{ID: "x", Channel: ChannelConfig{Type: "pigeon"}}}); err == nil {
+		t.Errorf("NewNotifier() accepted an unknown channel")
+	}
+}
@@ -0,0 +1,66 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// Subscription is a destination for the daily panchangam.
+type Subscription struct {
+	ID string `json:"id"`
+	// Locale selects the language of the messages, e.g. "ta".
+	Locale  string        `json:"locale,omitempty"`
+	Channel ChannelConfig `json:"channel"`
+	// Location names the location of the Config whose panchangam a Service
+	// sends.
+	Location string `json:"location,omitempty"`
+	// Daily is the local time, e.g. "05:30", a Service sends the daily
+	// summary at; empty sends none.
+	Daily string `json:"daily,omitempty"`
+	// Alerts lists the kinds of transition a Service alerts as they
+	// happen, e.g. tithi or rahu_kalam, whose start is announced ahead.
+	Alerts []string `json:"alerts,omitempty"`
+}
+
+// Notifier broadcasts messages to subscriptions over their channels.
+type Notifier struct {
+	subscriptions []Subscription
+	channels      []Channel
+}
+
+// NewNotifier returns a Notifier for subs, creating the channel of every
+// subscription up front so that configuration errors surface early.
+func NewNotifier(subs []Subscription) (*Notifier, error) {
+	n := &Notifier{subscriptions: subs}
+	for _, sub := range subs {
+		ch, err := NewChannel(sub.Channel)
+		if err != nil {
+			return nil, fmt.Errorf("subscription %q: %w", sub.ID, err)
+		}
+		n.channels = append(n.channels, ch)
+	}
+	return n, nil
+}
+
+// BroadcastDaily sends the daily summary to every subscription in its
+// locale. Delivery continues past failing subscriptions; their errors are
+// joined in the result.
+func (n *Notifier) BroadcastDaily(ctx context.Context, s DailySummary) error {
+	rendered := map[string]Message{}
+	var errs []error
+	for i, sub := range n.subscriptions {
+		msg, ok := rendered[sub.Locale]
+		if !ok {
+			var err error
+			if msg, err = RenderDaily(sub.Locale, s); err != nil {
+				return err
+			}
+			rendered[sub.Locale] = msg
+		}
+		if err := n.channels[i].Send(ctx, msg); err != nil {
+			errs = append(errs, fmt.Errorf("subscription %q: %w", sub.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,261 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/log"
+	"github.com/naren-m/panchangam/observability"
+	"github.com/naren-m/panchangam/transition"
+)
+
+var logger = log.Logger()
+
+// DefaultInterval is how often a Service looks for due messages.
+const DefaultInterval = time.Minute
+
+// Service sends the daily summaries and transition alerts of the
+// subscriptions of a Config as they fall due. It is run by `client notify
+// serve` and by servers started with -notify-config.
+type Service struct {
+	cfg       Config
+	festivals festival.Source
+	channels  []Channel
+	interval  time.Duration
+	now       func() time.Time
+}
+
+// ServiceOption configures a Service.
+type ServiceOption func(*Service)
+
+// WithInterval sets how often the service looks for due messages, and so
+// how late one may be sent. It defaults to DefaultInterval.
+func WithInterval(d time.Duration) ServiceOption {
+	return func(s *Service) {
+		s.interval = d
+	}
+}
+
+// WithClock sets the source of the current time, which Run sends the
+// messages falling due from and up to. It defaults to time.Now.
+func WithClock(now func() time.Time) ServiceOption {
+	return func(s *Service) {
+		s.now = now
+	}
+}
+
+// NewService returns a Service for cfg, whose daily summaries list the
+// festivals of festivals. It creates the channel of every subscription up
+// front so that configuration errors surface early.
+func NewService(cfg Config, festivals festival.Source, opts ...ServiceOption) (*Service, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	s := &Service{cfg: cfg, festivals: festivals, interval: DefaultInterval, now: time.Now}
+	for _, sub := range cfg.Subscriptions {
+		ch, err := NewChannel(sub.Channel)
+		if err != nil {
+			return nil, fmt.Errorf("subscription %q: %w", sub.ID, err)
+		}
+		s.channels = append(s.channels, ch)
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s, nil
+}
+
+// Run sends the messages falling due from the time it is called until ctx
+// ends. Messages due before Run are not sent.
+func (s *Service) Run(ctx context.Context) {
+	logger.InfoContext(ctx, "Sending notifications", "subscriptions", len(s.cfg.Subscriptions), "locations", len(s.cfg.Locations))
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	last := s.now()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			now := s.now()
+			if err := s.Send(ctx, last, now); err != nil {
+				logger.ErrorContext(ctx, "failed to send notifications", "error", err)
+				observability.RecordError(ctx, "notify", err, observability.WithCategory(observability.CategoryDependency),
+					observability.WithRetryable(true))
+			}
+			last = now
+		}
+	}
+}
+
+// Send sends the messages of every subscription due after from until to.
+// Sending continues past failing subscriptions; their errors are joined in
+// the result.
+func (s *Service) Send(ctx context.Context, from, to time.Time) error {
+	var errs []error
+	for i, sub := range s.cfg.Subscriptions {
+		msgs, err := s.due(ctx, sub, from, to)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %q: %w", sub.ID, err))
+			continue
+		}
+		for _, msg := range msgs {
+			if err := s.channels[i].Send(ctx, msg); err != nil {
+				errs = append(errs, fmt.Errorf("subscription %q: %w", sub.ID, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// SendDaily sends the daily summary of date to every subscription with a
+// daily time, whatever the time.
+func (s *Service) SendDaily(ctx context.Context, date string) error {
+	var errs []error
+	for i, sub := range s.cfg.Subscriptions {
+		if sub.Daily == "" {
+			continue
+		}
+		msg, err := s.daily(ctx, sub, date)
+		if err == nil {
+			err = s.channels[i].Send(ctx, msg)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf("subscription %q: %w", sub.ID, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// due returns the messages of sub due after from until to.
+func (s *Service) due(ctx context.Context, sub Subscription, from, to time.Time) ([]Message, error) {
+	l := s.cfg.location(sub.Location)
+	zone, err := time.LoadLocation(l.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	var msgs []Message
+	if sub.Daily != "" {
+		daily, _ := time.Parse("15:04", sub.Daily)
+		for day := civilDate(from.In(zone)); !day.After(to); day = day.AddDate(0, 0, 1) {
+			at := time.Date(day.Year(), day.Month(), day.Day(), daily.Hour(), daily.Minute(), 0, 0, zone)
+			if !at.After(from) || at.After(to) {
+				continue
+			}
+			msg, err := s.daily(ctx, sub, day.Format("2006-01-02"))
+			if err != nil {
+				return nil, err
+			}
+			msgs = append(msgs, msg)
+		}
+	}
+	if len(sub.Alerts) > 0 {
+		kinds := make([]transition.Kind, len(sub.Alerts))
+		for i, k := range sub.Alerts {
+			kinds[i] = transition.Kind(k)
+		}
+		notice := transition.DefaultNotice
+		if s.cfg.NoticeMinutes > 0 {
+			notice = time.Duration(s.cfg.NoticeMinutes) * time.Minute
+		}
+		scheduler := transition.NewScheduler(astronomy.Location{Latitude: l.Latitude, Longitude: l.Longitude}, zone,
+			transition.WithKinds(kinds...), transition.WithNotice(notice))
+		// Between includes its start and Send excludes it.
+		transitions, err := scheduler.Between(from.Add(time.Nanosecond), to.Add(time.Nanosecond))
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range transitions {
+			if alerted(t) {
+				msgs = append(msgs, RenderAlert(l.Name, t))
+			}
+		}
+	}
+	return msgs, nil
+}
+
+// alerted reports whether t is alerted: the notice of a period, which
+// announces it, or the change of an element or the rise or set of the sun
+// or moon.
+func alerted(t transition.Transition) bool {
+	switch t.Kind {
+	case transition.RahuKalam, transition.Yamagandam, transition.GulikaKalam:
+		return t.Event == transition.Notice
+	default:
+		return t.Event == transition.Start
+	}
+}
+
+// daily renders the daily summary of sub on date.
+func (s *Service) daily(ctx context.Context, sub Subscription, date string) (Message, error) {
+	summary, err := Summarize(ctx, s.festivals, s.cfg.location(sub.Location), date, sub.Locale)
+	if err != nil {
+		return Message{}, err
+	}
+	return RenderDaily(sub.Locale, summary)
+}
+
+// Summarize computes the daily summary of l on date, in YYYY-MM-DD format:
+// the times of sunrise and sunset, the elements at sunrise and the names in
+// locale, or English, of the festivals of festivals observed on the day.
+func Summarize(ctx context.Context, festivals festival.Source, l Location, date, locale string) (DailySummary, error) {
+	zone, err := time.LoadLocation(l.Timezone)
+	if err != nil {
+		return DailySummary{}, err
+	}
+	day, err := time.ParseInLocation("2006-01-02", date, zone)
+	if err != nil {
+		return DailySummary{}, err
+	}
+	loc := astronomy.Location{Latitude: l.Latitude, Longitude: l.Longitude}
+	sun, err := astronomy.CalculateSunTimes(loc, day, astronomy.WithPolarFallback())
+	if err != nil {
+		return DailySummary{}, err
+	}
+	elements := astronomy.CalculateElements(sun.Sunrise)
+	summary := DailySummary{
+		Place:     l.Name,
+		Date:      date,
+		Sunrise:   sun.Sunrise.In(zone).Format("15:04"),
+		Sunset:    sun.Sunset.In(zone).Format("15:04"),
+		Tithi:     elements.Tithi.Name,
+		Nakshatra: elements.Nakshatra.Name,
+		Yoga:      elements.Yoga.Name,
+		Karana:    elements.Karana.Name,
+	}
+	rules, err := festivals.ForRegion(l.Region)
+	if err != nil {
+		return DailySummary{}, err
+	}
+	events, err := rules.GenerateContext(ctx, day, day, l.Region, loc)
+	if err != nil {
+		return DailySummary{}, err
+	}
+	for _, e := range events {
+		name := e.Names[locale]
+		if name == "" {
+			name = e.Names[DefaultLocale]
+		}
+		summary.Festivals = append(summary.Festivals, name)
+	}
+	return summary, nil
+}
+
+// RenderAlert renders the alert of a transition at place, e.g. "Rahu Kalam
+// starts in 5 minutes, at 15:25". Alerts are in English.
+func RenderAlert(place string, t transition.Transition) Message {
+	subject := "Panchangam"
+	if place != "" {
+		subject += " in " + place
+	}
+	return Message{Locale: DefaultLocale, Subject: subject, Text: t.Message()}
+}
+
+func civilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
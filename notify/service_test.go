@@ -0,0 +1,143 @@
+package notify
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/services/panchangam/panchangamtest"
+)
+
+// inbox is a channel remembering the messages sent to it.
+type inbox struct {
+	mu   sync.Mutex
+	msgs []Message
+}
+
+func (c *inbox) Send(ctx context.Context, msg Message) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.msgs = append(c.msgs, msg)
+	return nil
+}
+
+var testLocation = Location{Name: "Delhi", Latitude: 28.6139, Longitude: 77.2090, Timezone: "Asia/Kolkata"}
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "notify.json")
+	if err := os.WriteFile(path, []byte(`{
+		"locations": [{"name": "Delhi", "latitude": 28.6139, "longitude": 77.209, "timezone": "Asia/Kolkata"}],
+		"subscriptions": [{"id": "team", "location": "Delhi", "daily": "05:30", "alerts": ["rahu_kalam"],
+			"channel": {"type": "slack", "url": "https://hooks.slack.com/services/T0/B0/X"}}]
+	}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := LoadConfig(path)
+	if err != nil {
+		t.Fatalf("LoadConfig() error = %v", err)
+	}
+	if len(cfg.Subscriptions) != 1 || cfg.Subscriptions[0].Daily != "05:30" || cfg.Subscriptions[0].Channel.Type != "slack" {
+		t.Errorf("LoadConfig() = %+v", cfg)
+	}
+
+	for _, tt := range []struct {
+		sub  Subscription
+		want string
+	}{
+		{Subscription{ID: "a", Location: "Mumbai", Daily: "06:00"}, "unknown location"},
+		{Subscription{ID: "b", Location: "Delhi", Daily: "6am"}, "invalid daily time"},
+		{Subscription{ID: "c", Location: "Delhi", Alerts: []string{"eclipse"}}, "unknown transition kind"},
+		{Subscription{ID: "d", Location: "Delhi"}, "neither daily nor alerts"},
+	} {
+		cfg := Config{Locations: []Location{testLocation}, Subscriptions: []Subscription{tt.sub}}
+		if err := cfg.Validate(); err == nil || !strings.Contains(err.Error(), tt.want) {
+			t.Errorf("Validate() of %+v error = %v, want %q", tt.sub, err, tt.want)
+		}
+	}
+}
+
+func TestServiceSend(t *testing.T) {
+	box := &inbox{}
+	RegisterChannel("inbox", func(ChannelConfig) (Channel, error) { return box, nil })
+	s, err := NewService(Config{
+		Locations: []Location{testLocation},
+		Subscriptions: []Subscription{
+			{ID: "daily", Locale: "hi", Location: "Delhi", Daily: "05:30", Channel: ChannelConfig{Type: "inbox"}},
+			{ID: "alerts", Location: "Delhi", Alerts: []string{"rahu_kalam", "sunrise"}, Channel: ChannelConfig{Type: "inbox"}},
+		},
+	}, festival.DefaultRuleSet())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Diwali of 2024 in Delhi, a Friday, whose Rahu Kalam starts mid-morning.
+	zone, _ := time.LoadLocation("Asia/Kolkata")
+	from := time.Date(2024, 11, 1, 5, 0, 0, 0, zone)
+	if err := s.Send(context.Background(), from, from.Add(12*time.Hour)); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+	var texts []string
+	for _, m := range box.msgs {
+		texts = append(texts, m.Subject+": "+m.Text)
+	}
+	if len(box.msgs) != 3 {
+		t.Fatalf("Send() sent %d messages, want the summary, sunrise and the notice of Rahu Kalam:\n%s", len(box.msgs), strings.Join(texts, "\n"))
+	}
+	if m := box.msgs[0]; m.Locale != "hi" || !strings.Contains(m.Subject, "2024-11-01") || !strings.Contains(m.Text, "पर्व:") {
+		t.Errorf("daily summary = %+v, want the festivals of 2024-11-01 in Hindi", m)
+	}
+	if !strings.Contains(box.msgs[1].Text, "Sunrise at") || !strings.Contains(box.msgs[2].Text, "Rahu Kalam starts in 5 minutes") {
+		t.Errorf("alerts = %v, want sunrise and the notice of Rahu Kalam", texts[1:])
+	}
+
+	box.msgs = nil
+	if err := s.Send(context.Background(), from.Add(12*time.Hour), from.Add(13*time.Hour)); err != nil || len(box.msgs) != 0 {
+		t.Errorf("Send() of a quiet hour sent %v, error %v", box.msgs, err)
+	}
+}
+
+func TestServiceRun(t *testing.T) {
+	box := &inbox{}
+	RegisterChannel("run", func(ChannelConfig) (Channel, error) { return box, nil })
+	zone, _ := time.LoadLocation("Asia/Kolkata")
+	clock := panchangamtest.NewClock(time.Date(2024, 11, 1, 5, 0, 0, 0, zone))
+	s, err := NewService(Config{
+		Locations:     []Location{testLocation},
+		Subscriptions: []Subscription{{ID: "daily", Location: "Delhi", Daily: "05:30", Channel: ChannelConfig{Type: "run"}}},
+	}, festival.DefaultRuleSet(), WithClock(clock.Now), WithInterval(time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	sent := func() []Message {
+		box.mu.Lock()
+		defer box.mu.Unlock()
+		return append([]Message(nil), box.msgs...)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		s.Run(ctx)
+		close(done)
+	}()
+
+	// The summary is sent once the clock passes its daily time, and only
+	// then.
+	time.Sleep(20 * time.Millisecond)
+	if msgs := sent(); len(msgs) != 0 {
+		t.Fatalf("Run() sent %v before 05:30", msgs)
+	}
+	clock.Advance(time.Hour)
+	for deadline := time.Now().Add(5 * time.Second); len(sent()) == 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+	if msgs := sent(); len(msgs) != 1 || !strings.Contains(msgs[0].Subject, "2024-11-01") {
+		t.Errorf("Run() sent %v, want the summary of 2024-11-01", msgs)
+	}
+}
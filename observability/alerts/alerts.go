@@ -0,0 +1,197 @@
+// Package alerts generates Prometheus alerting rules for the service from
+// the names of the metrics it records, so that operators get consistent SLO
+// monitoring without writing the rules themselves.
+//
+// Metric names are converted the way the OpenTelemetry Prometheus exporter
+// converts them: dots become underscores, the unit is appended and counters
+// get a _total suffix.
+package alerts
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/cache"
+	"github.com/naren-m/panchangam/gateway"
+	"github.com/naren-m/panchangam/observability"
+	"gopkg.in/yaml.v3"
+)
+
+// Thresholds are the objectives the rules alert on.
+type Thresholds struct {
+	// ErrorRate is the fraction of requests failing with a server error.
+	ErrorRate float64
+	// P99Latency is the 99th percentile latency of each method.
+	P99Latency time.Duration
+	// CacheHitRate is the fraction of cache reads that should be hits.
+	CacheHitRate float64
+	// ShadowMismatchRate is the fraction of requests mirrored to a canary
+	// whose responses may differ from the primary ones.
+	ShadowMismatchRate float64
+	// For is how long a condition must hold before the alert fires.
+	For time.Duration
+}
+
+// DefaultThresholds are the objectives of a production deployment.
+var DefaultThresholds = Thresholds{
+	ErrorRate:          0.01,
+	P99Latency:         time.Second,
+	CacheHitRate:       0.5,
+	ShadowMismatchRate: 0.01,
+	For:                10 * time.Minute,
+}
+
+// serverErrorCodes are the gRPC codes counted as failures of the service
+// rather than of the caller.
+const serverErrorCodes = "Unknown|Internal|Unavailable|DataLoss|DeadlineExceeded|Unimplemented"
+
+// File is a Prometheus rule file.
+type File struct {
+	Groups []Group `yaml:"groups"`
+}
+
+// Group is a named group of rules evaluated together.
+type Group struct {
+	Name  string `yaml:"name"`
+	Rules []Rule `yaml:"rules"`
+}
+
+// Rule is an alerting rule.
+type Rule struct {
+	Alert       string            `yaml:"alert"`
+	Expr        string            `yaml:"expr"`
+	For         string            `yaml:"for,omitempty"`
+	Labels      map[string]string `yaml:"labels,omitempty"`
+	Annotations map[string]string `yaml:"annotations,omitempty"`
+}
+
+// Rules returns the alerting rules for th.
+func Rules(th Thresholds) File {
+	requests := counter(observability.RPCRequestsMetric, "")
+	duration := histogram(observability.RPCDurationMetric, "ms")
+	cacheRequests := counter(cache.RequestsMetric, "")
+	shadowRequests := counter(gateway.ShadowRequestsMetric, "")
+	window := "5m"
+	forDuration := promDuration(th.For)
+
+	return File{Groups: []Group{
+		{
+			Name: "panchangam-slo",
+			Rules: []Rule{
+				{
+					Alert: "PanchangamHighErrorRate",
+					Expr: fmt.Sprintf(`sum by (method) (rate(%s{code=~%q}[%s])) / sum by (method) (rate(%s[%s])) > %g`,
+						requests, serverErrorCodes, window, requests, window, th.ErrorRate),
+					For:    forDuration,
+					Labels: map[string]string{"severity": "page"},
+					Annotations: map[string]string{
+						"summary":     "{{ $labels.method }} is failing",
+						"description": fmt.Sprintf("More than %g%% of {{ $labels.method }} requests fail with a server error.", th.ErrorRate*100),
+					},
+				},
+				{
+					Alert: "PanchangamHighLatency",
+					Expr: fmt.Sprintf(`histogram_quantile(0.99, sum by (method, le) (rate(%s_bucket[%s]))) > %g`,
+						duration, window, float64(th.P99Latency)/float64(time.Millisecond)),
+					For:    forDuration,
+					Labels: map[string]string{"severity": "page"},
+					Annotations: map[string]string{
+						"summary":     "{{ $labels.method }} is slow",
+						"description": fmt.Sprintf("The 99th percentile latency of {{ $labels.method }} is above %s.", th.P99Latency),
+					},
+				},
+				{
+					// Without requests the other rules cannot fire, so a
+					// silent service or a broken metrics pipeline alerts.
+					Alert:  "PanchangamMetricsAbsent",
+					Expr:   fmt.Sprintf(`absent(%s)`, requests),
+					For:    forDuration,
+					Labels: map[string]string{"severity": "ticket"},
+					Annotations: map[string]string{
+						"summary":     "No request metrics",
+						"description": "The service has reported no request metrics. It may be down or its metrics may not reach Prometheus.",
+					},
+				},
+			},
+		},
+		{
+			Name: "panchangam-cache",
+			Rules: []Rule{{
+				Alert: "PanchangamCacheHitRateCollapse",
+				Expr: fmt.Sprintf(`sum by (cache) (rate(%s{result="hit"}[%s])) / sum by (cache) (rate(%s[%s])) < %g`,
+					cacheRequests, window, cacheRequests, window, th.CacheHitRate),
+				For:    forDuration,
+				Labels: map[string]string{"severity": "ticket"},
+				Annotations: map[string]string{
+					"summary":     "The {{ $labels.cache }} cache is missing",
+					"description": fmt.Sprintf("Fewer than %g%% of the reads of the {{ $labels.cache }} cache are hits, so most requests are computed.", th.CacheHitRate*100),
+				},
+			}},
+		},
+		{
+			Name: "panchangam-canary",
+			Rules: []Rule{{
+				Alert: "PanchangamCanaryMismatch",
+				Expr: fmt.Sprintf(`sum by (method) (rate(%s{result!="match"}[%s])) / sum by (method) (rate(%s[%s])) > %g`,
+					shadowRequests, window, shadowRequests, window, th.ShadowMismatchRate),
+				For:    forDuration,
+				Labels: map[string]string{"severity": "ticket"},
+				Annotations: map[string]string{
+					"summary":     "The canary differs on {{ $labels.method }}",
+					"description": fmt.Sprintf("More than %g%% of the {{ $labels.method }} requests mirrored to the canary get a different response or fail.", th.ShadowMismatchRate*100),
+				},
+			}},
+		},
+	}}
+}
+
+// Export writes the alerting rules for th as a Prometheus rule file.
+func Export(w io.Writer, th Thresholds) error {
+	enc := yaml.NewEncoder(w)
+	enc.SetIndent(2)
+	if err := enc.Encode(Rules(th)); err != nil {
+		return err
+	}
+	return enc.Close()
+}
+
+// promUnits maps the units of the metrics to the suffixes of their
+// Prometheus names.
+var promUnits = map[string]string{
+	"ms": "milliseconds",
+	"s":  "seconds",
+	"By": "bytes",
+}
+
+// promName returns the Prometheus name of an OpenTelemetry metric.
+func promName(name, unit string) string {
+	name = strings.ReplaceAll(name, ".", "_")
+	if suffix, ok := promUnits[unit]; ok {
+		name += "_" + suffix
+	}
+	return name
+}
+
+func counter(name, unit string) string {
+	return promName(name, unit) + "_total"
+}
+
+func histogram(name, unit string) string {
+	return promName(name, unit)
+}
+
+// promDuration formats d as a Prometheus duration, e.g. 10m.
+func promDuration(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return ""
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%dh", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%dm", d/time.Minute)
+	default:
+		return fmt.Sprintf("%ds", (d+time.Second-1)/time.Second)
+	}
+}
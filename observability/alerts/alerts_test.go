@@ -0,0 +1,76 @@
+package alerts
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestRules(t *testing.T) {
+	rules := map[string]Rule{}
+	for _, g := range Rules(DefaultThresholds).Groups {
+		for _, r := range g.Rules {
+			rules[r.Alert] = r
+		}
+	}
+	tests := []struct {
+		alert string
+		want  []string
+	}{
+		{"PanchangamHighErrorRate", []string{"panchangam_rpc_requests_total{code=~", "Internal", "> 0.01"}},
+		{"PanchangamHighLatency", []string{"panchangam_rpc_duration_milliseconds_bucket", "> 1000"}},
+		{"PanchangamMetricsAbsent", []string{"absent(panchangam_rpc_requests_total)"}},
+		{"PanchangamCacheHitRateCollapse", []string{`cache_requests_total{result="hit"}`, "< 0.5"}},
+		{"PanchangamCanaryMismatch", []string{"gateway_shadow_requests_total", "> 0.01"}},
+	}
+	for _, tt := range tests {
+		r, ok := rules[tt.alert]
+		if !ok {
+			t.Errorf("no %s rule", tt.alert)
+			continue
+		}
+		for _, want := range tt.want {
+			if !strings.Contains(r.Expr, want) {
+				t.Errorf("%s expr %q does not contain %q", tt.alert, r.Expr, want)
+			}
+		}
+		if r.For != "10m" {
+			t.Errorf("%s for = %q, want 10m", tt.alert, r.For)
+		}
+	}
+}
+
+func TestExport(t *testing.T) {
+	th := DefaultThresholds
+	th.P99Latency = 250 * time.Millisecond
+	var buf bytes.Buffer
+	if err := Export(&buf, th); err != nil {
+		t.Fatalf("Export() error = %v", err)
+	}
+	var f File
+	if err := yaml.Unmarshal(buf.Bytes(), &f); err != nil {
+		t.Fatalf("Export() wrote invalid YAML: %v\n%s", err, buf.String())
+	}
+	if len(f.Groups) == 0 || f.Groups[0].Name != "panchangam-slo" {
+		t.Fatalf("Export() groups = %+v", f.Groups)
+	}
+	if !strings.Contains(buf.String(), "> 250") {
+		t.Errorf("Export() does not use the latency threshold:\n%s", buf.String())
+	}
+}
+
+func TestPromDuration(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                "",
+		90 * time.Second: "90s",
+		5 * time.Minute:  "5m",
+		2 * time.Hour:    "2h",
+	} {
+		if got := promDuration(d); got != want {
+			t.Errorf("promDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package observability
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Attributes of a coarse span listing its steps in the order they started,
+// see WithCoarseTracing.
+const (
+	// StepNamesAttribute holds the names of the steps.
+	StepNamesAttribute = "step.names"
+	// StepDurationsAttribute holds the durations of the steps in
+	// microseconds.
+	StepDurationsAttribute = "step.durations_us"
+)
+
+// inlineSteps is the number of steps a coarse span records without
+// allocating, more than a panchangam takes.
+const inlineSteps = 8
+
+// coarseSpanKey marks the contexts below a span created by CreateSpan in
+// coarse tracing mode, holding the *coarseSpan, nil if it is not recording.
+type coarseSpanKey struct{}
+
+// coarseSpan is the span of a request in coarse tracing mode, recording
+// the spans created below it as steps, set as attributes when it ends.
+type coarseSpan struct {
+	trace.Span
+
+	mu     sync.Mutex
+	ended  bool
+	steps  []*step
+	inline [inlineSteps]step
+	refs   [inlineSteps]*step
+}
+
+// step is a span created below a coarse span. It records its duration on
+// the coarse span and passes on its attributes, events, errors and status.
+type step struct {
+	trace.Span
+	root     *coarseSpan
+	name     string
+	start    time.Time
+	duration time.Duration
+}
+
+func newCoarseSpan(span trace.Span) *coarseSpan {
+	c := &coarseSpan{Span: span}
+	c.steps = c.refs[:0]
+	return c
+}
+
+// createStep returns ctx and a step of the coarse span of ctx if ctx lies
+// below one, reporting whether it does.
+func createStep(ctx context.Context, name string) (context.Context, trace.Span, bool) {
+	v := ctx.Value(coarseSpanKey{})
+	if v == nil {
+		return ctx, nil, false
+	}
+	c := v.(*coarseSpan)
+	if c == nil {
+		// Ending a span which is not recording does nothing.
+		return ctx, trace.SpanFromContext(ctx), true
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	var s *step
+	if n := len(c.steps); n < inlineSteps {
+		s = &c.inline[n]
+	} else {
+		s = new(step)
+	}
+	*s = step{Span: c.Span, root: c, name: name, start: time.Now()}
+	c.steps = append(c.steps, s)
+	return ctx, s, true
+}
+
+// End records the duration of the step.
+func (s *step) End(...trace.SpanEndOption) {
+	d := time.Since(s.start)
+	s.root.mu.Lock()
+	defer s.root.mu.Unlock()
+	if s.duration == 0 {
+		s.duration = max(d, time.Nanosecond)
+	}
+}
+
+// SetName leaves the name of the coarse span.
+func (s *step) SetName(string) {}
+
+// End sets the steps which ended as attributes of the span, and ends it.
+func (c *coarseSpan) End(opts ...trace.SpanEndOption) {
+	c.mu.Lock()
+	if c.ended {
+		c.mu.Unlock()
+		return
+	}
+	c.ended = true
+	names := make([]string, 0, len(c.steps))
+	durations := make([]int64, 0, len(c.steps))
+	for _, s := range c.steps {
+		if s.duration > 0 {
+			names = append(names, s.name)
+			durations = append(durations, s.duration.Microseconds())
+		}
+	}
+	c.mu.Unlock()
+	if len(names) > 0 {
+		c.Span.SetAttributes(attribute.StringSlice(StepNamesAttribute, names), attribute.Int64Slice(StepDurationsAttribute, durations))
+	}
+	c.Span.End(opts...)
+}
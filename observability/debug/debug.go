@@ -0,0 +1,126 @@
+// Package debug serves the runtime debug endpoints of the server and its
+// gateway, for profiling CPU and memory hotspots, e.g. in the astronomy
+// code, in staging:
+//
+//   - /debug/pprof/, the profiles of net/http/pprof,
+//   - /debug/vars, the variables of expvar,
+//   - /debug/goroutines, the stacks of every goroutine, and
+//   - /debug/errors, the errors recorded in a window, e.g.
+//     /debug/errors?window=1h.
+//
+// The endpoints expose the internals of the process, and the errors the
+// messages of failures, e.g. the URLs and responses of webhook
+// subscribers, so they are only served on loopback addresses, e.g. reached through an SSH tunnel:
+//
+//	go tool pprof http://localhost:6060/debug/pprof/profile?seconds=30
+package debug
+
+import (
+	"encoding/json"
+	"expvar"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	runtimepprof "runtime/pprof"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/observability"
+	"github.com/naren-m/panchangam/version"
+)
+
+var started = time.Now()
+
+// defaultErrorWindow is the window of /debug/errors without a window
+// parameter.
+const defaultErrorWindow = 15 * time.Minute
+
+var publishOnce sync.Once
+
+// publish publishes the panchangam variable of expvar, holding the version
+// and uptime of the process, its goroutines and its errors by component.
+func publish() {
+	expvar.Publish("panchangam", expvar.Func(func() any {
+		errors := map[string]int64{}
+		for _, g := range observability.Errors().Summary(0).SinceStarted {
+			errors[g.Component] += g.Count
+		}
+		return map[string]any{
+			"version":       version.Version,
+			"uptimeSeconds": int64(time.Since(started).Seconds()),
+			"goroutines":    runtime.NumGoroutine(),
+			"errors":        errors,
+		}
+	}))
+}
+
+// Handler returns the handler of the debug endpoints.
+func Handler() http.Handler {
+	publishOnce.Do(publish)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	mux.HandleFunc("/debug/goroutines", goroutines)
+	mux.HandleFunc("/debug/errors", errorSummary)
+	return mux
+}
+
+// errorSummary summarizes the errors recorded by the process in the last
+// window by category, severity, component and retryability, for triage
+// without a tracing backend. The server and the gateway share the process,
+// and so the error log.
+func errorSummary(w http.ResponseWriter, r *http.Request) {
+	window := defaultErrorWindow
+	if v := r.URL.Query().Get("window"); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d <= 0 {
+			http.Error(w, fmt.Sprintf("invalid window %q: expected a duration such as 15m or 1h", v), http.StatusBadRequest)
+			return
+		}
+		window = d
+	}
+	body, err := json.MarshalIndent(observability.Errors().Summary(window), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(body)
+}
+
+// goroutines writes the stacks of every goroutine, in the format of an
+// unrecovered panic.
+func goroutines(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	runtimepprof.Lookup("goroutine").WriteTo(w, 2)
+}
+
+// Listen listens on addr, which must be a loopback address such as
+// localhost:6060 or 127.0.0.1:6060.
+func Listen(addr string) (net.Listener, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return nil, err
+	}
+	if host != "localhost" {
+		if ip := net.ParseIP(host); ip == nil || !ip.IsLoopback() {
+			return nil, fmt.Errorf("debug address %s is not a loopback address, e.g. localhost:6060", addr)
+		}
+	}
+	return net.Listen("tcp", addr)
+}
+
+// Server returns the server of the debug endpoints.
+func Server() *http.Server {
+	return &http.Server{
+		Handler:           Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+}
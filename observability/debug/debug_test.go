@@ -0,0 +1,78 @@
+package debug
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/naren-m/panchangam/observability"
+)
+
+func TestListen(t *testing.T) {
+	for _, addr := range []string{"127.0.0.1:0", "localhost:0"} {
+		l, err := Listen(addr)
+		if err != nil {
+			t.Errorf("Listen(%q) failed: %v", addr, err)
+			continue
+		}
+		l.Close()
+	}
+	for _, addr := range []string{":0", "0.0.0.0:0", "[::]:0", "example.com:6060", "6060"} {
+		if l, err := Listen(addr); err == nil {
+			l.Close()
+			t.Errorf("Listen(%q) succeeded, want an error for an address which is not loopback", addr)
+		}
+	}
+}
+
+func TestHandler(t *testing.T) {
+	srv := httptest.NewServer(Handler())
+	defer srv.Close()
+	for path, want := range map[string]string{
+		"/debug/pprof/":             "goroutine",
+		"/debug/pprof/cmdline":      "debug.test",
+		"/debug/vars":               `"panchangam"`,
+		"/debug/goroutines":         "goroutine",
+		"/debug/pprof/heap?debug=1": "heap profile",
+	} {
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || !strings.Contains(string(body), want) {
+			t.Errorf("GET %s = %d, want 200 with %q in %.200s", path, resp.StatusCode, want, body)
+		}
+	}
+}
+
+func TestErrorSummary(t *testing.T) {
+	observability.RecordError(context.Background(), "webhook", errors.New("delivering to https://hooks.example.com/s1: 500 internal"))
+	srv := httptest.NewServer(Handler())
+	defer srv.Close()
+	for path, want := range map[string]int{
+		"/debug/errors":            http.StatusOK,
+		"/debug/errors?window=1h":  http.StatusOK,
+		"/debug/errors?window=-1h": http.StatusBadRequest,
+		"/debug/errors?window=day": http.StatusBadRequest,
+	} {
+		resp, err := http.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != want {
+			t.Errorf("GET %s = %d, want %d: %s", path, resp.StatusCode, want, body)
+			continue
+		}
+		if want == http.StatusOK && (!strings.Contains(string(body), "hooks.example.com") || resp.Header.Get("Cache-Control") != "no-store") {
+			t.Errorf("GET %s = %s, want the recorded error, not cached", path, body)
+		}
+	}
+}
@@ -0,0 +1,348 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"log/slog"
+)
+
+// ErrorCategory tells what kind of failure an error is, see ClassifyError.
+type ErrorCategory string
+
+// Error categories.
+const (
+	// CategoryInvalidInput is a request the server cannot serve as it is,
+	// e.g. an invalid date or an unknown festival.
+	CategoryInvalidInput ErrorCategory = "invalid_input"
+	// CategoryAuth is a request without a valid API key or permission.
+	CategoryAuth ErrorCategory = "auth"
+	// CategoryLimit is a request over a rate limit or quota.
+	CategoryLimit ErrorCategory = "limit"
+	// CategoryTimeout is a call that ran out of time or was cancelled.
+	CategoryTimeout ErrorCategory = "timeout"
+	// CategoryDependency is a failure of a service the server depends on,
+	// e.g. an ephemeris provider, the shared cache or a webhook endpoint.
+	CategoryDependency ErrorCategory = "dependency"
+	// CategoryInternal is a failure of the server itself, e.g. a
+	// calculation which did not converge.
+	CategoryInternal ErrorCategory = "internal"
+)
+
+// ErrorSeverity tells how urgently an error needs attention.
+type ErrorSeverity string
+
+// Error severities.
+const (
+	// SeverityWarning is an error expected in normal operation, such as an
+	// invalid request, which only needs attention in numbers.
+	SeverityWarning ErrorSeverity = "warning"
+	// SeverityError is a failure of the server or a dependency.
+	SeverityError ErrorSeverity = "error"
+	// SeverityCritical is a failure losing or corrupting data.
+	SeverityCritical ErrorSeverity = "critical"
+)
+
+// ErrorClass is the category and severity of an error, and whether the
+// operation may succeed when retried.
+type ErrorClass struct {
+	Category  ErrorCategory
+	Severity  ErrorSeverity
+	Retryable bool
+}
+
+// ClassifyError returns the class of err from its gRPC status code, or its
+// context error.
+func ClassifyError(err error) ErrorClass {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, context.Canceled) {
+		return ErrorClass{CategoryTimeout, SeverityWarning, true}
+	}
+	switch status.Code(err) {
+	case grpccodes.InvalidArgument, grpccodes.OutOfRange, grpccodes.FailedPrecondition,
+		grpccodes.NotFound, grpccodes.AlreadyExists, grpccodes.Unimplemented:
+		return ErrorClass{CategoryInvalidInput, SeverityWarning, false}
+	case grpccodes.Unauthenticated, grpccodes.PermissionDenied:
+		return ErrorClass{CategoryAuth, SeverityWarning, false}
+	case grpccodes.ResourceExhausted:
+		return ErrorClass{CategoryLimit, SeverityWarning, true}
+	case grpccodes.DeadlineExceeded, grpccodes.Canceled:
+		return ErrorClass{CategoryTimeout, SeverityWarning, true}
+	case grpccodes.Unavailable, grpccodes.Aborted:
+		return ErrorClass{CategoryDependency, SeverityError, true}
+	case grpccodes.DataLoss:
+		return ErrorClass{CategoryInternal, SeverityCritical, false}
+	}
+	return ErrorClass{CategoryInternal, SeverityError, false}
+}
+
+// ErrorOption overrides the class ClassifyError gives an error.
+type ErrorOption func(*ErrorClass)
+
+// WithCategory sets the category of an error.
+func WithCategory(c ErrorCategory) ErrorOption {
+	return func(e *ErrorClass) { e.Category = c }
+}
+
+// WithSeverity sets the severity of an error.
+func WithSeverity(s ErrorSeverity) ErrorOption {
+	return func(e *ErrorClass) { e.Severity = s }
+}
+
+// WithRetryable sets whether the failed operation may succeed when retried.
+func WithRetryable(retryable bool) ErrorOption {
+	return func(e *ErrorClass) { e.Retryable = retryable }
+}
+
+// Attributes of the errors recorded by RecordError, on the spans and in
+// the ErrorsMetric counter.
+const (
+	ErrorCategoryAttribute  = "error.category"
+	ErrorSeverityAttribute  = "error.severity"
+	ErrorComponentAttribute = "error.component"
+	ErrorRetryableAttribute = "error.retryable"
+)
+
+// ErrorsMetric counts the errors recorded by RecordError, by category,
+// severity, component and retryability.
+const ErrorsMetric = "panchangam.errors"
+
+var errorsCounter = sync.OnceValue(func() metric.Int64Counter {
+	counter, err := otel.Meter("github.com/naren-m/panchangam/observability").Int64Counter(ErrorsMetric,
+		metric.WithDescription("Errors, by category, severity, component and retryability"))
+	if err != nil {
+		slog.Error("Failed to create error counter", "error", err)
+		return nil
+	}
+	return counter
+})
+
+// RecordError records err, failing in component, e.g. rpc, webhook or
+// cache: on the span of ctx, in the ErrorsMetric counter and in the
+// ErrorLog of Errors. Its class is that of ClassifyError, overridden by
+// opts, which it returns.
+func RecordError(ctx context.Context, component string, err error, opts ...ErrorOption) ErrorClass {
+	class := ClassifyError(err)
+	for _, opt := range opts {
+		opt(&class)
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String(ErrorCategoryAttribute, string(class.Category)),
+		attribute.String(ErrorSeverityAttribute, string(class.Severity)),
+		attribute.String(ErrorComponentAttribute, component),
+		attribute.Bool(ErrorRetryableAttribute, class.Retryable),
+	}
+	span := trace.SpanFromContext(ctx)
+	if span.IsRecording() {
+		span.RecordError(err, trace.WithAttributes(attrs...))
+		if class.Severity != SeverityWarning {
+			span.SetStatus(codes.Error, err.Error())
+		}
+	}
+	if counter := errorsCounter(); counter != nil {
+		counter.Add(ctx, 1, metric.WithAttributes(attrs...))
+	}
+	method, _ := grpc.Method(ctx)
+	var traceID string
+	if sc := span.SpanContext(); sc.HasTraceID() {
+		traceID = sc.TraceID().String()
+	}
+	defaultErrorLog.Add(ErrorEvent{
+		Time:      time.Now(),
+		Component: component,
+		Class:     class,
+		Method:    method,
+		Message:   status.Convert(err).Message(),
+		TraceID:   traceID,
+	})
+	return class
+}
+
+// DefaultErrorLogSize is the number of recent errors Errors keeps.
+const DefaultErrorLogSize = 1000
+
+var defaultErrorLog = NewErrorLog(DefaultErrorLogSize)
+
+// Errors returns the log of the errors recorded by RecordError.
+func Errors() *ErrorLog {
+	return defaultErrorLog
+}
+
+// ErrorEvent is an error recorded by RecordError.
+type ErrorEvent struct {
+	Time      time.Time
+	Component string
+	Class     ErrorClass
+	// Method is the full RPC method failing, if any.
+	Method  string
+	Message string
+	// TraceID identifies the trace of the error, if any.
+	TraceID string
+}
+
+// errorGroupKey identifies the errors counted together.
+type errorGroupKey struct {
+	component string
+	class     ErrorClass
+}
+
+// ErrorLog keeps the latest errors, and counts every error since it was
+// created by component and class.
+type ErrorLog struct {
+	started time.Time
+	now     func() time.Time
+
+	mu     sync.Mutex
+	recent []ErrorEvent
+	next   int
+	full   bool
+	totals map[errorGroupKey]int64
+}
+
+// NewErrorLog returns a log keeping the latest size errors.
+func NewErrorLog(size int) *ErrorLog {
+	return &ErrorLog{
+		started: time.Now(),
+		now:     time.Now,
+		recent:  make([]ErrorEvent, size),
+		totals:  map[errorGroupKey]int64{},
+	}
+}
+
+// Add adds e to the log.
+func (l *ErrorLog) Add(e ErrorEvent) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.totals[errorGroupKey{e.Component, e.Class}]++
+	if len(l.recent) == 0 {
+		return
+	}
+	l.recent[l.next] = e
+	l.next = (l.next + 1) % len(l.recent)
+	l.full = l.full || l.next == 0
+}
+
+// ErrorGroup counts the errors of a component and class.
+type ErrorGroup struct {
+	Category    ErrorCategory `json:"category"`
+	Severity    ErrorSeverity `json:"severity"`
+	Component   string        `json:"component"`
+	Retryable   bool          `json:"retryable"`
+	Count       int64         `json:"count"`
+	LastSeen    *time.Time    `json:"lastSeen,omitempty"`
+	LastMethod  string        `json:"lastMethod,omitempty"`
+	LastMessage string        `json:"lastMessage,omitempty"`
+	LastTraceID string        `json:"lastTraceId,omitempty"`
+}
+
+// ErrorSummary summarizes the errors of a window of time, and counts those
+// since the log was created.
+type ErrorSummary struct {
+	// From is the start of the window, and Truncated reports whether the
+	// log no longer holds its earliest errors, so that its counts are
+	// incomplete.
+	From      time.Time `json:"from"`
+	To        time.Time `json:"to"`
+	Truncated bool      `json:"truncated"`
+	Total     int64     `json:"total"`
+
+	ByCategory  map[ErrorCategory]int64 `json:"byCategory"`
+	BySeverity  map[ErrorSeverity]int64 `json:"bySeverity"`
+	ByComponent map[string]int64        `json:"byComponent"`
+	// Retryable and Permanent count the errors which may and may not
+	// succeed when retried.
+	Retryable int64 `json:"retryable"`
+	Permanent int64 `json:"permanent"`
+	// Groups counts the errors of the window by component and class, the
+	// most frequent first.
+	Groups []ErrorGroup `json:"groups"`
+
+	// Started is when the log was created, and SinceStarted counts every
+	// error since, by component and class.
+	Started      time.Time    `json:"started"`
+	SinceStarted []ErrorGroup `json:"sinceStarted"`
+}
+
+// Summary summarizes the errors of the last window.
+func (l *ErrorLog) Summary(window time.Duration) ErrorSummary {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := l.now()
+	s := ErrorSummary{
+		From:         now.Add(-window),
+		To:           now,
+		ByCategory:   map[ErrorCategory]int64{},
+		BySeverity:   map[ErrorSeverity]int64{},
+		ByComponent:  map[string]int64{},
+		Groups:       []ErrorGroup{},
+		Started:      l.started,
+		SinceStarted: []ErrorGroup{},
+	}
+	groups := map[errorGroupKey]*ErrorGroup{}
+	n := l.next
+	if l.full {
+		n = len(l.recent)
+	}
+	// Go through the errors from the latest, so that the first of a group
+	// is its last.
+	for i := 1; i <= n; i++ {
+		e := l.recent[(l.next-i+len(l.recent))%len(l.recent)]
+		if e.Time.Before(s.From) {
+			break
+		}
+		if i == len(l.recent) {
+			s.Truncated = true
+		}
+		s.Total++
+		s.ByCategory[e.Class.Category]++
+		s.BySeverity[e.Class.Severity]++
+		s.ByComponent[e.Component]++
+		if e.Class.Retryable {
+			s.Retryable++
+		} else {
+			s.Permanent++
+		}
+		key := errorGroupKey{e.Component, e.Class}
+		g, ok := groups[key]
+		if !ok {
+			at := e.Time
+			g = &ErrorGroup{Category: e.Class.Category, Severity: e.Class.Severity, Component: e.Component, Retryable: e.Class.Retryable,
+				LastSeen: &at, LastMethod: e.Method, LastMessage: e.Message, LastTraceID: e.TraceID}
+			groups[key] = g
+		}
+		g.Count++
+	}
+	for _, g := range groups {
+		s.Groups = append(s.Groups, *g)
+	}
+	sortErrorGroups(s.Groups)
+	for key, count := range l.totals {
+		s.SinceStarted = append(s.SinceStarted, ErrorGroup{Category: key.class.Category, Severity: key.class.Severity,
+			Component: key.component, Retryable: key.class.Retryable, Count: count})
+	}
+	sortErrorGroups(s.SinceStarted)
+	return s
+}
+
+func sortErrorGroups(groups []ErrorGroup) {
+	sort.Slice(groups, func(i, j int) bool {
+		a, b := groups[i], groups[j]
+		if a.Count != b.Count {
+			return a.Count > b.Count
+		}
+		if a.Component != b.Component {
+			return a.Component < b.Component
+		}
+		return a.Category < b.Category
+	})
+}
@@ -0,0 +1,103 @@
+package observability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestClassifyError(t *testing.T) {
+	for _, tt := range []struct {
+		err  error
+		want ErrorClass
+	}{
+		{status.Error(codes.InvalidArgument, "date: invalid date"), ErrorClass{CategoryInvalidInput, SeverityWarning, false}},
+		{status.Error(codes.Unauthenticated, "missing API key"), ErrorClass{CategoryAuth, SeverityWarning, false}},
+		{status.Error(codes.ResourceExhausted, "rate limited"), ErrorClass{CategoryLimit, SeverityWarning, true}},
+		{status.Error(codes.Unavailable, "provider down"), ErrorClass{CategoryDependency, SeverityError, true}},
+		{status.Error(codes.DataLoss, "truncated"), ErrorClass{CategoryInternal, SeverityCritical, false}},
+		{fmt.Errorf("calling horizons: %w", context.DeadlineExceeded), ErrorClass{CategoryTimeout, SeverityWarning, true}},
+		{errors.New("did not converge"), ErrorClass{CategoryInternal, SeverityError, false}},
+	} {
+		if got := ClassifyError(tt.err); got != tt.want {
+			t.Errorf("ClassifyError(%v) = %+v, want %+v", tt.err, got, tt.want)
+		}
+	}
+}
+
+func TestErrorLogSummary(t *testing.T) {
+	now := time.Date(2024, time.April, 9, 12, 0, 0, 0, time.UTC)
+	l := NewErrorLog(4)
+	l.now = func() time.Time { return now }
+	dependency := ErrorClass{CategoryDependency, SeverityError, true}
+	invalid := ErrorClass{CategoryInvalidInput, SeverityWarning, false}
+	for i, e := range []ErrorEvent{
+		{Time: now.Add(-2 * time.Hour), Component: "rpc", Class: invalid, Message: "old"},
+		{Time: now.Add(-10 * time.Minute), Component: "rpc", Class: invalid, Message: "date: invalid date"},
+		{Time: now.Add(-5 * time.Minute), Component: "webhook", Class: dependency, Message: "connection refused"},
+		{Time: now.Add(-time.Minute), Component: "webhook", Class: dependency, Message: "timeout"},
+	} {
+		e.Method = fmt.Sprint(i)
+		l.Add(e)
+	}
+
+	s := l.Summary(time.Hour)
+	if s.Total != 3 || s.Truncated || s.Retryable != 2 || s.Permanent != 1 {
+		t.Errorf("summary = %d errors, truncated %v, %d retryable, %d permanent, want 3, false, 2 and 1", s.Total, s.Truncated, s.Retryable, s.Permanent)
+	}
+	if s.ByCategory[CategoryDependency] != 2 || s.ByComponent["rpc"] != 1 || s.BySeverity[SeverityWarning] != 1 {
+		t.Errorf("summary by category %v, component %v, severity %v", s.ByCategory, s.ByComponent, s.BySeverity)
+	}
+	if len(s.Groups) != 2 || s.Groups[0].Component != "webhook" || s.Groups[0].Count != 2 || s.Groups[0].LastMessage != "timeout" {
+		t.Errorf("groups = %+v, want webhook with its last message first", s.Groups)
+	}
+	if len(s.SinceStarted) != 2 || s.SinceStarted[0].Count != 2 || s.SinceStarted[1].Count != 2 {
+		t.Errorf("since started = %+v, want 2 errors of each group", s.SinceStarted)
+	}
+
+	// The log holds the last four errors only.
+	l.Add(ErrorEvent{Time: now, Component: "cache", Class: dependency})
+	if s := l.Summary(24 * time.Hour); s.Total != 4 || !s.Truncated {
+		t.Errorf("summary of a day = %d errors, truncated %v, want 4, true", s.Total, s.Truncated)
+	}
+}
+
+func TestRecordError(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	defer tp.Shutdown(context.Background())
+	ctx, span := tp.Tracer("test").Start(context.Background(), "Get")
+	class := RecordError(ctx, "ephemeris", errors.New("provider down"), WithCategory(CategoryDependency), WithRetryable(true))
+	span.End()
+
+	if want := (ErrorClass{CategoryDependency, SeverityError, true}); class != want {
+		t.Errorf("RecordError() = %+v, want %+v", class, want)
+	}
+	spans := exporter.GetSpans()
+	if len(spans) != 1 || len(spans[0].Events) != 1 || spans[0].Status.Code.String() != "Error" {
+		t.Fatalf("spans = %+v, want one failed span with an exception event", spans)
+	}
+	attrs := map[string]string{}
+	for _, a := range spans[0].Events[0].Attributes {
+		attrs[string(a.Key)] = a.Value.Emit()
+	}
+	if attrs[ErrorCategoryAttribute] != "dependency" || attrs[ErrorComponentAttribute] != "ephemeris" || attrs[ErrorRetryableAttribute] != "true" {
+		t.Errorf("event attributes = %v, want the class and component", attrs)
+	}
+	var found bool
+	for _, g := range Errors().Summary(time.Minute).Groups {
+		if g.Component == "ephemeris" && g.LastTraceID == spans[0].SpanContext.TraceID().String() {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("the error is not in the log of Errors")
+	}
+}
@@ -4,7 +4,9 @@ import (
 	"context"
 	"fmt"
 	"sync"
+	"time"
 
+	"github.com/naren-m/panchangam/version"
 	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -12,6 +14,7 @@ import (
 	"go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
 	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
 	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/metric"
 	"go.opentelemetry.io/otel/propagation"
 	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
 	sdkresource "go.opentelemetry.io/otel/sdk/resource"
@@ -19,6 +22,7 @@ import (
 	"go.opentelemetry.io/otel/trace"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
 	"log/slog"
 )
 
@@ -41,7 +45,8 @@ type ObserverInterface interface {
 	CreateSpan(ctx context.Context, name string) (context.Context, trace.Span)
 }
 type observer struct {
-	tp *sdktrace.TracerProvider
+	tp     *sdktrace.TracerProvider
+	coarse bool
 }
 
 var oi *observer
@@ -49,7 +54,7 @@ var oi *observer
 func NewLocalObserver() ObserverInterface {
 	// Initialize the TracerProvider and Tracer.
 	initObserverOnce.Do(func() {
-		tp, _ := initStdoutProvider()
+		tp, _ := initStdoutProvider(Sampling{Mode: AlwaysSampling})
 		oi = &observer{
 			tp: tp,
 		}
@@ -58,21 +63,54 @@ func NewLocalObserver() ObserverInterface {
 	return oi
 }
 
+// ObserverOption configures NewObserver.
+type ObserverOption func(*observerConfig)
+
+type observerConfig struct {
+	sampling Sampling
+	coarse   bool
+}
+
+// WithSampling sets which traces are recorded and exported, every one by
+// default. See EnvironmentSampling for the sampling of each environment.
+func WithSampling(s Sampling) ObserverOption {
+	return func(c *observerConfig) {
+		c.sampling = s
+	}
+}
+
+// WithCoarseTracing collapses the spans of a request created by CreateSpan
+// into the first one: the others become steps listed with their durations
+// in its attributes, see StepNamesAttribute. It spares the hot paths
+// of the calculations most of the cost of tracing, at the price of the
+// nesting of their steps.
+func WithCoarseTracing(coarse bool) ObserverOption {
+	return func(c *observerConfig) {
+		c.coarse = coarse
+	}
+}
+
 // NewObserver creates a new Observer instance.
-func NewObserver(address string) (ObserverInterface, error){
+func NewObserver(address string, opts ...ObserverOption) (ObserverInterface, error) {
+	cfg := observerConfig{sampling: Sampling{Mode: AlwaysSampling}}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
 	// Initialize the TracerProvider and Tracer.
 	var tp *sdktrace.TracerProvider
 	var err error
 	initObserverOnce.Do(func() {
 		if address == "" {
-			tp, err = initStdoutProvider()
+			tp, err = initStdoutProvider(cfg.sampling)
 			oi = &observer{
-				tp: tp,
+				tp:     tp,
+				coarse: cfg.coarse,
 			}
 		} else {
-			tp, err = initTracerProvider(address)
+			tp, err = initTracerProvider(address, cfg.sampling)
 			oi = &observer{
-				tp: tp,
+				tp:     tp,
+				coarse: cfg.coarse,
 			}
 		}
 	})
@@ -101,13 +139,63 @@ func (o *observer) Tracer(name string) trace.Tracer {
 }
 
 // CreateSpan starts a new span. Getting the RPC method name from the context.
+// In coarse tracing mode, see WithCoarseTracing, only the first span of a
+// request is started, and the others are steps recorded on it.
 func (o *observer) CreateSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	if o.coarse {
+		if ctx, step, ok := createStep(ctx, name); ok {
+			return ctx, step
+		}
+	}
 	fullMethod, ok := grpc.Method(ctx)
 	if !ok {
 		fullMethod = "unknown"
 	}
 	tracer := otel.GetTracerProvider().Tracer(fullMethod)
-	return tracer.Start(ctx, name)
+	ctx, span := tracer.Start(ctx, name)
+	if !o.coarse {
+		return ctx, span
+	}
+	if !span.IsRecording() {
+		return context.WithValue(ctx, coarseSpanKey{}, (*coarseSpan)(nil)), span
+	}
+	c := newCoarseSpan(span)
+	return context.WithValue(ctx, coarseSpanKey{}, c), c
+}
+
+// Names of the RPC metrics recorded by MetricsInterceptor.
+const (
+	RPCRequestsMetric = "panchangam.rpc.requests"
+	RPCDurationMetric = "panchangam.rpc.duration"
+)
+
+// MetricsInterceptor counts the requests by method and status code and
+// records their latency in milliseconds by method.
+func MetricsInterceptor() grpc.UnaryServerInterceptor {
+	meter := otel.Meter("github.com/naren-m/panchangam/observability")
+	requests, err := meter.Int64Counter(RPCRequestsMetric,
+		metric.WithDescription("RPCs handled, by method and status code"))
+	if err != nil {
+		slog.Error("Failed to create RPC request counter", "error", err)
+	}
+	duration, err := meter.Float64Histogram(RPCDurationMetric,
+		metric.WithDescription("Latency of RPCs, by method"),
+		metric.WithUnit("ms"))
+	if err != nil {
+		slog.Error("Failed to create RPC duration histogram", "error", err)
+	}
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		start := time.Now()
+		resp, err := handler(ctx, req)
+		method := attribute.String("method", info.FullMethod)
+		if requests != nil {
+			requests.Add(ctx, 1, metric.WithAttributes(method, attribute.String("code", status.Code(err).String())))
+		}
+		if duration != nil {
+			duration.Record(ctx, float64(time.Since(start))/float64(time.Millisecond), metric.WithAttributes(method))
+		}
+		return resp, err
+	}
 }
 
 func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
@@ -119,13 +207,14 @@ func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
 		resp, err := handler(ctx, req)
 		if err != nil {
 			slog.ErrorContext(ctx, "Request failed.", "error", err)
+			// Record the error on oSpan with its class, and count it.
+			RecordError(ctx, "rpc", err)
 		}
 		if oSpan.IsRecording() {
 			// If oSpan is recoding, record the oSpan.
 			slog.Info("Recording Span.")
 			if err != nil {
 				oSpan.AddEvent("Request failed.", trace.WithAttributes(attribute.String("error", err.Error())))
-				oSpan.RecordError(err)
 				oSpan.SetStatus(codes.Error, err.Error())
 			} else {
 				oSpan.AddEvent("Request completed successfully.")
@@ -150,7 +239,7 @@ func initResource() *sdkresource.Resource {
 				attribute.String("application", "panchangam"),
 				attribute.String("service.name", "panchangam"),
 				attribute.String("service.namespace", "observability"),
-				attribute.String("application.version", "0.0.1"),
+				attribute.String("application.version", version.Version),
 			),
 		)
 		resource, _ = sdkresource.Merge(
@@ -161,15 +250,14 @@ func initResource() *sdkresource.Resource {
 	return resource
 }
 
-func initStdoutProvider() (*sdktrace.TracerProvider, error) {
+func initStdoutProvider(sampling Sampling) (*sdktrace.TracerProvider, error) {
 	exporter, err := stdouttrace.New(stdouttrace.WithPrettyPrint())
 	if err != nil {
 		panic(fmt.Sprintf("failed to initialize stdouttrace export pipeline: %v", err))
 	}
 
 	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exporter),
-		sdktrace.WithResource(initResource()),
+		append(sampling.tracerProviderOptions(exporter), sdktrace.WithResource(initResource()))...,
 	)
 
 	otel.SetTracerProvider(tp)
@@ -178,7 +266,7 @@ func initStdoutProvider() (*sdktrace.TracerProvider, error) {
 	return tp, nil
 }
 
-func initTracerProvider(address string) (*sdktrace.TracerProvider, error) {
+func initTracerProvider(address string, sampling Sampling) (*sdktrace.TracerProvider, error) {
 	if address == "" {
 		return nil, fmt.Errorf("address is required")
 	}
@@ -197,8 +285,7 @@ func initTracerProvider(address string) (*sdktrace.TracerProvider, error) {
 	}
 
 	tp := sdktrace.NewTracerProvider(
-		sdktrace.WithBatcher(exporter),
-		sdktrace.WithResource(initResource()),
+		append(sampling.tracerProviderOptions(exporter), sdktrace.WithResource(initResource()))...,
 	)
 
 	otel.SetTracerProvider(tp)
@@ -0,0 +1,237 @@
+package observability
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Sampling modes.
+const (
+	// AlwaysSampling records and exports every trace.
+	AlwaysSampling = "always"
+	// NeverSampling records no trace.
+	NeverSampling = "never"
+	// RatioSampling records a ratio of the traces, chosen by trace ID.
+	RatioSampling = "ratio"
+	// ParentSampling follows the decision of the caller for traces
+	// continued from another service, and samples a ratio of the others.
+	ParentSampling = "parent"
+	// TailSampling records every trace but exports only those with an
+	// error, those whose root span lasts at least the slow threshold, and a
+	// ratio of the others, deciding when the root span ends.
+	TailSampling = "tail"
+)
+
+// Environments with default sampling, see EnvironmentSampling.
+const (
+	Development = "development"
+	Staging     = "staging"
+	Production  = "production"
+)
+
+// DefaultSlowThreshold is the duration of the root span from which tail
+// sampling keeps a trace.
+const DefaultSlowThreshold = time.Second
+
+// maxPendingTraces bounds the traces tail sampling holds until their root
+// span ends.
+const maxPendingTraces = 10000
+
+// Sampling configures which traces an Observer records and exports.
+type Sampling struct {
+	// Mode is one of AlwaysSampling, NeverSampling, RatioSampling,
+	// ParentSampling and TailSampling.
+	Mode string
+	// Ratio is the fraction of traces sampled by the ratio, parent and tail
+	// modes, between 0 and 1.
+	Ratio float64
+	// SlowThreshold is the duration of the root span from which the tail
+	// mode keeps a trace, DefaultSlowThreshold when zero.
+	SlowThreshold time.Duration
+}
+
+// ParseSampling parses a sampling given as the mode, a colon and the ratio
+// for the ratio, parent and tail modes, and another colon and the slow
+// threshold for the tail mode, e.g. "always", "ratio:0.1" or
+// "tail:0.01:500ms".
+func ParseSampling(s string) (Sampling, error) {
+	parts := strings.Split(s, ":")
+	sampling := Sampling{Mode: parts[0]}
+	switch sampling.Mode {
+	case AlwaysSampling, NeverSampling:
+		if len(parts) > 1 {
+			return Sampling{}, fmt.Errorf("invalid sampling %q: %s takes no ratio", s, sampling.Mode)
+		}
+		return sampling, nil
+	case RatioSampling, ParentSampling, TailSampling:
+	default:
+		return Sampling{}, fmt.Errorf("invalid sampling %q: expected %s, %s, %s:RATIO, %s:RATIO or %s:RATIO[:SLOW]",
+			s, AlwaysSampling, NeverSampling, RatioSampling, ParentSampling, TailSampling)
+	}
+	if len(parts) < 2 || (len(parts) > 2 && sampling.Mode != TailSampling) || len(parts) > 3 {
+		return Sampling{}, fmt.Errorf("invalid sampling %q: expected %s:RATIO", s, sampling.Mode)
+	}
+	ratio, err := strconv.ParseFloat(parts[1], 64)
+	if err != nil || ratio < 0 || ratio > 1 {
+		return Sampling{}, fmt.Errorf("invalid sampling %q: the ratio must be between 0 and 1", s)
+	}
+	sampling.Ratio = ratio
+	if len(parts) == 3 {
+		slow, err := time.ParseDuration(parts[2])
+		if err != nil || slow <= 0 {
+			return Sampling{}, fmt.Errorf("invalid sampling %q: bad slow threshold %q", s, parts[2])
+		}
+		sampling.SlowThreshold = slow
+	}
+	return sampling, nil
+}
+
+// String returns s in the form ParseSampling parses.
+func (s Sampling) String() string {
+	switch s.Mode {
+	case RatioSampling, ParentSampling:
+		return fmt.Sprintf("%s:%g", s.Mode, s.Ratio)
+	case TailSampling:
+		return fmt.Sprintf("%s:%g:%s", s.Mode, s.Ratio, s.slowThreshold())
+	}
+	return s.Mode
+}
+
+// EnvironmentSampling returns the default sampling of an environment: every
+// trace in development, a tenth of the traces started by the server in
+// staging, and in production the traces with errors or slow roots and one
+// in a hundred of the others.
+func EnvironmentSampling(env string) (Sampling, error) {
+	switch env {
+	case Development, "":
+		return Sampling{Mode: AlwaysSampling}, nil
+	case Staging:
+		return Sampling{Mode: ParentSampling, Ratio: 0.1}, nil
+	case Production:
+		return Sampling{Mode: TailSampling, Ratio: 0.01, SlowThreshold: DefaultSlowThreshold}, nil
+	}
+	return Sampling{}, fmt.Errorf("unknown environment %q: expected %s, %s or %s", env, Development, Staging, Production)
+}
+
+func (s Sampling) slowThreshold() time.Duration {
+	if s.SlowThreshold > 0 {
+		return s.SlowThreshold
+	}
+	return DefaultSlowThreshold
+}
+
+// tracerProviderOptions returns the options of a TracerProvider sampling
+// with s and exporting with exporter.
+func (s Sampling) tracerProviderOptions(exporter sdktrace.SpanExporter) []sdktrace.TracerProviderOption {
+	switch s.Mode {
+	case NeverSampling:
+		return []sdktrace.TracerProviderOption{sdktrace.WithSampler(sdktrace.NeverSample())}
+	case RatioSampling:
+		return []sdktrace.TracerProviderOption{sdktrace.WithSampler(sdktrace.TraceIDRatioBased(s.Ratio)), sdktrace.WithBatcher(exporter)}
+	case ParentSampling:
+		return []sdktrace.TracerProviderOption{sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(s.Ratio))), sdktrace.WithBatcher(exporter)}
+	case TailSampling:
+		return []sdktrace.TracerProviderOption{
+			sdktrace.WithSampler(sdktrace.AlwaysSample()),
+			sdktrace.WithSpanProcessor(newTailProcessor(sdktrace.NewBatchSpanProcessor(exporter), s.Ratio, s.slowThreshold())),
+		}
+	}
+	return []sdktrace.TracerProviderOption{sdktrace.WithSampler(sdktrace.AlwaysSample()), sdktrace.WithBatcher(exporter)}
+}
+
+// tailProcessor holds the ended spans of each trace until its local root
+// span ends, then passes them on to the next processor if the trace is to
+// be kept.
+type tailProcessor struct {
+	next  sdktrace.SpanProcessor
+	ratio sdktrace.Sampler
+	slow  time.Duration
+
+	mu     sync.Mutex
+	traces map[trace.TraceID]*pendingTrace
+}
+
+type pendingTrace struct {
+	spans   []sdktrace.ReadOnlySpan
+	failed  bool
+	started time.Time
+}
+
+func newTailProcessor(next sdktrace.SpanProcessor, ratio float64, slow time.Duration) *tailProcessor {
+	return &tailProcessor{
+		next:   next,
+		ratio:  sdktrace.TraceIDRatioBased(ratio),
+		slow:   slow,
+		traces: map[trace.TraceID]*pendingTrace{},
+	}
+}
+
+func (p *tailProcessor) OnStart(parent context.Context, s sdktrace.ReadWriteSpan) {}
+
+// OnEnd holds s with the other spans of its trace, and decides on the trace
+// when s is its local root: a span without parent or continuing a trace of
+// another service.
+func (p *tailProcessor) OnEnd(s sdktrace.ReadOnlySpan) {
+	id := s.SpanContext().TraceID()
+	root := !s.Parent().IsValid() || s.Parent().IsRemote()
+
+	p.mu.Lock()
+	t, ok := p.traces[id]
+	if !ok {
+		t = &pendingTrace{started: s.StartTime()}
+		if !root && len(p.traces) >= maxPendingTraces {
+			p.evictOldest()
+		}
+		p.traces[id] = t
+	}
+	t.spans = append(t.spans, s)
+	t.failed = t.failed || s.Status().Code == codes.Error
+	if !root {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.traces, id)
+	p.mu.Unlock()
+
+	keep := t.failed || s.EndTime().Sub(s.StartTime()) >= p.slow ||
+		p.ratio.ShouldSample(sdktrace.SamplingParameters{TraceID: id}).Decision == sdktrace.RecordAndSample
+	if keep {
+		for _, span := range t.spans {
+			p.next.OnEnd(span)
+		}
+	}
+}
+
+// evictOldest decides on the trace pending the longest, whose root span
+// may never end, keeping it only if it failed. p.mu must be held.
+func (p *tailProcessor) evictOldest() {
+	var oldest trace.TraceID
+	var first time.Time
+	for id, t := range p.traces {
+		if first.IsZero() || t.started.Before(first) {
+			oldest, first = id, t.started
+		}
+	}
+	if t := p.traces[oldest]; t.failed {
+		for _, span := range t.spans {
+			p.next.OnEnd(span)
+		}
+	}
+	delete(p.traces, oldest)
+}
+
+func (p *tailProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *tailProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
@@ -0,0 +1,83 @@
+package observability
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestParseSampling(t *testing.T) {
+	for spec, want := range map[string]Sampling{
+		"always":       {Mode: AlwaysSampling},
+		"never":        {Mode: NeverSampling},
+		"ratio:0.1":    {Mode: RatioSampling, Ratio: 0.1},
+		"parent:1":     {Mode: ParentSampling, Ratio: 1},
+		"tail:0.01":    {Mode: TailSampling, Ratio: 0.01},
+		"tail:0:500ms": {Mode: TailSampling, SlowThreshold: 500 * time.Millisecond},
+	} {
+		got, err := ParseSampling(spec)
+		if err != nil || got != want {
+			t.Errorf("ParseSampling(%q) = %+v, %v, want %+v", spec, got, err, want)
+		}
+	}
+	for _, spec := range []string{"", "sometimes", "always:1", "ratio", "ratio:2", "parent:0.1:1s", "tail:0.1:fast"} {
+		if _, err := ParseSampling(spec); err == nil {
+			t.Errorf("ParseSampling(%q) succeeded", spec)
+		}
+	}
+	if s := (Sampling{Mode: TailSampling, Ratio: 0.01}).String(); s != "tail:0.01:1s" {
+		t.Errorf("String() = %q, want tail:0.01:1s", s)
+	}
+}
+
+func TestEnvironmentSampling(t *testing.T) {
+	for env, mode := range map[string]string{Development: AlwaysSampling, Staging: ParentSampling, Production: TailSampling} {
+		if s, err := EnvironmentSampling(env); err != nil || s.Mode != mode {
+			t.Errorf("EnvironmentSampling(%s) = %+v, %v, want %s", env, s, err, mode)
+		}
+	}
+	if _, err := EnvironmentSampling("qa"); err == nil {
+		t.Error("EnvironmentSampling accepted an unknown environment")
+	}
+}
+
+func TestTailSampling(t *testing.T) {
+	exporter := tracetest.NewInMemoryExporter()
+	tp := sdktrace.NewTracerProvider(Sampling{Mode: TailSampling, Ratio: 0, SlowThreshold: 50 * time.Millisecond}.tracerProviderOptions(exporter)...)
+	tracer := tp.Tracer("test")
+
+	trace := func(name string, failed bool, d time.Duration) {
+		ctx, root := tracer.Start(context.Background(), name)
+		_, child := tracer.Start(ctx, name+"/child")
+		if failed {
+			child.SetStatus(codes.Error, "failed")
+		}
+		child.End()
+		time.Sleep(d)
+		root.End()
+	}
+	trace("fast", false, 0)
+	trace("failed", true, 0)
+	trace("slow", false, 60*time.Millisecond)
+	if err := tp.ForceFlush(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+
+	exported := map[string]bool{}
+	for _, s := range exporter.GetSpans() {
+		exported[s.Name] = true
+	}
+	for name, want := range map[string]bool{
+		"fast": false, "fast/child": false,
+		"failed": true, "failed/child": true,
+		"slow": true, "slow/child": true,
+	} {
+		if exported[name] != want {
+			t.Errorf("span %s exported = %v, want %v", name, exported[name], want)
+		}
+	}
+}
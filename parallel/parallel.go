@@ -0,0 +1,70 @@
+// Package parallel runs independent computations, such as those of the
+// days of a range, on a bounded pool of goroutines.
+package parallel
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// DefaultWorkers returns the number of goroutines used when none is given:
+// one per CPU usable by the process.
+func DefaultWorkers() int {
+	return runtime.GOMAXPROCS(0)
+}
+
+// Map calls fn for each index from 0 to n-1 on at most workers goroutines,
+// or DefaultWorkers if workers is not positive, and returns the results in
+// index order. The first error cancels the context of the calls still
+// running, stops those not started and is returned; so is the error of ctx
+// if it ends first.
+func Map[T any](ctx context.Context, n, workers int, fn func(ctx context.Context, i int) (T, error)) ([]T, error) {
+	if workers <= 0 {
+		workers = DefaultWorkers()
+	}
+	workers = min(workers, n)
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	results := make([]T, n)
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result, err := fn(ctx, i)
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+					return
+				}
+				results[i] = result
+			}
+		}()
+	}
+feed:
+	for i := 0; i < n; i++ {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(indexes)
+	wg.Wait()
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	// ctx may have ended after the last call started.
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
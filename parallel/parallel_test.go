@@ -0,0 +1,64 @@
+package parallel
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestMap(t *testing.T) {
+	var running, most atomic.Int32
+	got, err := Map(context.Background(), 50, 4, func(ctx context.Context, i int) (int, error) {
+		n := running.Add(1)
+		defer running.Add(-1)
+		for m := most.Load(); n > m && !most.CompareAndSwap(m, n); m = most.Load() {
+		}
+		// Later indexes finish first.
+		time.Sleep(time.Duration(50-i) * 10 * time.Microsecond)
+		return i * i, nil
+	})
+	if err != nil {
+		t.Fatalf("Map() error = %v", err)
+	}
+	for i, v := range got {
+		if v != i*i {
+			t.Fatalf("Map()[%d] = %d, want %d", i, v, i*i)
+		}
+	}
+	if len(got) != 50 {
+		t.Errorf("Map() returned %d results, want 50", len(got))
+	}
+	if most.Load() > 4 {
+		t.Errorf("Map() ran %d calls at once, want at most 4", most.Load())
+	}
+
+	if got, err := Map(context.Background(), 0, 0, func(context.Context, int) (int, error) { return 1, nil }); len(got) != 0 || err != nil {
+		t.Errorf("Map() of nothing = %v, %v", got, err)
+	}
+}
+
+func TestMapError(t *testing.T) {
+	failure := errors.New("failed")
+	var calls atomic.Int32
+	_, err := Map(context.Background(), 1000, 2, func(ctx context.Context, i int) (int, error) {
+		calls.Add(1)
+		if i == 3 {
+			return 0, failure
+		}
+		return i, nil
+	})
+	if err != failure {
+		t.Errorf("Map() error = %v, want %v", err, failure)
+	}
+	if calls.Load() >= 1000 {
+		t.Errorf("Map() made all %d calls after an error", calls.Load())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := Map(ctx, 10, 2, func(ctx context.Context, i int) (int, error) { return i, nil }); !errors.Is(err, context.Canceled) {
+		t.Errorf("Map() with a cancelled context error = %v, want context.Canceled", err)
+	}
+}
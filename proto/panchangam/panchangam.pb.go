@@ -1,7 +1,20 @@
 // PanchangamData represents the Panchangam data for a specific date, including Tithi, Nakshatra, Yoga, Karana, sunrise time, sunset time, and any additional events.
 // PanchangamEvent represents an event or special occurrence in the Panchangam, such as Rahu Kalam or Yamagandam.
+// ChoghadiyaPeriod represents one of the eight day or eight night Choghadiya periods.
+// Muhurta represents a named period of a day, such as Abhijit Muhurta or Varjyam.
+// TamilPeriod represents a Gowri Panchangam or Nalla Neram period of the Tamil Nadu almanacs.
+// NakshatraPada represents one of the four quarters of a nakshatra and when it starts and ends.
+// ElementBoundary flags an element whose value is uncertain because a transition lies close to the time it was evaluated.
 // GetPanchangamRequest is the request message sent to the server to retrieve Panchangam data for a specific date.
 //GetPanchangamResponse is the response message containing the requested Panchangam data.
+// GetFestivalBundleRequest and FestivalBundle carry a full year of festivals and vrats for a region and location.
+// GetEventsRequest and GetEventsResponse list the festivals, vrats, sankrantis and visible eclipses on a date or a few days.
+// GetMuhurtaRequest and GetMuhurtaResponse list the periods suitable for an activity following the rules of a tradition.
+// Profile describes the person a muhurta is sought for, whose janma nakshatra and rashi personalize the scores.
+// FindMuhurtaRequest and FindMuhurtaResponse rank the next periods suitable for an activity that satisfy further constraints.
+// GetVratListRequest and VratList carry the ekadashis, purnimas, amavasyas and sankrantis of a year, as on an annual vrat list.
+// GetLagnasRequest and GetLagnasResponse list the signs rising on the eastern horizon during a day, which many muhurta rules depend on.
+// WebhookSubscription is a URL notified of festivals and transitions at a location, which CreateSubscription, ListSubscriptions and DeleteSubscription manage.
 
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
@@ -41,12 +54,101 @@ type PanchangamData struct {
 	Yoga string `protobuf:"bytes,4,opt,name=yoga,proto3" json:"yoga,omitempty"`
 	// Karana for the given date
 	Karana string `protobuf:"bytes,5,opt,name=karana,proto3" json:"karana,omitempty"`
-	// Sunrise time for the given date (in ISO 8601 format: HH:MM:SS)
+	// Sunrise time for the given date (in ISO 8601 format: HH:MM:SS); empty
+	// when the sun does not rise or set, see sun_condition
 	SunriseTime string `protobuf:"bytes,6,opt,name=sunrise_time,json=sunriseTime,proto3" json:"sunrise_time,omitempty"`
-	// Sunset time for the given date (in ISO 8601 format: HH:MM:SS)
+	// Sunset time for the given date (in ISO 8601 format: HH:MM:SS); empty
+	// when the sun does not rise or set, see sun_condition
 	SunsetTime string `protobuf:"bytes,7,opt,name=sunset_time,json=sunsetTime,proto3" json:"sunset_time,omitempty"`
 	// Additional Panchangam details or events for the given date
 	Events []*PanchangamEvent `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"`
+	// Choghadiya periods from sunrise to the next sunrise, eight for the day followed by eight for the night
+	Choghadiya []*ChoghadiyaPeriod `protobuf:"bytes,9,rep,name=choghadiya,proto3" json:"choghadiya,omitempty"`
+	// Elements evaluated at sunrise that lie within the boundary window of a transition
+	NearBoundaries []*ElementBoundary `protobuf:"bytes,10,rep,name=near_boundaries,json=nearBoundaries,proto3" json:"near_boundaries,omitempty"`
+	// Amanta lunar month (new moon to new moon) at sunrise, e.g. Chaitra
+	Masa string `protobuf:"bytes,11,opt,name=masa,proto3" json:"masa,omitempty"`
+	// Purnimanta lunar month (full moon to full moon) at sunrise
+	PurnimantaMasa string `protobuf:"bytes,12,opt,name=purnimanta_masa,json=purnimantaMasa,proto3" json:"purnimanta_masa,omitempty"`
+	// Whether the month is an intercalary Adhika masa, in which the sun does not change sign
+	AdhikaMasa bool `protobuf:"varint,13,opt,name=adhika_masa,json=adhikaMasa,proto3" json:"adhika_masa,omitempty"`
+	// Whether the month is a Kshaya masa, in which the sun changes sign twice
+	KshayaMasa bool `protobuf:"varint,14,opt,name=kshaya_masa,json=kshayaMasa,proto3" json:"kshaya_masa,omitempty"`
+	// Lunar fortnight at sunrise: shukla or krishna
+	Paksha string `protobuf:"bytes,15,opt,name=paksha,proto3" json:"paksha,omitempty"`
+	// Seconds by which sunrise is later than on the previous day, negative when earlier
+	SunriseDriftSeconds int32 `protobuf:"varint,16,opt,name=sunrise_drift_seconds,json=sunriseDriftSeconds,proto3" json:"sunrise_drift_seconds,omitempty"`
+	// Seconds by which sunset is later than on the previous day, negative when earlier
+	SunsetDriftSeconds int32 `protobuf:"varint,17,opt,name=sunset_drift_seconds,json=sunsetDriftSeconds,proto3" json:"sunset_drift_seconds,omitempty"`
+	// Change of the day length from the previous day in seconds
+	DayLengthChangeSeconds int32 `protobuf:"varint,18,opt,name=day_length_change_seconds,json=dayLengthChangeSeconds,proto3" json:"day_length_change_seconds,omitempty"`
+	// Direction of the day length change: lengthening, shortening or steady
+	DayLengthTrend string `protobuf:"bytes,19,opt,name=day_length_trend,json=dayLengthTrend,proto3" json:"day_length_trend,omitempty"`
+	// Year of the 60-year cycle, e.g. Krodhi
+	Samvatsara string `protobuf:"bytes,20,opt,name=samvatsara,proto3" json:"samvatsara,omitempty"`
+	// Traditional season, which follows the northern hemisphere, e.g. Vasanta
+	Ritu string `protobuf:"bytes,21,opt,name=ritu,proto3" json:"ritu,omitempty"`
+	// Half year of the sun's motion: Uttarayana or Dakshinayana
+	Ayana string `protobuf:"bytes,22,opt,name=ayana,proto3" json:"ayana,omitempty"`
+	// Season experienced at the requested latitude; shifted by half a year from ritu in the southern hemisphere
+	LocalRitu string `protobuf:"bytes,23,opt,name=local_ritu,json=localRitu,proto3" json:"local_ritu,omitempty"`
+	// Hemisphere of the requested latitude, northern or southern, recording the convention used for local_ritu
+	Hemisphere string `protobuf:"bytes,24,opt,name=hemisphere,proto3" json:"hemisphere,omitempty"`
+	// Vikram Samvat year under the new-year rule of the requested region
+	VikramSamvat int32 `protobuf:"varint,25,opt,name=vikram_samvat,json=vikramSamvat,proto3" json:"vikram_samvat,omitempty"`
+	// Shaka Samvat year under the new-year rule of the requested region
+	ShakaSamvat int32 `protobuf:"varint,26,opt,name=shaka_samvat,json=shakaSamvat,proto3" json:"shaka_samvat,omitempty"`
+	// Moonrise time for the given date in the requested timezone (HH:MM:SS), empty when the moon does not rise that day
+	MoonriseTime string `protobuf:"bytes,27,opt,name=moonrise_time,json=moonriseTime,proto3" json:"moonrise_time,omitempty"`
+	// Moonset time for the given date in the requested timezone (HH:MM:SS), empty when the moon does not set that day
+	MoonsetTime string `protobuf:"bytes,28,opt,name=moonset_time,json=moonsetTime,proto3" json:"moonset_time,omitempty"`
+	// Moon phase at sunrise, e.g. Waxing Gibbous
+	MoonPhase string `protobuf:"bytes,29,opt,name=moon_phase,json=moonPhase,proto3" json:"moon_phase,omitempty"`
+	// Illuminated fraction of the moon's disc at sunrise, from 0 to 1
+	MoonIllumination float64 `protobuf:"fixed64,30,opt,name=moon_illumination,json=moonIllumination,proto3" json:"moon_illumination,omitempty"`
+	// Abhijit Muhurta, the eighth of the fifteen muhurtas from sunrise to sunset
+	AbhijitMuhurta *Muhurta `protobuf:"bytes,31,opt,name=abhijit_muhurta,json=abhijitMuhurta,proto3" json:"abhijit_muhurta,omitempty"`
+	// Brahma Muhurta, from 96 to 48 minutes before sunrise
+	BrahmaMuhurta *Muhurta `protobuf:"bytes,32,opt,name=brahma_muhurta,json=brahmaMuhurta,proto3" json:"brahma_muhurta,omitempty"`
+	// Convention sunrise and sunset were calculated with: apparent or mean
+	SunConvention string `protobuf:"bytes,33,opt,name=sun_convention,json=sunConvention,proto3" json:"sun_convention,omitempty"`
+	// Inauspicious periods from sunrise to the next sunrise, ordered by start:
+	// Durmuhurtam by weekday and Varjyam by nakshatra
+	InauspiciousPeriods []*Muhurta `protobuf:"bytes,34,rep,name=inauspicious_periods,json=inauspiciousPeriods,proto3" json:"inauspicious_periods,omitempty"`
+	// Gowri Panchangam periods from sunrise to the next sunrise, eight for the
+	// day followed by eight for the night; only for the tamil_nadu region
+	GowriPanchangam []*TamilPeriod `protobuf:"bytes,35,rep,name=gowri_panchangam,json=gowriPanchangam,proto3" json:"gowri_panchangam,omitempty"`
+	// Nalla Neram (good time) periods of the day and the night, ordered by
+	// start; only for the tamil_nadu region
+	NallaNeram []*TamilPeriod `protobuf:"bytes,36,rep,name=nalla_neram,json=nallaNeram,proto3" json:"nalla_neram,omitempty"`
+	// Nakshatra padas overlapping the civil day from midnight to midnight,
+	// ordered by start
+	NakshatraPadas []*NakshatraPada `protobuf:"bytes,37,rep,name=nakshatra_padas,json=nakshatraPadas,proto3" json:"nakshatra_padas,omitempty"`
+	// Position of the moon the elements were computed from: geocentric or
+	// topocentric
+	MoonPosition string `protobuf:"bytes,38,opt,name=moon_position,json=moonPosition,proto3" json:"moon_position,omitempty"`
+	// Empty when the sun rises and sets on the date, otherwise midnight_sun or
+	// polar_night. Sunrise and sunset are then empty, and the elements and
+	// periods reckoned from them use 06:00 and 18:00 local mean time
+	SunCondition string `protobuf:"bytes,39,opt,name=sun_condition,json=sunCondition,proto3" json:"sun_condition,omitempty"`
+	// Names of the tithi, nakshatra, yoga and karana in the requested locale,
+	// unset when no locale is requested
+	LocalNames *LocalNames `protobuf:"bytes,40,opt,name=local_names,json=localNames,proto3" json:"local_names,omitempty"`
+	// Date of the solar calendar followed in the requested region: Tamil for
+	// tamil_nadu, Malayalam for kerala, Bengali for bengal and Odia for
+	// odisha; unset for other regions
+	SolarDate *SolarDate `protobuf:"bytes,41,opt,name=solar_date,json=solarDate,proto3" json:"solar_date,omitempty"`
+	// Date of the Nepali calendar, the Bikram Sambat of Nepal, on the
+	// requested date as reckoned in Nepal
+	BikramSambat *SolarDate `protobuf:"bytes,42,opt,name=bikram_sambat,json=bikramSambat,proto3" json:"bikram_sambat,omitempty"`
+	// Date of the Islamic calendar during the daytime of the requested date
+	HijriDate *HijriDate `protobuf:"bytes,43,opt,name=hijri_date,json=hijriDate,proto3" json:"hijri_date,omitempty"`
+	// Sidereal sign of the moon at sunrise and its ingress into the next
+	// sign before the next sunrise, if any
+	MoonRashi *Rashi `protobuf:"bytes,44,opt,name=moon_rashi,json=moonRashi,proto3" json:"moon_rashi,omitempty"`
+	// Sidereal sign of the sun at sunrise and its ingress into the next
+	// sign before the next sunrise, if any
+	SunRashi *Rashi `protobuf:"bytes,45,opt,name=sun_rashi,json=sunRashi,proto3" json:"sun_rashi,omitempty"`
 }
 
 func (x *PanchangamData) Reset() {
@@ -137,6 +239,265 @@ func (x *PanchangamData) GetEvents() []*PanchangamEvent {
 	return nil
 }
 
+func (x *PanchangamData) GetChoghadiya() []*ChoghadiyaPeriod {
+	if x != nil {
+		return x.Choghadiya
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetNearBoundaries() []*ElementBoundary {
+	if x != nil {
+		return x.NearBoundaries
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetMasa() string {
+	if x != nil {
+		return x.Masa
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetPurnimantaMasa() string {
+	if x != nil {
+		return x.PurnimantaMasa
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetAdhikaMasa() bool {
+	if x != nil {
+		return x.AdhikaMasa
+	}
+	return false
+}
+
+func (x *PanchangamData) GetKshayaMasa() bool {
+	if x != nil {
+		return x.KshayaMasa
+	}
+	return false
+}
+
+func (x *PanchangamData) GetPaksha() string {
+	if x != nil {
+		return x.Paksha
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetSunriseDriftSeconds() int32 {
+	if x != nil {
+		return x.SunriseDriftSeconds
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetSunsetDriftSeconds() int32 {
+	if x != nil {
+		return x.SunsetDriftSeconds
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetDayLengthChangeSeconds() int32 {
+	if x != nil {
+		return x.DayLengthChangeSeconds
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetDayLengthTrend() string {
+	if x != nil {
+		return x.DayLengthTrend
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetSamvatsara() string {
+	if x != nil {
+		return x.Samvatsara
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetRitu() string {
+	if x != nil {
+		return x.Ritu
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetAyana() string {
+	if x != nil {
+		return x.Ayana
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetLocalRitu() string {
+	if x != nil {
+		return x.LocalRitu
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetHemisphere() string {
+	if x != nil {
+		return x.Hemisphere
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetVikramSamvat() int32 {
+	if x != nil {
+		return x.VikramSamvat
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetShakaSamvat() int32 {
+	if x != nil {
+		return x.ShakaSamvat
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetMoonriseTime() string {
+	if x != nil {
+		return x.MoonriseTime
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetMoonsetTime() string {
+	if x != nil {
+		return x.MoonsetTime
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetMoonPhase() string {
+	if x != nil {
+		return x.MoonPhase
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetMoonIllumination() float64 {
+	if x != nil {
+		return x.MoonIllumination
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetAbhijitMuhurta() *Muhurta {
+	if x != nil {
+		return x.AbhijitMuhurta
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetBrahmaMuhurta() *Muhurta {
+	if x != nil {
+		return x.BrahmaMuhurta
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetSunConvention() string {
+	if x != nil {
+		return x.SunConvention
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetInauspiciousPeriods() []*Muhurta {
+	if x != nil {
+		return x.InauspiciousPeriods
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetGowriPanchangam() []*TamilPeriod {
+	if x != nil {
+		return x.GowriPanchangam
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetNallaNeram() []*TamilPeriod {
+	if x != nil {
+		return x.NallaNeram
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetNakshatraPadas() []*NakshatraPada {
+	if x != nil {
+		return x.NakshatraPadas
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetMoonPosition() string {
+	if x != nil {
+		return x.MoonPosition
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetSunCondition() string {
+	if x != nil {
+		return x.SunCondition
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetLocalNames() *LocalNames {
+	if x != nil {
+		return x.LocalNames
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetSolarDate() *SolarDate {
+	if x != nil {
+		return x.SolarDate
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetBikramSambat() *SolarDate {
+	if x != nil {
+		return x.BikramSambat
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetHijriDate() *HijriDate {
+	if x != nil {
+		return x.HijriDate
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetMoonRashi() *Rashi {
+	if x != nil {
+		return x.MoonRashi
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetSunRashi() *Rashi {
+	if x != nil {
+		return x.SunRashi
+	}
+	return nil
+}
+
 // Represents an event or special occurrence in the Panchangam
 type PanchangamEvent struct {
 	state         protoimpl.MessageState
@@ -195,18 +556,24 @@ func (x *PanchangamEvent) GetTime() string {
 	return ""
 }
 
-// Request message to retrieve Panchangam data for a specific date
-type GetPanchangamRequest struct {
+// Represents a named period of a day
+type Muhurta struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Date for which Panchangam data is requested (in ISO 8601 format: YYYY-MM-DD)
-	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Name of the period, e.g. Abhijit, Brahma, Durmuhurtam or Varjyam
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Date of the day the muhurta belongs to (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,2,opt,name=date,proto3" json:"date,omitempty"`
+	// Start time of the muhurta (in ISO 8601 format: HH:MM:SS)
+	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time of the muhurta (in ISO 8601 format: HH:MM:SS)
+	EndTime string `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
 }
 
-func (x *GetPanchangamRequest) Reset() {
-	*x = GetPanchangamRequest{}
+func (x *Muhurta) Reset() {
+	*x = Muhurta{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_panchangam_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -214,13 +581,13 @@ func (x *GetPanchangamRequest) Reset() {
 	}
 }
 
-func (x *GetPanchangamRequest) String() string {
+func (x *Muhurta) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPanchangamRequest) ProtoMessage() {}
+func (*Muhurta) ProtoMessage() {}
 
-func (x *GetPanchangamRequest) ProtoReflect() protoreflect.Message {
+func (x *Muhurta) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_panchangam_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -232,30 +599,61 @@ func (x *GetPanchangamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPanchangamRequest.ProtoReflect.Descriptor instead.
-func (*GetPanchangamRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Muhurta.ProtoReflect.Descriptor instead.
+func (*Muhurta) Descriptor() ([]byte, []int) {
 	return file_proto_panchangam_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *GetPanchangamRequest) GetDate() string {
+func (x *Muhurta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Muhurta) GetDate() string {
 	if x != nil {
 		return x.Date
 	}
 	return ""
 }
 
-// Response message containing Panchangam data for the requested date
-type GetPanchangamResponse struct {
+func (x *Muhurta) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Muhurta) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// Represents a period of the Tamil Nadu almanacs
+type TamilPeriod struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Panchangam data for the requested date
-	PanchangamData *PanchangamData `protobuf:"bytes,1,opt,name=panchangam_data,json=panchangamData,proto3" json:"panchangam_data,omitempty"`
+	// Name of the period, e.g. Amirdha or Nalla Neram
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of the period in Tamil
+	TamilName string `protobuf:"bytes,2,opt,name=tamil_name,json=tamilName,proto3" json:"tamil_name,omitempty"`
+	// Nature of the period: auspicious or inauspicious
+	Nature string `protobuf:"bytes,3,opt,name=nature,proto3" json:"nature,omitempty"`
+	// Start time of the period (in ISO 8601 format: HH:MM:SS)
+	StartTime string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time of the period (in ISO 8601 format: HH:MM:SS)
+	EndTime string `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// True for the periods between sunrise and sunset
+	IsDay bool `protobuf:"varint,6,opt,name=is_day,json=isDay,proto3" json:"is_day,omitempty"`
 }
 
-func (x *GetPanchangamResponse) Reset() {
-	*x = GetPanchangamResponse{}
+func (x *TamilPeriod) Reset() {
+	*x = TamilPeriod{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_proto_panchangam_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -263,13 +661,13 @@ func (x *GetPanchangamResponse) Reset() {
 	}
 }
 
-func (x *GetPanchangamResponse) String() string {
+func (x *TamilPeriod) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetPanchangamResponse) ProtoMessage() {}
+func (*TamilPeriod) ProtoMessage() {}
 
-func (x *GetPanchangamResponse) ProtoReflect() protoreflect.Message {
+func (x *TamilPeriod) ProtoReflect() protoreflect.Message {
 	mi := &file_proto_panchangam_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -281,100 +679,7432 @@ func (x *GetPanchangamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetPanchangamResponse.ProtoReflect.Descriptor instead.
-func (*GetPanchangamResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TamilPeriod.ProtoReflect.Descriptor instead.
+func (*TamilPeriod) Descriptor() ([]byte, []int) {
 	return file_proto_panchangam_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *GetPanchangamResponse) GetPanchangamData() *PanchangamData {
+func (x *TamilPeriod) GetName() string {
 	if x != nil {
-		return x.PanchangamData
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-var File_proto_panchangam_proto protoreflect.FileDescriptor
+func (x *TamilPeriod) GetTamilName() string {
+	if x != nil {
+		return x.TamilName
+	}
+	return ""
+}
 
-var file_proto_panchangam_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61,
-	0x6e, 0x67, 0x61, 0x6d, 0x22, 0xfd, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
-	0x69, 0x74, 0x68, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x68,
-	0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x12,
-	0x12, 0x0a, 0x04, 0x79, 0x6f, 0x67, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79,
-	0x6f, 0x67, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73,
-	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f,
-	0x0a, 0x0b, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
-	0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x6e,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0x39, 0x0a, 0x0f, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74,
-	0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x22,
-	0x2a, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0x5c, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
-	0x61, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x6e, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x70, 0x61, 0x6e, 0x63, 0x68,
-	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x32, 0x58, 0x0a, 0x0a, 0x50, 0x61, 0x6e,
-	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x12, 0x4a, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x20,
-	0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x50,
-	0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x21, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65,
-	0x74, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x61, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *TamilPeriod) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
 }
 
-var (
-	file_proto_panchangam_proto_rawDescOnce sync.Once
-	file_proto_panchangam_proto_rawDescData = file_proto_panchangam_proto_rawDesc
-)
+func (x *TamilPeriod) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
 
-func file_proto_panchangam_proto_rawDescGZIP() []byte {
-	file_proto_panchangam_proto_rawDescOnce.Do(func() {
-		file_proto_panchangam_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_panchangam_proto_rawDescData)
-	})
-	return file_proto_panchangam_proto_rawDescData
+func (x *TamilPeriod) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
 }
 
-var file_proto_panchangam_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
-var file_proto_panchangam_proto_goTypes = []interface{}{
-	(*PanchangamData)(nil),        // 0: panchangam.PanchangamData
-	(*PanchangamEvent)(nil),       // 1: panchangam.PanchangamEvent
-	(*GetPanchangamRequest)(nil),  // 2: panchangam.GetPanchangamRequest
-	(*GetPanchangamResponse)(nil), // 3: panchangam.GetPanchangamResponse
+func (x *TamilPeriod) GetIsDay() bool {
+	if x != nil {
+		return x.IsDay
+	}
+	return false
 }
-var file_proto_panchangam_proto_depIdxs = []int32{
-	1, // 0: panchangam.PanchangamData.events:type_name -> panchangam.PanchangamEvent
-	0, // 1: panchangam.GetPanchangamResponse.panchangam_data:type_name -> panchangam.PanchangamData
-	2, // 2: panchangam.Panchangam.Get:input_type -> panchangam.GetPanchangamRequest
-	3, // 3: panchangam.Panchangam.Get:output_type -> panchangam.GetPanchangamResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+
+// Represents a date of a regional solar calendar, whose months start when
+// the sun enters a sidereal sign
+type SolarDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Calendar of the date: tamil, malayalam, bengali, odia or nepali
+	Calendar string `protobuf:"bytes,1,opt,name=calendar,proto3" json:"calendar,omitempty"`
+	// Sign of the sun the month is named after, from Mesha = 1
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	// Name of the month, e.g. Chingam
+	MonthName string `protobuf:"bytes,3,opt,name=month_name,json=monthName,proto3" json:"month_name,omitempty"`
+	// Name of the month in the script of the calendar
+	LocalMonthName string `protobuf:"bytes,4,opt,name=local_month_name,json=localMonthName,proto3" json:"local_month_name,omitempty"`
+	// Day of the month, from 1
+	Day int32 `protobuf:"varint,5,opt,name=day,proto3" json:"day,omitempty"`
+	// Era counting the year: Kollavarsham for malayalam, Bangabda for
+	// bengali and Bikram Sambat for nepali; empty for the calendars without
+	// one
+	Era string `protobuf:"bytes,6,opt,name=era,proto3" json:"era,omitempty"`
+	// Year of the era, zero without one
+	Year int32 `protobuf:"varint,7,opt,name=year,proto3" json:"year,omitempty"`
+	// Name of the year of the 60-year cycle starting with Mesha; only for
+	// tamil
+	Samvatsara string `protobuf:"bytes,8,opt,name=samvatsara,proto3" json:"samvatsara,omitempty"`
 }
 
-func init() { file_proto_panchangam_proto_init() }
-func file_proto_panchangam_proto_init() {
-	if File_proto_panchangam_proto != nil {
-		return
+func (x *SolarDate) Reset() {
+	*x = SolarDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_proto_panchangam_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanchangamData); i {
+}
+
+func (x *SolarDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SolarDate) ProtoMessage() {}
+
+func (x *SolarDate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SolarDate.ProtoReflect.Descriptor instead.
+func (*SolarDate) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *SolarDate) GetCalendar() string {
+	if x != nil {
+		return x.Calendar
+	}
+	return ""
+}
+
+func (x *SolarDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *SolarDate) GetMonthName() string {
+	if x != nil {
+		return x.MonthName
+	}
+	return ""
+}
+
+func (x *SolarDate) GetLocalMonthName() string {
+	if x != nil {
+		return x.LocalMonthName
+	}
+	return ""
+}
+
+func (x *SolarDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *SolarDate) GetEra() string {
+	if x != nil {
+		return x.Era
+	}
+	return ""
+}
+
+func (x *SolarDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *SolarDate) GetSamvatsara() string {
+	if x != nil {
+		return x.Samvatsara
+	}
+	return ""
+}
+
+// Represents a date of the Islamic calendar, following the tabular
+// calendar moved by the requested adjustment
+type HijriDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Year after the Hijra (AH)
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Month, from Muharram = 1
+	Month int32 `protobuf:"varint,2,opt,name=month,proto3" json:"month,omitempty"`
+	// Name of the month, e.g. Ramadan
+	MonthName string `protobuf:"bytes,3,opt,name=month_name,json=monthName,proto3" json:"month_name,omitempty"`
+	// Name of the month in Arabic
+	ArabicMonthName string `protobuf:"bytes,4,opt,name=arabic_month_name,json=arabicMonthName,proto3" json:"arabic_month_name,omitempty"`
+	// Day of the month, from 1
+	Day int32 `protobuf:"varint,5,opt,name=day,proto3" json:"day,omitempty"`
+	// Days the date was moved from the tabular calendar to follow the
+	// sighting of the crescent
+	AdjustmentDays int32 `protobuf:"varint,6,opt,name=adjustment_days,json=adjustmentDays,proto3" json:"adjustment_days,omitempty"`
+}
+
+func (x *HijriDate) Reset() {
+	*x = HijriDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HijriDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HijriDate) ProtoMessage() {}
+
+func (x *HijriDate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HijriDate.ProtoReflect.Descriptor instead.
+func (*HijriDate) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *HijriDate) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *HijriDate) GetMonth() int32 {
+	if x != nil {
+		return x.Month
+	}
+	return 0
+}
+
+func (x *HijriDate) GetMonthName() string {
+	if x != nil {
+		return x.MonthName
+	}
+	return ""
+}
+
+func (x *HijriDate) GetArabicMonthName() string {
+	if x != nil {
+		return x.ArabicMonthName
+	}
+	return ""
+}
+
+func (x *HijriDate) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *HijriDate) GetAdjustmentDays() int32 {
+	if x != nil {
+		return x.AdjustmentDays
+	}
+	return 0
+}
+
+// Represents the sidereal sign of a body during the day
+type Rashi struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Position of the sign at sunrise, from Mesha = 1
+	Number int32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Name of the sign at sunrise, e.g. Vrishabha
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of the sign the body enters before the next sunrise, empty when
+	// it stays in the sign
+	NextName string `protobuf:"bytes,3,opt,name=next_name,json=nextName,proto3" json:"next_name,omitempty"`
+	// Date of the ingress, which may follow the requested date (in ISO 8601
+	// format: YYYY-MM-DD)
+	IngressDate string `protobuf:"bytes,4,opt,name=ingress_date,json=ingressDate,proto3" json:"ingress_date,omitempty"`
+	// Time of the ingress (in ISO 8601 format: HH:MM:SS)
+	IngressTime string `protobuf:"bytes,5,opt,name=ingress_time,json=ingressTime,proto3" json:"ingress_time,omitempty"`
+}
+
+func (x *Rashi) Reset() {
+	*x = Rashi{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Rashi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Rashi) ProtoMessage() {}
+
+func (x *Rashi) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Rashi.ProtoReflect.Descriptor instead.
+func (*Rashi) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Rashi) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Rashi) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Rashi) GetNextName() string {
+	if x != nil {
+		return x.NextName
+	}
+	return ""
+}
+
+func (x *Rashi) GetIngressDate() string {
+	if x != nil {
+		return x.IngressDate
+	}
+	return ""
+}
+
+func (x *Rashi) GetIngressTime() string {
+	if x != nil {
+		return x.IngressTime
+	}
+	return ""
+}
+
+// Represents a quarter of a nakshatra
+type NakshatraPada struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the nakshatra, e.g. Rohini
+	Nakshatra string `protobuf:"bytes,1,opt,name=nakshatra,proto3" json:"nakshatra,omitempty"`
+	// Quarter of the nakshatra, from 1 to 4
+	Pada int32 `protobuf:"varint,2,opt,name=pada,proto3" json:"pada,omitempty"`
+	// Date the pada starts on, which may precede the requested date (in ISO 8601 format: YYYY-MM-DD)
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Start time of the pada (in ISO 8601 format: HH:MM:SS)
+	StartTime string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Date the pada ends on, which may follow the requested date (in ISO 8601 format: YYYY-MM-DD)
+	EndDate string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// End time of the pada (in ISO 8601 format: HH:MM:SS)
+	EndTime string `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *NakshatraPada) Reset() {
+	*x = NakshatraPada{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NakshatraPada) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NakshatraPada) ProtoMessage() {}
+
+func (x *NakshatraPada) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NakshatraPada.ProtoReflect.Descriptor instead.
+func (*NakshatraPada) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *NakshatraPada) GetNakshatra() string {
+	if x != nil {
+		return x.Nakshatra
+	}
+	return ""
+}
+
+func (x *NakshatraPada) GetPada() int32 {
+	if x != nil {
+		return x.Pada
+	}
+	return 0
+}
+
+func (x *NakshatraPada) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *NakshatraPada) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *NakshatraPada) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *NakshatraPada) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// Represents a single Choghadiya period
+type ChoghadiyaPeriod struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the Choghadiya (Amrit, Shubh, Labh, Char, Rog, Kaal or Udveg)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Nature of the period: auspicious, neutral or inauspicious
+	Nature string `protobuf:"bytes,2,opt,name=nature,proto3" json:"nature,omitempty"`
+	// Start time of the period (in ISO 8601 format: HH:MM:SS)
+	StartTime string `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time of the period (in ISO 8601 format: HH:MM:SS)
+	EndTime string `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// True for the eight periods between sunrise and sunset
+	IsDay bool `protobuf:"varint,5,opt,name=is_day,json=isDay,proto3" json:"is_day,omitempty"`
+}
+
+func (x *ChoghadiyaPeriod) Reset() {
+	*x = ChoghadiyaPeriod{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChoghadiyaPeriod) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChoghadiyaPeriod) ProtoMessage() {}
+
+func (x *ChoghadiyaPeriod) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChoghadiyaPeriod.ProtoReflect.Descriptor instead.
+func (*ChoghadiyaPeriod) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ChoghadiyaPeriod) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ChoghadiyaPeriod) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
+}
+
+func (x *ChoghadiyaPeriod) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *ChoghadiyaPeriod) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *ChoghadiyaPeriod) GetIsDay() bool {
+	if x != nil {
+		return x.IsDay
+	}
+	return false
+}
+
+// Represents an element transition close to the instant the element was evaluated
+type ElementBoundary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Element close to a transition: tithi, nakshatra, yoga or karana
+	Element string `protobuf:"bytes,1,opt,name=element,proto3" json:"element,omitempty"`
+	// Value computed at the evaluated instant
+	CurrentValue string `protobuf:"bytes,2,opt,name=current_value,json=currentValue,proto3" json:"current_value,omitempty"`
+	// Value on the other side of the transition
+	AdjacentValue string `protobuf:"bytes,3,opt,name=adjacent_value,json=adjacentValue,proto3" json:"adjacent_value,omitempty"`
+	// Estimated time of the transition (in ISO 8601 format: HH:MM:SS)
+	BoundaryTime string `protobuf:"bytes,4,opt,name=boundary_time,json=boundaryTime,proto3" json:"boundary_time,omitempty"`
+	// Seconds from the evaluated instant to the transition, negative if the transition has already happened
+	OffsetSeconds int32 `protobuf:"varint,5,opt,name=offset_seconds,json=offsetSeconds,proto3" json:"offset_seconds,omitempty"`
+}
+
+func (x *ElementBoundary) Reset() {
+	*x = ElementBoundary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ElementBoundary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElementBoundary) ProtoMessage() {}
+
+func (x *ElementBoundary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElementBoundary.ProtoReflect.Descriptor instead.
+func (*ElementBoundary) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ElementBoundary) GetElement() string {
+	if x != nil {
+		return x.Element
+	}
+	return ""
+}
+
+func (x *ElementBoundary) GetCurrentValue() string {
+	if x != nil {
+		return x.CurrentValue
+	}
+	return ""
+}
+
+func (x *ElementBoundary) GetAdjacentValue() string {
+	if x != nil {
+		return x.AdjacentValue
+	}
+	return ""
+}
+
+func (x *ElementBoundary) GetBoundaryTime() string {
+	if x != nil {
+		return x.BoundaryTime
+	}
+	return ""
+}
+
+func (x *ElementBoundary) GetOffsetSeconds() int32 {
+	if x != nil {
+		return x.OffsetSeconds
+	}
+	return 0
+}
+
+// Request message to retrieve Panchangam data for a specific date
+type GetPanchangamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Date for which Panchangam data is requested (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the returned times, e.g. Asia/Kolkata (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Window around element transitions within which both candidate values are reported, in seconds (defaults to 120)
+	BoundaryWindowSeconds int32 `protobuf:"varint,5,opt,name=boundary_window_seconds,json=boundaryWindowSeconds,proto3" json:"boundary_window_seconds,omitempty"`
+	// Region selecting regional conventions such as the new-year rule of the
+	// eras, e.g. gujarat, or tamil_nadu for the Gowri Panchangam and Nalla Neram
+	Region string `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	// Convention for sunrise and sunset and the quantities derived from them:
+	// apparent for the true, refracted sun or mean for the mean sun without
+	// refraction (defaults to apparent)
+	SunConvention string `protobuf:"bytes,7,opt,name=sun_convention,json=sunConvention,proto3" json:"sun_convention,omitempty"`
+	// Elevation of the observer above sea level in metres, used for the
+	// topocentric moon
+	Elevation float64 `protobuf:"fixed64,8,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	// Position of the moon the elements are computed from: geocentric as in
+	// traditional panchangams, or topocentric as seen by the observer,
+	// corrected for parallax (defaults to geocentric)
+	MoonPosition string `protobuf:"bytes,9,opt,name=moon_position,json=moonPosition,proto3" json:"moon_position,omitempty"`
+	// Atmospheric pressure at the observer in hectopascals for the refraction
+	// at sunrise and sunset; zero for the standard atmosphere of 1010 hPa and
+	// 10 degrees Celsius, in which case temperature is ignored
+	Pressure float64 `protobuf:"fixed64,10,opt,name=pressure,proto3" json:"pressure,omitempty"`
+	// Air temperature at the observer in degrees Celsius, used with pressure
+	Temperature float64 `protobuf:"fixed64,11,opt,name=temperature,proto3" json:"temperature,omitempty"`
+	// Whether sunrise and sunset are taken over the horizon lowered by the dip
+	// seen from elevation, as from a mountain over a distant horizon
+	HorizonDip bool `protobuf:"varint,12,opt,name=horizon_dip,json=horizonDip,proto3" json:"horizon_dip,omitempty"`
+	// Locale of the local names of the elements, e.g. hi, ta, te or sa; none
+	// are given when empty
+	Locale string `protobuf:"bytes,13,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Days by which the Hijri date is moved from the tabular calendar to
+	// follow the local sighting of the crescent, from -2 to 2: a positive
+	// adjustment starts the months later
+	HijriAdjustmentDays int32 `protobuf:"varint,14,opt,name=hijri_adjustment_days,json=hijriAdjustmentDays,proto3" json:"hijri_adjustment_days,omitempty"`
+}
+
+func (x *GetPanchangamRequest) Reset() {
+	*x = GetPanchangamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPanchangamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPanchangamRequest) ProtoMessage() {}
+
+func (x *GetPanchangamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPanchangamRequest.ProtoReflect.Descriptor instead.
+func (*GetPanchangamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetPanchangamRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetBoundaryWindowSeconds() int32 {
+	if x != nil {
+		return x.BoundaryWindowSeconds
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetSunConvention() string {
+	if x != nil {
+		return x.SunConvention
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetElevation() float64 {
+	if x != nil {
+		return x.Elevation
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetMoonPosition() string {
+	if x != nil {
+		return x.MoonPosition
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetPressure() float64 {
+	if x != nil {
+		return x.Pressure
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetTemperature() float64 {
+	if x != nil {
+		return x.Temperature
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetHorizonDip() bool {
+	if x != nil {
+		return x.HorizonDip
+	}
+	return false
+}
+
+func (x *GetPanchangamRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetHijriAdjustmentDays() int32 {
+	if x != nil {
+		return x.HijriAdjustmentDays
+	}
+	return 0
+}
+
+// Response message containing Panchangam data for the requested date
+type GetPanchangamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Panchangam data for the requested date
+	PanchangamData *PanchangamData `protobuf:"bytes,1,opt,name=panchangam_data,json=panchangamData,proto3" json:"panchangam_data,omitempty"`
+}
+
+func (x *GetPanchangamResponse) Reset() {
+	*x = GetPanchangamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPanchangamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPanchangamResponse) ProtoMessage() {}
+
+func (x *GetPanchangamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPanchangamResponse.ProtoReflect.Descriptor instead.
+func (*GetPanchangamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetPanchangamResponse) GetPanchangamData() *PanchangamData {
+	if x != nil {
+		return x.PanchangamData
+	}
+	return nil
+}
+
+// Request message to retrieve the festivals and vrats of a year
+type GetFestivalBundleRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Gregorian year of the bundle
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Region whose festivals are included, e.g. tamil_nadu (empty for all regions)
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the festival dates (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *GetFestivalBundleRequest) Reset() {
+	*x = GetFestivalBundleRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetFestivalBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFestivalBundleRequest) ProtoMessage() {}
+
+func (x *GetFestivalBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFestivalBundleRequest.ProtoReflect.Descriptor instead.
+func (*GetFestivalBundleRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetFestivalBundleRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetFestivalBundleRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetFestivalBundleRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetFestivalBundleRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetFestivalBundleRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// All festivals and vrats of a year for a region and location
+type FestivalBundle struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Gregorian year of the bundle
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Region the bundle was generated for
+	Region string `protobuf:"bytes,2,opt,name=region,proto3" json:"region,omitempty"`
+	// Latitude the bundle was generated for
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude the bundle was generated for
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone of the festival dates
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Festivals and vrats ordered by date
+	Festivals []*Festival `protobuf:"bytes,6,rep,name=festivals,proto3" json:"festivals,omitempty"`
+}
+
+func (x *FestivalBundle) Reset() {
+	*x = FestivalBundle{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FestivalBundle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FestivalBundle) ProtoMessage() {}
+
+func (x *FestivalBundle) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FestivalBundle.ProtoReflect.Descriptor instead.
+func (*FestivalBundle) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *FestivalBundle) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *FestivalBundle) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *FestivalBundle) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FestivalBundle) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *FestivalBundle) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *FestivalBundle) GetFestivals() []*Festival {
+	if x != nil {
+		return x.Festivals
+	}
+	return nil
+}
+
+// Represents a single dated festival or vrat
+type Festival struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Stable identifier of the occurrence, e.g. ekadashi-2025-01-10
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Kind of the occurrence: festival, vrat, eclipse or sankranti
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Date of the observance (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// Names of the festival in each supported locale
+	Names []*LocalizedName `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+	// Tithi prevailing at sunrise on the date, or at the start time of
+	// events bound to an instant
+	Tithi string `protobuf:"bytes,5,opt,name=tithi,proto3" json:"tithi,omitempty"`
+	// Identifier of the festival or vrat definition, e.g. ekadashi
+	Definition string `protobuf:"bytes,6,opt,name=definition,proto3" json:"definition,omitempty"`
+	// Start of the observance for events bound to an instant, such as the
+	// visible part of an eclipse or the moment of a sankranti; empty otherwise
+	StartTime string `protobuf:"bytes,7,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End of the observance for events spanning an interval; empty otherwise.
+	// It is 24:00:00 when the event continues on the next day
+	EndTime string `protobuf:"bytes,8,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Whether the event began on the previous day, in which case this entry
+	// covers its part on date from 00:00:00
+	ContinuesFromPreviousDay bool `protobuf:"varint,9,opt,name=continues_from_previous_day,json=continuesFromPreviousDay,proto3" json:"continues_from_previous_day,omitempty"`
+	// Whether the event continues on the next day, in which case this entry
+	// covers its part on date until midnight
+	ContinuesToNextDay bool `protobuf:"varint,10,opt,name=continues_to_next_day,json=continuesToNextDay,proto3" json:"continues_to_next_day,omitempty"`
+	// Part of the day the tithi of a festival or vrat must prevail during,
+	// e.g. pradosha for Pradosham: madhyahna, pradosha, nishita or moonrise;
+	// empty for observances evaluated at sunrise
+	Kala string `protobuf:"bytes,11,opt,name=kala,proto3" json:"kala,omitempty"`
+	// Start and end of the kala on date (in RFC 3339 format), when the
+	// observance is kept, such as the fast of Karva Chauth from sunrise to
+	// moonrise; empty without a kala. The nishita kala of a Shivaratri
+	// ends after midnight
+	ObservanceStart string `protobuf:"bytes,12,opt,name=observance_start,json=observanceStart,proto3" json:"observance_start,omitempty"`
+	ObservanceEnd   string `protobuf:"bytes,13,opt,name=observance_end,json=observanceEnd,proto3" json:"observance_end,omitempty"`
+}
+
+func (x *Festival) Reset() {
+	*x = Festival{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Festival) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Festival) ProtoMessage() {}
+
+func (x *Festival) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Festival.ProtoReflect.Descriptor instead.
+func (*Festival) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *Festival) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *Festival) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Festival) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Festival) GetNames() []*LocalizedName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *Festival) GetTithi() string {
+	if x != nil {
+		return x.Tithi
+	}
+	return ""
+}
+
+func (x *Festival) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *Festival) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Festival) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *Festival) GetContinuesFromPreviousDay() bool {
+	if x != nil {
+		return x.ContinuesFromPreviousDay
+	}
+	return false
+}
+
+func (x *Festival) GetContinuesToNextDay() bool {
+	if x != nil {
+		return x.ContinuesToNextDay
+	}
+	return false
+}
+
+func (x *Festival) GetKala() string {
+	if x != nil {
+		return x.Kala
+	}
+	return ""
+}
+
+func (x *Festival) GetObservanceStart() string {
+	if x != nil {
+		return x.ObservanceStart
+	}
+	return ""
+}
+
+func (x *Festival) GetObservanceEnd() string {
+	if x != nil {
+		return x.ObservanceEnd
+	}
+	return ""
+}
+
+// A festival lasting several days, such as Navaratri, with its days
+// following the tithis prevailing at sunrise
+type FestivalSpan struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the occurrence of the definition, e.g.
+	// navaratri-2024-10-03
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Identifier of the festival definition, e.g. navaratri
+	Definition string `protobuf:"bytes,2,opt,name=definition,proto3" json:"definition,omitempty"`
+	// Kind of the festival: festival or vrat
+	Kind string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	// Names of the festival in each supported locale
+	Names []*LocalizedName `protobuf:"bytes,4,rep,name=names,proto3" json:"names,omitempty"`
+	// Date the tithi of the definition prevails at sunrise (in ISO 8601
+	// format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,5,opt,name=date,proto3" json:"date,omitempty"`
+	// First and last days of the festival (in ISO 8601 format: YYYY-MM-DD)
+	StartDate string `protobuf:"bytes,6,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate   string `protobuf:"bytes,7,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Days of the festival ordered by date
+	Days []*FestivalSpanDay `protobuf:"bytes,8,rep,name=days,proto3" json:"days,omitempty"`
+}
+
+func (x *FestivalSpan) Reset() {
+	*x = FestivalSpan{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FestivalSpan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FestivalSpan) ProtoMessage() {}
+
+func (x *FestivalSpan) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FestivalSpan.ProtoReflect.Descriptor instead.
+func (*FestivalSpan) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *FestivalSpan) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FestivalSpan) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *FestivalSpan) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FestivalSpan) GetNames() []*LocalizedName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *FestivalSpan) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FestivalSpan) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *FestivalSpan) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *FestivalSpan) GetDays() []*FestivalSpanDay {
+	if x != nil {
+		return x.Days
+	}
+	return nil
+}
+
+// A day of a festival lasting several days
+type FestivalSpanDay struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the day, e.g. navaratri-2024-10-03-5
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Civil day of the festival counted from 1, e.g. 5 for the fifth night
+	// of Navaratri
+	Day int32 `protobuf:"varint,2,opt,name=day,proto3" json:"day,omitempty"`
+	// Date of the observance (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// Tithi of the day
+	Tithi string `protobuf:"bytes,4,opt,name=tithi,proto3" json:"tithi,omitempty"`
+	// Names of the day in each supported locale, e.g. Skandamata
+	Names []*LocalizedName `protobuf:"bytes,5,rep,name=names,proto3" json:"names,omitempty"`
+	// Whether the tithi prevails at no sunrise, so that it is observed on
+	// the same date as the previous day
+	Kshaya bool `protobuf:"varint,6,opt,name=kshaya,proto3" json:"kshaya,omitempty"`
+	// Whether the tithi also prevailed at the previous sunrise, so that it
+	// is observed again
+	Vriddhi bool `protobuf:"varint,7,opt,name=vriddhi,proto3" json:"vriddhi,omitempty"`
+}
+
+func (x *FestivalSpanDay) Reset() {
+	*x = FestivalSpanDay{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FestivalSpanDay) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FestivalSpanDay) ProtoMessage() {}
+
+func (x *FestivalSpanDay) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FestivalSpanDay.ProtoReflect.Descriptor instead.
+func (*FestivalSpanDay) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *FestivalSpanDay) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *FestivalSpanDay) GetDay() int32 {
+	if x != nil {
+		return x.Day
+	}
+	return 0
+}
+
+func (x *FestivalSpanDay) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FestivalSpanDay) GetTithi() string {
+	if x != nil {
+		return x.Tithi
+	}
+	return ""
+}
+
+func (x *FestivalSpanDay) GetNames() []*LocalizedName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *FestivalSpanDay) GetKshaya() bool {
+	if x != nil {
+		return x.Kshaya
+	}
+	return false
+}
+
+func (x *FestivalSpanDay) GetVriddhi() bool {
+	if x != nil {
+		return x.Vriddhi
+	}
+	return false
+}
+
+// Names of the elements of a panchangam in a locale; a name is empty when
+// the locale has none
+type LocalNames struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Locale code, e.g. hi, ta or te
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Name of the tithi, e.g. శుక్ల ఏకాదశి
+	Tithi string `protobuf:"bytes,2,opt,name=tithi,proto3" json:"tithi,omitempty"`
+	// Name of the nakshatra
+	Nakshatra string `protobuf:"bytes,3,opt,name=nakshatra,proto3" json:"nakshatra,omitempty"`
+	// Name of the yoga
+	Yoga string `protobuf:"bytes,4,opt,name=yoga,proto3" json:"yoga,omitempty"`
+	// Name of the karana
+	Karana string `protobuf:"bytes,5,opt,name=karana,proto3" json:"karana,omitempty"`
+}
+
+func (x *LocalNames) Reset() {
+	*x = LocalNames{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalNames) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalNames) ProtoMessage() {}
+
+func (x *LocalNames) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalNames.ProtoReflect.Descriptor instead.
+func (*LocalNames) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LocalNames) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *LocalNames) GetTithi() string {
+	if x != nil {
+		return x.Tithi
+	}
+	return ""
+}
+
+func (x *LocalNames) GetNakshatra() string {
+	if x != nil {
+		return x.Nakshatra
+	}
+	return ""
+}
+
+func (x *LocalNames) GetYoga() string {
+	if x != nil {
+		return x.Yoga
+	}
+	return ""
+}
+
+func (x *LocalNames) GetKarana() string {
+	if x != nil {
+		return x.Karana
+	}
+	return ""
+}
+
+// Represents a name in a specific locale
+type LocalizedName struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Locale code, e.g. en, hi or ta
+	Locale string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Name in the locale
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *LocalizedName) Reset() {
+	*x = LocalizedName{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LocalizedName) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LocalizedName) ProtoMessage() {}
+
+func (x *LocalizedName) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LocalizedName.ProtoReflect.Descriptor instead.
+func (*LocalizedName) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *LocalizedName) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *LocalizedName) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Request message to list the festivals, vrats, sankrantis and visible eclipses from a date
+type GetEventsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// First date to list (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Number of days to list starting with date (defaults to 1, or to the
+	// days up to end_date)
+	Days int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the dates (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Region whose festivals are included, e.g. tamil_nadu (empty for all regions)
+	Region string `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	// Kind (festival, vrat, eclipse or sankranti) or definition identifier (e.g.
+	// ekadashi or makara-sankranti) to list (empty for all)
+	Type string `protobuf:"bytes,7,opt,name=type,proto3" json:"type,omitempty"`
+	// Last date to list, inclusive (in ISO 8601 format: YYYY-MM-DD); when set
+	// with days the two must agree
+	EndDate string `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+}
+
+func (x *GetEventsRequest) Reset() {
+	*x = GetEventsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsRequest) ProtoMessage() {}
+
+func (x *GetEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsRequest.ProtoReflect.Descriptor instead.
+func (*GetEventsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *GetEventsRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetEventsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *GetEventsRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+// Response message listing festivals, vrats, sankrantis and visible eclipses
+type GetEventsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Festivals, vrats, sankrantis and eclipses ordered by date
+	Festivals []*Festival `protobuf:"bytes,1,rep,name=festivals,proto3" json:"festivals,omitempty"`
+	// Festivals lasting several days with a day in the range, ordered by
+	// their first day. Their days may extend beyond the range
+	Spans []*FestivalSpan `protobuf:"bytes,2,rep,name=spans,proto3" json:"spans,omitempty"`
+}
+
+func (x *GetEventsResponse) Reset() {
+	*x = GetEventsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetEventsResponse) ProtoMessage() {}
+
+func (x *GetEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetEventsResponse.ProtoReflect.Descriptor instead.
+func (*GetEventsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *GetEventsResponse) GetFestivals() []*Festival {
+	if x != nil {
+		return x.Festivals
+	}
+	return nil
+}
+
+func (x *GetEventsResponse) GetSpans() []*FestivalSpan {
+	if x != nil {
+		return x.Spans
+	}
+	return nil
+}
+
+// Request message to find the periods suitable for an activity
+type GetMuhurtaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// First date to search (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Number of days to search starting with date (defaults to 1, or to the
+	// days up to end_date)
+	Days int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the dates and times (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Activity, e.g. marriage, griha_pravesh or travel
+	Activity string `protobuf:"bytes,6,opt,name=activity,proto3" json:"activity,omitempty"`
+	// Rule pack of the tradition to follow, e.g. smarta, vaishnava or tamil
+	// (defaults to smarta)
+	Tradition string `protobuf:"bytes,7,opt,name=tradition,proto3" json:"tradition,omitempty"`
+	// Last date to search, inclusive (in ISO 8601 format: YYYY-MM-DD); when
+	// set with days the two must agree
+	EndDate string `protobuf:"bytes,8,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// Person the periods are for, whose tarabala and chandrabala are
+	// scored as well (optional)
+	Profile *Profile `protobuf:"bytes,9,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *GetMuhurtaRequest) Reset() {
+	*x = GetMuhurtaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMuhurtaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMuhurtaRequest) ProtoMessage() {}
+
+func (x *GetMuhurtaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMuhurtaRequest.ProtoReflect.Descriptor instead.
+func (*GetMuhurtaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetMuhurtaRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetMuhurtaRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *GetMuhurtaRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetMuhurtaRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetMuhurtaRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetMuhurtaRequest) GetActivity() string {
+	if x != nil {
+		return x.Activity
+	}
+	return ""
+}
+
+func (x *GetMuhurtaRequest) GetTradition() string {
+	if x != nil {
+		return x.Tradition
+	}
+	return ""
+}
+
+func (x *GetMuhurtaRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetMuhurtaRequest) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// The person a muhurta is sought for
+type Profile struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Janma nakshatra, that of the moon at birth, e.g. Rohini; tarabala is
+	// scored when set
+	JanmaNakshatra string `protobuf:"bytes,1,opt,name=janma_nakshatra,json=janmaNakshatra,proto3" json:"janma_nakshatra,omitempty"`
+	// Janma rashi, the sidereal sign of the moon at birth, e.g. Vrishabha;
+	// chandrabala is scored when set
+	JanmaRashi string `protobuf:"bytes,2,opt,name=janma_rashi,json=janmaRashi,proto3" json:"janma_rashi,omitempty"`
+}
+
+func (x *Profile) Reset() {
+	*x = Profile{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Profile) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Profile) ProtoMessage() {}
+
+func (x *Profile) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Profile.ProtoReflect.Descriptor instead.
+func (*Profile) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *Profile) GetJanmaNakshatra() string {
+	if x != nil {
+		return x.JanmaNakshatra
+	}
+	return ""
+}
+
+func (x *Profile) GetJanmaRashi() string {
+	if x != nil {
+		return x.JanmaRashi
+	}
+	return ""
+}
+
+// Response message listing the periods suitable for an activity
+type GetMuhurtaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rule pack the periods were found with
+	Tradition string `protobuf:"bytes,1,opt,name=tradition,proto3" json:"tradition,omitempty"`
+	// Activity the periods are suitable for
+	Activity string `protobuf:"bytes,2,opt,name=activity,proto3" json:"activity,omitempty"`
+	// Suitable periods ordered by time
+	Windows []*MuhurtaWindow `protobuf:"bytes,3,rep,name=windows,proto3" json:"windows,omitempty"`
+	// Abhijit and Brahma Muhurta of each day, which are auspicious for most
+	// activities regardless of the rules of the tradition
+	DailyMuhurtas []*Muhurta `protobuf:"bytes,4,rep,name=daily_muhurtas,json=dailyMuhurtas,proto3" json:"daily_muhurtas,omitempty"`
+}
+
+func (x *GetMuhurtaResponse) Reset() {
+	*x = GetMuhurtaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetMuhurtaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMuhurtaResponse) ProtoMessage() {}
+
+func (x *GetMuhurtaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMuhurtaResponse.ProtoReflect.Descriptor instead.
+func (*GetMuhurtaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetMuhurtaResponse) GetTradition() string {
+	if x != nil {
+		return x.Tradition
+	}
+	return ""
+}
+
+func (x *GetMuhurtaResponse) GetActivity() string {
+	if x != nil {
+		return x.Activity
+	}
+	return ""
+}
+
+func (x *GetMuhurtaResponse) GetWindows() []*MuhurtaWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+func (x *GetMuhurtaResponse) GetDailyMuhurtas() []*Muhurta {
+	if x != nil {
+		return x.DailyMuhurtas
+	}
+	return nil
+}
+
+// A period suitable for an activity
+type MuhurtaWindow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Civil date of this part of the period (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Start time of the period on date (in ISO 8601 format: HH:MM:SS)
+	StartTime string `protobuf:"bytes,2,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// End time of the period on date (in ISO 8601 format: HH:MM:SS), or
+	// 24:00:00 when it continues on the next day
+	EndTime string `protobuf:"bytes,3,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// Choghadiya the period spans
+	Choghadiya string `protobuf:"bytes,4,opt,name=choghadiya,proto3" json:"choghadiya,omitempty"`
+	// Score of the period under the rules of the tradition
+	Score int32 `protobuf:"varint,5,opt,name=score,proto3" json:"score,omitempty"`
+	// Factors that contributed to the score, e.g. "nakshatra Rohini +2"
+	Reasons []string `protobuf:"bytes,6,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// Whether the period began on the previous day
+	ContinuesFromPreviousDay bool `protobuf:"varint,7,opt,name=continues_from_previous_day,json=continuesFromPreviousDay,proto3" json:"continues_from_previous_day,omitempty"`
+	// Whether the period continues on the next day
+	ContinuesToNextDay bool `protobuf:"varint,8,opt,name=continues_to_next_day,json=continuesToNextDay,proto3" json:"continues_to_next_day,omitempty"`
+}
+
+func (x *MuhurtaWindow) Reset() {
+	*x = MuhurtaWindow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuhurtaWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuhurtaWindow) ProtoMessage() {}
+
+func (x *MuhurtaWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuhurtaWindow.ProtoReflect.Descriptor instead.
+func (*MuhurtaWindow) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *MuhurtaWindow) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *MuhurtaWindow) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *MuhurtaWindow) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *MuhurtaWindow) GetChoghadiya() string {
+	if x != nil {
+		return x.Choghadiya
+	}
+	return ""
+}
+
+func (x *MuhurtaWindow) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MuhurtaWindow) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *MuhurtaWindow) GetContinuesFromPreviousDay() bool {
+	if x != nil {
+		return x.ContinuesFromPreviousDay
+	}
+	return false
+}
+
+func (x *MuhurtaWindow) GetContinuesToNextDay() bool {
+	if x != nil {
+		return x.ContinuesToNextDay
+	}
+	return false
+}
+
+// Request message to find the next periods suitable for an activity
+type FindMuhurtaRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Date to search from (in ISO 8601 format: YYYY-MM-DD); periods of the
+	// night before it that run past midnight are included
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the dates and times (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Activity, e.g. marriage, griha_pravesh or travel
+	Activity string `protobuf:"bytes,5,opt,name=activity,proto3" json:"activity,omitempty"`
+	// Rule pack of the tradition to follow, e.g. smarta, vaishnava or tamil
+	// (defaults to smarta)
+	Tradition string `protobuf:"bytes,6,opt,name=tradition,proto3" json:"tradition,omitempty"`
+	// Number of periods to find, 1 to 50 (defaults to 5)
+	Count int32 `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	// Nakshatras one of which must prevail throughout a period, e.g. Rohini
+	// (defaults to any)
+	Nakshatras []string `protobuf:"bytes,8,rep,name=nakshatras,proto3" json:"nakshatras,omitempty"`
+	// Weekdays of the days to search, e.g. Friday; the weekday of a day is
+	// that of its sunrise (defaults to any)
+	Weekdays []string `protobuf:"bytes,9,rep,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// Whether to rule out periods touching Bhadra, the Vishti karana
+	ExcludeBhadra bool `protobuf:"varint,10,opt,name=exclude_bhadra,json=excludeBhadra,proto3" json:"exclude_bhadra,omitempty"`
+	// Number of days to search from date, 1 to 366 (defaults to 366)
+	Days int32 `protobuf:"varint,11,opt,name=days,proto3" json:"days,omitempty"`
+	// Person the periods are for, whose tarabala and chandrabala are
+	// scored as well (optional)
+	Profile *Profile `protobuf:"bytes,12,opt,name=profile,proto3" json:"profile,omitempty"`
+}
+
+func (x *FindMuhurtaRequest) Reset() {
+	*x = FindMuhurtaRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMuhurtaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMuhurtaRequest) ProtoMessage() {}
+
+func (x *FindMuhurtaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMuhurtaRequest.ProtoReflect.Descriptor instead.
+func (*FindMuhurtaRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *FindMuhurtaRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *FindMuhurtaRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FindMuhurtaRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *FindMuhurtaRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *FindMuhurtaRequest) GetActivity() string {
+	if x != nil {
+		return x.Activity
+	}
+	return ""
+}
+
+func (x *FindMuhurtaRequest) GetTradition() string {
+	if x != nil {
+		return x.Tradition
+	}
+	return ""
+}
+
+func (x *FindMuhurtaRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *FindMuhurtaRequest) GetNakshatras() []string {
+	if x != nil {
+		return x.Nakshatras
+	}
+	return nil
+}
+
+func (x *FindMuhurtaRequest) GetWeekdays() []string {
+	if x != nil {
+		return x.Weekdays
+	}
+	return nil
+}
+
+func (x *FindMuhurtaRequest) GetExcludeBhadra() bool {
+	if x != nil {
+		return x.ExcludeBhadra
+	}
+	return false
+}
+
+func (x *FindMuhurtaRequest) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *FindMuhurtaRequest) GetProfile() *Profile {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// Response message ranking the next periods suitable for an activity
+type FindMuhurtaResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Rule pack the periods were found with
+	Tradition string `protobuf:"bytes,1,opt,name=tradition,proto3" json:"tradition,omitempty"`
+	// Activity the periods are suitable for
+	Activity string `protobuf:"bytes,2,opt,name=activity,proto3" json:"activity,omitempty"`
+	// IANA timezone of the times
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Periods found, the first count in time, ranked by score, the earlier
+	// first among equal scores
+	Candidates []*MuhurtaCandidate `protobuf:"bytes,4,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	// Last date searched (in ISO 8601 format: YYYY-MM-DD): that of the
+	// latest period when count were found, or the last of days
+	SearchedUntil string `protobuf:"bytes,5,opt,name=searched_until,json=searchedUntil,proto3" json:"searched_until,omitempty"`
+}
+
+func (x *FindMuhurtaResponse) Reset() {
+	*x = FindMuhurtaResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindMuhurtaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindMuhurtaResponse) ProtoMessage() {}
+
+func (x *FindMuhurtaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindMuhurtaResponse.ProtoReflect.Descriptor instead.
+func (*FindMuhurtaResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *FindMuhurtaResponse) GetTradition() string {
+	if x != nil {
+		return x.Tradition
+	}
+	return ""
+}
+
+func (x *FindMuhurtaResponse) GetActivity() string {
+	if x != nil {
+		return x.Activity
+	}
+	return ""
+}
+
+func (x *FindMuhurtaResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *FindMuhurtaResponse) GetCandidates() []*MuhurtaCandidate {
+	if x != nil {
+		return x.Candidates
+	}
+	return nil
+}
+
+func (x *FindMuhurtaResponse) GetSearchedUntil() string {
+	if x != nil {
+		return x.SearchedUntil
+	}
+	return ""
+}
+
+// A period suitable for an activity found by FindMuhurta
+type MuhurtaCandidate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Start of the period (in RFC 3339 format)
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the period (in RFC 3339 format)
+	End string `protobuf:"bytes,2,opt,name=end,proto3" json:"end,omitempty"`
+	// Choghadiya the period spans
+	Choghadiya string `protobuf:"bytes,3,opt,name=choghadiya,proto3" json:"choghadiya,omitempty"`
+	// Score of the period under the rules of the tradition
+	Score int32 `protobuf:"varint,4,opt,name=score,proto3" json:"score,omitempty"`
+	// Factors that contributed to the score, e.g. "nakshatra Rohini +2",
+	// followed by the constraints the period satisfies
+	Reasons []string `protobuf:"bytes,5,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// Rank of the period among those found, from 1
+	Rank int32 `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`
+}
+
+func (x *MuhurtaCandidate) Reset() {
+	*x = MuhurtaCandidate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MuhurtaCandidate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MuhurtaCandidate) ProtoMessage() {}
+
+func (x *MuhurtaCandidate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MuhurtaCandidate.ProtoReflect.Descriptor instead.
+func (*MuhurtaCandidate) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MuhurtaCandidate) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *MuhurtaCandidate) GetEnd() string {
+	if x != nil {
+		return x.End
+	}
+	return ""
+}
+
+func (x *MuhurtaCandidate) GetChoghadiya() string {
+	if x != nil {
+		return x.Choghadiya
+	}
+	return ""
+}
+
+func (x *MuhurtaCandidate) GetScore() int32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *MuhurtaCandidate) GetReasons() []string {
+	if x != nil {
+		return x.Reasons
+	}
+	return nil
+}
+
+func (x *MuhurtaCandidate) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+// Request message to retrieve the year-at-a-glance vrat list
+type GetVratListRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Gregorian year of the list
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the dates and times (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Region whose festival definitions are used, e.g. tamil_nadu (empty for all regions)
+	Region string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	// Tradition deciding the day ekadashis are observed on: smarta or
+	// vaishnava (defaults to smarta)
+	Tradition string `protobuf:"bytes,6,opt,name=tradition,proto3" json:"tradition,omitempty"`
+}
+
+func (x *GetVratListRequest) Reset() {
+	*x = GetVratListRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVratListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVratListRequest) ProtoMessage() {}
+
+func (x *GetVratListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVratListRequest.ProtoReflect.Descriptor instead.
+func (*GetVratListRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetVratListRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *GetVratListRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetVratListRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetVratListRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetVratListRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetVratListRequest) GetTradition() string {
+	if x != nil {
+		return x.Tradition
+	}
+	return ""
+}
+
+// Ekadashis, purnimas, amavasyas and sankrantis of a year
+type VratList struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Gregorian year of the list
+	Year int32 `protobuf:"varint,1,opt,name=year,proto3" json:"year,omitempty"`
+	// Latitude the list was generated for
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude the list was generated for
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone of the dates and times
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Entries ordered by date
+	Dates []*VratDate `protobuf:"bytes,5,rep,name=dates,proto3" json:"dates,omitempty"`
+	// Tradition the ekadashis are observed following
+	Tradition string `protobuf:"bytes,6,opt,name=tradition,proto3" json:"tradition,omitempty"`
+}
+
+func (x *VratList) Reset() {
+	*x = VratList{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VratList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VratList) ProtoMessage() {}
+
+func (x *VratList) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VratList.ProtoReflect.Descriptor instead.
+func (*VratList) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *VratList) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *VratList) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *VratList) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *VratList) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *VratList) GetDates() []*VratDate {
+	if x != nil {
+		return x.Dates
+	}
+	return nil
+}
+
+func (x *VratList) GetTradition() string {
+	if x != nil {
+		return x.Tradition
+	}
+	return ""
+}
+
+// Represents an ekadashi, purnima, amavasya or sankranti of a vrat list
+type VratDate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the definition: ekadashi, purnima, amavasya or the
+	// sankranti, e.g. makara-sankranti
+	Definition string `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	// Names in each supported locale; the English name of an ekadashi is
+	// that of its month, e.g. Nirjala Ekadashi
+	Names []*LocalizedName `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// Date of the observance (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,3,opt,name=date,proto3" json:"date,omitempty"`
+	// Weekday of the date, e.g. Monday
+	Weekday string `protobuf:"bytes,4,opt,name=weekday,proto3" json:"weekday,omitempty"`
+	// Tithi prevailing at sunrise on the date, or at the moment of a sankranti
+	Tithi string `protobuf:"bytes,5,opt,name=tithi,proto3" json:"tithi,omitempty"`
+	// Moment of a sankranti; empty otherwise
+	Time string `protobuf:"bytes,6,opt,name=time,proto3" json:"time,omitempty"`
+	// Date of the parana of an ekadashi, the day after it; empty otherwise
+	ParanaDate string `protobuf:"bytes,7,opt,name=parana_date,json=paranaDate,proto3" json:"parana_date,omitempty"`
+	// Start of the window in which the fast of an ekadashi is broken
+	ParanaStartTime string `protobuf:"bytes,8,opt,name=parana_start_time,json=paranaStartTime,proto3" json:"parana_start_time,omitempty"`
+	// End of the window in which the fast of an ekadashi is broken
+	ParanaEndTime string `protobuf:"bytes,9,opt,name=parana_end_time,json=paranaEndTime,proto3" json:"parana_end_time,omitempty"`
+	// Whether Dashami prevails at arunodaya, 96 minutes before sunrise, on
+	// the first day an ekadashi prevails at sunrise; the Vaishnava then
+	// observe it on the next day
+	Viddha bool `protobuf:"varint,10,opt,name=viddha,proto3" json:"viddha,omitempty"`
+}
+
+func (x *VratDate) Reset() {
+	*x = VratDate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VratDate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VratDate) ProtoMessage() {}
+
+func (x *VratDate) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VratDate.ProtoReflect.Descriptor instead.
+func (*VratDate) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *VratDate) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *VratDate) GetNames() []*LocalizedName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *VratDate) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *VratDate) GetWeekday() string {
+	if x != nil {
+		return x.Weekday
+	}
+	return ""
+}
+
+func (x *VratDate) GetTithi() string {
+	if x != nil {
+		return x.Tithi
+	}
+	return ""
+}
+
+func (x *VratDate) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *VratDate) GetParanaDate() string {
+	if x != nil {
+		return x.ParanaDate
+	}
+	return ""
+}
+
+func (x *VratDate) GetParanaStartTime() string {
+	if x != nil {
+		return x.ParanaStartTime
+	}
+	return ""
+}
+
+func (x *VratDate) GetParanaEndTime() string {
+	if x != nil {
+		return x.ParanaEndTime
+	}
+	return ""
+}
+
+func (x *VratDate) GetViddha() bool {
+	if x != nil {
+		return x.Viddha
+	}
+	return false
+}
+
+// Request message to list the lagnas of a date
+type GetLagnasRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Date to list the lagnas of (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the date and times (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+}
+
+func (x *GetLagnasRequest) Reset() {
+	*x = GetLagnasRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLagnasRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLagnasRequest) ProtoMessage() {}
+
+func (x *GetLagnasRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLagnasRequest.ProtoReflect.Descriptor instead.
+func (*GetLagnasRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetLagnasRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetLagnasRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetLagnasRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetLagnasRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+// Response message listing the lagnas of a date
+type GetLagnasResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Date of the lagnas (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// IANA timezone of the dates and times
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Lagnas prevailing from midnight to midnight, ordered by time
+	Lagnas []*Lagna `protobuf:"bytes,3,rep,name=lagnas,proto3" json:"lagnas,omitempty"`
+}
+
+func (x *GetLagnasResponse) Reset() {
+	*x = GetLagnasResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetLagnasResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLagnasResponse) ProtoMessage() {}
+
+func (x *GetLagnasResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLagnasResponse.ProtoReflect.Descriptor instead.
+func (*GetLagnasResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetLagnasResponse) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetLagnasResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetLagnasResponse) GetLagnas() []*Lagna {
+	if x != nil {
+		return x.Lagnas
+	}
+	return nil
+}
+
+// Represents the sidereal sign rising on the eastern horizon during a period
+type Lagna struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the sign, e.g. Mesha
+	Rashi string `protobuf:"bytes,1,opt,name=rashi,proto3" json:"rashi,omitempty"`
+	// Position of the sign starting from Mesha = 1
+	Number int32 `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	// Date the lagna starts on, which may precede the requested date (in ISO 8601 format: YYYY-MM-DD)
+	StartDate string `protobuf:"bytes,3,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// Start time of the lagna (in ISO 8601 format: HH:MM:SS)
+	StartTime string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Date the lagna ends on, which may follow the requested date (in ISO 8601 format: YYYY-MM-DD)
+	EndDate string `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// End time of the lagna (in ISO 8601 format: HH:MM:SS)
+	EndTime string `protobuf:"bytes,6,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+}
+
+func (x *Lagna) Reset() {
+	*x = Lagna{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Lagna) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Lagna) ProtoMessage() {}
+
+func (x *Lagna) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Lagna.ProtoReflect.Descriptor instead.
+func (*Lagna) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *Lagna) GetRashi() string {
+	if x != nil {
+		return x.Rashi
+	}
+	return ""
+}
+
+func (x *Lagna) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Lagna) GetStartDate() string {
+	if x != nil {
+		return x.StartDate
+	}
+	return ""
+}
+
+func (x *Lagna) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *Lagna) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *Lagna) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+// Request message for the summary of the panchangam of a date
+type GetSummaryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Date to summarize (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the date and times (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Locale of the summary, e.g. hi, ta, te or sa (defaults to en)
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Convention for sunrise and sunset: apparent or mean (defaults to apparent)
+	SunConvention string `protobuf:"bytes,6,opt,name=sun_convention,json=sunConvention,proto3" json:"sun_convention,omitempty"`
+}
+
+func (x *GetSummaryRequest) Reset() {
+	*x = GetSummaryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSummaryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSummaryRequest) ProtoMessage() {}
+
+func (x *GetSummaryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSummaryRequest.ProtoReflect.Descriptor instead.
+func (*GetSummaryRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetSummaryRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetSummaryRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetSummaryRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetSummaryRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetSummaryRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetSummaryRequest) GetSunConvention() string {
+	if x != nil {
+		return x.SunConvention
+	}
+	return ""
+}
+
+// The Panchanga Shravanam: the panchangam of a date as it is read out or
+// printed, from the samvatsara to the inauspicious periods of the day
+type Summary struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Date of the summary (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Locale of the summary
+	Locale string `protobuf:"bytes,2,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Summary as a block of text, one "label: value" line after the date
+	Text string `protobuf:"bytes,3,opt,name=text,proto3" json:"text,omitempty"`
+	// Lines of the summary, in the order they are read out
+	Lines []*SummaryLine `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+}
+
+func (x *Summary) Reset() {
+	*x = Summary{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Summary) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Summary) ProtoMessage() {}
+
+func (x *Summary) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Summary.ProtoReflect.Descriptor instead.
+func (*Summary) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *Summary) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *Summary) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *Summary) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+func (x *Summary) GetLines() []*SummaryLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+// Represents a line of a summary. Times past the midnight ending the date
+// count on from 24:00, e.g. 26:15 for 02:15 the next morning.
+type SummaryLine struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Label of the line in the locale, e.g. Tithi
+	Label string `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	// Value of the line in the locale, e.g. Ekadashi until 14:05
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SummaryLine) Reset() {
+	*x = SummaryLine{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SummaryLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SummaryLine) ProtoMessage() {}
+
+func (x *SummaryLine) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SummaryLine.ProtoReflect.Descriptor instead.
+func (*SummaryLine) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *SummaryLine) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *SummaryLine) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// Request message subscribing to the transitions of the panchangam at a location
+type WatchTransitionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name of the days and times (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Locale of the local names of the elements, e.g. hi, ta, te or sa; none
+	// are given when empty
+	Locale string `protobuf:"bytes,4,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Minutes before Rahu Kalam, Yamagandam and Gulika Kalam start that they
+	// are announced, 0 to 120 (defaults to 5)
+	NoticeMinutes int32 `protobuf:"varint,5,opt,name=notice_minutes,json=noticeMinutes,proto3" json:"notice_minutes,omitempty"`
+	// Announce no period ahead of its start, regardless of notice_minutes
+	NoNotice bool `protobuf:"varint,6,opt,name=no_notice,json=noNotice,proto3" json:"no_notice,omitempty"`
+}
+
+func (x *WatchTransitionsRequest) Reset() {
+	*x = WatchTransitionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WatchTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchTransitionsRequest) ProtoMessage() {}
+
+func (x *WatchTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*WatchTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *WatchTransitionsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *WatchTransitionsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *WatchTransitionsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *WatchTransitionsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *WatchTransitionsRequest) GetNoticeMinutes() int32 {
+	if x != nil {
+		return x.NoticeMinutes
+	}
+	return 0
+}
+
+func (x *WatchTransitionsRequest) GetNoNotice() bool {
+	if x != nil {
+		return x.NoNotice
+	}
+	return false
+}
+
+// Represents a change of the panchangam, sent when it is due
+type Transition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// What changes: tithi, nakshatra, yoga, karana, vara, sunrise, sunset,
+	// rahu_kalam, yamagandam or gulika_kalam
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// What happens: start, end, or notice of a period starting soon
+	Event string `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	// Name of the element starting, e.g. Dvadashi, or of the period, e.g. Rahu Kalam
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of the element starting in the requested locale, if it has one
+	LocalName string `protobuf:"bytes,4,opt,name=local_name,json=localName,proto3" json:"local_name,omitempty"`
+	// Time of the change (in RFC 3339 format), which follows a notice
+	Time string `protobuf:"bytes,5,opt,name=time,proto3" json:"time,omitempty"`
+	// Description of the transition, e.g. "Tithi changes to Dvadashi at 14:32"
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *Transition) Reset() {
+	*x = Transition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Transition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transition) ProtoMessage() {}
+
+func (x *Transition) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transition.ProtoReflect.Descriptor instead.
+func (*Transition) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *Transition) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Transition) GetEvent() string {
+	if x != nil {
+		return x.Event
+	}
+	return ""
+}
+
+func (x *Transition) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Transition) GetLocalName() string {
+	if x != nil {
+		return x.LocalName
+	}
+	return ""
+}
+
+func (x *Transition) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *Transition) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// Request message for the next transitions of the panchangam at a location
+type GetNextTransitionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Time to list the transitions from (in RFC 3339 format; defaults to now)
+	Time string `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name of the days and times (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Locale of the local names of the elements, e.g. hi, ta, te or sa; none
+	// are given when empty
+	Locale string `protobuf:"bytes,5,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Number of transitions to list, 1 to 100 (defaults to 10)
+	Count int32 `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	// Kinds of transitions to list, e.g. tithi, sunset, moonrise or
+	// rahu_kalam (defaults to every kind)
+	Kinds []string `protobuf:"bytes,7,rep,name=kinds,proto3" json:"kinds,omitempty"`
+}
+
+func (x *GetNextTransitionsRequest) Reset() {
+	*x = GetNextTransitionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNextTransitionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextTransitionsRequest) ProtoMessage() {}
+
+func (x *GetNextTransitionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextTransitionsRequest.ProtoReflect.Descriptor instead.
+func (*GetNextTransitionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *GetNextTransitionsRequest) GetTime() string {
+	if x != nil {
+		return x.Time
+	}
+	return ""
+}
+
+func (x *GetNextTransitionsRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetNextTransitionsRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetNextTransitionsRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetNextTransitionsRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetNextTransitionsRequest) GetCount() int32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *GetNextTransitionsRequest) GetKinds() []string {
+	if x != nil {
+		return x.Kinds
+	}
+	return nil
+}
+
+// Response message listing the next transitions from a time
+type GetNextTransitionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// IANA timezone of the times
+	Timezone string `protobuf:"bytes,1,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Transitions from the requested time, ordered by time. Periods are
+	// not announced ahead, so there are no notices.
+	Transitions []*Transition `protobuf:"bytes,2,rep,name=transitions,proto3" json:"transitions,omitempty"`
+}
+
+func (x *GetNextTransitionsResponse) Reset() {
+	*x = GetNextTransitionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetNextTransitionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNextTransitionsResponse) ProtoMessage() {}
+
+func (x *GetNextTransitionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNextTransitionsResponse.ProtoReflect.Descriptor instead.
+func (*GetNextTransitionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *GetNextTransitionsResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetNextTransitionsResponse) GetTransitions() []*Transition {
+	if x != nil {
+		return x.Transitions
+	}
+	return nil
+}
+
+// Request message for the health of the server
+type GetHealthRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetHealthRequest) Reset() {
+	*x = GetHealthRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHealthRequest) ProtoMessage() {}
+
+func (x *GetHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHealthRequest.ProtoReflect.Descriptor instead.
+func (*GetHealthRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{41}
+}
+
+// Health of the server
+type Health struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Status of the server: serving, or warming while the first
+	// precomputation pass is running
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// Precomputation of the panchangams of popular locations, unset when
+	// none are precomputed
+	Precompute *PrecomputeStatus `protobuf:"bytes,2,opt,name=precompute,proto3" json:"precompute,omitempty"`
+}
+
+func (x *Health) Reset() {
+	*x = Health{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Health) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Health) ProtoMessage() {}
+
+func (x *Health) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Health.ProtoReflect.Descriptor instead.
+func (*Health) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *Health) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Health) GetPrecompute() *PrecomputeStatus {
+	if x != nil {
+		return x.Precompute
+	}
+	return nil
+}
+
+// Progress of the precomputation of the panchangams of popular locations
+type PrecomputeStatus struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of locations precomputed
+	Locations int32 `protobuf:"varint,1,opt,name=locations,proto3" json:"locations,omitempty"`
+	// Number of days precomputed for each location
+	Days int32 `protobuf:"varint,2,opt,name=days,proto3" json:"days,omitempty"`
+	// Number of panchangams computed and cached by the latest pass
+	Warm int32 `protobuf:"varint,3,opt,name=warm,proto3" json:"warm,omitempty"`
+	// Number of panchangams that failed to compute in the latest pass
+	Failed int32 `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	// Number of completed passes
+	Passes int32 `protobuf:"varint,5,opt,name=passes,proto3" json:"passes,omitempty"`
+	// Time the latest completed pass ended (in RFC 3339 format; empty before
+	// the first pass ends)
+	LastPassTime string `protobuf:"bytes,6,opt,name=last_pass_time,json=lastPassTime,proto3" json:"last_pass_time,omitempty"`
+	// Duration of the latest completed pass in milliseconds
+	LastPassDurationMs int64 `protobuf:"varint,7,opt,name=last_pass_duration_ms,json=lastPassDurationMs,proto3" json:"last_pass_duration_ms,omitempty"`
+	// Error of the latest failure in the latest pass
+	LastError string `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+}
+
+func (x *PrecomputeStatus) Reset() {
+	*x = PrecomputeStatus{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PrecomputeStatus) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrecomputeStatus) ProtoMessage() {}
+
+func (x *PrecomputeStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrecomputeStatus.ProtoReflect.Descriptor instead.
+func (*PrecomputeStatus) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *PrecomputeStatus) GetLocations() int32 {
+	if x != nil {
+		return x.Locations
+	}
+	return 0
+}
+
+func (x *PrecomputeStatus) GetDays() int32 {
+	if x != nil {
+		return x.Days
+	}
+	return 0
+}
+
+func (x *PrecomputeStatus) GetWarm() int32 {
+	if x != nil {
+		return x.Warm
+	}
+	return 0
+}
+
+func (x *PrecomputeStatus) GetFailed() int32 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *PrecomputeStatus) GetPasses() int32 {
+	if x != nil {
+		return x.Passes
+	}
+	return 0
+}
+
+func (x *PrecomputeStatus) GetLastPassTime() string {
+	if x != nil {
+		return x.LastPassTime
+	}
+	return ""
+}
+
+func (x *PrecomputeStatus) GetLastPassDurationMs() int64 {
+	if x != nil {
+		return x.LastPassDurationMs
+	}
+	return 0
+}
+
+func (x *PrecomputeStatus) GetLastError() string {
+	if x != nil {
+		return x.LastError
+	}
+	return ""
+}
+
+// Request message for the system information of the server
+type GetSystemInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetSystemInfoRequest) Reset() {
+	*x = GetSystemInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetSystemInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSystemInfoRequest) ProtoMessage() {}
+
+func (x *GetSystemInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSystemInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetSystemInfoRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{44}
+}
+
+// Build, ephemeris providers and caches of the server
+type SystemInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Release the server was built as, or dev
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Revision of the source the server was built from, empty when unknown
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Version of Go the server was built with
+	GoVersion string `protobuf:"bytes,3,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// Time the server started (in RFC 3339 format)
+	StartTime string `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	// Seconds since the server started
+	UptimeSeconds int64 `protobuf:"varint,5,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// Health of each registered ephemeris provider, by name
+	EphemerisProviders []*EphemerisProviderHealth `protobuf:"bytes,6,rep,name=ephemeris_providers,json=ephemerisProviders,proto3" json:"ephemeris_providers,omitempty"`
+	// Statistics of each cache
+	Caches []*CacheStats `protobuf:"bytes,7,rep,name=caches,proto3" json:"caches,omitempty"`
+}
+
+func (x *SystemInfo) Reset() {
+	*x = SystemInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SystemInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SystemInfo) ProtoMessage() {}
+
+func (x *SystemInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SystemInfo.ProtoReflect.Descriptor instead.
+func (*SystemInfo) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SystemInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetStartTime() string {
+	if x != nil {
+		return x.StartTime
+	}
+	return ""
+}
+
+func (x *SystemInfo) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *SystemInfo) GetEphemerisProviders() []*EphemerisProviderHealth {
+	if x != nil {
+		return x.EphemerisProviders
+	}
+	return nil
+}
+
+func (x *SystemInfo) GetCaches() []*CacheStats {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+// Health of an ephemeris provider, from computing a position with it
+type EphemerisProviderHealth struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the provider, e.g. builtin or horizons
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the provider computed the position
+	Healthy bool `protobuf:"varint,2,opt,name=healthy,proto3" json:"healthy,omitempty"`
+	// Error of the provider when it is not healthy
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	// Time the computation took in milliseconds
+	LatencyMs int64 `protobuf:"varint,4,opt,name=latency_ms,json=latencyMs,proto3" json:"latency_ms,omitempty"`
+	// Time the provider was checked (in RFC 3339 format); checks are reused
+	// for a minute
+	CheckedTime string `protobuf:"bytes,5,opt,name=checked_time,json=checkedTime,proto3" json:"checked_time,omitempty"`
+	// Whether an operator disabled the provider, which is then not checked
+	Disabled bool `protobuf:"varint,6,opt,name=disabled,proto3" json:"disabled,omitempty"`
+}
+
+func (x *EphemerisProviderHealth) Reset() {
+	*x = EphemerisProviderHealth{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EphemerisProviderHealth) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EphemerisProviderHealth) ProtoMessage() {}
+
+func (x *EphemerisProviderHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EphemerisProviderHealth.ProtoReflect.Descriptor instead.
+func (*EphemerisProviderHealth) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *EphemerisProviderHealth) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EphemerisProviderHealth) GetHealthy() bool {
+	if x != nil {
+		return x.Healthy
+	}
+	return false
+}
+
+func (x *EphemerisProviderHealth) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *EphemerisProviderHealth) GetLatencyMs() int64 {
+	if x != nil {
+		return x.LatencyMs
+	}
+	return 0
+}
+
+func (x *EphemerisProviderHealth) GetCheckedTime() string {
+	if x != nil {
+		return x.CheckedTime
+	}
+	return ""
+}
+
+func (x *EphemerisProviderHealth) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+// Statistics of a cache since the server started
+type CacheStats struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cache, e.g. panchangam
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Number of entries held
+	Entries int64 `protobuf:"varint,2,opt,name=entries,proto3" json:"entries,omitempty"`
+	// Reads served from the cache
+	Hits int64 `protobuf:"varint,3,opt,name=hits,proto3" json:"hits,omitempty"`
+	// Reads that waited for a computation
+	Misses int64 `protobuf:"varint,4,opt,name=misses,proto3" json:"misses,omitempty"`
+	// Computations started because of a miss
+	Loads int64 `protobuf:"varint,5,opt,name=loads,proto3" json:"loads,omitempty"`
+	// Computations started ahead of expiry
+	Refreshes int64 `protobuf:"varint,6,opt,name=refreshes,proto3" json:"refreshes,omitempty"`
+	// Misses served from the shared cache backend
+	SharedHits int64 `protobuf:"varint,7,opt,name=shared_hits,json=sharedHits,proto3" json:"shared_hits,omitempty"`
+}
+
+func (x *CacheStats) Reset() {
+	*x = CacheStats{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CacheStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CacheStats) ProtoMessage() {}
+
+func (x *CacheStats) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CacheStats.ProtoReflect.Descriptor instead.
+func (*CacheStats) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CacheStats) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CacheStats) GetEntries() int64 {
+	if x != nil {
+		return x.Entries
+	}
+	return 0
+}
+
+func (x *CacheStats) GetHits() int64 {
+	if x != nil {
+		return x.Hits
+	}
+	return 0
+}
+
+func (x *CacheStats) GetMisses() int64 {
+	if x != nil {
+		return x.Misses
+	}
+	return 0
+}
+
+func (x *CacheStats) GetLoads() int64 {
+	if x != nil {
+		return x.Loads
+	}
+	return 0
+}
+
+func (x *CacheStats) GetRefreshes() int64 {
+	if x != nil {
+		return x.Refreshes
+	}
+	return 0
+}
+
+func (x *CacheStats) GetSharedHits() int64 {
+	if x != nil {
+		return x.SharedHits
+	}
+	return 0
+}
+
+// Request message for the version of the server
+type GetVersionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetVersionRequest) Reset() {
+	*x = GetVersionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetVersionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVersionRequest) ProtoMessage() {}
+
+func (x *GetVersionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVersionRequest.ProtoReflect.Descriptor instead.
+func (*GetVersionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{48}
+}
+
+// Version the server was built as
+type VersionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Release the server was built as, or dev
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Revision of the source the server was built from, empty when unknown
+	Commit string `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
+	// Time the server was built (in RFC 3339 format), empty when unknown
+	BuildDate string `protobuf:"bytes,3,opt,name=build_date,json=buildDate,proto3" json:"build_date,omitempty"`
+	// Version of Go the server was built with
+	GoVersion string `protobuf:"bytes,4,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
+	// Optional features built into the server, e.g. redis
+	Features []string `protobuf:"bytes,5,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *VersionInfo) Reset() {
+	*x = VersionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VersionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VersionInfo) ProtoMessage() {}
+
+func (x *VersionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VersionInfo.ProtoReflect.Descriptor instead.
+func (*VersionInfo) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VersionInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *VersionInfo) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *VersionInfo) GetBuildDate() string {
+	if x != nil {
+		return x.BuildDate
+	}
+	return ""
+}
+
+func (x *VersionInfo) GetGoVersion() string {
+	if x != nil {
+		return x.GoVersion
+	}
+	return ""
+}
+
+func (x *VersionInfo) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+// Request message for the dates of a festival in a range of years
+type FindFestivalDatesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier or name in any locale of the festival, e.g. diwali or Raksha Bandhan
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// First Gregorian year searched
+	Year int32 `protobuf:"varint,2,opt,name=year,proto3" json:"year,omitempty"`
+	// Last Gregorian year searched, inclusive (defaults to year)
+	EndYear int32 `protobuf:"varint,3,opt,name=end_year,json=endYear,proto3" json:"end_year,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,4,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,5,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name used for the dates (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,6,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Region whose festival definitions are used, e.g. tamil_nadu (empty for all regions)
+	Region string `protobuf:"bytes,7,opt,name=region,proto3" json:"region,omitempty"`
+}
+
+func (x *FindFestivalDatesRequest) Reset() {
+	*x = FindFestivalDatesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindFestivalDatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindFestivalDatesRequest) ProtoMessage() {}
+
+func (x *FindFestivalDatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindFestivalDatesRequest.ProtoReflect.Descriptor instead.
+func (*FindFestivalDatesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *FindFestivalDatesRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FindFestivalDatesRequest) GetYear() int32 {
+	if x != nil {
+		return x.Year
+	}
+	return 0
+}
+
+func (x *FindFestivalDatesRequest) GetEndYear() int32 {
+	if x != nil {
+		return x.EndYear
+	}
+	return 0
+}
+
+func (x *FindFestivalDatesRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *FindFestivalDatesRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *FindFestivalDatesRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *FindFestivalDatesRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+// Dates of a festival in a range of years
+type FindFestivalDatesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the festival definition found, e.g. diwali
+	Definition string `protobuf:"bytes,1,opt,name=definition,proto3" json:"definition,omitempty"`
+	// Names of the festival in each supported locale
+	Names []*LocalizedName `protobuf:"bytes,2,rep,name=names,proto3" json:"names,omitempty"`
+	// IANA timezone of the dates
+	Timezone string `protobuf:"bytes,3,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Occurrences of the festival ordered by date; empty when it is not
+	// observed in the region
+	Festivals []*Festival `protobuf:"bytes,4,rep,name=festivals,proto3" json:"festivals,omitempty"`
+}
+
+func (x *FindFestivalDatesResponse) Reset() {
+	*x = FindFestivalDatesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FindFestivalDatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindFestivalDatesResponse) ProtoMessage() {}
+
+func (x *FindFestivalDatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FindFestivalDatesResponse.ProtoReflect.Descriptor instead.
+func (*FindFestivalDatesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *FindFestivalDatesResponse) GetDefinition() string {
+	if x != nil {
+		return x.Definition
+	}
+	return ""
+}
+
+func (x *FindFestivalDatesResponse) GetNames() []*LocalizedName {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+func (x *FindFestivalDatesResponse) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *FindFestivalDatesResponse) GetFestivals() []*Festival {
+	if x != nil {
+		return x.Festivals
+	}
+	return nil
+}
+
+// Request message for a usage report
+type GetUsageReportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// First UTC day of the report (in ISO 8601 format: YYYY-MM-DD; defaults to today)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Last UTC day of the report, inclusive (in ISO 8601 format: YYYY-MM-DD; defaults to date)
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// ID of the API key reported on (empty for every key)
+	KeyId string `protobuf:"bytes,3,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Full method name of the RPC reported on, e.g. /panchangam.Panchangam/Get (empty for every RPC)
+	Method string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+}
+
+func (x *GetUsageReportRequest) Reset() {
+	*x = GetUsageReportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetUsageReportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUsageReportRequest) ProtoMessage() {}
+
+func (x *GetUsageReportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUsageReportRequest.ProtoReflect.Descriptor instead.
+func (*GetUsageReportRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *GetUsageReportRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetEndDate() string {
+	if x != nil {
+		return x.EndDate
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *GetUsageReportRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+// Daily use of the RPCs by API key
+type UsageReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Use of an RPC by an API key on a day, ordered by day, key and method
+	Rows []*UsageRow `protobuf:"bytes,1,rep,name=rows,proto3" json:"rows,omitempty"`
+	// Calls in the report
+	TotalCalls int64 `protobuf:"varint,2,opt,name=total_calls,json=totalCalls,proto3" json:"total_calls,omitempty"`
+	// Failed calls in the report
+	TotalErrors int64 `protobuf:"varint,3,opt,name=total_errors,json=totalErrors,proto3" json:"total_errors,omitempty"`
+}
+
+func (x *UsageReport) Reset() {
+	*x = UsageReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageReport) ProtoMessage() {}
+
+func (x *UsageReport) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageReport.ProtoReflect.Descriptor instead.
+func (*UsageReport) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *UsageReport) GetRows() []*UsageRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *UsageReport) GetTotalCalls() int64 {
+	if x != nil {
+		return x.TotalCalls
+	}
+	return 0
+}
+
+func (x *UsageReport) GetTotalErrors() int64 {
+	if x != nil {
+		return x.TotalErrors
+	}
+	return 0
+}
+
+// Use of an RPC by an API key on a day
+type UsageRow struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// UTC day of the calls (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// ID of the API key, empty for calls without one
+	KeyId string `protobuf:"bytes,2,opt,name=key_id,json=keyId,proto3" json:"key_id,omitempty"`
+	// Full method name of the RPC
+	Method string `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	// Number of calls
+	Calls int64 `protobuf:"varint,4,opt,name=calls,proto3" json:"calls,omitempty"`
+	// Number of calls that failed
+	Errors int64 `protobuf:"varint,5,opt,name=errors,proto3" json:"errors,omitempty"`
+	// Mean latency of the calls in milliseconds
+	MeanLatencyMs float64 `protobuf:"fixed64,6,opt,name=mean_latency_ms,json=meanLatencyMs,proto3" json:"mean_latency_ms,omitempty"`
+	// Longest latency of the calls in milliseconds
+	MaxLatencyMs float64 `protobuf:"fixed64,7,opt,name=max_latency_ms,json=maxLatencyMs,proto3" json:"max_latency_ms,omitempty"`
+}
+
+func (x *UsageRow) Reset() {
+	*x = UsageRow{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UsageRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UsageRow) ProtoMessage() {}
+
+func (x *UsageRow) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UsageRow.ProtoReflect.Descriptor instead.
+func (*UsageRow) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *UsageRow) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *UsageRow) GetKeyId() string {
+	if x != nil {
+		return x.KeyId
+	}
+	return ""
+}
+
+func (x *UsageRow) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *UsageRow) GetCalls() int64 {
+	if x != nil {
+		return x.Calls
+	}
+	return 0
+}
+
+func (x *UsageRow) GetErrors() int64 {
+	if x != nil {
+		return x.Errors
+	}
+	return 0
+}
+
+func (x *UsageRow) GetMeanLatencyMs() float64 {
+	if x != nil {
+		return x.MeanLatencyMs
+	}
+	return 0
+}
+
+func (x *UsageRow) GetMaxLatencyMs() float64 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+// Request message to subscribe a URL to events
+type CreateSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// http or https URL the events are posted to
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name of the dates of festivals (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Region whose festival definitions are used, e.g. tamil_nadu (empty for all regions)
+	Region string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	// Events posted: festival, ekadashi, a kind of transition such as
+	// tithi or sunrise, or the start, end or notice of a period such as
+	// rahu_kalam_start
+	Events []string `protobuf:"bytes,6,rep,name=events,proto3" json:"events,omitempty"`
+}
+
+func (x *CreateSubscriptionRequest) Reset() {
+	*x = CreateSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateSubscriptionRequest) ProtoMessage() {}
+
+func (x *CreateSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*CreateSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CreateSubscriptionRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *CreateSubscriptionRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *CreateSubscriptionRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *CreateSubscriptionRequest) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+// A URL notified of the events at a location. Each event is posted as
+// JSON with the headers X-Panchangam-Timestamp, the Unix time it was sent
+// at, and X-Panchangam-Signature, "sha256=" and the hex HMAC-SHA256 keyed
+// with the secret of the timestamp, a dot and the body. Failed deliveries
+// are retried with exponential backoff.
+type WebhookSubscription struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the subscription
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// URL the events are posted to
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// Latitude of the observer in degrees
+	Latitude float64 `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees
+	Longitude float64 `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone of the dates of festivals
+	Timezone string `protobuf:"bytes,5,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Region whose festival definitions are used
+	Region string `protobuf:"bytes,6,opt,name=region,proto3" json:"region,omitempty"`
+	// Events posted
+	Events []string `protobuf:"bytes,7,rep,name=events,proto3" json:"events,omitempty"`
+	// Secret the deliveries are signed with; only returned by CreateSubscription
+	Secret string `protobuf:"bytes,8,opt,name=secret,proto3" json:"secret,omitempty"`
+	// Time the subscription was created in RFC 3339 format
+	Created string `protobuf:"bytes,9,opt,name=created,proto3" json:"created,omitempty"`
+}
+
+func (x *WebhookSubscription) Reset() {
+	*x = WebhookSubscription{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WebhookSubscription) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WebhookSubscription) ProtoMessage() {}
+
+func (x *WebhookSubscription) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WebhookSubscription.ProtoReflect.Descriptor instead.
+func (*WebhookSubscription) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *WebhookSubscription) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *WebhookSubscription) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetEvents() []string {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *WebhookSubscription) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *WebhookSubscription) GetCreated() string {
+	if x != nil {
+		return x.Created
+	}
+	return ""
+}
+
+// Request message for the webhook subscriptions of the caller
+type ListSubscriptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListSubscriptionsRequest) Reset() {
+	*x = ListSubscriptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscriptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsRequest) ProtoMessage() {}
+
+func (x *ListSubscriptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{57}
+}
+
+// Webhook subscriptions of the caller
+type ListSubscriptionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Subscriptions ordered by creation, without their secrets
+	Subscriptions []*WebhookSubscription `protobuf:"bytes,1,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+}
+
+func (x *ListSubscriptionsResponse) Reset() {
+	*x = ListSubscriptionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSubscriptionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSubscriptionsResponse) ProtoMessage() {}
+
+func (x *ListSubscriptionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSubscriptionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSubscriptionsResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *ListSubscriptionsResponse) GetSubscriptions() []*WebhookSubscription {
+	if x != nil {
+		return x.Subscriptions
+	}
+	return nil
+}
+
+// Request message to delete a webhook subscription
+type DeleteSubscriptionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the subscription
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *DeleteSubscriptionRequest) Reset() {
+	*x = DeleteSubscriptionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscriptionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionRequest) ProtoMessage() {}
+
+func (x *DeleteSubscriptionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *DeleteSubscriptionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+// Response message of a deleted webhook subscription
+type DeleteSubscriptionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteSubscriptionResponse) Reset() {
+	*x = DeleteSubscriptionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteSubscriptionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteSubscriptionResponse) ProtoMessage() {}
+
+func (x *DeleteSubscriptionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteSubscriptionResponse.ProtoReflect.Descriptor instead.
+func (*DeleteSubscriptionResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{60}
+}
+
+// Request message for the caches of the server
+type ListCachesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListCachesRequest) Reset() {
+	*x = ListCachesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCachesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCachesRequest) ProtoMessage() {}
+
+func (x *ListCachesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCachesRequest.ProtoReflect.Descriptor instead.
+func (*ListCachesRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{61}
+}
+
+// Response message listing the caches of the server
+type ListCachesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Statistics of each cache
+	Caches []*CacheStats `protobuf:"bytes,1,rep,name=caches,proto3" json:"caches,omitempty"`
+}
+
+func (x *ListCachesResponse) Reset() {
+	*x = ListCachesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCachesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCachesResponse) ProtoMessage() {}
+
+func (x *ListCachesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCachesResponse.ProtoReflect.Descriptor instead.
+func (*ListCachesResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *ListCachesResponse) GetCaches() []*CacheStats {
+	if x != nil {
+		return x.Caches
+	}
+	return nil
+}
+
+// Request message to empty caches
+type FlushCacheRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the cache, e.g. panchangam (empty for every cache)
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *FlushCacheRequest) Reset() {
+	*x = FlushCacheRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushCacheRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheRequest) ProtoMessage() {}
+
+func (x *FlushCacheRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheRequest.ProtoReflect.Descriptor instead.
+func (*FlushCacheRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FlushCacheRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+// Response message naming the emptied caches
+type FlushCacheResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Names of the caches emptied
+	Flushed []string `protobuf:"bytes,1,rep,name=flushed,proto3" json:"flushed,omitempty"`
+}
+
+func (x *FlushCacheResponse) Reset() {
+	*x = FlushCacheResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FlushCacheResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FlushCacheResponse) ProtoMessage() {}
+
+func (x *FlushCacheResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FlushCacheResponse.ProtoReflect.Descriptor instead.
+func (*FlushCacheResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *FlushCacheResponse) GetFlushed() []string {
+	if x != nil {
+		return x.Flushed
+	}
+	return nil
+}
+
+// Request message for the ephemeris providers
+type ListProvidersRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ListProvidersRequest) Reset() {
+	*x = ListProvidersRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersRequest) ProtoMessage() {}
+
+func (x *ListProvidersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersRequest.ProtoReflect.Descriptor instead.
+func (*ListProvidersRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{65}
+}
+
+// Response message listing the ephemeris providers
+type ListProvidersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Registered providers by name
+	Providers []*EphemerisProvider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+}
+
+func (x *ListProvidersResponse) Reset() {
+	*x = ListProvidersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListProvidersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProvidersResponse) ProtoMessage() {}
+
+func (x *ListProvidersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProvidersResponse.ProtoReflect.Descriptor instead.
+func (*ListProvidersResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *ListProvidersResponse) GetProviders() []*EphemerisProvider {
+	if x != nil {
+		return x.Providers
+	}
+	return nil
+}
+
+// An ephemeris provider registered with the server
+type EphemerisProvider struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the provider, e.g. builtin or horizons
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the provider is enabled; the builtin provider cannot be disabled
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// Whether the provider computes the positions of the moon and sun in
+	// the panchangams; the builtin provider stands in while it is disabled
+	Active bool `protobuf:"varint,3,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (x *EphemerisProvider) Reset() {
+	*x = EphemerisProvider{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EphemerisProvider) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EphemerisProvider) ProtoMessage() {}
+
+func (x *EphemerisProvider) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EphemerisProvider.ProtoReflect.Descriptor instead.
+func (*EphemerisProvider) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *EphemerisProvider) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EphemerisProvider) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+func (x *EphemerisProvider) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+// Request message to enable or disable an ephemeris provider
+type SetProviderEnabledRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the provider, e.g. horizons
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether to enable the provider
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty"`
+}
+
+func (x *SetProviderEnabledRequest) Reset() {
+	*x = SetProviderEnabledRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetProviderEnabledRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetProviderEnabledRequest) ProtoMessage() {}
+
+func (x *SetProviderEnabledRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetProviderEnabledRequest.ProtoReflect.Descriptor instead.
+func (*SetProviderEnabledRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *SetProviderEnabledRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetProviderEnabledRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+// Request message to change the log level
+type SetLogLevelRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Minimum level of the records logged: debug, info, warn or error
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+}
+
+func (x *SetLogLevelRequest) Reset() {
+	*x = SetLogLevelRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelRequest) ProtoMessage() {}
+
+func (x *SetLogLevelRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelRequest.ProtoReflect.Descriptor instead.
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *SetLogLevelRequest) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+// Response message with the log level
+type SetLogLevelResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Level now in effect, e.g. DEBUG
+	Level string `protobuf:"bytes,1,opt,name=level,proto3" json:"level,omitempty"`
+	// Level in effect before the change
+	PreviousLevel string `protobuf:"bytes,2,opt,name=previous_level,json=previousLevel,proto3" json:"previous_level,omitempty"`
+}
+
+func (x *SetLogLevelResponse) Reset() {
+	*x = SetLogLevelResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetLogLevelResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLogLevelResponse) ProtoMessage() {}
+
+func (x *SetLogLevelResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLogLevelResponse.ProtoReflect.Descriptor instead.
+func (*SetLogLevelResponse) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *SetLogLevelResponse) GetLevel() string {
+	if x != nil {
+		return x.Level
+	}
+	return ""
+}
+
+func (x *SetLogLevelResponse) GetPreviousLevel() string {
+	if x != nil {
+		return x.PreviousLevel
+	}
+	return ""
+}
+
+// Request message for the configuration of the server
+type GetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetConfigRequest) Reset() {
+	*x = GetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetConfigRequest) ProtoMessage() {}
+
+func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
+func (*GetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{71}
+}
+
+// Flags the server runs with
+type Config struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Flags ordered by name
+	Flags []*ConfigFlag `protobuf:"bytes,1,rep,name=flags,proto3" json:"flags,omitempty"`
+}
+
+func (x *Config) Reset() {
+	*x = Config{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Config) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Config) ProtoMessage() {}
+
+func (x *Config) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Config.ProtoReflect.Descriptor instead.
+func (*Config) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *Config) GetFlags() []*ConfigFlag {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+// A flag of the server
+type ConfigFlag struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the flag, e.g. http-addr
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Value in effect, from the command line, the configuration file or
+	// the environment; secrets such as passwords read REDACTED
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// Default value of the flag
+	DefaultValue string `protobuf:"bytes,3,opt,name=default_value,json=defaultValue,proto3" json:"default_value,omitempty"`
+	// Description of the flag
+	Usage string `protobuf:"bytes,4,opt,name=usage,proto3" json:"usage,omitempty"`
+}
+
+func (x *ConfigFlag) Reset() {
+	*x = ConfigFlag{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_panchangam_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigFlag) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigFlag) ProtoMessage() {}
+
+func (x *ConfigFlag) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_panchangam_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigFlag.ProtoReflect.Descriptor instead.
+func (*ConfigFlag) Descriptor() ([]byte, []int) {
+	return file_proto_panchangam_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ConfigFlag) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ConfigFlag) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *ConfigFlag) GetDefaultValue() string {
+	if x != nil {
+		return x.DefaultValue
+	}
+	return ""
+}
+
+func (x *ConfigFlag) GetUsage() string {
+	if x != nil {
+		return x.Usage
+	}
+	return ""
+}
+
+var File_proto_panchangam_proto protoreflect.FileDescriptor
+
+var file_proto_panchangam_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0a, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x61, 0x6d, 0x22, 0x80, 0x0f, 0x0a, 0x0e, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74,
+	0x69, 0x74, 0x68, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x68,
+	0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x12,
+	0x12, 0x0a, 0x04, 0x79, 0x6f, 0x67, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x79,
+	0x6f, 0x67, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x12, 0x21, 0x0a, 0x0c, 0x73,
+	0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12,
+	0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1b, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x68, 0x6f, 0x67, 0x68, 0x61, 0x64, 0x69,
+	0x79, 0x61, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x43, 0x68, 0x6f, 0x67, 0x68, 0x61, 0x64, 0x69, 0x79, 0x61,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0a, 0x63, 0x68, 0x6f, 0x67, 0x68, 0x61, 0x64, 0x69,
+	0x79, 0x61, 0x12, 0x44, 0x0a, 0x0f, 0x6e, 0x65, 0x61, 0x72, 0x5f, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61,
+	0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x52, 0x0e, 0x6e, 0x65, 0x61, 0x72, 0x42, 0x6f,
+	0x75, 0x6e, 0x64, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x61,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x61, 0x12, 0x27, 0x0a, 0x0f,
+	0x70, 0x75, 0x72, 0x6e, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x61, 0x5f, 0x6d, 0x61, 0x73, 0x61, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x75, 0x72, 0x6e, 0x69, 0x6d, 0x61, 0x6e, 0x74,
+	0x61, 0x4d, 0x61, 0x73, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x68, 0x69, 0x6b, 0x61, 0x5f,
+	0x6d, 0x61, 0x73, 0x61, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x64, 0x68, 0x69,
+	0x6b, 0x61, 0x4d, 0x61, 0x73, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x73, 0x68, 0x61, 0x79, 0x61,
+	0x5f, 0x6d, 0x61, 0x73, 0x61, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x73, 0x68,
+	0x61, 0x79, 0x61, 0x4d, 0x61, 0x73, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x6b, 0x73, 0x68,
+	0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x12,
+	0x32, 0x0a, 0x15, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5f, 0x64, 0x72, 0x69, 0x66, 0x74,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13,
+	0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x44, 0x72, 0x69, 0x66, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x5f, 0x64, 0x72,
+	0x69, 0x66, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x12, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x44, 0x72, 0x69, 0x66, 0x74, 0x53, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x64, 0x61, 0x79, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x05, 0x52, 0x16, 0x64, 0x61, 0x79, 0x4c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x28, 0x0a, 0x10, 0x64, 0x61, 0x79, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x74,
+	0x72, 0x65, 0x6e, 0x64, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x64, 0x61, 0x79, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x54, 0x72, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x61,
+	0x6d, 0x76, 0x61, 0x74, 0x73, 0x61, 0x72, 0x61, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x73, 0x61, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x69,
+	0x74, 0x75, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x69, 0x74, 0x75, 0x12, 0x14,
+	0x0a, 0x05, 0x61, 0x79, 0x61, 0x6e, 0x61, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61,
+	0x79, 0x61, 0x6e, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x72, 0x69,
+	0x74, 0x75, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x52,
+	0x69, 0x74, 0x75, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x65, 0x6d, 0x69, 0x73, 0x70, 0x68, 0x65, 0x72,
+	0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x65, 0x6d, 0x69, 0x73, 0x70, 0x68,
+	0x65, 0x72, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x6b, 0x72, 0x61, 0x6d, 0x5f, 0x73, 0x61,
+	0x6d, 0x76, 0x61, 0x74, 0x18, 0x19, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x76, 0x69, 0x6b, 0x72,
+	0x61, 0x6d, 0x53, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x61, 0x6b,
+	0x61, 0x5f, 0x73, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
+	0x73, 0x68, 0x61, 0x6b, 0x61, 0x53, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
+	0x6f, 0x6f, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x6f, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x6f, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x1c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6d, 0x6f, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x54,
+	0x69, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x6f, 0x6e, 0x5f, 0x70, 0x68, 0x61, 0x73,
+	0x65, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x6f, 0x6e, 0x50, 0x68, 0x61,
+	0x73, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x6f, 0x6e, 0x5f, 0x69, 0x6c, 0x6c, 0x75, 0x6d,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d,
+	0x6f, 0x6f, 0x6e, 0x49, 0x6c, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x3c, 0x0a, 0x0f, 0x61, 0x62, 0x68, 0x69, 0x6a, 0x69, 0x74, 0x5f, 0x6d, 0x75, 0x68, 0x75, 0x72,
+	0x74, 0x61, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x0e, 0x61,
+	0x62, 0x68, 0x69, 0x6a, 0x69, 0x74, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x12, 0x3a, 0x0a,
+	0x0e, 0x62, 0x72, 0x61, 0x68, 0x6d, 0x61, 0x5f, 0x6d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x18,
+	0x20, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x2e, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x0d, 0x62, 0x72, 0x61, 0x68,
+	0x6d, 0x61, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x6e,
+	0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x21, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x73, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x46, 0x0a, 0x14, 0x69, 0x6e, 0x61, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x18, 0x22, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13,
+	0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4d, 0x75, 0x68, 0x75,
+	0x72, 0x74, 0x61, 0x52, 0x13, 0x69, 0x6e, 0x61, 0x75, 0x73, 0x70, 0x69, 0x63, 0x69, 0x6f, 0x75,
+	0x73, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x73, 0x12, 0x42, 0x0a, 0x10, 0x67, 0x6f, 0x77, 0x72,
+	0x69, 0x5f, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x18, 0x23, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x54, 0x61, 0x6d, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0f, 0x67, 0x6f, 0x77,
+	0x72, 0x69, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x12, 0x38, 0x0a, 0x0b,
+	0x6e, 0x61, 0x6c, 0x6c, 0x61, 0x5f, 0x6e, 0x65, 0x72, 0x61, 0x6d, 0x18, 0x24, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x54,
+	0x61, 0x6d, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0a, 0x6e, 0x61, 0x6c, 0x6c,
+	0x61, 0x4e, 0x65, 0x72, 0x61, 0x6d, 0x12, 0x42, 0x0a, 0x0f, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61,
+	0x74, 0x72, 0x61, 0x5f, 0x70, 0x61, 0x64, 0x61, 0x73, 0x18, 0x25, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6b,
+	0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x50, 0x61, 0x64, 0x61, 0x52, 0x0e, 0x6e, 0x61, 0x6b, 0x73,
+	0x68, 0x61, 0x74, 0x72, 0x61, 0x50, 0x61, 0x64, 0x61, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f,
+	0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x26, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x23, 0x0a, 0x0d, 0x73, 0x75, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x27, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x37, 0x0a, 0x0b, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x73, 0x18, 0x28, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65,
+	0x73, 0x52, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x34, 0x0a,
+	0x0a, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x29, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x53,
+	0x6f, 0x6c, 0x61, 0x72, 0x44, 0x61, 0x74, 0x65, 0x52, 0x09, 0x73, 0x6f, 0x6c, 0x61, 0x72, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x0d, 0x62, 0x69, 0x6b, 0x72, 0x61, 0x6d, 0x5f, 0x73, 0x61,
+	0x6d, 0x62, 0x61, 0x74, 0x18, 0x2a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x53, 0x6f, 0x6c, 0x61, 0x72, 0x44, 0x61, 0x74,
+	0x65, 0x52, 0x0c, 0x62, 0x69, 0x6b, 0x72, 0x61, 0x6d, 0x53, 0x61, 0x6d, 0x62, 0x61, 0x74, 0x12,
+	0x34, 0x0a, 0x0a, 0x68, 0x69, 0x6a, 0x72, 0x69, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x2b, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d,
+	0x2e, 0x48, 0x69, 0x6a, 0x72, 0x69, 0x44, 0x61, 0x74, 0x65, 0x52, 0x09, 0x68, 0x69, 0x6a, 0x72,
+	0x69, 0x44, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0a, 0x6d, 0x6f, 0x6f, 0x6e, 0x5f, 0x72, 0x61,
+	0x73, 0x68, 0x69, 0x18, 0x2c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x52, 0x61, 0x73, 0x68, 0x69, 0x52, 0x09, 0x6d, 0x6f,
+	0x6f, 0x6e, 0x52, 0x61, 0x73, 0x68, 0x69, 0x12, 0x2e, 0x0a, 0x09, 0x73, 0x75, 0x6e, 0x5f, 0x72,
+	0x61, 0x73, 0x68, 0x69, 0x18, 0x2d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x61, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x52, 0x61, 0x73, 0x68, 0x69, 0x52, 0x08, 0x73,
+	0x75, 0x6e, 0x52, 0x61, 0x73, 0x68, 0x69, 0x22, 0x39, 0x0a, 0x0f, 0x50, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69,
+	0x6d, 0x65, 0x22, 0x6b, 0x0a, 0x07, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xa9, 0x01, 0x0a, 0x0b, 0x54, 0x61, 0x6d, 0x69, 0x6c, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x61, 0x6d, 0x69, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x6d, 0x69, 0x6c, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x44, 0x61, 0x79, 0x22, 0xde, 0x01, 0x0a, 0x09,
+	0x53, 0x6f, 0x6c, 0x61, 0x72, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x61, 0x6c,
+	0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x28, 0x0a, 0x10, 0x6c, 0x6f,
+	0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x6f, 0x6e, 0x74, 0x68,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x72, 0x61, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x65, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x73, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x73, 0x61, 0x72, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x73, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x73, 0x61, 0x72, 0x61, 0x22, 0xbb, 0x01, 0x0a,
+	0x09, 0x48, 0x69, 0x6a, 0x72, 0x69, 0x44, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x14,
+	0x0a, 0x05, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6d,
+	0x6f, 0x6e, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x6e, 0x74, 0x68, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x72, 0x61, 0x62, 0x69, 0x63, 0x5f, 0x6d, 0x6f,
+	0x6e, 0x74, 0x68, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x61, 0x72, 0x61, 0x62, 0x69, 0x63, 0x4d, 0x6f, 0x6e, 0x74, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61,
+	0x79, 0x12, 0x27, 0x0a, 0x0f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x64, 0x61, 0x79, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0e, 0x61, 0x64, 0x6a, 0x75,
+	0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x05, 0x52,
+	0x61, 0x73, 0x68, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
+	0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x73, 0x73, 0x54,
+	0x69, 0x6d, 0x65, 0x22, 0xb5, 0x01, 0x0a, 0x0d, 0x4e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72,
+	0x61, 0x50, 0x61, 0x64, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74,
+	0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61,
+	0x74, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x64, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x70, 0x61, 0x64, 0x61, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22, 0x8f, 0x01, 0x0a, 0x10,
+	0x43, 0x68, 0x6f, 0x67, 0x68, 0x61, 0x64, 0x69, 0x79, 0x61, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x79,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x44, 0x61, 0x79, 0x22, 0xc3, 0x01,
+	0x0a, 0x0f, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x42, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x65, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x63,
+	0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65,
+	0x12, 0x25, 0x0a, 0x0e, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65, 0x6e, 0x74, 0x5f, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x64, 0x6a, 0x61, 0x63, 0x65,
+	0x6e, 0x74, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x61, 0x72, 0x79, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0xe5, 0x03, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x36, 0x0a, 0x17, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61,
+	0x72, 0x79, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x15, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x61, 0x72,
+	0x79, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x6e, 0x5f, 0x63, 0x6f,
+	0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x73, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a,
+	0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01,
+	0x52, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
+	0x6f, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x6f, 0x6e, 0x50, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x70, 0x72, 0x65, 0x73, 0x73, 0x75, 0x72, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x0b, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x12, 0x1f,
+	0x0a, 0x0b, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x5f, 0x64, 0x69, 0x70, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0a, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x6f, 0x6e, 0x44, 0x69, 0x70, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x69, 0x6a, 0x72, 0x69,
+	0x5f, 0x61, 0x64, 0x6a, 0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x05, 0x52, 0x13, 0x68, 0x69, 0x6a, 0x72, 0x69, 0x41, 0x64, 0x6a,
+	0x75, 0x73, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x61, 0x79, 0x73, 0x22, 0x5c, 0x0a, 0x15, 0x47,
+	0x65, 0x74, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x22, 0x9c, 0x01, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x46, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x22, 0xc6, 0x01, 0x0a, 0x0e, 0x46, 0x65, 0x73,
+	0x74, 0x69, 0x76, 0x61, 0x6c, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79,
+	0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x32, 0x0a,
+	0x09, 0x66, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x46, 0x65,
+	0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x66, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c,
+	0x73, 0x22, 0xbb, 0x03, 0x0a, 0x08, 0x46, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65,
+	0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x68, 0x69,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x69, 0x74, 0x68, 0x69, 0x12, 0x1e, 0x0a,
+	0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a,
+	0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x3d, 0x0a, 0x1b, 0x63, 0x6f, 0x6e, 0x74, 0x69,
+	0x6e, 0x75, 0x65, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f,
+	0x75, 0x73, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x18, 0x63, 0x6f,
+	0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x6f, 0x75, 0x73, 0x44, 0x61, 0x79, 0x12, 0x31, 0x0a, 0x15, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e,
+	0x75, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x64, 0x61, 0x79, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x73,
+	0x54, 0x6f, 0x4e, 0x65, 0x78, 0x74, 0x44, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x6b, 0x61, 0x6c,
+	0x61, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6b, 0x61, 0x6c, 0x61, 0x12, 0x29, 0x0a,
+	0x10, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61,
+	0x6e, 0x63, 0x65, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6f, 0x62, 0x73, 0x65,
+	0x72, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x6e, 0x63, 0x65, 0x45, 0x6e, 0x64, 0x22,
+	0x82, 0x02, 0x0a, 0x0c, 0x46, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x6e,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x2f, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x44,
+	0x61, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x46,
+	0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x52, 0x04,
+	0x64, 0x61, 0x79, 0x73, 0x22, 0xc0, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61,
+	0x6c, 0x53, 0x70, 0x61, 0x6e, 0x44, 0x61, 0x79, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x61, 0x79, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x03, 0x64, 0x61, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x68, 0x69, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x68, 0x69, 0x12, 0x2f, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d,
+	0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05,
+	0x6e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x73, 0x68, 0x61, 0x79, 0x61, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6b, 0x73, 0x68, 0x61, 0x79, 0x61, 0x12, 0x18, 0x0a,
+	0x07, 0x76, 0x72, 0x69, 0x64, 0x64, 0x68, 0x69, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x76, 0x72, 0x69, 0x64, 0x64, 0x68, 0x69, 0x22, 0x84, 0x01, 0x0a, 0x0a, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x68, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x68, 0x69, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74,
+	0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x6f, 0x67, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x79, 0x6f, 0x67, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x6b, 0x61, 0x72, 0x61, 0x6e, 0x61,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6b, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x22, 0x3b,
+	0x0a, 0x0d, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xd7, 0x01, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e,
+	0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e,
+	0x64, 0x44, 0x61, 0x74, 0x65, 0x22, 0x77, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x32, 0x0a, 0x09, 0x66, 0x65,
+	0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x46, 0x65, 0x73, 0x74, 0x69,
+	0x76, 0x61, 0x6c, 0x52, 0x09, 0x66, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x12, 0x2e,
+	0x0a, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x46, 0x65, 0x73, 0x74, 0x69,
+	0x76, 0x61, 0x6c, 0x53, 0x70, 0x61, 0x6e, 0x52, 0x05, 0x73, 0x70, 0x61, 0x6e, 0x73, 0x22, 0x95,
+	0x02, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08,
+	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x53, 0x0a, 0x07, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c,
+	0x65, 0x12, 0x27, 0x0a, 0x0f, 0x6a, 0x61, 0x6e, 0x6d, 0x61, 0x5f, 0x6e, 0x61, 0x6b, 0x73, 0x68,
+	0x61, 0x74, 0x72, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x6a, 0x61, 0x6e, 0x6d,
+	0x61, 0x4e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6a, 0x61,
+	0x6e, 0x6d, 0x61, 0x5f, 0x72, 0x61, 0x73, 0x68, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6a, 0x61, 0x6e, 0x6d, 0x61, 0x52, 0x61, 0x73, 0x68, 0x69, 0x22, 0xbf, 0x01, 0x0a, 0x12,
+	0x47, 0x65, 0x74, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x33, 0x0a, 0x07,
+	0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4d, 0x75, 0x68, 0x75, 0x72,
+	0x74, 0x61, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x52, 0x07, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77,
+	0x73, 0x12, 0x3a, 0x0a, 0x0e, 0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6d, 0x75, 0x68, 0x75, 0x72,
+	0x74, 0x61, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x0d,
+	0x64, 0x61, 0x69, 0x6c, 0x79, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x73, 0x22, 0x9f, 0x02,
+	0x0a, 0x0d, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
+	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x63, 0x68, 0x6f, 0x67, 0x68, 0x61, 0x64, 0x69, 0x79, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x63, 0x68, 0x6f, 0x67, 0x68, 0x61, 0x64, 0x69, 0x79, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x3d, 0x0a,
+	0x1b, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f,
+	0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x18, 0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x73, 0x46, 0x72, 0x6f,
+	0x6d, 0x50, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x44, 0x61, 0x79, 0x12, 0x31, 0x0a, 0x15,
+	0x63, 0x6f, 0x6e, 0x74, 0x69, 0x6e, 0x75, 0x65, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6e, 0x65, 0x78,
+	0x74, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x12, 0x63, 0x6f, 0x6e,
+	0x74, 0x69, 0x6e, 0x75, 0x65, 0x73, 0x54, 0x6f, 0x4e, 0x65, 0x78, 0x74, 0x44, 0x61, 0x79, 0x22,
+	0xf4, 0x02, 0x0a, 0x12, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61,
+	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
+	0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1c, 0x0a, 0x09,
+	0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x73, 0x18, 0x08,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x18, 0x09, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x08, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x73, 0x12, 0x25, 0x0a, 0x0e,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x62, 0x68, 0x61, 0x64, 0x72, 0x61, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x42, 0x68, 0x61,
+	0x64, 0x72, 0x61, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x2d, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0xd0, 0x01, 0x0a, 0x13, 0x46, 0x69, 0x6e, 0x64, 0x4d,
+	0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65,
+	0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74,
+	0x65, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x65, 0x61, 0x72, 0x63, 0x68, 0x65, 0x64, 0x5f, 0x75,
+	0x6e, 0x74, 0x69, 0x6c, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x65, 0x64, 0x55, 0x6e, 0x74, 0x69, 0x6c, 0x22, 0x9e, 0x01, 0x0a, 0x10, 0x4d, 0x75,
+	0x68, 0x75, 0x72, 0x74, 0x61, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x6f, 0x67, 0x68, 0x61,
+	0x64, 0x69, 0x79, 0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x6f, 0x67,
+	0x68, 0x61, 0x64, 0x69, 0x79, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x22, 0xb4, 0x01, 0x0a, 0x12, 0x47,
+	0x65, 0x74, 0x56, 0x72, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72,
+	0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xbe, 0x01, 0x0a, 0x08, 0x56, 0x72, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x12,
+	0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x79, 0x65,
+	0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x2a, 0x0a, 0x05, 0x64, 0x61, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x56, 0x72, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x52, 0x05, 0x64,
+	0x61, 0x74, 0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x72, 0x61, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x22, 0xc0, 0x02, 0x0a, 0x08, 0x56, 0x72, 0x61, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x64, 0x61, 0x74, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x65, 0x65, 0x6b, 0x64, 0x61, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x68, 0x69, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x69, 0x74, 0x68, 0x69, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x61, 0x72, 0x61,
+	0x6e, 0x61, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x61, 0x72, 0x61, 0x6e, 0x61, 0x44, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x70, 0x61, 0x72,
+	0x61, 0x6e, 0x61, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x5f,
+	0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x70, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x76, 0x69, 0x64, 0x64, 0x68, 0x61, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x76,
+	0x69, 0x64, 0x64, 0x68, 0x61, 0x22, 0x7c, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x67, 0x6e,
+	0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x22, 0x6e, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x67, 0x6e, 0x61, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x29, 0x0a, 0x06, 0x6c, 0x61, 0x67, 0x6e,
+	0x61, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x61, 0x67, 0x6e, 0x61, 0x52, 0x06, 0x6c, 0x61, 0x67,
+	0x6e, 0x61, 0x73, 0x22, 0xa9, 0x01, 0x0a, 0x05, 0x4c, 0x61, 0x67, 0x6e, 0x61, 0x12, 0x14, 0x0a,
+	0x05, 0x72, 0x61, 0x73, 0x68, 0x69, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x72, 0x61,
+	0x73, 0x68, 0x69, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64,
+	0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64,
+	0x44, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x22,
+	0xbc, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74,
+	0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74,
+	0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x6e, 0x5f, 0x63,
+	0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x73, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x78,
+	0x0a, 0x07, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x65, 0x78, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x65, 0x78, 0x74, 0x12, 0x2d, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x6e,
+	0x65, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x22, 0x39, 0x0a, 0x0b, 0x53, 0x75, 0x6d, 0x6d,
+	0x61, 0x72, 0x79, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x22, 0xcb, 0x01, 0x0a, 0x17, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d,
+	0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x25, 0x0a,
+	0x0e, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6e, 0x6f, 0x74, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x6e,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x6f, 0x5f, 0x6e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6e, 0x6f, 0x4e, 0x6f, 0x74, 0x69, 0x63,
+	0x65, 0x22, 0x97, 0x01, 0x0a, 0x0a, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x12, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc9, 0x01, 0x0a, 0x19,
+	0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x69, 0x6d,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e,
+	0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f,
+	0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a,
+	0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x6b, 0x69, 0x6e, 0x64, 0x73, 0x22, 0x72, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x78, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e,
+	0x65, 0x12, 0x38, 0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x12, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x5e, 0x0a, 0x06, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x2e, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x52, 0x0a, 0x70, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x22,
+	0x80, 0x02, 0x0a, 0x10, 0x50, 0x72, 0x65, 0x63, 0x6f, 0x6d, 0x70, 0x75, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x79, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05,
+	0x52, 0x04, 0x64, 0x61, 0x79, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x77, 0x61, 0x72, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x77, 0x61, 0x72, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x61,
+	0x69, 0x6c, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x66, 0x61, 0x69, 0x6c,
+	0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x54, 0x69, 0x6d, 0x65,
+	0x12, 0x31, 0x0a, 0x15, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x12, 0x6c, 0x61, 0x73, 0x74, 0x50, 0x61, 0x73, 0x73, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x45, 0x72, 0x72,
+	0x6f, 0x72, 0x22, 0x16, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa9, 0x02, 0x0a, 0x0a, 0x53,
+	0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x67,
+	0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x74,
+	0x69, 0x6d, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0d, 0x75, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x54, 0x0a, 0x13, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x69, 0x73, 0x5f, 0x70, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x23, 0x2e,
+	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x52, 0x12, 0x65, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x69, 0x73, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2e, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x2e, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06,
+	0x63, 0x61, 0x63, 0x68, 0x65, 0x73, 0x22, 0xbb, 0x01, 0x0a, 0x17, 0x45, 0x70, 0x68, 0x65, 0x6d,
+	0x65, 0x72, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x48, 0x65, 0x61, 0x6c,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63,
+	0x79, 0x5f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x6c, 0x61, 0x74, 0x65,
+	0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0xbb, 0x01, 0x0a, 0x0a, 0x43, 0x61, 0x63, 0x68, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x69, 0x74, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x04, 0x68, 0x69, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x69, 0x73, 0x73, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x6c, 0x6f, 0x61, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x6c, 0x6f,
+	0x61, 0x64, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65, 0x73,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x72, 0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x65,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x68, 0x69, 0x74, 0x73,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x48, 0x69,
+	0x74, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x99, 0x01, 0x0a, 0x0b, 0x56, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x75, 0x69,
+	0x6c, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62,
+	0x75, 0x69, 0x6c, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x67, 0x6f, 0x5f, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x67, 0x6f,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x73, 0x22, 0xcb, 0x01, 0x0a, 0x18, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x65, 0x73, 0x74,
+	0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x79, 0x65, 0x61, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x79, 0x65, 0x61, 0x72, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x5f,
+	0x79, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x05, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x59,
+	0x65, 0x61, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f,
+	0x6e, 0x22, 0xbc, 0x01, 0x0a, 0x19, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x65, 0x73, 0x74, 0x69, 0x76,
+	0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2f, 0x0a, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x6f, 0x63, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x64, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x05, 0x6e, 0x61, 0x6d, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x32, 0x0a, 0x09,
+	0x66, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x14, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x46, 0x65, 0x73,
+	0x74, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x09, 0x66, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x73,
+	0x22, 0x75, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a,
+	0x08, 0x65, 0x6e, 0x64, 0x5f, 0x64, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x65, 0x6e, 0x64, 0x44, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12,
+	0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x22, 0x7b, 0x0a, 0x0b, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x28, 0x0a, 0x04, 0x72, 0x6f, 0x77, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61,
+	0x6d, 0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x6f, 0x77, 0x52, 0x04, 0x72, 0x6f, 0x77, 0x73,
+	0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c,
+	0x73, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x22, 0xc9, 0x01, 0x0a, 0x08, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x6f,
+	0x77, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x6b, 0x65, 0x79, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6b, 0x65, 0x79, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x05, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x65, 0x61, 0x6e, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e,
+	0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x6d, 0x65, 0x61,
+	0x6e, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61,
+	0x78, 0x5f, 0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73,
+	0x22, 0xb3, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xef, 0x01, 0x0a, 0x13, 0x57, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x10,
+	0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09,
+	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69,
+	0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x4c, 0x69, 0x73, 0x74,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x62, 0x0a, 0x19, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x45, 0x0a, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x73, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x2b, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x13, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x44, 0x0a, 0x12, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e,
+	0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x43, 0x61, 0x63, 0x68,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x73, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x73, 0x22, 0x27,
+	0x0a, 0x11, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x2e, 0x0a, 0x12, 0x46, 0x6c, 0x75, 0x73, 0x68,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x66, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x64, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x66, 0x6c, 0x75, 0x73, 0x68, 0x65, 0x64, 0x22, 0x16, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x54, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3b, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61,
+	0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x73, 0x22, 0x59, 0x0a, 0x11, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72,
+	0x69, 0x73, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65,
+	0x22, 0x49, 0x0a, 0x19, 0x53, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0x2a, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x52, 0x0a, 0x13, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c,
+	0x65, 0x76, 0x65, 0x6c, 0x12, 0x25, 0x0a, 0x0e, 0x70, 0x72, 0x65, 0x76, 0x69, 0x6f, 0x75, 0x73,
+	0x5f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72,
+	0x65, 0x76, 0x69, 0x6f, 0x75, 0x73, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x12, 0x0a, 0x10, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
+	0x36, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2c, 0x0a, 0x05, 0x66, 0x6c, 0x61,
+	0x67, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x46, 0x6c, 0x61, 0x67,
+	0x52, 0x05, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x22, 0x71, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x46, 0x6c, 0x61, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
+	0x23, 0x0a, 0x0d, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x56,
+	0x61, 0x6c, 0x75, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x75, 0x73, 0x61, 0x67, 0x65, 0x32, 0xc4, 0x0b, 0x0a, 0x0a, 0x50,
+	0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x12, 0x4a, 0x0a, 0x03, 0x47, 0x65, 0x74,
+	0x12, 0x20, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x46, 0x65, 0x73, 0x74,
+	0x69, 0x76, 0x61, 0x6c, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x46, 0x65, 0x73, 0x74, 0x69,
+	0x76, 0x61, 0x6c, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x46, 0x65,
+	0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x48, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x68,
+	0x75, 0x72, 0x74, 0x61, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61,
+	0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0b, 0x46, 0x69, 0x6e, 0x64, 0x4d, 0x75, 0x68, 0x75, 0x72,
+	0x74, 0x61, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x4d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x56, 0x72, 0x61, 0x74, 0x4c, 0x69,
+	0x73, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x47, 0x65, 0x74, 0x56, 0x72, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x14, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x56, 0x72, 0x61, 0x74, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x4c,
+	0x61, 0x67, 0x6e, 0x61, 0x73, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x67, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d,
+	0x2e, 0x47, 0x65, 0x74, 0x4c, 0x61, 0x67, 0x6e, 0x61, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
+	0x12, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65,
+	0x74, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x12, 0x51, 0x0a, 0x10, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61,
+	0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e,
+	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x30, 0x01, 0x12, 0x63, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x78, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x2e,
+	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65,
+	0x78, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61,
+	0x6d, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x65, 0x78, 0x74, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x49, 0x0a, 0x0d, 0x47,
+	0x65, 0x74, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x2e, 0x70,
+	0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x79, 0x73,
+	0x74, 0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x53, 0x79, 0x73, 0x74,
+	0x65, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x44, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61,
+	0x6d, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d,
+	0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x60, 0x0a, 0x11,
+	0x46, 0x69, 0x6e, 0x64, 0x46, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65,
+	0x73, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x46, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61, 0x6c, 0x44, 0x61, 0x74, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x46, 0x65, 0x73, 0x74, 0x69, 0x76, 0x61,
+	0x6c, 0x44, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c,
+	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x21, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d,
+	0x2e, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x5c, 0x0a, 0x12,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x60, 0x0a, 0x11, 0x4c, 0x69,
+	0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
+	0x24, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x63, 0x0a, 0x12,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x25, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x32, 0xe2, 0x03, 0x0a, 0x05, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x4b, 0x0a, 0x0a, 0x4c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x63, 0x68, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4b, 0x0a, 0x0a, 0x46, 0x6c, 0x75, 0x73,
+	0x68, 0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x2e, 0x46, 0x6c, 0x75, 0x73, 0x68, 0x43, 0x61, 0x63, 0x68, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x54, 0x0a, 0x0d, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f,
+	0x76, 0x69, 0x64, 0x65, 0x72, 0x73, 0x12, 0x20, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64,
+	0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x12, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x12, 0x25, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x53,
+	0x65, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x45, 0x70, 0x68, 0x65, 0x6d, 0x65, 0x72, 0x69, 0x73, 0x50,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x4e, 0x0a, 0x0b, 0x53, 0x65, 0x74, 0x4c, 0x6f,
+	0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x2e, 0x53, 0x65, 0x74, 0x4c, 0x6f, 0x67, 0x4c, 0x65, 0x76, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1c, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61,
+	0x6d, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x12, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70, 0x61, 0x6e, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_panchangam_proto_rawDescOnce sync.Once
+	file_proto_panchangam_proto_rawDescData = file_proto_panchangam_proto_rawDesc
+)
+
+func file_proto_panchangam_proto_rawDescGZIP() []byte {
+	file_proto_panchangam_proto_rawDescOnce.Do(func() {
+		file_proto_panchangam_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_panchangam_proto_rawDescData)
+	})
+	return file_proto_panchangam_proto_rawDescData
+}
+
+var file_proto_panchangam_proto_msgTypes = make([]protoimpl.MessageInfo, 74)
+var file_proto_panchangam_proto_goTypes = []interface{}{
+	(*PanchangamData)(nil),             // 0: panchangam.PanchangamData
+	(*PanchangamEvent)(nil),            // 1: panchangam.PanchangamEvent
+	(*Muhurta)(nil),                    // 2: panchangam.Muhurta
+	(*TamilPeriod)(nil),                // 3: panchangam.TamilPeriod
+	(*SolarDate)(nil),                  // 4: panchangam.SolarDate
+	(*HijriDate)(nil),                  // 5: panchangam.HijriDate
+	(*Rashi)(nil),                      // 6: panchangam.Rashi
+	(*NakshatraPada)(nil),              // 7: panchangam.NakshatraPada
+	(*ChoghadiyaPeriod)(nil),           // 8: panchangam.ChoghadiyaPeriod
+	(*ElementBoundary)(nil),            // 9: panchangam.ElementBoundary
+	(*GetPanchangamRequest)(nil),       // 10: panchangam.GetPanchangamRequest
+	(*GetPanchangamResponse)(nil),      // 11: panchangam.GetPanchangamResponse
+	(*GetFestivalBundleRequest)(nil),   // 12: panchangam.GetFestivalBundleRequest
+	(*FestivalBundle)(nil),             // 13: panchangam.FestivalBundle
+	(*Festival)(nil),                   // 14: panchangam.Festival
+	(*FestivalSpan)(nil),               // 15: panchangam.FestivalSpan
+	(*FestivalSpanDay)(nil),            // 16: panchangam.FestivalSpanDay
+	(*LocalNames)(nil),                 // 17: panchangam.LocalNames
+	(*LocalizedName)(nil),              // 18: panchangam.LocalizedName
+	(*GetEventsRequest)(nil),           // 19: panchangam.GetEventsRequest
+	(*GetEventsResponse)(nil),          // 20: panchangam.GetEventsResponse
+	(*GetMuhurtaRequest)(nil),          // 21: panchangam.GetMuhurtaRequest
+	(*Profile)(nil),                    // 22: panchangam.Profile
+	(*GetMuhurtaResponse)(nil),         // 23: panchangam.GetMuhurtaResponse
+	(*MuhurtaWindow)(nil),              // 24: panchangam.MuhurtaWindow
+	(*FindMuhurtaRequest)(nil),         // 25: panchangam.FindMuhurtaRequest
+	(*FindMuhurtaResponse)(nil),        // 26: panchangam.FindMuhurtaResponse
+	(*MuhurtaCandidate)(nil),           // 27: panchangam.MuhurtaCandidate
+	(*GetVratListRequest)(nil),         // 28: panchangam.GetVratListRequest
+	(*VratList)(nil),                   // 29: panchangam.VratList
+	(*VratDate)(nil),                   // 30: panchangam.VratDate
+	(*GetLagnasRequest)(nil),           // 31: panchangam.GetLagnasRequest
+	(*GetLagnasResponse)(nil),          // 32: panchangam.GetLagnasResponse
+	(*Lagna)(nil),                      // 33: panchangam.Lagna
+	(*GetSummaryRequest)(nil),          // 34: panchangam.GetSummaryRequest
+	(*Summary)(nil),                    // 35: panchangam.Summary
+	(*SummaryLine)(nil),                // 36: panchangam.SummaryLine
+	(*WatchTransitionsRequest)(nil),    // 37: panchangam.WatchTransitionsRequest
+	(*Transition)(nil),                 // 38: panchangam.Transition
+	(*GetNextTransitionsRequest)(nil),  // 39: panchangam.GetNextTransitionsRequest
+	(*GetNextTransitionsResponse)(nil), // 40: panchangam.GetNextTransitionsResponse
+	(*GetHealthRequest)(nil),           // 41: panchangam.GetHealthRequest
+	(*Health)(nil),                     // 42: panchangam.Health
+	(*PrecomputeStatus)(nil),           // 43: panchangam.PrecomputeStatus
+	(*GetSystemInfoRequest)(nil),       // 44: panchangam.GetSystemInfoRequest
+	(*SystemInfo)(nil),                 // 45: panchangam.SystemInfo
+	(*EphemerisProviderHealth)(nil),    // 46: panchangam.EphemerisProviderHealth
+	(*CacheStats)(nil),                 // 47: panchangam.CacheStats
+	(*GetVersionRequest)(nil),          // 48: panchangam.GetVersionRequest
+	(*VersionInfo)(nil),                // 49: panchangam.VersionInfo
+	(*FindFestivalDatesRequest)(nil),   // 50: panchangam.FindFestivalDatesRequest
+	(*FindFestivalDatesResponse)(nil),  // 51: panchangam.FindFestivalDatesResponse
+	(*GetUsageReportRequest)(nil),      // 52: panchangam.GetUsageReportRequest
+	(*UsageReport)(nil),                // 53: panchangam.UsageReport
+	(*UsageRow)(nil),                   // 54: panchangam.UsageRow
+	(*CreateSubscriptionRequest)(nil),  // 55: panchangam.CreateSubscriptionRequest
+	(*WebhookSubscription)(nil),        // 56: panchangam.WebhookSubscription
+	(*ListSubscriptionsRequest)(nil),   // 57: panchangam.ListSubscriptionsRequest
+	(*ListSubscriptionsResponse)(nil),  // 58: panchangam.ListSubscriptionsResponse
+	(*DeleteSubscriptionRequest)(nil),  // 59: panchangam.DeleteSubscriptionRequest
+	(*DeleteSubscriptionResponse)(nil), // 60: panchangam.DeleteSubscriptionResponse
+	(*ListCachesRequest)(nil),          // 61: panchangam.ListCachesRequest
+	(*ListCachesResponse)(nil),         // 62: panchangam.ListCachesResponse
+	(*FlushCacheRequest)(nil),          // 63: panchangam.FlushCacheRequest
+	(*FlushCacheResponse)(nil),         // 64: panchangam.FlushCacheResponse
+	(*ListProvidersRequest)(nil),       // 65: panchangam.ListProvidersRequest
+	(*ListProvidersResponse)(nil),      // 66: panchangam.ListProvidersResponse
+	(*EphemerisProvider)(nil),          // 67: panchangam.EphemerisProvider
+	(*SetProviderEnabledRequest)(nil),  // 68: panchangam.SetProviderEnabledRequest
+	(*SetLogLevelRequest)(nil),         // 69: panchangam.SetLogLevelRequest
+	(*SetLogLevelResponse)(nil),        // 70: panchangam.SetLogLevelResponse
+	(*GetConfigRequest)(nil),           // 71: panchangam.GetConfigRequest
+	(*Config)(nil),                     // 72: panchangam.Config
+	(*ConfigFlag)(nil),                 // 73: panchangam.ConfigFlag
+}
+var file_proto_panchangam_proto_depIdxs = []int32{
+	1,  // 0: panchangam.PanchangamData.events:type_name -> panchangam.PanchangamEvent
+	8,  // 1: panchangam.PanchangamData.choghadiya:type_name -> panchangam.ChoghadiyaPeriod
+	9,  // 2: panchangam.PanchangamData.near_boundaries:type_name -> panchangam.ElementBoundary
+	2,  // 3: panchangam.PanchangamData.abhijit_muhurta:type_name -> panchangam.Muhurta
+	2,  // 4: panchangam.PanchangamData.brahma_muhurta:type_name -> panchangam.Muhurta
+	2,  // 5: panchangam.PanchangamData.inauspicious_periods:type_name -> panchangam.Muhurta
+	3,  // 6: panchangam.PanchangamData.gowri_panchangam:type_name -> panchangam.TamilPeriod
+	3,  // 7: panchangam.PanchangamData.nalla_neram:type_name -> panchangam.TamilPeriod
+	7,  // 8: panchangam.PanchangamData.nakshatra_padas:type_name -> panchangam.NakshatraPada
+	17, // 9: panchangam.PanchangamData.local_names:type_name -> panchangam.LocalNames
+	4,  // 10: panchangam.PanchangamData.solar_date:type_name -> panchangam.SolarDate
+	4,  // 11: panchangam.PanchangamData.bikram_sambat:type_name -> panchangam.SolarDate
+	5,  // 12: panchangam.PanchangamData.hijri_date:type_name -> panchangam.HijriDate
+	6,  // 13: panchangam.PanchangamData.moon_rashi:type_name -> panchangam.Rashi
+	6,  // 14: panchangam.PanchangamData.sun_rashi:type_name -> panchangam.Rashi
+	0,  // 15: panchangam.GetPanchangamResponse.panchangam_data:type_name -> panchangam.PanchangamData
+	14, // 16: panchangam.FestivalBundle.festivals:type_name -> panchangam.Festival
+	18, // 17: panchangam.Festival.names:type_name -> panchangam.LocalizedName
+	18, // 18: panchangam.FestivalSpan.names:type_name -> panchangam.LocalizedName
+	16, // 19: panchangam.FestivalSpan.days:type_name -> panchangam.FestivalSpanDay
+	18, // 20: panchangam.FestivalSpanDay.names:type_name -> panchangam.LocalizedName
+	14, // 21: panchangam.GetEventsResponse.festivals:type_name -> panchangam.Festival
+	15, // 22: panchangam.GetEventsResponse.spans:type_name -> panchangam.FestivalSpan
+	22, // 23: panchangam.GetMuhurtaRequest.profile:type_name -> panchangam.Profile
+	24, // 24: panchangam.GetMuhurtaResponse.windows:type_name -> panchangam.MuhurtaWindow
+	2,  // 25: panchangam.GetMuhurtaResponse.daily_muhurtas:type_name -> panchangam.Muhurta
+	22, // 26: panchangam.FindMuhurtaRequest.profile:type_name -> panchangam.Profile
+	27, // 27: panchangam.FindMuhurtaResponse.candidates:type_name -> panchangam.MuhurtaCandidate
+	30, // 28: panchangam.VratList.dates:type_name -> panchangam.VratDate
+	18, // 29: panchangam.VratDate.names:type_name -> panchangam.LocalizedName
+	33, // 30: panchangam.GetLagnasResponse.lagnas:type_name -> panchangam.Lagna
+	36, // 31: panchangam.Summary.lines:type_name -> panchangam.SummaryLine
+	38, // 32: panchangam.GetNextTransitionsResponse.transitions:type_name -> panchangam.Transition
+	43, // 33: panchangam.Health.precompute:type_name -> panchangam.PrecomputeStatus
+	46, // 34: panchangam.SystemInfo.ephemeris_providers:type_name -> panchangam.EphemerisProviderHealth
+	47, // 35: panchangam.SystemInfo.caches:type_name -> panchangam.CacheStats
+	18, // 36: panchangam.FindFestivalDatesResponse.names:type_name -> panchangam.LocalizedName
+	14, // 37: panchangam.FindFestivalDatesResponse.festivals:type_name -> panchangam.Festival
+	54, // 38: panchangam.UsageReport.rows:type_name -> panchangam.UsageRow
+	56, // 39: panchangam.ListSubscriptionsResponse.subscriptions:type_name -> panchangam.WebhookSubscription
+	47, // 40: panchangam.ListCachesResponse.caches:type_name -> panchangam.CacheStats
+	67, // 41: panchangam.ListProvidersResponse.providers:type_name -> panchangam.EphemerisProvider
+	73, // 42: panchangam.Config.flags:type_name -> panchangam.ConfigFlag
+	10, // 43: panchangam.Panchangam.Get:input_type -> panchangam.GetPanchangamRequest
+	12, // 44: panchangam.Panchangam.GetFestivalBundle:input_type -> panchangam.GetFestivalBundleRequest
+	19, // 45: panchangam.Panchangam.GetEvents:input_type -> panchangam.GetEventsRequest
+	21, // 46: panchangam.Panchangam.GetMuhurta:input_type -> panchangam.GetMuhurtaRequest
+	25, // 47: panchangam.Panchangam.FindMuhurta:input_type -> panchangam.FindMuhurtaRequest
+	28, // 48: panchangam.Panchangam.GetVratList:input_type -> panchangam.GetVratListRequest
+	31, // 49: panchangam.Panchangam.GetLagnas:input_type -> panchangam.GetLagnasRequest
+	34, // 50: panchangam.Panchangam.GetSummary:input_type -> panchangam.GetSummaryRequest
+	37, // 51: panchangam.Panchangam.WatchTransitions:input_type -> panchangam.WatchTransitionsRequest
+	39, // 52: panchangam.Panchangam.GetNextTransitions:input_type -> panchangam.GetNextTransitionsRequest
+	41, // 53: panchangam.Panchangam.GetHealth:input_type -> panchangam.GetHealthRequest
+	44, // 54: panchangam.Panchangam.GetSystemInfo:input_type -> panchangam.GetSystemInfoRequest
+	48, // 55: panchangam.Panchangam.GetVersion:input_type -> panchangam.GetVersionRequest
+	50, // 56: panchangam.Panchangam.FindFestivalDates:input_type -> panchangam.FindFestivalDatesRequest
+	52, // 57: panchangam.Panchangam.GetUsageReport:input_type -> panchangam.GetUsageReportRequest
+	55, // 58: panchangam.Panchangam.CreateSubscription:input_type -> panchangam.CreateSubscriptionRequest
+	57, // 59: panchangam.Panchangam.ListSubscriptions:input_type -> panchangam.ListSubscriptionsRequest
+	59, // 60: panchangam.Panchangam.DeleteSubscription:input_type -> panchangam.DeleteSubscriptionRequest
+	61, // 61: panchangam.Admin.ListCaches:input_type -> panchangam.ListCachesRequest
+	63, // 62: panchangam.Admin.FlushCache:input_type -> panchangam.FlushCacheRequest
+	65, // 63: panchangam.Admin.ListProviders:input_type -> panchangam.ListProvidersRequest
+	68, // 64: panchangam.Admin.SetProviderEnabled:input_type -> panchangam.SetProviderEnabledRequest
+	69, // 65: panchangam.Admin.SetLogLevel:input_type -> panchangam.SetLogLevelRequest
+	71, // 66: panchangam.Admin.GetConfig:input_type -> panchangam.GetConfigRequest
+	11, // 67: panchangam.Panchangam.Get:output_type -> panchangam.GetPanchangamResponse
+	13, // 68: panchangam.Panchangam.GetFestivalBundle:output_type -> panchangam.FestivalBundle
+	20, // 69: panchangam.Panchangam.GetEvents:output_type -> panchangam.GetEventsResponse
+	23, // 70: panchangam.Panchangam.GetMuhurta:output_type -> panchangam.GetMuhurtaResponse
+	26, // 71: panchangam.Panchangam.FindMuhurta:output_type -> panchangam.FindMuhurtaResponse
+	29, // 72: panchangam.Panchangam.GetVratList:output_type -> panchangam.VratList
+	32, // 73: panchangam.Panchangam.GetLagnas:output_type -> panchangam.GetLagnasResponse
+	35, // 74: panchangam.Panchangam.GetSummary:output_type -> panchangam.Summary
+	38, // 75: panchangam.Panchangam.WatchTransitions:output_type -> panchangam.Transition
+	40, // 76: panchangam.Panchangam.GetNextTransitions:output_type -> panchangam.GetNextTransitionsResponse
+	42, // 77: panchangam.Panchangam.GetHealth:output_type -> panchangam.Health
+	45, // 78: panchangam.Panchangam.GetSystemInfo:output_type -> panchangam.SystemInfo
+	49, // 79: panchangam.Panchangam.GetVersion:output_type -> panchangam.VersionInfo
+	51, // 80: panchangam.Panchangam.FindFestivalDates:output_type -> panchangam.FindFestivalDatesResponse
+	53, // 81: panchangam.Panchangam.GetUsageReport:output_type -> panchangam.UsageReport
+	56, // 82: panchangam.Panchangam.CreateSubscription:output_type -> panchangam.WebhookSubscription
+	58, // 83: panchangam.Panchangam.ListSubscriptions:output_type -> panchangam.ListSubscriptionsResponse
+	60, // 84: panchangam.Panchangam.DeleteSubscription:output_type -> panchangam.DeleteSubscriptionResponse
+	62, // 85: panchangam.Admin.ListCaches:output_type -> panchangam.ListCachesResponse
+	64, // 86: panchangam.Admin.FlushCache:output_type -> panchangam.FlushCacheResponse
+	66, // 87: panchangam.Admin.ListProviders:output_type -> panchangam.ListProvidersResponse
+	67, // 88: panchangam.Admin.SetProviderEnabled:output_type -> panchangam.EphemerisProvider
+	70, // 89: panchangam.Admin.SetLogLevel:output_type -> panchangam.SetLogLevelResponse
+	72, // 90: panchangam.Admin.GetConfig:output_type -> panchangam.Config
+	67, // [67:91] is the sub-list for method output_type
+	43, // [43:67] is the sub-list for method input_type
+	43, // [43:43] is the sub-list for extension type_name
+	43, // [43:43] is the sub-list for extension extendee
+	0,  // [0:43] is the sub-list for field type_name
+}
+
+func init() { file_proto_panchangam_proto_init() }
+func file_proto_panchangam_proto_init() {
+	if File_proto_panchangam_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_panchangam_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanchangamData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanchangamEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Muhurta); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TamilPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SolarDate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HijriDate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Rashi); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NakshatraPada); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ChoghadiyaPeriod); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ElementBoundary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPanchangamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPanchangamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetFestivalBundleRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FestivalBundle); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Festival); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FestivalSpan); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FestivalSpanDay); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalNames); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LocalizedName); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMuhurtaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Profile); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetMuhurtaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuhurtaWindow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMuhurtaRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindMuhurtaResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MuhurtaCandidate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVratListRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VratList); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VratDate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLagnasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetLagnasResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Lagna); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSummaryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Summary); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SummaryLine); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WatchTransitionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Transition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNextTransitionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNextTransitionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHealthRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Health); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PrecomputeStatus); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetSystemInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SystemInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -385,8 +8115,8 @@ func file_proto_panchangam_proto_init() {
 				return nil
 			}
 		}
-		file_proto_panchangam_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PanchangamEvent); i {
+		file_proto_panchangam_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EphemerisProviderHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -397,8 +8127,8 @@ func file_proto_panchangam_proto_init() {
 				return nil
 			}
 		}
-		file_proto_panchangam_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPanchangamRequest); i {
+		file_proto_panchangam_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CacheStats); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -409,8 +8139,308 @@ func file_proto_panchangam_proto_init() {
 				return nil
 			}
 		}
-		file_proto_panchangam_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetPanchangamResponse); i {
+		file_proto_panchangam_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetVersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindFestivalDatesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindFestivalDatesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUsageReportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UsageRow); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*WebhookSubscription); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscriptionsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSubscriptionsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscriptionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteSubscriptionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCachesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCachesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushCacheRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FlushCacheResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListProvidersResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EphemerisProvider); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetProviderEnabledRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetLogLevelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Config); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_panchangam_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigFlag); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -428,9 +8458,9 @@ func file_proto_panchangam_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_proto_panchangam_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   74,
 			NumExtensions: 0,
-			NumServices:   1,
+			NumServices:   2,
 		},
 		GoTypes:           file_proto_panchangam_proto_goTypes,
 		DependencyIndexes: file_proto_panchangam_proto_depIdxs,
@@ -1,7 +1,20 @@
 // PanchangamData represents the Panchangam data for a specific date, including Tithi, Nakshatra, Yoga, Karana, sunrise time, sunset time, and any additional events.
 // PanchangamEvent represents an event or special occurrence in the Panchangam, such as Rahu Kalam or Yamagandam.
+// ChoghadiyaPeriod represents one of the eight day or eight night Choghadiya periods.
+// Muhurta represents a named period of a day, such as Abhijit Muhurta or Varjyam.
+// TamilPeriod represents a Gowri Panchangam or Nalla Neram period of the Tamil Nadu almanacs.
+// NakshatraPada represents one of the four quarters of a nakshatra and when it starts and ends.
+// ElementBoundary flags an element whose value is uncertain because a transition lies close to the time it was evaluated.
 // GetPanchangamRequest is the request message sent to the server to retrieve Panchangam data for a specific date.
 //GetPanchangamResponse is the response message containing the requested Panchangam data.
+// GetFestivalBundleRequest and FestivalBundle carry a full year of festivals and vrats for a region and location.
+// GetEventsRequest and GetEventsResponse list the festivals, vrats, sankrantis and visible eclipses on a date or a few days.
+// GetMuhurtaRequest and GetMuhurtaResponse list the periods suitable for an activity following the rules of a tradition.
+// Profile describes the person a muhurta is sought for, whose janma nakshatra and rashi personalize the scores.
+// FindMuhurtaRequest and FindMuhurtaResponse rank the next periods suitable for an activity that satisfy further constraints.
+// GetVratListRequest and VratList carry the ekadashis, purnimas, amavasyas and sankrantis of a year, as on an annual vrat list.
+// GetLagnasRequest and GetLagnasResponse list the signs rising on the eastern horizon during a day, which many muhurta rules depend on.
+// WebhookSubscription is a URL notified of festivals and transitions at a location, which CreateSubscription, ListSubscriptions and DeleteSubscription manage.
 
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
@@ -24,7 +37,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Panchangam_Get_FullMethodName = "/panchangam.Panchangam/Get"
+	Panchangam_Get_FullMethodName                = "/panchangam.Panchangam/Get"
+	Panchangam_GetFestivalBundle_FullMethodName  = "/panchangam.Panchangam/GetFestivalBundle"
+	Panchangam_GetEvents_FullMethodName          = "/panchangam.Panchangam/GetEvents"
+	Panchangam_GetMuhurta_FullMethodName         = "/panchangam.Panchangam/GetMuhurta"
+	Panchangam_FindMuhurta_FullMethodName        = "/panchangam.Panchangam/FindMuhurta"
+	Panchangam_GetVratList_FullMethodName        = "/panchangam.Panchangam/GetVratList"
+	Panchangam_GetLagnas_FullMethodName          = "/panchangam.Panchangam/GetLagnas"
+	Panchangam_GetSummary_FullMethodName         = "/panchangam.Panchangam/GetSummary"
+	Panchangam_WatchTransitions_FullMethodName   = "/panchangam.Panchangam/WatchTransitions"
+	Panchangam_GetNextTransitions_FullMethodName = "/panchangam.Panchangam/GetNextTransitions"
+	Panchangam_GetHealth_FullMethodName          = "/panchangam.Panchangam/GetHealth"
+	Panchangam_GetSystemInfo_FullMethodName      = "/panchangam.Panchangam/GetSystemInfo"
+	Panchangam_GetVersion_FullMethodName         = "/panchangam.Panchangam/GetVersion"
+	Panchangam_FindFestivalDates_FullMethodName  = "/panchangam.Panchangam/FindFestivalDates"
+	Panchangam_GetUsageReport_FullMethodName     = "/panchangam.Panchangam/GetUsageReport"
+	Panchangam_CreateSubscription_FullMethodName = "/panchangam.Panchangam/CreateSubscription"
+	Panchangam_ListSubscriptions_FullMethodName  = "/panchangam.Panchangam/ListSubscriptions"
+	Panchangam_DeleteSubscription_FullMethodName = "/panchangam.Panchangam/DeleteSubscription"
 )
 
 // PanchangamClient is the client API for Panchangam service.
@@ -33,6 +63,40 @@ const (
 type PanchangamClient interface {
 	// RPC method to retrieve Panchangam data for a specific date
 	Get(ctx context.Context, in *GetPanchangamRequest, opts ...grpc.CallOption) (*GetPanchangamResponse, error)
+	// RPC method to retrieve all festivals and vrats of a year for a region and location
+	GetFestivalBundle(ctx context.Context, in *GetFestivalBundleRequest, opts ...grpc.CallOption) (*FestivalBundle, error)
+	// RPC method to list the festivals, vrats, sankrantis and visible eclipses on a date or range of days
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	// RPC method to find the periods suitable for an activity, e.g. a marriage
+	GetMuhurta(ctx context.Context, in *GetMuhurtaRequest, opts ...grpc.CallOption) (*GetMuhurtaResponse, error)
+	// RPC method to find the next periods suitable for an activity from a date that satisfy constraints, ranked by score
+	FindMuhurta(ctx context.Context, in *FindMuhurtaRequest, opts ...grpc.CallOption) (*FindMuhurtaResponse, error)
+	// RPC method to list the ekadashis, purnimas, amavasyas and sankrantis of a year with the parana window of each ekadashi
+	GetVratList(ctx context.Context, in *GetVratListRequest, opts ...grpc.CallOption) (*VratList, error)
+	// RPC method to list the lagnas, the sidereal signs rising on the eastern horizon, during a date
+	GetLagnas(ctx context.Context, in *GetLagnasRequest, opts ...grpc.CallOption) (*GetLagnasResponse, error)
+	// RPC method to summarize the panchangam of a date as it is read out, in a locale
+	GetSummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (*Summary, error)
+	// RPC method to stream the transitions of the panchangam at a location as they happen, until the call is cancelled
+	WatchTransitions(ctx context.Context, in *WatchTransitionsRequest, opts ...grpc.CallOption) (Panchangam_WatchTransitionsClient, error)
+	// RPC method to list the next transitions of the panchangam at a location from a time
+	GetNextTransitions(ctx context.Context, in *GetNextTransitionsRequest, opts ...grpc.CallOption) (*GetNextTransitionsResponse, error)
+	// RPC method to report whether the server is serving and how far precomputing popular locations has got
+	GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*Health, error)
+	// RPC method to report the build of the server, the health of its ephemeris providers and the statistics of its caches
+	GetSystemInfo(ctx context.Context, in *GetSystemInfoRequest, opts ...grpc.CallOption) (*SystemInfo, error)
+	// RPC method to report the version the server was built as and the features built in
+	GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*VersionInfo, error)
+	// RPC method to find the dates of a festival in a range of years, e.g. when Diwali falls in 2026
+	FindFestivalDates(ctx context.Context, in *FindFestivalDatesRequest, opts ...grpc.CallOption) (*FindFestivalDatesResponse, error)
+	// RPC method to report the daily calls, errors and latency of the RPCs by API key
+	GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*UsageReport, error)
+	// RPC method to register a URL notified with signed POSTs of the festivals and transitions at a location
+	CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error)
+	// RPC method to list the webhook subscriptions of the caller
+	ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error)
+	// RPC method to delete a webhook subscription of the caller
+	DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*DeleteSubscriptionResponse, error)
 }
 
 type panchangamClient struct {
@@ -52,12 +116,222 @@ func (c *panchangamClient) Get(ctx context.Context, in *GetPanchangamRequest, op
 	return out, nil
 }
 
+func (c *panchangamClient) GetFestivalBundle(ctx context.Context, in *GetFestivalBundleRequest, opts ...grpc.CallOption) (*FestivalBundle, error) {
+	out := new(FestivalBundle)
+	err := c.cc.Invoke(ctx, Panchangam_GetFestivalBundle_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, Panchangam_GetEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetMuhurta(ctx context.Context, in *GetMuhurtaRequest, opts ...grpc.CallOption) (*GetMuhurtaResponse, error) {
+	out := new(GetMuhurtaResponse)
+	err := c.cc.Invoke(ctx, Panchangam_GetMuhurta_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) FindMuhurta(ctx context.Context, in *FindMuhurtaRequest, opts ...grpc.CallOption) (*FindMuhurtaResponse, error) {
+	out := new(FindMuhurtaResponse)
+	err := c.cc.Invoke(ctx, Panchangam_FindMuhurta_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetVratList(ctx context.Context, in *GetVratListRequest, opts ...grpc.CallOption) (*VratList, error) {
+	out := new(VratList)
+	err := c.cc.Invoke(ctx, Panchangam_GetVratList_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetLagnas(ctx context.Context, in *GetLagnasRequest, opts ...grpc.CallOption) (*GetLagnasResponse, error) {
+	out := new(GetLagnasResponse)
+	err := c.cc.Invoke(ctx, Panchangam_GetLagnas_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetSummary(ctx context.Context, in *GetSummaryRequest, opts ...grpc.CallOption) (*Summary, error) {
+	out := new(Summary)
+	err := c.cc.Invoke(ctx, Panchangam_GetSummary_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) WatchTransitions(ctx context.Context, in *WatchTransitionsRequest, opts ...grpc.CallOption) (Panchangam_WatchTransitionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Panchangam_ServiceDesc.Streams[0], Panchangam_WatchTransitions_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &panchangamWatchTransitionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Panchangam_WatchTransitionsClient interface {
+	Recv() (*Transition, error)
+	grpc.ClientStream
+}
+
+type panchangamWatchTransitionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *panchangamWatchTransitionsClient) Recv() (*Transition, error) {
+	m := new(Transition)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *panchangamClient) GetNextTransitions(ctx context.Context, in *GetNextTransitionsRequest, opts ...grpc.CallOption) (*GetNextTransitionsResponse, error) {
+	out := new(GetNextTransitionsResponse)
+	err := c.cc.Invoke(ctx, Panchangam_GetNextTransitions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetHealth(ctx context.Context, in *GetHealthRequest, opts ...grpc.CallOption) (*Health, error) {
+	out := new(Health)
+	err := c.cc.Invoke(ctx, Panchangam_GetHealth_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetSystemInfo(ctx context.Context, in *GetSystemInfoRequest, opts ...grpc.CallOption) (*SystemInfo, error) {
+	out := new(SystemInfo)
+	err := c.cc.Invoke(ctx, Panchangam_GetSystemInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetVersion(ctx context.Context, in *GetVersionRequest, opts ...grpc.CallOption) (*VersionInfo, error) {
+	out := new(VersionInfo)
+	err := c.cc.Invoke(ctx, Panchangam_GetVersion_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) FindFestivalDates(ctx context.Context, in *FindFestivalDatesRequest, opts ...grpc.CallOption) (*FindFestivalDatesResponse, error) {
+	out := new(FindFestivalDatesResponse)
+	err := c.cc.Invoke(ctx, Panchangam_FindFestivalDates_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) GetUsageReport(ctx context.Context, in *GetUsageReportRequest, opts ...grpc.CallOption) (*UsageReport, error) {
+	out := new(UsageReport)
+	err := c.cc.Invoke(ctx, Panchangam_GetUsageReport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) CreateSubscription(ctx context.Context, in *CreateSubscriptionRequest, opts ...grpc.CallOption) (*WebhookSubscription, error) {
+	out := new(WebhookSubscription)
+	err := c.cc.Invoke(ctx, Panchangam_CreateSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) ListSubscriptions(ctx context.Context, in *ListSubscriptionsRequest, opts ...grpc.CallOption) (*ListSubscriptionsResponse, error) {
+	out := new(ListSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, Panchangam_ListSubscriptions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *panchangamClient) DeleteSubscription(ctx context.Context, in *DeleteSubscriptionRequest, opts ...grpc.CallOption) (*DeleteSubscriptionResponse, error) {
+	out := new(DeleteSubscriptionResponse)
+	err := c.cc.Invoke(ctx, Panchangam_DeleteSubscription_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PanchangamServer is the server API for Panchangam service.
 // All implementations must embed UnimplementedPanchangamServer
 // for forward compatibility
 type PanchangamServer interface {
 	// RPC method to retrieve Panchangam data for a specific date
 	Get(context.Context, *GetPanchangamRequest) (*GetPanchangamResponse, error)
+	// RPC method to retrieve all festivals and vrats of a year for a region and location
+	GetFestivalBundle(context.Context, *GetFestivalBundleRequest) (*FestivalBundle, error)
+	// RPC method to list the festivals, vrats, sankrantis and visible eclipses on a date or range of days
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	// RPC method to find the periods suitable for an activity, e.g. a marriage
+	GetMuhurta(context.Context, *GetMuhurtaRequest) (*GetMuhurtaResponse, error)
+	// RPC method to find the next periods suitable for an activity from a date that satisfy constraints, ranked by score
+	FindMuhurta(context.Context, *FindMuhurtaRequest) (*FindMuhurtaResponse, error)
+	// RPC method to list the ekadashis, purnimas, amavasyas and sankrantis of a year with the parana window of each ekadashi
+	GetVratList(context.Context, *GetVratListRequest) (*VratList, error)
+	// RPC method to list the lagnas, the sidereal signs rising on the eastern horizon, during a date
+	GetLagnas(context.Context, *GetLagnasRequest) (*GetLagnasResponse, error)
+	// RPC method to summarize the panchangam of a date as it is read out, in a locale
+	GetSummary(context.Context, *GetSummaryRequest) (*Summary, error)
+	// RPC method to stream the transitions of the panchangam at a location as they happen, until the call is cancelled
+	WatchTransitions(*WatchTransitionsRequest, Panchangam_WatchTransitionsServer) error
+	// RPC method to list the next transitions of the panchangam at a location from a time
+	GetNextTransitions(context.Context, *GetNextTransitionsRequest) (*GetNextTransitionsResponse, error)
+	// RPC method to report whether the server is serving and how far precomputing popular locations has got
+	GetHealth(context.Context, *GetHealthRequest) (*Health, error)
+	// RPC method to report the build of the server, the health of its ephemeris providers and the statistics of its caches
+	GetSystemInfo(context.Context, *GetSystemInfoRequest) (*SystemInfo, error)
+	// RPC method to report the version the server was built as and the features built in
+	GetVersion(context.Context, *GetVersionRequest) (*VersionInfo, error)
+	// RPC method to find the dates of a festival in a range of years, e.g. when Diwali falls in 2026
+	FindFestivalDates(context.Context, *FindFestivalDatesRequest) (*FindFestivalDatesResponse, error)
+	// RPC method to report the daily calls, errors and latency of the RPCs by API key
+	GetUsageReport(context.Context, *GetUsageReportRequest) (*UsageReport, error)
+	// RPC method to register a URL notified with signed POSTs of the festivals and transitions at a location
+	CreateSubscription(context.Context, *CreateSubscriptionRequest) (*WebhookSubscription, error)
+	// RPC method to list the webhook subscriptions of the caller
+	ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error)
+	// RPC method to delete a webhook subscription of the caller
+	DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*DeleteSubscriptionResponse, error)
 	mustEmbedUnimplementedPanchangamServer()
 }
 
@@ -68,6 +342,57 @@ type UnimplementedPanchangamServer struct {
 func (UnimplementedPanchangamServer) Get(context.Context, *GetPanchangamRequest) (*GetPanchangamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
 }
+func (UnimplementedPanchangamServer) GetFestivalBundle(context.Context, *GetFestivalBundleRequest) (*FestivalBundle, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFestivalBundle not implemented")
+}
+func (UnimplementedPanchangamServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedPanchangamServer) GetMuhurta(context.Context, *GetMuhurtaRequest) (*GetMuhurtaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMuhurta not implemented")
+}
+func (UnimplementedPanchangamServer) FindMuhurta(context.Context, *FindMuhurtaRequest) (*FindMuhurtaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMuhurta not implemented")
+}
+func (UnimplementedPanchangamServer) GetVratList(context.Context, *GetVratListRequest) (*VratList, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVratList not implemented")
+}
+func (UnimplementedPanchangamServer) GetLagnas(context.Context, *GetLagnasRequest) (*GetLagnasResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLagnas not implemented")
+}
+func (UnimplementedPanchangamServer) GetSummary(context.Context, *GetSummaryRequest) (*Summary, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSummary not implemented")
+}
+func (UnimplementedPanchangamServer) WatchTransitions(*WatchTransitionsRequest, Panchangam_WatchTransitionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchTransitions not implemented")
+}
+func (UnimplementedPanchangamServer) GetNextTransitions(context.Context, *GetNextTransitionsRequest) (*GetNextTransitionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNextTransitions not implemented")
+}
+func (UnimplementedPanchangamServer) GetHealth(context.Context, *GetHealthRequest) (*Health, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHealth not implemented")
+}
+func (UnimplementedPanchangamServer) GetSystemInfo(context.Context, *GetSystemInfoRequest) (*SystemInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSystemInfo not implemented")
+}
+func (UnimplementedPanchangamServer) GetVersion(context.Context, *GetVersionRequest) (*VersionInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVersion not implemented")
+}
+func (UnimplementedPanchangamServer) FindFestivalDates(context.Context, *FindFestivalDatesRequest) (*FindFestivalDatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindFestivalDates not implemented")
+}
+func (UnimplementedPanchangamServer) GetUsageReport(context.Context, *GetUsageReportRequest) (*UsageReport, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUsageReport not implemented")
+}
+func (UnimplementedPanchangamServer) CreateSubscription(context.Context, *CreateSubscriptionRequest) (*WebhookSubscription, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateSubscription not implemented")
+}
+func (UnimplementedPanchangamServer) ListSubscriptions(context.Context, *ListSubscriptionsRequest) (*ListSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSubscriptions not implemented")
+}
+func (UnimplementedPanchangamServer) DeleteSubscription(context.Context, *DeleteSubscriptionRequest) (*DeleteSubscriptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteSubscription not implemented")
+}
 func (UnimplementedPanchangamServer) mustEmbedUnimplementedPanchangamServer() {}
 
 // UnsafePanchangamServer may be embedded to opt out of forward compatibility for this service.
@@ -99,16 +424,682 @@ func _Panchangam_Get_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
-// Panchangam_ServiceDesc is the grpc.ServiceDesc for Panchangam service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var Panchangam_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "panchangam.Panchangam",
-	HandlerType: (*PanchangamServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Get",
-			Handler:    _Panchangam_Get_Handler,
+func _Panchangam_GetFestivalBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFestivalBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetFestivalBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetFestivalBundle_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetFestivalBundle(ctx, req.(*GetFestivalBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetEvents(ctx, req.(*GetEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetMuhurta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMuhurtaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetMuhurta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetMuhurta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetMuhurta(ctx, req.(*GetMuhurtaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_FindMuhurta_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMuhurtaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).FindMuhurta(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_FindMuhurta_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).FindMuhurta(ctx, req.(*FindMuhurtaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetVratList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVratListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetVratList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetVratList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetVratList(ctx, req.(*GetVratListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetLagnas_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLagnasRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetLagnas(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetLagnas_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetLagnas(ctx, req.(*GetLagnasRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetSummary_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetSummary(ctx, req.(*GetSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_WatchTransitions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchTransitionsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PanchangamServer).WatchTransitions(m, &panchangamWatchTransitionsServer{stream})
+}
+
+type Panchangam_WatchTransitionsServer interface {
+	Send(*Transition) error
+	grpc.ServerStream
+}
+
+type panchangamWatchTransitionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *panchangamWatchTransitionsServer) Send(m *Transition) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Panchangam_GetNextTransitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNextTransitionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetNextTransitions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetNextTransitions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetNextTransitions(ctx, req.(*GetNextTransitionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetHealth_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetHealth(ctx, req.(*GetHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetSystemInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSystemInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetSystemInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetSystemInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetSystemInfo(ctx, req.(*GetSystemInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetVersion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVersionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetVersion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetVersion_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetVersion(ctx, req.(*GetVersionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_FindFestivalDates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindFestivalDatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).FindFestivalDates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_FindFestivalDates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).FindFestivalDates(ctx, req.(*FindFestivalDatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_GetUsageReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsageReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).GetUsageReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_GetUsageReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).GetUsageReport(ctx, req.(*GetUsageReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_CreateSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).CreateSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_CreateSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).CreateSubscription(ctx, req.(*CreateSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_ListSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSubscriptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).ListSubscriptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_ListSubscriptions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).ListSubscriptions(ctx, req.(*ListSubscriptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Panchangam_DeleteSubscription_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteSubscriptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PanchangamServer).DeleteSubscription(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Panchangam_DeleteSubscription_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PanchangamServer).DeleteSubscription(ctx, req.(*DeleteSubscriptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Panchangam_ServiceDesc is the grpc.ServiceDesc for Panchangam service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Panchangam_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "panchangam.Panchangam",
+	HandlerType: (*PanchangamServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Get",
+			Handler:    _Panchangam_Get_Handler,
+		},
+		{
+			MethodName: "GetFestivalBundle",
+			Handler:    _Panchangam_GetFestivalBundle_Handler,
+		},
+		{
+			MethodName: "GetEvents",
+			Handler:    _Panchangam_GetEvents_Handler,
+		},
+		{
+			MethodName: "GetMuhurta",
+			Handler:    _Panchangam_GetMuhurta_Handler,
+		},
+		{
+			MethodName: "FindMuhurta",
+			Handler:    _Panchangam_FindMuhurta_Handler,
+		},
+		{
+			MethodName: "GetVratList",
+			Handler:    _Panchangam_GetVratList_Handler,
+		},
+		{
+			MethodName: "GetLagnas",
+			Handler:    _Panchangam_GetLagnas_Handler,
+		},
+		{
+			MethodName: "GetSummary",
+			Handler:    _Panchangam_GetSummary_Handler,
+		},
+		{
+			MethodName: "GetNextTransitions",
+			Handler:    _Panchangam_GetNextTransitions_Handler,
+		},
+		{
+			MethodName: "GetHealth",
+			Handler:    _Panchangam_GetHealth_Handler,
+		},
+		{
+			MethodName: "GetSystemInfo",
+			Handler:    _Panchangam_GetSystemInfo_Handler,
+		},
+		{
+			MethodName: "GetVersion",
+			Handler:    _Panchangam_GetVersion_Handler,
+		},
+		{
+			MethodName: "FindFestivalDates",
+			Handler:    _Panchangam_FindFestivalDates_Handler,
+		},
+		{
+			MethodName: "GetUsageReport",
+			Handler:    _Panchangam_GetUsageReport_Handler,
+		},
+		{
+			MethodName: "CreateSubscription",
+			Handler:    _Panchangam_CreateSubscription_Handler,
+		},
+		{
+			MethodName: "ListSubscriptions",
+			Handler:    _Panchangam_ListSubscriptions_Handler,
+		},
+		{
+			MethodName: "DeleteSubscription",
+			Handler:    _Panchangam_DeleteSubscription_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchTransitions",
+			Handler:       _Panchangam_WatchTransitions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "proto/panchangam.proto",
+}
+
+const (
+	Admin_ListCaches_FullMethodName         = "/panchangam.Admin/ListCaches"
+	Admin_FlushCache_FullMethodName         = "/panchangam.Admin/FlushCache"
+	Admin_ListProviders_FullMethodName      = "/panchangam.Admin/ListProviders"
+	Admin_SetProviderEnabled_FullMethodName = "/panchangam.Admin/SetProviderEnabled"
+	Admin_SetLogLevel_FullMethodName        = "/panchangam.Admin/SetLogLevel"
+	Admin_GetConfig_FullMethodName          = "/panchangam.Admin/GetConfig"
+)
+
+// AdminClient is the client API for Admin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminClient interface {
+	// RPC method to list the caches of the server with their statistics
+	ListCaches(ctx context.Context, in *ListCachesRequest, opts ...grpc.CallOption) (*ListCachesResponse, error)
+	// RPC method to empty a cache, or every cache, including the entries shared through a cache backend
+	FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error)
+	// RPC method to list the registered ephemeris providers and whether they are enabled
+	ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error)
+	// RPC method to enable or disable an ephemeris provider
+	SetProviderEnabled(ctx context.Context, in *SetProviderEnabledRequest, opts ...grpc.CallOption) (*EphemerisProvider, error)
+	// RPC method to change the minimum level of the records the server logs
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error)
+	// RPC method to dump the flags the server runs with, secrets redacted
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*Config, error)
+}
+
+type adminClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminClient(cc grpc.ClientConnInterface) AdminClient {
+	return &adminClient{cc}
+}
+
+func (c *adminClient) ListCaches(ctx context.Context, in *ListCachesRequest, opts ...grpc.CallOption) (*ListCachesResponse, error) {
+	out := new(ListCachesResponse)
+	err := c.cc.Invoke(ctx, Admin_ListCaches_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) FlushCache(ctx context.Context, in *FlushCacheRequest, opts ...grpc.CallOption) (*FlushCacheResponse, error) {
+	out := new(FlushCacheResponse)
+	err := c.cc.Invoke(ctx, Admin_FlushCache_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) ListProviders(ctx context.Context, in *ListProvidersRequest, opts ...grpc.CallOption) (*ListProvidersResponse, error) {
+	out := new(ListProvidersResponse)
+	err := c.cc.Invoke(ctx, Admin_ListProviders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetProviderEnabled(ctx context.Context, in *SetProviderEnabledRequest, opts ...grpc.CallOption) (*EphemerisProvider, error) {
+	out := new(EphemerisProvider)
+	err := c.cc.Invoke(ctx, Admin_SetProviderEnabled_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*SetLogLevelResponse, error) {
+	out := new(SetLogLevelResponse)
+	err := c.cc.Invoke(ctx, Admin_SetLogLevel_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*Config, error) {
+	out := new(Config)
+	err := c.cc.Invoke(ctx, Admin_GetConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// AdminServer is the server API for Admin service.
+// All implementations must embed UnimplementedAdminServer
+// for forward compatibility
+type AdminServer interface {
+	// RPC method to list the caches of the server with their statistics
+	ListCaches(context.Context, *ListCachesRequest) (*ListCachesResponse, error)
+	// RPC method to empty a cache, or every cache, including the entries shared through a cache backend
+	FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error)
+	// RPC method to list the registered ephemeris providers and whether they are enabled
+	ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error)
+	// RPC method to enable or disable an ephemeris provider
+	SetProviderEnabled(context.Context, *SetProviderEnabledRequest) (*EphemerisProvider, error)
+	// RPC method to change the minimum level of the records the server logs
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error)
+	// RPC method to dump the flags the server runs with, secrets redacted
+	GetConfig(context.Context, *GetConfigRequest) (*Config, error)
+	mustEmbedUnimplementedAdminServer()
+}
+
+// UnimplementedAdminServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServer struct {
+}
+
+func (UnimplementedAdminServer) ListCaches(context.Context, *ListCachesRequest) (*ListCachesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListCaches not implemented")
+}
+func (UnimplementedAdminServer) FlushCache(context.Context, *FlushCacheRequest) (*FlushCacheResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FlushCache not implemented")
+}
+func (UnimplementedAdminServer) ListProviders(context.Context, *ListProvidersRequest) (*ListProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListProviders not implemented")
+}
+func (UnimplementedAdminServer) SetProviderEnabled(context.Context, *SetProviderEnabledRequest) (*EphemerisProvider, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetProviderEnabled not implemented")
+}
+func (UnimplementedAdminServer) SetLogLevel(context.Context, *SetLogLevelRequest) (*SetLogLevelResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (UnimplementedAdminServer) GetConfig(context.Context, *GetConfigRequest) (*Config, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedAdminServer) mustEmbedUnimplementedAdminServer() {}
+
+// UnsafeAdminServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServer will
+// result in compilation errors.
+type UnsafeAdminServer interface {
+	mustEmbedUnimplementedAdminServer()
+}
+
+func RegisterAdminServer(s grpc.ServiceRegistrar, srv AdminServer) {
+	s.RegisterService(&Admin_ServiceDesc, srv)
+}
+
+func _Admin_ListCaches_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCachesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListCaches(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListCaches_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListCaches(ctx, req.(*ListCachesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_FlushCache_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlushCacheRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).FlushCache(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_FlushCache_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).FlushCache(ctx, req.(*FlushCacheRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_ListProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).ListProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_ListProviders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).ListProviders(ctx, req.(*ListProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetProviderEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetProviderEnabledRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetProviderEnabled(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SetProviderEnabled_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetProviderEnabled(ctx, req.(*SetProviderEnabledRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).SetLogLevel(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_SetLogLevel_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Admin_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Admin_GetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Admin_ServiceDesc is the grpc.ServiceDesc for Admin service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Admin_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "panchangam.Admin",
+	HandlerType: (*AdminServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListCaches",
+			Handler:    _Admin_ListCaches_Handler,
+		},
+		{
+			MethodName: "FlushCache",
+			Handler:    _Admin_FlushCache_Handler,
+		},
+		{
+			MethodName: "ListProviders",
+			Handler:    _Admin_ListProviders_Handler,
+		},
+		{
+			MethodName: "SetProviderEnabled",
+			Handler:    _Admin_SetProviderEnabled_Handler,
+		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _Admin_SetLogLevel_Handler,
+		},
+		{
+			MethodName: "GetConfig",
+			Handler:    _Admin_GetConfig_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -0,0 +1,927 @@
+// PanchangamData is version 2 of the panchangam of a date, in which the five limbs are structured Element messages and times are timestamps.
+// Element represents one of the five limbs of the panchangam: its number, names, span and qualities.
+// Period represents a named span of a day, such as a choghadiya or a muhurta.
+// GetPanchangamRequest and GetPanchangamResponse retrieve the version 2 panchangam of a date; version 1 clients keep using panchangam.Panchangam.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.34.0
+// 	protoc        v5.26.1
+// source: proto/v2/panchangam.proto
+
+package panchangam
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Panchangam data for a specific date
+type PanchangamData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Date for which Panchangam data is provided (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// IANA timezone name the date is reckoned in
+	Timezone string `protobuf:"bytes,2,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Tithi (lunar day) prevailing at sunrise
+	Tithi *Element `protobuf:"bytes,3,opt,name=tithi,proto3" json:"tithi,omitempty"`
+	// Nakshatra (lunar mansion) prevailing at sunrise
+	Nakshatra *Element `protobuf:"bytes,4,opt,name=nakshatra,proto3" json:"nakshatra,omitempty"`
+	// Yoga prevailing at sunrise
+	Yoga *Element `protobuf:"bytes,5,opt,name=yoga,proto3" json:"yoga,omitempty"`
+	// Karana prevailing at sunrise
+	Karana *Element `protobuf:"bytes,6,opt,name=karana,proto3" json:"karana,omitempty"`
+	// Vara (weekday), from sunrise to the next sunrise
+	Vara *Element `protobuf:"bytes,7,opt,name=vara,proto3" json:"vara,omitempty"`
+	// Sunrise; the conventional 06:00 local mean time when the sun does not
+	// rise or set, see sun_condition
+	Sunrise *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sunrise,proto3" json:"sunrise,omitempty"`
+	// Sunset; the conventional 18:00 local mean time when the sun does not
+	// rise or set, see sun_condition
+	Sunset *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=sunset,proto3" json:"sunset,omitempty"`
+	// Moonrise on the date, unset when the moon does not rise that day
+	Moonrise *timestamppb.Timestamp `protobuf:"bytes,10,opt,name=moonrise,proto3" json:"moonrise,omitempty"`
+	// Moonset on the date, unset when the moon does not set that day
+	Moonset *timestamppb.Timestamp `protobuf:"bytes,11,opt,name=moonset,proto3" json:"moonset,omitempty"`
+	// Empty when the sun rises and sets on the date, otherwise midnight_sun or
+	// polar_night
+	SunCondition string `protobuf:"bytes,12,opt,name=sun_condition,json=sunCondition,proto3" json:"sun_condition,omitempty"`
+	// Amanta lunar month (new moon to new moon) at sunrise, e.g. Chaitra
+	Masa string `protobuf:"bytes,13,opt,name=masa,proto3" json:"masa,omitempty"`
+	// Purnimanta lunar month (full moon to full moon) at sunrise
+	PurnimantaMasa string `protobuf:"bytes,14,opt,name=purnimanta_masa,json=purnimantaMasa,proto3" json:"purnimanta_masa,omitempty"`
+	// Whether the month is an intercalary Adhika masa
+	AdhikaMasa bool `protobuf:"varint,15,opt,name=adhika_masa,json=adhikaMasa,proto3" json:"adhika_masa,omitempty"`
+	// Whether the month is a Kshaya masa
+	KshayaMasa bool `protobuf:"varint,16,opt,name=kshaya_masa,json=kshayaMasa,proto3" json:"kshaya_masa,omitempty"`
+	// Lunar fortnight at sunrise: shukla or krishna
+	Paksha string `protobuf:"bytes,17,opt,name=paksha,proto3" json:"paksha,omitempty"`
+	// Year of the 60-year cycle, e.g. Krodhi
+	Samvatsara string `protobuf:"bytes,18,opt,name=samvatsara,proto3" json:"samvatsara,omitempty"`
+	// Traditional season, e.g. Vasanta
+	Ritu string `protobuf:"bytes,19,opt,name=ritu,proto3" json:"ritu,omitempty"`
+	// Half year of the sun's motion: Uttarayana or Dakshinayana
+	Ayana string `protobuf:"bytes,20,opt,name=ayana,proto3" json:"ayana,omitempty"`
+	// Vikram Samvat year under the new-year rule of the requested region
+	VikramSamvat int32 `protobuf:"varint,21,opt,name=vikram_samvat,json=vikramSamvat,proto3" json:"vikram_samvat,omitempty"`
+	// Shaka Samvat year under the new-year rule of the requested region
+	ShakaSamvat int32 `protobuf:"varint,22,opt,name=shaka_samvat,json=shakaSamvat,proto3" json:"shaka_samvat,omitempty"`
+	// Moon phase at sunrise, e.g. Waxing Gibbous
+	MoonPhase string `protobuf:"bytes,23,opt,name=moon_phase,json=moonPhase,proto3" json:"moon_phase,omitempty"`
+	// Illuminated fraction of the moon's disc at sunrise, from 0 to 1
+	MoonIllumination float64 `protobuf:"fixed64,24,opt,name=moon_illumination,json=moonIllumination,proto3" json:"moon_illumination,omitempty"`
+	// Choghadiya periods from sunrise to the next sunrise, eight for the day
+	// followed by eight for the night
+	Choghadiya []*Period `protobuf:"bytes,25,rep,name=choghadiya,proto3" json:"choghadiya,omitempty"`
+	// Abhijit and Brahma Muhurta, Durmuhurtam and Varjyam from sunrise to the
+	// next sunrise, ordered by start
+	Muhurtas []*Period `protobuf:"bytes,26,rep,name=muhurtas,proto3" json:"muhurtas,omitempty"`
+}
+
+func (x *PanchangamData) Reset() {
+	*x = PanchangamData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v2_panchangam_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PanchangamData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PanchangamData) ProtoMessage() {}
+
+func (x *PanchangamData) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_panchangam_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PanchangamData.ProtoReflect.Descriptor instead.
+func (*PanchangamData) Descriptor() ([]byte, []int) {
+	return file_proto_v2_panchangam_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *PanchangamData) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetTithi() *Element {
+	if x != nil {
+		return x.Tithi
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetNakshatra() *Element {
+	if x != nil {
+		return x.Nakshatra
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetYoga() *Element {
+	if x != nil {
+		return x.Yoga
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetKarana() *Element {
+	if x != nil {
+		return x.Karana
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetVara() *Element {
+	if x != nil {
+		return x.Vara
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetSunrise() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Sunrise
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetSunset() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Sunset
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetMoonrise() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Moonrise
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetMoonset() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Moonset
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetSunCondition() string {
+	if x != nil {
+		return x.SunCondition
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetMasa() string {
+	if x != nil {
+		return x.Masa
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetPurnimantaMasa() string {
+	if x != nil {
+		return x.PurnimantaMasa
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetAdhikaMasa() bool {
+	if x != nil {
+		return x.AdhikaMasa
+	}
+	return false
+}
+
+func (x *PanchangamData) GetKshayaMasa() bool {
+	if x != nil {
+		return x.KshayaMasa
+	}
+	return false
+}
+
+func (x *PanchangamData) GetPaksha() string {
+	if x != nil {
+		return x.Paksha
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetSamvatsara() string {
+	if x != nil {
+		return x.Samvatsara
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetRitu() string {
+	if x != nil {
+		return x.Ritu
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetAyana() string {
+	if x != nil {
+		return x.Ayana
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetVikramSamvat() int32 {
+	if x != nil {
+		return x.VikramSamvat
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetShakaSamvat() int32 {
+	if x != nil {
+		return x.ShakaSamvat
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetMoonPhase() string {
+	if x != nil {
+		return x.MoonPhase
+	}
+	return ""
+}
+
+func (x *PanchangamData) GetMoonIllumination() float64 {
+	if x != nil {
+		return x.MoonIllumination
+	}
+	return 0
+}
+
+func (x *PanchangamData) GetChoghadiya() []*Period {
+	if x != nil {
+		return x.Choghadiya
+	}
+	return nil
+}
+
+func (x *PanchangamData) GetMuhurtas() []*Period {
+	if x != nil {
+		return x.Muhurtas
+	}
+	return nil
+}
+
+// One of the five limbs of the panchangam
+type Element struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// One-based position in the cycle of the element: 1-30 for tithi, 1-27
+	// for nakshatra and yoga, 1-60 for karana and 1-7 from Sunday for vara
+	Number int32 `protobuf:"varint,1,opt,name=number,proto3" json:"number,omitempty"`
+	// Name of the element, e.g. Shukla Ekadashi
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Name of the element in the requested locale, e.g. शुक्ल एकादशी for hi;
+	// empty when the locale has no names
+	LocalName string `protobuf:"bytes,3,opt,name=local_name,json=localName,proto3" json:"local_name,omitempty"`
+	// Start of the element, which may be before the date
+	Start *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the element, which may be after the date
+	End *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=end,proto3" json:"end,omitempty"`
+	// Traditional classifications of the element, e.g. nanda for a tithi,
+	// dhruva for a nakshatra, inauspicious for a yoga or karana, or the
+	// planet ruling a vara
+	Qualities []string `protobuf:"bytes,6,rep,name=qualities,proto3" json:"qualities,omitempty"`
+}
+
+func (x *Element) Reset() {
+	*x = Element{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v2_panchangam_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Element) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Element) ProtoMessage() {}
+
+func (x *Element) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_panchangam_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Element.ProtoReflect.Descriptor instead.
+func (*Element) Descriptor() ([]byte, []int) {
+	return file_proto_v2_panchangam_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Element) GetNumber() int32 {
+	if x != nil {
+		return x.Number
+	}
+	return 0
+}
+
+func (x *Element) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Element) GetLocalName() string {
+	if x != nil {
+		return x.LocalName
+	}
+	return ""
+}
+
+func (x *Element) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Element) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Element) GetQualities() []string {
+	if x != nil {
+		return x.Qualities
+	}
+	return nil
+}
+
+// A named span of a day
+type Period struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Name of the period, e.g. Amrit or Abhijit
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Start of the period
+	Start *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=start,proto3" json:"start,omitempty"`
+	// End of the period
+	End *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=end,proto3" json:"end,omitempty"`
+	// Nature of the period: auspicious, neutral or inauspicious
+	Nature string `protobuf:"bytes,4,opt,name=nature,proto3" json:"nature,omitempty"`
+	// Whether a choghadiya belongs to the day rather than the night
+	IsDay bool `protobuf:"varint,5,opt,name=is_day,json=isDay,proto3" json:"is_day,omitempty"`
+}
+
+func (x *Period) Reset() {
+	*x = Period{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v2_panchangam_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Period) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Period) ProtoMessage() {}
+
+func (x *Period) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_panchangam_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Period.ProtoReflect.Descriptor instead.
+func (*Period) Descriptor() ([]byte, []int) {
+	return file_proto_v2_panchangam_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Period) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Period) GetStart() *timestamppb.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+func (x *Period) GetEnd() *timestamppb.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
+}
+
+func (x *Period) GetNature() string {
+	if x != nil {
+		return x.Nature
+	}
+	return ""
+}
+
+func (x *Period) GetIsDay() bool {
+	if x != nil {
+		return x.IsDay
+	}
+	return false
+}
+
+// Request message to retrieve the structured Panchangam data for a date
+type GetPanchangamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Date for which Panchangam data is requested (in ISO 8601 format: YYYY-MM-DD)
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// Latitude of the observer in degrees, positive north
+	Latitude float64 `protobuf:"fixed64,2,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	// Longitude of the observer in degrees, positive east
+	Longitude float64 `protobuf:"fixed64,3,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	// IANA timezone name the date is reckoned in, e.g. Asia/Kolkata (defaults to the timezone at the location)
+	Timezone string `protobuf:"bytes,4,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	// Region selecting regional conventions such as the new-year rule of the
+	// eras, e.g. gujarat
+	Region string `protobuf:"bytes,5,opt,name=region,proto3" json:"region,omitempty"`
+	// Locale of the local names of the elements, e.g. hi, ta, te or sa
+	// (defaults to en)
+	Locale string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
+	// Convention for sunrise and sunset: apparent or mean (defaults to apparent)
+	SunConvention string `protobuf:"bytes,7,opt,name=sun_convention,json=sunConvention,proto3" json:"sun_convention,omitempty"`
+	// Elevation of the observer above sea level in metres, used for the
+	// topocentric moon
+	Elevation float64 `protobuf:"fixed64,8,opt,name=elevation,proto3" json:"elevation,omitempty"`
+	// Position of the moon the elements are computed from: geocentric or
+	// topocentric (defaults to geocentric)
+	MoonPosition string `protobuf:"bytes,9,opt,name=moon_position,json=moonPosition,proto3" json:"moon_position,omitempty"`
+}
+
+func (x *GetPanchangamRequest) Reset() {
+	*x = GetPanchangamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v2_panchangam_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPanchangamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPanchangamRequest) ProtoMessage() {}
+
+func (x *GetPanchangamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_panchangam_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPanchangamRequest.ProtoReflect.Descriptor instead.
+func (*GetPanchangamRequest) Descriptor() ([]byte, []int) {
+	return file_proto_v2_panchangam_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetPanchangamRequest) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetRegion() string {
+	if x != nil {
+		return x.Region
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetSunConvention() string {
+	if x != nil {
+		return x.SunConvention
+	}
+	return ""
+}
+
+func (x *GetPanchangamRequest) GetElevation() float64 {
+	if x != nil {
+		return x.Elevation
+	}
+	return 0
+}
+
+func (x *GetPanchangamRequest) GetMoonPosition() string {
+	if x != nil {
+		return x.MoonPosition
+	}
+	return ""
+}
+
+// Response message containing the structured Panchangam data for the requested date
+type GetPanchangamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Panchangam data for the requested date
+	PanchangamData *PanchangamData `protobuf:"bytes,1,opt,name=panchangam_data,json=panchangamData,proto3" json:"panchangam_data,omitempty"`
+}
+
+func (x *GetPanchangamResponse) Reset() {
+	*x = GetPanchangamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_proto_v2_panchangam_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetPanchangamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPanchangamResponse) ProtoMessage() {}
+
+func (x *GetPanchangamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_proto_v2_panchangam_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPanchangamResponse.ProtoReflect.Descriptor instead.
+func (*GetPanchangamResponse) Descriptor() ([]byte, []int) {
+	return file_proto_v2_panchangam_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *GetPanchangamResponse) GetPanchangamData() *PanchangamData {
+	if x != nil {
+		return x.PanchangamData
+	}
+	return nil
+}
+
+var File_proto_v2_panchangam_proto protoreflect.FileDescriptor
+
+var file_proto_v2_panchangam_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x76, 0x32, 0x2f, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0d, 0x70, 0x61, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x88, 0x08, 0x0a, 0x0e,
+	0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61,
+	0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x2c,
+	0x0a, 0x05, 0x74, 0x69, 0x74, 0x68, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6c,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x05, 0x74, 0x69, 0x74, 0x68, 0x69, 0x12, 0x34, 0x0a, 0x09,
+	0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74, 0x72, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32, 0x2e,
+	0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x09, 0x6e, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x74,
+	0x72, 0x61, 0x12, 0x2a, 0x0a, 0x04, 0x79, 0x6f, 0x67, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32,
+	0x2e, 0x45, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x79, 0x6f, 0x67, 0x61, 0x12, 0x2e,
+	0x0a, 0x06, 0x6b, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x45,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x6b, 0x61, 0x72, 0x61, 0x6e, 0x61, 0x12, 0x2a,
+	0x0a, 0x04, 0x76, 0x61, 0x72, 0x61, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70,
+	0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x45, 0x6c, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x04, 0x76, 0x61, 0x72, 0x61, 0x12, 0x34, 0x0a, 0x07, 0x73, 0x75,
+	0x6e, 0x72, 0x69, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x73, 0x75, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x12, 0x32, 0x0a, 0x06, 0x73, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x73, 0x75,
+	0x6e, 0x73, 0x65, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x6d, 0x6f, 0x6f, 0x6e, 0x72, 0x69, 0x73, 0x65,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x08, 0x6d, 0x6f, 0x6f, 0x6e, 0x72, 0x69, 0x73, 0x65, 0x12, 0x34, 0x0a, 0x07,
+	0x6d, 0x6f, 0x6f, 0x6e, 0x73, 0x65, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x07, 0x6d, 0x6f, 0x6f, 0x6e, 0x73,
+	0x65, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73, 0x75, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x6e, 0x43, 0x6f,
+	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x61, 0x73, 0x61, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x61, 0x73, 0x61, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x75, 0x72, 0x6e, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x61, 0x5f, 0x6d, 0x61, 0x73, 0x61, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x75, 0x72, 0x6e, 0x69, 0x6d, 0x61, 0x6e, 0x74, 0x61,
+	0x4d, 0x61, 0x73, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x61, 0x64, 0x68, 0x69, 0x6b, 0x61, 0x5f, 0x6d,
+	0x61, 0x73, 0x61, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x61, 0x64, 0x68, 0x69, 0x6b,
+	0x61, 0x4d, 0x61, 0x73, 0x61, 0x12, 0x1f, 0x0a, 0x0b, 0x6b, 0x73, 0x68, 0x61, 0x79, 0x61, 0x5f,
+	0x6d, 0x61, 0x73, 0x61, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x6b, 0x73, 0x68, 0x61,
+	0x79, 0x61, 0x4d, 0x61, 0x73, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x6b, 0x73, 0x68, 0x61,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x6b, 0x73, 0x68, 0x61, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x73, 0x61, 0x72, 0x61, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x73, 0x61, 0x72, 0x61, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x69, 0x74, 0x75, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x72, 0x69,
+	0x74, 0x75, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x79, 0x61, 0x6e, 0x61, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x61, 0x79, 0x61, 0x6e, 0x61, 0x12, 0x23, 0x0a, 0x0d, 0x76, 0x69, 0x6b, 0x72,
+	0x61, 0x6d, 0x5f, 0x73, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0c, 0x76, 0x69, 0x6b, 0x72, 0x61, 0x6d, 0x53, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x73, 0x68, 0x61, 0x6b, 0x61, 0x5f, 0x73, 0x61, 0x6d, 0x76, 0x61, 0x74, 0x18, 0x16, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x0b, 0x73, 0x68, 0x61, 0x6b, 0x61, 0x53, 0x61, 0x6d, 0x76, 0x61, 0x74,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x6f, 0x6e, 0x5f, 0x70, 0x68, 0x61, 0x73, 0x65, 0x18, 0x17,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x6f, 0x6e, 0x50, 0x68, 0x61, 0x73, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x6d, 0x6f, 0x6f, 0x6e, 0x5f, 0x69, 0x6c, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x18, 0x20, 0x01, 0x28, 0x01, 0x52, 0x10, 0x6d, 0x6f, 0x6f, 0x6e,
+	0x49, 0x6c, 0x6c, 0x75, 0x6d, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x0a,
+	0x63, 0x68, 0x6f, 0x67, 0x68, 0x61, 0x64, 0x69, 0x79, 0x61, 0x18, 0x19, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32,
+	0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x0a, 0x63, 0x68, 0x6f, 0x67, 0x68, 0x61, 0x64,
+	0x69, 0x79, 0x61, 0x12, 0x31, 0x0a, 0x08, 0x6d, 0x75, 0x68, 0x75, 0x72, 0x74, 0x61, 0x73, 0x18,
+	0x1a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x61, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x52, 0x08, 0x6d, 0x75,
+	0x68, 0x75, 0x72, 0x74, 0x61, 0x73, 0x22, 0xd2, 0x01, 0x0a, 0x07, 0x45, 0x6c, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x2c, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x1c, 0x0a,
+	0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x09, 0x71, 0x75, 0x61, 0x6c, 0x69, 0x74, 0x69, 0x65, 0x73, 0x22, 0xab, 0x01, 0x0a, 0x06,
+	0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03,
+	0x65, 0x6e, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x64, 0x61, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x44, 0x61, 0x79, 0x22, 0x9a, 0x02, 0x0a, 0x14, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x67, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x67, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x73, 0x75, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x75, 0x6e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x09, 0x65, 0x6c, 0x65, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x6f, 0x6e, 0x5f, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x6f, 0x6e, 0x50, 0x6f,
+	0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5f, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x61, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x46, 0x0a, 0x0f, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x5f, 0x64, 0x61,
+	0x74, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x52, 0x0e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x61, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x32, 0x5e, 0x0a, 0x0a, 0x50, 0x61, 0x6e, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x61, 0x6d, 0x12, 0x50, 0x0a, 0x03, 0x47, 0x65, 0x74, 0x12, 0x23, 0x2e, 0x70,
+	0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76, 0x32, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x70, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x2e, 0x76,
+	0x32, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0e, 0x5a, 0x0c, 0x2e, 0x2f, 0x70, 0x61, 0x6e,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x61, 0x6d, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_proto_v2_panchangam_proto_rawDescOnce sync.Once
+	file_proto_v2_panchangam_proto_rawDescData = file_proto_v2_panchangam_proto_rawDesc
+)
+
+func file_proto_v2_panchangam_proto_rawDescGZIP() []byte {
+	file_proto_v2_panchangam_proto_rawDescOnce.Do(func() {
+		file_proto_v2_panchangam_proto_rawDescData = protoimpl.X.CompressGZIP(file_proto_v2_panchangam_proto_rawDescData)
+	})
+	return file_proto_v2_panchangam_proto_rawDescData
+}
+
+var file_proto_v2_panchangam_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_proto_v2_panchangam_proto_goTypes = []interface{}{
+	(*PanchangamData)(nil),        // 0: panchangam.v2.PanchangamData
+	(*Element)(nil),               // 1: panchangam.v2.Element
+	(*Period)(nil),                // 2: panchangam.v2.Period
+	(*GetPanchangamRequest)(nil),  // 3: panchangam.v2.GetPanchangamRequest
+	(*GetPanchangamResponse)(nil), // 4: panchangam.v2.GetPanchangamResponse
+	(*timestamppb.Timestamp)(nil), // 5: google.protobuf.Timestamp
+}
+var file_proto_v2_panchangam_proto_depIdxs = []int32{
+	1,  // 0: panchangam.v2.PanchangamData.tithi:type_name -> panchangam.v2.Element
+	1,  // 1: panchangam.v2.PanchangamData.nakshatra:type_name -> panchangam.v2.Element
+	1,  // 2: panchangam.v2.PanchangamData.yoga:type_name -> panchangam.v2.Element
+	1,  // 3: panchangam.v2.PanchangamData.karana:type_name -> panchangam.v2.Element
+	1,  // 4: panchangam.v2.PanchangamData.vara:type_name -> panchangam.v2.Element
+	5,  // 5: panchangam.v2.PanchangamData.sunrise:type_name -> google.protobuf.Timestamp
+	5,  // 6: panchangam.v2.PanchangamData.sunset:type_name -> google.protobuf.Timestamp
+	5,  // 7: panchangam.v2.PanchangamData.moonrise:type_name -> google.protobuf.Timestamp
+	5,  // 8: panchangam.v2.PanchangamData.moonset:type_name -> google.protobuf.Timestamp
+	2,  // 9: panchangam.v2.PanchangamData.choghadiya:type_name -> panchangam.v2.Period
+	2,  // 10: panchangam.v2.PanchangamData.muhurtas:type_name -> panchangam.v2.Period
+	5,  // 11: panchangam.v2.Element.start:type_name -> google.protobuf.Timestamp
+	5,  // 12: panchangam.v2.Element.end:type_name -> google.protobuf.Timestamp
+	5,  // 13: panchangam.v2.Period.start:type_name -> google.protobuf.Timestamp
+	5,  // 14: panchangam.v2.Period.end:type_name -> google.protobuf.Timestamp
+	0,  // 15: panchangam.v2.GetPanchangamResponse.panchangam_data:type_name -> panchangam.v2.PanchangamData
+	3,  // 16: panchangam.v2.Panchangam.Get:input_type -> panchangam.v2.GetPanchangamRequest
+	4,  // 17: panchangam.v2.Panchangam.Get:output_type -> panchangam.v2.GetPanchangamResponse
+	17, // [17:18] is the sub-list for method output_type
+	16, // [16:17] is the sub-list for method input_type
+	16, // [16:16] is the sub-list for extension type_name
+	16, // [16:16] is the sub-list for extension extendee
+	0,  // [0:16] is the sub-list for field type_name
+}
+
+func init() { file_proto_v2_panchangam_proto_init() }
+func file_proto_v2_panchangam_proto_init() {
+	if File_proto_v2_panchangam_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_proto_v2_panchangam_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PanchangamData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v2_panchangam_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Element); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v2_panchangam_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Period); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v2_panchangam_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPanchangamRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_proto_v2_panchangam_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetPanchangamResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_proto_v2_panchangam_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   5,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_proto_v2_panchangam_proto_goTypes,
+		DependencyIndexes: file_proto_v2_panchangam_proto_depIdxs,
+		MessageInfos:      file_proto_v2_panchangam_proto_msgTypes,
+	}.Build()
+	File_proto_v2_panchangam_proto = out.File
+	file_proto_v2_panchangam_proto_rawDesc = nil
+	file_proto_v2_panchangam_proto_goTypes = nil
+	file_proto_v2_panchangam_proto_depIdxs = nil
+}
@@ -0,0 +1,196 @@
+// Package client is the Go SDK of the Panchangam gRPC API. It wraps the
+// generated stubs with the defaults every caller needs:
+//
+//   - a timeout on unary calls whose context has no deadline,
+//   - retries with exponential backoff of calls failing with Unavailable,
+//   - a request ID on every call, correlating its logs in the server,
+//   - the API key of the caller, and
+//   - typed helpers taking a Location and a Date, and an iterator over the
+//     transitions streamed by WatchTransitions.
+//
+// A Client embeds the generated ppb.PanchangamClient, so every RPC is
+// available with the same defaults:
+//
+//	c, err := client.Dial("localhost:50051", client.WithAPIKey(key))
+//	if err != nil { ... }
+//	defer c.Close()
+//	day, err := c.Day(ctx, client.Location{Latitude: 13.08, Longitude: 80.27}, client.Today())
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/log"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Defaults of a Client.
+const (
+	// DefaultTimeout bounds the unary calls whose context has no deadline.
+	DefaultTimeout = 30 * time.Second
+	// DefaultAttempts is the number of times a unary call failing with
+	// Unavailable is tried.
+	DefaultAttempts = 3
+	// DefaultBackoff is the wait before the first retry, doubled before
+	// each further one.
+	DefaultBackoff = 200 * time.Millisecond
+	// maxBackoff caps the wait between retries.
+	maxBackoff = 10 * time.Second
+)
+
+// nonIdempotent lists the methods never retried, as a call failing with
+// Unavailable may still have taken effect.
+var nonIdempotent = map[string]bool{
+	ppb.Panchangam_CreateSubscription_FullMethodName: true,
+}
+
+// Client is a connection to a Panchangam server.
+type Client struct {
+	ppb.PanchangamClient
+	conn *grpc.ClientConn
+	opts options
+}
+
+type options struct {
+	timeout     time.Duration
+	attempts    int
+	backoff     time.Duration
+	apiKey      string
+	creds       credentials.TransportCredentials
+	dialOptions []grpc.DialOption
+}
+
+// Option configures a Client.
+type Option func(*options)
+
+// WithTimeout sets the timeout of the unary calls whose context has no
+// deadline, DefaultTimeout by default. Zero disables it.
+func WithTimeout(d time.Duration) Option {
+	return func(o *options) { o.timeout = d }
+}
+
+// WithRetry sets the number of times a unary call failing with Unavailable
+// is tried, and the wait before the first retry, doubled before each
+// further one up to 10 seconds. One attempt disables retries.
+func WithRetry(attempts int, backoff time.Duration) Option {
+	return func(o *options) { o.attempts, o.backoff = attempts, backoff }
+}
+
+// WithAPIKey sends key in the x-api-key metadata of every call.
+func WithAPIKey(key string) Option {
+	return func(o *options) { o.apiKey = key }
+}
+
+// WithTLS connects over TLS configured by config, e.g. from aaa.ClientTLS.
+// Connections are plaintext by default.
+func WithTLS(config *tls.Config) Option {
+	return func(o *options) { o.creds = credentials.NewTLS(config) }
+}
+
+// WithTransportCredentials sets the credentials of the connection.
+func WithTransportCredentials(creds credentials.TransportCredentials) Option {
+	return func(o *options) { o.creds = creds }
+}
+
+// WithDialOptions adds options to those the connection is created with.
+func WithDialOptions(opts ...grpc.DialOption) Option {
+	return func(o *options) { o.dialOptions = append(o.dialOptions, opts...) }
+}
+
+// Dial returns a Client of the server at addr, e.g. localhost:50051. The
+// connection is established on the first call.
+func Dial(addr string, opts ...Option) (*Client, error) {
+	o := options{
+		timeout:  DefaultTimeout,
+		attempts: DefaultAttempts,
+		backoff:  DefaultBackoff,
+		creds:    insecure.NewCredentials(),
+	}
+	for _, opt := range opts {
+		opt(&o)
+	}
+	if o.attempts < 1 {
+		o.attempts = 1
+	}
+	dialOptions := append([]grpc.DialOption{
+		grpc.WithTransportCredentials(o.creds),
+		grpc.WithChainUnaryInterceptor(o.unaryInterceptor),
+		grpc.WithChainStreamInterceptor(o.streamInterceptor),
+	}, o.dialOptions...)
+	conn, err := grpc.NewClient(addr, dialOptions...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{PanchangamClient: ppb.NewPanchangamClient(conn), conn: conn, opts: o}, nil
+}
+
+// Conn returns the connection of c, for the clients of the other services
+// of the server, e.g. ppb.NewAdminClient(c.Conn()).
+func (c *Client) Conn() *grpc.ClientConn {
+	return c.conn
+}
+
+// Close closes the connection.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+// outgoing returns ctx with the metadata of a call: the request ID of ctx,
+// see log.WithRequestID, or a new one, and the API key.
+func (o *options) outgoing(ctx context.Context) context.Context {
+	md, _ := metadata.FromOutgoingContext(ctx)
+	if len(md.Get(log.RequestIDHeader)) == 0 {
+		id := log.RequestID(ctx)
+		if id == "" {
+			id = log.NewRequestID()
+		}
+		ctx = metadata.AppendToOutgoingContext(ctx, log.RequestIDHeader, id)
+	}
+	if o.apiKey != "" && len(md.Get(aaa.APIKeyHeader)) == 0 {
+		ctx = metadata.AppendToOutgoingContext(ctx, aaa.APIKeyHeader, o.apiKey)
+	}
+	return ctx
+}
+
+// unaryInterceptor applies the timeout, metadata and retries of o to a
+// unary call. Retries keep the request ID of the call.
+func (o *options) unaryInterceptor(ctx context.Context, method string, req, reply any, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	if _, ok := ctx.Deadline(); !ok && o.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.timeout)
+		defer cancel()
+	}
+	ctx = o.outgoing(ctx)
+	attempts := o.attempts
+	if nonIdempotent[method] {
+		attempts = 1
+	}
+	wait := o.backoff
+	for attempt := 1; ; attempt++ {
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if status.Code(err) != codes.Unavailable || attempt >= attempts {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(wait):
+		}
+		wait = min(2*wait, maxBackoff)
+	}
+}
+
+// streamInterceptor adds the metadata of o to a streaming call. Streams
+// have no timeout, as they last until cancelled.
+func (o *options) streamInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	return streamer(o.outgoing(ctx), desc, cc, method, opts...)
+}
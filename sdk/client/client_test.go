@@ -0,0 +1,180 @@
+package client
+
+import (
+	"context"
+	"net"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/log"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// server fails the first calls of each method with Unavailable and records
+// the metadata and requests it receives.
+type server struct {
+	ppb.UnimplementedPanchangamServer
+	failures int
+
+	mu       sync.Mutex
+	calls    map[string]int
+	ids      []string
+	keys     []string
+	requests []*ppb.GetPanchangamRequest
+}
+
+// record counts a call of method and reports whether it is to fail.
+func (s *server) record(ctx context.Context, method string) bool {
+	md, _ := metadata.FromIncomingContext(ctx)
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.calls[method]++
+	s.ids = append(s.ids, md.Get(log.RequestIDHeader)...)
+	s.keys = append(s.keys, md.Get(aaa.APIKeyHeader)...)
+	return s.calls[method] <= s.failures
+}
+
+func (s *server) Get(ctx context.Context, req *ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error) {
+	if s.record(ctx, "Get") {
+		return nil, status.Error(codes.Unavailable, "starting")
+	}
+	s.mu.Lock()
+	s.requests = append(s.requests, req)
+	s.mu.Unlock()
+	return &ppb.GetPanchangamResponse{PanchangamData: &ppb.PanchangamData{Date: req.Date}}, nil
+}
+
+func (s *server) CreateSubscription(ctx context.Context, req *ppb.CreateSubscriptionRequest) (*ppb.WebhookSubscription, error) {
+	s.record(ctx, "CreateSubscription")
+	return nil, status.Error(codes.Unavailable, "starting")
+}
+
+func (s *server) GetHealth(ctx context.Context, req *ppb.GetHealthRequest) (*ppb.Health, error) {
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (s *server) WatchTransitions(req *ppb.WatchTransitionsRequest, stream ppb.Panchangam_WatchTransitionsServer) error {
+	if s.record(stream.Context(), "WatchTransitions") {
+		return status.Error(codes.Unavailable, "starting")
+	}
+	for _, name := range []string{"Dvadashi", "Trayodashi"} {
+		if err := stream.Send(&ppb.Transition{Name: name}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func start(t *testing.T, s *server, opts ...Option) *Client {
+	t.Helper()
+	s.calls = map[string]int{}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := grpc.NewServer()
+	ppb.RegisterPanchangamServer(srv, s)
+	go srv.Serve(l)
+	t.Cleanup(srv.Stop)
+	c, err := Dial(l.Addr().String(), append([]Option{WithRetry(3, time.Millisecond)}, opts...)...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { c.Close() })
+	return c
+}
+
+func TestRetry(t *testing.T) {
+	s := &server{failures: 2}
+	c := start(t, s, WithAPIKey("key"))
+	day, err := c.Day(context.Background(), Location{Latitude: 13.08, Longitude: 80.27, Timezone: "Asia/Kolkata"}, Date{2024, time.April, 9})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if day.Date != "2024-04-09" || s.calls["Get"] != 3 {
+		t.Errorf("Day() = %v after %d calls, want 2024-04-09 after 3", day.Date, s.calls["Get"])
+	}
+	if len(s.ids) != 3 || s.ids[0] == "" || s.ids[1] != s.ids[0] || s.ids[2] != s.ids[0] {
+		t.Errorf("request IDs = %v, want the same one on every attempt", s.ids)
+	}
+	if len(s.keys) != 3 || s.keys[0] != "key" {
+		t.Errorf("API keys = %v, want key on every attempt", s.keys)
+	}
+	if req := s.requests[0]; req.Latitude != 13.08 || req.Timezone != "Asia/Kolkata" {
+		t.Errorf("request = %v, want the location", req)
+	}
+
+	if _, err := c.Day(context.Background(), Location{}, Date{2024, time.April, 9}); err != nil {
+		t.Fatal(err)
+	}
+	if s.ids[3] == s.ids[0] {
+		t.Error("calls share a request ID")
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	s := &server{failures: 5}
+	c := start(t, s)
+	_, err := c.Day(context.Background(), Location{}, Today())
+	if status.Code(err) != codes.Unavailable || s.calls["Get"] != 3 {
+		t.Errorf("Day() error = %v after %d calls, want Unavailable after 3", err, s.calls["Get"])
+	}
+	if _, err := c.CreateSubscription(context.Background(), &ppb.CreateSubscriptionRequest{}); status.Code(err) != codes.Unavailable || s.calls["CreateSubscription"] != 1 {
+		t.Errorf("CreateSubscription() retried %d times, want no retries", s.calls["CreateSubscription"]-1)
+	}
+}
+
+func TestRequestIDOfContext(t *testing.T) {
+	s := &server{}
+	c := start(t, s)
+	ctx := log.WithRequestID(context.Background(), "gateway-request")
+	if _, err := c.Day(ctx, Location{}, Today()); err != nil {
+		t.Fatal(err)
+	}
+	if s.ids[0] != "gateway-request" {
+		t.Errorf("request ID = %q, want that of the context", s.ids[0])
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	c := start(t, &server{}, WithTimeout(50*time.Millisecond))
+	if _, err := c.GetHealth(context.Background(), &ppb.GetHealthRequest{}); status.Code(err) != codes.DeadlineExceeded {
+		t.Errorf("GetHealth() error = %v, want DeadlineExceeded", err)
+	}
+}
+
+func TestTransitions(t *testing.T) {
+	s := &server{failures: 1}
+	c := start(t, s)
+	it := c.Transitions(context.Background(), Location{Latitude: 13.08, Longitude: 80.27}, nil)
+	var names []string
+	for it.Next() {
+		names = append(names, it.Transition().GetName())
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "Dvadashi" || s.calls["WatchTransitions"] != 2 {
+		t.Errorf("transitions = %v after %d calls, want Dvadashi and Trayodashi after reopening", names, s.calls["WatchTransitions"])
+	}
+}
+
+func TestDate(t *testing.T) {
+	d, err := ParseDate("2024-02-28")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := d.AddDays(2).String(); got != "2024-03-01" {
+		t.Errorf("AddDays(2) = %s, want 2024-03-01", got)
+	}
+	if _, err := ParseDate("28/02/2024"); err == nil {
+		t.Error("ParseDate accepted 28/02/2024")
+	}
+}
@@ -0,0 +1,144 @@
+package client
+
+import (
+	"context"
+	"io"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Day returns the panchangam of date at loc.
+func (c *Client) Day(ctx context.Context, loc Location, date Date) (*ppb.PanchangamData, error) {
+	resp, err := c.Get(ctx, &ppb.GetPanchangamRequest{
+		Date:      date.String(),
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Timezone:  loc.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPanchangamData(), nil
+}
+
+// Events returns the festivals, vrats, sankrantis and eclipses at loc
+// observed on the days days from date, selecting the regional festivals of
+// region, empty for all.
+func (c *Client) Events(ctx context.Context, loc Location, date Date, days int, region string) (*ppb.GetEventsResponse, error) {
+	return c.GetEvents(ctx, &ppb.GetEventsRequest{
+		Date:      date.String(),
+		Days:      int32(days),
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Timezone:  loc.Timezone,
+		Region:    region,
+	})
+}
+
+// Muhurtas returns the windows suitable for activity, e.g. marriage, at
+// loc on the days days from date, following the rule pack of tradition,
+// empty for the default one.
+func (c *Client) Muhurtas(ctx context.Context, loc Location, date Date, days int, activity, tradition string) (*ppb.GetMuhurtaResponse, error) {
+	return c.GetMuhurta(ctx, &ppb.GetMuhurtaRequest{
+		Date:      date.String(),
+		Days:      int32(days),
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Timezone:  loc.Timezone,
+		Activity:  activity,
+		Tradition: tradition,
+	})
+}
+
+// Summary returns the summary of the panchangam of date at loc in locale,
+// empty for English.
+func (c *Client) Summary(ctx context.Context, loc Location, date Date, locale string) (*ppb.Summary, error) {
+	return c.GetSummary(ctx, &ppb.GetSummaryRequest{
+		Date:      date.String(),
+		Latitude:  loc.Latitude,
+		Longitude: loc.Longitude,
+		Timezone:  loc.Timezone,
+		Locale:    locale,
+	})
+}
+
+// Transitions returns an iterator over the transitions of the panchangam
+// at loc as they happen, streamed by WatchTransitions with req, whose
+// location is replaced by loc. The stream is reopened with backoff when
+// the server becomes unavailable, so the iterator runs until ctx ends:
+//
+//	it := c.Transitions(ctx, loc, &ppb.WatchTransitionsRequest{NoticeMinutes: 10})
+//	for it.Next() {
+//		fmt.Println(it.Transition().GetMessage())
+//	}
+//	if err := it.Err(); err != nil { ... }
+func (c *Client) Transitions(ctx context.Context, loc Location, req *ppb.WatchTransitionsRequest) *TransitionIterator {
+	if req == nil {
+		req = &ppb.WatchTransitionsRequest{}
+	}
+	req.Latitude, req.Longitude, req.Timezone = loc.Latitude, loc.Longitude, loc.Timezone
+	return &TransitionIterator{ctx: ctx, client: c, req: req}
+}
+
+// TransitionIterator iterates over streamed transitions, in the manner of
+// bufio.Scanner.
+type TransitionIterator struct {
+	ctx    context.Context
+	client *Client
+	req    *ppb.WatchTransitionsRequest
+
+	stream ppb.Panchangam_WatchTransitionsClient
+	cur    *ppb.Transition
+	err    error
+}
+
+// Next advances to the next transition, waiting for it, and reports
+// whether there is one. It returns false when ctx ends or the stream fails
+// otherwise than by the server becoming unavailable; Err then tells why.
+func (it *TransitionIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+	wait := it.client.opts.backoff
+	for {
+		if it.stream == nil {
+			it.stream, it.err = it.client.WatchTransitions(it.ctx, it.req)
+		}
+		if it.err == nil {
+			it.cur, it.err = it.stream.Recv()
+			if it.err == nil {
+				return true
+			}
+		}
+		it.stream = nil
+		if it.err == io.EOF || it.ctx.Err() != nil || status.Code(it.err) == codes.Canceled {
+			it.err = nil
+			return false
+		}
+		if status.Code(it.err) != codes.Unavailable {
+			return false
+		}
+		select {
+		case <-it.ctx.Done():
+			it.err = nil
+			return false
+		case <-time.After(wait):
+		}
+		it.err = nil
+		wait = min(2*wait, maxBackoff)
+	}
+}
+
+// Transition returns the transition Next advanced to.
+func (it *TransitionIterator) Transition() *ppb.Transition {
+	return it.cur
+}
+
+// Err returns the error that ended the iteration, nil when it ended with
+// ctx or the stream.
+func (it *TransitionIterator) Err() error {
+	return it.err
+}
@@ -0,0 +1,58 @@
+package client
+
+import (
+	"fmt"
+	"time"
+)
+
+// Location is a place on Earth and the timezone of its civil dates.
+type Location struct {
+	// Latitude and Longitude are in degrees, positive north and east.
+	Latitude  float64
+	Longitude float64
+	// Timezone is an IANA timezone name, e.g. Asia/Kolkata. The server uses
+	// the timezone at the location when it is empty.
+	Timezone string
+}
+
+// Date is a civil date, independent of any timezone.
+type Date struct {
+	Year  int
+	Month time.Month
+	Day   int
+}
+
+// DateOf returns the civil date of t in its location.
+func DateOf(t time.Time) Date {
+	y, m, d := t.Date()
+	return Date{Year: y, Month: m, Day: d}
+}
+
+// Today returns the civil date of now in the local timezone.
+func Today() Date {
+	return DateOf(time.Now())
+}
+
+// ParseDate parses a date in YYYY-MM-DD format.
+func ParseDate(s string) (Date, error) {
+	t, err := time.Parse(time.DateOnly, s)
+	if err != nil {
+		return Date{}, fmt.Errorf("invalid date %q: expected YYYY-MM-DD", s)
+	}
+	return DateOf(t), nil
+}
+
+// String returns d in YYYY-MM-DD format, as the API expects.
+func (d Date) String() string {
+	return fmt.Sprintf("%04d-%02d-%02d", d.Year, d.Month, d.Day)
+}
+
+// AddDays returns the date n days after d, or before it for negative n.
+func (d Date) AddDays(n int) Date {
+	return DateOf(time.Date(d.Year, d.Month, d.Day+n, 12, 0, 0, 0, time.UTC))
+}
+
+// In returns the midnight starting d in loc.
+func (d Date) In(loc *time.Location) time.Time {
+	return time.Date(d.Year, d.Month, d.Day, 0, 0, 0, 0, loc)
+}
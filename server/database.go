@@ -0,0 +1,29 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"slices"
+	"time"
+)
+
+// openDatabase opens the database dsn with driver and checks that it can be
+// reached. The drivers are only built into the server with their build
+// tags: sqlite3 with sqlite and postgres with postgres.
+func openDatabase(driver, dsn string) (*sql.DB, error) {
+	if !slices.Contains(sql.Drivers(), driver) {
+		return nil, fmt.Errorf("database driver %q is not built in: build the server with -tags sqlite for sqlite3 or -tags postgres for postgres (built in: %v)", driver, sql.Drivers())
+	}
+	db, err := sql.Open(driver, dsn)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := db.PingContext(ctx); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
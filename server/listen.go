@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+)
+
+// listenAll listens on each of the comma separated addresses, accepting at
+// most maxConns connections at a time on each (0 for no limit).
+//
+// IPv4 and IPv6 literals listen on their family only, so that
+// "0.0.0.0:50051,[::]:50051" gives two listeners. A host name or an empty
+// host, as in ":50051", listens on both families where the system allows.
+func listenAll(addrs string, maxConns int) ([]net.Listener, error) {
+	var listeners []net.Listener
+	for _, addr := range strings.Split(addrs, ",") {
+		addr = strings.TrimSpace(addr)
+		if addr == "" {
+			continue
+		}
+		network, err := listenNetwork(addr)
+		if err == nil {
+			var l net.Listener
+			if l, err = net.Listen(network, addr); err == nil {
+				if maxConns > 0 {
+					l = newLimitListener(l, maxConns)
+				}
+				listeners = append(listeners, l)
+				continue
+			}
+		}
+		for _, l := range listeners {
+			l.Close()
+		}
+		return nil, fmt.Errorf("listening on %q: %w", addr, err)
+	}
+	if len(listeners) == 0 {
+		return nil, fmt.Errorf("no address to listen on in %q", addrs)
+	}
+	return listeners, nil
+}
+
+// listenNetwork returns the network to listen on addr with.
+func listenNetwork(addr string) (string, error) {
+	host, _, err := net.SplitHostPort(addr)
+	if err != nil {
+		return "", err
+	}
+	ip := net.ParseIP(host)
+	switch {
+	case ip == nil:
+		return "tcp", nil
+	case ip.To4() != nil:
+		return "tcp4", nil
+	default:
+		return "tcp6", nil
+	}
+}
+
+// dialAddr returns the address to connect to l from the same host.
+func dialAddr(l net.Listener) string {
+	addr, ok := l.Addr().(*net.TCPAddr)
+	if !ok {
+		return l.Addr().String()
+	}
+	port := fmt.Sprint(addr.Port)
+	if addr.IP == nil || addr.IP.IsUnspecified() {
+		return net.JoinHostPort("localhost", port)
+	}
+	return net.JoinHostPort(addr.IP.String(), port)
+}
+
+// limitListener accepts at most a fixed number of open connections, making
+// further clients wait in the listen backlog.
+type limitListener struct {
+	net.Listener
+	sem       chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+func newLimitListener(l net.Listener, n int) *limitListener {
+	return &limitListener{Listener: l, sem: make(chan struct{}, n), done: make(chan struct{})}
+}
+
+func (l *limitListener) Accept() (net.Conn, error) {
+	select {
+	case l.sem <- struct{}{}:
+	case <-l.done:
+		return nil, net.ErrClosed
+	}
+	c, err := l.Listener.Accept()
+	if err != nil {
+		<-l.sem
+		return nil, err
+	}
+	return &limitConn{Conn: c, release: func() { <-l.sem }}, nil
+}
+
+func (l *limitListener) Close() error {
+	err := l.Listener.Close()
+	l.closeOnce.Do(func() { close(l.done) })
+	return err
+}
+
+// limitConn frees its slot in a limitListener when closed.
+type limitConn struct {
+	net.Conn
+	releaseOnce sync.Once
+	release     func()
+}
+
+func (c *limitConn) Close() error {
+	err := c.Conn.Close()
+	c.releaseOnce.Do(c.release)
+	return err
+}
@@ -0,0 +1,94 @@
+package main
+
+import (
+	"net"
+	"testing"
+	"time"
+)
+
+func TestListenNetwork(t *testing.T) {
+	tests := []struct {
+		addr, want string
+	}{
+		{":50051", "tcp"},
+		{"localhost:50051", "tcp"},
+		{"0.0.0.0:50051", "tcp4"},
+		{"127.0.0.1:50051", "tcp4"},
+		{"[::]:50051", "tcp6"},
+		{"[2001:db8::1]:50051", "tcp6"},
+	}
+	for _, tt := range tests {
+		if got, err := listenNetwork(tt.addr); got != tt.want || err != nil {
+			t.Errorf("listenNetwork(%q) = %q, %v, want %q", tt.addr, got, err, tt.want)
+		}
+	}
+	if _, err := listenNetwork("50051"); err == nil {
+		t.Error("listenNetwork(50051) succeeded without a port separator")
+	}
+}
+
+func TestListenAll(t *testing.T) {
+	listeners, err := listenAll("127.0.0.1:0, localhost:0", 0)
+	if err != nil {
+		t.Fatalf("listenAll() error = %v", err)
+	}
+	defer func() {
+		for _, l := range listeners {
+			l.Close()
+		}
+	}()
+	if len(listeners) != 2 {
+		t.Fatalf("listenAll() returned %d listeners, want 2", len(listeners))
+	}
+	if got := dialAddr(listeners[0]); got != listeners[0].Addr().String() {
+		t.Errorf("dialAddr() = %q, want %q", got, listeners[0].Addr())
+	}
+
+	if _, err := listenAll(" , ", 0); err == nil {
+		t.Error("listenAll() succeeded without addresses")
+	}
+	if _, err := listenAll("127.0.0.1:0,bad", 0); err == nil {
+		t.Error("listenAll() succeeded with an invalid address")
+	}
+}
+
+func TestLimitListener(t *testing.T) {
+	listeners, err := listenAll("127.0.0.1:0", 1)
+	if err != nil {
+		t.Fatalf("listenAll() error = %v", err)
+	}
+	l := listeners[0]
+	defer l.Close()
+
+	accepted := make(chan net.Conn, 2)
+	go func() {
+		for {
+			c, err := l.Accept()
+			if err != nil {
+				return
+			}
+			accepted <- c
+		}
+	}()
+	for i := 0; i < 2; i++ {
+		c, err := net.Dial("tcp", l.Addr().String())
+		if err != nil {
+			t.Fatalf("Dial() error = %v", err)
+		}
+		defer c.Close()
+	}
+
+	first := <-accepted
+	select {
+	case <-accepted:
+		t.Fatal("a second connection was accepted while the first was open")
+	case <-time.After(50 * time.Millisecond):
+	}
+	first.Close()
+	select {
+	case c := <-accepted:
+		c.Close()
+	case <-time.After(time.Second):
+		t.Fatal("the second connection was not accepted after the first closed")
+	}
+}
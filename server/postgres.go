@@ -0,0 +1,10 @@
+//go:build postgres
+
+// The postgres driver of -api-keys-driver, -usage-driver and -audit-driver
+// is built with:
+//
+//	go build -tags postgres ./server
+
+package main
+
+import _ "github.com/lib/pq"
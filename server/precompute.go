@@ -0,0 +1,42 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/naren-m/panchangam/location"
+	ps "github.com/naren-m/panchangam/services/panchangam"
+)
+
+// parsePrecomputeLocations parses a comma separated list of locations,
+// each the name of a city known to the gazetteer, e.g. Chennai, or a
+// latitude/longitude pair, e.g. 17.385/78.4867.
+func parsePrecomputeLocations(list string) ([]ps.PrecomputeLocation, error) {
+	var locations []ps.PrecomputeLocation
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if lat, lon, ok := strings.Cut(entry, "/"); ok {
+			latitude, err := strconv.ParseFloat(lat, 64)
+			if err != nil || latitude < -90 || latitude > 90 {
+				return nil, fmt.Errorf("invalid latitude in %q", entry)
+			}
+			longitude, err := strconv.ParseFloat(lon, 64)
+			if err != nil || longitude < -180 || longitude > 180 {
+				return nil, fmt.Errorf("invalid longitude in %q", entry)
+			}
+			locations = append(locations, ps.PrecomputeLocation{Name: entry, Latitude: latitude, Longitude: longitude})
+			continue
+		}
+		place, err := location.Gazetteer{}.Geocode(context.Background(), entry)
+		if err != nil {
+			return nil, err
+		}
+		locations = append(locations, ps.PrecomputeLocation{Name: place.Name, Latitude: place.Latitude, Longitude: place.Longitude})
+	}
+	return locations, nil
+}
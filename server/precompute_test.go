@@ -0,0 +1,27 @@
+package main
+
+import (
+	"testing"
+
+	ps "github.com/naren-m/panchangam/services/panchangam"
+)
+
+func TestParsePrecomputeLocations(t *testing.T) {
+	got, err := parsePrecomputeLocations("chennai, 17.385/78.4867,")
+	if err != nil {
+		t.Fatalf("parsePrecomputeLocations() error = %v", err)
+	}
+	want := []ps.PrecomputeLocation{
+		{Name: "Chennai", Latitude: 13.08, Longitude: 80.27},
+		{Name: "17.385/78.4867", Latitude: 17.385, Longitude: 78.4867},
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("parsePrecomputeLocations() = %v, want %v", got, want)
+	}
+
+	for _, list := range []string{"Atlantis", "91/0", "17.385/east"} {
+		if _, err := parsePrecomputeLocations(list); err == nil {
+			t.Errorf("parsePrecomputeLocations(%q) succeeded", list)
+		}
+	}
+}
@@ -2,52 +2,526 @@ package main
 
 import (
 	"context"
+	"crypto/tls"
+	"flag"
+	"fmt"
 	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/audit"
+	"github.com/naren-m/panchangam/cache"
+	"github.com/naren-m/panchangam/chaos"
+	"github.com/naren-m/panchangam/config"
+	"github.com/naren-m/panchangam/ephemeris"
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/gateway"
+	"github.com/naren-m/panchangam/i18n"
 	"github.com/naren-m/panchangam/log"
+	"github.com/naren-m/panchangam/muhurta"
+	"github.com/naren-m/panchangam/notify"
 	"github.com/naren-m/panchangam/observability"
+	"github.com/naren-m/panchangam/observability/debug"
 	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	pbv2 "github.com/naren-m/panchangam/proto/v2/panchangam"
 	ps "github.com/naren-m/panchangam/services/panchangam"
+	"github.com/naren-m/panchangam/webhook"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/keepalive"
+	"log/slog"
 	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
 )
 
 var logger = log.Logger()
 
+// swissProvider returns the swiss ephemeris provider reading its data files
+// in path. Only servers built with -tags swisseph have one.
+var swissProvider func(path string) ephemeris.Provider
+
+// authOptions returns the scopes of the RPCs needing other than the read
+// scope. Load balancers check the health of the server without an API key,
+// keys subscribing to webhooks need the write scope, and the system
+// information is for operators.
+func authOptions() []aaa.AuthOption {
+	return []aaa.AuthOption{
+		aaa.WithPublicMethod(healthpb.Health_Check_FullMethodName),
+		aaa.WithPublicMethod(healthpb.Health_Watch_FullMethodName),
+		aaa.WithMethodScope(ppb.Panchangam_CreateSubscription_FullMethodName, aaa.WriteScope),
+		aaa.WithMethodScope(ppb.Panchangam_DeleteSubscription_FullMethodName, aaa.WriteScope),
+		aaa.WithMethodScope(ppb.Panchangam_GetSystemInfo_FullMethodName, aaa.AdminScope),
+		aaa.WithMethodScope(ppb.Panchangam_GetUsageReport_FullMethodName, aaa.AdminScope),
+		aaa.WithServiceScope(ppb.Admin_ServiceDesc.ServiceName, aaa.AdminScope),
+	}
+}
+
 func main() {
+	festivalsDir := flag.String("festivals-dir", "", "Directory of custom festival definition files (*.json)")
+	muhurtaDir := flag.String("muhurta-dir", "", "Directory of custom muhurta rule packs (*.yaml)")
+	localesDir := flag.String("locales-dir", "", "Directory of custom name catalogs (*.yaml)")
+	ephemerisProvider := flag.String("ephemeris", "builtin", "Ephemeris provider of the positions of the moon and sun in the rashis: "+strings.Join(ephemeris.Names(), ", "))
+	ephemerisPath := flag.String("ephemeris-path", "", "Directory of the data files of the swiss ephemeris provider (default $SE_EPHE_PATH)")
+	rateLimit := flag.Float64("rate-limit", 10, "Requests per second allowed per client (0 disables rate limiting)")
+	rateBurst := flag.Int("rate-burst", 20, "Requests a client may make at once before being rate limited")
+	apiKeys := flag.String("api-keys", "", "API key file; when set every request needs a valid key (manage keys with the client keys command)")
+	apiKeysDriver := flag.String("api-keys-driver", "", "database/sql driver of -api-keys-db: sqlite3, built in with -tags sqlite, or postgres, built in with -tags postgres")
+	apiKeysDB := flag.String("api-keys-db", "", "Data source name of a SQLite or PostgreSQL database of API keys shared by the servers, instead of -api-keys")
+	clientLimits := flag.String("client-limits", "", "Per API key limits as id=rate/burst,..., by the key IDs of -api-keys, overriding -rate-limit and -rate-burst")
+	usageFile := flag.String("usage-file", "", "JSON file the daily usage of the RPCs by API key is recorded in")
+	usageDriver := flag.String("usage-driver", "", "database/sql driver of -usage-db: sqlite3, built in with -tags sqlite, or postgres, built in with -tags postgres")
+	usageDB := flag.String("usage-db", "", "Data source name of a SQLite or PostgreSQL database the daily usage is recorded in, shared by the servers")
+	auditFile := flag.String("audit-file", "", "JSON Lines file every calculation is recorded in, with its inputs, ayanamsa, provider and output, to reproduce it with client audit")
+	auditDriver := flag.String("audit-driver", "", "database/sql driver of -audit-db: sqlite3, built in with -tags sqlite, or postgres, built in with -tags postgres")
+	auditDB := flag.String("audit-db", "", "Data source name of a SQLite or PostgreSQL database the calculations are recorded in, shared by the servers")
+	auditMaxAge := flag.Duration("audit-max-age", 30*24*time.Hour, "Age from which audited calculations are dropped (0 keeps them)")
+	auditMaxRecords := flag.Int("audit-max-records", 0, "Most audited calculations kept, the latest ones (0 keeps them all)")
+	auditFlush := flag.Duration("audit-flush-interval", 10*time.Second, "Time between writes of the audited calculations")
+	webhooksFile := flag.String("webhooks-file", "", "JSON file of the webhook subscriptions; when set the server posts their events to them")
+	webhooksMax := flag.Int("webhooks-max-per-key", webhook.DefaultMaxSubscriptions, "Most webhook subscriptions of an API key (0 lifts the limit)")
+	notifyConfig := flag.String("notify-config", "", "JSON notify configuration; when set the server sends the daily panchangam and transition alerts of its locations over Telegram, Slack, webhooks or SMS")
+	usageFlush := flag.Duration("usage-flush-interval", time.Minute, "Time between writes of the recorded usage")
+	canaryAddr := flag.String("canary-addr", "", "gRPC address of a canary backend to shadow gateway traffic to")
+	maxDays := flag.Int("max-days", aaa.DefaultLimits.MaxDays, "Longest date range a request may cover, in days (0 disables the limit)")
+	maxBatch := flag.Int("max-batch", aaa.DefaultLimits.MaxBatch, "Most items a request may list (0 disables the limit)")
+	maxLocations := flag.Int("max-locations", aaa.DefaultLimits.MaxLocations, "Most locations a request may ask for (0 disables the limit)")
+	cacheTTL := flag.Duration("cache-ttl", cache.DefaultTTL, "Time computed panchangams are cached, before staggering and jitter")
+	cacheBackend := flag.String("cache-backend", "", "Second level cache of computed panchangams: memory, or redis to share them between server instances (default none)")
+	cacheEntries := flag.Int("cache-backend-entries", cache.DefaultMemoryBackendEntries, "Most panchangams held by the memory cache backend")
+	cacheVersion := flag.String("cache-version", cache.DefaultVersion, "Version of the panchangams in the cache backend; change it to stop sharing those computed by older servers")
+	redisAddr := flag.String("redis-addr", "localhost:6379", "Address of the Redis server of the redis cache backend")
+	redisPassword := flag.String("redis-password", "", "Password of the Redis server, best set as PANCHANGAM_REDIS_PASSWORD")
+	redisDB := flag.Int("redis-db", 0, "Redis database of the redis cache backend")
+	precompute := flag.String("precompute", "", "Comma separated popular locations whose panchangams are kept computed: city names, e.g. Chennai, or latitude/longitude pairs, e.g. 17.385/78.4867")
+	precomputeDays := flag.Int("precompute-days", ps.DefaultPrecomputeWindow, "Days before and after today precomputed for each popular location")
+	precomputeInterval := flag.Duration("precompute-interval", ps.DefaultPrecomputeInterval, "Time between precomputation passes, which recompute the panchangams expired from the cache")
+	gatewayCacheEntries := flag.Int("gateway-cache-entries", 10000, "Most responses held by the in-process cache of the gateway (0 disables it)")
+	gatewayCacheTTL := flag.Duration("gateway-cache-ttl", gateway.DefaultResponseTTL, "Time the gateway caches responses, and lets its clients cache them")
+	publicURL := flag.String("public-url", "", "URL the gateway is reached at by its clients, e.g. https://panchangam.example.com, which the links of its feeds start with (default the scheme and host of each request)")
+	shadowPercent := flag.Float64("shadow-percent", 1, "Percentage of gateway requests mirrored to the canary backend")
+	grpcAddr := flag.String("grpc-addr", ":50051", "Comma separated addresses the gRPC server listens on, e.g. 0.0.0.0:50051,[::]:50051")
+	httpAddr := flag.String("http-addr", ":8080", "Comma separated addresses the JSON gateway listens on, e.g. [::1]:8080")
+	debugAddr := flag.String("debug-addr", "", "Loopback address serving pprof, expvar, goroutine dumps and recorded errors of the server and gateway, e.g. localhost:6060 (default none)")
+	maxConns := flag.Int("max-conns", 0, "Most open connections per listener; further clients wait (0 for no limit)")
+	keepaliveTime := flag.Duration("keepalive-time", 2*time.Hour, "Idle time after which the gRPC server pings a client")
+	keepaliveTimeout := flag.Duration("keepalive-timeout", 20*time.Second, "Time the gRPC server waits for a ping reply before closing the connection")
+	keepaliveMinTime := flag.Duration("keepalive-min-time", 5*time.Minute, "Shortest interval between client pings; connections pinging more often are closed")
+	maxConnIdle := flag.Duration("max-conn-idle", 0, "Time after which idle gRPC connections are closed (0 keeps them open)")
+	httpIdleTimeout := flag.Duration("http-idle-timeout", 2*time.Minute, "Time after which idle gateway keep-alive connections are closed")
+	chaosPercent := flag.Float64("chaos", 0, "Percentage of requests failed with an injected Internal error, for testing clients against a failing server")
+	tlsCert := flag.String("tls-cert", "", "PEM certificate the gRPC server and the gateway present; with -tls-key both serve TLS")
+	tlsKey := flag.String("tls-key", "", "PEM private key of -tls-cert")
+	tlsClientCA := flag.String("tls-client-ca", "", "PEM CA certificates signing the client certificates; when set every client needs one (mutual TLS)")
+	tlsCA := flag.String("tls-ca", "", "PEM CA certificates the gateway verifies the gRPC server and the canary against (default the system CAs)")
+	chaosLatency := flag.Duration("chaos-latency", 0, "Longest random delay injected before each request, for testing (0 injects none)")
+	chaosSeed := flag.Int64("chaos-seed", 0, "Seed of the random faults and delays injected, so that tests see the same ones in every run (default seeded randomly)")
+	environment := flag.String("environment", observability.Development, "Deployment environment selecting the default trace sampling: development, staging or production")
+	traceSampling := flag.String("trace-sampling", "", "Trace sampling overriding that of -environment: always, never, ratio:RATIO, parent:RATIO or tail:RATIO[:SLOW], e.g. tail:0.01:500ms")
+	coarseTracing := flag.Bool("coarse-tracing", false, "Trace each request with a single span, recording the steps of its calculations as attributes rather than spans")
+	logLevel := flag.String("log-level", "info", "Minimum level of the records logged: debug, info, warn or error; operators may change it with client admin log-level")
+	// Flags may also be set in the server section of the configuration
+	// file or as PANCHANGAM_ environment variables, e.g. PANCHANGAM_CHAOS.
+	if err := config.Parse(flag.CommandLine, os.Args[1:], "server"); err != nil {
+		logger.With("error", err).Error("Failed to load configuration:")
+		return
+	}
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(*logLevel)); err != nil {
+		logger.With("error", err).Error("Failed to parse log level:")
+		return
+	}
+	log.SetLevel(level)
+
+	sampling, err := observability.EnvironmentSampling(*environment)
+	if err != nil {
+		logger.With("error", err).Error("Failed to configure trace sampling:")
+		return
+	}
+	if *traceSampling != "" {
+		if sampling, err = observability.ParseSampling(*traceSampling); err != nil {
+			logger.With("error", err).Error("Failed to configure trace sampling:")
+			return
+		}
+	}
+	logger.Info("Sampling traces", "environment", *environment, "sampling", sampling.String(), "coarse", *coarseTracing)
+
 	// Step 1: Initialize OpenTelemetry
 	// Set up OpenTelemetry.
-	o, err := observability.NewObserver("localhost:4317")
+	o, err := observability.NewObserver("localhost:4317", observability.WithSampling(sampling),
+		observability.WithCoarseTracing(*coarseTracing))
 	defer o.Shutdown(context.Background())
 
-	// Create a listener on TCP port 50051
-	listener, err := net.Listen("tcp", ":50051")
+	grpcListeners, err := listenAll(*grpcAddr, *maxConns)
 	if err != nil {
 		logger.With("error", err).Error("Failed to listen:")
 		return
 	}
-	a := aaa.NewAuth()
-	grpcServer := grpc.NewServer(
-		grpc.ChainUnaryInterceptor(
-			observability.UnaryServerInterceptor(),
-			a.AuthInterceptor(),
-			a.AccountingInterceptor(),
-		),
-	)
+	httpListeners, err := listenAll(*httpAddr, *maxConns)
+	if err != nil {
+		logger.With("error", err).Error("Failed to listen:")
+		return
+	}
+	if *debugAddr != "" {
+		l, err := debug.Listen(*debugAddr)
+		if err != nil {
+			logger.With("error", err).Error("Failed to listen:")
+			return
+		}
+		debugServer := debug.Server()
+		defer debugServer.Close()
+		logger.Info("Debug endpoints started on", "addr", l.Addr().String())
+		go debugServer.Serve(l)
+	}
+	authOpts := authOptions()
+	switch {
+	case *apiKeysDB != "":
+		db, err := openDatabase(*apiKeysDriver, *apiKeysDB)
+		if err != nil {
+			logger.With("error", err).Error("Failed to open API key database:")
+			return
+		}
+		defer db.Close()
+		keys, err := aaa.NewSQLKeyStore(context.Background(), db)
+		if err != nil {
+			logger.With("error", err).Error("Failed to open API key database:")
+			return
+		}
+		authOpts = append(authOpts, aaa.WithKeyStore(keys))
+	case *apiKeys != "":
+		keys, err := aaa.OpenKeyStore(*apiKeys)
+		if err != nil {
+			logger.With("error", err).Error("Failed to open API keys:")
+			return
+		}
+		authOpts = append(authOpts, aaa.WithKeyStore(keys))
+	}
+	var usageStore aaa.UsageStore
+	switch {
+	case *usageDB != "":
+		db, err := openDatabase(*usageDriver, *usageDB)
+		if err != nil {
+			logger.With("error", err).Error("Failed to open usage database:")
+			return
+		}
+		defer db.Close()
+		if usageStore, err = aaa.NewSQLUsageStore(context.Background(), db); err != nil {
+			logger.With("error", err).Error("Failed to open usage database:")
+			return
+		}
+	case *usageFile != "":
+		if usageStore, err = aaa.OpenUsageStore(*usageFile); err != nil {
+			logger.With("error", err).Error("Failed to open usage file:")
+			return
+		}
+	}
+	var usage *aaa.Usage
+	if usageStore != nil {
+		usage = aaa.NewUsage(usageStore)
+		authOpts = append(authOpts, aaa.WithUsage(usage))
+	}
+	a := aaa.NewAuth(authOpts...)
+	var auditStore audit.Store
+	switch {
+	case *auditDB != "":
+		db, err := openDatabase(*auditDriver, *auditDB)
+		if err != nil {
+			logger.With("error", err).Error("Failed to open audit database:")
+			return
+		}
+		defer db.Close()
+		if auditStore, err = audit.NewSQLStore(context.Background(), db); err != nil {
+			logger.With("error", err).Error("Failed to open audit database:")
+			return
+		}
+	case *auditFile != "":
+		if auditStore, err = audit.OpenStore(*auditFile); err != nil {
+			logger.With("error", err).Error("Failed to open audit file:")
+			return
+		}
+	}
+	var auditLog *audit.Log
+	if auditStore != nil {
+		auditLog = audit.NewLog(auditStore,
+			audit.WithProvider(*ephemerisProvider),
+			audit.WithRetention(audit.Retention{MaxAge: *auditMaxAge, MaxRecords: *auditMaxRecords}),
+			audit.WithMethods(ps.CalculationMethods...))
+	}
+	cacheOpts := []cache.Option{cache.WithTTL(*cacheTTL), cache.WithVersion(*cacheVersion)}
+	switch *cacheBackend {
+	case "":
+	case "memory":
+		cacheOpts = append(cacheOpts, cache.WithBackend(cache.NewMemoryBackend(*cacheEntries), ps.PanchangamCodec))
+	case "redis":
+		backend := cache.NewRedisBackend(*redisAddr, cache.WithRedisPassword(*redisPassword), cache.WithRedisDB(*redisDB))
+		defer backend.Close()
+		cacheOpts = append(cacheOpts, cache.WithBackend(backend, ps.PanchangamCodec))
+		logger.Info("Sharing computed panchangams through Redis", "addr", *redisAddr, "version", *cacheVersion)
+	default:
+		logger.Error("Unknown cache backend: expected memory or redis", "backend", *cacheBackend)
+		return
+	}
+	opts := []ps.Option{ps.WithCacheOptions(cacheOpts...)}
+	if usage != nil {
+		opts = append(opts, ps.WithUsage(usage))
+	}
+	// The webhook dispatcher finds the festivals the server serves.
+	festivals := festival.NewCatalogs()
+	if *festivalsDir != "" {
+		definitions, err := festival.ReadDir(*festivalsDir)
+		if err != nil {
+			logger.With("error", err).Error("Failed to load festival definitions:")
+			return
+		}
+		festivals = festival.NewCatalogs(festival.WithDefinitions(definitions...))
+	}
+	opts = append(opts, ps.WithFestivalRules(festivals))
+	var webhooks *webhook.Store
+	if *webhooksFile != "" {
+		if webhooks, err = webhook.OpenStore(*webhooksFile, webhook.WithMaxSubscriptions(*webhooksMax)); err != nil {
+			logger.With("error", err).Error("Failed to open webhooks file:")
+			return
+		}
+		opts = append(opts, ps.WithWebhooks(webhooks))
+	}
+	var notifier *notify.Service
+	if *notifyConfig != "" {
+		cfg, err := notify.LoadConfig(*notifyConfig)
+		if err != nil {
+			logger.With("error", err).Error("Failed to load notify configuration:")
+			return
+		}
+		if notifier, err = notify.NewService(cfg, festivals); err != nil {
+			logger.With("error", err).Error("Failed to configure notifications:")
+			return
+		}
+	}
+	if *muhurtaDir != "" {
+		packs, err := muhurta.LoadDir(*muhurtaDir)
+		if err != nil {
+			logger.With("error", err).Error("Failed to load muhurta rule packs:")
+			return
+		}
+		opts = append(opts, ps.WithMuhurtaPacks(packs))
+	}
+	if *localesDir != "" {
+		catalogs, err := i18n.LoadDir(*localesDir)
+		if err != nil {
+			logger.With("error", err).Error("Failed to load name catalogs:")
+			return
+		}
+		opts = append(opts, ps.WithCatalogs(catalogs))
+	}
+	if *ephemerisPath != "" {
+		if swissProvider == nil {
+			logger.Error("-ephemeris-path needs a server built with -tags swisseph")
+			return
+		}
+		ephemeris.Register(swissProvider(*ephemerisPath))
+	}
+	if *ephemerisProvider != "builtin" {
+		p, err := ephemeris.Lookup(*ephemerisProvider)
+		if err != nil {
+			logger.With("error", err).Error("Failed to find ephemeris provider:")
+			return
+		}
+		opts = append(opts, ps.WithEphemeris(p))
+	}
+	if *precompute != "" {
+		locations, err := parsePrecomputeLocations(*precompute)
+		if err != nil {
+			logger.With("error", err).Error("Failed to parse precompute locations:")
+			return
+		}
+		opts = append(opts, ps.WithPrecompute(locations, *precomputeDays, *precomputeInterval))
+	}
+	pService := ps.NewPanchangamServer(opts...)
+	// Export the request, cache and shadow comparison metrics that the
+	// rules of `client admin alerts export` are based on.
+	mp := observability.InitMeterProvider()
+	defer mp.Shutdown(context.Background())
+	interceptors := []grpc.UnaryServerInterceptor{log.RequestIDInterceptor(), observability.UnaryServerInterceptor(), observability.MetricsInterceptor()}
+	// Streams, such as WatchTransitions, are authenticated and rate limited
+	// once when they start.
+	streamInterceptors := []grpc.StreamServerInterceptor{log.StreamRequestIDInterceptor()}
+	interceptors = append(interceptors, a.AuthInterceptor())
+	streamInterceptors = append(streamInterceptors, a.StreamAuthInterceptor())
+	if *rateLimit > 0 {
+		limits, err := aaa.ParseClientLimits(*clientLimits)
+		if err != nil {
+			logger.With("error", err).Error("Failed to parse client limits:")
+			return
+		}
+		limiter := aaa.NewRateLimiter(aaa.RateLimit{Rate: *rateLimit, Burst: *rateBurst}, aaa.WithClientLimits(limits))
+		// Clients are limited by the keys they were authenticated with, and
+		// their requests over the limit rejected before any other work.
+		interceptors = append(interceptors, limiter.UnaryInterceptor())
+		streamInterceptors = append(streamInterceptors, limiter.StreamInterceptor())
+	}
+	interceptors = append(interceptors, a.AccountingInterceptor())
+	requestLimiter := aaa.NewRequestLimiter(aaa.Limits{MaxDays: *maxDays, MaxBatch: *maxBatch, MaxLocations: *maxLocations})
+	interceptors = append(interceptors, requestLimiter.UnaryInterceptor(), pService.ValidationInterceptor())
+	streamInterceptors = append(streamInterceptors, pService.StreamValidationInterceptor())
+	if auditLog != nil {
+		// Only the valid requests reach a calculation to audit.
+		interceptors = append(interceptors, auditLog.UnaryInterceptor())
+	}
+	chaosOpts := []chaos.Option{chaos.WithLatency(*chaosLatency)}
+	if *chaosSeed != 0 {
+		chaosOpts = append(chaosOpts, chaos.WithSeed(*chaosSeed))
+	}
+	if injector := chaos.NewInjector(*chaosPercent, chaosOpts...); injector.Enabled() {
+		logger.Warn("Injecting faults into requests", "percent", *chaosPercent, "latency", *chaosLatency)
+		interceptors = append(interceptors, injector.UnaryInterceptor())
+	}
+	// The gateway and the canary are reached in plaintext, unless the
+	// servers use TLS.
+	serverOpts := []grpc.ServerOption{}
+	clientCreds := insecure.NewCredentials()
+	var tlsConfig *tls.Config
+	if *tlsCert != "" || *tlsKey != "" {
+		tlsConfig, err = aaa.ServerTLS(*tlsCert, *tlsKey, *tlsClientCA)
+		if err != nil {
+			logger.With("error", err).Error("Failed to configure TLS:")
+			return
+		}
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(tlsConfig)))
+		// Under mutual TLS the gateway presents the certificate of the
+		// server, which must then also be valid for client authentication.
+		clientConfig, err := aaa.ClientTLS(*tlsCA, *tlsCert, *tlsKey)
+		if err != nil {
+			logger.With("error", err).Error("Failed to configure TLS:")
+			return
+		}
+		clientCreds = credentials.NewTLS(clientConfig)
+		logger.Info("Serving TLS", "mutual", *tlsClientCA != "")
+	} else if *tlsClientCA != "" {
+		logger.Error("Mutual TLS needs -tls-cert and -tls-key")
+		return
+	}
+	grpcServer := grpc.NewServer(append(serverOpts,
+		grpc.ChainUnaryInterceptor(interceptors...),
+		grpc.ChainStreamInterceptor(streamInterceptors...),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:              *keepaliveTime,
+			Timeout:           *keepaliveTimeout,
+			MaxConnectionIdle: *maxConnIdle,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             *keepaliveMinTime,
+			PermitWithoutStream: true,
+		}),
+	)...)
 
-	pService := ps.NewPanchangamServer()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go pService.Precompute(ctx)
+	if usage != nil {
+		go usage.Run(ctx, *usageFlush)
+	}
+	if auditLog != nil {
+		go auditLog.Run(ctx, *auditFlush)
+	}
+	if webhooks != nil {
+		go webhook.NewDispatcher(webhooks, festivals).Run(ctx)
+	}
+	if notifier != nil {
+		go notifier.Run(ctx)
+	}
 	ppb.RegisterPanchangamServer(grpcServer, pService)
+	pbv2.RegisterPanchangamServer(grpcServer, ps.NewV2Server(pService))
+	// Without API keys anyone could call the Admin service, so it is only
+	// served when they are required.
+	if *apiKeys != "" || *apiKeysDB != "" {
+		ppb.RegisterAdminServer(grpcServer, ps.NewAdminServer(pService, ps.WithFlags(flag.CommandLine)))
+	} else {
+		logger.Info("Not serving the Admin service without -api-keys or -api-keys-db")
+	}
+	healthServer := health.NewServer()
+	healthServer.SetServingStatus(ppb.Panchangam_ServiceDesc.ServiceName, healthpb.HealthCheckResponse_SERVING)
+	healthpb.RegisterHealthServer(grpcServer, healthServer)
 
-	logger.Info("Server started on", "port", "50051")
 	// Start serving requests
-	srvErr := make(chan error, 1)
-	go func() {
-		srvErr <- grpcServer.Serve(listener)
-	}()
+	srvErr := make(chan error, len(grpcListeners)+len(httpListeners))
+	for _, l := range grpcListeners {
+		logger.Info("Server started on", "addr", l.Addr().String())
+		go func(l net.Listener) {
+			srvErr <- grpcServer.Serve(l)
+		}(l)
+	}
+
+	// Serve the JSON gateway, forwarding to the gRPC server above.
+	conn, err := grpc.NewClient(dialAddr(grpcListeners[0]), grpc.WithTransportCredentials(clientCreds))
+	if err != nil {
+		logger.With("error", err).Error("Failed to create gateway client:")
+		return
+	}
+	defer conn.Close()
+	var gatewayOpts []gateway.Option
+	var responses cache.Backend
+	if *gatewayCacheEntries > 0 {
+		responses = cache.NewMemoryBackend(*gatewayCacheEntries)
+	}
+	gatewayOpts = append(gatewayOpts, gateway.WithResponseCache(responses, *gatewayCacheTTL), gateway.WithMaxBatch(*maxBatch))
+	if *publicURL != "" {
+		u, err := url.Parse(*publicURL)
+		if err == nil && (u.Scheme != "http" && u.Scheme != "https" || u.Host == "") {
+			err = fmt.Errorf("%q is not an http or https URL", *publicURL)
+		}
+		if err != nil {
+			logger.With("error", err).Error("Failed to parse public URL:")
+			return
+		}
+		gatewayOpts = append(gatewayOpts, gateway.WithPublicURL(u))
+	}
+	if *canaryAddr != "" {
+		canaryConn, err := grpc.NewClient(*canaryAddr, grpc.WithTransportCredentials(clientCreds))
+		if err != nil {
+			logger.With("error", err).Error("Failed to create canary client:")
+			return
+		}
+		defer canaryConn.Close()
+		gatewayOpts = append(gatewayOpts, gateway.WithShadow(ppb.NewPanchangamClient(canaryConn), *shadowPercent))
+		logger.Info("Shadowing gateway traffic to", "canary", *canaryAddr, "percent", *shadowPercent)
+	}
+	httpServer := &http.Server{
+		Handler:           gateway.NewGateway(ppb.NewPanchangamClient(conn), gatewayOpts...),
+		ReadHeaderTimeout: 10 * time.Second,
+		IdleTimeout:       *httpIdleTimeout,
+		TLSConfig:         tlsConfig,
+	}
+	for _, l := range httpListeners {
+		logger.Info("Gateway started on", "addr", l.Addr().String())
+		go func(l net.Listener) {
+			if tlsConfig != nil {
+				// The certificate is taken from the TLS configuration.
+				srvErr <- httpServer.ServeTLS(l, "", "")
+				return
+			}
+			srvErr <- httpServer.Serve(l)
+		}(l)
+	}
 	// Wait for interruption.
 	select {
 	case err = <-srvErr:
 		// Error when starting HTTP server.
+		logger.With("error", err).Error("Server stopped:")
+		healthServer.Shutdown()
+		httpServer.Close()
 		grpcServer.Stop()
+		if usage != nil {
+			if err := usage.Flush(context.Background()); err != nil {
+				logger.With("error", err).Error("Failed to flush usage:")
+			}
+		}
+		if auditLog != nil {
+			if err := auditLog.Flush(context.Background()); err != nil {
+				logger.With("error", err).Error("Failed to flush audit log:")
+			}
+		}
 		return
 	}
 }
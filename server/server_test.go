@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/observability"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuthOptions(t *testing.T) {
+	if _, err := observability.NewObserver(""); err != nil {
+		t.Fatalf("NewObserver() error = %v", err)
+	}
+	store, err := aaa.OpenKeyStore(filepath.Join(t.TempDir(), "keys.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	secrets := map[aaa.Scope]string{}
+	for _, scope := range []aaa.Scope{aaa.ReadScope, aaa.WriteScope, aaa.AdminScope} {
+		if secrets[scope], _, err = store.Create(string(scope), scope); err != nil {
+			t.Fatal(err)
+		}
+	}
+	a := aaa.NewAuth(append(authOptions(), aaa.WithKeyStore(store))...)
+	ok := func(ctx context.Context, req interface{}) (interface{}, error) { return "ok", nil }
+
+	for _, tt := range []struct {
+		method string
+		scope  aaa.Scope
+		want   codes.Code
+	}{
+		{ppb.Panchangam_Get_FullMethodName, aaa.ReadScope, codes.OK},
+		{ppb.Panchangam_ListSubscriptions_FullMethodName, aaa.ReadScope, codes.OK},
+		// Only keys with the write scope subscribe to webhooks.
+		{ppb.Panchangam_CreateSubscription_FullMethodName, aaa.ReadScope, codes.PermissionDenied},
+		{ppb.Panchangam_DeleteSubscription_FullMethodName, aaa.ReadScope, codes.PermissionDenied},
+		{ppb.Panchangam_CreateSubscription_FullMethodName, aaa.WriteScope, codes.OK},
+		{ppb.Panchangam_DeleteSubscription_FullMethodName, aaa.WriteScope, codes.OK},
+		{ppb.Panchangam_CreateSubscription_FullMethodName, aaa.AdminScope, codes.OK},
+		{ppb.Panchangam_GetSystemInfo_FullMethodName, aaa.WriteScope, codes.PermissionDenied},
+		{ppb.Panchangam_GetSystemInfo_FullMethodName, aaa.AdminScope, codes.OK},
+	} {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs(aaa.APIKeyHeader, secrets[tt.scope]))
+		_, err := a.AuthInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, ok)
+		if status.Code(err) != tt.want {
+			t.Errorf("%s with a %s key error = %v, want %s", tt.method, tt.scope, err, tt.want)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+//go:build sqlite
+
+// The sqlite3 driver of -api-keys-driver, -usage-driver and -audit-driver
+// links SQLite with cgo, and so needs a C compiler. Build with:
+//
+//	go build -tags sqlite ./server
+
+package main
+
+import _ "github.com/mattn/go-sqlite3"
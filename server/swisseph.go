@@ -0,0 +1,14 @@
+//go:build swisseph
+
+// The swiss provider of -ephemeris links the Swiss Ephemeris C library,
+// which must be installed with its headers. Build with:
+//
+//	go build -tags swisseph ./server
+
+package main
+
+import "github.com/naren-m/panchangam/ephemeris"
+
+func init() {
+	swissProvider = func(path string) ephemeris.Provider { return ephemeris.NewSwissProvider(path) }
+}
@@ -0,0 +1,193 @@
+package panchangam
+
+import (
+	"context"
+	"flag"
+	"log/slog"
+	"strings"
+
+	"github.com/naren-m/panchangam/ephemeris"
+	"github.com/naren-m/panchangam/log"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// redacted replaces the values of secret flags in GetConfig.
+const redacted = "REDACTED"
+
+// AdminServer serves the Admin service, with which operators flush the
+// caches, disable ephemeris providers and change the log level of a running
+// PanchangamServer. It has no access control of its own: the server must
+// require the admin scope for its RPCs, e.g. with aaa.WithServiceScope.
+type AdminServer struct {
+	*PanchangamServer
+	flags *flag.FlagSet
+	ppb.UnimplementedAdminServer
+}
+
+// AdminOption configures an AdminServer.
+type AdminOption func(*AdminServer)
+
+// WithFlags sets the flags GetConfig dumps, usually flag.CommandLine once
+// parsed. Without it GetConfig reports no flags.
+func WithFlags(fs *flag.FlagSet) AdminOption {
+	return func(s *AdminServer) {
+		s.flags = fs
+	}
+}
+
+// NewAdminServer returns an Admin server for s.
+func NewAdminServer(s *PanchangamServer, opts ...AdminOption) *AdminServer {
+	a := &AdminServer{PanchangamServer: s}
+	for _, opt := range opts {
+		opt(a)
+	}
+	return a
+}
+
+// ListCaches reports the statistics of the caches of the server.
+func (s *AdminServer) ListCaches(ctx context.Context, req *ppb.ListCachesRequest) (*ppb.ListCachesResponse, error) {
+	_, span := s.observer.CreateSpan(ctx, "ListCaches")
+	defer span.End()
+
+	resp := &ppb.ListCachesResponse{}
+	for _, c := range s.caches() {
+		resp.Caches = append(resp.Caches, cacheStats(c))
+	}
+	return resp, nil
+}
+
+// FlushCache empties the cache named in the request, or every cache when
+// none is, along with the entries they share through a cache backend.
+func (s *AdminServer) FlushCache(ctx context.Context, req *ppb.FlushCacheRequest) (*ppb.FlushCacheResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "FlushCache")
+	defer span.End()
+
+	resp := &ppb.FlushCacheResponse{}
+	for _, c := range s.caches() {
+		if req.Name != "" && c.Name() != req.Name {
+			continue
+		}
+		if err := c.Purge(ctx); err != nil {
+			return nil, status.Errorf(codes.Unavailable, "flushing cache %s: %v", c.Name(), err)
+		}
+		logger.InfoContext(ctx, "Flushed cache", "cache", c.Name())
+		resp.Flushed = append(resp.Flushed, c.Name())
+	}
+	if len(resp.Flushed) == 0 {
+		return nil, status.Errorf(codes.NotFound, "no cache named %q", req.Name)
+	}
+	return resp, nil
+}
+
+// ListProviders reports the registered ephemeris providers and whether
+// they are enabled.
+func (s *AdminServer) ListProviders(ctx context.Context, req *ppb.ListProvidersRequest) (*ppb.ListProvidersResponse, error) {
+	_, span := s.observer.CreateSpan(ctx, "ListProviders")
+	defer span.End()
+
+	resp := &ppb.ListProvidersResponse{}
+	for _, p := range s.providers() {
+		resp.Providers = append(resp.Providers, s.providerState(p.Name()))
+	}
+	return resp, nil
+}
+
+// SetProviderEnabled enables or disables an ephemeris provider. While the
+// provider of the positions of the moon and sun is disabled the builtin
+// provider computes them; panchangams already cached are kept until
+// flushed. The builtin provider cannot be disabled.
+func (s *AdminServer) SetProviderEnabled(ctx context.Context, req *ppb.SetProviderEnabledRequest) (*ppb.EphemerisProvider, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "SetProviderEnabled")
+	defer span.End()
+
+	found := false
+	for _, p := range s.providers() {
+		found = found || p.Name() == req.Name
+	}
+	if !found && req.Name != s.positions.Name() {
+		return nil, status.Errorf(codes.NotFound, "unknown ephemeris provider %q", req.Name)
+	}
+	if !req.Enabled && req.Name == (ephemeris.BuiltinProvider{}).Name() {
+		return nil, status.Error(codes.FailedPrecondition, "the builtin ephemeris provider cannot be disabled")
+	}
+	s.disabledMu.Lock()
+	if req.Enabled {
+		delete(s.disabled, req.Name)
+	} else {
+		s.disabled[req.Name] = true
+	}
+	s.disabledMu.Unlock()
+	// The health of a provider enabled again is checked afresh.
+	if err := s.providerChecks.Invalidate(ctx, req.Name); err != nil {
+		logger.WarnContext(ctx, "Failed to invalidate provider health", "provider", req.Name, "error", err)
+	}
+	logger.InfoContext(ctx, "Set ephemeris provider", "provider", req.Name, "enabled", req.Enabled)
+	return s.providerState(req.Name), nil
+}
+
+func (s *AdminServer) providerState(name string) *ppb.EphemerisProvider {
+	return &ppb.EphemerisProvider{
+		Name:    name,
+		Enabled: s.providerEnabled(name),
+		Active:  s.ephemerisProvider().Name() == name,
+	}
+}
+
+// SetLogLevel changes the minimum level of the records the server logs,
+// e.g. to debug while investigating a problem.
+func (s *AdminServer) SetLogLevel(ctx context.Context, req *ppb.SetLogLevelRequest) (*ppb.SetLogLevelResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "SetLogLevel")
+	defer span.End()
+
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(req.Level)); err != nil {
+		return nil, fieldErrorf("level", "unknown log level %q: expected debug, info, warn or error", req.Level)
+	}
+	previous := log.Level()
+	log.SetLevel(level)
+	logger.InfoContext(ctx, "Set log level", "level", level, "previous", previous)
+	return &ppb.SetLogLevelResponse{Level: level.String(), PreviousLevel: previous.String()}, nil
+}
+
+// GetConfig dumps the flags the server runs with, whether set on the
+// command line, in the configuration file or in the environment. The
+// values of flags naming a password, secret or token are redacted.
+func (s *AdminServer) GetConfig(ctx context.Context, req *ppb.GetConfigRequest) (*ppb.Config, error) {
+	_, span := s.observer.CreateSpan(ctx, "GetConfig")
+	defer span.End()
+
+	config := &ppb.Config{}
+	if s.flags == nil {
+		return config, nil
+	}
+	// VisitAll visits the flags in lexicographical order.
+	s.flags.VisitAll(func(f *flag.Flag) {
+		value, def := f.Value.String(), f.DefValue
+		if secretFlag(f.Name) {
+			value, def = redact(value), redact(def)
+		}
+		config.Flags = append(config.Flags, &ppb.ConfigFlag{Name: f.Name, Value: value, DefaultValue: def, Usage: f.Usage})
+	})
+	return config, nil
+}
+
+// secretFlag reports whether the flag named name holds a secret.
+func secretFlag(name string) bool {
+	for _, word := range []string{"password", "secret", "token"} {
+		if strings.Contains(name, word) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact redacts a secret, leaving empty values as they are to show that
+// no secret is set.
+func redact(value string) string {
+	if value == "" {
+		return ""
+	}
+	return redacted
+}
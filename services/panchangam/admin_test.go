@@ -0,0 +1,138 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log/slog"
+	"testing"
+
+	"github.com/naren-m/panchangam/ephemeris"
+	"github.com/naren-m/panchangam/ephemeris/ephemeristest"
+	"github.com/naren-m/panchangam/log"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestAdminCaches(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+	admin := NewAdminServer(s)
+	if _, err := s.Get(ctx, &ppb.GetPanchangamRequest{Date: "2024-04-09", Latitude: 17.385, Longitude: 78.4867}); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	list, err := admin.ListCaches(ctx, &ppb.ListCachesRequest{})
+	if err != nil {
+		t.Fatalf("ListCaches() error = %v", err)
+	}
+	if len(list.Caches) != 2 || list.Caches[0].Name != "panchangam" || list.Caches[0].Entries != 1 {
+		t.Errorf("ListCaches() = %v, want the panchangam cache with an entry", list.Caches)
+	}
+
+	flushed, err := admin.FlushCache(ctx, &ppb.FlushCacheRequest{Name: "panchangam"})
+	if err != nil {
+		t.Fatalf("FlushCache() error = %v", err)
+	}
+	if len(flushed.Flushed) != 1 || s.panchangam.Len() != 0 {
+		t.Errorf("FlushCache() = %v leaving %d entries, want the panchangam cache emptied", flushed.Flushed, s.panchangam.Len())
+	}
+	if all, err := admin.FlushCache(ctx, &ppb.FlushCacheRequest{}); err != nil || len(all.Flushed) != 2 {
+		t.Errorf("FlushCache() of every cache = %v, %v, want both caches", all, err)
+	}
+	if _, err := admin.FlushCache(ctx, &ppb.FlushCacheRequest{Name: "nope"}); status.Code(err) != codes.NotFound {
+		t.Errorf("FlushCache() of an unknown cache error = %v, want NotFound", err)
+	}
+}
+
+func TestAdminProviders(t *testing.T) {
+	ctx := context.Background()
+	s := newTestServer(t)
+	broken := ephemeristest.New("broken")
+	broken.Fail(errors.New("no data files"))
+	s.positions = broken
+	s.providers = func() []ephemeris.Provider {
+		return []ephemeris.Provider{ephemeris.BuiltinProvider{}, broken}
+	}
+	admin := NewAdminServer(s)
+	req := &ppb.GetPanchangamRequest{Date: "2024-04-09", Latitude: 17.385, Longitude: 78.4867}
+	if _, err := s.Get(ctx, req); status.Code(err) != codes.Unavailable {
+		t.Fatalf("Get() with a broken provider error = %v, want Unavailable", err)
+	}
+
+	p, err := admin.SetProviderEnabled(ctx, &ppb.SetProviderEnabledRequest{Name: "broken"})
+	if err != nil {
+		t.Fatalf("SetProviderEnabled() error = %v", err)
+	}
+	if p.Enabled || p.Active {
+		t.Errorf("SetProviderEnabled() = %v, want broken disabled and inactive", p)
+	}
+	// The builtin provider stands in for the disabled one.
+	if _, err := s.Get(ctx, req); err != nil {
+		t.Errorf("Get() with the provider disabled error = %v", err)
+	}
+	info, err := s.GetSystemInfo(ctx, &ppb.GetSystemInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetSystemInfo() error = %v", err)
+	}
+	if h := info.EphemerisProviders[1]; !h.Disabled || h.CheckedTime != "" {
+		t.Errorf("EphemerisProviders[1] = %v, want broken disabled and not checked", h)
+	}
+	list, err := admin.ListProviders(ctx, &ppb.ListProvidersRequest{})
+	if err != nil {
+		t.Fatalf("ListProviders() error = %v", err)
+	}
+	if got := list.Providers; len(got) != 2 || !got[0].Enabled || !got[0].Active || got[1].Enabled {
+		t.Errorf("ListProviders() = %v, want builtin active and broken disabled", got)
+	}
+
+	if p, err := admin.SetProviderEnabled(ctx, &ppb.SetProviderEnabledRequest{Name: "broken", Enabled: true}); err != nil || !p.Active {
+		t.Errorf("SetProviderEnabled() enabling = %v, %v, want broken active", p, err)
+	}
+	if _, err := admin.SetProviderEnabled(ctx, &ppb.SetProviderEnabledRequest{Name: "builtin"}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("SetProviderEnabled() disabling builtin error = %v, want FailedPrecondition", err)
+	}
+	if _, err := admin.SetProviderEnabled(ctx, &ppb.SetProviderEnabledRequest{Name: "nope"}); status.Code(err) != codes.NotFound {
+		t.Errorf("SetProviderEnabled() of an unknown provider error = %v, want NotFound", err)
+	}
+}
+
+func TestAdminLogLevel(t *testing.T) {
+	defer log.SetLevel(log.Level())
+	log.SetLevel(slog.LevelInfo)
+	admin := NewAdminServer(newTestServer(t))
+
+	resp, err := admin.SetLogLevel(context.Background(), &ppb.SetLogLevelRequest{Level: "debug"})
+	if err != nil {
+		t.Fatalf("SetLogLevel() error = %v", err)
+	}
+	if resp.Level != "DEBUG" || resp.PreviousLevel != "INFO" || log.Level() != slog.LevelDebug {
+		t.Errorf("SetLogLevel() = %v, want DEBUG after INFO", resp)
+	}
+	if _, err := admin.SetLogLevel(context.Background(), &ppb.SetLogLevelRequest{Level: "loud"}); status.Code(err) != codes.InvalidArgument {
+		t.Errorf("SetLogLevel() of an unknown level error = %v, want InvalidArgument", err)
+	}
+}
+
+func TestAdminGetConfig(t *testing.T) {
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	fs.String("http-addr", ":8080", "Gateway address")
+	fs.String("redis-password", "", "Redis password")
+	if err := fs.Parse([]string{"-http-addr", ":9090", "-redis-password", "hunter2"}); err != nil {
+		t.Fatal(err)
+	}
+	admin := NewAdminServer(newTestServer(t), WithFlags(fs))
+
+	config, err := admin.GetConfig(context.Background(), &ppb.GetConfigRequest{})
+	if err != nil {
+		t.Fatalf("GetConfig() error = %v", err)
+	}
+	flags := config.Flags
+	if len(flags) != 2 || flags[0].Name != "http-addr" || flags[0].Value != ":9090" || flags[0].DefaultValue != ":8080" {
+		t.Fatalf("GetConfig() = %v, want http-addr set to :9090", flags)
+	}
+	if flags[1].Value != redacted || flags[1].DefaultValue != "" {
+		t.Errorf("redis-password = %v, want its value redacted", flags[1])
+	}
+}
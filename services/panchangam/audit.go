@@ -0,0 +1,23 @@
+package panchangam
+
+import (
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	pbv2 "github.com/naren-m/panchangam/proto/v2/panchangam"
+)
+
+// CalculationMethods lists the RPCs calculating the panchangam, rather
+// than reporting on the server or managing subscriptions, whose calls an
+// audit.Log records, e.g. audit.WithMethods(CalculationMethods...).
+var CalculationMethods = []string{
+	ppb.Panchangam_Get_FullMethodName,
+	ppb.Panchangam_GetFestivalBundle_FullMethodName,
+	ppb.Panchangam_GetEvents_FullMethodName,
+	ppb.Panchangam_GetMuhurta_FullMethodName,
+	ppb.Panchangam_FindMuhurta_FullMethodName,
+	ppb.Panchangam_GetVratList_FullMethodName,
+	ppb.Panchangam_GetLagnas_FullMethodName,
+	ppb.Panchangam_GetSummary_FullMethodName,
+	ppb.Panchangam_GetNextTransitions_FullMethodName,
+	ppb.Panchangam_FindFestivalDates_FullMethodName,
+	pbv2.Panchangam_Get_FullMethodName,
+}
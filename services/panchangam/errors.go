@@ -0,0 +1,39 @@
+package panchangam
+
+import (
+	"fmt"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// FieldError reports an invalid request field. It converts to an
+// InvalidArgument status carrying a BadRequest detail that names the field,
+// so that clients can point at the offending input.
+type FieldError struct {
+	// Field is the name of the request field, e.g. "end_date".
+	Field       string
+	Description string
+}
+
+// fieldErrorf returns a FieldError for field with a formatted description.
+func fieldErrorf(field, format string, a ...any) *FieldError {
+	return &FieldError{Field: field, Description: fmt.Sprintf(format, a...)}
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": " + e.Description
+}
+
+// GRPCStatus returns the status sent to the client for the error.
+func (e *FieldError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+	detailed, err := st.WithDetails(&errdetails.BadRequest{
+		FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: e.Field, Description: e.Description}},
+	})
+	if err != nil {
+		return st
+	}
+	return detailed
+}
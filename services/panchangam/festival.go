@@ -0,0 +1,350 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/i18n"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PanchangamServer) GetFestivalBundle(ctx context.Context, req *ppb.GetFestivalBundleRequest) (*ppb.FestivalBundle, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetFestivalBundle")
+	defer span.End()
+	logger.InfoContext(ctx, "Received festival bundle request", "year", req.Year, "region", req.Region)
+
+	if req.Year < 1 || req.Year > 9999 {
+		return nil, fieldErrorf("year", "invalid year %d: expected 1 to 9999", req.Year)
+	}
+	tz, err := loadTimezone(timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.festivalRules(ctx, req.Region)
+	if err != nil {
+		return nil, err
+	}
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}
+	events, err := rules.GenerateYear(int(req.Year), req.Region, loc, tz)
+	if errors.Is(err, astronomy.ErrNoSunrise) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to generate festivals", "error", err)
+		return nil, status.Error(codes.Internal, "failed to generate festivals")
+	}
+
+	bundle := &ppb.FestivalBundle{
+		Year:      req.Year,
+		Region:    req.Region,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Timezone:  tz.String(),
+		Festivals: make([]*ppb.Festival, 0, len(events)),
+	}
+	for _, e := range events {
+		bundle.Festivals = append(bundle.Festivals, festivalMessage(e, s.catalogs))
+	}
+	logger.InfoContext(ctx, "Prepared festival bundle", "festivals", len(bundle.Festivals))
+	return bundle, nil
+}
+
+func (s *PanchangamServer) GetVratList(ctx context.Context, req *ppb.GetVratListRequest) (*ppb.VratList, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetVratList")
+	defer span.End()
+	logger.InfoContext(ctx, "Received vrat list request", "year", req.Year, "region", req.Region, "tradition", req.Tradition)
+
+	if req.Year < 1 || req.Year > 9999 {
+		return nil, fieldErrorf("year", "invalid year %d: expected 1 to 9999", req.Year)
+	}
+	tradition, err := festival.ParseTradition(req.Tradition)
+	if err != nil {
+		return nil, fieldErrorf("tradition", "%v", err)
+	}
+	tz, err := loadTimezone(timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.festivalRules(ctx, req.Region)
+	if err != nil {
+		return nil, err
+	}
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}
+	dates, err := rules.VratList(int(req.Year), req.Region, loc, tz, tradition)
+	if errors.Is(err, astronomy.ErrNoSunrise) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to generate vrat list", "error", err)
+		return nil, status.Error(codes.Internal, "failed to generate vrat list")
+	}
+
+	list := &ppb.VratList{
+		Year:      req.Year,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Timezone:  tz.String(),
+		Dates:     make([]*ppb.VratDate, 0, len(dates)),
+		Tradition: string(tradition),
+	}
+	for _, d := range dates {
+		m := &ppb.VratDate{
+			Definition: d.Definition,
+			Names:      localizedNames(s.catalogs.FestivalNames(d.Definition, d.Names)),
+			Date:       d.Date,
+			Weekday:    d.Weekday.String(),
+			Tithi:      d.Tithi,
+			Time:       formatTime(d.Start),
+			Viddha:     d.Viddha,
+		}
+		if !d.ParanaStart.IsZero() {
+			m.ParanaDate = d.ParanaStart.Format(dateLayout)
+			m.ParanaStartTime = d.ParanaStart.Format(timeLayout)
+			m.ParanaEndTime = d.ParanaEnd.Format(timeLayout)
+		}
+		list.Dates = append(list.Dates, m)
+	}
+	logger.InfoContext(ctx, "Prepared vrat list", "dates", len(list.Dates))
+	return list, nil
+}
+
+// maxFestivalYears bounds the range of years of FindFestivalDates requests.
+const maxFestivalYears = 100
+
+func (s *PanchangamServer) FindFestivalDates(ctx context.Context, req *ppb.FindFestivalDatesRequest) (*ppb.FindFestivalDatesResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "FindFestivalDates")
+	defer span.End()
+	logger.InfoContext(ctx, "Received festival dates request", "name", req.Name, "year", req.Year, "end_year", req.EndYear, "region", req.Region)
+
+	if req.Name == "" {
+		return nil, fieldErrorf("name", "missing festival name")
+	}
+	if req.Year < 1 || req.Year > 9999 {
+		return nil, fieldErrorf("year", "invalid year %d: expected 1 to 9999", req.Year)
+	}
+	endYear := req.EndYear
+	if endYear == 0 {
+		endYear = req.Year
+	}
+	if endYear < req.Year || endYear > 9999 {
+		return nil, fieldErrorf("end_year", "invalid end year %d: expected %d to 9999", endYear, req.Year)
+	}
+	if endYear-req.Year >= maxFestivalYears {
+		return nil, fieldErrorf("end_year", "range of %d years is too long: expected at most %d", endYear-req.Year+1, maxFestivalYears)
+	}
+	tz, err := loadTimezone(timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.festivalRules(ctx, req.Region)
+	if err != nil {
+		return nil, err
+	}
+	definition, ok := rules.Lookup(req.Name)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "unknown festival %q", req.Name)
+	}
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}
+	events, err := rules.FindDates(ctx, definition.ID, int(req.Year), int(endYear), req.Region, loc, tz)
+	if errors.Is(err, astronomy.ErrNoSunrise) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to find festival dates", "error", err)
+		return nil, status.Error(codes.Internal, "failed to find festival dates")
+	}
+
+	resp := &ppb.FindFestivalDatesResponse{
+		Definition: definition.ID,
+		Names:      localizedNames(s.catalogs.FestivalNames(definition.ID, definition.Names)),
+		Timezone:   tz.String(),
+		Festivals:  make([]*ppb.Festival, 0, len(events)),
+	}
+	for _, e := range events {
+		resp.Festivals = append(resp.Festivals, festivalMessage(e, s.catalogs))
+	}
+	logger.InfoContext(ctx, "Found festival dates", "definition", definition.ID, "dates", len(resp.Festivals))
+	return resp, nil
+}
+
+// festivalRules returns the festival definitions of region.
+func (s *PanchangamServer) festivalRules(ctx context.Context, region string) (*festival.RuleSet, error) {
+	rules, err := s.festivals.ForRegion(region)
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to load festival definitions", "region", region, "error", err)
+		return nil, status.Error(codes.Internal, "failed to load festival definitions")
+	}
+	return rules, nil
+}
+
+// maxEventDays bounds the range of GetEvents and GetMuhurta requests.
+const maxEventDays = 366
+
+func (s *PanchangamServer) GetEvents(ctx context.Context, req *ppb.GetEventsRequest) (*ppb.GetEventsResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetEvents")
+	defer span.End()
+	logger.InfoContext(ctx, "Received events request", "date", req.Date, "end_date", req.EndDate, "days", req.Days, "region", req.Region, "type", req.Type)
+
+	first, last, err := dateRange(req.Date, req.EndDate, req.Days, timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := s.festivalRules(ctx, req.Region)
+	if err != nil {
+		return nil, err
+	}
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}
+	events, err := rules.GenerateContext(ctx, first, last, req.Region, loc)
+	if errors.Is(err, astronomy.ErrNoSunrise) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to generate events", "error", err)
+		return nil, status.Error(codes.Internal, "failed to generate events")
+	}
+	events = append(events, festival.Sankrantis(first, last)...)
+	// An eclipse of the night before first may continue on first.
+	events = append(events, festival.Eclipses(first.AddDate(0, 0, -1), last, loc)...)
+	portions := eventPortions(events, first.Format(dateLayout), last.Format(dateLayout))
+
+	spans, err := rules.Spans(ctx, first, last, req.Region, loc)
+	if errors.Is(err, astronomy.ErrNoSunrise) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if ctx.Err() != nil {
+		return nil, status.FromContextError(ctx.Err()).Err()
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to generate festival spans", "error", err)
+		return nil, status.Error(codes.Internal, "failed to generate events")
+	}
+
+	resp := &ppb.GetEventsResponse{}
+	for _, p := range portions {
+		if req.Type != "" && req.Type != string(p.event.Kind) && req.Type != p.event.Definition {
+			continue
+		}
+		resp.Festivals = append(resp.Festivals, p.message(s.catalogs))
+	}
+	for _, span := range spans {
+		if req.Type != "" && req.Type != string(span.Kind) && req.Type != span.Definition {
+			continue
+		}
+		resp.Spans = append(resp.Spans, spanMessage(span, s.catalogs))
+	}
+	logger.InfoContext(ctx, "Prepared events", "festivals", len(resp.Festivals), "spans", len(resp.Spans))
+	return resp, nil
+}
+
+// eventPortion is the part of an event on one civil day.
+type eventPortion struct {
+	event festival.Event
+	span  daySpan
+}
+
+// eventPortions splits the events spanning an interval that crosses
+// midnight, such as a lunar eclipse, into their parts on each civil day, so
+// that the event is reported on every day it affects. It returns the parts
+// dated from first to last inclusive, ordered by date.
+func eventPortions(events []festival.Event, first, last string) []eventPortion {
+	var portions []eventPortion
+	for _, e := range events {
+		if e.Start.IsZero() || e.End.IsZero() {
+			portions = append(portions, eventPortion{event: e, span: daySpan{date: e.Date, start: e.Start}})
+			continue
+		}
+		for _, span := range splitAtMidnight(e.Start, e.End) {
+			portions = append(portions, eventPortion{event: e, span: span})
+		}
+	}
+	kept := portions[:0]
+	for _, p := range portions {
+		if p.span.date >= first && p.span.date <= last {
+			kept = append(kept, p)
+		}
+	}
+	sort.SliceStable(kept, func(i, j int) bool { return kept[i].span.date < kept[j].span.date })
+	return kept
+}
+
+func (p eventPortion) message(catalogs *i18n.Catalogs) *ppb.Festival {
+	m := festivalMessage(p.event, catalogs)
+	m.Date = p.span.date
+	if !p.event.End.IsZero() {
+		m.StartTime = p.span.startTime()
+		m.EndTime = p.span.endTime()
+	}
+	m.ContinuesFromPreviousDay = p.span.continuesFrom
+	m.ContinuesToNextDay = p.span.continuesTo
+	return m
+}
+
+// festivalMessage converts e with its names completed from catalogs.
+func festivalMessage(e festival.Event, catalogs *i18n.Catalogs) *ppb.Festival {
+	return &ppb.Festival{
+		Id:         e.ID,
+		Kind:       string(e.Kind),
+		Date:       e.Date,
+		Names:      localizedNames(catalogs.FestivalNames(e.Definition, e.Names)),
+		Tithi:      e.Tithi,
+		Definition: e.Definition,
+		StartTime:  formatTime(e.Start),
+		EndTime:    formatTime(e.End),
+		Kala:       string(e.Kala),
+		// The observance window may end on the next day, so its times
+		// carry their dates.
+		ObservanceStart: formatInstant(e.ObservanceStart),
+		ObservanceEnd:   formatInstant(e.ObservanceEnd),
+	}
+}
+
+// spanMessage converts span with its names completed from catalogs.
+func spanMessage(span festival.FestivalSpan, catalogs *i18n.Catalogs) *ppb.FestivalSpan {
+	m := &ppb.FestivalSpan{
+		Id:         span.ID,
+		Definition: span.Definition,
+		Kind:       string(span.Kind),
+		Names:      localizedNames(catalogs.FestivalNames(span.Definition, span.Names)),
+		Date:       span.Date,
+		StartDate:  span.StartDate,
+		EndDate:    span.EndDate,
+	}
+	for _, d := range span.Days {
+		m.Days = append(m.Days, &ppb.FestivalSpanDay{
+			Id:      d.ID,
+			Day:     int32(d.Day),
+			Date:    d.Date,
+			Tithi:   d.Tithi,
+			Names:   localizedNames(d.Names),
+			Kshaya:  d.Kshaya,
+			Vriddhi: d.Vriddhi,
+		})
+	}
+	return m
+}
+
+// localizedNames converts a locale to name map into a list sorted by locale
+// so that responses are byte-for-byte stable.
+func localizedNames(names map[string]string) []*ppb.LocalizedName {
+	result := make([]*ppb.LocalizedName, 0, len(names))
+	for locale, name := range names {
+		result = append(result, &ppb.LocalizedName{Locale: locale, Name: name})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Locale < result[j].Locale })
+	return result
+}
@@ -0,0 +1,102 @@
+package panchangam
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindFestivalDates(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.FindFestivalDates(context.Background(), &ppb.FindFestivalDatesRequest{
+		Name:      "Diwali",
+		Year:      2025,
+		EndYear:   2027,
+		Latitude:  28.6139,
+		Longitude: 77.2090,
+	})
+	if err != nil {
+		t.Fatalf("FindFestivalDates() error = %v", err)
+	}
+	if resp.Definition != "diwali" || resp.Timezone != "Asia/Kolkata" || len(resp.Names) == 0 {
+		t.Errorf("FindFestivalDates() = %v, want diwali in Asia/Kolkata", resp)
+	}
+	if len(resp.Festivals) != 3 {
+		t.Fatalf("FindFestivalDates() found %d dates, want one a year", len(resp.Festivals))
+	}
+	for i, f := range resp.Festivals {
+		if year := f.Date[:4]; year != []string{"2025", "2026", "2027"}[i] {
+			t.Errorf("Festivals[%d].Date = %s, want one in each year", i, f.Date)
+		}
+	}
+
+	for _, tt := range []struct {
+		req  *ppb.FindFestivalDatesRequest
+		code codes.Code
+	}{
+		{&ppb.FindFestivalDatesRequest{Year: 2026}, codes.InvalidArgument},
+		{&ppb.FindFestivalDatesRequest{Name: "diwali", Year: 2026, EndYear: 2025}, codes.InvalidArgument},
+		{&ppb.FindFestivalDatesRequest{Name: "diwali", Year: 2000, EndYear: 2200}, codes.InvalidArgument},
+		{&ppb.FindFestivalDatesRequest{Name: "no-such-festival", Year: 2026}, codes.NotFound},
+	} {
+		if _, err := s.FindFestivalDates(context.Background(), tt.req); status.Code(err) != tt.code {
+			t.Errorf("FindFestivalDates(%v) error = %v, want %s", tt.req, err, tt.code)
+		}
+	}
+}
+
+func TestFindFestivalDatesObservance(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.FindFestivalDates(context.Background(), &ppb.FindFestivalDatesRequest{
+		Name:      "maha-shivaratri",
+		Year:      2024,
+		Latitude:  28.6139,
+		Longitude: 77.2090,
+	})
+	if err != nil {
+		t.Fatalf("FindFestivalDates() error = %v", err)
+	}
+	if len(resp.Festivals) != 1 {
+		t.Fatalf("FindFestivalDates() found %d dates, want 1", len(resp.Festivals))
+	}
+	// The nishita kala of the night of 8 March ends after midnight.
+	f := resp.Festivals[0]
+	if f.Date != "2024-03-08" || f.Kala != "nishita" ||
+		!strings.HasPrefix(f.ObservanceStart, "2024-03-09T00:07:") || !strings.HasSuffix(f.ObservanceStart, "+05:30") ||
+		!strings.HasPrefix(f.ObservanceEnd, "2024-03-09T00:56:") {
+		t.Errorf("FindFestivalDates() = %v, want the nishita kala of 2024-03-08", f)
+	}
+}
+
+func TestGetEventsSpans(t *testing.T) {
+	s := newTestServer(t)
+	req := &ppb.GetEventsRequest{
+		Date:      "2024-10-08",
+		Days:      1,
+		Latitude:  28.6139,
+		Longitude: 77.2090,
+	}
+	resp, err := s.GetEvents(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetEvents() error = %v", err)
+	}
+	if len(resp.Spans) != 1 {
+		t.Fatalf("GetEvents() returned %d spans, want Navaratri", len(resp.Spans))
+	}
+	span := resp.Spans[0]
+	if span.Id != "navaratri-2024-10-03" || span.StartDate != "2024-10-03" || span.EndDate != "2024-10-12" || len(span.Days) != 10 {
+		t.Errorf("span = %s %s..%s with %d days, want Navaratri from 2024-10-03 to 2024-10-12 with 10 days", span.Id, span.StartDate, span.EndDate, len(span.Days))
+	}
+	if day := span.Days[5]; day.Day != 6 || day.Date != "2024-10-08" || len(day.Names) == 0 {
+		t.Errorf("sixth day = %v, want 2024-10-08", day)
+	}
+
+	req.Type = "diwali"
+	if resp, err = s.GetEvents(context.Background(), req); err != nil || len(resp.Spans) != 0 {
+		t.Errorf("GetEvents(type diwali) = %d spans, %v, want none", len(resp.GetSpans()), err)
+	}
+}
@@ -0,0 +1,42 @@
+package panchangam
+
+import (
+	"context"
+
+	"github.com/naren-m/panchangam/astronomy"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+// GetLagnas lists the lagnas prevailing during the requested date, from
+// midnight to midnight.
+func (s *PanchangamServer) GetLagnas(ctx context.Context, req *ppb.GetLagnasRequest) (*ppb.GetLagnasResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetLagnas")
+	defer span.End()
+	logger.InfoContext(ctx, "Received lagna request", "date", req.Date)
+
+	date, err := parseDate(req.Date, timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+	c := astronomy.NewLagnaCalculator(astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude})
+	lagnas := c.Lagnas(date, date.AddDate(0, 0, 1))
+
+	resp := &ppb.GetLagnasResponse{
+		Date:     date.Format(dateLayout),
+		Timezone: date.Location().String(),
+		Lagnas:   make([]*ppb.Lagna, 0, len(lagnas)),
+	}
+	for _, l := range lagnas {
+		start, end := l.Start.In(date.Location()), l.End.In(date.Location())
+		resp.Lagnas = append(resp.Lagnas, &ppb.Lagna{
+			Rashi:     l.Rashi,
+			Number:    int32(l.Number),
+			StartDate: start.Format(dateLayout),
+			StartTime: start.Format(timeLayout),
+			EndDate:   end.Format(dateLayout),
+			EndTime:   end.Format(timeLayout),
+		})
+	}
+	logger.InfoContext(ctx, "Prepared lagnas", "lagnas", len(resp.Lagnas))
+	return resp, nil
+}
@@ -0,0 +1,203 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/muhurta"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *PanchangamServer) GetMuhurta(ctx context.Context, req *ppb.GetMuhurtaRequest) (*ppb.GetMuhurtaResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetMuhurta")
+	defer span.End()
+	logger.InfoContext(ctx, "Received muhurta request", "date", req.Date, "end_date", req.EndDate, "days", req.Days, "activity", req.Activity, "tradition", req.Tradition)
+
+	tradition := req.Tradition
+	if tradition == "" {
+		tradition = muhurta.DefaultTradition
+	}
+	pack, ok := s.muhurtas.Get(tradition)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown tradition %q: expected one of %s",
+			req.Tradition, strings.Join(s.muhurtas.IDs(), ", "))
+	}
+	first, last, err := dateRange(req.Date, req.EndDate, req.Days, timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}
+	// Each day of Find runs from sunrise, so the night of the day before
+	// first may hold periods after midnight on first.
+	windows, err := pack.Find(req.Activity, first.AddDate(0, 0, -1), last, loc, profileOptions(req.Profile)...)
+	switch {
+	case errors.Is(err, muhurta.ErrUnknownActivity):
+		return nil, status.Errorf(codes.InvalidArgument, "unknown activity %q: expected one of %s",
+			req.Activity, strings.Join(pack.ActivityNames(), ", "))
+	case errors.Is(err, muhurta.ErrInvalidProfile):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, astronomy.ErrNoSunrise):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		logger.ErrorContext(ctx, "failed to find muhurtas", "error", err)
+		return nil, status.Error(codes.Internal, "failed to find muhurtas")
+	}
+
+	resp := &ppb.GetMuhurtaResponse{Tradition: pack.ID, Activity: req.Activity}
+	from, to := first.Format(dateLayout), last.Format(dateLayout)
+	for _, w := range windows {
+		// Night periods crossing midnight are reported on both days.
+		for _, span := range splitAtMidnight(w.Start, w.End) {
+			if span.date < from || span.date > to {
+				continue
+			}
+			resp.Windows = append(resp.Windows, &ppb.MuhurtaWindow{
+				Date:                     span.date,
+				StartTime:                span.startTime(),
+				EndTime:                  span.endTime(),
+				Choghadiya:               w.Choghadiya,
+				Score:                    int32(w.Score),
+				Reasons:                  w.Reasons,
+				ContinuesFromPreviousDay: span.continuesFrom,
+				ContinuesToNextDay:       span.continuesTo,
+			})
+		}
+	}
+	for day := first; !day.After(last); day = day.AddDate(0, 0, 1) {
+		sunTimes, err := s.calculateSunTimes(ctx, loc, day)
+		if err != nil {
+			return nil, err
+		}
+		date := day.Format(dateLayout)
+		resp.DailyMuhurtas = append(resp.DailyMuhurtas,
+			muhurtaMessage(astronomy.BrahmaMuhurta(sunTimes.Sunrise), date),
+			muhurtaMessage(astronomy.AbhijitMuhurta(sunTimes.Sunrise, sunTimes.Sunset), date))
+	}
+	logger.InfoContext(ctx, "Prepared muhurtas", "windows", len(resp.Windows))
+	return resp, nil
+}
+
+// Bounds of the number of periods FindMuhurta finds. It searches at most
+// maxEventDays days.
+const (
+	defaultMuhurtaCount = 5
+	maxMuhurtaCount     = 50
+)
+
+// FindMuhurta finds the next periods suitable for the requested activity
+// from the requested date that satisfy the constraints of the request,
+// ranked by score.
+func (s *PanchangamServer) FindMuhurta(ctx context.Context, req *ppb.FindMuhurtaRequest) (*ppb.FindMuhurtaResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "FindMuhurta")
+	defer span.End()
+	logger.InfoContext(ctx, "Received muhurta search", "date", req.Date, "days", req.Days, "count", req.Count, "activity", req.Activity, "tradition", req.Tradition)
+
+	tradition := req.Tradition
+	if tradition == "" {
+		tradition = muhurta.DefaultTradition
+	}
+	pack, ok := s.muhurtas.Get(tradition)
+	if !ok {
+		return nil, status.Errorf(codes.InvalidArgument, "unknown tradition %q: expected one of %s",
+			req.Tradition, strings.Join(s.muhurtas.IDs(), ", "))
+	}
+	from, err := parseDate(req.Date, timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+	count := int(req.Count)
+	if count == 0 {
+		count = defaultMuhurtaCount
+	}
+	if count < 1 || count > maxMuhurtaCount {
+		return nil, fieldErrorf("count", "invalid count %d: expected 1 to %d", req.Count, maxMuhurtaCount)
+	}
+	days := int(req.Days)
+	if days == 0 {
+		days = maxEventDays
+	}
+	if days < 1 || days > maxEventDays {
+		return nil, fieldErrorf("days", "invalid days %d: expected 1 to %d", req.Days, maxEventDays)
+	}
+
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}
+	constraints := muhurta.Constraints{Nakshatras: req.Nakshatras, Weekdays: req.Weekdays, ExcludeBhadra: req.ExcludeBhadra}
+	windows, err := pack.Search(req.Activity, from, loc, constraints, count, days, profileOptions(req.Profile)...)
+	switch {
+	case errors.Is(err, muhurta.ErrUnknownActivity):
+		return nil, status.Errorf(codes.InvalidArgument, "unknown activity %q: expected one of %s",
+			req.Activity, strings.Join(pack.ActivityNames(), ", "))
+	case errors.Is(err, muhurta.ErrInvalidConstraint), errors.Is(err, muhurta.ErrInvalidProfile):
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	case errors.Is(err, astronomy.ErrNoSunrise):
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	case err != nil:
+		logger.ErrorContext(ctx, "failed to search muhurtas", "error", err)
+		return nil, status.Error(codes.Internal, "failed to search muhurtas")
+	}
+
+	resp := &ppb.FindMuhurtaResponse{
+		Tradition:     pack.ID,
+		Activity:      req.Activity,
+		Timezone:      from.Location().String(),
+		SearchedUntil: from.AddDate(0, 0, days-1).Format(dateLayout),
+	}
+	var latest time.Time
+	for i, w := range windows {
+		resp.Candidates = append(resp.Candidates, &ppb.MuhurtaCandidate{
+			Start:      formatInstant(w.Start),
+			End:        formatInstant(w.End),
+			Choghadiya: w.Choghadiya,
+			Score:      int32(w.Score),
+			Reasons:    w.Reasons,
+			Rank:       int32(i + 1),
+		})
+		if w.Start.After(latest) {
+			latest = w.Start
+		}
+	}
+	if len(windows) == count {
+		resp.SearchedUntil = latest.Format(dateLayout)
+	}
+	logger.InfoContext(ctx, "Found muhurtas", "candidates", len(resp.Candidates))
+	return resp, nil
+}
+
+// profileOptions returns the options scoring muhurtas for the person of
+// profile, if any.
+func profileOptions(profile *ppb.Profile) []muhurta.Option {
+	if profile == nil {
+		return nil
+	}
+	return []muhurta.Option{muhurta.WithProfile(muhurta.Profile{Nakshatra: profile.JanmaNakshatra, Rashi: profile.JanmaRashi})}
+}
+
+// inauspiciousPeriods returns the Durmuhurtam and Varjyam periods of the
+// day of date, ordered by start.
+func inauspiciousPeriods(sunTimes, nextSunTimes *astronomy.SunTimes, date string) []*ppb.Muhurta {
+	periods := astronomy.Durmuhurtas(sunTimes.Sunrise, sunTimes.Sunset, nextSunTimes.Sunrise)
+	periods = append(periods, astronomy.Varjyams(sunTimes.Sunrise, nextSunTimes.Sunrise)...)
+	sort.Slice(periods, func(i, j int) bool { return periods[i].Start.Before(periods[j].Start) })
+	messages := make([]*ppb.Muhurta, 0, len(periods))
+	for _, p := range periods {
+		messages = append(messages, muhurtaMessage(p, date))
+	}
+	return messages
+}
+
+// muhurtaMessage converts a muhurta of the day of date.
+func muhurtaMessage(p astronomy.Period, date string) *ppb.Muhurta {
+	return &ppb.Muhurta{
+		Name:      p.Name,
+		Date:      date,
+		StartTime: formatTime(p.Start),
+		EndTime:   formatTime(p.End),
+	}
+}
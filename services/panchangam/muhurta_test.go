@@ -0,0 +1,73 @@
+package panchangam
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestFindMuhurta(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.FindMuhurta(context.Background(), &ppb.FindMuhurtaRequest{
+		Date:          "2024-11-01",
+		Latitude:      28.6139,
+		Longitude:     77.2090,
+		Activity:      "marriage",
+		Count:         3,
+		Nakshatras:    []string{"Rohini", "Hasta"},
+		ExcludeBhadra: true,
+	})
+	if err != nil {
+		t.Fatalf("FindMuhurta() error = %v", err)
+	}
+	if resp.Tradition != "smarta" || resp.Timezone != "Asia/Kolkata" || len(resp.Candidates) != 3 {
+		t.Fatalf("FindMuhurta() = %v, want 3 smarta candidates in Asia/Kolkata", resp)
+	}
+	for i, c := range resp.Candidates {
+		start, err := time.Parse(time.RFC3339, c.Start)
+		if err != nil || start.Format(dateLayout) < "2024-10-31" || c.End <= c.Start {
+			t.Errorf("Candidates[%d] = %v, want a period from 2024-11-01", i, c)
+		}
+		if c.Rank != int32(i+1) || (i > 0 && c.Score > resp.Candidates[i-1].Score) {
+			t.Errorf("Candidates[%d] = %v, not ranked by score", i, c)
+		}
+	}
+	personal, err := s.FindMuhurta(context.Background(), &ppb.FindMuhurtaRequest{
+		Date:      "2024-11-01",
+		Latitude:  28.6139,
+		Longitude: 77.2090,
+		Activity:  "marriage",
+		Count:     1,
+		Profile:   &ppb.Profile{JanmaNakshatra: "Rohini", JanmaRashi: "Vrishabha"},
+	})
+	if err != nil {
+		t.Fatalf("FindMuhurta() with a profile error = %v", err)
+	}
+	if reasons := strings.Join(personal.Candidates[0].Reasons, ", "); !strings.Contains(reasons, "tarabala") && !strings.Contains(reasons, "chandrabala") {
+		t.Errorf("FindMuhurta() with a profile reasons = %s, want tarabala or chandrabala", reasons)
+	}
+	if resp.SearchedUntil < "2024-11-01" {
+		t.Errorf("SearchedUntil = %s, want a date from 2024-11-01", resp.SearchedUntil)
+	}
+
+	for _, tt := range []struct {
+		req  *ppb.FindMuhurtaRequest
+		code codes.Code
+	}{
+		{&ppb.FindMuhurtaRequest{Date: "2024-11-01", Activity: "coronation"}, codes.InvalidArgument},
+		{&ppb.FindMuhurtaRequest{Date: "2024-11-01", Activity: "marriage", Tradition: "unknown"}, codes.InvalidArgument},
+		{&ppb.FindMuhurtaRequest{Date: "2024-11-01", Activity: "marriage", Count: 51}, codes.InvalidArgument},
+		{&ppb.FindMuhurtaRequest{Date: "2024-11-01", Activity: "marriage", Days: 400}, codes.InvalidArgument},
+		{&ppb.FindMuhurtaRequest{Date: "2024-11-01", Activity: "marriage", Weekdays: []string{"Someday"}}, codes.InvalidArgument},
+		{&ppb.FindMuhurtaRequest{Date: "2024-11-01", Activity: "marriage", Profile: &ppb.Profile{JanmaRashi: "Leo"}}, codes.InvalidArgument},
+	} {
+		if _, err := s.FindMuhurta(context.Background(), tt.req); status.Code(err) != tt.code {
+			t.Errorf("FindMuhurta(%v) error = %v, want %s", tt.req, err, tt.code)
+		}
+	}
+}
@@ -0,0 +1,129 @@
+// Package panchangamtest provides a frozen clock and scripted ephemeris
+// responses, so that tests of the panchangam service, and of servers
+// embedding it, give the same results in every run:
+//
+//	clock := panchangamtest.NewClock(time.Date(2024, 4, 9, 6, 0, 0, 0, time.UTC))
+//	positions := panchangamtest.NewEphemeris()
+//	positions.Script(ephemeris.Moon, clock.Now(), panchangamtest.Response{Longitude: 29.5, Speed: 13})
+//	s := panchangam.NewPanchangamServer(panchangam.WithClock(clock.Now), panchangam.WithEphemeris(positions))
+package panchangamtest
+
+import (
+	"context"
+	"math"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/ephemeris"
+)
+
+// Clock is a clock which only moves when told to. It is safe for
+// concurrent use.
+type Clock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewClock returns a clock frozen at t.
+func NewClock(t time.Time) *Clock {
+	return &Clock{now: t}
+}
+
+// Now returns the time of the clock, e.g. for panchangam.WithClock.
+func (c *Clock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+// Advance moves the clock forward by d.
+func (c *Clock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+// Set moves the clock to t.
+func (c *Clock) Set(t time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = t
+}
+
+// Response is a scripted answer of an Ephemeris.
+type Response struct {
+	// Longitude is the tropical longitude of the body, in degrees, from
+	// the start of the response, and Speed its motion in degrees per day
+	// from then.
+	Longitude float64
+	Speed     float64
+	// Err, when not nil, fails the computations instead.
+	Err error
+}
+
+// scripted is a response from a time on.
+type scripted struct {
+	from time.Time
+	Response
+}
+
+// Ephemeris is an ephemeris.Provider answering with scripted responses,
+// and with those of its fallback, the builtin provider, before the first
+// response of a body. It is safe for concurrent use. Provider of
+// ephemeristest scripts fixed positions, failures and latency instead.
+type Ephemeris struct {
+	fallback ephemeris.Provider
+
+	mu      sync.Mutex
+	scripts map[ephemeris.Body][]scripted
+	calls   int
+}
+
+// NewEphemeris returns an Ephemeris without responses.
+func NewEphemeris() *Ephemeris {
+	return &Ephemeris{fallback: ephemeris.BuiltinProvider{}, scripts: map[ephemeris.Body][]scripted{}}
+}
+
+// Name returns "scripted".
+func (e *Ephemeris) Name() string { return "scripted" }
+
+// Script makes e answer with r for the positions of body from the time
+// from on, until that of its next response.
+func (e *Ephemeris) Script(body ephemeris.Body, from time.Time, r Response) {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	s := append(e.scripts[body], scripted{from, r})
+	sort.SliceStable(s, func(i, j int) bool { return s[i].from.Before(s[j].from) })
+	e.scripts[body] = s
+}
+
+// Calls returns the number of positions computed by e, or failed.
+func (e *Ephemeris) Calls() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.calls
+}
+
+// Position returns the position of body at t of the latest response from
+// before t, or that of the builtin provider.
+func (e *Ephemeris) Position(ctx context.Context, body ephemeris.Body, t time.Time) (ephemeris.Position, error) {
+	e.mu.Lock()
+	e.calls++
+	s := e.scripts[body]
+	i := sort.Search(len(s), func(i int) bool { return s[i].from.After(t) })
+	e.mu.Unlock()
+	if i == 0 {
+		return e.fallback.Position(ctx, body, t)
+	}
+	r := s[i-1]
+	if r.Err != nil {
+		return ephemeris.Position{}, r.Err
+	}
+	days := t.Sub(r.from).Hours() / 24
+	longitude := math.Mod(r.Longitude+r.Speed*days, 360)
+	if longitude < 0 {
+		longitude += 360
+	}
+	return ephemeris.Position{Body: body, Time: t, Longitude: longitude, Speed: r.Speed}, nil
+}
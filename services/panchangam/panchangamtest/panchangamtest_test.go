@@ -0,0 +1,67 @@
+package panchangamtest
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/ephemeris"
+)
+
+func TestClock(t *testing.T) {
+	start := time.Date(2024, 4, 9, 6, 0, 0, 0, time.UTC)
+	c := NewClock(start)
+	if got := c.Now(); !got.Equal(start) {
+		t.Errorf("Now() = %v, want %v", got, start)
+	}
+	c.Advance(90 * time.Minute)
+	if got := c.Now(); !got.Equal(start.Add(90 * time.Minute)) {
+		t.Errorf("Now() after Advance = %v, want 07:30", got)
+	}
+	c.Set(start)
+	if got := c.Now(); !got.Equal(start) {
+		t.Errorf("Now() after Set = %v, want %v", got, start)
+	}
+}
+
+func TestEphemeris(t *testing.T) {
+	ctx := context.Background()
+	start := time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC)
+	down := errors.New("no data files")
+	e := NewEphemeris()
+	e.Script(ephemeris.Moon, start.Add(48*time.Hour), Response{Err: down})
+	e.Script(ephemeris.Moon, start, Response{Longitude: 350, Speed: 12})
+
+	for _, tt := range []struct {
+		at   time.Duration
+		want float64
+	}{
+		{0, 350},
+		{12 * time.Hour, 356},
+		{24 * time.Hour, 2},
+	} {
+		p, err := e.Position(ctx, ephemeris.Moon, start.Add(tt.at))
+		if err != nil || math.Abs(p.Longitude-tt.want) > 1e-9 || p.Speed != 12 {
+			t.Errorf("Position(%v) = %+v, %v, want longitude %g", tt.at, p, err, tt.want)
+		}
+	}
+	if _, err := e.Position(ctx, ephemeris.Moon, start.Add(72*time.Hour)); !errors.Is(err, down) {
+		t.Errorf("Position() after the failure = %v, want %v", err, down)
+	}
+
+	// Before its first response, and for the sun, the builtin provider
+	// answers.
+	for _, body := range []ephemeris.Body{ephemeris.Moon, ephemeris.Sun} {
+		at := start.Add(-time.Hour)
+		got, err := e.Position(ctx, body, at)
+		want, _ := ephemeris.BuiltinProvider{}.Position(ctx, body, at)
+		if err != nil || got != want {
+			t.Errorf("Position(%s) = %+v, %v, want the builtin %+v", body, got, err, want)
+		}
+	}
+	if n := e.Calls(); n != 6 {
+		t.Errorf("Calls() = %d, want 6", n)
+	}
+}
@@ -0,0 +1,154 @@
+package panchangam
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/observability"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/protobuf/proto"
+)
+
+// Defaults of the precomputation of popular locations.
+const (
+	DefaultPrecomputeWindow   = 30
+	DefaultPrecomputeInterval = 10 * time.Minute
+)
+
+// Health statuses of the server.
+const (
+	statusServing = "serving"
+	statusWarming = "warming"
+)
+
+// PrecomputeLocation is a popular location whose panchangams are kept
+// computed.
+type PrecomputeLocation struct {
+	Name      string
+	Latitude  float64
+	Longitude float64
+	// Timezone is the IANA timezone of the requests precomputed; they have
+	// none, as most requests, when it is empty.
+	Timezone string
+}
+
+// precomputer keeps the panchangams of popular locations in the cache.
+type precomputer struct {
+	locations []PrecomputeLocation
+	window    int
+	interval  time.Duration
+
+	mu     sync.Mutex
+	status *ppb.PrecomputeStatus
+}
+
+// WithPrecompute keeps the panchangams of locations computed for the days
+// from window days before today until window days after, recomputing those
+// expired from the cache every interval once Precompute runs. The first
+// requests for them are then served from the cache.
+func WithPrecompute(locations []PrecomputeLocation, window int, interval time.Duration) Option {
+	return func(s *PanchangamServer) {
+		s.precompute = &precomputer{
+			locations: locations,
+			window:    window,
+			interval:  interval,
+			status: &ppb.PrecomputeStatus{
+				Locations: int32(len(locations)),
+				Days:      int32(2*window + 1),
+			},
+		}
+	}
+}
+
+// Precompute computes the panchangams of the locations set with
+// WithPrecompute every interval until ctx is done. It returns at once if
+// none were set.
+func (s *PanchangamServer) Precompute(ctx context.Context) {
+	p := s.precompute
+	if p == nil {
+		return
+	}
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		s.precomputePass(ctx)
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// precomputePass computes the panchangams of every location and day of the
+// window. Those still cached are not recomputed.
+func (s *PanchangamServer) precomputePass(ctx context.Context) {
+	ctx, span := s.observer.CreateSpan(ctx, "Precompute")
+	defer span.End()
+	p := s.precompute
+	start := s.now()
+	var warm, failed int32
+	var lastErr error
+	for _, loc := range p.locations {
+		zone, err := loadTimezone(timezoneAt(loc.Timezone, loc.Latitude, loc.Longitude))
+		if err != nil {
+			failed += int32(2*p.window + 1)
+			lastErr = err
+			continue
+		}
+		today := start.In(zone)
+		for d := -p.window; d <= p.window; d++ {
+			if ctx.Err() != nil {
+				return
+			}
+			req := &ppb.GetPanchangamRequest{
+				Date:      today.AddDate(0, 0, d).Format(dateLayout),
+				Latitude:  loc.Latitude,
+				Longitude: loc.Longitude,
+				Timezone:  loc.Timezone,
+			}
+			if _, err := s.fetchPanchangamData(ctx, req); err != nil {
+				failed++
+				lastErr = err
+				logger.WarnContext(ctx, "Failed to precompute panchangam", "location", loc.Name, "date", req.Date, "error", err)
+				observability.RecordError(ctx, "precompute", err)
+				continue
+			}
+			warm++
+		}
+	}
+	end := s.now()
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.status.Warm = warm
+	p.status.Failed = failed
+	p.status.Passes++
+	p.status.LastPassTime = end.Format(time.RFC3339)
+	p.status.LastPassDurationMs = end.Sub(start).Milliseconds()
+	p.status.LastError = ""
+	if lastErr != nil {
+		p.status.LastError = lastErr.Error()
+	}
+	logger.InfoContext(ctx, "Precomputed panchangams", "warm", warm, "failed", failed, "duration", end.Sub(start))
+}
+
+// GetHealth reports whether the server is serving and the progress of the
+// precomputation. The server is warming until the first precomputation pass
+// ends, though it serves requests meanwhile.
+func (s *PanchangamServer) GetHealth(ctx context.Context, req *ppb.GetHealthRequest) (*ppb.Health, error) {
+	_, span := s.observer.CreateSpan(ctx, "GetHealth")
+	defer span.End()
+
+	health := &ppb.Health{Status: statusServing}
+	if p := s.precompute; p != nil {
+		p.mu.Lock()
+		health.Precompute = proto.Clone(p.status).(*ppb.PrecomputeStatus)
+		p.mu.Unlock()
+		if health.Precompute.Passes == 0 {
+			health.Status = statusWarming
+		}
+	}
+	return health, nil
+}
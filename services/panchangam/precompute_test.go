@@ -0,0 +1,52 @@
+package panchangam
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+func TestPrecompute(t *testing.T) {
+	s := newTestServer(t)
+	if health, _ := s.GetHealth(context.Background(), &ppb.GetHealthRequest{}); health.Status != statusServing || health.Precompute != nil {
+		t.Errorf("GetHealth() without precomputation = %v, want serving", health)
+	}
+
+	hyderabad := PrecomputeLocation{Name: "Hyderabad", Latitude: 17.385, Longitude: 78.4867}
+	WithPrecompute([]PrecomputeLocation{hyderabad, {Name: "Nowhere", Timezone: "Mars/Olympus_Mons"}}, 2, time.Hour)(s)
+	s.now = func() time.Time { return time.Date(2024, 4, 9, 20, 0, 0, 0, time.UTC) }
+	if health, _ := s.GetHealth(context.Background(), &ppb.GetHealthRequest{}); health.Status != statusWarming {
+		t.Errorf("GetHealth() before the first pass = %v, want warming", health)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	done := make(chan struct{})
+	go func() {
+		s.Precompute(ctx)
+		close(done)
+	}()
+	var health *ppb.Health
+	for deadline := time.Now().Add(10 * time.Second); time.Now().Before(deadline); time.Sleep(10 * time.Millisecond) {
+		if health, _ = s.GetHealth(ctx, &ppb.GetHealthRequest{}); health.Status == statusServing {
+			break
+		}
+	}
+	cancel()
+	<-done
+
+	p := health.GetPrecompute()
+	if health.Status != statusServing || p.Passes != 1 || p.Locations != 2 || p.Days != 5 || p.Warm != 5 || p.Failed != 5 || p.LastError == "" {
+		t.Fatalf("GetHealth() after the first pass = %v, want 5 warm and 5 failed in a pass", health)
+	}
+	// The days are those of the location, which is already April 10.
+	req := &ppb.GetPanchangamRequest{Date: "2024-04-12", Latitude: hyderabad.Latitude, Longitude: hyderabad.Longitude}
+	if _, err := s.Get(context.Background(), req); err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if stats := s.panchangam.Stats(); stats.Hits != 1 || stats.Loads != 5 {
+		t.Errorf("Stats() = %+v, want the precomputed day served from the cache", stats)
+	}
+}
@@ -0,0 +1,65 @@
+package panchangam
+
+import (
+	"context"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/ephemeris"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// rashi returns the sidereal sign of body at sunrise and its ingress into
+// the next sign before nextSunrise, dated in tz.
+func (s *PanchangamServer) rashi(ctx context.Context, body ephemeris.Body, sunrise, nextSunrise time.Time, tz *time.Location) (*ppb.Rashi, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "rashi")
+	defer span.End()
+
+	positions := s.ephemerisProvider()
+	pos, err := positions.Position(ctx, body, sunrise)
+	if err != nil {
+		return nil, ephemerisError(ctx, positions, err)
+	}
+	ingresses, err := ephemeris.RashiIngresses(ctx, positions, body, sunrise, nextSunrise)
+	if err != nil {
+		return nil, ephemerisError(ctx, positions, err)
+	}
+	number := ephemeris.Rashi(pos)
+	r := &ppb.Rashi{Number: int32(number), Name: astronomy.RashiName(number)}
+	if len(ingresses) > 0 {
+		in := ingresses[0]
+		t := in.Time.In(tz)
+		r.NextName = in.Name
+		r.IngressDate = t.Format(dateLayout)
+		r.IngressTime = t.Format(timeLayout)
+	}
+	return r, nil
+}
+
+// ephemerisProvider returns the provider of the positions of the moon and
+// sun, or the builtin provider while an operator has disabled it.
+func (s *PanchangamServer) ephemerisProvider() ephemeris.Provider {
+	if !s.providerEnabled(s.positions.Name()) {
+		return ephemeris.BuiltinProvider{}
+	}
+	return s.positions
+}
+
+// providerEnabled reports whether the ephemeris provider named name is
+// enabled.
+func (s *PanchangamServer) providerEnabled(name string) bool {
+	s.disabledMu.RLock()
+	defer s.disabledMu.RUnlock()
+	return !s.disabled[name]
+}
+
+// ephemerisError converts an error of the ephemeris provider p, which may
+// be a remote service.
+func ephemerisError(ctx context.Context, p ephemeris.Provider, err error) error {
+	if ctx.Err() != nil {
+		return status.FromContextError(ctx.Err()).Err()
+	}
+	return status.Errorf(codes.Unavailable, "ephemeris %s: %v", p.Name(), err)
+}
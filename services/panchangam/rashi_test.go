@@ -0,0 +1,67 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/ephemeris"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/services/panchangam/panchangamtest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestGetRashi(t *testing.T) {
+	s := newTestServer(t)
+	var previous *ppb.Rashi
+	var ingresses int
+	for date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC); date.Day() <= 5; date = date.AddDate(0, 0, 1) {
+		req := &ppb.GetPanchangamRequest{Date: date.Format(dateLayout), Latitude: 17.385, Longitude: 78.4867, Timezone: "Asia/Kolkata"}
+		resp, err := s.Get(context.Background(), req)
+		if err != nil {
+			t.Fatalf("Get(%s) error = %v", req.Date, err)
+		}
+		moon, sun := resp.PanchangamData.MoonRashi, resp.PanchangamData.SunRashi
+		// The sun is in Kumbha from 2024-02-13 to 2024-03-14.
+		if sun.GetName() != "Kumbha" || sun.GetNumber() != 11 || sun.GetNextName() != "" {
+			t.Errorf("%s: SunRashi = %v, want Kumbha without ingress", req.Date, sun)
+		}
+		// The moon is in the sign it entered the day before.
+		if previous != nil {
+			want := previous.GetName()
+			if previous.GetNextName() != "" {
+				want = previous.GetNextName()
+			}
+			if moon.GetName() != want {
+				t.Errorf("%s: MoonRashi = %s, want %s", req.Date, moon.GetName(), want)
+			}
+		}
+		if moon.GetNextName() != "" {
+			ingresses++
+			if moon.GetIngressDate() < req.Date || moon.GetIngressTime() == "" {
+				t.Errorf("%s: MoonRashi ingress on %s at %s", req.Date, moon.GetIngressDate(), moon.GetIngressTime())
+			}
+		}
+		previous = moon
+	}
+	// The moon enters a sign every two or three days.
+	if ingresses < 2 {
+		t.Errorf("MoonRashi has %d ingresses in five days, want at least 2", ingresses)
+	}
+}
+
+func TestGetRashiEphemerisError(t *testing.T) {
+	newTestServer(t)
+	positions := panchangamtest.NewEphemeris()
+	positions.Script(ephemeris.Moon, time.Time{}, panchangamtest.Response{Err: errors.New("no data files")})
+	s := NewPanchangamServer(WithEphemeris(positions))
+	req := &ppb.GetPanchangamRequest{Date: "2024-03-01", Latitude: 17.385, Longitude: 78.4867, Timezone: "Asia/Kolkata"}
+	if _, err := s.Get(context.Background(), req); status.Code(err) != codes.Unavailable {
+		t.Errorf("Get() error = %v, want Unavailable", err)
+	}
+	if positions.Calls() == 0 {
+		t.Error("Get() did not use the ephemeris provider")
+	}
+}
@@ -3,27 +3,184 @@ package panchangam
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"time"
 
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/cache"
+	"github.com/naren-m/panchangam/calendar"
+	"github.com/naren-m/panchangam/ephemeris"
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/i18n"
+	"github.com/naren-m/panchangam/location"
 	"github.com/naren-m/panchangam/log"
+	"github.com/naren-m/panchangam/muhurta"
 	"github.com/naren-m/panchangam/observability"
 	ppb "github.com/naren-m/panchangam/proto/panchangam"
-	"golang.org/x/exp/rand"
+	"github.com/naren-m/panchangam/webhook"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
 var logger = log.Logger()
 
+// Layouts used for dates and times in requests and responses.
+const (
+	dateLayout = "2006-01-02"
+	timeLayout = "15:04:05"
+)
+
 type PanchangamServer struct {
-	observer observability.ObserverInterface
+	observer  observability.ObserverInterface
+	festivals festival.Source
+	muhurtas  *muhurta.Packs
+	catalogs  *i18n.Catalogs
+	// panchangam caches the computed panchangam of each date and location.
+	panchangam *cache.Cache[*ppb.PanchangamData]
+	// precompute keeps the panchangams of popular locations cached, or is
+	// nil when none are.
+	precompute *precomputer
+	// providerChecks caches the health of the ephemeris providers, which
+	// providers returns.
+	providerChecks *cache.Cache[*ppb.EphemerisProviderHealth]
+	providers      func() []ephemeris.Provider
+	// positions computes the positions of the moon and sun in the rashis.
+	positions ephemeris.Provider
+	// disabled holds the names of the ephemeris providers an operator
+	// disabled through the Admin service.
+	disabledMu sync.RWMutex
+	disabled   map[string]bool
+	// usage reports the calls recorded by the accounting interceptor, or
+	// is nil when they are not recorded.
+	usage *aaa.Usage
+	// webhooks holds the webhook subscriptions, or is nil when the server
+	// posts no events.
+	webhooks *webhook.Store
+	// started is when the server was created.
+	started time.Time
+	// now returns the current time, e.g. that WatchTransitions starts
+	// from, time.Now unless set by WithClock.
+	now func() time.Time
 	ppb.UnimplementedPanchangamServer
 }
 
-func NewPanchangamServer() *PanchangamServer {
-	return &PanchangamServer{
-		observer: observability.Observer(),
+// Option configures a PanchangamServer.
+type Option func(*PanchangamServer)
+
+// WithFestivalRules sets the festival definitions used by GetFestivalBundle,
+// GetEvents and GetVratList, e.g. a RuleSet or Catalogs. By default the
+// built-in definitions of each region are loaded when it is first requested.
+func WithFestivalRules(rules festival.Source) Option {
+	return func(s *PanchangamServer) {
+		s.festivals = rules
+	}
+}
+
+// WithMuhurtaPacks sets the muhurta rule packs used by GetMuhurta. The
+// built-in packs are used by default.
+func WithMuhurtaPacks(packs *muhurta.Packs) Option {
+	return func(s *PanchangamServer) {
+		s.muhurtas = packs
+	}
+}
+
+// WithCatalogs sets the catalogs translating the names of elements and
+// festivals. The built-in catalogs are used by default.
+func WithCatalogs(catalogs *i18n.Catalogs) Option {
+	return func(s *PanchangamServer) {
+		s.catalogs = catalogs
+	}
+}
+
+// WithCacheOptions configures the cache of computed panchangams, which by
+// default spreads the expiry of entries filled together over ten minutes
+// and refreshes hot entries ahead of expiry.
+func WithCacheOptions(opts ...cache.Option) Option {
+	return func(s *PanchangamServer) {
+		s.panchangam = cache.New[*ppb.PanchangamData](append(defaultCacheOptions(), opts...)...)
+	}
+}
+
+// WithEphemeris sets the ephemeris provider of the positions of the moon
+// and sun from which their rashis and ingresses are computed. The built-in
+// provider is used by default.
+func WithEphemeris(p ephemeris.Provider) Option {
+	return func(s *PanchangamServer) {
+		s.positions = p
+	}
+}
+
+// WithUsage sets the usage of the RPCs reported by GetUsageReport, which
+// fails without it.
+func WithUsage(usage *aaa.Usage) Option {
+	return func(s *PanchangamServer) {
+		s.usage = usage
+	}
+}
+
+// WithWebhooks sets the store of the subscriptions managed by
+// CreateSubscription, ListSubscriptions and DeleteSubscription, which fail
+// without it. A webhook.Dispatcher posts their events.
+func WithWebhooks(store *webhook.Store) Option {
+	return func(s *PanchangamServer) {
+		s.webhooks = store
+	}
+}
+
+// WithClock sets the source of the current time, time.Now by default: the
+// time WatchTransitions and GetNextTransitions start from, the default
+// date of GetUsageReport and the uptime of GetSystemInfo. Tests set a
+// frozen clock, e.g. that of panchangamtest.NewClock.
+func WithClock(now func() time.Time) Option {
+	return func(s *PanchangamServer) {
+		s.now = now
+		s.started = now()
+	}
+}
+
+// PanchangamCodec encodes computed panchangams for a shared cache.Backend,
+// e.g. cache.WithBackend(backend, PanchangamCodec).
+var PanchangamCodec = cache.Codec[*ppb.PanchangamData]{
+	Marshal: func(d *ppb.PanchangamData) ([]byte, error) { return proto.Marshal(d) },
+	Unmarshal: func(b []byte) (*ppb.PanchangamData, error) {
+		d := &ppb.PanchangamData{}
+		return d, proto.Unmarshal(b, d)
+	},
+}
+
+func defaultCacheOptions() []cache.Option {
+	return []cache.Option{
+		cache.WithName("panchangam"),
+		cache.WithTTL(cache.DefaultTTL),
+		cache.WithStagger(10 * time.Minute),
+		cache.WithJitter(0.1),
+		cache.WithRefreshAhead(0.1, 3),
+	}
+}
+
+func NewPanchangamServer(opts ...Option) *PanchangamServer {
+	s := &PanchangamServer{
+		observer:   observability.Observer(),
+		festivals:  festival.NewCatalogs(),
+		muhurtas:   muhurta.DefaultPacks(),
+		catalogs:   i18n.DefaultCatalogs(),
+		panchangam: cache.New[*ppb.PanchangamData](defaultCacheOptions()...),
+		providerChecks: cache.New[*ppb.EphemerisProviderHealth](
+			cache.WithName("ephemeris_health"), cache.WithTTL(providerCheckTTL)),
+		providers: registeredProviders,
+		positions: ephemeris.BuiltinProvider{},
+		disabled:  map[string]bool{},
+		started:   time.Now(),
+		now:       time.Now,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+	return s
 }
 
 func (s *PanchangamServer) Get(ctx context.Context, req *ppb.GetPanchangamRequest) (*ppb.GetPanchangamResponse, error) {
@@ -31,41 +188,391 @@ func (s *PanchangamServer) Get(ctx context.Context, req *ppb.GetPanchangamReques
 	defer span.End()
 	// Create a child span for the service-level operation.
 	logger.InfoContext(ctx, "Received request", "date", req.Date)
-	d, _ := s.fetchPanchangamData(ctx, req.Date)
+	d, err := s.fetchPanchangamData(ctx, req)
+	if err != nil {
+		return nil, err
+	}
 	response := &ppb.GetPanchangamResponse{
 		PanchangamData: d,
 	}
-	time.Sleep(100 * time.Millisecond)
 	logger.InfoContext(ctx, "Prepared response")
 
 	return response, nil
 }
 
-func (s *PanchangamServer) fetchPanchangamData(ctx context.Context, date string) (*ppb.PanchangamData, error) {
+func (s *PanchangamServer) fetchPanchangamData(ctx context.Context, req *ppb.GetPanchangamRequest) (*ppb.PanchangamData, error) {
 	ctx, span := s.observer.CreateSpan(ctx, "fetchPanchangamData")
 	defer span.End()
 
 	logger.InfoContext(ctx, "fetching panchangam data")
-	// Simulate a delay in fetching data.
-	time.Sleep(29 * time.Millisecond)
+	date, err := parseDate(req.Date, timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+	return s.panchangam.Get(ctx, panchangamKey(req), func(ctx context.Context) (*ppb.PanchangamData, error) {
+		return s.computePanchangamData(ctx, req, date)
+	})
+}
+
+// panchangamKey identifies the panchangam computed for a request. The
+// coordinates are rounded to about 10 m, so that requests from clients
+// reporting positions slightly apart share the panchangam of whichever came
+// first, which differs from theirs by well under a second.
+func panchangamKey(req *ppb.GetPanchangamRequest) string {
+	return fmt.Sprintf("%s|%.4f|%.4f|%g|%s|%d|%s|%s|%s|%g|%g|%t|%s|%d", req.Date, req.Latitude, req.Longitude, req.Elevation,
+		req.Timezone, req.BoundaryWindowSeconds, req.Region, req.SunConvention, req.MoonPosition,
+		req.Pressure, req.Temperature, req.HorizonDip, req.Locale, req.HijriAdjustmentDays)
+}
+
+func (s *PanchangamServer) computePanchangamData(ctx context.Context, req *ppb.GetPanchangamRequest, date time.Time) (*ppb.PanchangamData, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "computePanchangamData")
+	defer span.End()
 
-	// Randomly return some error. This is just for testing.
-	if rand.Intn(10)%2 == 0 {
-		err := status.Error(codes.Internal, "failed to fetch panchangam data")
-		logger.ErrorContext(ctx, "failed to fetch panchangam data", "error", err)
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude, Elevation: req.Elevation}
+	// The convention and moon position were checked by the
+	// ValidationInterceptor.
+	convention, _ := astronomy.ParseSunConvention(req.SunConvention)
+	// Days without sunrise or sunset get conventional times, so that the
+	// panchangam is defined everywhere.
+	sunOpts := []astronomy.SunOption{astronomy.WithSunConvention(convention), astronomy.WithPolarFallback()}
+	if req.Pressure > 0 {
+		sunOpts = append(sunOpts, astronomy.WithAtmosphere(req.Pressure, req.Temperature))
+	}
+	if req.HorizonDip {
+		sunOpts = append(sunOpts, astronomy.WithHorizonDip())
+	}
+	moonPosition, _ := astronomy.ParseMoonPosition(req.MoonPosition)
+	var elementOpts []astronomy.ElementOption
+	if moonPosition == astronomy.TopocentricMoon {
+		elementOpts = append(elementOpts, astronomy.WithObserver(loc))
+	}
+	sunTimes, err := s.calculateSunTimes(ctx, loc, date, sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	nextSunTimes, err := s.calculateSunTimes(ctx, loc, date.AddDate(0, 0, 1), sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	previousSunTimes, err := s.calculateSunTimes(ctx, loc, date.AddDate(0, 0, -1), sunOpts...)
+	if err != nil {
 		return nil, err
 	}
+	// The conventional times of a day without sunrise say nothing about
+	// the drift.
+	drift := &astronomy.SunDrift{}
+	if previousSunTimes.Condition == "" && sunTimes.Condition == "" {
+		drift = astronomy.CalculateSunDrift(previousSunTimes, sunTimes)
+	}
+
+	vikram, err := calendar.Year(calendar.VikramSamvat, sunTimes.Sunrise, req.Region)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	shaka, err := calendar.Year(calendar.ShakaSamvat, sunTimes.Sunrise, req.Region)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	moonTimes := s.calculateMoonTimes(ctx, loc, date)
+	moonPhase := astronomy.CalculateMoonPhase(sunTimes.Sunrise)
+
+	// Elements are those prevailing at sunrise, as is traditional.
+	elements := astronomy.CalculateElements(sunTimes.Sunrise, elementOpts...)
+	masa := astronomy.CalculateMasa(sunTimes.Sunrise)
+	window := astronomy.DefaultBoundaryWindow
+	if req.BoundaryWindowSeconds > 0 {
+		window = time.Duration(req.BoundaryWindowSeconds) * time.Second
+	}
+	gowri, nallaNeram := tamilPeriods(req.Region, sunTimes, nextSunTimes)
+	solar, err := solarDate(req.Region, loc, date, sunTimes)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	bikramSambat, err := calendar.BikramSambat(date)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	// The adjustment was checked by the ValidationInterceptor.
+	hijri, _ := calendar.Hijri(date, int(req.HijriAdjustmentDays))
+	moonRashi, err := s.rashi(ctx, ephemeris.Moon, sunTimes.Sunrise, nextSunTimes.Sunrise, date.Location())
+	if err != nil {
+		return nil, err
+	}
+	sunRashi, err := s.rashi(ctx, ephemeris.Sun, sunTimes.Sunrise, nextSunTimes.Sunrise, date.Location())
+	if err != nil {
+		return nil, err
+	}
+
 	return &ppb.PanchangamData{
-		Date:        date,
-		Tithi:       "Some Tithi",
-		Nakshatra:   "Some Nakshatra",
-		Yoga:        "Some Yoga",
-		Karana:      "Some Karana",
-		SunriseTime: "06:00:00",
-		SunsetTime:  "18:00:00",
-		Events: []*ppb.PanchangamEvent{
-			{Name: "Some Event 1", Time: "08:00:00"},
-			{Name: "Some Event 2", Time: "12:00:00"},
-		},
+		Date:           req.Date,
+		Tithi:          elements.Tithi.Name,
+		Nakshatra:      elements.Nakshatra.Name,
+		Yoga:           elements.Yoga.Name,
+		Karana:         elements.Karana.Name,
+		SunriseTime:    formatSunTime(sunTimes, sunTimes.Sunrise),
+		SunsetTime:     formatSunTime(sunTimes, sunTimes.Sunset),
+		Choghadiya:     s.calculateChoghadiya(ctx, sunTimes.Sunrise, sunTimes.Sunset, nextSunTimes.Sunrise),
+		NearBoundaries: s.nearBoundaries(ctx, sunTimes.Sunrise, window),
+		Masa:           masa.Name,
+		PurnimantaMasa: astronomy.CalculatePurnimantaMasa(sunTimes.Sunrise).Name,
+		AdhikaMasa:     masa.Adhika,
+		KshayaMasa:     masa.Kshaya,
+		Paksha:         string(astronomy.TithiPaksha(elements.Tithi)),
+
+		SunriseDriftSeconds:    seconds(drift.Sunrise),
+		SunsetDriftSeconds:     seconds(drift.Sunset),
+		DayLengthChangeSeconds: seconds(drift.DayLength),
+		DayLengthTrend:         string(drift.Trend()),
+
+		Samvatsara: astronomy.CalculateSamvatsara(sunTimes.Sunrise).Name,
+		Ritu:       astronomy.CalculateRitu(sunTimes.Sunrise).Name,
+		Ayana:      string(astronomy.CalculateAyana(sunTimes.Sunrise)),
+		LocalRitu:  astronomy.CalculateLocalRitu(sunTimes.Sunrise, loc.Latitude).Name,
+		Hemisphere: string(astronomy.HemisphereOf(loc.Latitude)),
+
+		VikramSamvat: int32(vikram),
+		ShakaSamvat:  int32(shaka),
+
+		MoonriseTime:     formatTime(moonTimes.Moonrise),
+		MoonsetTime:      formatTime(moonTimes.Moonset),
+		MoonPhase:        moonPhase.Name,
+		MoonIllumination: moonPhase.Illumination,
+
+		AbhijitMuhurta: muhurtaMessage(astronomy.AbhijitMuhurta(sunTimes.Sunrise, sunTimes.Sunset), req.Date),
+		BrahmaMuhurta:  muhurtaMessage(astronomy.BrahmaMuhurta(sunTimes.Sunrise), req.Date),
+		SunConvention:  string(sunTimes.Convention),
+
+		InauspiciousPeriods: inauspiciousPeriods(sunTimes, nextSunTimes, req.Date),
+
+		GowriPanchangam: gowri,
+		NallaNeram:      nallaNeram,
+
+		NakshatraPadas: s.nakshatraPadas(ctx, date, elementOpts...),
+		MoonPosition:   string(moonPosition),
+		SunCondition:   string(sunTimes.Condition),
+		LocalNames:     s.localNames(req.Locale, elements),
+		SolarDate:      solar,
+		BikramSambat:   solarDateMessage(bikramSambat),
+		HijriDate:      hijriDateMessage(hijri),
+		MoonRashi:      moonRashi,
+		SunRashi:       sunRashi,
 	}, nil
 }
+
+// localNames returns the names of elements in locale, or nil if no locale
+// is requested.
+func (s *PanchangamServer) localNames(locale string, elements astronomy.Elements) *ppb.LocalNames {
+	if locale == "" {
+		return nil
+	}
+	return &ppb.LocalNames{
+		Locale:    locale,
+		Tithi:     s.catalogs.ElementName(locale, elements.Tithi),
+		Nakshatra: s.catalogs.ElementName(locale, elements.Nakshatra),
+		Yoga:      s.catalogs.ElementName(locale, elements.Yoga),
+		Karana:    s.catalogs.ElementName(locale, elements.Karana),
+	}
+}
+
+// nakshatraPadas returns the padas overlapping the civil day starting at
+// date.
+func (s *PanchangamServer) nakshatraPadas(ctx context.Context, date time.Time, opts ...astronomy.ElementOption) []*ppb.NakshatraPada {
+	_, span := s.observer.CreateSpan(ctx, "nakshatraPadas")
+	defer span.End()
+
+	tz := date.Location()
+	var result []*ppb.NakshatraPada
+	for _, p := range astronomy.NakshatraPadas(date, date.AddDate(0, 0, 1), opts...) {
+		start, end := p.Start.In(tz), p.End.In(tz)
+		result = append(result, &ppb.NakshatraPada{
+			Nakshatra: p.Nakshatra.Name,
+			Pada:      int32(p.Pada),
+			StartDate: start.Format(dateLayout),
+			StartTime: start.Format(timeLayout),
+			EndDate:   end.Format(dateLayout),
+			EndTime:   end.Format(timeLayout),
+		})
+	}
+	return result
+}
+
+// formatSunTime formats a sunrise or sunset, or returns an empty string for
+// the conventional times of a day without either.
+func formatSunTime(sunTimes *astronomy.SunTimes, t time.Time) string {
+	if sunTimes.Condition != "" {
+		return ""
+	}
+	return t.Format(timeLayout)
+}
+
+// formatTime formats a time of day, or returns an empty string for the zero
+// time.
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(timeLayout)
+}
+
+// formatInstant formats t in RFC 3339 format, or returns an empty string if
+// it is zero.
+func formatInstant(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// seconds rounds d to whole seconds.
+func seconds(d time.Duration) int32 {
+	return int32(d.Round(time.Second).Seconds())
+}
+
+// timezoneAt returns the timezone of a request, or the timezone at its
+// location if it has none.
+func timezoneAt(timezone string, latitude, longitude float64) string {
+	if timezone != "" {
+		return timezone
+	}
+	return location.TimezoneName(latitude, longitude)
+}
+
+// loadTimezone resolves an IANA timezone name, defaulting to UTC.
+func loadTimezone(timezone string) (*time.Location, error) {
+	if timezone == "" {
+		return time.UTC, nil
+	}
+	tz, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fieldErrorf("timezone", "invalid timezone %q: %v", timezone, err)
+	}
+	return tz, nil
+}
+
+// parseDate parses a YYYY-MM-DD date in the given IANA timezone, defaulting to UTC.
+func parseDate(date, timezone string) (time.Time, error) {
+	tz, err := loadTimezone(timezone)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return parseDateField("date", date, tz)
+}
+
+// parseDateField parses the YYYY-MM-DD date of a request field in tz.
+func parseDateField(field, date string, tz *time.Location) (time.Time, error) {
+	t, err := time.ParseInLocation(dateLayout, date, tz)
+	if err != nil {
+		return time.Time{}, fieldErrorf(field, "invalid date %q: expected YYYY-MM-DD", date)
+	}
+	return t, nil
+}
+
+// dateRange returns the first and last civil dates of a request for a range
+// of days starting on date. Both are inclusive: the range ends on endDate if
+// it is set, or lasts days days, or is the single day of date. When both
+// endDate and days are set they must agree. A range lasts at most
+// maxEventDays days.
+func dateRange(date, endDate string, days int32, timezone string) (first, last time.Time, err error) {
+	first, err = parseDate(date, timezone)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	if days < 0 {
+		return time.Time{}, time.Time{}, fieldErrorf("days", "invalid days %d: expected 1 to %d", days, maxEventDays)
+	}
+	field := "days"
+	switch {
+	case endDate != "":
+		field = "end_date"
+		if last, err = parseDateField(field, endDate, first.Location()); err != nil {
+			return time.Time{}, time.Time{}, err
+		}
+		if last.Before(first) {
+			return time.Time{}, time.Time{}, fieldErrorf(field, "%s is before date %s", endDate, date)
+		}
+		if n := daysBetween(first, last) + 1; days > 0 && int(days) != n {
+			return time.Time{}, time.Time{}, fieldErrorf("days", "%d days conflicts with end_date %s, which makes the range %d days inclusive", days, endDate, n)
+		}
+	case days > 0:
+		last = first.AddDate(0, 0, int(days)-1)
+	default:
+		last = first
+	}
+	if n := daysBetween(first, last) + 1; n > maxEventDays {
+		return time.Time{}, time.Time{}, fieldErrorf(field, "range of %d days is longer than %d days", n, maxEventDays)
+	}
+	return first, last, nil
+}
+
+// daysBetween returns the number of civil days from the date of first to
+// that of last, which is not the number of 24 hour periods across a
+// daylight saving change.
+func daysBetween(first, last time.Time) int {
+	y, m, d := first.Date()
+	from := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	y, m, d = last.Date()
+	to := time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+	return int(to.Sub(from) / (24 * time.Hour))
+}
+
+func (s *PanchangamServer) calculateSunTimes(ctx context.Context, loc astronomy.Location, date time.Time, opts ...astronomy.SunOption) (*astronomy.SunTimes, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "calculateSunTimes")
+	defer span.End()
+
+	sunTimes, err := astronomy.CalculateSunTimes(loc, date, opts...)
+	if errors.Is(err, astronomy.ErrNoSunrise) {
+		logger.WarnContext(ctx, "no sunrise or sunset", "date", date, "latitude", loc.Latitude)
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		logger.ErrorContext(ctx, "failed to calculate sun times", "error", err)
+		return nil, status.Error(codes.Internal, "failed to calculate sun times")
+	}
+	return sunTimes, nil
+}
+
+func (s *PanchangamServer) calculateMoonTimes(ctx context.Context, loc astronomy.Location, date time.Time) *astronomy.MoonTimes {
+	_, span := s.observer.CreateSpan(ctx, "calculateMoonTimes")
+	defer span.End()
+
+	return astronomy.CalculateMoonTimes(loc, date)
+}
+
+func (s *PanchangamServer) calculateChoghadiya(ctx context.Context, sunrise, sunset, nextSunrise time.Time) []*ppb.ChoghadiyaPeriod {
+	_, span := s.observer.CreateSpan(ctx, "calculateChoghadiya")
+	defer span.End()
+
+	periods := astronomy.CalculateChoghadiya(sunrise, sunset, nextSunrise)
+	result := make([]*ppb.ChoghadiyaPeriod, 0, len(periods))
+	for _, p := range periods {
+		result = append(result, &ppb.ChoghadiyaPeriod{
+			Name:      p.Name,
+			Nature:    string(p.Nature),
+			StartTime: p.Start.Format(timeLayout),
+			EndTime:   p.End.Format(timeLayout),
+			IsDay:     p.IsDay,
+		})
+	}
+	return result
+}
+
+// nearBoundaries reports the elements whose transition lies within window of
+// at, since ephemeris uncertainty can flip their value.
+func (s *PanchangamServer) nearBoundaries(ctx context.Context, at time.Time, window time.Duration) []*ppb.ElementBoundary {
+	ctx, span := s.observer.CreateSpan(ctx, "nearBoundaries")
+	defer span.End()
+
+	var result []*ppb.ElementBoundary
+	for _, b := range astronomy.NearBoundaries(at, window) {
+		logger.InfoContext(ctx, "element close to transition", "element", b.Kind, "current", b.Current.Name, "adjacent", b.Adjacent.Name)
+		result = append(result, &ppb.ElementBoundary{
+			Element:       string(b.Kind),
+			CurrentValue:  b.Current.Name,
+			AdjacentValue: b.Adjacent.Name,
+			BoundaryTime:  b.Time.In(at.Location()).Format(timeLayout),
+			OffsetSeconds: seconds(b.Time.Sub(at)),
+		})
+	}
+	return result
+}
@@ -0,0 +1,166 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/naren-m/panchangam/cache"
+	"github.com/naren-m/panchangam/observability"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestDateRange(t *testing.T) {
+	tests := []struct {
+		name          string
+		date, endDate string
+		days          int32
+		wantFirst     string
+		wantLast      string
+		wantField     string
+	}{
+		{name: "single day", date: "2024-03-01", wantFirst: "2024-03-01", wantLast: "2024-03-01"},
+		{name: "days", date: "2024-03-01", days: 3, wantFirst: "2024-03-01", wantLast: "2024-03-03"},
+		{name: "end date inclusive", date: "2024-03-01", endDate: "2024-03-03", wantFirst: "2024-03-01", wantLast: "2024-03-03"},
+		{name: "same day", date: "2024-03-01", endDate: "2024-03-01", wantFirst: "2024-03-01", wantLast: "2024-03-01"},
+		{name: "agreeing days", date: "2024-03-01", endDate: "2024-03-03", days: 3, wantFirst: "2024-03-01", wantLast: "2024-03-03"},
+		{name: "leap year", date: "2024-01-01", endDate: "2024-12-31", wantFirst: "2024-01-01", wantLast: "2024-12-31"},
+		{name: "misordered", date: "2024-03-03", endDate: "2024-03-01", wantField: "end_date"},
+		{name: "conflicting days", date: "2024-03-01", endDate: "2024-03-03", days: 2, wantField: "days"},
+		{name: "negative days", date: "2024-03-01", days: -1, wantField: "days"},
+		{name: "too many days", date: "2024-03-01", days: maxEventDays + 1, wantField: "days"},
+		{name: "too long", date: "2024-01-01", endDate: "2025-01-01", wantField: "end_date"},
+		{name: "invalid date", date: "2024-3-1", wantField: "date"},
+		{name: "invalid end date", date: "2024-03-01", endDate: "tomorrow", wantField: "end_date"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			first, last, err := dateRange(tt.date, tt.endDate, tt.days, "America/New_York")
+			if tt.wantField != "" {
+				var fieldErr *FieldError
+				if !errors.As(err, &fieldErr) || fieldErr.Field != tt.wantField {
+					t.Fatalf("dateRange() error = %v, want an error on %s", err, tt.wantField)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("dateRange() error = %v", err)
+			}
+			if got := first.Format(dateLayout); got != tt.wantFirst {
+				t.Errorf("first = %s, want %s", got, tt.wantFirst)
+			}
+			if got := last.Format(dateLayout); got != tt.wantLast {
+				t.Errorf("last = %s, want %s", got, tt.wantLast)
+			}
+		})
+	}
+}
+
+func TestGetLocalNames(t *testing.T) {
+	s := newTestServer(t)
+	req := &ppb.GetPanchangamRequest{Date: "2024-04-09", Latitude: 17.385, Longitude: 78.4867, Timezone: "Asia/Kolkata"}
+	resp, err := s.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if resp.PanchangamData.LocalNames != nil {
+		t.Errorf("LocalNames = %v without a locale", resp.PanchangamData.LocalNames)
+	}
+	if events := resp.PanchangamData.Events; len(events) != 0 {
+		t.Errorf("Events = %v, want none", events)
+	}
+
+	req.Locale = "te"
+	resp, err = s.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	local := resp.PanchangamData.LocalNames
+	if local.GetLocale() != "te" || local.GetTithi() == "" || local.GetNakshatra() == "" || local.GetYoga() == "" || local.GetKarana() == "" {
+		t.Errorf("LocalNames = %v, want every element named in te", local)
+	}
+}
+
+func TestGetSolarDate(t *testing.T) {
+	s := newTestServer(t)
+	// Chingam 1 of Kollavarsham 1200.
+	req := &ppb.GetPanchangamRequest{Date: "2024-08-17", Latitude: 9.9312, Longitude: 76.2673, Timezone: "Asia/Kolkata"}
+	resp, err := s.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if resp.PanchangamData.SolarDate != nil {
+		t.Errorf("SolarDate = %v without a region", resp.PanchangamData.SolarDate)
+	}
+
+	req.Region = "kerala"
+	resp, err = s.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	want := &ppb.SolarDate{Calendar: "malayalam", Month: 5, MonthName: "Chingam", LocalMonthName: "ചിങ്ങം", Day: 1, Era: "Kollavarsham", Year: 1200}
+	if got := resp.PanchangamData.SolarDate; !proto.Equal(got, want) {
+		t.Errorf("SolarDate = %v, want %v", got, want)
+	}
+}
+
+func TestGetParallelDates(t *testing.T) {
+	s := newTestServer(t)
+	// Ramadan 1445 began on 2024-03-11 in the tabular calendar.
+	req := &ppb.GetPanchangamRequest{Date: "2024-03-11", Latitude: 17.385, Longitude: 78.4867, Timezone: "Asia/Kolkata"}
+	resp, err := s.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	wantBS := &ppb.SolarDate{Calendar: "nepali", Month: 11, MonthName: "Falgun", LocalMonthName: "फागुन", Day: 28, Era: "Bikram Sambat", Year: 2080}
+	if got := resp.PanchangamData.BikramSambat; !proto.Equal(got, wantBS) {
+		t.Errorf("BikramSambat = %v, want %v", got, wantBS)
+	}
+	wantHijri := &ppb.HijriDate{Year: 1445, Month: 9, MonthName: "Ramadan", ArabicMonthName: "رمضان", Day: 1}
+	if got := resp.PanchangamData.HijriDate; !proto.Equal(got, wantHijri) {
+		t.Errorf("HijriDate = %v, want %v", got, wantHijri)
+	}
+
+	req.HijriAdjustmentDays = 1
+	resp, err = s.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if got := resp.PanchangamData.HijriDate; got.GetMonth() != 8 || got.GetDay() != 29 || got.GetAdjustmentDays() != 1 {
+		t.Errorf("HijriDate = %v adjusted by a day, want 29 Shaban", got)
+	}
+}
+
+func TestGetSharedCache(t *testing.T) {
+	newTestServer(t)
+	backend := cache.NewMemoryBackend(0)
+	opts := WithCacheOptions(cache.WithBackend(backend, PanchangamCodec))
+	first, second := NewPanchangamServer(opts), NewPanchangamServer(opts)
+	req := &ppb.GetPanchangamRequest{Date: "2024-04-09", Latitude: 17.385, Longitude: 78.4867, Timezone: "Asia/Kolkata"}
+	want, err := first.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+
+	// A location a metre away shares the panchangam.
+	req.Latitude += 0.00001
+	got, err := second.Get(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	if !proto.Equal(got, want) {
+		t.Errorf("Get() from the shared cache = %v, want %v", got, want)
+	}
+	if stats := second.panchangam.Stats(); stats.SharedHits != 1 || stats.Loads != 0 {
+		t.Errorf("Stats() = %+v, want a shared hit and no load", stats)
+	}
+}
+
+func newTestServer(t *testing.T) *PanchangamServer {
+	t.Helper()
+	if _, err := observability.NewObserver(""); err != nil {
+		t.Fatalf("NewObserver() error = %v", err)
+	}
+	return NewPanchangamServer()
+}
@@ -0,0 +1,49 @@
+package panchangam
+
+import (
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/calendar"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+// solarDate returns the date in the solar calendar of region of the civil
+// day starting at date, and nil for regions following none.
+func solarDate(region string, loc astronomy.Location, date time.Time, sunTimes *astronomy.SunTimes) (*ppb.SolarDate, error) {
+	c, ok := calendar.SolarCalendarFor(region)
+	if !ok {
+		return nil, nil
+	}
+	d, err := calendar.NewSolarDater(loc).Date(c, date, sunTimes)
+	if err != nil {
+		return nil, err
+	}
+	return solarDateMessage(d), nil
+}
+
+// solarDateMessage converts a solar date.
+func solarDateMessage(d calendar.SolarDate) *ppb.SolarDate {
+	return &ppb.SolarDate{
+		Calendar:       string(d.Calendar),
+		Month:          int32(d.Month),
+		MonthName:      d.MonthName,
+		LocalMonthName: d.LocalMonthName,
+		Day:            int32(d.Day),
+		Era:            d.Era,
+		Year:           int32(d.Year),
+		Samvatsara:     d.Samvatsara,
+	}
+}
+
+// hijriDateMessage converts a Hijri date.
+func hijriDateMessage(d calendar.HijriDate) *ppb.HijriDate {
+	return &ppb.HijriDate{
+		Year:            int32(d.Year),
+		Month:           int32(d.Month),
+		MonthName:       d.MonthName,
+		ArabicMonthName: d.ArabicMonthName,
+		Day:             int32(d.Day),
+		AdjustmentDays:  int32(d.Adjustment),
+	}
+}
@@ -0,0 +1,56 @@
+package panchangam
+
+import "time"
+
+// endOfDay is the end time reported for the part of a window that lasts
+// until midnight, so that it sorts after every other time of the day.
+const endOfDay = "24:00:00"
+
+// daySpan is the part of a time window that falls on one civil day.
+type daySpan struct {
+	date       string
+	start, end time.Time
+	// continuesFrom and continuesTo report that the window began on an
+	// earlier day or ends on a later one.
+	continuesFrom bool
+	continuesTo   bool
+}
+
+// startTime and endTime format the bounds of the span on its day.
+func (s daySpan) startTime() string { return formatTime(s.start) }
+
+func (s daySpan) endTime() string {
+	if s.continuesTo || s.end.Format(dateLayout) != s.date {
+		return endOfDay
+	}
+	return formatTime(s.end)
+}
+
+// splitAtMidnight splits the window from start to end into its parts on
+// each civil day of start's location. A window ending exactly at midnight
+// does not extend to the next day.
+func splitAtMidnight(start, end time.Time) []daySpan {
+	tz := start.Location()
+	end = end.In(tz)
+	var spans []daySpan
+	for from := start; ; {
+		y, m, d := from.Date()
+		// time.Date normalizes the day and resolves daylight saving
+		// changes, so the next midnight is found even on 23 and 25 hour
+		// days.
+		midnight := time.Date(y, m, d+1, 0, 0, 0, 0, tz)
+		span := daySpan{
+			date:          from.Format(dateLayout),
+			start:         from,
+			end:           end,
+			continuesFrom: len(spans) > 0,
+		}
+		if !end.After(midnight) {
+			return append(spans, span)
+		}
+		span.end = midnight
+		span.continuesTo = true
+		spans = append(spans, span)
+		from = midnight
+	}
+}
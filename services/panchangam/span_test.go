@@ -0,0 +1,85 @@
+package panchangam
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSplitAtMidnight(t *testing.T) {
+	ist := time.FixedZone("IST", 5*3600+1800)
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skipf("timezone database unavailable: %v", err)
+	}
+	at := func(tz *time.Location, day, hour, min int) time.Time {
+		return time.Date(2025, time.March, day, hour, min, 0, 0, tz)
+	}
+
+	type part struct {
+		date, start, end string
+		from, to         bool
+		length           time.Duration
+	}
+	tests := []struct {
+		name       string
+		start, end time.Time
+		want       []part
+	}{
+		{
+			name:  "within a day",
+			start: at(ist, 14, 6, 30), end: at(ist, 14, 8, 0),
+			want: []part{{"2025-03-14", "06:30:00", "08:00:00", false, false, 90 * time.Minute}},
+		},
+		{
+			name:  "nishita crossing midnight",
+			start: at(ist, 14, 23, 40), end: at(ist, 15, 0, 28),
+			want: []part{
+				{"2025-03-14", "23:40:00", endOfDay, false, true, 20 * time.Minute},
+				{"2025-03-15", "00:00:00", "00:28:00", true, false, 28 * time.Minute},
+			},
+		},
+		{
+			name:  "ending at midnight",
+			start: at(ist, 14, 22, 0), end: at(ist, 15, 0, 0),
+			want: []part{{"2025-03-14", "22:00:00", endOfDay, false, false, 2 * time.Hour}},
+		},
+		{
+			name:  "spanning a whole day",
+			start: at(ist, 14, 18, 0), end: at(ist, 16, 3, 0),
+			want: []part{
+				{"2025-03-14", "18:00:00", endOfDay, false, true, 6 * time.Hour},
+				{"2025-03-15", "00:00:00", endOfDay, true, true, 24 * time.Hour},
+				{"2025-03-16", "00:00:00", "03:00:00", true, false, 3 * time.Hour},
+			},
+		},
+		{
+			// Clocks spring forward at 02:00 on 9 March 2025.
+			name:  "daylight saving day",
+			start: at(newYork, 8, 22, 0), end: at(newYork, 10, 1, 0),
+			want: []part{
+				{"2025-03-08", "22:00:00", endOfDay, false, true, 2 * time.Hour},
+				{"2025-03-09", "00:00:00", endOfDay, true, true, 23 * time.Hour},
+				{"2025-03-10", "00:00:00", "01:00:00", true, false, time.Hour},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			spans := splitAtMidnight(tt.start, tt.end)
+			if len(spans) != len(tt.want) {
+				t.Fatalf("got %d parts, want %d", len(spans), len(tt.want))
+			}
+			var total time.Duration
+			for i, s := range spans {
+				got := part{s.date, s.startTime(), s.endTime(), s.continuesFrom, s.continuesTo, s.end.Sub(s.start)}
+				if got != tt.want[i] {
+					t.Errorf("part %d = %+v, want %+v", i, got, tt.want[i])
+				}
+				total += got.length
+			}
+			if want := tt.end.Sub(tt.start); total != want {
+				t.Errorf("parts last %v in total, want %v", total, want)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package panchangam
+
+import (
+	"context"
+	"strings"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/i18n"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/summary"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetSummary summarizes the panchangam of the requested date in the
+// requested locale, as it is read out in the Panchanga Shravanam.
+func (s *PanchangamServer) GetSummary(ctx context.Context, req *ppb.GetSummaryRequest) (*ppb.Summary, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetSummary")
+	defer span.End()
+	logger.InfoContext(ctx, "Received summary request", "date", req.Date, "locale", req.Locale)
+
+	date, err := parseDate(req.Date, timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+	convention, err := astronomy.ParseSunConvention(req.SunConvention)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	locale := req.Locale
+	if locale == "" {
+		locale = i18n.English
+	}
+	if !s.catalogs.Supports(locale) {
+		return nil, fieldErrorf("locale", "unsupported locale %q: expected one of %s", req.Locale, strings.Join(s.catalogs.Locales(), ", "))
+	}
+
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}
+	sunOpts := []astronomy.SunOption{astronomy.WithSunConvention(convention), astronomy.WithPolarFallback()}
+	sunTimes, err := s.calculateSunTimes(ctx, loc, date, sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	nextSunTimes, err := s.calculateSunTimes(ctx, loc, date.AddDate(0, 0, 1), sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	day := summary.Compute(date, sunTimes.Sunrise, sunTimes.Sunset, nextSunTimes.Sunrise)
+
+	resp := &ppb.Summary{
+		Date:   date.Format(dateLayout),
+		Locale: locale,
+		Text:   day.Text(s.catalogs, locale),
+	}
+	for _, l := range day.Lines(s.catalogs, locale) {
+		resp.Lines = append(resp.Lines, &ppb.SummaryLine{Label: l.Label, Value: l.Value})
+	}
+	logger.InfoContext(ctx, "Prepared summary", "lines", len(resp.Lines))
+	return resp, nil
+}
@@ -0,0 +1,36 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+)
+
+func TestGetSummary(t *testing.T) {
+	s := newTestServer(t)
+	resp, err := s.GetSummary(context.Background(), &ppb.GetSummaryRequest{
+		Date:      "2024-04-09",
+		Latitude:  12.9716,
+		Longitude: 77.5946,
+		Timezone:  "Asia/Kolkata",
+		Locale:    "ta",
+	})
+	if err != nil {
+		t.Fatalf("GetSummary() error = %v", err)
+	}
+	if resp.Locale != "ta" || len(resp.Lines) == 0 {
+		t.Fatalf("GetSummary() = %v", resp)
+	}
+	if !strings.HasPrefix(resp.Text, "2024-04-09\n") || !strings.Contains(resp.Text, "சைத்ர") {
+		t.Errorf("Text = %q, want the date and the Tamil masa", resp.Text)
+	}
+
+	var fieldErr *FieldError
+	_, err = s.GetSummary(context.Background(), &ppb.GetSummaryRequest{Date: "2024-04-09", Locale: "fr"})
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "locale" {
+		t.Errorf("GetSummary() with locale fr error = %v, want an error on locale", err)
+	}
+}
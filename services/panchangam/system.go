@@ -0,0 +1,129 @@
+package panchangam
+
+import (
+	"context"
+	"runtime"
+	"time"
+
+	"github.com/naren-m/panchangam/cache"
+	"github.com/naren-m/panchangam/ephemeris"
+	"github.com/naren-m/panchangam/observability"
+	"github.com/naren-m/panchangam/parallel"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/version"
+	"google.golang.org/grpc/status"
+)
+
+// Ephemeris providers are checked at most once a minute, as some query
+// remote services, and a check taking longer than providerCheckTimeout
+// fails.
+const (
+	providerCheckTTL     = time.Minute
+	providerCheckTimeout = 5 * time.Second
+)
+
+// GetSystemInfo reports the build of the server, the health of the
+// registered ephemeris providers and the statistics of its caches.
+func (s *PanchangamServer) GetSystemInfo(ctx context.Context, req *ppb.GetSystemInfoRequest) (*ppb.SystemInfo, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetSystemInfo")
+	defer span.End()
+
+	info := &ppb.SystemInfo{
+		Version:       version.Version,
+		Commit:        version.Commit(),
+		GoVersion:     runtime.Version(),
+		StartTime:     s.started.Format(time.RFC3339),
+		UptimeSeconds: int64(s.now().Sub(s.started).Seconds()),
+	}
+	for _, c := range s.caches() {
+		info.Caches = append(info.Caches, cacheStats(c))
+	}
+	providers := s.providers()
+	checks, err := parallel.Map(ctx, len(providers), 0, func(ctx context.Context, i int) (*ppb.EphemerisProviderHealth, error) {
+		p := providers[i]
+		if !s.providerEnabled(p.Name()) {
+			return &ppb.EphemerisProviderHealth{Name: p.Name(), Disabled: true}, nil
+		}
+		return s.providerChecks.Get(ctx, p.Name(), func(ctx context.Context) (*ppb.EphemerisProviderHealth, error) {
+			return s.checkProvider(ctx, p), nil
+		})
+	})
+	if err != nil {
+		return nil, status.FromContextError(err).Err()
+	}
+	info.EphemerisProviders = checks
+	return info, nil
+}
+
+// GetVersion reports the version the server was built as and the optional
+// features built into it.
+func (s *PanchangamServer) GetVersion(ctx context.Context, req *ppb.GetVersionRequest) (*ppb.VersionInfo, error) {
+	_, span := s.observer.CreateSpan(ctx, "GetVersion")
+	defer span.End()
+
+	return &ppb.VersionInfo{
+		Version:   version.Version,
+		Commit:    version.Commit(),
+		BuildDate: version.BuildDate,
+		GoVersion: runtime.Version(),
+		Features:  version.FeatureList(),
+	}, nil
+}
+
+// checkProvider computes a position with p to report its health.
+func (s *PanchangamServer) checkProvider(ctx context.Context, p ephemeris.Provider) *ppb.EphemerisProviderHealth {
+	ctx, cancel := context.WithTimeout(ctx, providerCheckTimeout)
+	defer cancel()
+	start := s.now()
+	err := ephemeris.Check(ctx, p)
+	health := &ppb.EphemerisProviderHealth{
+		Name:        p.Name(),
+		Healthy:     err == nil,
+		LatencyMs:   s.now().Sub(start).Milliseconds(),
+		CheckedTime: start.Format(time.RFC3339),
+	}
+	if err != nil {
+		logger.WarnContext(ctx, "Ephemeris provider is unhealthy", "provider", p.Name(), "error", err)
+		observability.RecordError(ctx, "ephemeris", err, observability.WithCategory(observability.CategoryDependency),
+			observability.WithRetryable(true))
+		health.Error = err.Error()
+	}
+	return health
+}
+
+// registeredProviders returns the registered ephemeris providers by name.
+func registeredProviders() []ephemeris.Provider {
+	var providers []ephemeris.Provider
+	for _, name := range ephemeris.Names() {
+		if p, err := ephemeris.Lookup(name); err == nil {
+			providers = append(providers, p)
+		}
+	}
+	return providers
+}
+
+// namedCache is a cache of the server, whatever the type of its values.
+type namedCache interface {
+	Name() string
+	Len() int
+	Stats() cache.Stats
+	Purge(ctx context.Context) error
+}
+
+// caches returns the caches of the server.
+func (s *PanchangamServer) caches() []namedCache {
+	return []namedCache{s.panchangam, s.providerChecks}
+}
+
+func cacheStats(c namedCache) *ppb.CacheStats {
+	stats := c.Stats()
+	return &ppb.CacheStats{
+		Name:       c.Name(),
+		Entries:    int64(c.Len()),
+		Hits:       stats.Hits,
+		Misses:     stats.Misses,
+		Loads:      stats.Loads,
+		Refreshes:  stats.Refreshes,
+		SharedHits: stats.SharedHits,
+	}
+}
@@ -0,0 +1,102 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	"github.com/naren-m/panchangam/ephemeris"
+	"github.com/naren-m/panchangam/ephemeris/ephemeristest"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/version"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestGetSystemInfo(t *testing.T) {
+	s := newTestServer(t)
+	broken := ephemeristest.New("broken")
+	broken.Fail(errors.New("no data files"))
+	s.providers = func() []ephemeris.Provider {
+		return []ephemeris.Provider{ephemeris.BuiltinProvider{}, broken}
+	}
+	req := &ppb.GetPanchangamRequest{Date: "2024-04-09", Latitude: 17.385, Longitude: 78.4867}
+	s.Get(context.Background(), req)
+	s.Get(context.Background(), req)
+
+	info, err := s.GetSystemInfo(context.Background(), &ppb.GetSystemInfoRequest{})
+	if err != nil {
+		t.Fatalf("GetSystemInfo() error = %v", err)
+	}
+	if info.Version == "" || info.GoVersion == "" || info.StartTime == "" {
+		t.Errorf("GetSystemInfo() = %v, want the build and start time", info)
+	}
+	providers := info.EphemerisProviders
+	if len(providers) != 2 || !providers[0].Healthy || providers[1].Healthy || providers[1].Error == "" {
+		t.Errorf("EphemerisProviders = %v, want builtin healthy and broken not", providers)
+	}
+	if c := info.Caches[0]; c.Name != "panchangam" || c.Entries != 1 || c.Hits != 1 || c.Misses != 1 || c.Loads != 1 {
+		t.Errorf("Caches[0] = %v, want the panchangam cache with a hit and a load", c)
+	}
+
+	// The checks are reused for a while.
+	if _, err := s.GetSystemInfo(context.Background(), &ppb.GetSystemInfoRequest{}); err != nil {
+		t.Fatalf("GetSystemInfo() error = %v", err)
+	}
+	if calls := len(broken.Calls()); calls != 1 {
+		t.Errorf("broken provider checked %d times, want 1", calls)
+	}
+}
+
+func TestGetVersion(t *testing.T) {
+	defer func(v, date, features string) {
+		version.Version, version.BuildDate, version.Features = v, date, features
+	}(version.Version, version.BuildDate, version.Features)
+	version.Version, version.BuildDate, version.Features = "v1.2.0", "2024-05-01T10:00:00Z", "swisseph"
+
+	s := newTestServer(t)
+	info, err := s.GetVersion(context.Background(), &ppb.GetVersionRequest{})
+	if err != nil {
+		t.Fatalf("GetVersion() error = %v", err)
+	}
+	if info.Version != "v1.2.0" || info.BuildDate != "2024-05-01T10:00:00Z" || info.GoVersion == "" {
+		t.Errorf("GetVersion() = %v, want the build set at link time", info)
+	}
+	if len(info.Features) != 1 || info.Features[0] != "swisseph" {
+		t.Errorf("Features = %v, want [swisseph]", info.Features)
+	}
+}
+
+func TestGetUsageReport(t *testing.T) {
+	s := newTestServer(t)
+	if _, err := s.GetUsageReport(context.Background(), &ppb.GetUsageReportRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("GetUsageReport() without usage error = %v, want FailedPrecondition", err)
+	}
+
+	store, err := aaa.OpenUsageStore(filepath.Join(t.TempDir(), "usage.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	usage := aaa.NewUsage(store)
+	usage.Record("k1", ppb.Panchangam_Get_FullMethodName, nil, 10*time.Millisecond)
+	usage.Record("k1", ppb.Panchangam_Get_FullMethodName, errors.New("invalid"), 30*time.Millisecond)
+	usage.Record("k2", ppb.Panchangam_GetEvents_FullMethodName, nil, time.Millisecond)
+	s.usage = usage
+	today := time.Now().UTC().Format(dateLayout)
+	report, err := s.GetUsageReport(context.Background(), &ppb.GetUsageReportRequest{Date: today, KeyId: "k1"})
+	if err != nil {
+		t.Fatalf("GetUsageReport() error = %v", err)
+	}
+	want := &ppb.UsageReport{
+		Rows:        []*ppb.UsageRow{{Date: today, KeyId: "k1", Method: ppb.Panchangam_Get_FullMethodName, Calls: 2, Errors: 1, MeanLatencyMs: 20, MaxLatencyMs: 30}},
+		TotalCalls:  2,
+		TotalErrors: 1,
+	}
+	if !proto.Equal(report, want) {
+		t.Errorf("GetUsageReport() = %v, want %v", report, want)
+	}
+}
@@ -0,0 +1,36 @@
+package panchangam
+
+import (
+	"github.com/naren-m/panchangam/astronomy"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/tamil"
+)
+
+// tamilPeriods returns the Gowri Panchangam and Nalla Neram of the day for
+// the tamil_nadu region, and nothing for the other regions.
+func tamilPeriods(region string, sunTimes, nextSunTimes *astronomy.SunTimes) (gowri, nallaNeram []*ppb.TamilPeriod) {
+	if region != tamil.Region {
+		return nil, nil
+	}
+	for _, p := range tamil.GowriPanchangam(sunTimes.Sunrise, sunTimes.Sunset, nextSunTimes.Sunrise) {
+		gowri = append(gowri, &ppb.TamilPeriod{
+			Name:      p.Name,
+			TamilName: p.TamilName,
+			Nature:    string(p.Nature),
+			StartTime: p.Start.Format(timeLayout),
+			EndTime:   p.End.Format(timeLayout),
+			IsDay:     p.IsDay,
+		})
+	}
+	for _, w := range tamil.NallaNeramWindows(sunTimes.Sunrise, sunTimes.Sunset, nextSunTimes.Sunrise) {
+		nallaNeram = append(nallaNeram, &ppb.TamilPeriod{
+			Name:      tamil.NallaNeram,
+			TamilName: tamil.TamilName(tamil.NallaNeram),
+			Nature:    string(astronomy.Auspicious),
+			StartTime: w.Start.Format(timeLayout),
+			EndTime:   w.End.Format(timeLayout),
+			IsDay:     w.IsDay,
+		})
+	}
+	return gowri, nallaNeram
+}
@@ -0,0 +1,163 @@
+package panchangam
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/transition"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// maxNoticeMinutes bounds how long ahead a period may be announced.
+const maxNoticeMinutes = 120
+
+// transitionHorizon is how far ahead the transitions are scheduled at a
+// time.
+const transitionHorizon = 24 * time.Hour
+
+// Bounds of GetNextTransitions: the number of transitions listed, and how
+// far ahead they are searched for.
+const (
+	defaultTransitionCount = 10
+	maxTransitionCount     = 100
+	maxTransitionSearch    = 366 * 24 * time.Hour
+)
+
+// WatchTransitions sends the transitions of the panchangam at the requested
+// location as they become due, until the call is cancelled.
+func (s *PanchangamServer) WatchTransitions(req *ppb.WatchTransitionsRequest, stream ppb.Panchangam_WatchTransitionsServer) error {
+	ctx, span := s.observer.CreateSpan(stream.Context(), "WatchTransitions")
+	defer span.End()
+	logger.InfoContext(ctx, "Received transition subscription", "latitude", req.Latitude, "longitude", req.Longitude)
+
+	zone, err := loadTimezone(timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return err
+	}
+	if req.Locale != "" && !s.catalogs.Supports(req.Locale) {
+		return fieldErrorf("locale", "unsupported locale %q: expected one of %s", req.Locale, strings.Join(s.catalogs.Locales(), ", "))
+	}
+	if req.NoticeMinutes < 0 || req.NoticeMinutes > maxNoticeMinutes {
+		return fieldErrorf("notice_minutes", "invalid notice_minutes %d: expected 0 to %d", req.NoticeMinutes, maxNoticeMinutes)
+	}
+	notice := transition.DefaultNotice
+	if req.NoticeMinutes > 0 {
+		notice = time.Duration(req.NoticeMinutes) * time.Minute
+	}
+	if req.NoNotice {
+		notice = 0
+	}
+	// Send the headers now, so that the client learns that the
+	// subscription was accepted before the first transition is due.
+	if err := stream.SendHeader(metadata.MD{}); err != nil {
+		return err
+	}
+
+	scheduler := transition.NewScheduler(astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}, zone, transition.WithNotice(notice))
+	for from := s.now(); ; from = from.Add(transitionHorizon) {
+		transitions, err := scheduler.Between(from, from.Add(transitionHorizon))
+		if err != nil {
+			return status.Errorf(codes.Internal, "failed to schedule transitions: %v", err)
+		}
+		for _, t := range transitions {
+			timer := time.NewTimer(t.Time.Sub(s.now()))
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				logger.InfoContext(ctx, "Transition subscription ended")
+				return status.FromContextError(ctx.Err()).Err()
+			case <-timer.C:
+			}
+			if err := stream.Send(s.transitionMessage(t, req.Locale)); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// GetNextTransitions lists the transitions of the panchangam at the
+// requested location following the requested time.
+func (s *PanchangamServer) GetNextTransitions(ctx context.Context, req *ppb.GetNextTransitionsRequest) (*ppb.GetNextTransitionsResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetNextTransitions")
+	defer span.End()
+	logger.InfoContext(ctx, "Received next transitions request", "time", req.Time, "count", req.Count)
+
+	zone, err := loadTimezone(timezoneAt(req.Timezone, req.Latitude, req.Longitude))
+	if err != nil {
+		return nil, err
+	}
+	from := s.now()
+	if req.Time != "" {
+		if from, err = time.Parse(time.RFC3339, req.Time); err != nil {
+			return nil, fieldErrorf("time", "invalid time %q: expected RFC 3339, e.g. 2024-04-09T14:30:00+05:30", req.Time)
+		}
+	}
+	if req.Locale != "" && !s.catalogs.Supports(req.Locale) {
+		return nil, fieldErrorf("locale", "unsupported locale %q: expected one of %s", req.Locale, strings.Join(s.catalogs.Locales(), ", "))
+	}
+	count := int(req.Count)
+	if count == 0 {
+		count = defaultTransitionCount
+	}
+	if count < 1 || count > maxTransitionCount {
+		return nil, fieldErrorf("count", "invalid count %d: expected 1 to %d", req.Count, maxTransitionCount)
+	}
+	opts := []transition.Option{transition.WithNotice(0)}
+	if len(req.Kinds) > 0 {
+		kinds := make([]transition.Kind, 0, len(req.Kinds))
+		for _, k := range req.Kinds {
+			kind, err := transition.ParseKind(k)
+			if err != nil {
+				return nil, fieldErrorf("kinds", "%v: expected one of %s", err, transitionKindList())
+			}
+			kinds = append(kinds, kind)
+		}
+		opts = append(opts, transition.WithKinds(kinds...))
+	}
+
+	scheduler := transition.NewScheduler(astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude}, zone, opts...)
+	resp := &ppb.GetNextTransitionsResponse{Timezone: zone.String()}
+	for start := from; len(resp.Transitions) < count && start.Sub(from) < maxTransitionSearch; start = start.Add(transitionHorizon) {
+		transitions, err := scheduler.Between(start, start.Add(transitionHorizon))
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to schedule transitions: %v", err)
+		}
+		for _, t := range transitions {
+			if len(resp.Transitions) == count {
+				break
+			}
+			resp.Transitions = append(resp.Transitions, s.transitionMessage(t, req.Locale))
+		}
+	}
+	logger.InfoContext(ctx, "Prepared next transitions", "transitions", len(resp.Transitions))
+	return resp, nil
+}
+
+// transitionMessage converts t, with the local name of its element in
+// locale.
+func (s *PanchangamServer) transitionMessage(t transition.Transition, locale string) *ppb.Transition {
+	msg := &ppb.Transition{
+		Kind:    string(t.Kind),
+		Event:   string(t.Event),
+		Name:    t.Name,
+		Time:    t.At.Format(time.RFC3339),
+		Message: t.Message(),
+	}
+	if locale != "" && t.Element.Kind != "" {
+		msg.LocalName = s.catalogs.ElementName(locale, t.Element)
+	}
+	return msg
+}
+
+func transitionKindList() string {
+	var kinds []string
+	for _, k := range transition.Kinds() {
+		kinds = append(kinds, string(k))
+	}
+	return strings.Join(kinds, ", ")
+}
@@ -0,0 +1,118 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/services/panchangam/panchangamtest"
+	"github.com/naren-m/panchangam/transition"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// transitionStream collects the transitions sent by WatchTransitions.
+type transitionStream struct {
+	grpc.ServerStream
+	ctx         context.Context
+	transitions chan *ppb.Transition
+}
+
+func (s *transitionStream) Context() context.Context     { return s.ctx }
+func (s *transitionStream) SendHeader(metadata.MD) error { return nil }
+func (s *transitionStream) Send(t *ppb.Transition) error {
+	s.transitions <- t
+	return nil
+}
+
+func TestWatchTransitions(t *testing.T) {
+	s := newTestServer(t)
+	ist, _ := time.LoadLocation("Asia/Kolkata")
+	bengaluru := astronomy.Location{Latitude: 12.9716, Longitude: 77.5946}
+	start := time.Date(2024, 4, 9, 12, 0, 0, 0, ist)
+	transitions, err := transition.NewScheduler(bengaluru, ist).Between(start, start.Add(24*time.Hour))
+	if err != nil || len(transitions) == 0 {
+		t.Fatalf("Between() = %v, %v", transitions, err)
+	}
+	// Freeze the clock of the server just before the first transition.
+	WithClock(panchangamtest.NewClock(transitions[0].Time.Add(-50 * time.Millisecond)).Now)(s)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &transitionStream{ctx: ctx, transitions: make(chan *ppb.Transition)}
+	done := make(chan error, 1)
+	go func() {
+		done <- s.WatchTransitions(&ppb.WatchTransitionsRequest{
+			Latitude: bengaluru.Latitude, Longitude: bengaluru.Longitude, Timezone: "Asia/Kolkata", Locale: "hi",
+		}, stream)
+	}()
+	select {
+	case got := <-stream.transitions:
+		want := transitions[0]
+		if got.Kind != string(want.Kind) || got.Name != want.Name || got.Time != want.At.Format(time.RFC3339) || got.Message != want.Message() {
+			t.Errorf("first transition = %v, want %v", got, want)
+		}
+	case err := <-done:
+		t.Fatalf("WatchTransitions() = %v before the first transition", err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("no transition sent")
+	}
+	cancel()
+	if err := <-done; status.Code(err) != codes.Canceled {
+		t.Errorf("WatchTransitions() after cancel = %v, want Canceled", err)
+	}
+
+	var fieldErr *FieldError
+	err = s.WatchTransitions(&ppb.WatchTransitionsRequest{NoticeMinutes: 600}, stream)
+	if !errors.As(err, &fieldErr) || fieldErr.Field != "notice_minutes" {
+		t.Errorf("WatchTransitions() with a notice of 600 minutes = %v, want an error on notice_minutes", err)
+	}
+}
+
+func TestGetNextTransitions(t *testing.T) {
+	s := newTestServer(t)
+	req := &ppb.GetNextTransitionsRequest{
+		Time:      "2024-04-09T12:00:00+05:30",
+		Latitude:  12.9716,
+		Longitude: 77.5946,
+		Timezone:  "Asia/Kolkata",
+		Locale:    "ta",
+		Count:     5,
+		Kinds:     []string{"tithi", "sunset", "moonrise"},
+	}
+	resp, err := s.GetNextTransitions(context.Background(), req)
+	if err != nil {
+		t.Fatalf("GetNextTransitions() error = %v", err)
+	}
+	if len(resp.Transitions) != 5 || resp.Timezone != "Asia/Kolkata" {
+		t.Fatalf("GetNextTransitions() = %v, want 5 transitions in Asia/Kolkata", resp)
+	}
+	previous := req.Time
+	for _, tr := range resp.Transitions {
+		if tr.Kind != "tithi" && tr.Kind != "sunset" && tr.Kind != "moonrise" {
+			t.Errorf("transition of kind %s", tr.Kind)
+		}
+		if tr.Time < previous {
+			t.Errorf("transition at %s before %s", tr.Time, previous)
+		}
+		if tr.Kind == "tithi" && tr.LocalName == "" {
+			t.Errorf("tithi %s has no Tamil name", tr.Name)
+		}
+		previous = tr.Time
+	}
+
+	for field, bad := range map[string]*ppb.GetNextTransitionsRequest{
+		"time":  {Time: "2024-04-09 12:00"},
+		"count": {Count: 1000},
+		"kinds": {Kinds: []string{"eclipse"}},
+	} {
+		var fieldErr *FieldError
+		if _, err := s.GetNextTransitions(context.Background(), bad); !errors.As(err, &fieldErr) || fieldErr.Field != field {
+			t.Errorf("GetNextTransitions(%v) error = %v, want an error on %s", bad, err, field)
+		}
+	}
+}
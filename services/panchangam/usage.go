@@ -0,0 +1,59 @@
+package panchangam
+
+import (
+	"context"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// GetUsageReport reports the daily calls, errors and latency of the RPCs
+// by API key, from the rollups of the usage set with WithUsage. Like the
+// events, a report covers at most a year of UTC days.
+func (s *PanchangamServer) GetUsageReport(ctx context.Context, req *ppb.GetUsageReportRequest) (*ppb.UsageReport, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetUsageReport")
+	defer span.End()
+
+	if s.usage == nil {
+		return nil, status.Error(codes.FailedPrecondition, "usage is not recorded: start the server with -usage-file or -usage-db")
+	}
+	date := req.Date
+	if date == "" {
+		date = s.now().UTC().Format(dateLayout)
+	}
+	first, last, err := dateRange(date, req.EndDate, 0, "UTC")
+	if err != nil {
+		return nil, err
+	}
+	records, err := s.usage.Report(ctx, aaa.UsageQuery{
+		From:   first.Format(dateLayout),
+		To:     last.Format(dateLayout),
+		KeyID:  req.KeyId,
+		Method: req.Method,
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Unavailable, "reading usage: %v", err)
+	}
+	report := &ppb.UsageReport{}
+	for _, r := range records {
+		report.Rows = append(report.Rows, &ppb.UsageRow{
+			Date:          r.Day,
+			KeyId:         r.KeyID,
+			Method:        r.Method,
+			Calls:         r.Calls,
+			Errors:        r.Errors,
+			MeanLatencyMs: milliseconds(r.MeanLatency()),
+			MaxLatencyMs:  milliseconds(r.MaxLatency),
+		})
+		report.TotalCalls += r.Calls
+		report.TotalErrors += r.Errors
+	}
+	return report, nil
+}
+
+func milliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -0,0 +1,176 @@
+package panchangam
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/i18n"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	pbv2 "github.com/naren-m/panchangam/proto/v2/panchangam"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+// V2Server serves version 2 of the Panchangam API, in which the limbs of the
+// panchangam are structured elements and times are timestamps. It shares
+// the validation, cache and calendar of the version 1 PanchangamServer it
+// wraps, which keeps serving version 1 clients unchanged.
+type V2Server struct {
+	*PanchangamServer
+	pbv2.UnimplementedPanchangamServer
+}
+
+// NewV2Server returns a version 2 server computing with s.
+func NewV2Server(s *PanchangamServer) *V2Server {
+	return &V2Server{PanchangamServer: s}
+}
+
+func (s *V2Server) Get(ctx context.Context, req *pbv2.GetPanchangamRequest) (*pbv2.GetPanchangamResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "GetV2")
+	defer span.End()
+	logger.InfoContext(ctx, "Received v2 request", "date", req.Date)
+
+	v1 := &ppb.GetPanchangamRequest{
+		Date:          req.Date,
+		Latitude:      req.Latitude,
+		Longitude:     req.Longitude,
+		Timezone:      req.Timezone,
+		Region:        req.Region,
+		SunConvention: req.SunConvention,
+		Elevation:     req.Elevation,
+		MoonPosition:  req.MoonPosition,
+		Locale:        req.Locale,
+	}
+	// The version 1 panchangam provides the calendar of the date.
+	d, err := s.fetchPanchangamData(ctx, v1)
+	if err != nil {
+		return nil, err
+	}
+	timezone := timezoneAt(req.Timezone, req.Latitude, req.Longitude)
+	date, err := parseDate(req.Date, timezone)
+	if err != nil {
+		return nil, err
+	}
+
+	loc := astronomy.Location{Latitude: req.Latitude, Longitude: req.Longitude, Elevation: req.Elevation}
+	convention, _ := astronomy.ParseSunConvention(req.SunConvention)
+	sunOpts := []astronomy.SunOption{astronomy.WithSunConvention(convention), astronomy.WithPolarFallback()}
+	moonPosition, _ := astronomy.ParseMoonPosition(req.MoonPosition)
+	var elementOpts []astronomy.ElementOption
+	if moonPosition == astronomy.TopocentricMoon {
+		elementOpts = append(elementOpts, astronomy.WithObserver(loc))
+	}
+	sunTimes, err := s.calculateSunTimes(ctx, loc, date, sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	nextSunTimes, err := s.calculateSunTimes(ctx, loc, date.AddDate(0, 0, 1), sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	moonTimes := s.calculateMoonTimes(ctx, loc, date)
+
+	locale := req.Locale
+	if locale == "" {
+		locale = i18n.English
+	}
+	element := func(p astronomy.ElementPeriod) *pbv2.Element {
+		return elementMessage(p, s.catalogs.ElementName(locale, p.Element))
+	}
+	period := func(kind astronomy.ElementKind) astronomy.ElementPeriod {
+		return astronomy.CalculateElementPeriod(kind, sunTimes.Sunrise, elementOpts...)
+	}
+
+	resp := &pbv2.GetPanchangamResponse{
+		PanchangamData: &pbv2.PanchangamData{
+			Date:      req.Date,
+			Timezone:  timezone,
+			Tithi:     element(period(astronomy.TithiElement)),
+			Nakshatra: element(period(astronomy.NakshatraElement)),
+			Yoga:      element(period(astronomy.YogaElement)),
+			Karana:    element(period(astronomy.KaranaElement)),
+			Vara:      element(astronomy.CalculateVara(sunTimes.Sunrise, nextSunTimes.Sunrise)),
+
+			Sunrise:      timestamppb.New(sunTimes.Sunrise),
+			Sunset:       timestamppb.New(sunTimes.Sunset),
+			Moonrise:     timestamp(moonTimes.Moonrise),
+			Moonset:      timestamp(moonTimes.Moonset),
+			SunCondition: d.SunCondition,
+
+			Masa:           d.Masa,
+			PurnimantaMasa: d.PurnimantaMasa,
+			AdhikaMasa:     d.AdhikaMasa,
+			KshayaMasa:     d.KshayaMasa,
+			Paksha:         d.Paksha,
+			Samvatsara:     d.Samvatsara,
+			Ritu:           d.Ritu,
+			Ayana:          d.Ayana,
+			VikramSamvat:   d.VikramSamvat,
+			ShakaSamvat:    d.ShakaSamvat,
+
+			MoonPhase:        d.MoonPhase,
+			MoonIllumination: d.MoonIllumination,
+
+			Choghadiya: choghadiyaPeriods(astronomy.CalculateChoghadiya(sunTimes.Sunrise, sunTimes.Sunset, nextSunTimes.Sunrise)),
+			Muhurtas:   muhurtaPeriods(sunTimes, nextSunTimes),
+		},
+	}
+	logger.InfoContext(ctx, "Prepared v2 response")
+	return resp, nil
+}
+
+// elementMessage converts an element with its local name.
+func elementMessage(p astronomy.ElementPeriod, localName string) *pbv2.Element {
+	return &pbv2.Element{
+		Number:    int32(p.Number),
+		Name:      p.Name,
+		LocalName: localName,
+		Start:     timestamppb.New(p.Start),
+		End:       timestamppb.New(p.End),
+		Qualities: p.Qualities(),
+	}
+}
+
+// timestamp converts t, leaving it unset when t is zero.
+func timestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+	return timestamppb.New(t)
+}
+
+func choghadiyaPeriods(periods []astronomy.ChoghadiyaPeriod) []*pbv2.Period {
+	result := make([]*pbv2.Period, 0, len(periods))
+	for _, p := range periods {
+		result = append(result, &pbv2.Period{
+			Name:   p.Name,
+			Start:  timestamppb.New(p.Start),
+			End:    timestamppb.New(p.End),
+			Nature: string(p.Nature),
+			IsDay:  p.IsDay,
+		})
+	}
+	return result
+}
+
+// muhurtaPeriods returns the Brahma and Abhijit Muhurta, Durmuhurtam and
+// Varjyam of the day beginning at sunrise, ordered by start.
+func muhurtaPeriods(sunTimes, nextSunTimes *astronomy.SunTimes) []*pbv2.Period {
+	var periods []*pbv2.Period
+	add := func(nature astronomy.Nature, ps ...astronomy.Period) {
+		for _, p := range ps {
+			periods = append(periods, &pbv2.Period{
+				Name:   p.Name,
+				Start:  timestamppb.New(p.Start),
+				End:    timestamppb.New(p.End),
+				Nature: string(nature),
+			})
+		}
+	}
+	add(astronomy.Auspicious, astronomy.BrahmaMuhurta(sunTimes.Sunrise), astronomy.AbhijitMuhurta(sunTimes.Sunrise, sunTimes.Sunset))
+	add(astronomy.Inauspicious, astronomy.Durmuhurtas(sunTimes.Sunrise, sunTimes.Sunset, nextSunTimes.Sunrise)...)
+	add(astronomy.Inauspicious, astronomy.Varjyams(sunTimes.Sunrise, nextSunTimes.Sunrise)...)
+	sort.SliceStable(periods, func(i, j int) bool { return periods[i].Start.AsTime().Before(periods[j].Start.AsTime()) })
+	return periods
+}
@@ -0,0 +1,50 @@
+package panchangam
+
+import (
+	"context"
+	"testing"
+
+	pbv2 "github.com/naren-m/panchangam/proto/v2/panchangam"
+)
+
+func TestV2Get(t *testing.T) {
+	s := NewV2Server(newTestServer(t))
+	resp, err := s.Get(context.Background(), &pbv2.GetPanchangamRequest{
+		Date:      "2024-04-09",
+		Latitude:  12.9716,
+		Longitude: 77.5946,
+		Timezone:  "Asia/Kolkata",
+		Locale:    "hi",
+	})
+	if err != nil {
+		t.Fatalf("Get() error = %v", err)
+	}
+	d := resp.PanchangamData
+	if d.Masa != "Chaitra" {
+		t.Errorf("Masa = %s, want Chaitra", d.Masa)
+	}
+	if d.Vara.Name != "Mangalavara" || d.Vara.LocalName != "मंगलवार" || d.Vara.Number != 3 {
+		t.Errorf("Vara = %v, want Mangalavara", d.Vara)
+	}
+	sunrise := d.Sunrise.AsTime()
+	for _, e := range []*pbv2.Element{d.Tithi, d.Nakshatra, d.Yoga, d.Karana} {
+		if e.Name == "" || e.LocalName == "" || len(e.Qualities) == 0 {
+			t.Errorf("element %v lacks a name or qualities", e)
+		}
+		if e.Start.AsTime().After(sunrise) || !e.End.AsTime().After(sunrise) {
+			t.Errorf("element %s spans %v to %v, not sunrise %v", e.Name, e.Start.AsTime(), e.End.AsTime(), sunrise)
+		}
+	}
+	if len(d.Choghadiya) != 16 {
+		t.Errorf("len(Choghadiya) = %d, want 16", len(d.Choghadiya))
+	}
+	for i := 1; i < len(d.Muhurtas); i++ {
+		if d.Muhurtas[i].Start.AsTime().Before(d.Muhurtas[i-1].Start.AsTime()) {
+			t.Errorf("muhurtas out of order at %d", i)
+		}
+	}
+
+	if _, err := s.Get(context.Background(), &pbv2.GetPanchangamRequest{Date: "2024-4-9"}); err == nil {
+		t.Error("Get() with an invalid date succeeded")
+	}
+}
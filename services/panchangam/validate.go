@@ -0,0 +1,219 @@
+package panchangam
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/calendar"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// ValidationError reports every invalid field of a request. Like a
+// FieldError it converts to an InvalidArgument status with a BadRequest
+// detail, listing each violation.
+type ValidationError struct {
+	Violations []*FieldError
+}
+
+func (e *ValidationError) Error() string {
+	msgs := make([]string, len(e.Violations))
+	for i, v := range e.Violations {
+		msgs[i] = v.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// GRPCStatus returns the status sent to the client for the error.
+func (e *ValidationError) GRPCStatus() *status.Status {
+	st := status.New(codes.InvalidArgument, e.Error())
+	br := &errdetails.BadRequest{}
+	for _, v := range e.Violations {
+		br.FieldViolations = append(br.FieldViolations, &errdetails.BadRequest_FieldViolation{Field: v.Field, Description: v.Description})
+	}
+	detailed, err := st.WithDetails(br)
+	if err != nil {
+		return st
+	}
+	return detailed
+}
+
+// Has reports whether field is among the violations.
+func (e *ValidationError) Has(field string) bool {
+	for _, v := range e.Violations {
+		if v.Field == field {
+			return true
+		}
+	}
+	return false
+}
+
+// fieldRule checks a field of a request, given the message holding it, and
+// returns a description of the violation or "".
+type fieldRule func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string
+
+// fieldRules are the rules of the request fields, by field name, shared by
+// every request, including those of version 2 of the API, that has a field
+// of the name. Unset fields are left to the defaults of the RPCs.
+var fieldRules = map[protoreflect.Name]fieldRule{
+	"date":     dateRule,
+	"end_date": dateRule,
+	"time": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if _, err := time.Parse(time.RFC3339, m.Get(fd).String()); err != nil {
+			return fmt.Sprintf("invalid time %q: expected RFC 3339, e.g. 2024-04-09T14:30:00+05:30", m.Get(fd).String())
+		}
+		return ""
+	},
+	"latitude": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		return rangeRule(m.Get(fd).Float(), -90, 90)
+	},
+	"longitude": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		return rangeRule(m.Get(fd).Float(), -180, 180)
+	},
+	"timezone": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if _, err := time.LoadLocation(m.Get(fd).String()); err != nil {
+			return fmt.Sprintf("invalid timezone %q: %v", m.Get(fd).String(), err)
+		}
+		return ""
+	},
+	"locale": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if locale := m.Get(fd).String(); !s.catalogs.Supports(locale) {
+			return fmt.Sprintf("unsupported locale %q: expected one of %s", locale, strings.Join(s.catalogs.Locales(), ", "))
+		}
+		return ""
+	},
+	"sun_convention": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if _, err := astronomy.ParseSunConvention(m.Get(fd).String()); err != nil {
+			return err.Error()
+		}
+		return ""
+	},
+	"moon_position": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if _, err := astronomy.ParseMoonPosition(m.Get(fd).String()); err != nil {
+			return err.Error()
+		}
+		return ""
+	},
+	"hijri_adjustment_days": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if d := m.Get(fd).Int(); d < -calendar.MaxHijriAdjustment || d > calendar.MaxHijriAdjustment {
+			return fmt.Sprintf("invalid hijri adjustment %d: expected -%d to %d days", d, calendar.MaxHijriAdjustment, calendar.MaxHijriAdjustment)
+		}
+		return ""
+	},
+	"pressure": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if p := m.Get(fd).Float(); p < 0 || math.IsNaN(p) {
+			return fmt.Sprintf("invalid pressure %g: expected 0 hPa or more", p)
+		}
+		return ""
+	},
+	// The temperature only matters with a pressure.
+	"temperature": func(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+		if pressure := m.Descriptor().Fields().ByName("pressure"); pressure != nil && m.Get(pressure).Float() > 0 && m.Get(fd).Float() <= -273 {
+			return fmt.Sprintf("invalid temperature %g: expected above -273 °C", m.Get(fd).Float())
+		}
+		return ""
+	},
+}
+
+func dateRule(s *PanchangamServer, m protoreflect.Message, fd protoreflect.FieldDescriptor) string {
+	if _, err := time.Parse(dateLayout, m.Get(fd).String()); err != nil {
+		return fmt.Sprintf("invalid date %q: expected YYYY-MM-DD", m.Get(fd).String())
+	}
+	return ""
+}
+
+func rangeRule(v, min, max float64) string {
+	if math.IsNaN(v) || v < min || v > max {
+		return fmt.Sprintf("invalid coordinate %g: expected %g to %g degrees", v, min, max)
+	}
+	return ""
+}
+
+// validate checks the fields of req, and of the messages it holds, against
+// fieldRules. It returns a ValidationError listing every violation, named
+// by its path in the request, e.g. locations[1].latitude.
+func (s *PanchangamServer) validate(req proto.Message) error {
+	var violations []*FieldError
+	var walk func(m protoreflect.Message, prefix string)
+	walk = func(m protoreflect.Message, prefix string) {
+		// The fields are walked in the order of their numbers, rather than
+		// the unspecified one of Range, so that the violations are listed
+		// in a stable order.
+		fields := m.Descriptor().Fields()
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if !m.Has(fd) {
+				continue
+			}
+			v := m.Get(fd)
+			path := prefix + string(fd.Name())
+			switch {
+			case fd.IsList() && fd.Message() != nil:
+				for j := 0; j < v.List().Len(); j++ {
+					walk(v.List().Get(j).Message(), fmt.Sprintf("%s[%d].", path, j))
+				}
+			case fd.Message() != nil && !fd.IsMap():
+				walk(v.Message(), path+".")
+			case !fd.IsList() && !fd.IsMap():
+				if rule, ok := fieldRules[fd.Name()]; ok {
+					if desc := rule(s, m, fd); desc != "" {
+						violations = append(violations, &FieldError{Field: path, Description: desc})
+					}
+				}
+			}
+		}
+	}
+	walk(req.ProtoReflect(), "")
+	if len(violations) > 0 {
+		return &ValidationError{Violations: violations}
+	}
+	return nil
+}
+
+// ValidationInterceptor rejects requests with invalid fields, such as a
+// malformed date, a latitude beyond the poles, an unknown timezone or a
+// locale without names, with an InvalidArgument status whose BadRequest
+// detail lists every invalid field. The RPCs may then rely on the fields it
+// checks, and only check what is particular to them.
+func (s *PanchangamServer) ValidationInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if m, ok := req.(proto.Message); ok {
+			if err := s.validate(m); err != nil {
+				return nil, err
+			}
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamValidationInterceptor is ValidationInterceptor for streaming RPCs,
+// whose requests are validated as they are received.
+func (s *PanchangamServer) StreamValidationInterceptor() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &validatingStream{ServerStream: ss, s: s})
+	}
+}
+
+// validatingStream is a server stream validating the messages it receives.
+type validatingStream struct {
+	grpc.ServerStream
+	s *PanchangamServer
+}
+
+func (vs *validatingStream) RecvMsg(m interface{}) error {
+	if err := vs.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if msg, ok := m.(proto.Message); ok {
+		return vs.s.validate(msg)
+	}
+	return nil
+}
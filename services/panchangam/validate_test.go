@@ -0,0 +1,90 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	pbv2 "github.com/naren-m/panchangam/proto/v2/panchangam"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestValidate(t *testing.T) {
+	s := newTestServer(t)
+	tests := []struct {
+		name       string
+		req        proto.Message
+		wantFields []string
+	}{
+		{name: "valid", req: &ppb.GetPanchangamRequest{Date: "2024-04-09", Latitude: 17.385, Longitude: 78.4867, Timezone: "Asia/Kolkata", Locale: "te"}},
+		{name: "defaults", req: &ppb.GetPanchangamRequest{}},
+		{name: "invalid date", req: &ppb.GetPanchangamRequest{Date: "2024-4-9"}, wantFields: []string{"date"}},
+		{name: "beyond the poles", req: &ppb.GetPanchangamRequest{Latitude: 91, Longitude: -181}, wantFields: []string{"latitude", "longitude"}},
+		{name: "unknown timezone", req: &ppb.GetEventsRequest{Timezone: "Asia/Nowhere"}, wantFields: []string{"timezone"}},
+		{name: "unsupported locale", req: &pbv2.GetPanchangamRequest{Date: "2024-04-09", Locale: "fr"}, wantFields: []string{"locale"}},
+		{name: "hijri adjustment", req: &ppb.GetPanchangamRequest{HijriAdjustmentDays: 3}, wantFields: []string{"hijri_adjustment_days"}},
+		{name: "invalid time", req: &ppb.GetNextTransitionsRequest{Time: "tomorrow"}, wantFields: []string{"time"}},
+		{name: "invalid end date", req: &ppb.GetMuhurtaRequest{Date: "2024-04-09", EndDate: "2024-04-31"}, wantFields: []string{"end_date"}},
+		{name: "absolute zero", req: &ppb.GetPanchangamRequest{Pressure: 1013, Temperature: -300}, wantFields: []string{"temperature"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := s.validate(tt.req)
+			if len(tt.wantFields) == 0 {
+				if err != nil {
+					t.Fatalf("validate() error = %v", err)
+				}
+				return
+			}
+			var verr *ValidationError
+			if !errors.As(err, &verr) || len(verr.Violations) != len(tt.wantFields) {
+				t.Fatalf("validate() error = %v, want violations of %v", err, tt.wantFields)
+			}
+			for _, f := range tt.wantFields {
+				if !verr.Has(f) {
+					t.Errorf("validate() error = %v, want a violation of %s", err, f)
+				}
+			}
+		})
+	}
+}
+
+func TestValidationInterceptor(t *testing.T) {
+	s := newTestServer(t)
+	intercept := s.ValidationInterceptor()
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+	info := &grpc.UnaryServerInfo{FullMethod: "/panchangam.Panchangam/Get"}
+
+	_, err := intercept(context.Background(), &ppb.GetPanchangamRequest{Latitude: 100, Locale: "fr"}, info, handler)
+	if called {
+		t.Fatal("handler called for an invalid request")
+	}
+	st := status.Convert(err)
+	if st.Code() != codes.InvalidArgument {
+		t.Fatalf("code = %v, want InvalidArgument", st.Code())
+	}
+	var fields []string
+	for _, d := range st.Details() {
+		if br, ok := d.(*errdetails.BadRequest); ok {
+			for _, v := range br.FieldViolations {
+				fields = append(fields, v.Field)
+			}
+		}
+	}
+	if len(fields) != 2 || fields[0] != "latitude" || fields[1] != "locale" {
+		t.Errorf("field violations = %v, want latitude and locale", fields)
+	}
+
+	if _, err := intercept(context.Background(), &ppb.GetPanchangamRequest{Date: "2024-04-09"}, info, handler); err != nil || !called {
+		t.Errorf("intercept() of a valid request error = %v, called = %v", err, called)
+	}
+}
@@ -0,0 +1,111 @@
+package panchangam
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/naren-m/panchangam/aaa"
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// errNoWebhooks is returned by the subscription RPCs of a server without a
+// store of subscriptions.
+var errNoWebhooks = status.Error(codes.FailedPrecondition, "webhooks are not enabled: start the server with -webhooks-file")
+
+// CreateSubscription registers a URL the events at a location are posted
+// to, owned by the API key of the caller. Its secret, which signs the
+// deliveries, is only returned now.
+func (s *PanchangamServer) CreateSubscription(ctx context.Context, req *ppb.CreateSubscriptionRequest) (*ppb.WebhookSubscription, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "CreateSubscription")
+	defer span.End()
+	logger.InfoContext(ctx, "Received subscription request", "url", req.Url, "events", req.Events, "region", req.Region)
+
+	if s.webhooks == nil {
+		return nil, errNoWebhooks
+	}
+	if _, err := s.festivals.ForRegion(req.Region); err != nil {
+		return nil, fieldErrorf("region", "%v", err)
+	}
+	sub, err := s.webhooks.Create(webhook.Subscription{
+		Owner:     owner(ctx),
+		URL:       req.Url,
+		Latitude:  req.Latitude,
+		Longitude: req.Longitude,
+		Timezone:  timezoneAt(req.Timezone, req.Latitude, req.Longitude),
+		Region:    req.Region,
+		Events:    req.Events,
+	})
+	switch {
+	case errors.Is(err, webhook.ErrInvalidURL):
+		return nil, fieldErrorf("url", "%v", err)
+	case errors.Is(err, webhook.ErrInvalidEvents):
+		return nil, fieldErrorf("events", "%v", err)
+	case errors.Is(err, webhook.ErrTooManySubscriptions):
+		return nil, status.Errorf(codes.ResourceExhausted, "%v: delete one to subscribe again", err)
+	case err != nil:
+		logger.ErrorContext(ctx, "failed to save subscription", "error", err)
+		return nil, status.Error(codes.Internal, "failed to save subscription")
+	}
+	msg := subscriptionMessage(sub)
+	msg.Secret = sub.Secret
+	return msg, nil
+}
+
+// ListSubscriptions lists the subscriptions of the API key of the caller.
+func (s *PanchangamServer) ListSubscriptions(ctx context.Context, req *ppb.ListSubscriptionsRequest) (*ppb.ListSubscriptionsResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "ListSubscriptions")
+	defer span.End()
+
+	if s.webhooks == nil {
+		return nil, errNoWebhooks
+	}
+	resp := &ppb.ListSubscriptionsResponse{}
+	for _, sub := range s.webhooks.List(owner(ctx)) {
+		resp.Subscriptions = append(resp.Subscriptions, subscriptionMessage(sub))
+	}
+	return resp, nil
+}
+
+// DeleteSubscription deletes a subscription of the API key of the caller.
+func (s *PanchangamServer) DeleteSubscription(ctx context.Context, req *ppb.DeleteSubscriptionRequest) (*ppb.DeleteSubscriptionResponse, error) {
+	ctx, span := s.observer.CreateSpan(ctx, "DeleteSubscription")
+	defer span.End()
+	logger.InfoContext(ctx, "Received subscription deletion", "id", req.Id)
+
+	if s.webhooks == nil {
+		return nil, errNoWebhooks
+	}
+	err := s.webhooks.Delete(owner(ctx), req.Id)
+	switch {
+	case errors.Is(err, webhook.ErrNotFound):
+		return nil, status.Errorf(codes.NotFound, "subscription %q not found", req.Id)
+	case err != nil:
+		logger.ErrorContext(ctx, "failed to delete subscription", "error", err)
+		return nil, status.Error(codes.Internal, "failed to delete subscription")
+	}
+	return &ppb.DeleteSubscriptionResponse{}, nil
+}
+
+// owner returns the ID of the API key of the caller, or "" on a server
+// without keys.
+func owner(ctx context.Context) string {
+	key, _ := aaa.KeyFromContext(ctx)
+	return key.ID
+}
+
+func subscriptionMessage(sub webhook.Subscription) *ppb.WebhookSubscription {
+	return &ppb.WebhookSubscription{
+		Id:        sub.ID,
+		Url:       sub.URL,
+		Latitude:  sub.Latitude,
+		Longitude: sub.Longitude,
+		Timezone:  sub.Timezone,
+		Region:    sub.Region,
+		Events:    sub.Events,
+		Created:   sub.Created.Format(time.RFC3339),
+	}
+}
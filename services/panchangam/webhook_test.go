@@ -0,0 +1,82 @@
+package panchangam
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	ppb "github.com/naren-m/panchangam/proto/panchangam"
+	"github.com/naren-m/panchangam/webhook"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestSubscriptions(t *testing.T) {
+	s := newTestServer(t)
+	if _, err := s.ListSubscriptions(context.Background(), &ppb.ListSubscriptionsRequest{}); status.Code(err) != codes.FailedPrecondition {
+		t.Errorf("ListSubscriptions() without webhooks error = %v, want FailedPrecondition", err)
+	}
+
+	store, err := webhook.OpenStore(filepath.Join(t.TempDir(), "webhooks.json"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	WithWebhooks(store)(s)
+	sub, err := s.CreateSubscription(context.Background(), &ppb.CreateSubscriptionRequest{
+		Url:       "https://example.com/hook",
+		Latitude:  13.0827,
+		Longitude: 80.2707,
+		Region:    "tamil_nadu",
+		Events:    []string{"festival", "rahu_kalam_start"},
+	})
+	if err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+	if sub.Id == "" || sub.Secret == "" || sub.Timezone != "Asia/Kolkata" || sub.Created == "" {
+		t.Errorf("CreateSubscription() = %v, want an ID, a secret and the timezone at the location", sub)
+	}
+
+	list, err := s.ListSubscriptions(context.Background(), &ppb.ListSubscriptionsRequest{})
+	if err != nil {
+		t.Fatalf("ListSubscriptions() error = %v", err)
+	}
+	if len(list.Subscriptions) != 1 || list.Subscriptions[0].Id != sub.Id || list.Subscriptions[0].Secret != "" {
+		t.Errorf("ListSubscriptions() = %v, want %s without its secret", list, sub.Id)
+	}
+
+	for _, tt := range []struct {
+		req   *ppb.CreateSubscriptionRequest
+		field string
+	}{
+		{&ppb.CreateSubscriptionRequest{Url: "example.com/hook", Events: []string{"festival"}}, "url"},
+		{&ppb.CreateSubscriptionRequest{Url: "http://169.254.169.254/latest/meta-data/", Events: []string{"festival"}}, "url"},
+		{&ppb.CreateSubscriptionRequest{Url: "https://example.com/hook"}, "events"},
+		{&ppb.CreateSubscriptionRequest{Url: "https://example.com/hook", Events: []string{"rahu_kalam"}}, "events"},
+	} {
+		_, err := s.CreateSubscription(context.Background(), tt.req)
+		if fe, ok := err.(*FieldError); !ok || fe.Field != tt.field {
+			t.Errorf("CreateSubscription(%v) error = %v, want a %s field error", tt.req, err, tt.field)
+		}
+	}
+
+	limited, err := webhook.OpenStore("", webhook.WithMaxSubscriptions(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	limitedServer := newTestServer(t)
+	WithWebhooks(limited)(limitedServer)
+	req := &ppb.CreateSubscriptionRequest{Url: "https://example.com/hook", Events: []string{"festival"}}
+	if _, err := limitedServer.CreateSubscription(context.Background(), req); err != nil {
+		t.Fatalf("CreateSubscription() error = %v", err)
+	}
+	if _, err := limitedServer.CreateSubscription(context.Background(), req); status.Code(err) != codes.ResourceExhausted {
+		t.Errorf("CreateSubscription() past the limit error = %v, want ResourceExhausted", err)
+	}
+
+	if _, err := s.DeleteSubscription(context.Background(), &ppb.DeleteSubscriptionRequest{Id: sub.Id}); err != nil {
+		t.Fatalf("DeleteSubscription() error = %v", err)
+	}
+	if _, err := s.DeleteSubscription(context.Background(), &ppb.DeleteSubscriptionRequest{Id: sub.Id}); status.Code(err) != codes.NotFound {
+		t.Errorf("DeleteSubscription() of a deleted subscription error = %v, want NotFound", err)
+	}
+}
@@ -0,0 +1,249 @@
+// Package summary composes the Panchanga Shravanam: the daily panchangam as
+// it is read out or printed, from the year down to the inauspicious periods
+// of the day, in the locales of the i18n catalogs.
+package summary
+
+import (
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/i18n"
+)
+
+// Label keys, looked up in the label section of the catalogs.
+const (
+	SamvatsaraLabel  = "samvatsara"
+	AyanaLabel       = "ayana"
+	RituLabel        = "ritu"
+	MasaLabel        = "masa"
+	AdhikaLabel      = "adhika"
+	PakshaLabel      = "paksha"
+	VaraLabel        = "vara"
+	TithiLabel       = "tithi"
+	NakshatraLabel   = "nakshatra"
+	YogaLabel        = "yoga"
+	KaranaLabel      = "karana"
+	SunriseLabel     = "sunrise"
+	SunsetLabel      = "sunset"
+	RahuKalamLabel   = "rahu_kalam"
+	YamagandamLabel  = "yamagandam"
+	GulikaKalamLabel = "gulika_kalam"
+	// UntilLabel is the phrase giving the end of an element, in which
+	// {time} stands for the time.
+	UntilLabel = "until"
+)
+
+// englishLabels are the labels of locales whose catalog lacks them.
+var englishLabels = map[string]string{
+	SamvatsaraLabel:  "Samvatsara",
+	AyanaLabel:       "Ayana",
+	RituLabel:        "Ritu",
+	MasaLabel:        "Masa",
+	AdhikaLabel:      "Adhika",
+	PakshaLabel:      "Paksha",
+	VaraLabel:        "Vara",
+	TithiLabel:       "Tithi",
+	NakshatraLabel:   "Nakshatra",
+	YogaLabel:        "Yoga",
+	KaranaLabel:      "Karana",
+	SunriseLabel:     "Sunrise",
+	SunsetLabel:      "Sunset",
+	RahuKalamLabel:   "Rahu Kalam",
+	YamagandamLabel:  "Yamagandam",
+	GulikaKalamLabel: "Gulika Kalam",
+	UntilLabel:       "until {time}",
+}
+
+// LabelKeys returns the keys of the labels used by the summaries.
+func LabelKeys() []string {
+	keys := make([]string, 0, len(englishLabels))
+	for key := range englishLabels {
+		keys = append(keys, key)
+	}
+	return keys
+}
+
+// Day is the panchangam of a civil day, whose elements are those prevailing
+// at sunrise.
+type Day struct {
+	// Date is the midnight starting the day, in the time zone of the
+	// summary.
+	Date        time.Time
+	Sunrise     time.Time
+	Sunset      time.Time
+	NextSunrise time.Time
+
+	Samvatsara string
+	Ayana      astronomy.Ayana
+	Ritu       string
+	Masa       astronomy.Masa
+	Paksha     astronomy.Paksha
+
+	Vara      astronomy.ElementPeriod
+	Tithi     astronomy.ElementPeriod
+	Nakshatra astronomy.ElementPeriod
+	Yoga      astronomy.ElementPeriod
+	Karana    astronomy.ElementPeriod
+
+	RahuKalam   astronomy.Period
+	Yamagandam  astronomy.Period
+	GulikaKalam astronomy.Period
+}
+
+// Compute returns the panchangam of the day starting at the midnight date,
+// given its sunrise and sunset and the sunrise of the next day.
+func Compute(date, sunrise, sunset, nextSunrise time.Time, opts ...astronomy.ElementOption) Day {
+	period := func(kind astronomy.ElementKind) astronomy.ElementPeriod {
+		return astronomy.CalculateElementPeriod(kind, sunrise, opts...)
+	}
+	tithi := period(astronomy.TithiElement)
+	return Day{
+		Date:        date,
+		Sunrise:     sunrise,
+		Sunset:      sunset,
+		NextSunrise: nextSunrise,
+		Samvatsara:  astronomy.CalculateSamvatsara(sunrise).Name,
+		Ayana:       astronomy.CalculateAyana(sunrise),
+		Ritu:        astronomy.CalculateRitu(sunrise).Name,
+		Masa:        astronomy.CalculateMasa(sunrise),
+		Paksha:      astronomy.TithiPaksha(tithi.Element),
+		Vara:        astronomy.CalculateVara(sunrise, nextSunrise),
+		Tithi:       tithi,
+		Nakshatra:   period(astronomy.NakshatraElement),
+		Yoga:        period(astronomy.YogaElement),
+		Karana:      period(astronomy.KaranaElement),
+		RahuKalam:   astronomy.RahuKalam(sunrise, sunset),
+		Yamagandam:  astronomy.Yamagandam(sunrise, sunset),
+		GulikaKalam: astronomy.GulikaKalam(sunrise, sunset),
+	}
+}
+
+// Line is a line of a summary.
+type Line struct {
+	Label string
+	Value string
+}
+
+// Lines returns the lines of the summary of d in locale. Names missing from
+// the catalog of locale are given in English.
+//
+// Times are those of the clock in the time zone of d.Date. As is usual in
+// printed panchangams, times after the midnight ending the day count on from
+// 24:00, e.g. 26:15 for 02:15 the next morning. An element ending after the
+// next sunrise prevails the whole day and is given without its end.
+func (d Day) Lines(catalogs *i18n.Catalogs, locale string) []Line {
+	f := formatter{catalogs: catalogs, locale: locale, date: d.Date}
+	masa := f.name(i18n.MasaTerm, d.Masa.Name)
+	if d.Masa.Adhika {
+		masa = f.label(AdhikaLabel) + " " + masa
+	}
+	paksha := f.name(i18n.PakshaTerm, string(d.Paksha))
+	if paksha == string(d.Paksha) {
+		paksha = capitalize(paksha)
+	}
+	return []Line{
+		{f.label(SamvatsaraLabel), f.name(i18n.SamvatsaraTerm, d.Samvatsara)},
+		{f.label(AyanaLabel), f.name(i18n.AyanaTerm, string(d.Ayana))},
+		{f.label(RituLabel), f.name(i18n.RituTerm, d.Ritu)},
+		{f.label(MasaLabel), masa},
+		{f.label(PakshaLabel), paksha},
+		{f.label(VaraLabel), f.element(d.Vara.Element)},
+		{f.label(TithiLabel), f.until(d.Tithi, d.NextSunrise)},
+		{f.label(NakshatraLabel), f.until(d.Nakshatra, d.NextSunrise)},
+		{f.label(YogaLabel), f.until(d.Yoga, d.NextSunrise)},
+		{f.label(KaranaLabel), f.until(d.Karana, d.NextSunrise)},
+		{f.label(SunriseLabel), f.time(d.Sunrise)},
+		{f.label(SunsetLabel), f.time(d.Sunset)},
+		{f.label(RahuKalamLabel), f.period(d.RahuKalam)},
+		{f.label(YamagandamLabel), f.period(d.Yamagandam)},
+		{f.label(GulikaKalamLabel), f.period(d.GulikaKalam)},
+	}
+}
+
+// Highlights returns the lines of the summary of d in locale that a
+// calendar of several days shows for each: the vara, tithi and nakshatra,
+// sunrise, sunset and Rahu Kalam.
+func (d Day) Highlights(catalogs *i18n.Catalogs, locale string) []Line {
+	f := formatter{catalogs: catalogs, locale: locale, date: d.Date}
+	return []Line{
+		{f.label(VaraLabel), f.element(d.Vara.Element)},
+		{f.label(TithiLabel), f.until(d.Tithi, d.NextSunrise)},
+		{f.label(NakshatraLabel), f.until(d.Nakshatra, d.NextSunrise)},
+		{f.label(SunriseLabel), f.time(d.Sunrise)},
+		{f.label(SunsetLabel), f.time(d.Sunset)},
+		{f.label(RahuKalamLabel), f.period(d.RahuKalam)},
+	}
+}
+
+// Text returns the summary of d in locale as a block of text, one
+// "label: value" line after the date.
+func (d Day) Text(catalogs *i18n.Catalogs, locale string) string {
+	var b strings.Builder
+	b.WriteString(d.Date.Format("2006-01-02"))
+	b.WriteByte('\n')
+	for _, l := range d.Lines(catalogs, locale) {
+		fmt.Fprintf(&b, "%s: %s\n", l.Label, l.Value)
+	}
+	return b.String()
+}
+
+type formatter struct {
+	catalogs *i18n.Catalogs
+	locale   string
+	date     time.Time
+}
+
+func (f formatter) label(key string) string {
+	if label := f.catalogs.Label(f.locale, key); label != "" {
+		return label
+	}
+	return englishLabels[key]
+}
+
+func (f formatter) name(term i18n.Term, name string) string {
+	if n := f.catalogs.Name(f.locale, term, name); n != "" {
+		return n
+	}
+	return name
+}
+
+func (f formatter) element(e astronomy.Element) string {
+	if n := f.catalogs.ElementName(f.locale, e); n != "" {
+		return n
+	}
+	return e.Name
+}
+
+// until returns the name of p followed by its end, unless p prevails past
+// nextSunrise.
+func (f formatter) until(p astronomy.ElementPeriod, nextSunrise time.Time) string {
+	name := f.element(p.Element)
+	if !p.End.Before(nextSunrise) {
+		return name
+	}
+	return name + " " + strings.ReplaceAll(f.label(UntilLabel), "{time}", f.time(p.End))
+}
+
+func (f formatter) period(p astronomy.Period) string {
+	return f.time(p.Start) + "–" + f.time(p.End)
+}
+
+// time returns the clock time of t counted from the midnight starting the
+// day, so that it may exceed 24:00.
+func (f formatter) time(t time.Time) string {
+	t = t.In(f.date.Location())
+	y, m, d := f.date.Date()
+	days := int(time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC).Sub(time.Date(y, m, d, 0, 0, 0, 0, time.UTC)).Hours() / 24)
+	return fmt.Sprintf("%02d:%02d", t.Hour()+24*days, t.Minute())
+}
+
+func capitalize(s string) string {
+	r := []rune(s)
+	if len(r) > 0 {
+		r[0] = unicode.ToUpper(r[0])
+	}
+	return string(r)
+}
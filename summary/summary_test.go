@@ -0,0 +1,87 @@
+package summary
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/i18n"
+)
+
+func bengaluruDay() Day {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	// Sun times of Bengaluru on 9 April 2024, a Tuesday.
+	date := time.Date(2024, 4, 9, 0, 0, 0, 0, ist)
+	sunrise := time.Date(2024, 4, 9, 6, 11, 0, 0, ist)
+	sunset := time.Date(2024, 4, 9, 18, 30, 0, 0, ist)
+	nextSunrise := time.Date(2024, 4, 10, 6, 10, 0, 0, ist)
+	return Compute(date, sunrise, sunset, nextSunrise)
+}
+
+func TestLines(t *testing.T) {
+	d := bengaluruDay()
+	lines := d.Lines(i18n.DefaultCatalogs(), i18n.English)
+	values := map[string]string{}
+	for _, l := range lines {
+		values[l.Label] = l.Value
+	}
+	for label, want := range map[string]string{
+		"Samvatsara": "Krodhi",
+		"Masa":       "Chaitra",
+		"Paksha":     "Shukla",
+		"Vara":       "Mangalavara",
+		"Sunrise":    "06:11",
+		"Rahu Kalam": "15:25–16:57",
+	} {
+		if values[label] != want {
+			t.Errorf("%s = %q, want %q", label, values[label], want)
+		}
+	}
+	if !strings.HasPrefix(values["Tithi"], d.Tithi.Name+" until ") {
+		t.Errorf("Tithi = %q, want %s with its end", values["Tithi"], d.Tithi.Name)
+	}
+}
+
+func TestTextInLocale(t *testing.T) {
+	text := bengaluruDay().Text(i18n.DefaultCatalogs(), "hi")
+	for _, want := range []string{"2024-04-09\n", "मास: चैत्र\n", "वार: मंगलवार\n", "पक्ष: शुक्ल\n", "राहुकाल: 15:25–16:57\n"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("Text(hi) lacks %q:\n%s", want, text)
+		}
+	}
+}
+
+func TestHighlights(t *testing.T) {
+	lines := bengaluruDay().Highlights(i18n.DefaultCatalogs(), "ta")
+	if len(lines) != 6 {
+		t.Fatalf("Highlights() has %d lines, want 6", len(lines))
+	}
+	if last := lines[len(lines)-1]; last.Value != "15:25–16:57" {
+		t.Errorf("last highlight = %+v, want Rahu Kalam 15:25–16:57", last)
+	}
+}
+
+func TestTimesPastMidnight(t *testing.T) {
+	d := bengaluruDay()
+	f := formatter{catalogs: i18n.DefaultCatalogs(), locale: i18n.English, date: d.Date}
+	if got := f.time(d.Date.Add(26*time.Hour + 15*time.Minute)); got != "26:15" {
+		t.Errorf("time() = %s, want 26:15", got)
+	}
+}
+
+func TestCatalogsHaveLabels(t *testing.T) {
+	c := i18n.DefaultCatalogs()
+	for _, locale := range c.Locales() {
+		if locale == i18n.English {
+			continue
+		}
+		for _, key := range LabelKeys() {
+			if c.Label(locale, key) == "" {
+				t.Errorf("%s has no label %s", locale, key)
+			}
+		}
+		if !strings.Contains(c.Label(locale, UntilLabel), "{time}") {
+			t.Errorf("%s label until lacks {time}", locale)
+		}
+	}
+}
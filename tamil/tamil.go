@@ -0,0 +1,169 @@
+// Package tamil computes the periods of a day used by Tamil Nadu almanacs:
+// the Gowri Panchangam and the Nalla Neram (good time) derived from it.
+//
+// Like the choghadiya, the Gowri Panchangam splits the day (sunrise to
+// sunset) and the night (sunset to the next sunrise) into eight equal
+// periods each, named according to the weekday of sunrise. Nalla Neram is
+// made of the auspicious Gowri periods, leaving out Rahu Kalam and
+// Yamagandam.
+package tamil
+
+import (
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// Region is the region selecting the Tamil periods.
+const Region = "tamil_nadu"
+
+// Gowri period names.
+const (
+	Uthi    = "Uthi"
+	Amirdha = "Amirdha"
+	Rogam   = "Rogam"
+	Laabam  = "Laabam"
+	Dhanam  = "Dhanam"
+	Sugam   = "Sugam"
+	Soram   = "Soram"
+	Visham  = "Visham"
+)
+
+// NallaNeram is the name of the good time periods.
+const NallaNeram = "Nalla Neram"
+
+var gowriNature = map[string]astronomy.Nature{
+	Uthi:    astronomy.Auspicious,
+	Amirdha: astronomy.Auspicious,
+	Rogam:   astronomy.Inauspicious,
+	Laabam:  astronomy.Auspicious,
+	Dhanam:  astronomy.Auspicious,
+	Sugam:   astronomy.Auspicious,
+	Soram:   astronomy.Inauspicious,
+	Visham:  astronomy.Inauspicious,
+}
+
+// tamilNames maps the names of the periods to their Tamil names.
+var tamilNames = map[string]string{
+	Uthi:       "உத்தி",
+	Amirdha:    "அமிர்தம்",
+	Rogam:      "ரோகம்",
+	Laabam:     "லாபம்",
+	Dhanam:     "தனம்",
+	Sugam:      "சுகம்",
+	Soram:      "சோரம்",
+	Visham:     "விஷம்",
+	NallaNeram: "நல்ல நேரம்",
+}
+
+// TamilName returns the Tamil name of a Gowri period or of Nalla Neram, or
+// name itself when it has none.
+func TamilName(name string) string {
+	if tamilName, ok := tamilNames[name]; ok {
+		return tamilName
+	}
+	return name
+}
+
+// Gowri periods of the day and of the night for each weekday, starting from
+// Sunday.
+var (
+	dayGowri = [7][8]string{
+		{Uthi, Amirdha, Rogam, Laabam, Dhanam, Sugam, Soram, Visham},
+		{Amirdha, Visham, Rogam, Laabam, Dhanam, Sugam, Soram, Uthi},
+		{Rogam, Laabam, Dhanam, Sugam, Soram, Visham, Uthi, Amirdha},
+		{Laabam, Dhanam, Sugam, Soram, Visham, Uthi, Amirdha, Rogam},
+		{Dhanam, Sugam, Soram, Visham, Uthi, Amirdha, Rogam, Laabam},
+		{Sugam, Soram, Visham, Uthi, Amirdha, Rogam, Laabam, Dhanam},
+		{Soram, Visham, Uthi, Amirdha, Rogam, Laabam, Dhanam, Sugam},
+	}
+	nightGowri = [7][8]string{
+		{Dhanam, Sugam, Soram, Visham, Uthi, Amirdha, Rogam, Laabam},
+		{Sugam, Soram, Visham, Uthi, Amirdha, Rogam, Laabam, Dhanam},
+		{Soram, Visham, Uthi, Amirdha, Rogam, Laabam, Dhanam, Sugam},
+		{Visham, Uthi, Amirdha, Rogam, Laabam, Dhanam, Sugam, Soram},
+		{Uthi, Amirdha, Rogam, Laabam, Dhanam, Sugam, Soram, Visham},
+		{Amirdha, Rogam, Laabam, Dhanam, Sugam, Soram, Visham, Uthi},
+		{Rogam, Laabam, Dhanam, Sugam, Soram, Visham, Uthi, Amirdha},
+	}
+)
+
+// GowriPeriod is one of the sixteen Gowri Panchangam periods of a day.
+type GowriPeriod struct {
+	Name      string
+	TamilName string
+	Nature    astronomy.Nature
+	Start     time.Time
+	End       time.Time
+	IsDay     bool
+}
+
+// Window is a Nalla Neram period.
+type Window struct {
+	Start time.Time
+	End   time.Time
+	IsDay bool
+}
+
+// GowriPanchangam returns the eight day periods from sunrise to sunset
+// followed by the eight night periods from sunset to nextSunrise.
+func GowriPanchangam(sunrise, sunset, nextSunrise time.Time) []GowriPeriod {
+	weekday := sunrise.Weekday()
+	periods := make([]GowriPeriod, 0, 16)
+	periods = appendGowri(periods, sunrise, sunset, dayGowri[weekday], true)
+	return appendGowri(periods, sunset, nextSunrise, nightGowri[weekday], false)
+}
+
+func appendGowri(periods []GowriPeriod, start, end time.Time, names [8]string, isDay bool) []GowriPeriod {
+	for i, name := range names {
+		s, e := part(start, end, i+1)
+		periods = append(periods, GowriPeriod{
+			Name:      name,
+			TamilName: tamilNames[name],
+			Nature:    gowriNature[name],
+			Start:     s,
+			End:       e,
+			IsDay:     isDay,
+		})
+	}
+	return periods
+}
+
+// part returns the bounds of the nth of the eight equal parts from start to
+// end.
+func part(start, end time.Time, n int) (time.Time, time.Time) {
+	length := end.Sub(start) / 8
+	partEnd := start.Add(length * time.Duration(n))
+	if n == 8 {
+		partEnd = end
+	}
+	return start.Add(length * time.Duration(n-1)), partEnd
+}
+
+// NallaNeramWindows returns the Nalla Neram of the day and of the night:
+// the auspicious Gowri periods, merged when adjacent, without the Rahu Kalam
+// and Yamagandam of the day.
+func NallaNeramWindows(sunrise, sunset, nextSunrise time.Time) []Window {
+	rahu := astronomy.RahuKalam(sunrise, sunset)
+	yama := astronomy.Yamagandam(sunrise, sunset)
+
+	var windows []Window
+	for _, p := range GowriPanchangam(sunrise, sunset, nextSunrise) {
+		if p.Nature != astronomy.Auspicious {
+			continue
+		}
+		if p.IsDay && (overlaps(p.Start, p.End, rahu.Start, rahu.End) || overlaps(p.Start, p.End, yama.Start, yama.End)) {
+			continue
+		}
+		if n := len(windows); n > 0 && windows[n-1].End.Equal(p.Start) && windows[n-1].IsDay == p.IsDay {
+			windows[n-1].End = p.End
+			continue
+		}
+		windows = append(windows, Window{Start: p.Start, End: p.End, IsDay: p.IsDay})
+	}
+	return windows
+}
+
+func overlaps(start, end, otherStart, otherEnd time.Time) bool {
+	return start.Before(otherEnd) && otherStart.Before(end)
+}
@@ -0,0 +1,89 @@
+package tamil
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestGowriPanchangam(t *testing.T) {
+	// 28 April 2024 is a Sunday.
+	sunrise := time.Date(2024, 4, 28, 6, 0, 0, 0, time.UTC)
+	sunset := sunrise.Add(12 * time.Hour)
+	nextSunrise := sunrise.Add(24 * time.Hour)
+	periods := GowriPanchangam(sunrise, sunset, nextSunrise)
+	if len(periods) != 16 {
+		t.Fatalf("len(periods) = %d, want 16", len(periods))
+	}
+	wantDay := []string{Uthi, Amirdha, Rogam, Laabam, Dhanam, Sugam, Soram, Visham}
+	wantNight := []string{Dhanam, Sugam, Soram, Visham, Uthi, Amirdha, Rogam, Laabam}
+	for i, p := range periods {
+		want, isDay := wantNight[i%8], false
+		if i < 8 {
+			want, isDay = wantDay[i], true
+		}
+		if p.Name != want || p.IsDay != isDay {
+			t.Errorf("periods[%d] = %s day %v, want %s day %v", i, p.Name, p.IsDay, want, isDay)
+		}
+		if p.TamilName == "" || p.TamilName == p.Name {
+			t.Errorf("periods[%d] has no Tamil name", i)
+		}
+		if p.End.Sub(p.Start) != 90*time.Minute {
+			t.Errorf("periods[%d] lasts %v, want 1h30m", i, p.End.Sub(p.Start))
+		}
+	}
+	if !periods[7].End.Equal(sunset) || !periods[15].End.Equal(nextSunrise) {
+		t.Errorf("periods end at %v and %v, want %v and %v", periods[7].End, periods[15].End, sunset, nextSunrise)
+	}
+}
+
+func TestNallaNeramWindows(t *testing.T) {
+	// On a Sunday Yamagandam is the 5th part of the day (12:00-13:30),
+	// which rules out Dhanam, and Rahu Kalam the 8th (16:30-18:00), which is
+	// Visham anyway.
+	sunrise := time.Date(2024, 4, 28, 6, 0, 0, 0, time.UTC)
+	at := func(h, m int) time.Time { return time.Date(2024, 4, 28, h, m, 0, 0, time.UTC) }
+	windows := NallaNeramWindows(sunrise, sunrise.Add(12*time.Hour), sunrise.Add(24*time.Hour))
+	want := []Window{
+		{at(6, 0), at(9, 0), true},
+		{at(10, 30), at(12, 0), true},
+		{at(13, 30), at(15, 0), true},
+		{at(18, 0), at(21, 0), false},
+		{at(24, 0), at(27, 0), false},
+		{at(28, 30), at(30, 0), false},
+	}
+	if len(windows) != len(want) {
+		t.Fatalf("NallaNeramWindows() = %v, want %v", windows, want)
+	}
+	for i := range want {
+		if !windows[i].Start.Equal(want[i].Start) || !windows[i].End.Equal(want[i].End) || windows[i].IsDay != want[i].IsDay {
+			t.Errorf("windows[%d] = %v, want %v", i, windows[i], want[i])
+		}
+	}
+}
+
+func TestNallaNeramIsAuspicious(t *testing.T) {
+	sunrise := time.Date(2024, 4, 28, 6, 12, 0, 0, time.UTC)
+	for d := 0; d < 7; d++ {
+		day := sunrise.AddDate(0, 0, d)
+		sunset, nextSunrise := day.Add(12*time.Hour+25*time.Minute), day.Add(24*time.Hour)
+		periods := GowriPanchangam(day, sunset, nextSunrise)
+		for _, w := range NallaNeramWindows(day, sunset, nextSunrise) {
+			for _, p := range periods {
+				if overlaps(w.Start, w.End, p.Start, p.End) && p.Nature != astronomy.Auspicious {
+					t.Errorf("%v: Nalla Neram %v-%v overlaps %s", day.Weekday(), w.Start, w.End, p.Name)
+				}
+			}
+		}
+	}
+}
+
+func TestTamilName(t *testing.T) {
+	if got := TamilName(NallaNeram); got != "நல்ல நேரம்" {
+		t.Errorf("TamilName(%q) = %q", NallaNeram, got)
+	}
+	if got := TamilName("Unknown"); got != "Unknown" {
+		t.Errorf("TamilName(Unknown) = %q, want Unknown", got)
+	}
+}
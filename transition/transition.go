@@ -0,0 +1,260 @@
+// Package transition schedules the moments at which the panchangam of a
+// place changes: the ends of the tithi, nakshatra, yoga and karana, sunrise,
+// which starts the vara, sunset, moonrise and moonset, and the inauspicious
+// periods of the day, which are announced a few minutes ahead.
+package transition
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+// Kind is what changes at a transition.
+type Kind string
+
+const (
+	Tithi       Kind = "tithi"
+	Nakshatra   Kind = "nakshatra"
+	Yoga        Kind = "yoga"
+	Karana      Kind = "karana"
+	Vara        Kind = "vara"
+	Sunrise     Kind = "sunrise"
+	Sunset      Kind = "sunset"
+	Moonrise    Kind = "moonrise"
+	Moonset     Kind = "moonset"
+	RahuKalam   Kind = "rahu_kalam"
+	Yamagandam  Kind = "yamagandam"
+	GulikaKalam Kind = "gulika_kalam"
+)
+
+var kindNames = map[Kind]string{
+	Tithi: "Tithi", Nakshatra: "Nakshatra", Yoga: "Yoga", Karana: "Karana", Vara: "Vara",
+	Sunrise: "Sunrise", Sunset: "Sunset", Moonrise: "Moonrise", Moonset: "Moonset",
+	RahuKalam: astronomy.Rahu, Yamagandam: astronomy.Yamaganda, GulikaKalam: astronomy.Gulika,
+}
+
+// Kinds returns every kind of transition.
+func Kinds() []Kind {
+	return []Kind{Tithi, Nakshatra, Yoga, Karana, Vara, Sunrise, Sunset, Moonrise, Moonset, RahuKalam, Yamagandam, GulikaKalam}
+}
+
+// ParseKind parses the name of a kind of transition, e.g. rahu_kalam.
+func ParseKind(s string) (Kind, error) {
+	if _, ok := kindNames[Kind(s)]; !ok {
+		return "", fmt.Errorf("unknown transition kind %q", s)
+	}
+	return Kind(s), nil
+}
+
+// Event is what happens to the element or period of a transition.
+type Event string
+
+const (
+	// Start is the start of an element or period, or the rise or set of
+	// the sun or moon itself.
+	Start Event = "start"
+	// End is the end of a period.
+	End Event = "end"
+	// Notice announces the start of a period ahead of time.
+	Notice Event = "notice"
+)
+
+// DefaultNotice is how long before a period starts it is announced.
+const DefaultNotice = 5 * time.Minute
+
+// Transition is a change of the panchangam.
+type Transition struct {
+	Kind  Kind
+	Event Event
+	// Name is the name of the element starting, e.g. Dvadashi, or of the
+	// period, e.g. Rahu Kalam.
+	Name string
+	// Element is the element starting at a transition of the tithi,
+	// nakshatra, yoga, karana or vara.
+	Element astronomy.Element
+	// Time is when the transition is due: the time of the change itself,
+	// or of the notice of a period.
+	Time time.Time
+	// At is the time of the change, which a notice precedes.
+	At time.Time
+}
+
+// Message describes t in English, e.g. "Tithi changes to Dvadashi at 14:32"
+// or "Rahu Kalam starts in 5 minutes, at 15:25".
+func (t Transition) Message() string {
+	at := t.At.Format("15:04")
+	switch {
+	case t.Kind == Sunrise || t.Kind == Sunset || t.Kind == Moonrise || t.Kind == Moonset:
+		return fmt.Sprintf("%s at %s", kindNames[t.Kind], at)
+	case t.Element.Kind != "":
+		return fmt.Sprintf("%s changes to %s at %s", kindNames[t.Kind], t.Name, at)
+	case t.Event == Notice:
+		return fmt.Sprintf("%s starts in %d minutes, at %s", t.Name, int(t.At.Sub(t.Time).Round(time.Minute).Minutes()), at)
+	case t.Event == End:
+		return fmt.Sprintf("%s ends at %s", t.Name, at)
+	default:
+		return fmt.Sprintf("%s starts at %s", t.Name, at)
+	}
+}
+
+// Scheduler computes the transitions of the panchangam at a location.
+type Scheduler struct {
+	loc         astronomy.Location
+	zone        *time.Location
+	notice      time.Duration
+	sunOpts     []astronomy.SunOption
+	elementOpts []astronomy.ElementOption
+	// kinds holds the kinds scheduled, or is nil for all of them.
+	kinds map[Kind]bool
+}
+
+// Option configures a Scheduler.
+type Option func(*Scheduler)
+
+// WithNotice sets how long before a period starts it is announced. A zero
+// notice announces none. It defaults to DefaultNotice.
+func WithNotice(d time.Duration) Option {
+	return func(s *Scheduler) {
+		s.notice = d
+	}
+}
+
+// WithSunOptions sets the options sunrise and sunset are calculated with.
+// Days without sunrise or sunset get conventional times regardless.
+func WithSunOptions(opts ...astronomy.SunOption) Option {
+	return func(s *Scheduler) {
+		s.sunOpts = append(s.sunOpts, opts...)
+	}
+}
+
+// WithElementOptions sets the options the elements are calculated with,
+// e.g. astronomy.WithObserver.
+func WithElementOptions(opts ...astronomy.ElementOption) Option {
+	return func(s *Scheduler) {
+		s.elementOpts = append(s.elementOpts, opts...)
+	}
+}
+
+// WithKinds limits the transitions scheduled to those of the given kinds.
+func WithKinds(kinds ...Kind) Option {
+	return func(s *Scheduler) {
+		s.kinds = map[Kind]bool{}
+		for _, k := range kinds {
+			s.kinds[k] = true
+		}
+	}
+}
+
+// NewScheduler returns a Scheduler for loc, whose days are the civil days
+// of zone.
+func NewScheduler(loc astronomy.Location, zone *time.Location, opts ...Option) *Scheduler {
+	s := &Scheduler{
+		loc:     loc,
+		zone:    zone,
+		notice:  DefaultNotice,
+		sunOpts: []astronomy.SunOption{astronomy.WithPolarFallback()},
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+var elementKinds = []astronomy.ElementKind{
+	astronomy.TithiElement, astronomy.NakshatraElement, astronomy.YogaElement, astronomy.KaranaElement,
+}
+
+// Between returns the transitions due from from until to, excluding to,
+// ordered by time. Their times are in the zone of the scheduler.
+func (s *Scheduler) Between(from, to time.Time) ([]Transition, error) {
+	var transitions []Transition
+	add := func(t Transition) {
+		if s.schedules(t.Kind) && !t.Time.Before(from) && t.Time.Before(to) {
+			t.Time, t.At = t.Time.In(s.zone), t.At.In(s.zone)
+			transitions = append(transitions, t)
+		}
+	}
+
+	for _, kind := range elementKinds {
+		if !s.schedules(Kind(kind)) {
+			continue
+		}
+		p := astronomy.CalculateElementPeriod(kind, from, s.elementOpts...)
+		for p.End.Before(to) {
+			// Elements last hours, so a minute past the end of one is
+			// well within the next.
+			next := astronomy.CalculateElementPeriod(kind, p.End.Add(time.Minute), s.elementOpts...)
+			add(Transition{Kind: Kind(kind), Event: Start, Name: next.Name, Element: next.Element, Time: p.End, At: p.End})
+			p = next
+		}
+	}
+
+	// The transitions of a day other than those of the elements fall within
+	// it, so the days of from and to hold them all.
+	first := civilDate(from.In(s.zone))
+	last := civilDate(to.In(s.zone))
+	sunTimes, err := astronomy.CalculateSunTimes(s.loc, first, s.sunOpts...)
+	if err != nil {
+		return nil, err
+	}
+	for date := first; !date.After(last); date = date.AddDate(0, 0, 1) {
+		next, err := astronomy.CalculateSunTimes(s.loc, date.AddDate(0, 0, 1), s.sunOpts...)
+		if err != nil {
+			return nil, err
+		}
+		vara := astronomy.CalculateVara(sunTimes.Sunrise, next.Sunrise)
+		add(Transition{Kind: Sunrise, Event: Start, Name: kindNames[Sunrise], Time: sunTimes.Sunrise, At: sunTimes.Sunrise})
+		add(Transition{Kind: Vara, Event: Start, Name: vara.Name, Element: vara.Element, Time: sunTimes.Sunrise, At: sunTimes.Sunrise})
+		add(Transition{Kind: Sunset, Event: Start, Name: kindNames[Sunset], Time: sunTimes.Sunset, At: sunTimes.Sunset})
+		for kind, period := range map[Kind]astronomy.Period{
+			RahuKalam:   astronomy.RahuKalam(sunTimes.Sunrise, sunTimes.Sunset),
+			Yamagandam:  astronomy.Yamagandam(sunTimes.Sunrise, sunTimes.Sunset),
+			GulikaKalam: astronomy.GulikaKalam(sunTimes.Sunrise, sunTimes.Sunset),
+		} {
+			if s.notice > 0 {
+				add(Transition{Kind: kind, Event: Notice, Name: period.Name, Time: period.Start.Add(-s.notice), At: period.Start})
+			}
+			add(Transition{Kind: kind, Event: Start, Name: period.Name, Time: period.Start, At: period.Start})
+			add(Transition{Kind: kind, Event: End, Name: period.Name, Time: period.End, At: period.End})
+		}
+		if s.schedules(Moonrise) || s.schedules(Moonset) {
+			moonTimes := astronomy.CalculateMoonTimes(s.loc, date)
+			if !moonTimes.Moonrise.IsZero() {
+				add(Transition{Kind: Moonrise, Event: Start, Name: kindNames[Moonrise], Time: moonTimes.Moonrise, At: moonTimes.Moonrise})
+			}
+			if !moonTimes.Moonset.IsZero() {
+				add(Transition{Kind: Moonset, Event: Start, Name: kindNames[Moonset], Time: moonTimes.Moonset, At: moonTimes.Moonset})
+			}
+		}
+		sunTimes = next
+	}
+
+	sort.SliceStable(transitions, func(i, j int) bool {
+		if !transitions[i].Time.Equal(transitions[j].Time) {
+			return transitions[i].Time.Before(transitions[j].Time)
+		}
+		// A period ending at the start of another is reported first, and
+		// the map above has no order.
+		return order(transitions[i]) < order(transitions[j])
+	})
+	return transitions, nil
+}
+
+// schedules reports whether transitions of kind are scheduled.
+func (s *Scheduler) schedules(kind Kind) bool {
+	return s.kinds == nil || s.kinds[kind]
+}
+
+// order ranks simultaneous transitions.
+func order(t Transition) string {
+	rank := map[Event]string{End: "0", Start: "1", Notice: "2"}[t.Event]
+	return rank + string(t.Kind)
+}
+
+func civilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,103 @@
+package transition
+
+import (
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+)
+
+func TestBetween(t *testing.T) {
+	ist := time.FixedZone("IST", 5*60*60+30*60)
+	bengaluru := astronomy.Location{Latitude: 12.9716, Longitude: 77.5946}
+	s := NewScheduler(bengaluru, ist)
+	from := time.Date(2024, 4, 9, 0, 0, 0, 0, ist)
+	transitions, err := s.Between(from, from.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("Between() error = %v", err)
+	}
+
+	count := map[Kind]int{}
+	for i, tr := range transitions {
+		count[tr.Kind]++
+		if i > 0 && tr.Time.Before(transitions[i-1].Time) {
+			t.Errorf("transitions out of order at %d", i)
+		}
+		if tr.Time.Location() != ist {
+			t.Errorf("transition %v not in IST", tr)
+		}
+	}
+	// One vara starts, and a tithi ends, every day; two karanas make a
+	// tithi.
+	if count[Vara] != 1 || count[Sunrise] != 1 || count[Sunset] != 1 || count[Tithi] < 1 || count[Karana] < 2 {
+		t.Errorf("transitions per kind = %v", count)
+	}
+	if count[RahuKalam] != 3 {
+		t.Errorf("%d Rahu Kalam transitions, want notice, start and end", count[RahuKalam])
+	}
+
+	for _, tr := range transitions {
+		if tr.Kind == RahuKalam && tr.Event == Notice {
+			if tr.At.Sub(tr.Time) != DefaultNotice {
+				t.Errorf("notice %v before Rahu Kalam, want %v", tr.At.Sub(tr.Time), DefaultNotice)
+			}
+			if got, want := tr.Message(), "Rahu Kalam starts in 5 minutes, at "+tr.At.Format("15:04"); got != want {
+				t.Errorf("Message() = %q, want %q", got, want)
+			}
+		}
+		if tr.Kind == Vara && tr.Name != "Mangalavara" {
+			t.Errorf("vara %s starts, want Mangalavara", tr.Name)
+		}
+		if tr.Kind == Tithi {
+			// The tithi starting is the one prevailing just after.
+			p := astronomy.CalculateElementPeriod(astronomy.TithiElement, tr.Time.Add(time.Minute))
+			if p.Name != tr.Name || p.Start.Sub(tr.Time).Abs() > time.Second {
+				t.Errorf("tithi %s at %v, want %s from %v", tr.Name, tr.Time, p.Name, p.Start)
+			}
+		}
+	}
+}
+
+func TestWithoutNotice(t *testing.T) {
+	s := NewScheduler(astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}, time.UTC, WithNotice(0))
+	from := time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC)
+	transitions, err := s.Between(from, from.Add(24*time.Hour))
+	if err != nil {
+		t.Fatalf("Between() error = %v", err)
+	}
+	for _, tr := range transitions {
+		if tr.Event == Notice {
+			t.Errorf("notice %v with WithNotice(0)", tr)
+		}
+		if tr.Time.Before(from) || !tr.Time.Before(from.Add(24*time.Hour)) {
+			t.Errorf("transition %v out of range", tr)
+		}
+	}
+}
+
+func TestWithKinds(t *testing.T) {
+	s := NewScheduler(astronomy.Location{Latitude: 28.6139, Longitude: 77.2090}, time.UTC, WithKinds(Moonrise, Tithi))
+	from := time.Date(2024, 4, 9, 0, 0, 0, 0, time.UTC)
+	transitions, err := s.Between(from, from.AddDate(0, 0, 3))
+	if err != nil {
+		t.Fatalf("Between() error = %v", err)
+	}
+	count := map[Kind]int{}
+	for _, tr := range transitions {
+		count[tr.Kind]++
+	}
+	if len(count) != 2 || count[Moonrise] < 2 || count[Tithi] < 2 {
+		t.Errorf("transitions per kind = %v, want moonrises and tithis", count)
+	}
+}
+
+func TestParseKind(t *testing.T) {
+	for _, k := range Kinds() {
+		if got, err := ParseKind(string(k)); got != k || err != nil {
+			t.Errorf("ParseKind(%s) = %s, %v", k, got, err)
+		}
+	}
+	if _, err := ParseKind("eclipse"); err == nil {
+		t.Error("ParseKind(eclipse) succeeded")
+	}
+}
@@ -0,0 +1,65 @@
+// Package version reports the version of the running binary.
+//
+// The variables are set at build time with -ldflags, as the Makefile does:
+//
+//	go build -ldflags "-X github.com/naren-m/panchangam/version.Version=v1.2.0 \
+//		-X github.com/naren-m/panchangam/version.BuildDate=2024-05-01T10:00:00Z \
+//		-X github.com/naren-m/panchangam/version.Features=swisseph"
+package version
+
+import (
+	"runtime/debug"
+	"strings"
+)
+
+var (
+	// Version is the release the binary was built as.
+	Version = "dev"
+	// GitCommit is the revision the binary was built from. When it is not
+	// set, Commit reads the revision Go stamps into binaries built in a
+	// repository.
+	GitCommit = ""
+	// BuildDate is the time the binary was built, in RFC 3339 format.
+	BuildDate = ""
+	// Features lists the optional features built in, separated by commas,
+	// such as the build tags.
+	Features = ""
+)
+
+// Commit returns the revision of the version control system the binary was
+// built from, suffixed with -dirty if the tree had local changes, or an
+// empty string if it is unknown, e.g. for go run.
+func Commit() string {
+	if GitCommit != "" {
+		return GitCommit
+	}
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+	var revision string
+	var modified bool
+	for _, s := range info.Settings {
+		switch s.Key {
+		case "vcs.revision":
+			revision = s.Value
+		case "vcs.modified":
+			modified = s.Value == "true"
+		}
+	}
+	if revision != "" && modified {
+		revision += "-dirty"
+	}
+	return revision
+}
+
+// FeatureList returns the features built in, in the order they were set.
+func FeatureList() []string {
+	var features []string
+	for _, f := range strings.Split(Features, ",") {
+		if f = strings.TrimSpace(f); f != "" {
+			features = append(features, f)
+		}
+	}
+	return features
+}
@@ -0,0 +1,31 @@
+package version
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestFeatureList(t *testing.T) {
+	defer func(features string) { Features = features }(Features)
+	for _, tt := range []struct {
+		features string
+		want     []string
+	}{
+		{"", nil},
+		{"swisseph", []string{"swisseph"}},
+		{"swisseph, redis,,", []string{"swisseph", "redis"}},
+	} {
+		Features = tt.features
+		if got := FeatureList(); !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("FeatureList() with %q = %v, want %v", tt.features, got, tt.want)
+		}
+	}
+}
+
+func TestCommit(t *testing.T) {
+	defer func(commit string) { GitCommit = commit }(GitCommit)
+	GitCommit = "abc123"
+	if got := Commit(); got != "abc123" {
+		t.Errorf("Commit() = %q, want the commit set at build time", got)
+	}
+}
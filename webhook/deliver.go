@@ -0,0 +1,252 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/netip"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+)
+
+// Headers of a delivery.
+const (
+	// TimestampHeader holds the Unix time the delivery was signed at.
+	TimestampHeader = "X-Panchangam-Timestamp"
+	// SignatureHeader holds "sha256=" and the hex HMAC-SHA256, keyed with
+	// the secret of the subscription, of the timestamp, a dot and the body.
+	SignatureHeader = "X-Panchangam-Signature"
+	// EventHeader holds the name of the event, e.g. rahu_kalam_start.
+	EventHeader = "X-Panchangam-Event"
+	// DeliveryHeader holds the ID of the delivery, the same for its retries.
+	DeliveryHeader = "X-Panchangam-Delivery"
+)
+
+// Payload is the JSON body of a delivery.
+type Payload struct {
+	// ID identifies the delivery and is the same for its retries, so that
+	// receivers can ignore duplicates.
+	ID             string `json:"id"`
+	SubscriptionID string `json:"subscription_id"`
+	// Event is the name of the event, e.g. festival or rahu_kalam_start.
+	Event string `json:"event"`
+	// Time is when the event is due in RFC 3339 format, and At when what it
+	// announces happens, later than Time for notices.
+	Time string `json:"time"`
+	At   string `json:"at,omitempty"`
+	// Name is that of the festival, element or period, e.g. Diwali,
+	// Dvadashi or Rahu Kalam.
+	Name string `json:"name"`
+	// Message describes the event in English.
+	Message string `json:"message"`
+	// Date is the civil date of a festival or ekadashi.
+	Date      string  `json:"date,omitempty"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// Sign returns the value of the SignatureHeader of body signed at timestamp
+// with secret.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	fmt.Fprintf(mac, "%d.", timestamp)
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// ErrInvalidSignature is returned by Verify for a delivery not signed with
+// the secret, or signed too long ago.
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// Verify checks the signature of a delivery received with the given
+// headers and body, and that it was signed within tolerance of now, which
+// guards against replays. Receivers written in Go can call it before
+// trusting a delivery.
+func Verify(secret string, header http.Header, body []byte, tolerance time.Duration) error {
+	timestamp, err := strconv.ParseInt(header.Get(TimestampHeader), 10, 64)
+	if err != nil {
+		return fmt.Errorf("%w: bad timestamp", ErrInvalidSignature)
+	}
+	if age := time.Since(time.Unix(timestamp, 0)); age > tolerance || age < -tolerance {
+		return fmt.Errorf("%w: signed %s ago", ErrInvalidSignature, age.Round(time.Second))
+	}
+	if !hmac.Equal([]byte(header.Get(SignatureHeader)), []byte(Sign(secret, timestamp, body))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+// Default retry policy of a Deliverer.
+const (
+	// DefaultAttempts is the number of times a delivery is tried.
+	DefaultAttempts = 5
+	// DefaultBackoff is the wait before the first retry, doubled before
+	// each further one.
+	DefaultBackoff = 10 * time.Second
+	// maxBackoff caps the wait between retries.
+	maxBackoff = 10 * time.Minute
+)
+
+// Deliverer posts payloads to the URLs of subscriptions, retrying failures.
+type Deliverer struct {
+	client   *http.Client
+	attempts int
+	backoff  time.Duration
+	now      func() time.Time
+}
+
+// DelivererOption configures a Deliverer.
+type DelivererOption func(*Deliverer)
+
+// WithHTTPClient sets the client deliveries are posted with. It defaults to
+// a client timing out after 10 seconds that connects directly, without a
+// proxy, and only to the addresses publicAddr accepts; another client
+// posts wherever it is told.
+func WithHTTPClient(c *http.Client) DelivererOption {
+	return func(d *Deliverer) {
+		d.client = c
+	}
+}
+
+// WithRetry sets the number of times a delivery is tried and the wait
+// before the first retry, doubled before each further one up to 10
+// minutes.
+func WithRetry(attempts int, backoff time.Duration) DelivererOption {
+	return func(d *Deliverer) {
+		d.attempts, d.backoff = attempts, backoff
+	}
+}
+
+// WithSigningClock sets the source of the time deliveries are signed at,
+// time.Now by default.
+func WithSigningClock(now func() time.Time) DelivererOption {
+	return func(d *Deliverer) {
+		d.now = now
+	}
+}
+
+// errPrivateAddress is returned for a delivery to an address publicAddr
+// refuses.
+var errPrivateAddress = errors.New("address not on the public internet")
+
+// dialPublic refuses connections to addresses publicAddr rejects. As it
+// sees the address dialed, it also catches the names of subscriptions
+// resolving to them, whenever they are resolved.
+func dialPublic(network, address string, _ syscall.RawConn) error {
+	addr, err := netip.ParseAddrPort(address)
+	if err != nil {
+		return err
+	}
+	if !publicAddr(addr.Addr()) {
+		return fmt.Errorf("%w: %s", errPrivateAddress, addr.Addr())
+	}
+	return nil
+}
+
+// publicClient returns the default client of deliveries.
+func publicClient() *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = (&net.Dialer{
+		Timeout:   10 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   dialPublic,
+	}).DialContext
+	return &http.Client{Timeout: 10 * time.Second, Transport: transport}
+}
+
+// NewDeliverer returns a Deliverer.
+func NewDeliverer(opts ...DelivererOption) *Deliverer {
+	d := &Deliverer{
+		client:   publicClient(),
+		attempts: DefaultAttempts,
+		backoff:  DefaultBackoff,
+		now:      time.Now,
+	}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.attempts < 1 {
+		d.attempts = 1
+	}
+	return d
+}
+
+// permanentError is a failure retrying cannot fix, such as a 4xx response.
+type permanentError struct {
+	err error
+}
+
+func (e *permanentError) Error() string { return e.err.Error() }
+func (e *permanentError) Unwrap() error { return e.err }
+
+// Deliver posts p to the URL of sub, signed with its secret. It retries
+// network errors, 5xx and 429 responses with exponential backoff until the
+// attempts are exhausted or ctx ends, and gives up at once on other
+// responses outside 2xx. It returns the error of the last attempt.
+func (d *Deliverer) Deliver(ctx context.Context, sub Subscription, p Payload) error {
+	body, err := json.Marshal(p)
+	if err != nil {
+		return err
+	}
+	wait := d.backoff
+	for attempt := 1; ; attempt++ {
+		err = d.post(ctx, sub, p, body)
+		var permanent *permanentError
+		if err == nil || errors.As(err, &permanent) || attempt == d.attempts {
+			return err
+		}
+		logger.WarnContext(ctx, "webhook delivery failed, retrying",
+			"subscription", sub.ID, "delivery", p.ID, "attempt", attempt, "retry_in", wait, "error", err)
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+		wait = min(2*wait, maxBackoff)
+	}
+}
+
+// post makes a single attempt of a delivery. Each is signed afresh, so that
+// retries fall within the tolerance of receivers.
+func (d *Deliverer) post(ctx context.Context, sub Subscription, p Payload, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return &permanentError{err}
+	}
+	timestamp := d.now().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "panchangam-webhook")
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(sub.Secret, timestamp, body))
+	req.Header.Set(EventHeader, p.Event)
+	req.Header.Set(DeliveryHeader, p.ID)
+	resp, err := d.client.Do(req)
+	if errors.Is(err, errPrivateAddress) {
+		return &permanentError{err}
+	}
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 == 2 {
+		io.Copy(io.Discard, resp.Body)
+		return nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+	err = fmt.Errorf("%s: %s", resp.Status, strings.TrimSpace(string(msg)))
+	if resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests {
+		return err
+	}
+	return &permanentError{err}
+}
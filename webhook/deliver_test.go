@@ -0,0 +1,150 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+)
+
+// receiver is a test server answering with the statuses given in turn and
+// remembering the requests it received.
+type receiver struct {
+	*httptest.Server
+	mu       sync.Mutex
+	statuses []int
+	requests []*http.Request
+	bodies   [][]byte
+}
+
+func newReceiver(t *testing.T, statuses ...int) *receiver {
+	r := &receiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, err := io.ReadAll(req.Body)
+		if err != nil {
+			t.Errorf("reading request body: %v", err)
+		}
+		r.mu.Lock()
+		defer r.mu.Unlock()
+		status := http.StatusNoContent
+		if n := len(r.requests); n < len(r.statuses) {
+			status = r.statuses[n]
+		}
+		r.requests = append(r.requests, req)
+		r.bodies = append(r.bodies, body)
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *receiver) count() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.requests)
+}
+
+func TestDeliver(t *testing.T) {
+	p := Payload{ID: "d1", SubscriptionID: "s1", Event: "rahu_kalam_start", Name: "Rahu Kalam", Message: "Rahu Kalam starts at 15:25"}
+	for _, tt := range []struct {
+		name     string
+		statuses []int
+		attempts int
+		wantErr  bool
+	}{
+		{"success", nil, 1, false},
+		{"retried 5xx", []int{http.StatusBadGateway, http.StatusTooManyRequests}, 3, false},
+		{"exhausted", []int{500, 500, 500}, 3, true},
+		{"permanent 4xx", []int{http.StatusGone}, 1, true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			srv := newReceiver(t, tt.statuses...)
+			sub := Subscription{ID: "s1", URL: srv.URL, Secret: "whsec_test"}
+			err := NewDeliverer(WithHTTPClient(srv.Client()), WithRetry(3, time.Millisecond)).Deliver(context.Background(), sub, p)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Deliver() error = %v, want error %v", err, tt.wantErr)
+			}
+			if srv.count() != tt.attempts {
+				t.Errorf("Deliver() made %d attempts, want %d", srv.count(), tt.attempts)
+			}
+			req, body := srv.requests[0], srv.bodies[0]
+			if err := Verify(sub.Secret, req.Header, body, time.Minute); err != nil {
+				t.Errorf("Verify() error = %v", err)
+			}
+			if req.Header.Get(EventHeader) != p.Event || req.Header.Get(DeliveryHeader) != p.ID {
+				t.Errorf("headers = %v, want the event and delivery", req.Header)
+			}
+			var got Payload
+			if err := json.Unmarshal(body, &got); err != nil || got != p {
+				t.Errorf("body = %s, want %+v", body, p)
+			}
+		})
+	}
+}
+
+func TestDeliverSigningClock(t *testing.T) {
+	srv := newReceiver(t)
+	sub := Subscription{ID: "s1", URL: srv.URL, Secret: "whsec_test"}
+	signed := time.Date(2024, 11, 1, 6, 0, 0, 0, time.UTC)
+	d := NewDeliverer(WithHTTPClient(srv.Client()), WithSigningClock(func() time.Time { return signed }))
+	if err := d.Deliver(context.Background(), sub, Payload{ID: "d1", Event: FestivalEvent}); err != nil {
+		t.Fatal(err)
+	}
+	req, body := srv.requests[0], srv.bodies[0]
+	if got := req.Header.Get(TimestampHeader); got != strconv.FormatInt(signed.Unix(), 10) {
+		t.Errorf("%s = %s, want the time of the clock %d", TimestampHeader, got, signed.Unix())
+	}
+	if got := req.Header.Get(SignatureHeader); got != Sign(sub.Secret, signed.Unix(), body) {
+		t.Errorf("%s = %s, want the signature at the time of the clock", SignatureHeader, got)
+	}
+}
+
+func TestDeliverPrivate(t *testing.T) {
+	// The default client refuses to connect to the server's network, even
+	// through a name, and the delivery is not retried.
+	srv := newReceiver(t)
+	_, port, _ := net.SplitHostPort(srv.Listener.Addr().String())
+	for _, url := range []string{srv.URL, "http://localhost:" + port + "/hook"} {
+		sub := Subscription{ID: "s1", URL: url, Secret: "whsec_test"}
+		err := NewDeliverer(WithRetry(3, time.Millisecond)).Deliver(context.Background(), sub, Payload{ID: "d1", Event: FestivalEvent})
+		var permanent *permanentError
+		if !errors.Is(err, errPrivateAddress) || !errors.As(err, &permanent) {
+			t.Errorf("Deliver() to %s error = %v, want a permanent %v", url, err, errPrivateAddress)
+		}
+	}
+	if n := srv.count(); n != 0 {
+		t.Errorf("Deliver() reached the receiver %d times", n)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	body := []byte(`{"id":"d1"}`)
+	now := time.Now().Unix()
+	header := func(secret string, timestamp int64) http.Header {
+		h := http.Header{}
+		h.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+		h.Set(SignatureHeader, Sign(secret, timestamp, body))
+		return h
+	}
+	if err := Verify("s", header("s", now), body, time.Minute); err != nil {
+		t.Errorf("Verify() error = %v", err)
+	}
+	for name, h := range map[string]http.Header{
+		"wrong secret": header("other", now),
+		"stale":        header("s", now-3600),
+	} {
+		if err := Verify("s", h, body, time.Minute); !errors.Is(err, ErrInvalidSignature) {
+			t.Errorf("Verify() %s error = %v, want ErrInvalidSignature", name, err)
+		}
+	}
+	if err := Verify("s", header("s", now), []byte(`{"id":"d2"}`), time.Minute); !errors.Is(err, ErrInvalidSignature) {
+		t.Errorf("Verify() of a changed body error = %v, want ErrInvalidSignature", err)
+	}
+}
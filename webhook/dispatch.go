@@ -0,0 +1,214 @@
+package webhook
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/astronomy"
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/observability"
+	"github.com/naren-m/panchangam/transition"
+)
+
+// DefaultInterval is how often a Dispatcher looks for due events.
+const DefaultInterval = time.Minute
+
+// Dispatcher delivers the events of the subscriptions of a store as they
+// become due.
+type Dispatcher struct {
+	store     *Store
+	festivals festival.Source
+	deliverer *Deliverer
+	interval  time.Duration
+	now       func() time.Time
+
+	wg sync.WaitGroup
+}
+
+// DispatcherOption configures a Dispatcher.
+type DispatcherOption func(*Dispatcher)
+
+// WithInterval sets how often the dispatcher looks for due events, and so
+// how late an event may be delivered. It defaults to DefaultInterval.
+func WithInterval(d time.Duration) DispatcherOption {
+	return func(dp *Dispatcher) {
+		dp.interval = d
+	}
+}
+
+// WithDeliverer sets the deliverer posting the events.
+func WithDeliverer(d *Deliverer) DispatcherOption {
+	return func(dp *Dispatcher) {
+		dp.deliverer = d
+	}
+}
+
+// WithClock sets the source of the current time, time.Now by default: the
+// time Run dispatches events from and up to, and that deliveries are
+// signed at unless WithDeliverer sets the deliverer.
+func WithClock(now func() time.Time) DispatcherOption {
+	return func(dp *Dispatcher) {
+		dp.now = now
+	}
+}
+
+// NewDispatcher returns a Dispatcher for the subscriptions of store, whose
+// festivals and ekadashis are those of festivals.
+func NewDispatcher(store *Store, festivals festival.Source, opts ...DispatcherOption) *Dispatcher {
+	d := &Dispatcher{store: store, festivals: festivals, interval: DefaultInterval, now: time.Now}
+	for _, opt := range opts {
+		opt(d)
+	}
+	if d.deliverer == nil {
+		d.deliverer = NewDeliverer(WithSigningClock(d.now))
+	}
+	return d
+}
+
+// Run delivers the events falling due from the time it is called until ctx
+// ends, then waits for the deliveries in flight, which ctx cancels. Events
+// due before Run are not delivered.
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.interval)
+	defer ticker.Stop()
+	last := d.now()
+	for {
+		select {
+		case <-ctx.Done():
+			d.wg.Wait()
+			return
+		case <-ticker.C:
+			now := d.now()
+			d.Dispatch(ctx, last, now)
+			last = now
+		}
+	}
+}
+
+// Dispatch starts delivering the events of every subscription due from
+// from, excluding it, until to, and returns without waiting for them.
+func (d *Dispatcher) Dispatch(ctx context.Context, from, to time.Time) {
+	for _, sub := range d.store.all() {
+		payloads, err := d.due(ctx, sub, from, to)
+		if err != nil {
+			logger.ErrorContext(ctx, "computing webhook events", "subscription", sub.ID, "error", err)
+			observability.RecordError(ctx, "webhook", err)
+			continue
+		}
+		for _, p := range payloads {
+			d.wg.Add(1)
+			go func(sub Subscription, p Payload) {
+				defer d.wg.Done()
+				if err := d.deliverer.Deliver(ctx, sub, p); err != nil {
+					logger.ErrorContext(ctx, "webhook delivery failed", "subscription", sub.ID, "delivery", p.ID, "url", sub.URL, "error", err)
+					observability.RecordError(ctx, "webhook", err, observability.WithCategory(observability.CategoryDependency),
+						observability.WithRetryable(true))
+					return
+				}
+				logger.InfoContext(ctx, "webhook delivered", "subscription", sub.ID, "delivery", p.ID, "event", p.Event)
+			}(sub, p)
+		}
+	}
+}
+
+// Wait waits for the deliveries started by Dispatch.
+func (d *Dispatcher) Wait() {
+	d.wg.Wait()
+}
+
+// due returns the payloads of the events of sub due after from until to.
+func (d *Dispatcher) due(ctx context.Context, sub Subscription, from, to time.Time) ([]Payload, error) {
+	zone, err := time.LoadLocation(sub.Timezone)
+	if err != nil {
+		return nil, err
+	}
+	loc := astronomy.Location{Latitude: sub.Latitude, Longitude: sub.Longitude}
+	payload := func(event, name, message string, due time.Time) Payload {
+		return Payload{
+			ID:             fmt.Sprintf("%s-%s-%d", sub.ID, event, due.Unix()),
+			SubscriptionID: sub.ID,
+			Event:          event,
+			Time:           due.In(zone).Format(time.RFC3339),
+			Name:           name,
+			Message:        message,
+			Latitude:       sub.Latitude,
+			Longitude:      sub.Longitude,
+		}
+	}
+
+	var payloads []Payload
+	// Day events are due at the midnight starting their date, so the
+	// midnights after from hold them.
+	if sub.wants(FestivalEvent) || sub.wants(EkadashiEvent) {
+		first := civilDate(from.In(zone)).AddDate(0, 0, 1)
+		last := civilDate(to.In(zone))
+		if !last.Before(first) {
+			rules, err := d.festivals.ForRegion(sub.Region)
+			if err != nil {
+				return nil, err
+			}
+			events, err := rules.GenerateContext(ctx, first, last, sub.Region, loc)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range events {
+				event := FestivalEvent
+				if e.Definition == "ekadashi" {
+					event = EkadashiEvent
+				} else if e.Kind != festival.FestivalKind {
+					continue
+				}
+				if !sub.wants(event) {
+					continue
+				}
+				date, err := time.ParseInLocation("2006-01-02", e.Date, zone)
+				if err != nil {
+					return nil, err
+				}
+				name := e.Names["en"]
+				if name == "" {
+					name = e.ID
+				}
+				p := payload(event, name, fmt.Sprintf("%s is observed on %s", name, e.Date), date)
+				p.ID = sub.ID + "-" + e.ID
+				p.Date = e.Date
+				payloads = append(payloads, p)
+			}
+		}
+	}
+
+	var kinds []transition.Kind
+	for _, kind := range transition.Kinds() {
+		for _, e := range sub.Events {
+			if e == string(kind) || (periodKinds[kind] && strings.HasPrefix(e, string(kind)+"_")) {
+				kinds = append(kinds, kind)
+				break
+			}
+		}
+	}
+	if len(kinds) > 0 {
+		// Between includes its start and Dispatch excludes it.
+		transitions, err := transition.NewScheduler(loc, zone, transition.WithKinds(kinds...)).Between(from.Add(time.Nanosecond), to.Add(time.Nanosecond))
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range transitions {
+			event := eventName(t)
+			if !sub.wants(event) {
+				continue
+			}
+			p := payload(event, t.Name, t.Message(), t.Time)
+			p.At = t.At.Format(time.RFC3339)
+			payloads = append(payloads, p)
+		}
+	}
+	return payloads, nil
+}
+
+func civilDate(t time.Time) time.Time {
+	y, m, d := t.Date()
+	return time.Date(y, m, d, 0, 0, 0, 0, t.Location())
+}
@@ -0,0 +1,123 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/naren-m/panchangam/festival"
+	"github.com/naren-m/panchangam/services/panchangam/panchangamtest"
+)
+
+func TestDispatch(t *testing.T) {
+	srv := newReceiver(t)
+	store, err := OpenStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.allowPrivate = true
+	sub, err := store.Create(Subscription{
+		URL:       srv.URL,
+		Latitude:  28.6139,
+		Longitude: 77.2090,
+		Timezone:  "Asia/Kolkata",
+		Events:    []string{EkadashiEvent, FestivalEvent, "rahu_kalam_start", "sunrise"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := NewDispatcher(store, festival.DefaultRuleSet(), WithDeliverer(NewDeliverer(WithHTTPClient(srv.Client()), WithRetry(1, 0))))
+
+	// Diwali and the Prabodhini Ekadashi of 2024 in Delhi.
+	zone, _ := time.LoadLocation("Asia/Kolkata")
+	for _, tt := range []struct {
+		from, to time.Time
+		want     map[string]int
+	}{
+		{time.Date(2024, 10, 31, 23, 0, 0, 0, zone), time.Date(2024, 11, 1, 1, 0, 0, 0, zone), map[string]int{FestivalEvent: 1}},
+		{time.Date(2024, 11, 11, 23, 59, 0, 0, zone), time.Date(2024, 11, 12, 0, 0, 0, 0, zone), map[string]int{EkadashiEvent: 1}},
+		{time.Date(2024, 11, 12, 0, 0, 0, 0, zone), time.Date(2024, 11, 12, 23, 59, 0, 0, zone), map[string]int{"rahu_kalam_start": 1, "sunrise": 1}},
+	} {
+		srv.mu.Lock()
+		srv.requests, srv.bodies = nil, nil
+		srv.mu.Unlock()
+		d.Dispatch(context.Background(), tt.from, tt.to)
+		d.Wait()
+
+		got := map[string]int{}
+		for _, body := range srv.bodies {
+			var p Payload
+			if err := json.Unmarshal(body, &p); err != nil {
+				t.Fatal(err)
+			}
+			if p.SubscriptionID != sub.ID || p.Name == "" || p.Message == "" {
+				t.Errorf("Dispatch(%s, %s) delivered %+v", tt.from, tt.to, p)
+			}
+			got[p.Event]++
+		}
+		if len(got) != len(tt.want) {
+			t.Errorf("Dispatch(%s, %s) delivered %v, want %v", tt.from, tt.to, got, tt.want)
+			continue
+		}
+		for event, n := range tt.want {
+			if got[event] != n {
+				t.Errorf("Dispatch(%s, %s) delivered %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		}
+	}
+}
+
+func TestDispatcherRun(t *testing.T) {
+	srv := newReceiver(t)
+	store, err := OpenStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	store.allowPrivate = true
+	if _, err := store.Create(Subscription{
+		URL:       srv.URL,
+		Latitude:  28.6139,
+		Longitude: 77.2090,
+		Timezone:  "Asia/Kolkata",
+		Events:    []string{FestivalEvent},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// The eve of Diwali 2024 in Delhi.
+	zone, _ := time.LoadLocation("Asia/Kolkata")
+	clock := panchangamtest.NewClock(time.Date(2024, 10, 31, 23, 0, 0, 0, zone))
+	d := NewDispatcher(store, festival.DefaultRuleSet(), WithClock(clock.Now), WithInterval(time.Millisecond))
+	d.deliverer.client = srv.Client()
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		d.Run(ctx)
+		close(done)
+	}()
+
+	// Nothing falls due while the clock stands still.
+	time.Sleep(20 * time.Millisecond)
+	if n := srv.count(); n != 0 {
+		t.Fatalf("Run() delivered %d events before Diwali", n)
+	}
+	clock.Advance(2 * time.Hour)
+	for deadline := time.Now().Add(5 * time.Second); srv.count() == 0 && time.Now().Before(deadline); {
+		time.Sleep(time.Millisecond)
+	}
+	cancel()
+	<-done
+
+	if n := srv.count(); n != 1 {
+		t.Fatalf("Run() delivered %d events once the clock passed Diwali, want 1", n)
+	}
+	var p Payload
+	if err := json.Unmarshal(srv.bodies[0], &p); err != nil || p.Event != FestivalEvent {
+		t.Errorf("Run() delivered %s, %v, want Diwali", srv.bodies[0], err)
+	}
+	// The delivery is signed at the time of the clock.
+	if got, want := srv.requests[0].Header.Get(TimestampHeader), strconv.FormatInt(clock.Now().Unix(), 10); got != want {
+		t.Errorf("%s = %s, want %s", TimestampHeader, got, want)
+	}
+}
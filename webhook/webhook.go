@@ -0,0 +1,332 @@
+// Package webhook delivers festival and transition notifications to URLs
+// registered by clients.
+//
+// A Subscription names a URL, a location and the events it wants, such as
+// festival, ekadashi or rahu_kalam_start. A Dispatcher computes the events
+// of every subscription as they become due and POSTs each as JSON, signed
+// with the secret of the subscription, retrying failed deliveries with
+// exponential backoff.
+package webhook
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/naren-m/panchangam/log"
+	"github.com/naren-m/panchangam/transition"
+)
+
+var logger = log.Logger()
+
+// Day events, due at the midnight starting the civil date they are
+// observed on.
+const (
+	// FestivalEvent is a festival, e.g. Diwali.
+	FestivalEvent = "festival"
+	// EkadashiEvent is an ekadashi vrat.
+	EkadashiEvent = "ekadashi"
+)
+
+// periodKinds are the kinds of transition of periods, whose start, end and
+// notice are separate events, e.g. rahu_kalam_start.
+var periodKinds = map[transition.Kind]bool{
+	transition.RahuKalam:   true,
+	transition.Yamagandam:  true,
+	transition.GulikaKalam: true,
+}
+
+// Events returns the names of the events a subscription may ask for: the
+// day events, the kinds of transition of the elements and of the sun and
+// moon, e.g. tithi or sunrise, and the start, end and notice of the
+// periods, e.g. rahu_kalam_start.
+func Events() []string {
+	events := []string{FestivalEvent, EkadashiEvent}
+	for _, kind := range transition.Kinds() {
+		if !periodKinds[kind] {
+			events = append(events, string(kind))
+			continue
+		}
+		for _, e := range []transition.Event{transition.Start, transition.End, transition.Notice} {
+			events = append(events, string(kind)+"_"+string(e))
+		}
+	}
+	return events
+}
+
+// eventName returns the name of the event of t.
+func eventName(t transition.Transition) string {
+	if periodKinds[t.Kind] {
+		return string(t.Kind) + "_" + string(t.Event)
+	}
+	return string(t.Kind)
+}
+
+var (
+	// ErrNotFound is returned when deleting an unknown subscription.
+	ErrNotFound = errors.New("subscription not found")
+	// ErrInvalidURL is returned for a subscription to other than an
+	// absolute http or https URL.
+	ErrInvalidURL = errors.New("invalid url")
+	// ErrInvalidEvents is returned for a subscription to no events or to
+	// unknown ones.
+	ErrInvalidEvents = errors.New("invalid events")
+	// ErrTooManySubscriptions is returned when an owner already has the
+	// most subscriptions a store allows.
+	ErrTooManySubscriptions = errors.New("too many subscriptions")
+)
+
+// DefaultMaxSubscriptions is the most subscriptions an owner may have in a
+// store unless WithMaxSubscriptions sets another limit.
+const DefaultMaxSubscriptions = 20
+
+// publicAddr reports whether deliveries may be posted to addr. Loopback,
+// private, link-local, multicast and unspecified addresses are refused, so
+// that subscriptions cannot reach the network of the server, such as the
+// metadata endpoint of a cloud instance.
+func publicAddr(addr netip.Addr) bool {
+	addr = addr.Unmap()
+	return addr.IsValid() && !addr.IsLoopback() && !addr.IsPrivate() && !addr.IsLinkLocalUnicast() &&
+		!addr.IsMulticast() && !addr.IsUnspecified()
+}
+
+// Subscription is a URL notified of the events at a location.
+type Subscription struct {
+	ID string `json:"id"`
+	// Owner is the ID of the API key that created the subscription, empty
+	// when the server needs none. Only the owner lists and deletes it.
+	Owner string `json:"owner,omitempty"`
+	// URL is the http or https endpoint the events are posted to.
+	URL       string  `json:"url"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+	// Timezone is the IANA timezone of the civil dates of the day events.
+	Timezone string `json:"timezone"`
+	// Region selects the festivals, e.g. tamil_nadu; empty for all.
+	Region string `json:"region,omitempty"`
+	// Events lists the names of the events delivered, see Events.
+	Events []string `json:"events"`
+	// Secret signs the deliveries. It is returned when the subscription is
+	// created and never listed.
+	Secret  string    `json:"secret"`
+	Created time.Time `json:"created"`
+}
+
+// wants reports whether the subscription asked for the event named name.
+func (s *Subscription) wants(name string) bool {
+	return contains(s.Events, name)
+}
+
+// validate checks the URL and events of s. A URL naming a host by an
+// address publicAddr refuses, or localhost, is invalid; names resolving to
+// such addresses are refused when a delivery dials them.
+func (s *Subscription) validate(allowPrivate bool) error {
+	u, err := url.Parse(s.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("%w %q: expected an absolute http or https URL", ErrInvalidURL, s.URL)
+	}
+	host := strings.ToLower(strings.TrimSuffix(u.Hostname(), "."))
+	addr, err := netip.ParseAddr(host)
+	if !allowPrivate && (host == "localhost" || strings.HasSuffix(host, ".localhost") || err == nil && !publicAddr(addr)) {
+		return fmt.Errorf("%w %q: expected a host on the public internet", ErrInvalidURL, s.URL)
+	}
+	known := Events()
+	if len(s.Events) == 0 {
+		return fmt.Errorf("%w: expected some of %s", ErrInvalidEvents, strings.Join(known, ", "))
+	}
+	for _, e := range s.Events {
+		if !contains(known, e) {
+			return fmt.Errorf("%w: unknown event %q: expected one of %s", ErrInvalidEvents, e, strings.Join(known, ", "))
+		}
+	}
+	if _, err := time.LoadLocation(s.Timezone); err != nil {
+		return fmt.Errorf("invalid timezone %q: %v", s.Timezone, err)
+	}
+	return nil
+}
+
+// Store keeps the subscriptions, in a JSON file if it has a path.
+type Store struct {
+	path     string
+	now      func() time.Time
+	maxOwned int
+	// allowPrivate accepts the URLs of any host, for tests posting to a
+	// local server.
+	allowPrivate bool
+
+	mu   sync.Mutex
+	subs []Subscription
+}
+
+// StoreOption configures a Store.
+type StoreOption func(*Store)
+
+// WithCreationClock sets the source of the creation times of
+// subscriptions, time.Now by default.
+func WithCreationClock(now func() time.Time) StoreOption {
+	return func(s *Store) {
+		s.now = now
+	}
+}
+
+// WithMaxSubscriptions sets the most subscriptions an owner may have, by
+// default DefaultMaxSubscriptions. Zero or less lifts the limit. On a
+// server without API keys every caller is the same owner.
+func WithMaxSubscriptions(n int) StoreOption {
+	return func(s *Store) {
+		s.maxOwned = n
+	}
+}
+
+// OpenStore returns the store kept in the file at path, which is created
+// when the first subscription is added. With an empty path the
+// subscriptions are kept in memory only.
+func OpenStore(path string, opts ...StoreOption) (*Store, error) {
+	s := &Store{path: path, now: time.Now, maxOwned: DefaultMaxSubscriptions}
+	for _, opt := range opts {
+		opt(s)
+	}
+	if path == "" {
+		return s, nil
+	}
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return s, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, &s.subs); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return s, nil
+}
+
+// save writes the subscriptions to a temporary file and renames it over the
+// store, so that a crash never leaves a partial file.
+func (s *Store) save() error {
+	if s.path == "" {
+		return nil
+	}
+	data, err := json.MarshalIndent(s.subs, "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), ".webhooks-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
+
+// Create validates sub, assigns it an ID, a secret and its creation time,
+// and adds it to the store, unless its owner already has as many
+// subscriptions as allowed.
+func (s *Store) Create(sub Subscription) (Subscription, error) {
+	if err := sub.validate(s.allowPrivate); err != nil {
+		return Subscription{}, err
+	}
+	id, err := randomHex(8)
+	if err != nil {
+		return Subscription{}, err
+	}
+	secret, err := randomHex(24)
+	if err != nil {
+		return Subscription{}, err
+	}
+	sub.ID, sub.Secret = id, "whsec_"+secret
+	sub.Created = s.now().UTC().Truncate(time.Second)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.maxOwned > 0 {
+		owned := 0
+		for _, other := range s.subs {
+			if other.Owner == sub.Owner {
+				owned++
+			}
+		}
+		if owned >= s.maxOwned {
+			return Subscription{}, fmt.Errorf("%w: the limit is %d", ErrTooManySubscriptions, s.maxOwned)
+		}
+	}
+	s.subs = append(s.subs, sub)
+	if err := s.save(); err != nil {
+		s.subs = s.subs[:len(s.subs)-1]
+		return Subscription{}, err
+	}
+	return sub, nil
+}
+
+// List returns the subscriptions of owner ordered by creation.
+func (s *Store) List(owner string) []Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var subs []Subscription
+	for _, sub := range s.subs {
+		if sub.Owner == owner {
+			subs = append(subs, sub)
+		}
+	}
+	return subs
+}
+
+// all returns every subscription.
+func (s *Store) all() []Subscription {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Subscription(nil), s.subs...)
+}
+
+// Delete removes the subscription of owner with the given ID.
+func (s *Store) Delete(owner, id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for i, sub := range s.subs {
+		if sub.ID == id && sub.Owner == owner {
+			subs := append(append([]Subscription(nil), s.subs[:i]...), s.subs[i+1:]...)
+			old := s.subs
+			s.subs = subs
+			if err := s.save(); err != nil {
+				s.subs = old
+				return err
+			}
+			return nil
+		}
+	}
+	return fmt.Errorf("%w: %s", ErrNotFound, id)
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func randomHex(n int) (string, error) {
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,167 @@
+package webhook
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+var testSubscription = Subscription{
+	Owner:     "key1",
+	URL:       "https://example.com/hook",
+	Latitude:  28.6139,
+	Longitude: 77.2090,
+	Timezone:  "Asia/Kolkata",
+	Events:    []string{FestivalEvent, "rahu_kalam_start"},
+}
+
+func TestEvents(t *testing.T) {
+	events := strings.Join(Events(), ",")
+	for _, want := range []string{"festival", "ekadashi", "tithi", "sunrise", "rahu_kalam_start", "rahu_kalam_end", "gulika_kalam_notice"} {
+		if !contains(Events(), want) {
+			t.Errorf("Events() = %s, missing %s", events, want)
+		}
+	}
+	if contains(Events(), "rahu_kalam") || contains(Events(), "tithi_start") {
+		t.Errorf("Events() = %s, want periods only with their start, end and notice", events)
+	}
+}
+
+func TestStore(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "webhooks.json")
+	store, err := OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sub, err := store.Create(testSubscription)
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+	if sub.ID == "" || !strings.HasPrefix(sub.Secret, "whsec_") || sub.Created.IsZero() {
+		t.Errorf("Create() = %+v, want an ID, a secret and a creation time", sub)
+	}
+	if _, err := store.Create(Subscription{Owner: "key2", URL: "https://example.org/", Timezone: "UTC", Events: []string{"sunrise"}}); err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	reopened, err := OpenStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := reopened.List("key1"); len(got) != 1 || got[0].ID != sub.ID || got[0].Secret != sub.Secret {
+		t.Errorf("List(key1) after reopening = %+v, want %s", got, sub.ID)
+	}
+	if err := reopened.Delete("key2", sub.ID); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Delete() by another owner error = %v, want ErrNotFound", err)
+	}
+	if err := reopened.Delete("key1", sub.ID); err != nil {
+		t.Fatalf("Delete() error = %v", err)
+	}
+	if got := reopened.List("key1"); len(got) != 0 {
+		t.Errorf("List(key1) after Delete() = %+v, want none", got)
+	}
+	if got := reopened.List("key2"); len(got) != 1 {
+		t.Errorf("List(key2) = %+v, want one subscription", got)
+	}
+
+	// The creation time is that of the store's clock, to the second.
+	clock := func() time.Time { return time.Date(2024, 11, 1, 6, 0, 0, 5e8, time.FixedZone("IST", 19800)) }
+	frozen, err := OpenStore("", WithCreationClock(clock))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub, err := frozen.Create(testSubscription); err != nil || !sub.Created.Equal(time.Date(2024, 11, 1, 0, 30, 0, 0, time.UTC)) || sub.Created.Location() != time.UTC {
+		t.Errorf("Create() with a clock = %v, %v, want created at 2024-11-01T00:30:00Z", sub.Created, err)
+	}
+
+	for _, tt := range []struct {
+		name string
+		edit func(*Subscription)
+	}{
+		{"relative url", func(s *Subscription) { s.URL = "/hook" }},
+		{"ftp url", func(s *Subscription) { s.URL = "ftp://example.com/" }},
+		{"no events", func(s *Subscription) { s.Events = nil }},
+		{"unknown event", func(s *Subscription) { s.Events = []string{"rahu_kalam"} }},
+		{"bad timezone", func(s *Subscription) { s.Timezone = "Mars/Olympus" }},
+	} {
+		sub := testSubscription
+		tt.edit(&sub)
+		if _, err := store.Create(sub); err == nil {
+			t.Errorf("Create() with %s succeeded", tt.name)
+		}
+	}
+}
+
+func TestStorePrivateURL(t *testing.T) {
+	store, err := OpenStore("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, url := range []string{
+		"http://localhost:8080/hook",
+		"http://api.localhost/hook",
+		"http://127.0.0.1/hook",
+		"http://[::1]/hook",
+		"http://[::ffff:127.0.0.1]/hook",
+		"http://0.0.0.0/hook",
+		"http://10.0.0.5/hook",
+		"http://192.168.1.1/hook",
+		"http://[fd00::1]/hook",
+		"http://169.254.169.254/latest/meta-data/",
+		"http://[fe80::1]/hook",
+	} {
+		sub := testSubscription
+		sub.URL = url
+		if _, err := store.Create(sub); !errors.Is(err, ErrInvalidURL) {
+			t.Errorf("Create() of %s error = %v, want %v", url, err, ErrInvalidURL)
+		}
+	}
+	// Names are resolved when delivering, not when subscribing.
+	for _, url := range []string{"https://203.0.113.7/hook", "https://hooks.example.com/hook"} {
+		sub := testSubscription
+		sub.URL = url
+		if _, err := store.Create(sub); err != nil {
+			t.Errorf("Create() of %s error = %v", url, err)
+		}
+	}
+}
+
+func TestStoreLimit(t *testing.T) {
+	store, err := OpenStore("", WithMaxSubscriptions(2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var first Subscription
+	for i := 0; i < 2; i++ {
+		if first, err = store.Create(testSubscription); err != nil {
+			t.Fatalf("Create() %d error = %v", i+1, err)
+		}
+	}
+	if _, err := store.Create(testSubscription); !errors.Is(err, ErrTooManySubscriptions) {
+		t.Errorf("Create() past the limit error = %v, want %v", err, ErrTooManySubscriptions)
+	}
+	// The limit is per owner, and deleting a subscription makes room.
+	other := testSubscription
+	other.Owner = "key2"
+	if _, err := store.Create(other); err != nil {
+		t.Errorf("Create() of another owner error = %v", err)
+	}
+	if err := store.Delete(testSubscription.Owner, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := store.Create(testSubscription); err != nil {
+		t.Errorf("Create() after Delete() error = %v", err)
+	}
+
+	unlimited, err := OpenStore("", WithMaxSubscriptions(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < DefaultMaxSubscriptions+1; i++ {
+		if _, err := unlimited.Create(testSubscription); err != nil {
+			t.Fatalf("Create() %d without a limit error = %v", i+1, err)
+		}
+	}
+}